@@ -38,8 +38,24 @@ SSLMODE=$(yq e '.postgres.sslmode' "$CONFIG_PATH")
 DB_URL="postgres://$USER:$PASSWORD@$HOST:$PORT/$DB?sslmode=$SSLMODE"
 MIGRATION_PATH="./services/$SERVICE/migrations"
 
-if [ -n "$STEPS" ]; then
-  migrate -path "$MIGRATION_PATH" -database "$DB_URL" "$ACTION" "$STEPS"
+# Services with an outbox section also get the outbox table shared through
+# pkg/outbox. Its migrations keep a version table of their own, so their
+# versions do not mix with the ones of the service.
+OUTBOX_MIGRATION_PATH="./pkg/outbox/migrations"
+OUTBOX_DB_URL="$DB_URL&x-migrations-table=outbox_schema_migrations"
+
+run() {
+  if [ -n "$STEPS" ]; then
+    migrate -path "$1" -database "$2" "$ACTION" "$STEPS"
+  else
+    migrate -path "$1" -database "$2" "$ACTION"
+  fi
+}
+
+if [ "$(yq e '.outbox' "$CONFIG_PATH")" = "null" ] || [ "$ACTION" = "force" ]; then
+  run "$MIGRATION_PATH" "$DB_URL"
+elif [ "$ACTION" = "down" ]; then
+  run "$OUTBOX_MIGRATION_PATH" "$OUTBOX_DB_URL" && run "$MIGRATION_PATH" "$DB_URL"
 else
-  migrate -path "$MIGRATION_PATH" -database "$DB_URL" "$ACTION"
+  run "$MIGRATION_PATH" "$DB_URL" && run "$OUTBOX_MIGRATION_PATH" "$OUTBOX_DB_URL"
 fi
\ No newline at end of file
@@ -0,0 +1,15 @@
+// Package authn signs and parses the access tokens the auth service issues so
+// that every service reads the caller's identity the same way.
+package authn
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims identifies the user a token was issued to. R is the service's own
+// user role type; roles travel as their enum names.
+type Claims[R ~string] struct {
+	jwt.RegisteredClaims
+	Role R
+	Sub  int64
+}
@@ -0,0 +1,5 @@
+module authn
+
+go 1.25.4
+
+require github.com/golang-jwt/jwt/v5 v5.3.0
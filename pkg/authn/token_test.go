@@ -0,0 +1,56 @@
+package authn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type role string
+
+func TestParseBearerToken(t *testing.T) {
+	const secret = "access-secret"
+
+	valid, err := SignToken(42, role("USER_ROLE_MODERATOR"), time.Minute, []byte(secret))
+	if err != nil {
+		t.Fatalf("SignToken() error = %v", err)
+	}
+	expired, err := SignToken(42, role("USER_ROLE_USER"), -time.Minute, []byte(secret))
+	if err != nil {
+		t.Fatalf("SignToken() error = %v", err)
+	}
+
+	claims, err := ParseBearerToken[role](valid, secret)
+	if err != nil {
+		t.Fatalf("ParseBearerToken() error = %v", err)
+	}
+	if claims.Sub != 42 || claims.Role != "USER_ROLE_MODERATOR" {
+		t.Errorf("claims = %d %s, want 42 USER_ROLE_MODERATOR", claims.Sub, claims.Role)
+	}
+
+	for name, token := range map[string]string{
+		"expired":      expired,
+		"other secret": mustSign(t, "other-secret"),
+		"tampered":     valid[:strings.LastIndexByte(valid, '.')] + ".c2lnbmF0dXJl",
+		"unsigned":     "eyJhbGciOiJub25lIn0.eyJTdWIiOjQyfQ.",
+		"not a jwt":    "token",
+		"missing":      "",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseBearerToken[role](token, secret); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("ParseBearerToken() error = %v, want %v", err, ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func mustSign(t *testing.T, secret string) string {
+	t.Helper()
+
+	token, err := SignToken(1, role("USER_ROLE_ADMIN"), time.Minute, []byte(secret))
+	if err != nil {
+		t.Fatalf("SignToken() error = %v", err)
+	}
+	return token
+}
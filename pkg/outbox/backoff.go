@@ -0,0 +1,12 @@
+package outbox
+
+import "time"
+
+// maxRetryShift caps the exponential backoff at 1024 times the base delay.
+const maxRetryShift = 10
+
+// Backoff is how long to wait before the next try after attempts failed ones:
+// base doubles with every failure until it reaches 1024 times base.
+func Backoff(base time.Duration, attempts int32) time.Duration {
+	return base << min(max(attempts, 0), maxRetryShift)
+}
@@ -0,0 +1,25 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{attempts: 0, want: time.Second},
+		{attempts: 1, want: 2 * time.Second},
+		{attempts: 3, want: 8 * time.Second},
+		{attempts: 10, want: 1024 * time.Second},
+		{attempts: 50, want: 1024 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := Backoff(time.Second, tt.attempts); got != tt.want {
+			t.Errorf("Backoff(1s, %d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
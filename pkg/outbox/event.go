@@ -0,0 +1,37 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Event is a domain event stored in the transaction of the change that caused
+// it; Payload is the protobuf encoding of the event.
+type Event struct {
+	CreatedAt     time.Time
+	AvailableAt   time.Time
+	LastError     *string
+	AggregateType string
+	AggregateID   string
+	EventType     string
+	Payload       []byte
+	Attempts      int32
+	ID            uuid.UUID
+}
+
+// NewEvent encodes msg as the payload of an event of the given aggregate.
+func NewEvent(aggregateType, aggregateID, eventType string, msg proto.Message) (*Event, error) {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       payload,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"outbox"
+)
+
+const Name = "kafka"
+
+// Publisher writes messages to Kafka, waiting for all in-sync replicas. The
+// writer tries each message once and flushes it right away; retries are left
+// to the outbox relay.
+type Publisher struct {
+	writer *kafka.Writer
+}
+
+func New(brokers []string) *Publisher {
+	return &Publisher{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+			BatchTimeout:           10 * time.Millisecond,
+			MaxAttempts:            1,
+		},
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, msg outbox.Message) error {
+	headers := make([]kafka.Header, 0, len(msg.Headers))
+	for key, value := range msg.Headers {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Topic:   msg.Topic,
+		Key:     []byte(msg.Key),
+		Value:   msg.Value,
+		Headers: headers,
+	})
+}
+
+func (p *Publisher) Close() error {
+	return p.writer.Close()
+}
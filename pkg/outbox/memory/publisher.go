@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"outbox"
+)
+
+const Name = "memory"
+
+// Publisher keeps published messages in memory, for tests and running the
+// service without a broker.
+type Publisher struct {
+	messages []outbox.Message
+	mu       sync.Mutex
+}
+
+func New() *Publisher {
+	return &Publisher{}
+}
+
+func (p *Publisher) Publish(_ context.Context, msg outbox.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+// Messages returns the messages published so far, oldest first.
+func (p *Publisher) Messages() []outbox.Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	messages := make([]outbox.Message, len(p.messages))
+	copy(messages, p.messages)
+	return messages
+}
+
+func (p *Publisher) Close() error {
+	return nil
+}
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Domain events wait here until the outbox relay has published them. A failed
+-- publish is retried from available_at; an event that keeps failing goes to the
+-- dead-letter topic and gets dead_lettered_at instead of published_at.
+--
+-- seq orders the events of one aggregate even when they were stored in the same
+-- transaction and share created_at; the relay only picks the oldest
+-- unpublished event of each aggregate, so one waiting to be retried holds back
+-- the newer ones.
+CREATE TABLE IF NOT EXISTS outbox (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    seq BIGINT GENERATED ALWAYS AS IDENTITY,
+    aggregate_type TEXT NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload BYTEA NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMPTZ,
+    dead_lettered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(available_at, created_at)
+    WHERE published_at IS NULL AND dead_lettered_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending_aggregate ON outbox(aggregate_type, aggregate_id, seq)
+    WHERE published_at IS NULL AND dead_lettered_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_outbox_pending_aggregate;
+
+DROP INDEX IF EXISTS idx_outbox_pending;
+
+DROP TABLE IF EXISTS outbox;
+-- +goose StatementEnd
@@ -0,0 +1,67 @@
+package postgres
+
+const (
+	createEvent = `
+		INSERT INTO outbox (
+			aggregate_type,
+			aggregate_id,
+			event_type,
+			payload
+		)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, available_at, created_at;`
+
+	// selectPendingEvents locks the oldest events due for publishing and skips
+	// the ones another relay is already publishing. An event is only due once
+	// every older event of its aggregate has been published or dead-lettered,
+	// so a retry never lets a newer event overtake it.
+	selectPendingEvents = `
+		SELECT
+			id,
+			aggregate_type,
+			aggregate_id,
+			event_type,
+			payload,
+			attempts,
+			last_error,
+			available_at,
+			created_at
+		FROM outbox
+		WHERE published_at IS NULL
+		  AND dead_lettered_at IS NULL
+		  AND available_at <= now()
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM outbox AS older
+			WHERE older.aggregate_type = outbox.aggregate_type
+			  AND older.aggregate_id = outbox.aggregate_id
+			  AND older.published_at IS NULL
+			  AND older.dead_lettered_at IS NULL
+			  AND older.seq < outbox.seq
+		  )
+		ORDER BY seq
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED;`
+
+	// markEventPublished drops the payload: once on the topic the event is
+	// only kept as a record of what was published, not of what it carried.
+	markEventPublished = `
+		UPDATE outbox
+		SET published_at = now(),
+			payload = ''::bytea
+		WHERE id = $1;`
+
+	markEventFailed = `
+		UPDATE outbox
+		SET attempts = attempts + 1,
+			last_error = $2,
+			available_at = $3
+		WHERE id = $1;`
+
+	markEventDeadLettered = `
+		UPDATE outbox
+		SET attempts = attempts + 1,
+			last_error = $2,
+			dead_lettered_at = now()
+		WHERE id = $1;`
+)
@@ -0,0 +1,104 @@
+// Package postgres keeps the outbox in the outbox table that
+// pkg/outbox/migrations adds to the database of a service.
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"outbox"
+)
+
+var ErrNilEvent = errors.New("outbox event is nil")
+
+// Store is the outbox table of a service database. Services embed it in their
+// repository, store events with CreateOutboxEvent in the transaction of the
+// change that caused them and hand the repository to outbox.NewRelay.
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func New(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+// BeginTx starts the transaction the outbox relay publishes a batch in.
+func (s *Store) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return s.db.Begin(ctx)
+}
+
+// CreateOutboxEvent stores the event in tx, next to the change it describes.
+func (s *Store) CreateOutboxEvent(ctx context.Context, tx pgx.Tx, e *outbox.Event) error {
+	if e == nil {
+		return ErrNilEvent
+	}
+
+	return tx.QueryRow(
+		ctx, createEvent,
+		e.AggregateType,
+		e.AggregateID,
+		e.EventType,
+		e.Payload,
+	).Scan(&e.ID, &e.AvailableAt, &e.CreatedAt)
+}
+
+// GetPendingOutboxEvents locks up to limit events due for publishing until tx ends.
+func (s *Store) GetPendingOutboxEvents(ctx context.Context, tx pgx.Tx, limit int) ([]*outbox.Event, error) {
+	rows, err := tx.Query(ctx, selectPendingEvents, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*outbox.Event
+	for rows.Next() {
+		var e outbox.Event
+		if err = rows.Scan(
+			&e.ID,
+			&e.AggregateType,
+			&e.AggregateID,
+			&e.EventType,
+			&e.Payload,
+			&e.Attempts,
+			&e.LastError,
+			&e.AvailableAt,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, &e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (s *Store) MarkOutboxEventPublished(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	_, err := tx.Exec(ctx, markEventPublished, id)
+	return err
+}
+
+// MarkOutboxEventFailed records a failed publish and retries the event at retryAt.
+func (s *Store) MarkOutboxEventFailed(
+	ctx context.Context,
+	tx pgx.Tx,
+	id uuid.UUID,
+	lastErr string,
+	retryAt time.Time,
+) error {
+	_, err := tx.Exec(ctx, markEventFailed, id, lastErr, retryAt)
+	return err
+}
+
+func (s *Store) MarkOutboxEventDeadLettered(ctx context.Context, tx pgx.Tx, id uuid.UUID, lastErr string) error {
+	_, err := tx.Exec(ctx, markEventDeadLettered, id, lastErr)
+	return err
+}
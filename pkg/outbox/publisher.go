@@ -0,0 +1,27 @@
+package outbox
+
+import (
+	"context"
+)
+
+const (
+	HeaderEventID       = "event-id"
+	HeaderEventType     = "event-type"
+	HeaderAggregateType = "aggregate-type"
+	HeaderError         = "error"
+)
+
+// Message is an outbox event on its way to a topic. Value is the protobuf
+// encoding of the event and Key the aggregate it belongs to, which keeps the
+// events of one aggregate on one partition.
+type Message struct {
+	Headers map[string]string
+	Topic   string
+	Key     string
+	Value   []byte
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+	Close() error
+}
@@ -0,0 +1,24 @@
+// Package publisher picks the outbox publisher a service is configured with.
+package publisher
+
+import (
+	"errors"
+	"fmt"
+
+	"outbox"
+	"outbox/kafka"
+	"outbox/memory"
+)
+
+var ErrUnknown = errors.New("unknown outbox publisher")
+
+func New(cfg outbox.Config) (outbox.Publisher, error) {
+	switch cfg.Publisher {
+	case kafka.Name:
+		return kafka.New(cfg.Brokers), nil
+	case memory.Name:
+		return memory.New(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknown, cfg.Publisher)
+	}
+}
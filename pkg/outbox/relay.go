@@ -0,0 +1,138 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Config sets up the relay publishing the outbox of a service under the
+// "outbox" key of its configuration; Publisher is "kafka" or "memory", which
+// keeps the messages in the process. Every service publishes to topics of its
+// own, so they have no default.
+type Config struct {
+	Publisher       string        `yaml:"publisher" env:"OUTBOX_PUBLISHER" env-default:"kafka"`
+	Topic           string        `yaml:"topic" env:"OUTBOX_TOPIC" env-required:"true"`
+	DeadLetterTopic string        `yaml:"dead_letter_topic" env:"OUTBOX_DEAD_LETTER_TOPIC" env-required:"true"`
+	Brokers         []string      `yaml:"brokers" env:"KAFKA_BROKERS" env-separator:","`
+	PollInterval    time.Duration `yaml:"poll_interval" env:"OUTBOX_POLL_INTERVAL" env-default:"1s"`
+	RetryBackoff    time.Duration `yaml:"retry_backoff" env:"OUTBOX_RETRY_BACKOFF" env-default:"5s"`
+	BatchSize       int           `yaml:"batch_size" env:"OUTBOX_BATCH_SIZE" env-default:"100"`
+	MaxAttempts     int32         `yaml:"max_attempts" env:"OUTBOX_MAX_ATTEMPTS" env-default:"10"`
+}
+
+// Repository is the outbox table of a service. GetPendingOutboxEvents returns
+// only the oldest unpublished event of each aggregate, so an event waiting to
+// be retried holds back the newer events of its aggregate and they reach the
+// topic in the order they were stored.
+type Repository interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+	GetPendingOutboxEvents(ctx context.Context, tx pgx.Tx, limit int) ([]*Event, error)
+	MarkOutboxEventPublished(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+	MarkOutboxEventFailed(ctx context.Context, tx pgx.Tx, id uuid.UUID, lastErr string, retryAt time.Time) error
+	MarkOutboxEventDeadLettered(ctx context.Context, tx pgx.Tx, id uuid.UUID, lastErr string) error
+}
+
+// Relay publishes the outbox at least once: an event is marked published only
+// after the publisher accepted it, so consumers must tolerate duplicates.
+type Relay struct {
+	repo      Repository
+	publisher Publisher
+	cfg       Config
+}
+
+func NewRelay(repo Repository, publisher Publisher, cfg Config) *Relay {
+	return &Relay{
+		repo:      repo,
+		publisher: publisher,
+		cfg:       cfg,
+	}
+}
+
+// Run publishes pending events until ctx is cancelled. A batch holds at most
+// one event per aggregate, so the next batch is fetched right away while the
+// last one found anything, and the outbox is polled once it has run dry.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := r.relayBatch(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to relay outbox", "err", err)
+		}
+		if err == nil && n > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	tx, err := r.repo.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	events, err := r.repo.GetPendingOutboxEvents(ctx, tx, r.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		if err = r.relay(ctx, tx, event); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return len(events), nil
+}
+
+// relay publishes one event. A failed event is retried with exponential
+// backoff and moved to the dead-letter topic on its last attempt.
+func (r *Relay) relay(ctx context.Context, tx pgx.Tx, event *Event) error {
+	publishErr := r.publisher.Publish(ctx, newMessage(r.cfg.Topic, event))
+	if publishErr == nil {
+		return r.repo.MarkOutboxEventPublished(ctx, tx, event.ID)
+	}
+
+	slog.WarnContext(ctx, "failed to publish outbox event",
+		"event_id", event.ID, "event_type", event.EventType, "err", publishErr)
+
+	if event.Attempts+1 >= r.cfg.MaxAttempts {
+		dead := newMessage(r.cfg.DeadLetterTopic, event)
+		dead.Headers[HeaderError] = publishErr.Error()
+		if err := r.publisher.Publish(ctx, dead); err == nil {
+			return r.repo.MarkOutboxEventDeadLettered(ctx, tx, event.ID, publishErr.Error())
+		}
+	}
+
+	retryAt := time.Now().Add(Backoff(r.cfg.RetryBackoff, event.Attempts))
+	return r.repo.MarkOutboxEventFailed(ctx, tx, event.ID, publishErr.Error(), retryAt)
+}
+
+func newMessage(topic string, event *Event) Message {
+	return Message{
+		Headers: map[string]string{
+			HeaderEventID:       event.ID.String(),
+			HeaderEventType:     event.EventType,
+			HeaderAggregateType: event.AggregateType,
+		},
+		Topic: topic,
+		Key:   event.AggregateID,
+		Value: event.Payload,
+	}
+}
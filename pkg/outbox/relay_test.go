@@ -0,0 +1,194 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var errBrokerDown = errors.New("broker down")
+
+type fakeTx struct {
+	pgx.Tx
+}
+
+func (fakeTx) Commit(context.Context) error   { return nil }
+func (fakeTx) Rollback(context.Context) error { return nil }
+
+type row struct {
+	event        *Event
+	published    bool
+	deadLettered bool
+}
+
+// tableRepo keeps the outbox in memory in insertion order and hands out
+// pending events the way the postgres query does: only the oldest unpublished
+// event of each aggregate, and only once it is due.
+type tableRepo struct {
+	rows []*row
+}
+
+func (r *tableRepo) add(aggregateID, eventType string) *Event {
+	e := &Event{ID: uuid.New(), AggregateType: "booking", AggregateID: aggregateID, EventType: eventType}
+	r.rows = append(r.rows, &row{event: e})
+
+	return e
+}
+
+func (r *tableRepo) find(id uuid.UUID) *row {
+	for _, row := range r.rows {
+		if row.event.ID == id {
+			return row
+		}
+	}
+
+	return nil
+}
+
+func (r *tableRepo) BeginTx(context.Context) (pgx.Tx, error) {
+	return fakeTx{}, nil
+}
+
+func (r *tableRepo) GetPendingOutboxEvents(_ context.Context, _ pgx.Tx, limit int) ([]*Event, error) {
+	var events []*Event
+	held := make(map[string]bool)
+	for _, row := range r.rows {
+		if row.published || row.deadLettered {
+			continue
+		}
+		key := row.event.AggregateType + "/" + row.event.AggregateID
+		if !held[key] && !row.event.AvailableAt.After(time.Now()) && len(events) < limit {
+			events = append(events, row.event)
+		}
+		held[key] = true
+	}
+
+	return events, nil
+}
+
+func (r *tableRepo) MarkOutboxEventPublished(_ context.Context, _ pgx.Tx, id uuid.UUID) error {
+	r.find(id).published = true
+
+	return nil
+}
+
+func (r *tableRepo) MarkOutboxEventFailed(
+	_ context.Context, _ pgx.Tx, id uuid.UUID, lastErr string, retryAt time.Time,
+) error {
+	e := r.find(id).event
+	e.Attempts++
+	e.LastError = &lastErr
+	e.AvailableAt = retryAt
+
+	return nil
+}
+
+func (r *tableRepo) MarkOutboxEventDeadLettered(_ context.Context, _ pgx.Tx, id uuid.UUID, lastErr string) error {
+	row := r.find(id)
+	row.event.Attempts++
+	row.event.LastError = &lastErr
+	row.deadLettered = true
+
+	return nil
+}
+
+// flakyPublisher rejects the events in failing and records the event ids it
+// accepted per topic.
+type flakyPublisher struct {
+	failing   map[string]bool
+	published map[string][]string
+}
+
+func (p *flakyPublisher) Publish(_ context.Context, msg Message) error {
+	if p.failing[msg.Headers[HeaderEventID]] && msg.Topic != "events.dlq" {
+		return errBrokerDown
+	}
+	p.published[msg.Topic] = append(p.published[msg.Topic], msg.Headers[HeaderEventType])
+
+	return nil
+}
+
+func (p *flakyPublisher) Close() error {
+	return nil
+}
+
+func TestRelayKeepsAggregateOrder(t *testing.T) {
+	repo := &tableRepo{}
+	created := repo.add("a", "a.created")
+	repo.add("a", "a.confirmed")
+	repo.add("b", "b.created")
+
+	publisher := &flakyPublisher{
+		failing:   map[string]bool{created.ID.String(): true},
+		published: make(map[string][]string),
+	}
+	relay := NewRelay(repo, publisher, Config{
+		Topic:           "events",
+		DeadLetterTopic: "events.dlq",
+		RetryBackoff:    time.Minute,
+		BatchSize:       10,
+		MaxAttempts:     3,
+	})
+	drain := func() {
+		for {
+			n, err := relay.relayBatch(context.Background())
+			if err != nil {
+				t.Fatalf("relayBatch() error = %v", err)
+			}
+			if n == 0 {
+				return
+			}
+		}
+	}
+
+	drain()
+	if got, want := publisher.published["events"], []string{"b.created"}; !slices.Equal(got, want) {
+		t.Fatalf("published while a.created waits = %v, want %v", got, want)
+	}
+
+	// The broker recovers and the retry comes due.
+	delete(publisher.failing, created.ID.String())
+	created.AvailableAt = time.Now()
+	drain()
+	if got, want := publisher.published["events"], []string{"b.created", "a.created", "a.confirmed"}; !slices.Equal(got, want) {
+		t.Errorf("published = %v, want %v", got, want)
+	}
+}
+
+func TestRelayDeadLettersLastAttempt(t *testing.T) {
+	repo := &tableRepo{}
+	created := repo.add("a", "a.created")
+	created.Attempts = 2
+	repo.add("a", "a.confirmed")
+
+	publisher := &flakyPublisher{
+		failing:   map[string]bool{created.ID.String(): true},
+		published: make(map[string][]string),
+	}
+	relay := NewRelay(repo, publisher, Config{
+		Topic:           "events",
+		DeadLetterTopic: "events.dlq",
+		RetryBackoff:    time.Minute,
+		BatchSize:       10,
+		MaxAttempts:     3,
+	})
+
+	for range 2 {
+		if _, err := relay.relayBatch(context.Background()); err != nil {
+			t.Fatalf("relayBatch() error = %v", err)
+		}
+	}
+
+	if got, want := publisher.published["events.dlq"], []string{"a.created"}; !slices.Equal(got, want) {
+		t.Errorf("dead-lettered = %v, want %v", got, want)
+	}
+	// A dead-lettered event no longer holds its aggregate back.
+	if got, want := publisher.published["events"], []string{"a.confirmed"}; !slices.Equal(got, want) {
+		t.Errorf("published = %v, want %v", got, want)
+	}
+}
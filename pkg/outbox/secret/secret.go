@@ -0,0 +1,79 @@
+// Package secret seals the fields of an event that must not be readable from
+// the outbox table, the topics or the dead-letter topics, such as a password
+// reset token. The producing and the consuming service share the key.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+)
+
+// KeySize is the length of a key; fields are sealed with AES-256-GCM.
+const KeySize = 32
+
+var (
+	ErrInvalidKey = errors.New("secret key must be 32 base64-encoded bytes")
+	ErrMalformed  = errors.New("sealed value is malformed or was sealed with another key")
+)
+
+// ParseKey decodes a key kept base64-encoded in the configuration.
+func ParseKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	return key, nil
+}
+
+// Seal encrypts plaintext with key; the random nonce is prepended to the
+// result.
+func Seal(key, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Open decrypts a value sealed by Seal with the same key.
+func Open(key, sealed []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrMalformed
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,67 @@
+package secret
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestSealOpen(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, KeySize)
+	otherKey := bytes.Repeat([]byte{2}, KeySize)
+
+	sealed, err := Seal(key, []byte("reset-token"))
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+	if bytes.Contains(sealed, []byte("reset-token")) {
+		t.Fatalf("sealed value %q contains the plaintext", sealed)
+	}
+
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name    string
+		key     []byte
+		sealed  []byte
+		want    string
+		wantErr error
+	}{
+		{name: "same key", key: key, sealed: sealed, want: "reset-token"},
+		{name: "other key", key: otherKey, sealed: sealed, wantErr: ErrMalformed},
+		{name: "tampered", key: key, sealed: tampered, wantErr: ErrMalformed},
+		{name: "too short", key: key, sealed: sealed[:4], wantErr: ErrMalformed},
+		{name: "short key", key: key[:16], sealed: sealed, wantErr: ErrInvalidKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Open(tt.key, tt.sealed)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Open() error = %v, want %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Open() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		in      string
+		wantErr error
+	}{
+		{in: "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE="},
+		{in: "AQEBAQEBAQEBAQEBAQEBAQ==", wantErr: ErrInvalidKey},
+		{in: "not base64", wantErr: ErrInvalidKey},
+		{in: "", wantErr: ErrInvalidKey},
+	}
+
+	for _, tt := range tests {
+		if _, err := ParseKey(tt.in); !errors.Is(err, tt.wantErr) {
+			t.Errorf("ParseKey(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: user/v1/rpc/token/reset_password.proto
+
+package userv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetToken    string                 `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_user_v1_rpc_token_reset_password_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_rpc_token_reset_password_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_rpc_token_reset_password_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResetPasswordRequest) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_user_v1_rpc_token_reset_password_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_rpc_token_reset_password_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_rpc_token_reset_password_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResetPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_user_v1_rpc_token_reset_password_proto protoreflect.FileDescriptor
+
+const file_user_v1_rpc_token_reset_password_proto_rawDesc = "" +
+	"\n" +
+	"&user/v1/rpc/token/reset_password.proto\x12\auser.v1\x1a\x1bbuf/validate/validate.proto\"o\n" +
+	"\x14ResetPasswordRequest\x12-\n" +
+	"\vreset_token\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x18dR\n" +
+	"resetToken\x12(\n" +
+	"\bpassword\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\b\x18dR\bpassword\"1\n" +
+	"\x15ResetPasswordResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessageB\x14Z\x12api/user/v1;userv1b\x06proto3"
+
+var (
+	file_user_v1_rpc_token_reset_password_proto_rawDescOnce sync.Once
+	file_user_v1_rpc_token_reset_password_proto_rawDescData []byte
+)
+
+func file_user_v1_rpc_token_reset_password_proto_rawDescGZIP() []byte {
+	file_user_v1_rpc_token_reset_password_proto_rawDescOnce.Do(func() {
+		file_user_v1_rpc_token_reset_password_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_v1_rpc_token_reset_password_proto_rawDesc), len(file_user_v1_rpc_token_reset_password_proto_rawDesc)))
+	})
+	return file_user_v1_rpc_token_reset_password_proto_rawDescData
+}
+
+var file_user_v1_rpc_token_reset_password_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_user_v1_rpc_token_reset_password_proto_goTypes = []any{
+	(*ResetPasswordRequest)(nil),  // 0: user.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil), // 1: user.v1.ResetPasswordResponse
+}
+var file_user_v1_rpc_token_reset_password_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_user_v1_rpc_token_reset_password_proto_init() }
+func file_user_v1_rpc_token_reset_password_proto_init() {
+	if File_user_v1_rpc_token_reset_password_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_rpc_token_reset_password_proto_rawDesc), len(file_user_v1_rpc_token_reset_password_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_user_v1_rpc_token_reset_password_proto_goTypes,
+		DependencyIndexes: file_user_v1_rpc_token_reset_password_proto_depIdxs,
+		MessageInfos:      file_user_v1_rpc_token_reset_password_proto_msgTypes,
+	}.Build()
+	File_user_v1_rpc_token_reset_password_proto = out.File
+	file_user_v1_rpc_token_reset_password_proto_goTypes = nil
+	file_user_v1_rpc_token_reset_password_proto_depIdxs = nil
+}
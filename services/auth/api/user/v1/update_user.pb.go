@@ -23,12 +23,16 @@ const (
 )
 
 type UpdateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email    string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// locale and telegram_chat_id are where the user gets notifications; an
+	// unset field is left as it is and an empty one clears it.
+	Locale         *string `protobuf:"bytes,4,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	TelegramChatId *string `protobuf:"bytes,5,opt,name=telegram_chat_id,json=telegramChatId,proto3,oneof" json:"telegram_chat_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateUserRequest) Reset() {
@@ -82,6 +86,20 @@ func (x *UpdateUserRequest) GetUsername() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetTelegramChatId() string {
+	if x != nil && x.TelegramChatId != nil {
+		return *x.TelegramChatId
+	}
+	return ""
+}
+
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *UpdateUser            `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -130,12 +148,16 @@ var File_user_v1_rpc_user_update_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_rpc_user_update_user_proto_rawDesc = "" +
 	"\n" +
-	"\"user/v1/rpc/user/update_user.proto\x12\auser.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19user/v1/models/user.proto\"\x7f\n" +
+	"\"user/v1/rpc/user/update_user.proto\x12\auser.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19user/v1/models/user.proto\"\x8a\x02\n" +
 	"\x11UpdateUserRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\x03B\n" +
 	"\xbaH\a\xc8\x01\x01\"\x02(\x01R\x02id\x12$\n" +
 	"\x05email\x18\x02 \x01(\tB\x0e\xbaH\v\xc8\x01\x01r\x06\x10\x05\x18d`\x01R\x05email\x12(\n" +
-	"\busername\x18\x03 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x18dR\busername\"=\n" +
+	"\busername\x18\x03 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x18dR\busername\x121\n" +
+	"\x06locale\x18\x04 \x01(\tB\x14\xbaH\x11r\x0f2\r^([a-z]{2})?$H\x00R\x06locale\x88\x01\x01\x126\n" +
+	"\x10telegram_chat_id\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18@H\x01R\x0etelegramChatId\x88\x01\x01B\t\n" +
+	"\a_localeB\x13\n" +
+	"\x11_telegram_chat_id\"=\n" +
 	"\x12UpdateUserResponse\x12'\n" +
 	"\x04user\x18\x01 \x01(\v2\x13.user.v1.UpdateUserR\x04userB\x14Z\x12api/user/v1;userv1b\x06proto3"
 
@@ -172,6 +194,7 @@ func file_user_v1_rpc_user_update_user_proto_init() {
 		return
 	}
 	file_user_v1_models_user_proto_init()
+	file_user_v1_rpc_user_update_user_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: user/v1/events/user_events.proto
+
+package userv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UserRegistered struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username      *string                `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	Role          UserRole               `protobuf:"varint,4,opt,name=role,proto3,enum=user.v1.UserRole" json:"role,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRegistered) Reset() {
+	*x = UserRegistered{}
+	mi := &file_user_v1_events_user_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRegistered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRegistered) ProtoMessage() {}
+
+func (x *UserRegistered) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_events_user_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRegistered.ProtoReflect.Descriptor instead.
+func (*UserRegistered) Descriptor() ([]byte, []int) {
+	return file_user_v1_events_user_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserRegistered) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserRegistered) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserRegistered) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *UserRegistered) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *UserRegistered) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// UserProfileUpdated is published as user.profile_updated with the settings
+// the notification service addresses the user by.
+type UserProfileUpdated struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Locale         *string                `protobuf:"bytes,3,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	TelegramChatId *string                `protobuf:"bytes,4,opt,name=telegram_chat_id,json=telegramChatId,proto3,oneof" json:"telegram_chat_id,omitempty"`
+	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserProfileUpdated) Reset() {
+	*x = UserProfileUpdated{}
+	mi := &file_user_v1_events_user_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfileUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfileUpdated) ProtoMessage() {}
+
+func (x *UserProfileUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_events_user_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfileUpdated.ProtoReflect.Descriptor instead.
+func (*UserProfileUpdated) Descriptor() ([]byte, []int) {
+	return file_user_v1_events_user_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserProfileUpdated) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserProfileUpdated) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserProfileUpdated) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *UserProfileUpdated) GetTelegramChatId() string {
+	if x != nil && x.TelegramChatId != nil {
+		return *x.TelegramChatId
+	}
+	return ""
+}
+
+func (x *UserProfileUpdated) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// UserPasswordResetRequested is published as user.password_reset_requested;
+// the notification service opens sealed_reset_token with the key it shares
+// with auth and mails the reset link to the user. The token is never carried
+// in plain text, so the outbox, the topics and the dead-letter topic do not
+// hold a usable token.
+type UserPasswordResetRequested struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email            string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	OccurredAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	SealedResetToken []byte                 `protobuf:"bytes,6,opt,name=sealed_reset_token,json=sealedResetToken,proto3" json:"sealed_reset_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserPasswordResetRequested) Reset() {
+	*x = UserPasswordResetRequested{}
+	mi := &file_user_v1_events_user_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPasswordResetRequested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPasswordResetRequested) ProtoMessage() {}
+
+func (x *UserPasswordResetRequested) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_events_user_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPasswordResetRequested.ProtoReflect.Descriptor instead.
+func (*UserPasswordResetRequested) Descriptor() ([]byte, []int) {
+	return file_user_v1_events_user_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserPasswordResetRequested) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserPasswordResetRequested) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserPasswordResetRequested) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *UserPasswordResetRequested) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *UserPasswordResetRequested) GetSealedResetToken() []byte {
+	if x != nil {
+		return x.SealedResetToken
+	}
+	return nil
+}
+
+var File_user_v1_events_user_events_proto protoreflect.FileDescriptor
+
+const file_user_v1_events_user_events_proto_rawDesc = "" +
+	"\n" +
+	" user/v1/events/user_events.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1duser/v1/enums/user_role.proto\"\xd1\x01\n" +
+	"\x0eUserRegistered\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1f\n" +
+	"\busername\x18\x03 \x01(\tH\x00R\busername\x88\x01\x01\x12%\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x11.user.v1.UserRoleR\x04role\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\v\n" +
+	"\t_username\"\xec\x01\n" +
+	"\x12UserProfileUpdated\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
+	"\x06locale\x18\x03 \x01(\tH\x00R\x06locale\x88\x01\x01\x12-\n" +
+	"\x10telegram_chat_id\x18\x04 \x01(\tH\x01R\x0etelegramChatId\x88\x01\x01\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\t\n" +
+	"\a_localeB\x13\n" +
+	"\x11_telegram_chat_id\"\x84\x02\n" +
+	"\x1aUserPasswordResetRequested\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12,\n" +
+	"\x12sealed_reset_token\x18\x06 \x01(\fR\x10sealedResetTokenJ\x04\b\x03\x10\x04R\vreset_tokenB\x14Z\x12api/user/v1;userv1b\x06proto3"
+
+var (
+	file_user_v1_events_user_events_proto_rawDescOnce sync.Once
+	file_user_v1_events_user_events_proto_rawDescData []byte
+)
+
+func file_user_v1_events_user_events_proto_rawDescGZIP() []byte {
+	file_user_v1_events_user_events_proto_rawDescOnce.Do(func() {
+		file_user_v1_events_user_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_v1_events_user_events_proto_rawDesc), len(file_user_v1_events_user_events_proto_rawDesc)))
+	})
+	return file_user_v1_events_user_events_proto_rawDescData
+}
+
+var file_user_v1_events_user_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_user_v1_events_user_events_proto_goTypes = []any{
+	(*UserRegistered)(nil),             // 0: user.v1.UserRegistered
+	(*UserProfileUpdated)(nil),         // 1: user.v1.UserProfileUpdated
+	(*UserPasswordResetRequested)(nil), // 2: user.v1.UserPasswordResetRequested
+	(UserRole)(0),                      // 3: user.v1.UserRole
+	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+}
+var file_user_v1_events_user_events_proto_depIdxs = []int32{
+	3, // 0: user.v1.UserRegistered.role:type_name -> user.v1.UserRole
+	4, // 1: user.v1.UserRegistered.occurred_at:type_name -> google.protobuf.Timestamp
+	4, // 2: user.v1.UserProfileUpdated.occurred_at:type_name -> google.protobuf.Timestamp
+	4, // 3: user.v1.UserPasswordResetRequested.expires_at:type_name -> google.protobuf.Timestamp
+	4, // 4: user.v1.UserPasswordResetRequested.occurred_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_user_v1_events_user_events_proto_init() }
+func file_user_v1_events_user_events_proto_init() {
+	if File_user_v1_events_user_events_proto != nil {
+		return
+	}
+	file_user_v1_enums_user_role_proto_init()
+	file_user_v1_events_user_events_proto_msgTypes[0].OneofWrappers = []any{}
+	file_user_v1_events_user_events_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_events_user_events_proto_rawDesc), len(file_user_v1_events_user_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_user_v1_events_user_events_proto_goTypes,
+		DependencyIndexes: file_user_v1_events_user_events_proto_depIdxs,
+		MessageInfos:      file_user_v1_events_user_events_proto_msgTypes,
+	}.Build()
+	File_user_v1_events_user_events_proto = out.File
+	file_user_v1_events_user_events_proto_goTypes = nil
+	file_user_v1_events_user_events_proto_depIdxs = nil
+}
@@ -24,7 +24,7 @@ var File_user_v1_user_service_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1auser/v1/user_service.proto\x12\auser.v1\x1a\"user/v1/rpc/user/create_user.proto\x1a user/v1/rpc/user/get_users.proto\x1a\x1fuser/v1/rpc/user/get_user.proto\x1a\"user/v1/rpc/user/update_user.proto\x1a+user/v1/rpc/user/update_user_activity.proto\x1a'user/v1/rpc/user/update_user_role.proto\x1a\"user/v1/rpc/user/delete_user.proto\x1a%user/v1/rpc/token/register_user.proto\x1a\"user/v1/rpc/token/login_user.proto\x1a%user/v1/rpc/token/refresh_token.proto2\x93\x04\n" +
+	"\x1auser/v1/user_service.proto\x12\auser.v1\x1a\"user/v1/rpc/user/create_user.proto\x1a user/v1/rpc/user/get_users.proto\x1a\x1fuser/v1/rpc/user/get_user.proto\x1a\"user/v1/rpc/user/update_user.proto\x1a+user/v1/rpc/user/update_user_activity.proto\x1a'user/v1/rpc/user/update_user_role.proto\x1a\"user/v1/rpc/user/delete_user.proto\x1a%user/v1/rpc/token/register_user.proto\x1a\"user/v1/rpc/token/login_user.proto\x1a%user/v1/rpc/token/refresh_token.proto\x1a.user/v1/rpc/token/request_password_reset.proto\x1a&user/v1/rpc/token/reset_password.proto2\x93\x04\n" +
 	"\vUserService\x12E\n" +
 	"\n" +
 	"CreateUser\x12\x1a.user.v1.CreateUserRequest\x1a\x1b.user.v1.CreateUserResponse\x12?\n" +
@@ -35,33 +35,39 @@ const file_user_v1_user_service_proto_rawDesc = "" +
 	"\x12UpdateUserActivity\x12\".user.v1.UpdateUserActivityRequest\x1a#.user.v1.UpdateUserActivityResponse\x12Q\n" +
 	"\x0eUpdateUserRole\x12\x1e.user.v1.UpdateUserRoleRequest\x1a\x1f.user.v1.UpdateUserRoleResponse\x12E\n" +
 	"\n" +
-	"DeleteUser\x12\x1a.user.v1.DeleteUserRequest\x1a\x1b.user.v1.DeleteUserResponse2\xec\x01\n" +
+	"DeleteUser\x12\x1a.user.v1.DeleteUserRequest\x1a\x1b.user.v1.DeleteUserResponse2\xa1\x03\n" +
 	"\fTokenService\x12K\n" +
 	"\fRegisterUser\x12\x1c.user.v1.RegisterUserRequest\x1a\x1d.user.v1.RegisterUserResponse\x12B\n" +
 	"\tLoginUser\x12\x19.user.v1.LoginUserRequest\x1a\x1a.user.v1.LoginUserResponse\x12K\n" +
-	"\fRefreshToken\x12\x1c.user.v1.RefreshTokenRequest\x1a\x1d.user.v1.RefreshTokenResponseB\x14Z\x12api/user/v1;userv1b\x06proto3"
+	"\fRefreshToken\x12\x1c.user.v1.RefreshTokenRequest\x1a\x1d.user.v1.RefreshTokenResponse\x12c\n" +
+	"\x14RequestPasswordReset\x12$.user.v1.RequestPasswordResetRequest\x1a%.user.v1.RequestPasswordResetResponse\x12N\n" +
+	"\rResetPassword\x12\x1d.user.v1.ResetPasswordRequest\x1a\x1e.user.v1.ResetPasswordResponseB\x14Z\x12api/user/v1;userv1b\x06proto3"
 
 var file_user_v1_user_service_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),          // 0: user.v1.CreateUserRequest
-	(*GetUsersRequest)(nil),            // 1: user.v1.GetUsersRequest
-	(*GetUserRequest)(nil),             // 2: user.v1.GetUserRequest
-	(*UpdateUserRequest)(nil),          // 3: user.v1.UpdateUserRequest
-	(*UpdateUserActivityRequest)(nil),  // 4: user.v1.UpdateUserActivityRequest
-	(*UpdateUserRoleRequest)(nil),      // 5: user.v1.UpdateUserRoleRequest
-	(*DeleteUserRequest)(nil),          // 6: user.v1.DeleteUserRequest
-	(*RegisterUserRequest)(nil),        // 7: user.v1.RegisterUserRequest
-	(*LoginUserRequest)(nil),           // 8: user.v1.LoginUserRequest
-	(*RefreshTokenRequest)(nil),        // 9: user.v1.RefreshTokenRequest
-	(*CreateUserResponse)(nil),         // 10: user.v1.CreateUserResponse
-	(*GetUsersResponse)(nil),           // 11: user.v1.GetUsersResponse
-	(*GetUserResponse)(nil),            // 12: user.v1.GetUserResponse
-	(*UpdateUserResponse)(nil),         // 13: user.v1.UpdateUserResponse
-	(*UpdateUserActivityResponse)(nil), // 14: user.v1.UpdateUserActivityResponse
-	(*UpdateUserRoleResponse)(nil),     // 15: user.v1.UpdateUserRoleResponse
-	(*DeleteUserResponse)(nil),         // 16: user.v1.DeleteUserResponse
-	(*RegisterUserResponse)(nil),       // 17: user.v1.RegisterUserResponse
-	(*LoginUserResponse)(nil),          // 18: user.v1.LoginUserResponse
-	(*RefreshTokenResponse)(nil),       // 19: user.v1.RefreshTokenResponse
+	(*CreateUserRequest)(nil),            // 0: user.v1.CreateUserRequest
+	(*GetUsersRequest)(nil),              // 1: user.v1.GetUsersRequest
+	(*GetUserRequest)(nil),               // 2: user.v1.GetUserRequest
+	(*UpdateUserRequest)(nil),            // 3: user.v1.UpdateUserRequest
+	(*UpdateUserActivityRequest)(nil),    // 4: user.v1.UpdateUserActivityRequest
+	(*UpdateUserRoleRequest)(nil),        // 5: user.v1.UpdateUserRoleRequest
+	(*DeleteUserRequest)(nil),            // 6: user.v1.DeleteUserRequest
+	(*RegisterUserRequest)(nil),          // 7: user.v1.RegisterUserRequest
+	(*LoginUserRequest)(nil),             // 8: user.v1.LoginUserRequest
+	(*RefreshTokenRequest)(nil),          // 9: user.v1.RefreshTokenRequest
+	(*RequestPasswordResetRequest)(nil),  // 10: user.v1.RequestPasswordResetRequest
+	(*ResetPasswordRequest)(nil),         // 11: user.v1.ResetPasswordRequest
+	(*CreateUserResponse)(nil),           // 12: user.v1.CreateUserResponse
+	(*GetUsersResponse)(nil),             // 13: user.v1.GetUsersResponse
+	(*GetUserResponse)(nil),              // 14: user.v1.GetUserResponse
+	(*UpdateUserResponse)(nil),           // 15: user.v1.UpdateUserResponse
+	(*UpdateUserActivityResponse)(nil),   // 16: user.v1.UpdateUserActivityResponse
+	(*UpdateUserRoleResponse)(nil),       // 17: user.v1.UpdateUserRoleResponse
+	(*DeleteUserResponse)(nil),           // 18: user.v1.DeleteUserResponse
+	(*RegisterUserResponse)(nil),         // 19: user.v1.RegisterUserResponse
+	(*LoginUserResponse)(nil),            // 20: user.v1.LoginUserResponse
+	(*RefreshTokenResponse)(nil),         // 21: user.v1.RefreshTokenResponse
+	(*RequestPasswordResetResponse)(nil), // 22: user.v1.RequestPasswordResetResponse
+	(*ResetPasswordResponse)(nil),        // 23: user.v1.ResetPasswordResponse
 }
 var file_user_v1_user_service_proto_depIdxs = []int32{
 	0,  // 0: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
@@ -74,18 +80,22 @@ var file_user_v1_user_service_proto_depIdxs = []int32{
 	7,  // 7: user.v1.TokenService.RegisterUser:input_type -> user.v1.RegisterUserRequest
 	8,  // 8: user.v1.TokenService.LoginUser:input_type -> user.v1.LoginUserRequest
 	9,  // 9: user.v1.TokenService.RefreshToken:input_type -> user.v1.RefreshTokenRequest
-	10, // 10: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
-	11, // 11: user.v1.UserService.GetUsers:output_type -> user.v1.GetUsersResponse
-	12, // 12: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	13, // 13: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
-	14, // 14: user.v1.UserService.UpdateUserActivity:output_type -> user.v1.UpdateUserActivityResponse
-	15, // 15: user.v1.UserService.UpdateUserRole:output_type -> user.v1.UpdateUserRoleResponse
-	16, // 16: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
-	17, // 17: user.v1.TokenService.RegisterUser:output_type -> user.v1.RegisterUserResponse
-	18, // 18: user.v1.TokenService.LoginUser:output_type -> user.v1.LoginUserResponse
-	19, // 19: user.v1.TokenService.RefreshToken:output_type -> user.v1.RefreshTokenResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	10, // 10: user.v1.TokenService.RequestPasswordReset:input_type -> user.v1.RequestPasswordResetRequest
+	11, // 11: user.v1.TokenService.ResetPassword:input_type -> user.v1.ResetPasswordRequest
+	12, // 12: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
+	13, // 13: user.v1.UserService.GetUsers:output_type -> user.v1.GetUsersResponse
+	14, // 14: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	15, // 15: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
+	16, // 16: user.v1.UserService.UpdateUserActivity:output_type -> user.v1.UpdateUserActivityResponse
+	17, // 17: user.v1.UserService.UpdateUserRole:output_type -> user.v1.UpdateUserRoleResponse
+	18, // 18: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
+	19, // 19: user.v1.TokenService.RegisterUser:output_type -> user.v1.RegisterUserResponse
+	20, // 20: user.v1.TokenService.LoginUser:output_type -> user.v1.LoginUserResponse
+	21, // 21: user.v1.TokenService.RefreshToken:output_type -> user.v1.RefreshTokenResponse
+	22, // 22: user.v1.TokenService.RequestPasswordReset:output_type -> user.v1.RequestPasswordResetResponse
+	23, // 23: user.v1.TokenService.ResetPassword:output_type -> user.v1.ResetPasswordResponse
+	12, // [12:24] is the sub-list for method output_type
+	0,  // [0:12] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -106,6 +116,8 @@ func file_user_v1_user_service_proto_init() {
 	file_user_v1_rpc_token_register_user_proto_init()
 	file_user_v1_rpc_token_login_user_proto_init()
 	file_user_v1_rpc_token_refresh_token_proto_init()
+	file_user_v1_rpc_token_request_password_reset_proto_init()
+	file_user_v1_rpc_token_reset_password_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
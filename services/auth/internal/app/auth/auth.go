@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"net"
@@ -18,6 +19,9 @@ import (
 	"auth/internal/repository/postgres"
 	"auth/internal/service"
 	"auth/pkg/lib/utils/jwt"
+	"outbox"
+	"outbox/publisher"
+	"outbox/secret"
 )
 
 type App struct {
@@ -31,7 +35,11 @@ func (app *App) MustLoadGRPC() {
 	repo := postgres.New(app.Config)
 
 	tokenCredentials := jwt.GetTokenCredentials(app.Config)
-	svc := service.New(repo, tokenCredentials)
+	resetKey, err := secret.ParseKey(app.Config.PasswordReset.EventKey)
+	if err != nil {
+		panic(err.Error())
+	}
+	svc := service.New(repo, tokenCredentials, app.Config.PasswordReset.TokenTTL, resetKey)
 
 	validator, err := protovalidate.New()
 	if err != nil {
@@ -39,6 +47,16 @@ func (app *App) MustLoadGRPC() {
 	}
 	h := handler.New(svc, validator)
 
+	pub, err := publisher.New(app.Config.Outbox)
+	if err != nil {
+		panic(err.Error())
+	}
+	defer func() { _ = pub.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go outbox.NewRelay(repo, pub, app.Config.Outbox).Run(ctx)
+
 	addr := fmt.Sprintf("%s:%d", app.Config.Server.Host, app.Config.Server.Port)
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
+
+	"outbox"
 )
 
 type ServerConfig struct {
@@ -27,12 +29,22 @@ type JWTConfig struct {
 	RefreshTTL    time.Duration `yaml:"refresh_ttl"     env:"REFRESH_TTL"    env-default:"7d"`
 }
 
+// PasswordResetConfig sets how long a password reset token can be used and
+// the base64 key the token is sealed with in user.password_reset_requested;
+// the notification service is configured with the same key.
+type PasswordResetConfig struct {
+	TokenTTL time.Duration `yaml:"token_ttl"  env:"PASSWORD_RESET_TOKEN_TTL"  env-default:"1h"`
+	EventKey string        `yaml:"event_key"  env:"PASSWORD_RESET_EVENT_KEY"  env-required:"true"`
+}
+
 type Config struct {
-	Env      string         `yaml:"env"         env:"ENV"                env-required:"true"`
-	LogLevel string         `yaml:"log_level"   env:"LOG_LEVEL"          env-required:"true"`
-	Server   ServerConfig   `yaml:"server"      env-prefix:"SERVER_"`
-	Postgres PostgresConfig `yaml:"postgres"    env-prefix:"POSTGRES_"`
-	JWT      JWTConfig      `yaml:"jwt"         env-prefix:"JWT_"`
+	Env           string              `yaml:"env"         env:"ENV"                env-required:"true"`
+	LogLevel      string              `yaml:"log_level"   env:"LOG_LEVEL"          env-required:"true"`
+	Server        ServerConfig        `yaml:"server"      env-prefix:"SERVER_"`
+	Postgres      PostgresConfig      `yaml:"postgres"    env-prefix:"POSTGRES_"`
+	JWT           JWTConfig           `yaml:"jwt"         env-prefix:"JWT_"`
+	Outbox        outbox.Config       `yaml:"outbox"`
+	PasswordReset PasswordResetConfig `yaml:"password_reset"`
 }
 
 func New(configPath string) (*Config, error) {
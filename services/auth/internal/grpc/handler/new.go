@@ -24,6 +24,8 @@ type TokenService interface {
 	RegisterByEmail(ctx context.Context, user *models.CreateUser) (*jwt.Token, error)
 	LoginByEmail(ctx context.Context, user *models.CreateUser) (*jwt.Token, error)
 	RefreshToken(token *jwt.Token) (*jwt.Token, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, password string) error
 }
 
 type Service interface {
@@ -7,6 +7,7 @@ import (
 	userv1 "auth/api/user/v1"
 	"auth/internal/grpc/lib/utils/helper"
 	"auth/internal/grpc/lib/utils/mapper"
+	"auth/pkg/lib/utils/consts"
 )
 
 func (h *Handler) RegisterUser(
@@ -72,3 +73,39 @@ func (h *Handler) RefreshToken(
 		Tokens: mapper.JWTTokenResponseToProto(tokens),
 	}, nil
 }
+
+func (h *Handler) RequestPasswordReset(
+	ctx context.Context,
+	req *userv1.RequestPasswordResetRequest,
+) (*userv1.RequestPasswordResetResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	if err := h.svc.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &userv1.RequestPasswordResetResponse{
+		Message: consts.PasswordResetRequested,
+	}, nil
+}
+
+func (h *Handler) ResetPassword(
+	ctx context.Context,
+	req *userv1.ResetPasswordRequest,
+) (*userv1.ResetPasswordResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	if err := h.svc.ResetPassword(ctx, req.GetResetToken(), req.GetPassword()); err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &userv1.ResetPasswordResponse{
+		Message: consts.PasswordResetSuccess,
+	}, nil
+}
@@ -19,18 +19,22 @@ import (
 type contextKey string
 
 const (
-	ClaimsKey          contextKey = "claims"
-	methodRegisterUser string     = "/user.v1.TokenService/RegisterUser"
-	methodLoginUser    string     = "/user.v1.TokenService/LoginUser"
-	methodRefreshToken string     = "/user.v1.TokenService/RefreshToken"
+	ClaimsKey                  contextKey = "claims"
+	methodRegisterUser         string     = "/user.v1.TokenService/RegisterUser"
+	methodLoginUser            string     = "/user.v1.TokenService/LoginUser"
+	methodRefreshToken         string     = "/user.v1.TokenService/RefreshToken"
+	methodRequestPasswordReset string     = "/user.v1.TokenService/RequestPasswordReset"
+	methodResetPassword        string     = "/user.v1.TokenService/ResetPassword"
 )
 
 func AuthInterceptor(accessSecret string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		publicMethods := map[string]struct{}{
-			methodRegisterUser: {},
-			methodLoginUser:    {},
-			methodRefreshToken: {},
+			methodRegisterUser:         {},
+			methodLoginUser:            {},
+			methodRefreshToken:         {},
+			methodRequestPasswordReset: {},
+			methodResetPassword:        {},
 		}
 
 		if _, ok := publicMethods[info.FullMethod]; ok {
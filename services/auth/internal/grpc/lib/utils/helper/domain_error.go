@@ -25,6 +25,7 @@ var (
 	errInvalidRefreshToken = domainErr{consts.MsgInvalidToken, codes.Unauthenticated}
 	errInvalidUnauthorized = domainErr{consts.MsgUnauthorized, codes.Unauthenticated}
 	errForbidden           = domainErr{consts.MsgForbidden, codes.PermissionDenied}
+	errInvalidResetToken   = domainErr{consts.MsgInvalidResetToken, codes.InvalidArgument}
 	errInternalServer      = domainErr{consts.MsgInternalServer, codes.Internal}
 )
 
@@ -53,6 +54,8 @@ func HandleDomainErr(err error) error {
 		domErr = errInvalidUnauthorized
 	case errors.Is(err, consts.ErrForbidden):
 		domErr = errForbidden
+	case errors.Is(err, consts.ErrInvalidResetToken):
+		domErr = errInvalidResetToken
 
 	default:
 		domErr = errInternalServer
@@ -36,9 +36,11 @@ func CreateUserRequestToDomain(req *userv1.CreateUserRequest) (*models.CreateUse
 
 func UpdateUserRequestToDomain(req *userv1.UpdateUserRequest) *models.UpdateUser {
 	return &models.UpdateUser{
-		ID:       req.Id,
-		Username: req.Username,
-		Email:    req.Email,
+		ID:             req.Id,
+		Username:       req.Username,
+		Email:          req.Email,
+		Locale:         req.Locale,
+		TelegramChatID: req.TelegramChatId,
 	}
 }
 
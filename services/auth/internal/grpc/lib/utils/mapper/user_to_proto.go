@@ -36,20 +36,24 @@ func CreateUserResponseToProto(resp *models.User) *userv1.User {
 
 func UserResponseToProto(resp *models.User) *userv1.User {
 	return &userv1.User{
-		Id:        resp.ID,
-		Username:  resp.Username,
-		Email:     resp.Email,
-		Role:      userRoleToProto(resp.Role),
-		IsActive:  resp.IsActive,
-		CreatedAt: timestamppb.New(resp.CreatedAt),
-		UpdatedAt: timestamppb.New(resp.UpdatedAt),
+		Id:             resp.ID,
+		Username:       resp.Username,
+		Email:          resp.Email,
+		Role:           userRoleToProto(resp.Role),
+		IsActive:       resp.IsActive,
+		Locale:         resp.Locale,
+		TelegramChatId: resp.TelegramChatID,
+		CreatedAt:      timestamppb.New(resp.CreatedAt),
+		UpdatedAt:      timestamppb.New(resp.UpdatedAt),
 	}
 }
 
 func UpdateUserResponseToProto(resp *models.UpdateUser) *userv1.UpdateUser {
 	return &userv1.UpdateUser{
-		Username: resp.Username,
-		Email:    resp.Email,
+		Username:       resp.Username,
+		Email:          resp.Email,
+		Locale:         resp.Locale,
+		TelegramChatId: resp.TelegramChatID,
 	}
 }
 
@@ -0,0 +1,58 @@
+package outbox
+
+import (
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userv1 "auth/api/user/v1"
+	"auth/internal/repository/models"
+	"outbox"
+)
+
+const AggregateUser = "user"
+
+const (
+	EventUserRegistered             = "user.registered"
+	EventUserProfileUpdated         = "user.profile_updated"
+	EventUserPasswordResetRequested = "user.password_reset_requested"
+)
+
+func UserRegistered(u *models.User) (*outbox.Event, error) {
+	return newEvent(u.ID, EventUserRegistered, &userv1.UserRegistered{
+		UserId:     u.ID,
+		Email:      u.Email,
+		Username:   u.Username,
+		Role:       userv1.UserRole(userv1.UserRole_value[string(u.Role)]),
+		OccurredAt: timestamppb.New(u.CreatedAt),
+	})
+}
+
+func UserProfileUpdated(u *models.UpdateUser, updatedAt time.Time) (*outbox.Event, error) {
+	return newEvent(u.ID, EventUserProfileUpdated, &userv1.UserProfileUpdated{
+		UserId:         u.ID,
+		Email:          u.Email,
+		Locale:         u.Locale,
+		TelegramChatId: u.TelegramChatID,
+		OccurredAt:     timestamppb.New(updatedAt),
+	})
+}
+
+// UserPasswordResetRequested carries the reset token sealed with the key auth
+// shares with the notification service, so the outbox and the topics only
+// ever see it encrypted.
+func UserPasswordResetRequested(reset *models.PasswordReset, requestedAt time.Time) (*outbox.Event, error) {
+	return newEvent(reset.UserID, EventUserPasswordResetRequested, &userv1.UserPasswordResetRequested{
+		UserId:           reset.UserID,
+		Email:            reset.Email,
+		SealedResetToken: reset.SealedToken,
+		ExpiresAt:        timestamppb.New(reset.ExpiresAt),
+		OccurredAt:       timestamppb.New(requestedAt),
+	})
+}
+
+func newEvent(userID int64, eventType string, msg proto.Message) (*outbox.Event, error) {
+	return outbox.NewEvent(AggregateUser, strconv.FormatInt(userID, 10), eventType, msg)
+}
@@ -8,20 +8,26 @@ type CreateUser struct {
 	Password string
 }
 
+// UpdateUser replaces the username and email. Locale and TelegramChatID are
+// left as they are when nil and cleared when empty.
 type UpdateUser struct {
-	Username string
-	Email    string
-	ID       int64
+	Locale         *string
+	TelegramChatID *string
+	Username       string
+	Email          string
+	ID             int64
 }
 
 type User struct {
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	Username  *string
-	Email     string
-	Role      UserRole
-	ID        int64
-	IsActive  bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Username       *string
+	Locale         *string
+	TelegramChatID *string
+	Email          string
+	Role           UserRole
+	ID             int64
+	IsActive       bool
 }
 
 type UserShort struct {
@@ -43,6 +49,18 @@ type UpdateUserPassword struct {
 	ID          int64
 }
 
+// PasswordReset is a reset token issued to a user. Only TokenHash is stored;
+// Token is sent to the user once, through the outbox.
+// PasswordReset is a reset token on its way to the user: the database keeps
+// TokenHash and the event SealedToken, so the plain token is stored nowhere.
+type PasswordReset struct {
+	ExpiresAt   time.Time
+	Email       string
+	SealedToken []byte
+	TokenHash   []byte
+	UserID      int64
+}
+
 type UserCredentials struct {
 	Email    string
 	Role     UserRole
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"auth/internal/outbox"
+	"auth/internal/repository/models"
+	"auth/pkg/lib/utils/consts"
+)
+
+// InsertPasswordReset stores the reset token and records
+// user.password_reset_requested in the outbox in the same transaction.
+func (r *Repository) InsertPasswordReset(ctx context.Context, reset *models.PasswordReset) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var createdAt time.Time
+		err := tx.QueryRow(ctx, InsertPasswordReset, reset.TokenHash, reset.UserID, reset.ExpiresAt).Scan(&createdAt)
+		if err != nil {
+			return err
+		}
+
+		event, err := outbox.UserPasswordResetRequested(reset, createdAt)
+		if err != nil {
+			return err
+		}
+		return r.CreateOutboxEvent(ctx, tx, event)
+	})
+}
+
+// ResetPassword spends the reset token with tokenHash, sets the password of its
+// user and spends the other tokens the user still holds.
+func (r *Repository) ResetPassword(ctx context.Context, tokenHash []byte, password string) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var userID int64
+		if err := tx.QueryRow(ctx, UsePasswordReset, tokenHash).Scan(&userID); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, UpdateUserPassword, userID, password); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(ctx, RevokePasswordResets, userID)
+		return err
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return consts.ErrInvalidResetToken
+	}
+
+	return err
+}
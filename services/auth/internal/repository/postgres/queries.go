@@ -7,7 +7,7 @@ const (
     RETURNING id, role, is_active, created_at, updated_at`
 
 	SelectUserByID = `
-    SELECT username, email, role, is_active, created_at, updated_at
+    SELECT username, email, role, is_active, locale, telegram_chat_id, created_at, updated_at
     FROM users 
     WHERE id = $1`
 
@@ -29,7 +29,17 @@ const (
 
 	UpdateUser = `
     UPDATE users
-    SET username = $2, email = $3, updated_at = CURRENT_TIMESTAMP
+    SET username = $2,
+        email = $3,
+        locale = NULLIF(COALESCE($4, locale), ''),
+        telegram_chat_id = NULLIF(COALESCE($5, telegram_chat_id), ''),
+        updated_at = CURRENT_TIMESTAMP
+    WHERE id = $1
+    RETURNING locale, telegram_chat_id, updated_at`
+
+	UpdateUserPassword = `
+    UPDATE users
+    SET password = $2, updated_at = CURRENT_TIMESTAMP
     WHERE id = $1`
 
 	UpdateUserRoleStatus = `
@@ -46,3 +56,28 @@ const (
     DELETE FROM users
     WHERE id = $1`
 )
+
+const (
+	InsertPasswordReset = `
+    INSERT INTO password_reset (token_hash, user_id, expires_at)
+    VALUES ($1, $2, $3)
+    RETURNING created_at`
+
+	// UsePasswordReset spends a token that is neither used nor expired and
+	// returns whose it was.
+	UsePasswordReset = `
+    UPDATE password_reset
+    SET used_at = now()
+    WHERE token_hash = $1
+      AND used_at IS NULL
+      AND expires_at > now()
+    RETURNING user_id`
+
+	// RevokePasswordResets spends the other tokens of the user once the
+	// password has been reset with one of them.
+	RevokePasswordResets = `
+    UPDATE password_reset
+    SET used_at = now()
+    WHERE user_id = $1
+      AND used_at IS NULL`
+)
@@ -3,18 +3,33 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 
+	"auth/internal/outbox"
 	"auth/internal/repository/models"
 	"auth/pkg/lib/utils/consts"
 )
 
+// InsertUser creates the user and records user.registered in the outbox in the
+// same transaction.
 func (r *Repository) InsertUser(ctx context.Context, u *models.CreateUser) (*models.User, error) {
 	newUser := u.ToUserRead()
-	err := r.db.QueryRow(ctx, InsertUser, u.Email, u.Username, u.Password).
-		Scan(&newUser.ID, &newUser.Role, &newUser.IsActive, &newUser.CreatedAt, &newUser.UpdatedAt)
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, InsertUser, u.Email, u.Username, u.Password).
+			Scan(&newUser.ID, &newUser.Role, &newUser.IsActive, &newUser.CreatedAt, &newUser.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		event, err := outbox.UserRegistered(newUser)
+		if err != nil {
+			return err
+		}
+		return r.CreateOutboxEvent(ctx, tx, event)
+	})
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -64,7 +79,7 @@ func (r *Repository) SelectUsers(ctx context.Context, limit, offset uint64) (*mo
 func (r *Repository) SelectUserByID(ctx context.Context, id int64) (*models.User, error) {
 	u := &models.User{ID: id}
 	if err := r.db.QueryRow(ctx, SelectUserByID, id).Scan(
-		&u.Username, &u.Email, &u.Role, &u.IsActive, &u.CreatedAt, &u.UpdatedAt,
+		&u.Username, &u.Email, &u.Role, &u.IsActive, &u.Locale, &u.TelegramChatID, &u.CreatedAt, &u.UpdatedAt,
 	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, consts.ErrUserNotFound
@@ -90,9 +105,28 @@ func (r *Repository) SelectUserCredentialsByEmail(ctx context.Context, email str
 	return u, nil
 }
 
+// UpdateUserByID updates the user, fills u with the notification settings it
+// ended up with and records user.profile_updated in the outbox in the same
+// transaction.
 func (r *Repository) UpdateUserByID(ctx context.Context, u *models.UpdateUser) error {
-	rows, err := r.db.Exec(ctx, UpdateUser, u.ID, u.Username, u.Email)
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var updatedAt time.Time
+		err := tx.QueryRow(ctx, UpdateUser, u.ID, u.Username, u.Email, u.Locale, u.TelegramChatID).
+			Scan(&u.Locale, &u.TelegramChatID, &updatedAt)
+		if err != nil {
+			return err
+		}
+
+		event, err := outbox.UserProfileUpdated(u, updatedAt)
+		if err != nil {
+			return err
+		}
+		return r.CreateOutboxEvent(ctx, tx, event)
+	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return consts.ErrUserNotFound
+		}
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
 			return consts.ErrUniqueUserField
@@ -100,10 +134,6 @@ func (r *Repository) UpdateUserByID(ctx context.Context, u *models.UpdateUser) e
 		return err
 	}
 
-	if rows.RowsAffected() == 0 {
-		return consts.ErrUserNotFound
-	}
-
 	return nil
 }
 
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"auth/internal/repository/models"
 	"auth/internal/repository/postgres"
@@ -19,18 +20,30 @@ type UserRepository interface {
 	DeleteUserByID(ctx context.Context, id int64) error
 }
 
+type PasswordResetRepository interface {
+	InsertPasswordReset(ctx context.Context, reset *models.PasswordReset) error
+	ResetPassword(ctx context.Context, tokenHash []byte, password string) error
+}
+
 type Repository interface {
 	UserRepository
+	PasswordResetRepository
 }
 
 type Service struct {
 	repo       Repository
 	tokenCreds *jwt.TokenCredentials
+	resetTTL   time.Duration
+	resetKey   []byte
 }
 
-func New(repo *postgres.Repository, token *jwt.TokenCredentials) *Service {
+// New sets up the service; reset tokens live for resetTTL and are sealed with
+// resetKey before they leave the service in an event.
+func New(repo *postgres.Repository, token *jwt.TokenCredentials, resetTTL time.Duration, resetKey []byte) *Service {
 	return &Service{
 		repo:       repo,
 		tokenCreds: token,
+		resetTTL:   resetTTL,
+		resetKey:   resetKey,
 	}
 }
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 
 	"auth/internal/grpc/lib/utils/helper"
 	"auth/internal/repository/models"
 	"auth/pkg/lib/utils/consts"
 	"auth/pkg/lib/utils/jwt"
+	"outbox/secret"
 )
 
 func (s *Service) RegisterByEmail(ctx context.Context, user *models.CreateUser) (*jwt.Token, error) {
@@ -52,3 +54,47 @@ func (s *Service) RefreshToken(token *jwt.Token) (*jwt.Token, error) {
 	token.Access = access
 	return token, nil
 }
+
+// RequestPasswordReset issues a reset token to the user with email, which the
+// notification service mails out. An unknown email is not an error, so the
+// caller cannot tell which addresses are registered.
+func (s *Service) RequestPasswordReset(ctx context.Context, email string) error {
+	userCred, err := s.repo.SelectUserCredentialsByEmail(ctx, email)
+	if errors.Is(err, consts.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		slog.Error("failed request password reset", "err:", err.Error())
+		return err
+	}
+
+	token, tokenHash, err := helper.NewResetToken()
+	if err != nil {
+		return err
+	}
+
+	sealedToken, err := secret.Seal(s.resetKey, []byte(token))
+	if err != nil {
+		slog.Error("failed to seal password reset token", "err:", err.Error())
+		return err
+	}
+
+	return s.repo.InsertPasswordReset(ctx, &models.PasswordReset{
+		ExpiresAt:   time.Now().Add(s.resetTTL),
+		Email:       userCred.Email,
+		SealedToken: sealedToken,
+		TokenHash:   tokenHash,
+		UserID:      userCred.ID,
+	})
+}
+
+// ResetPassword sets the password of the user the reset token was issued to;
+// a token works once and only until it expires.
+func (s *Service) ResetPassword(ctx context.Context, token, password string) error {
+	hashPass, err := helper.HashPassword(password)
+	if err != nil {
+		return consts.ErrPasswordHashing
+	}
+
+	return s.repo.ResetPassword(ctx, helper.HashResetToken(token), hashPass)
+}
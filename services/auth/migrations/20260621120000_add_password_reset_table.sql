@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A reset token is only stored hashed. The user.password_reset_requested event
+-- the notification service mails it out from carries it sealed with a key the
+-- two services share, and the outbox drops the payload once it is published.
+CREATE TABLE IF NOT EXISTS password_reset (
+    token_hash BYTEA PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_password_reset_user ON password_reset(user_id)
+    WHERE used_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_password_reset_user;
+
+DROP TABLE IF EXISTS password_reset;
+-- +goose StatementEnd
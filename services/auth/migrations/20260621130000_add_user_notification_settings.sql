@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS locale VARCHAR(10),
+    ADD COLUMN IF NOT EXISTS telegram_chat_id VARCHAR(64);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS telegram_chat_id,
+    DROP COLUMN IF EXISTS locale;
+-- +goose StatementEnd
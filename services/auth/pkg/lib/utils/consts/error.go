@@ -19,6 +19,7 @@ const (
 	MsgInternalServer     = "internal server error"
 	MsgForbidden          = "forbidden"
 	MsgInvalidJSON        = "invalid JSON body"
+	MsgInvalidResetToken  = "invalid or expired password reset token"
 )
 
 var (
@@ -38,4 +39,5 @@ var (
 	ErrInternalServer     = errors.New(MsgInternalServer)
 	ErrForbidden          = errors.New(MsgForbidden)
 	ErrInvalidJSON        = errors.New(MsgInvalidJSON)
+	ErrInvalidResetToken  = errors.New(MsgInvalidResetToken)
 )
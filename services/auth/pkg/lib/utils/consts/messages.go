@@ -3,4 +3,6 @@ package consts
 const (
 	UserRoleUpdateSuccess   = "Success update role status"
 	UserActiveUpdateSuccess = "Success update active status"
+	PasswordResetRequested  = "If the email is registered, a password reset link has been sent"
+	PasswordResetSuccess    = "Success reset password"
 )
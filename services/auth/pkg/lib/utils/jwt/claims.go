@@ -3,17 +3,12 @@ package jwt
 import (
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
-
 	"auth/internal/config"
 	"auth/internal/repository/models"
+	"authn"
 )
 
-type Claims struct {
-	jwt.RegisteredClaims
-	Role models.UserRole
-	Sub  int64
-}
+type Claims = authn.Claims[models.UserRole]
 
 type Token struct {
 	Access  string
@@ -3,23 +3,12 @@ package jwt
 import (
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
-
 	"auth/internal/repository/models"
+	"authn"
 )
 
 func GenerateToken(sub int64, role models.UserRole, ttl time.Duration, secret []byte) (string, error) {
-	claims := Claims{
-		Sub:  sub,
-		Role: role,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(secret)
+	return authn.SignToken(sub, role, ttl, secret)
 }
 
 func GenerateAccessToken(sub int64, role models.UserRole, t *TokenCredentials) (string, error) {
@@ -1,25 +1,17 @@
 package jwt
 
 import (
-	"github.com/golang-jwt/jwt/v5"
-
+	"auth/internal/repository/models"
 	"auth/pkg/lib/utils/consts"
+	"authn"
 )
 
 func ParseBearerToken(tokenStr string, secret string) (*Claims, error) {
-	tokenFunc := func(t *jwt.Token) (any, error) {
-		return []byte(secret), nil
-	}
-	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, tokenFunc)
+	claims, err := authn.ParseBearerToken[models.UserRole](tokenStr, secret)
 	if err != nil {
 		return nil, consts.ErrInvalidToken
 	}
 
-	claims, ok := token.Claims.(*Claims)
-	if !ok || !token.Valid {
-		return nil, jwt.ErrTokenInvalidClaims
-	}
-
 	return claims, nil
 }
 
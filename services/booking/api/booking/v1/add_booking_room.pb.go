@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/add_booking_room.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddBookingRoomRequest struct {
+	state               protoimpl.MessageState    `protogen:"open.v1"`
+	BookingId           string                    `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Room                *CreateBookingRoomRequest `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	ExpectedTotalAmount *string                   `protobuf:"bytes,3,opt,name=expected_total_amount,json=expectedTotalAmount,proto3,oneof" json:"expected_total_amount,omitempty"`
+	ExpectedVersion     *int64                    `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AddBookingRoomRequest) Reset() {
+	*x = AddBookingRoomRequest{}
+	mi := &file_booking_v1_rpc_add_booking_room_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookingRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookingRoomRequest) ProtoMessage() {}
+
+func (x *AddBookingRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_add_booking_room_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookingRoomRequest.ProtoReflect.Descriptor instead.
+func (*AddBookingRoomRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_add_booking_room_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddBookingRoomRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *AddBookingRoomRequest) GetRoom() *CreateBookingRoomRequest {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *AddBookingRoomRequest) GetExpectedTotalAmount() string {
+	if x != nil && x.ExpectedTotalAmount != nil {
+		return *x.ExpectedTotalAmount
+	}
+	return ""
+}
+
+func (x *AddBookingRoomRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type AddBookingRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBookingRoomResponse) Reset() {
+	*x = AddBookingRoomResponse{}
+	mi := &file_booking_v1_rpc_add_booking_room_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookingRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookingRoomResponse) ProtoMessage() {}
+
+func (x *AddBookingRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_add_booking_room_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookingRoomResponse.ProtoReflect.Descriptor instead.
+func (*AddBookingRoomResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_add_booking_room_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddBookingRoomResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_add_booking_room_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_add_booking_room_proto_rawDesc = "" +
+	"\n" +
+	"%booking/v1/rpc/add_booking_room.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fbooking/v1/models/booking.proto\x1a#booking/v1/rpc/create_booking.proto\"\xc4\x02\n" +
+	"\x15AddBookingRoomRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\x12@\n" +
+	"\x04room\x18\x02 \x01(\v2$.booking.v1.CreateBookingRoomRequestB\x06\xbaH\x03\xc8\x01\x01R\x04room\x12X\n" +
+	"\x15expected_total_amount\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[0-9]+(\\.[0-9]{1,18})?$H\x00R\x13expectedTotalAmount\x88\x01\x01\x127\n" +
+	"\x10expected_version\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x01R\x0fexpectedVersion\x88\x01\x01B\x18\n" +
+	"\x16_expected_total_amountB\x13\n" +
+	"\x11_expected_version\"G\n" +
+	"\x16AddBookingRoomResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abookingB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_add_booking_room_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_add_booking_room_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_add_booking_room_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_add_booking_room_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_add_booking_room_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_add_booking_room_proto_rawDesc), len(file_booking_v1_rpc_add_booking_room_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_add_booking_room_proto_rawDescData
+}
+
+var file_booking_v1_rpc_add_booking_room_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_add_booking_room_proto_goTypes = []any{
+	(*AddBookingRoomRequest)(nil),    // 0: booking.v1.AddBookingRoomRequest
+	(*AddBookingRoomResponse)(nil),   // 1: booking.v1.AddBookingRoomResponse
+	(*CreateBookingRoomRequest)(nil), // 2: booking.v1.CreateBookingRoomRequest
+	(*Booking)(nil),                  // 3: booking.v1.Booking
+}
+var file_booking_v1_rpc_add_booking_room_proto_depIdxs = []int32{
+	2, // 0: booking.v1.AddBookingRoomRequest.room:type_name -> booking.v1.CreateBookingRoomRequest
+	3, // 1: booking.v1.AddBookingRoomResponse.booking:type_name -> booking.v1.Booking
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_add_booking_room_proto_init() }
+func file_booking_v1_rpc_add_booking_room_proto_init() {
+	if File_booking_v1_rpc_add_booking_room_proto != nil {
+		return
+	}
+	file_booking_v1_models_booking_proto_init()
+	file_booking_v1_rpc_create_booking_proto_init()
+	file_booking_v1_rpc_add_booking_room_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_add_booking_room_proto_rawDesc), len(file_booking_v1_rpc_add_booking_room_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_add_booking_room_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_add_booking_room_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_add_booking_room_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_add_booking_room_proto = out.File
+	file_booking_v1_rpc_add_booking_room_proto_goTypes = nil
+	file_booking_v1_rpc_add_booking_room_proto_depIdxs = nil
+}
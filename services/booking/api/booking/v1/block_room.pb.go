@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/block_room.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BlockRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	BlockId       string                 `protobuf:"bytes,2,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	StayRange     *DateRange             `protobuf:"bytes,3,opt,name=stay_range,json=stayRange,proto3" json:"stay_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRoomRequest) Reset() {
+	*x = BlockRoomRequest{}
+	mi := &file_booking_v1_rpc_block_room_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRoomRequest) ProtoMessage() {}
+
+func (x *BlockRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_block_room_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRoomRequest.ProtoReflect.Descriptor instead.
+func (*BlockRoomRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_block_room_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlockRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *BlockRoomRequest) GetBlockId() string {
+	if x != nil {
+		return x.BlockId
+	}
+	return ""
+}
+
+func (x *BlockRoomRequest) GetStayRange() *DateRange {
+	if x != nil {
+		return x.StayRange
+	}
+	return nil
+}
+
+type BlockRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomLock      *RoomLock              `protobuf:"bytes,1,opt,name=room_lock,json=roomLock,proto3" json:"room_lock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRoomResponse) Reset() {
+	*x = BlockRoomResponse{}
+	mi := &file_booking_v1_rpc_block_room_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRoomResponse) ProtoMessage() {}
+
+func (x *BlockRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_block_room_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRoomResponse.ProtoReflect.Descriptor instead.
+func (*BlockRoomResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_block_room_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlockRoomResponse) GetRoomLock() *RoomLock {
+	if x != nil {
+		return x.RoomLock
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_block_room_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_block_room_proto_rawDesc = "" +
+	"\n" +
+	"\x1fbooking/v1/rpc/block_room.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ebooking/v1/models/common.proto\x1a!booking/v1/models/room_lock.proto\"\x88\x02\n" +
+	"\x10BlockRoomRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12#\n" +
+	"\bblock_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\ablockId\x12<\n" +
+	"\n" +
+	"stay_range\x18\x03 \x01(\v2\x15.booking.v1.DateRangeB\x06\xbaH\x03\xc8\x01\x01R\tstayRange:n\xbaHk\x1ai\n" +
+	"\x16block_room.dates.order\x12\"stay_range end must be after start\x1a+this.stay_range.end > this.stay_range.start\"F\n" +
+	"\x11BlockRoomResponse\x121\n" +
+	"\troom_lock\x18\x01 \x01(\v2\x14.booking.v1.RoomLockR\broomLockB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_block_room_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_block_room_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_block_room_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_block_room_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_block_room_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_block_room_proto_rawDesc), len(file_booking_v1_rpc_block_room_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_block_room_proto_rawDescData
+}
+
+var file_booking_v1_rpc_block_room_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_block_room_proto_goTypes = []any{
+	(*BlockRoomRequest)(nil),  // 0: booking.v1.BlockRoomRequest
+	(*BlockRoomResponse)(nil), // 1: booking.v1.BlockRoomResponse
+	(*DateRange)(nil),         // 2: booking.v1.DateRange
+	(*RoomLock)(nil),          // 3: booking.v1.RoomLock
+}
+var file_booking_v1_rpc_block_room_proto_depIdxs = []int32{
+	2, // 0: booking.v1.BlockRoomRequest.stay_range:type_name -> booking.v1.DateRange
+	3, // 1: booking.v1.BlockRoomResponse.room_lock:type_name -> booking.v1.RoomLock
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_block_room_proto_init() }
+func file_booking_v1_rpc_block_room_proto_init() {
+	if File_booking_v1_rpc_block_room_proto != nil {
+		return
+	}
+	file_booking_v1_models_common_proto_init()
+	file_booking_v1_models_room_lock_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_block_room_proto_rawDesc), len(file_booking_v1_rpc_block_room_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_block_room_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_block_room_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_block_room_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_block_room_proto = out.File
+	file_booking_v1_rpc_block_room_proto_goTypes = nil
+	file_booking_v1_rpc_block_room_proto_depIdxs = nil
+}
@@ -39,6 +39,9 @@ type Booking struct {
 	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	BookingRooms        []*BookingRoomWithLock `protobuf:"bytes,15,rep,name=booking_rooms,json=bookingRooms,proto3" json:"booking_rooms,omitempty"`
+	Policy              *BookingPolicy         `protobuf:"bytes,16,opt,name=policy,proto3" json:"policy,omitempty"`
+	Version             int64                  `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`
+	Code                string                 `protobuf:"bytes,18,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -178,6 +181,27 @@ func (x *Booking) GetBookingRooms() []*BookingRoomWithLock {
 	return nil
 }
 
+func (x *Booking) GetPolicy() *BookingPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *Booking) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Booking) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
 type BookingShort struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -193,6 +217,7 @@ type BookingShort struct {
 	ExpectedTotalAmount string                 `protobuf:"bytes,11,opt,name=expected_total_amount,json=expectedTotalAmount,proto3" json:"expected_total_amount,omitempty"`
 	FinalTotalAmount    string                 `protobuf:"bytes,12,opt,name=final_total_amount,json=finalTotalAmount,proto3" json:"final_total_amount,omitempty"`
 	BookingRooms        []*BookingRoom         `protobuf:"bytes,13,rep,name=booking_rooms,json=bookingRooms,proto3" json:"booking_rooms,omitempty"`
+	Code                string                 `protobuf:"bytes,14,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -318,12 +343,19 @@ func (x *BookingShort) GetBookingRooms() []*BookingRoom {
 	return nil
 }
 
+func (x *BookingShort) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
 var File_booking_v1_models_booking_proto protoreflect.FileDescriptor
 
 const file_booking_v1_models_booking_proto_rawDesc = "" +
 	"\n" +
 	"\x1fbooking/v1/models/booking.proto\x12\n" +
-	"booking.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%booking/v1/enums/booking_status.proto\x1a$booking/v1/models/booking_room.proto\"\xb5\x05\n" +
+	"booking.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%booking/v1/enums/booking_status.proto\x1a$booking/v1/models/booking_room.proto\x1a&booking/v1/models/booking_policy.proto\"\x96\x06\n" +
 	"\aBooking\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
@@ -345,9 +377,12 @@ const file_booking_v1_models_booking_proto_rawDesc = "" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12D\n" +
-	"\rbooking_rooms\x18\x0f \x03(\v2\x1f.booking.v1.BookingRoomWithLockR\fbookingRoomsB\x0e\n" +
+	"\rbooking_rooms\x18\x0f \x03(\v2\x1f.booking.v1.BookingRoomWithLockR\fbookingRooms\x121\n" +
+	"\x06policy\x18\x10 \x01(\v2\x19.booking.v1.BookingPolicyR\x06policy\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04code\x18\x12 \x01(\tR\x04codeB\x0e\n" +
 	"\f_guest_emailB\x0e\n" +
-	"\f_guest_phone\"\xbc\x04\n" +
+	"\f_guest_phone\"\xd0\x04\n" +
 	"\fBookingShort\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
@@ -365,7 +400,8 @@ const file_booking_v1_models_booking_proto_rawDesc = "" +
 	" \x01(\tR\bcurrency\x122\n" +
 	"\x15expected_total_amount\x18\v \x01(\tR\x13expectedTotalAmount\x12,\n" +
 	"\x12final_total_amount\x18\f \x01(\tR\x10finalTotalAmount\x12<\n" +
-	"\rbooking_rooms\x18\r \x03(\v2\x17.booking.v1.BookingRoomR\fbookingRoomsB\x0e\n" +
+	"\rbooking_rooms\x18\r \x03(\v2\x17.booking.v1.BookingRoomR\fbookingRooms\x12\x12\n" +
+	"\x04code\x18\x0e \x01(\tR\x04codeB\x0e\n" +
 	"\f_guest_emailB\x0e\n" +
 	"\f_guest_phoneB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
 
@@ -388,7 +424,8 @@ var file_booking_v1_models_booking_proto_goTypes = []any{
 	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
 	(BookingStatus)(0),            // 3: booking.v1.BookingStatus
 	(*BookingRoomWithLock)(nil),   // 4: booking.v1.BookingRoomWithLock
-	(*BookingRoom)(nil),           // 5: booking.v1.BookingRoom
+	(*BookingPolicy)(nil),         // 5: booking.v1.BookingPolicy
+	(*BookingRoom)(nil),           // 6: booking.v1.BookingRoom
 }
 var file_booking_v1_models_booking_proto_depIdxs = []int32{
 	2,  // 0: booking.v1.Booking.check_in:type_name -> google.protobuf.Timestamp
@@ -397,15 +434,16 @@ var file_booking_v1_models_booking_proto_depIdxs = []int32{
 	2,  // 3: booking.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
 	2,  // 4: booking.v1.Booking.updated_at:type_name -> google.protobuf.Timestamp
 	4,  // 5: booking.v1.Booking.booking_rooms:type_name -> booking.v1.BookingRoomWithLock
-	2,  // 6: booking.v1.BookingShort.check_in:type_name -> google.protobuf.Timestamp
-	2,  // 7: booking.v1.BookingShort.check_out:type_name -> google.protobuf.Timestamp
-	3,  // 8: booking.v1.BookingShort.status:type_name -> booking.v1.BookingStatus
-	5,  // 9: booking.v1.BookingShort.booking_rooms:type_name -> booking.v1.BookingRoom
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	5,  // 6: booking.v1.Booking.policy:type_name -> booking.v1.BookingPolicy
+	2,  // 7: booking.v1.BookingShort.check_in:type_name -> google.protobuf.Timestamp
+	2,  // 8: booking.v1.BookingShort.check_out:type_name -> google.protobuf.Timestamp
+	3,  // 9: booking.v1.BookingShort.status:type_name -> booking.v1.BookingStatus
+	6,  // 10: booking.v1.BookingShort.booking_rooms:type_name -> booking.v1.BookingRoom
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_booking_v1_models_booking_proto_init() }
@@ -415,6 +453,7 @@ func file_booking_v1_models_booking_proto_init() {
 	}
 	file_booking_v1_enums_booking_status_proto_init()
 	file_booking_v1_models_booking_room_proto_init()
+	file_booking_v1_models_booking_policy_proto_init()
 	file_booking_v1_models_booking_proto_msgTypes[0].OneofWrappers = []any{}
 	file_booking_v1_models_booking_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
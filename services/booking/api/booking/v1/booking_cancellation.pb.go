@@ -0,0 +1,195 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/models/booking_cancellation.proto
+
+package bookingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BookingCancellation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookingId      string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Penalty        CancellationPenalty    `protobuf:"varint,2,opt,name=penalty,proto3,enum=booking.v1.CancellationPenalty" json:"penalty,omitempty"`
+	Fee            string                 `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	PaidAmount     string                 `protobuf:"bytes,4,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	RetainedAmount string                 `protobuf:"bytes,5,opt,name=retained_amount,json=retainedAmount,proto3" json:"retained_amount,omitempty"`
+	RefundAmount   string                 `protobuf:"bytes,6,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BookingCancellation) Reset() {
+	*x = BookingCancellation{}
+	mi := &file_booking_v1_models_booking_cancellation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCancellation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCancellation) ProtoMessage() {}
+
+func (x *BookingCancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_booking_cancellation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCancellation.ProtoReflect.Descriptor instead.
+func (*BookingCancellation) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_booking_cancellation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BookingCancellation) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingCancellation) GetPenalty() CancellationPenalty {
+	if x != nil {
+		return x.Penalty
+	}
+	return CancellationPenalty_CANCELLATION_PENALTY_UNSPECIFIED
+}
+
+func (x *BookingCancellation) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *BookingCancellation) GetPaidAmount() string {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return ""
+}
+
+func (x *BookingCancellation) GetRetainedAmount() string {
+	if x != nil {
+		return x.RetainedAmount
+	}
+	return ""
+}
+
+func (x *BookingCancellation) GetRefundAmount() string {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return ""
+}
+
+func (x *BookingCancellation) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BookingCancellation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_booking_v1_models_booking_cancellation_proto protoreflect.FileDescriptor
+
+const file_booking_v1_models_booking_cancellation_proto_rawDesc = "" +
+	"\n" +
+	",booking/v1/models/booking_cancellation.proto\x12\n" +
+	"booking.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a+booking/v1/enums/cancellation_penalty.proto\"\xc7\x02\n" +
+	"\x13BookingCancellation\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x129\n" +
+	"\apenalty\x18\x02 \x01(\x0e2\x1f.booking.v1.CancellationPenaltyR\apenalty\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\tR\x03fee\x12\x1f\n" +
+	"\vpaid_amount\x18\x04 \x01(\tR\n" +
+	"paidAmount\x12'\n" +
+	"\x0fretained_amount\x18\x05 \x01(\tR\x0eretainedAmount\x12#\n" +
+	"\rrefund_amount\x18\x06 \x01(\tR\frefundAmount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_models_booking_cancellation_proto_rawDescOnce sync.Once
+	file_booking_v1_models_booking_cancellation_proto_rawDescData []byte
+)
+
+func file_booking_v1_models_booking_cancellation_proto_rawDescGZIP() []byte {
+	file_booking_v1_models_booking_cancellation_proto_rawDescOnce.Do(func() {
+		file_booking_v1_models_booking_cancellation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_models_booking_cancellation_proto_rawDesc), len(file_booking_v1_models_booking_cancellation_proto_rawDesc)))
+	})
+	return file_booking_v1_models_booking_cancellation_proto_rawDescData
+}
+
+var file_booking_v1_models_booking_cancellation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_booking_v1_models_booking_cancellation_proto_goTypes = []any{
+	(*BookingCancellation)(nil),   // 0: booking.v1.BookingCancellation
+	(CancellationPenalty)(0),      // 1: booking.v1.CancellationPenalty
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_booking_v1_models_booking_cancellation_proto_depIdxs = []int32{
+	1, // 0: booking.v1.BookingCancellation.penalty:type_name -> booking.v1.CancellationPenalty
+	2, // 1: booking.v1.BookingCancellation.created_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_models_booking_cancellation_proto_init() }
+func file_booking_v1_models_booking_cancellation_proto_init() {
+	if File_booking_v1_models_booking_cancellation_proto != nil {
+		return
+	}
+	file_booking_v1_enums_cancellation_penalty_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_models_booking_cancellation_proto_rawDesc), len(file_booking_v1_models_booking_cancellation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_models_booking_cancellation_proto_goTypes,
+		DependencyIndexes: file_booking_v1_models_booking_cancellation_proto_depIdxs,
+		MessageInfos:      file_booking_v1_models_booking_cancellation_proto_msgTypes,
+	}.Build()
+	File_booking_v1_models_booking_cancellation_proto = out.File
+	file_booking_v1_models_booking_cancellation_proto_goTypes = nil
+	file_booking_v1_models_booking_cancellation_proto_depIdxs = nil
+}
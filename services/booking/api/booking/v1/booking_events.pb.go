@@ -0,0 +1,779 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/events/booking_events.proto
+
+package bookingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BookingCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	CheckIn       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	GuestName     string                 `protobuf:"bytes,6,opt,name=guest_name,json=guestName,proto3" json:"guest_name,omitempty"`
+	GuestEmail    *string                `protobuf:"bytes,7,opt,name=guest_email,json=guestEmail,proto3,oneof" json:"guest_email,omitempty"`
+	GuestPhone    *string                `protobuf:"bytes,8,opt,name=guest_phone,json=guestPhone,proto3,oneof" json:"guest_phone,omitempty"`
+	Currency      string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalAmount   string                 `protobuf:"bytes,10,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingCreated) Reset() {
+	*x = BookingCreated{}
+	mi := &file_booking_v1_events_booking_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCreated) ProtoMessage() {}
+
+func (x *BookingCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_events_booking_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCreated.ProtoReflect.Descriptor instead.
+func (*BookingCreated) Descriptor() ([]byte, []int) {
+	return file_booking_v1_events_booking_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BookingCreated) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingCreated) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *BookingCreated) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *BookingCreated) GetGuestName() string {
+	if x != nil {
+		return x.GuestName
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetGuestEmail() string {
+	if x != nil && x.GuestEmail != nil {
+		return *x.GuestEmail
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetGuestPhone() string {
+	if x != nil && x.GuestPhone != nil {
+		return *x.GuestPhone
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *BookingCreated) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type BookingConfirmed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	CheckIn       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	GuestName     string                 `protobuf:"bytes,6,opt,name=guest_name,json=guestName,proto3" json:"guest_name,omitempty"`
+	GuestEmail    *string                `protobuf:"bytes,7,opt,name=guest_email,json=guestEmail,proto3,oneof" json:"guest_email,omitempty"`
+	GuestPhone    *string                `protobuf:"bytes,8,opt,name=guest_phone,json=guestPhone,proto3,oneof" json:"guest_phone,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingConfirmed) Reset() {
+	*x = BookingConfirmed{}
+	mi := &file_booking_v1_events_booking_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingConfirmed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingConfirmed) ProtoMessage() {}
+
+func (x *BookingConfirmed) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_events_booking_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingConfirmed.ProtoReflect.Descriptor instead.
+func (*BookingConfirmed) Descriptor() ([]byte, []int) {
+	return file_booking_v1_events_booking_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BookingConfirmed) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingConfirmed) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingConfirmed) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *BookingConfirmed) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *BookingConfirmed) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *BookingConfirmed) GetGuestName() string {
+	if x != nil {
+		return x.GuestName
+	}
+	return ""
+}
+
+func (x *BookingConfirmed) GetGuestEmail() string {
+	if x != nil && x.GuestEmail != nil {
+		return *x.GuestEmail
+	}
+	return ""
+}
+
+func (x *BookingConfirmed) GetGuestPhone() string {
+	if x != nil && x.GuestPhone != nil {
+		return *x.GuestPhone
+	}
+	return ""
+}
+
+func (x *BookingConfirmed) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type BookingCancelled struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	GuestName     string                 `protobuf:"bytes,4,opt,name=guest_name,json=guestName,proto3" json:"guest_name,omitempty"`
+	GuestEmail    *string                `protobuf:"bytes,5,opt,name=guest_email,json=guestEmail,proto3,oneof" json:"guest_email,omitempty"`
+	GuestPhone    *string                `protobuf:"bytes,6,opt,name=guest_phone,json=guestPhone,proto3,oneof" json:"guest_phone,omitempty"`
+	ActorId       *int64                 `protobuf:"varint,7,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,8,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingCancelled) Reset() {
+	*x = BookingCancelled{}
+	mi := &file_booking_v1_events_booking_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCancelled) ProtoMessage() {}
+
+func (x *BookingCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_events_booking_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCancelled.ProtoReflect.Descriptor instead.
+func (*BookingCancelled) Descriptor() ([]byte, []int) {
+	return file_booking_v1_events_booking_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BookingCancelled) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingCancelled) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetGuestName() string {
+	if x != nil {
+		return x.GuestName
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetGuestEmail() string {
+	if x != nil && x.GuestEmail != nil {
+		return *x.GuestEmail
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetGuestPhone() string {
+	if x != nil && x.GuestPhone != nil {
+		return *x.GuestPhone
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetActorId() int64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *BookingCancelled) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *BookingCancelled) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// BookingStatusChanged is published for the transitions that have no event of
+// their own.
+type BookingStatusChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	From          BookingStatus          `protobuf:"varint,4,opt,name=from,proto3,enum=booking.v1.BookingStatus" json:"from,omitempty"`
+	To            BookingStatus          `protobuf:"varint,5,opt,name=to,proto3,enum=booking.v1.BookingStatus" json:"to,omitempty"`
+	ActorId       *int64                 `protobuf:"varint,6,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,7,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingStatusChanged) Reset() {
+	*x = BookingStatusChanged{}
+	mi := &file_booking_v1_events_booking_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingStatusChanged) ProtoMessage() {}
+
+func (x *BookingStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_events_booking_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingStatusChanged.ProtoReflect.Descriptor instead.
+func (*BookingStatusChanged) Descriptor() ([]byte, []int) {
+	return file_booking_v1_events_booking_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BookingStatusChanged) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingStatusChanged) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingStatusChanged) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *BookingStatusChanged) GetFrom() BookingStatus {
+	if x != nil {
+		return x.From
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChanged) GetTo() BookingStatus {
+	if x != nil {
+		return x.To
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChanged) GetActorId() int64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *BookingStatusChanged) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *BookingStatusChanged) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// BookingModified is published when the stay, the rooms or the guest details
+// of a booking change and carries the booking as it is after the change.
+type BookingModified struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Status        BookingStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	CheckIn       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	GuestName     string                 `protobuf:"bytes,7,opt,name=guest_name,json=guestName,proto3" json:"guest_name,omitempty"`
+	GuestEmail    *string                `protobuf:"bytes,8,opt,name=guest_email,json=guestEmail,proto3,oneof" json:"guest_email,omitempty"`
+	GuestPhone    *string                `protobuf:"bytes,9,opt,name=guest_phone,json=guestPhone,proto3,oneof" json:"guest_phone,omitempty"`
+	Currency      string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalAmount   string                 `protobuf:"bytes,11,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Version       int64                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingModified) Reset() {
+	*x = BookingModified{}
+	mi := &file_booking_v1_events_booking_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingModified) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingModified) ProtoMessage() {}
+
+func (x *BookingModified) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_events_booking_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingModified.ProtoReflect.Descriptor instead.
+func (*BookingModified) Descriptor() ([]byte, []int) {
+	return file_booking_v1_events_booking_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BookingModified) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingModified) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookingModified) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *BookingModified) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingModified) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *BookingModified) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *BookingModified) GetGuestName() string {
+	if x != nil {
+		return x.GuestName
+	}
+	return ""
+}
+
+func (x *BookingModified) GetGuestEmail() string {
+	if x != nil && x.GuestEmail != nil {
+		return *x.GuestEmail
+	}
+	return ""
+}
+
+func (x *BookingModified) GetGuestPhone() string {
+	if x != nil && x.GuestPhone != nil {
+		return *x.GuestPhone
+	}
+	return ""
+}
+
+func (x *BookingModified) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BookingModified) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *BookingModified) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BookingModified) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_booking_v1_events_booking_events_proto protoreflect.FileDescriptor
+
+const file_booking_v1_events_booking_events_proto_rawDesc = "" +
+	"\n" +
+	"&booking/v1/events/booking_events.proto\x12\n" +
+	"booking.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%booking/v1/enums/booking_status.proto\"\xda\x03\n" +
+	"\x0eBookingCreated\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\tR\ahotelId\x125\n" +
+	"\bcheck_in\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\acheckIn\x127\n" +
+	"\tcheck_out\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bcheckOut\x12\x1d\n" +
+	"\n" +
+	"guest_name\x18\x06 \x01(\tR\tguestName\x12$\n" +
+	"\vguest_email\x18\a \x01(\tH\x00R\n" +
+	"guestEmail\x88\x01\x01\x12$\n" +
+	"\vguest_phone\x18\b \x01(\tH\x01R\n" +
+	"guestPhone\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12!\n" +
+	"\ftotal_amount\x18\n" +
+	" \x01(\tR\vtotalAmount\x12;\n" +
+	"\voccurred_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\x0e\n" +
+	"\f_guest_emailB\x0e\n" +
+	"\f_guest_phone\"\x9d\x03\n" +
+	"\x10BookingConfirmed\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\tR\ahotelId\x125\n" +
+	"\bcheck_in\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\acheckIn\x127\n" +
+	"\tcheck_out\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bcheckOut\x12\x1d\n" +
+	"\n" +
+	"guest_name\x18\x06 \x01(\tR\tguestName\x12$\n" +
+	"\vguest_email\x18\a \x01(\tH\x00R\n" +
+	"guestEmail\x88\x01\x01\x12$\n" +
+	"\vguest_phone\x18\b \x01(\tH\x01R\n" +
+	"guestPhone\x88\x01\x01\x12;\n" +
+	"\voccurred_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\x0e\n" +
+	"\f_guest_emailB\x0e\n" +
+	"\f_guest_phone\"\x82\x03\n" +
+	"\x10BookingCancelled\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\tR\ahotelId\x12\x1d\n" +
+	"\n" +
+	"guest_name\x18\x04 \x01(\tR\tguestName\x12$\n" +
+	"\vguest_email\x18\x05 \x01(\tH\x00R\n" +
+	"guestEmail\x88\x01\x01\x12$\n" +
+	"\vguest_phone\x18\x06 \x01(\tH\x01R\n" +
+	"guestPhone\x88\x01\x01\x12\x1e\n" +
+	"\bactor_id\x18\a \x01(\x03H\x02R\aactorId\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\b \x01(\tH\x03R\x06reason\x88\x01\x01\x12;\n" +
+	"\voccurred_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\x0e\n" +
+	"\f_guest_emailB\x0e\n" +
+	"\f_guest_phoneB\v\n" +
+	"\t_actor_idB\t\n" +
+	"\a_reason\"\xd5\x02\n" +
+	"\x14BookingStatusChanged\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\tR\ahotelId\x12-\n" +
+	"\x04from\x18\x04 \x01(\x0e2\x19.booking.v1.BookingStatusR\x04from\x12)\n" +
+	"\x02to\x18\x05 \x01(\x0e2\x19.booking.v1.BookingStatusR\x02to\x12\x1e\n" +
+	"\bactor_id\x18\x06 \x01(\x03H\x00R\aactorId\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\a \x01(\tH\x01R\x06reason\x88\x01\x01\x12;\n" +
+	"\voccurred_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\v\n" +
+	"\t_actor_idB\t\n" +
+	"\a_reason\"\xa8\x04\n" +
+	"\x0fBookingModified\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\tR\ahotelId\x121\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x125\n" +
+	"\bcheck_in\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\acheckIn\x127\n" +
+	"\tcheck_out\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bcheckOut\x12\x1d\n" +
+	"\n" +
+	"guest_name\x18\a \x01(\tR\tguestName\x12$\n" +
+	"\vguest_email\x18\b \x01(\tH\x00R\n" +
+	"guestEmail\x88\x01\x01\x12$\n" +
+	"\vguest_phone\x18\t \x01(\tH\x01R\n" +
+	"guestPhone\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12!\n" +
+	"\ftotal_amount\x18\v \x01(\tR\vtotalAmount\x12\x18\n" +
+	"\aversion\x18\f \x01(\x03R\aversion\x12;\n" +
+	"\voccurred_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\x0e\n" +
+	"\f_guest_emailB\x0e\n" +
+	"\f_guest_phoneB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_events_booking_events_proto_rawDescOnce sync.Once
+	file_booking_v1_events_booking_events_proto_rawDescData []byte
+)
+
+func file_booking_v1_events_booking_events_proto_rawDescGZIP() []byte {
+	file_booking_v1_events_booking_events_proto_rawDescOnce.Do(func() {
+		file_booking_v1_events_booking_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_events_booking_events_proto_rawDesc), len(file_booking_v1_events_booking_events_proto_rawDesc)))
+	})
+	return file_booking_v1_events_booking_events_proto_rawDescData
+}
+
+var file_booking_v1_events_booking_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_booking_v1_events_booking_events_proto_goTypes = []any{
+	(*BookingCreated)(nil),        // 0: booking.v1.BookingCreated
+	(*BookingConfirmed)(nil),      // 1: booking.v1.BookingConfirmed
+	(*BookingCancelled)(nil),      // 2: booking.v1.BookingCancelled
+	(*BookingStatusChanged)(nil),  // 3: booking.v1.BookingStatusChanged
+	(*BookingModified)(nil),       // 4: booking.v1.BookingModified
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(BookingStatus)(0),            // 6: booking.v1.BookingStatus
+}
+var file_booking_v1_events_booking_events_proto_depIdxs = []int32{
+	5,  // 0: booking.v1.BookingCreated.check_in:type_name -> google.protobuf.Timestamp
+	5,  // 1: booking.v1.BookingCreated.check_out:type_name -> google.protobuf.Timestamp
+	5,  // 2: booking.v1.BookingCreated.occurred_at:type_name -> google.protobuf.Timestamp
+	5,  // 3: booking.v1.BookingConfirmed.check_in:type_name -> google.protobuf.Timestamp
+	5,  // 4: booking.v1.BookingConfirmed.check_out:type_name -> google.protobuf.Timestamp
+	5,  // 5: booking.v1.BookingConfirmed.occurred_at:type_name -> google.protobuf.Timestamp
+	5,  // 6: booking.v1.BookingCancelled.occurred_at:type_name -> google.protobuf.Timestamp
+	6,  // 7: booking.v1.BookingStatusChanged.from:type_name -> booking.v1.BookingStatus
+	6,  // 8: booking.v1.BookingStatusChanged.to:type_name -> booking.v1.BookingStatus
+	5,  // 9: booking.v1.BookingStatusChanged.occurred_at:type_name -> google.protobuf.Timestamp
+	6,  // 10: booking.v1.BookingModified.status:type_name -> booking.v1.BookingStatus
+	5,  // 11: booking.v1.BookingModified.check_in:type_name -> google.protobuf.Timestamp
+	5,  // 12: booking.v1.BookingModified.check_out:type_name -> google.protobuf.Timestamp
+	5,  // 13: booking.v1.BookingModified.occurred_at:type_name -> google.protobuf.Timestamp
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_events_booking_events_proto_init() }
+func file_booking_v1_events_booking_events_proto_init() {
+	if File_booking_v1_events_booking_events_proto != nil {
+		return
+	}
+	file_booking_v1_enums_booking_status_proto_init()
+	file_booking_v1_events_booking_events_proto_msgTypes[0].OneofWrappers = []any{}
+	file_booking_v1_events_booking_events_proto_msgTypes[1].OneofWrappers = []any{}
+	file_booking_v1_events_booking_events_proto_msgTypes[2].OneofWrappers = []any{}
+	file_booking_v1_events_booking_events_proto_msgTypes[3].OneofWrappers = []any{}
+	file_booking_v1_events_booking_events_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_events_booking_events_proto_rawDesc), len(file_booking_v1_events_booking_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_events_booking_events_proto_goTypes,
+		DependencyIndexes: file_booking_v1_events_booking_events_proto_depIdxs,
+		MessageInfos:      file_booking_v1_events_booking_events_proto_msgTypes,
+	}.Build()
+	File_booking_v1_events_booking_events_proto = out.File
+	file_booking_v1_events_booking_events_proto_goTypes = nil
+	file_booking_v1_events_booking_events_proto_depIdxs = nil
+}
@@ -0,0 +1,391 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/models/booking_policy.proto
+
+package bookingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CancellationTier struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HoursBeforeCheckIn uint32                 `protobuf:"varint,1,opt,name=hours_before_check_in,json=hoursBeforeCheckIn,proto3" json:"hours_before_check_in,omitempty"`
+	Penalty            CancellationPenalty    `protobuf:"varint,2,opt,name=penalty,proto3,enum=booking.v1.CancellationPenalty" json:"penalty,omitempty"`
+	PenaltyPercent     uint32                 `protobuf:"varint,3,opt,name=penalty_percent,json=penaltyPercent,proto3" json:"penalty_percent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CancellationTier) Reset() {
+	*x = CancellationTier{}
+	mi := &file_booking_v1_models_booking_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationTier) ProtoMessage() {}
+
+func (x *CancellationTier) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_booking_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationTier.ProtoReflect.Descriptor instead.
+func (*CancellationTier) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_booking_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CancellationTier) GetHoursBeforeCheckIn() uint32 {
+	if x != nil {
+		return x.HoursBeforeCheckIn
+	}
+	return 0
+}
+
+func (x *CancellationTier) GetPenalty() CancellationPenalty {
+	if x != nil {
+		return x.Penalty
+	}
+	return CancellationPenalty_CANCELLATION_PENALTY_UNSPECIFIED
+}
+
+func (x *CancellationTier) GetPenaltyPercent() uint32 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
+type ChildAgeBand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinAge        uint32                 `protobuf:"varint,1,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`
+	MaxAge        uint32                 `protobuf:"varint,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	PricePercent  uint32                 `protobuf:"varint,3,opt,name=price_percent,json=pricePercent,proto3" json:"price_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChildAgeBand) Reset() {
+	*x = ChildAgeBand{}
+	mi := &file_booking_v1_models_booking_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChildAgeBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildAgeBand) ProtoMessage() {}
+
+func (x *ChildAgeBand) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_booking_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildAgeBand.ProtoReflect.Descriptor instead.
+func (*ChildAgeBand) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_booking_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChildAgeBand) GetMinAge() uint32 {
+	if x != nil {
+		return x.MinAge
+	}
+	return 0
+}
+
+func (x *ChildAgeBand) GetMaxAge() uint32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *ChildAgeBand) GetPricePercent() uint32 {
+	if x != nil {
+		return x.PricePercent
+	}
+	return 0
+}
+
+type PetPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	FeePerNight   *string                `protobuf:"bytes,2,opt,name=fee_per_night,json=feePerNight,proto3,oneof" json:"fee_per_night,omitempty"`
+	MaxPets       uint32                 `protobuf:"varint,3,opt,name=max_pets,json=maxPets,proto3" json:"max_pets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetPolicy) Reset() {
+	*x = PetPolicy{}
+	mi := &file_booking_v1_models_booking_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetPolicy) ProtoMessage() {}
+
+func (x *PetPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_booking_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetPolicy.ProtoReflect.Descriptor instead.
+func (*PetPolicy) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_booking_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PetPolicy) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *PetPolicy) GetFeePerNight() string {
+	if x != nil && x.FeePerNight != nil {
+		return *x.FeePerNight
+	}
+	return ""
+}
+
+func (x *PetPolicy) GetMaxPets() uint32 {
+	if x != nil {
+		return x.MaxPets
+	}
+	return 0
+}
+
+type BookingPolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Timezone          string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CheckInTime       string                 `protobuf:"bytes,2,opt,name=check_in_time,json=checkInTime,proto3" json:"check_in_time,omitempty"`
+	CheckOutTime      string                 `protobuf:"bytes,3,opt,name=check_out_time,json=checkOutTime,proto3" json:"check_out_time,omitempty"`
+	LatestCheckInTime *string                `protobuf:"bytes,4,opt,name=latest_check_in_time,json=latestCheckInTime,proto3,oneof" json:"latest_check_in_time,omitempty"`
+	CancellationTiers []*CancellationTier    `protobuf:"bytes,5,rep,name=cancellation_tiers,json=cancellationTiers,proto3" json:"cancellation_tiers,omitempty"`
+	PrepaymentPercent uint32                 `protobuf:"varint,6,opt,name=prepayment_percent,json=prepaymentPercent,proto3" json:"prepayment_percent,omitempty"`
+	ChildAgeBands     []*ChildAgeBand        `protobuf:"bytes,7,rep,name=child_age_bands,json=childAgeBands,proto3" json:"child_age_bands,omitempty"`
+	Pets              *PetPolicy             `protobuf:"bytes,8,opt,name=pets,proto3" json:"pets,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BookingPolicy) Reset() {
+	*x = BookingPolicy{}
+	mi := &file_booking_v1_models_booking_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingPolicy) ProtoMessage() {}
+
+func (x *BookingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_booking_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingPolicy.ProtoReflect.Descriptor instead.
+func (*BookingPolicy) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_booking_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BookingPolicy) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *BookingPolicy) GetCheckInTime() string {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return ""
+}
+
+func (x *BookingPolicy) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
+}
+
+func (x *BookingPolicy) GetLatestCheckInTime() string {
+	if x != nil && x.LatestCheckInTime != nil {
+		return *x.LatestCheckInTime
+	}
+	return ""
+}
+
+func (x *BookingPolicy) GetCancellationTiers() []*CancellationTier {
+	if x != nil {
+		return x.CancellationTiers
+	}
+	return nil
+}
+
+func (x *BookingPolicy) GetPrepaymentPercent() uint32 {
+	if x != nil {
+		return x.PrepaymentPercent
+	}
+	return 0
+}
+
+func (x *BookingPolicy) GetChildAgeBands() []*ChildAgeBand {
+	if x != nil {
+		return x.ChildAgeBands
+	}
+	return nil
+}
+
+func (x *BookingPolicy) GetPets() *PetPolicy {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+var File_booking_v1_models_booking_policy_proto protoreflect.FileDescriptor
+
+const file_booking_v1_models_booking_policy_proto_rawDesc = "" +
+	"\n" +
+	"&booking/v1/models/booking_policy.proto\x12\n" +
+	"booking.v1\x1a+booking/v1/enums/cancellation_penalty.proto\"\xa9\x01\n" +
+	"\x10CancellationTier\x121\n" +
+	"\x15hours_before_check_in\x18\x01 \x01(\rR\x12hoursBeforeCheckIn\x129\n" +
+	"\apenalty\x18\x02 \x01(\x0e2\x1f.booking.v1.CancellationPenaltyR\apenalty\x12'\n" +
+	"\x0fpenalty_percent\x18\x03 \x01(\rR\x0epenaltyPercent\"e\n" +
+	"\fChildAgeBand\x12\x17\n" +
+	"\amin_age\x18\x01 \x01(\rR\x06minAge\x12\x17\n" +
+	"\amax_age\x18\x02 \x01(\rR\x06maxAge\x12#\n" +
+	"\rprice_percent\x18\x03 \x01(\rR\fpricePercent\"{\n" +
+	"\tPetPolicy\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12'\n" +
+	"\rfee_per_night\x18\x02 \x01(\tH\x00R\vfeePerNight\x88\x01\x01\x12\x19\n" +
+	"\bmax_pets\x18\x03 \x01(\rR\amaxPetsB\x10\n" +
+	"\x0e_fee_per_night\"\xad\x03\n" +
+	"\rBookingPolicy\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12\"\n" +
+	"\rcheck_in_time\x18\x02 \x01(\tR\vcheckInTime\x12$\n" +
+	"\x0echeck_out_time\x18\x03 \x01(\tR\fcheckOutTime\x124\n" +
+	"\x14latest_check_in_time\x18\x04 \x01(\tH\x00R\x11latestCheckInTime\x88\x01\x01\x12K\n" +
+	"\x12cancellation_tiers\x18\x05 \x03(\v2\x1c.booking.v1.CancellationTierR\x11cancellationTiers\x12-\n" +
+	"\x12prepayment_percent\x18\x06 \x01(\rR\x11prepaymentPercent\x12@\n" +
+	"\x0fchild_age_bands\x18\a \x03(\v2\x18.booking.v1.ChildAgeBandR\rchildAgeBands\x12)\n" +
+	"\x04pets\x18\b \x01(\v2\x15.booking.v1.PetPolicyR\x04petsB\x17\n" +
+	"\x15_latest_check_in_timeB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_models_booking_policy_proto_rawDescOnce sync.Once
+	file_booking_v1_models_booking_policy_proto_rawDescData []byte
+)
+
+func file_booking_v1_models_booking_policy_proto_rawDescGZIP() []byte {
+	file_booking_v1_models_booking_policy_proto_rawDescOnce.Do(func() {
+		file_booking_v1_models_booking_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_models_booking_policy_proto_rawDesc), len(file_booking_v1_models_booking_policy_proto_rawDesc)))
+	})
+	return file_booking_v1_models_booking_policy_proto_rawDescData
+}
+
+var file_booking_v1_models_booking_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_booking_v1_models_booking_policy_proto_goTypes = []any{
+	(*CancellationTier)(nil), // 0: booking.v1.CancellationTier
+	(*ChildAgeBand)(nil),     // 1: booking.v1.ChildAgeBand
+	(*PetPolicy)(nil),        // 2: booking.v1.PetPolicy
+	(*BookingPolicy)(nil),    // 3: booking.v1.BookingPolicy
+	(CancellationPenalty)(0), // 4: booking.v1.CancellationPenalty
+}
+var file_booking_v1_models_booking_policy_proto_depIdxs = []int32{
+	4, // 0: booking.v1.CancellationTier.penalty:type_name -> booking.v1.CancellationPenalty
+	0, // 1: booking.v1.BookingPolicy.cancellation_tiers:type_name -> booking.v1.CancellationTier
+	1, // 2: booking.v1.BookingPolicy.child_age_bands:type_name -> booking.v1.ChildAgeBand
+	2, // 3: booking.v1.BookingPolicy.pets:type_name -> booking.v1.PetPolicy
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_models_booking_policy_proto_init() }
+func file_booking_v1_models_booking_policy_proto_init() {
+	if File_booking_v1_models_booking_policy_proto != nil {
+		return
+	}
+	file_booking_v1_enums_cancellation_penalty_proto_init()
+	file_booking_v1_models_booking_policy_proto_msgTypes[2].OneofWrappers = []any{}
+	file_booking_v1_models_booking_policy_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_models_booking_policy_proto_rawDesc), len(file_booking_v1_models_booking_policy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_models_booking_policy_proto_goTypes,
+		DependencyIndexes: file_booking_v1_models_booking_policy_proto_depIdxs,
+		MessageInfos:      file_booking_v1_models_booking_policy_proto_msgTypes,
+	}.Build()
+	File_booking_v1_models_booking_policy_proto = out.File
+	file_booking_v1_models_booking_policy_proto_goTypes = nil
+	file_booking_v1_models_booking_policy_proto_depIdxs = nil
+}
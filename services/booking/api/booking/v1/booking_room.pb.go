@@ -28,6 +28,7 @@ type BookingRoom struct {
 	Adults        uint32                 `protobuf:"varint,3,opt,name=adults,proto3" json:"adults,omitempty"`
 	Children      uint32                 `protobuf:"varint,4,opt,name=children,proto3" json:"children,omitempty"`
 	PricePerNight string                 `protobuf:"bytes,5,opt,name=price_per_night,json=pricePerNight,proto3" json:"price_per_night,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,6 +98,13 @@ func (x *BookingRoom) GetPricePerNight() string {
 	return ""
 }
 
+func (x *BookingRoom) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
 type BookingRoomWithLock struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -105,6 +113,7 @@ type BookingRoomWithLock struct {
 	Children      uint32                 `protobuf:"varint,4,opt,name=children,proto3" json:"children,omitempty"`
 	PricePerNight string                 `protobuf:"bytes,5,opt,name=price_per_night,json=pricePerNight,proto3" json:"price_per_night,omitempty"`
 	RoomLock      *RoomLockShort         `protobuf:"bytes,6,opt,name=room_lock,json=roomLock,proto3" json:"room_lock,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -181,25 +190,36 @@ func (x *BookingRoomWithLock) GetRoomLock() *RoomLockShort {
 	return nil
 }
 
+func (x *BookingRoomWithLock) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
 var File_booking_v1_models_booking_room_proto protoreflect.FileDescriptor
 
 const file_booking_v1_models_booking_room_proto_rawDesc = "" +
 	"\n" +
 	"$booking/v1/models/booking_room.proto\x12\n" +
-	"booking.v1\x1a!booking/v1/models/room_lock.proto\"\x92\x01\n" +
+	"booking.v1\x1a!booking/v1/models/room_lock.proto\"\xb3\x01\n" +
 	"\vBookingRoom\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x16\n" +
 	"\x06adults\x18\x03 \x01(\rR\x06adults\x12\x1a\n" +
 	"\bchildren\x18\x04 \x01(\rR\bchildren\x12&\n" +
-	"\x0fprice_per_night\x18\x05 \x01(\tR\rpricePerNight\"\xd2\x01\n" +
+	"\x0fprice_per_night\x18\x05 \x01(\tR\rpricePerNight\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\"\xf3\x01\n" +
 	"\x13BookingRoomWithLock\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x12\x16\n" +
 	"\x06adults\x18\x03 \x01(\rR\x06adults\x12\x1a\n" +
 	"\bchildren\x18\x04 \x01(\rR\bchildren\x12&\n" +
 	"\x0fprice_per_night\x18\x05 \x01(\tR\rpricePerNight\x126\n" +
-	"\troom_lock\x18\x06 \x01(\v2\x19.booking.v1.RoomLockShortR\broomLockB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+	"\troom_lock\x18\x06 \x01(\v2\x19.booking.v1.RoomLockShortR\broomLock\x12\x1f\n" +
+	"\vcategory_id\x18\a \x01(\tR\n" +
+	"categoryIdB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
 
 var (
 	file_booking_v1_models_booking_room_proto_rawDescOnce sync.Once
@@ -26,45 +26,158 @@ var File_booking_v1_booking_service_proto protoreflect.FileDescriptor
 const file_booking_v1_booking_service_proto_rawDesc = "" +
 	"\n" +
 	" booking/v1/booking_service.proto\x12\n" +
-	"booking.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a#booking/v1/rpc/create_booking.proto\x1a!booking/v1/rpc/get_bookings.proto\x1a booking/v1/rpc/get_booking.proto\x1a+booking/v1/rpc/confirm_booking_status.proto\x1a*booking/v1/rpc/cancel_booking_status.proto\x1a#booking/v1/rpc/delete_booking.proto2\xac\x04\n" +
+	"booking.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a#booking/v1/rpc/create_booking.proto\x1a!booking/v1/rpc/get_bookings.proto\x1a booking/v1/rpc/get_booking.proto\x1a)booking/v1/rpc/find_booking_by_code.proto\x1a+booking/v1/rpc/confirm_booking_status.proto\x1a*booking/v1/rpc/cancel_booking_status.proto\x1a\x1dbooking/v1/rpc/check_in.proto\x1a\x1ebooking/v1/rpc/check_out.proto\x1a!booking/v1/rpc/mark_no_show.proto\x1a#booking/v1/rpc/delete_booking.proto\x1a\x1fbooking/v1/rpc/block_room.proto\x1a!booking/v1/rpc/unblock_room.proto\x1a*booking/v1/rpc/get_room_availability.proto\x1a(booking/v1/rpc/get_active_bookings.proto\x1a+booking/v1/rpc/cancel_active_bookings.proto\x1a*booking/v1/rpc/reassign_booking_room.proto\x1a.booking/v1/rpc/get_category_availability.proto\x1a(booking/v1/rpc/get_booking_history.proto\x1a)booking/v1/rpc/update_booking_guest.proto\x1a)booking/v1/rpc/change_booking_dates.proto\x1a%booking/v1/rpc/add_booking_room.proto\x1a(booking/v1/rpc/remove_booking_room.proto\x1a/booking/v1/rpc/update_booking_room_guests.proto\x1a)booking/v1/rpc/preview_cancellation.proto\x1a#booking/v1/rpc/create_payment.proto\x1a$booking/v1/rpc/capture_payment.proto\x1a#booking/v1/rpc/refund_payment.proto\x1a)booking/v1/rpc/get_booking_payments.proto2\xde\x0e\n" +
 	"\x0eBookingService\x12T\n" +
 	"\rCreateBooking\x12 .booking.v1.CreateBookingRequest\x1a!.booking.v1.CreateBookingResponse\x12N\n" +
 	"\vGetBookings\x12\x1e.booking.v1.GetBookingsRequest\x1a\x1f.booking.v1.GetBookingsResponse\x12K\n" +
 	"\n" +
-	"GetBooking\x12\x1d.booking.v1.GetBookingRequest\x1a\x1e.booking.v1.GetBookingResponse\x12i\n" +
+	"GetBooking\x12\x1d.booking.v1.GetBookingRequest\x1a\x1e.booking.v1.GetBookingResponse\x12`\n" +
+	"\x11FindBookingByCode\x12$.booking.v1.FindBookingByCodeRequest\x1a%.booking.v1.FindBookingByCodeResponse\x12i\n" +
 	"\x14ConfirmBookingStatus\x12'.booking.v1.ConfirmBookingStatusRequest\x1a(.booking.v1.ConfirmBookingStatusResponse\x12f\n" +
-	"\x13CancelBookingStatus\x12&.booking.v1.CancelBookingStatusRequest\x1a'.booking.v1.CancelBookingStatusResponse\x12T\n" +
-	"\rDeleteBooking\x12 .booking.v1.DeleteBookingRequest\x1a!.booking.v1.DeleteBookingResponseB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+	"\x13CancelBookingStatus\x12&.booking.v1.CancelBookingStatusRequest\x1a'.booking.v1.CancelBookingStatusResponse\x12B\n" +
+	"\aCheckIn\x12\x1a.booking.v1.CheckInRequest\x1a\x1b.booking.v1.CheckInResponse\x12E\n" +
+	"\bCheckOut\x12\x1b.booking.v1.CheckOutRequest\x1a\x1c.booking.v1.CheckOutResponse\x12K\n" +
+	"\n" +
+	"MarkNoShow\x12\x1d.booking.v1.MarkNoShowRequest\x1a\x1e.booking.v1.MarkNoShowResponse\x12f\n" +
+	"\x13PreviewCancellation\x12&.booking.v1.PreviewCancellationRequest\x1a'.booking.v1.PreviewCancellationResponse\x12T\n" +
+	"\rDeleteBooking\x12 .booking.v1.DeleteBookingRequest\x1a!.booking.v1.DeleteBookingResponse\x12`\n" +
+	"\x11GetActiveBookings\x12$.booking.v1.GetActiveBookingsRequest\x1a%.booking.v1.GetActiveBookingsResponse\x12i\n" +
+	"\x14CancelActiveBookings\x12'.booking.v1.CancelActiveBookingsRequest\x1a(.booking.v1.CancelActiveBookingsResponse\x12f\n" +
+	"\x13ReassignBookingRoom\x12&.booking.v1.ReassignBookingRoomRequest\x1a'.booking.v1.ReassignBookingRoomResponse\x12`\n" +
+	"\x11GetBookingHistory\x12$.booking.v1.GetBookingHistoryRequest\x1a%.booking.v1.GetBookingHistoryResponse\x12c\n" +
+	"\x12UpdateBookingGuest\x12%.booking.v1.UpdateBookingGuestRequest\x1a&.booking.v1.UpdateBookingGuestResponse\x12c\n" +
+	"\x12ChangeBookingDates\x12%.booking.v1.ChangeBookingDatesRequest\x1a&.booking.v1.ChangeBookingDatesResponse\x12W\n" +
+	"\x0eAddBookingRoom\x12!.booking.v1.AddBookingRoomRequest\x1a\".booking.v1.AddBookingRoomResponse\x12`\n" +
+	"\x11RemoveBookingRoom\x12$.booking.v1.RemoveBookingRoomRequest\x1a%.booking.v1.RemoveBookingRoomResponse\x12r\n" +
+	"\x17UpdateBookingRoomGuests\x12*.booking.v1.UpdateBookingRoomGuestsRequest\x1a+.booking.v1.UpdateBookingRoomGuestsResponse2\x8f\x03\n" +
+	"\x17RoomAvailabilityService\x12H\n" +
+	"\tBlockRoom\x12\x1c.booking.v1.BlockRoomRequest\x1a\x1d.booking.v1.BlockRoomResponse\x12N\n" +
+	"\vUnblockRoom\x12\x1e.booking.v1.UnblockRoomRequest\x1a\x1f.booking.v1.UnblockRoomResponse\x12f\n" +
+	"\x13GetRoomAvailability\x12&.booking.v1.GetRoomAvailabilityRequest\x1a'.booking.v1.GetRoomAvailabilityResponse\x12r\n" +
+	"\x17GetCategoryAvailability\x12*.booking.v1.GetCategoryAvailabilityRequest\x1a+.booking.v1.GetCategoryAvailabilityResponse2\xfa\x02\n" +
+	"\x0ePaymentService\x12T\n" +
+	"\rCreatePayment\x12 .booking.v1.CreatePaymentRequest\x1a!.booking.v1.CreatePaymentResponse\x12W\n" +
+	"\x0eCapturePayment\x12!.booking.v1.CapturePaymentRequest\x1a\".booking.v1.CapturePaymentResponse\x12T\n" +
+	"\rRefundPayment\x12 .booking.v1.RefundPaymentRequest\x1a!.booking.v1.RefundPaymentResponse\x12c\n" +
+	"\x12GetBookingPayments\x12%.booking.v1.GetBookingPaymentsRequest\x1a&.booking.v1.GetBookingPaymentsResponseB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
 
 var file_booking_v1_booking_service_proto_goTypes = []any{
-	(*CreateBookingRequest)(nil),         // 0: booking.v1.CreateBookingRequest
-	(*GetBookingsRequest)(nil),           // 1: booking.v1.GetBookingsRequest
-	(*GetBookingRequest)(nil),            // 2: booking.v1.GetBookingRequest
-	(*ConfirmBookingStatusRequest)(nil),  // 3: booking.v1.ConfirmBookingStatusRequest
-	(*CancelBookingStatusRequest)(nil),   // 4: booking.v1.CancelBookingStatusRequest
-	(*DeleteBookingRequest)(nil),         // 5: booking.v1.DeleteBookingRequest
-	(*CreateBookingResponse)(nil),        // 6: booking.v1.CreateBookingResponse
-	(*GetBookingsResponse)(nil),          // 7: booking.v1.GetBookingsResponse
-	(*GetBookingResponse)(nil),           // 8: booking.v1.GetBookingResponse
-	(*ConfirmBookingStatusResponse)(nil), // 9: booking.v1.ConfirmBookingStatusResponse
-	(*CancelBookingStatusResponse)(nil),  // 10: booking.v1.CancelBookingStatusResponse
-	(*DeleteBookingResponse)(nil),        // 11: booking.v1.DeleteBookingResponse
+	(*CreateBookingRequest)(nil),            // 0: booking.v1.CreateBookingRequest
+	(*GetBookingsRequest)(nil),              // 1: booking.v1.GetBookingsRequest
+	(*GetBookingRequest)(nil),               // 2: booking.v1.GetBookingRequest
+	(*FindBookingByCodeRequest)(nil),        // 3: booking.v1.FindBookingByCodeRequest
+	(*ConfirmBookingStatusRequest)(nil),     // 4: booking.v1.ConfirmBookingStatusRequest
+	(*CancelBookingStatusRequest)(nil),      // 5: booking.v1.CancelBookingStatusRequest
+	(*CheckInRequest)(nil),                  // 6: booking.v1.CheckInRequest
+	(*CheckOutRequest)(nil),                 // 7: booking.v1.CheckOutRequest
+	(*MarkNoShowRequest)(nil),               // 8: booking.v1.MarkNoShowRequest
+	(*PreviewCancellationRequest)(nil),      // 9: booking.v1.PreviewCancellationRequest
+	(*DeleteBookingRequest)(nil),            // 10: booking.v1.DeleteBookingRequest
+	(*GetActiveBookingsRequest)(nil),        // 11: booking.v1.GetActiveBookingsRequest
+	(*CancelActiveBookingsRequest)(nil),     // 12: booking.v1.CancelActiveBookingsRequest
+	(*ReassignBookingRoomRequest)(nil),      // 13: booking.v1.ReassignBookingRoomRequest
+	(*GetBookingHistoryRequest)(nil),        // 14: booking.v1.GetBookingHistoryRequest
+	(*UpdateBookingGuestRequest)(nil),       // 15: booking.v1.UpdateBookingGuestRequest
+	(*ChangeBookingDatesRequest)(nil),       // 16: booking.v1.ChangeBookingDatesRequest
+	(*AddBookingRoomRequest)(nil),           // 17: booking.v1.AddBookingRoomRequest
+	(*RemoveBookingRoomRequest)(nil),        // 18: booking.v1.RemoveBookingRoomRequest
+	(*UpdateBookingRoomGuestsRequest)(nil),  // 19: booking.v1.UpdateBookingRoomGuestsRequest
+	(*BlockRoomRequest)(nil),                // 20: booking.v1.BlockRoomRequest
+	(*UnblockRoomRequest)(nil),              // 21: booking.v1.UnblockRoomRequest
+	(*GetRoomAvailabilityRequest)(nil),      // 22: booking.v1.GetRoomAvailabilityRequest
+	(*GetCategoryAvailabilityRequest)(nil),  // 23: booking.v1.GetCategoryAvailabilityRequest
+	(*CreatePaymentRequest)(nil),            // 24: booking.v1.CreatePaymentRequest
+	(*CapturePaymentRequest)(nil),           // 25: booking.v1.CapturePaymentRequest
+	(*RefundPaymentRequest)(nil),            // 26: booking.v1.RefundPaymentRequest
+	(*GetBookingPaymentsRequest)(nil),       // 27: booking.v1.GetBookingPaymentsRequest
+	(*CreateBookingResponse)(nil),           // 28: booking.v1.CreateBookingResponse
+	(*GetBookingsResponse)(nil),             // 29: booking.v1.GetBookingsResponse
+	(*GetBookingResponse)(nil),              // 30: booking.v1.GetBookingResponse
+	(*FindBookingByCodeResponse)(nil),       // 31: booking.v1.FindBookingByCodeResponse
+	(*ConfirmBookingStatusResponse)(nil),    // 32: booking.v1.ConfirmBookingStatusResponse
+	(*CancelBookingStatusResponse)(nil),     // 33: booking.v1.CancelBookingStatusResponse
+	(*CheckInResponse)(nil),                 // 34: booking.v1.CheckInResponse
+	(*CheckOutResponse)(nil),                // 35: booking.v1.CheckOutResponse
+	(*MarkNoShowResponse)(nil),              // 36: booking.v1.MarkNoShowResponse
+	(*PreviewCancellationResponse)(nil),     // 37: booking.v1.PreviewCancellationResponse
+	(*DeleteBookingResponse)(nil),           // 38: booking.v1.DeleteBookingResponse
+	(*GetActiveBookingsResponse)(nil),       // 39: booking.v1.GetActiveBookingsResponse
+	(*CancelActiveBookingsResponse)(nil),    // 40: booking.v1.CancelActiveBookingsResponse
+	(*ReassignBookingRoomResponse)(nil),     // 41: booking.v1.ReassignBookingRoomResponse
+	(*GetBookingHistoryResponse)(nil),       // 42: booking.v1.GetBookingHistoryResponse
+	(*UpdateBookingGuestResponse)(nil),      // 43: booking.v1.UpdateBookingGuestResponse
+	(*ChangeBookingDatesResponse)(nil),      // 44: booking.v1.ChangeBookingDatesResponse
+	(*AddBookingRoomResponse)(nil),          // 45: booking.v1.AddBookingRoomResponse
+	(*RemoveBookingRoomResponse)(nil),       // 46: booking.v1.RemoveBookingRoomResponse
+	(*UpdateBookingRoomGuestsResponse)(nil), // 47: booking.v1.UpdateBookingRoomGuestsResponse
+	(*BlockRoomResponse)(nil),               // 48: booking.v1.BlockRoomResponse
+	(*UnblockRoomResponse)(nil),             // 49: booking.v1.UnblockRoomResponse
+	(*GetRoomAvailabilityResponse)(nil),     // 50: booking.v1.GetRoomAvailabilityResponse
+	(*GetCategoryAvailabilityResponse)(nil), // 51: booking.v1.GetCategoryAvailabilityResponse
+	(*CreatePaymentResponse)(nil),           // 52: booking.v1.CreatePaymentResponse
+	(*CapturePaymentResponse)(nil),          // 53: booking.v1.CapturePaymentResponse
+	(*RefundPaymentResponse)(nil),           // 54: booking.v1.RefundPaymentResponse
+	(*GetBookingPaymentsResponse)(nil),      // 55: booking.v1.GetBookingPaymentsResponse
 }
 var file_booking_v1_booking_service_proto_depIdxs = []int32{
 	0,  // 0: booking.v1.BookingService.CreateBooking:input_type -> booking.v1.CreateBookingRequest
 	1,  // 1: booking.v1.BookingService.GetBookings:input_type -> booking.v1.GetBookingsRequest
 	2,  // 2: booking.v1.BookingService.GetBooking:input_type -> booking.v1.GetBookingRequest
-	3,  // 3: booking.v1.BookingService.ConfirmBookingStatus:input_type -> booking.v1.ConfirmBookingStatusRequest
-	4,  // 4: booking.v1.BookingService.CancelBookingStatus:input_type -> booking.v1.CancelBookingStatusRequest
-	5,  // 5: booking.v1.BookingService.DeleteBooking:input_type -> booking.v1.DeleteBookingRequest
-	6,  // 6: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingResponse
-	7,  // 7: booking.v1.BookingService.GetBookings:output_type -> booking.v1.GetBookingsResponse
-	8,  // 8: booking.v1.BookingService.GetBooking:output_type -> booking.v1.GetBookingResponse
-	9,  // 9: booking.v1.BookingService.ConfirmBookingStatus:output_type -> booking.v1.ConfirmBookingStatusResponse
-	10, // 10: booking.v1.BookingService.CancelBookingStatus:output_type -> booking.v1.CancelBookingStatusResponse
-	11, // 11: booking.v1.BookingService.DeleteBooking:output_type -> booking.v1.DeleteBookingResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
+	3,  // 3: booking.v1.BookingService.FindBookingByCode:input_type -> booking.v1.FindBookingByCodeRequest
+	4,  // 4: booking.v1.BookingService.ConfirmBookingStatus:input_type -> booking.v1.ConfirmBookingStatusRequest
+	5,  // 5: booking.v1.BookingService.CancelBookingStatus:input_type -> booking.v1.CancelBookingStatusRequest
+	6,  // 6: booking.v1.BookingService.CheckIn:input_type -> booking.v1.CheckInRequest
+	7,  // 7: booking.v1.BookingService.CheckOut:input_type -> booking.v1.CheckOutRequest
+	8,  // 8: booking.v1.BookingService.MarkNoShow:input_type -> booking.v1.MarkNoShowRequest
+	9,  // 9: booking.v1.BookingService.PreviewCancellation:input_type -> booking.v1.PreviewCancellationRequest
+	10, // 10: booking.v1.BookingService.DeleteBooking:input_type -> booking.v1.DeleteBookingRequest
+	11, // 11: booking.v1.BookingService.GetActiveBookings:input_type -> booking.v1.GetActiveBookingsRequest
+	12, // 12: booking.v1.BookingService.CancelActiveBookings:input_type -> booking.v1.CancelActiveBookingsRequest
+	13, // 13: booking.v1.BookingService.ReassignBookingRoom:input_type -> booking.v1.ReassignBookingRoomRequest
+	14, // 14: booking.v1.BookingService.GetBookingHistory:input_type -> booking.v1.GetBookingHistoryRequest
+	15, // 15: booking.v1.BookingService.UpdateBookingGuest:input_type -> booking.v1.UpdateBookingGuestRequest
+	16, // 16: booking.v1.BookingService.ChangeBookingDates:input_type -> booking.v1.ChangeBookingDatesRequest
+	17, // 17: booking.v1.BookingService.AddBookingRoom:input_type -> booking.v1.AddBookingRoomRequest
+	18, // 18: booking.v1.BookingService.RemoveBookingRoom:input_type -> booking.v1.RemoveBookingRoomRequest
+	19, // 19: booking.v1.BookingService.UpdateBookingRoomGuests:input_type -> booking.v1.UpdateBookingRoomGuestsRequest
+	20, // 20: booking.v1.RoomAvailabilityService.BlockRoom:input_type -> booking.v1.BlockRoomRequest
+	21, // 21: booking.v1.RoomAvailabilityService.UnblockRoom:input_type -> booking.v1.UnblockRoomRequest
+	22, // 22: booking.v1.RoomAvailabilityService.GetRoomAvailability:input_type -> booking.v1.GetRoomAvailabilityRequest
+	23, // 23: booking.v1.RoomAvailabilityService.GetCategoryAvailability:input_type -> booking.v1.GetCategoryAvailabilityRequest
+	24, // 24: booking.v1.PaymentService.CreatePayment:input_type -> booking.v1.CreatePaymentRequest
+	25, // 25: booking.v1.PaymentService.CapturePayment:input_type -> booking.v1.CapturePaymentRequest
+	26, // 26: booking.v1.PaymentService.RefundPayment:input_type -> booking.v1.RefundPaymentRequest
+	27, // 27: booking.v1.PaymentService.GetBookingPayments:input_type -> booking.v1.GetBookingPaymentsRequest
+	28, // 28: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingResponse
+	29, // 29: booking.v1.BookingService.GetBookings:output_type -> booking.v1.GetBookingsResponse
+	30, // 30: booking.v1.BookingService.GetBooking:output_type -> booking.v1.GetBookingResponse
+	31, // 31: booking.v1.BookingService.FindBookingByCode:output_type -> booking.v1.FindBookingByCodeResponse
+	32, // 32: booking.v1.BookingService.ConfirmBookingStatus:output_type -> booking.v1.ConfirmBookingStatusResponse
+	33, // 33: booking.v1.BookingService.CancelBookingStatus:output_type -> booking.v1.CancelBookingStatusResponse
+	34, // 34: booking.v1.BookingService.CheckIn:output_type -> booking.v1.CheckInResponse
+	35, // 35: booking.v1.BookingService.CheckOut:output_type -> booking.v1.CheckOutResponse
+	36, // 36: booking.v1.BookingService.MarkNoShow:output_type -> booking.v1.MarkNoShowResponse
+	37, // 37: booking.v1.BookingService.PreviewCancellation:output_type -> booking.v1.PreviewCancellationResponse
+	38, // 38: booking.v1.BookingService.DeleteBooking:output_type -> booking.v1.DeleteBookingResponse
+	39, // 39: booking.v1.BookingService.GetActiveBookings:output_type -> booking.v1.GetActiveBookingsResponse
+	40, // 40: booking.v1.BookingService.CancelActiveBookings:output_type -> booking.v1.CancelActiveBookingsResponse
+	41, // 41: booking.v1.BookingService.ReassignBookingRoom:output_type -> booking.v1.ReassignBookingRoomResponse
+	42, // 42: booking.v1.BookingService.GetBookingHistory:output_type -> booking.v1.GetBookingHistoryResponse
+	43, // 43: booking.v1.BookingService.UpdateBookingGuest:output_type -> booking.v1.UpdateBookingGuestResponse
+	44, // 44: booking.v1.BookingService.ChangeBookingDates:output_type -> booking.v1.ChangeBookingDatesResponse
+	45, // 45: booking.v1.BookingService.AddBookingRoom:output_type -> booking.v1.AddBookingRoomResponse
+	46, // 46: booking.v1.BookingService.RemoveBookingRoom:output_type -> booking.v1.RemoveBookingRoomResponse
+	47, // 47: booking.v1.BookingService.UpdateBookingRoomGuests:output_type -> booking.v1.UpdateBookingRoomGuestsResponse
+	48, // 48: booking.v1.RoomAvailabilityService.BlockRoom:output_type -> booking.v1.BlockRoomResponse
+	49, // 49: booking.v1.RoomAvailabilityService.UnblockRoom:output_type -> booking.v1.UnblockRoomResponse
+	50, // 50: booking.v1.RoomAvailabilityService.GetRoomAvailability:output_type -> booking.v1.GetRoomAvailabilityResponse
+	51, // 51: booking.v1.RoomAvailabilityService.GetCategoryAvailability:output_type -> booking.v1.GetCategoryAvailabilityResponse
+	52, // 52: booking.v1.PaymentService.CreatePayment:output_type -> booking.v1.CreatePaymentResponse
+	53, // 53: booking.v1.PaymentService.CapturePayment:output_type -> booking.v1.CapturePaymentResponse
+	54, // 54: booking.v1.PaymentService.RefundPayment:output_type -> booking.v1.RefundPaymentResponse
+	55, // 55: booking.v1.PaymentService.GetBookingPayments:output_type -> booking.v1.GetBookingPaymentsResponse
+	28, // [28:56] is the sub-list for method output_type
+	0,  // [0:28] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -78,9 +191,31 @@ func file_booking_v1_booking_service_proto_init() {
 	file_booking_v1_rpc_create_booking_proto_init()
 	file_booking_v1_rpc_get_bookings_proto_init()
 	file_booking_v1_rpc_get_booking_proto_init()
+	file_booking_v1_rpc_find_booking_by_code_proto_init()
 	file_booking_v1_rpc_confirm_booking_status_proto_init()
 	file_booking_v1_rpc_cancel_booking_status_proto_init()
+	file_booking_v1_rpc_check_in_proto_init()
+	file_booking_v1_rpc_check_out_proto_init()
+	file_booking_v1_rpc_mark_no_show_proto_init()
 	file_booking_v1_rpc_delete_booking_proto_init()
+	file_booking_v1_rpc_block_room_proto_init()
+	file_booking_v1_rpc_unblock_room_proto_init()
+	file_booking_v1_rpc_get_room_availability_proto_init()
+	file_booking_v1_rpc_get_active_bookings_proto_init()
+	file_booking_v1_rpc_cancel_active_bookings_proto_init()
+	file_booking_v1_rpc_reassign_booking_room_proto_init()
+	file_booking_v1_rpc_get_category_availability_proto_init()
+	file_booking_v1_rpc_get_booking_history_proto_init()
+	file_booking_v1_rpc_update_booking_guest_proto_init()
+	file_booking_v1_rpc_change_booking_dates_proto_init()
+	file_booking_v1_rpc_add_booking_room_proto_init()
+	file_booking_v1_rpc_remove_booking_room_proto_init()
+	file_booking_v1_rpc_update_booking_room_guests_proto_init()
+	file_booking_v1_rpc_preview_cancellation_proto_init()
+	file_booking_v1_rpc_create_payment_proto_init()
+	file_booking_v1_rpc_capture_payment_proto_init()
+	file_booking_v1_rpc_refund_payment_proto_init()
+	file_booking_v1_rpc_get_booking_payments_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -89,7 +224,7 @@ func file_booking_v1_booking_service_proto_init() {
 			NumEnums:      0,
 			NumMessages:   0,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_booking_v1_booking_service_proto_goTypes,
 		DependencyIndexes: file_booking_v1_booking_service_proto_depIdxs,
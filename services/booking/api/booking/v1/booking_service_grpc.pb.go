@@ -19,12 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_CreateBooking_FullMethodName        = "/booking.v1.BookingService/CreateBooking"
-	BookingService_GetBookings_FullMethodName          = "/booking.v1.BookingService/GetBookings"
-	BookingService_GetBooking_FullMethodName           = "/booking.v1.BookingService/GetBooking"
-	BookingService_ConfirmBookingStatus_FullMethodName = "/booking.v1.BookingService/ConfirmBookingStatus"
-	BookingService_CancelBookingStatus_FullMethodName  = "/booking.v1.BookingService/CancelBookingStatus"
-	BookingService_DeleteBooking_FullMethodName        = "/booking.v1.BookingService/DeleteBooking"
+	BookingService_CreateBooking_FullMethodName           = "/booking.v1.BookingService/CreateBooking"
+	BookingService_GetBookings_FullMethodName             = "/booking.v1.BookingService/GetBookings"
+	BookingService_GetBooking_FullMethodName              = "/booking.v1.BookingService/GetBooking"
+	BookingService_FindBookingByCode_FullMethodName       = "/booking.v1.BookingService/FindBookingByCode"
+	BookingService_ConfirmBookingStatus_FullMethodName    = "/booking.v1.BookingService/ConfirmBookingStatus"
+	BookingService_CancelBookingStatus_FullMethodName     = "/booking.v1.BookingService/CancelBookingStatus"
+	BookingService_CheckIn_FullMethodName                 = "/booking.v1.BookingService/CheckIn"
+	BookingService_CheckOut_FullMethodName                = "/booking.v1.BookingService/CheckOut"
+	BookingService_MarkNoShow_FullMethodName              = "/booking.v1.BookingService/MarkNoShow"
+	BookingService_PreviewCancellation_FullMethodName     = "/booking.v1.BookingService/PreviewCancellation"
+	BookingService_DeleteBooking_FullMethodName           = "/booking.v1.BookingService/DeleteBooking"
+	BookingService_GetActiveBookings_FullMethodName       = "/booking.v1.BookingService/GetActiveBookings"
+	BookingService_CancelActiveBookings_FullMethodName    = "/booking.v1.BookingService/CancelActiveBookings"
+	BookingService_ReassignBookingRoom_FullMethodName     = "/booking.v1.BookingService/ReassignBookingRoom"
+	BookingService_GetBookingHistory_FullMethodName       = "/booking.v1.BookingService/GetBookingHistory"
+	BookingService_UpdateBookingGuest_FullMethodName      = "/booking.v1.BookingService/UpdateBookingGuest"
+	BookingService_ChangeBookingDates_FullMethodName      = "/booking.v1.BookingService/ChangeBookingDates"
+	BookingService_AddBookingRoom_FullMethodName          = "/booking.v1.BookingService/AddBookingRoom"
+	BookingService_RemoveBookingRoom_FullMethodName       = "/booking.v1.BookingService/RemoveBookingRoom"
+	BookingService_UpdateBookingRoomGuests_FullMethodName = "/booking.v1.BookingService/UpdateBookingRoomGuests"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -34,9 +48,23 @@ type BookingServiceClient interface {
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error)
 	GetBookings(ctx context.Context, in *GetBookingsRequest, opts ...grpc.CallOption) (*GetBookingsResponse, error)
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error)
+	FindBookingByCode(ctx context.Context, in *FindBookingByCodeRequest, opts ...grpc.CallOption) (*FindBookingByCodeResponse, error)
 	ConfirmBookingStatus(ctx context.Context, in *ConfirmBookingStatusRequest, opts ...grpc.CallOption) (*ConfirmBookingStatusResponse, error)
 	CancelBookingStatus(ctx context.Context, in *CancelBookingStatusRequest, opts ...grpc.CallOption) (*CancelBookingStatusResponse, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+	CheckOut(ctx context.Context, in *CheckOutRequest, opts ...grpc.CallOption) (*CheckOutResponse, error)
+	MarkNoShow(ctx context.Context, in *MarkNoShowRequest, opts ...grpc.CallOption) (*MarkNoShowResponse, error)
+	PreviewCancellation(ctx context.Context, in *PreviewCancellationRequest, opts ...grpc.CallOption) (*PreviewCancellationResponse, error)
 	DeleteBooking(ctx context.Context, in *DeleteBookingRequest, opts ...grpc.CallOption) (*DeleteBookingResponse, error)
+	GetActiveBookings(ctx context.Context, in *GetActiveBookingsRequest, opts ...grpc.CallOption) (*GetActiveBookingsResponse, error)
+	CancelActiveBookings(ctx context.Context, in *CancelActiveBookingsRequest, opts ...grpc.CallOption) (*CancelActiveBookingsResponse, error)
+	ReassignBookingRoom(ctx context.Context, in *ReassignBookingRoomRequest, opts ...grpc.CallOption) (*ReassignBookingRoomResponse, error)
+	GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error)
+	UpdateBookingGuest(ctx context.Context, in *UpdateBookingGuestRequest, opts ...grpc.CallOption) (*UpdateBookingGuestResponse, error)
+	ChangeBookingDates(ctx context.Context, in *ChangeBookingDatesRequest, opts ...grpc.CallOption) (*ChangeBookingDatesResponse, error)
+	AddBookingRoom(ctx context.Context, in *AddBookingRoomRequest, opts ...grpc.CallOption) (*AddBookingRoomResponse, error)
+	RemoveBookingRoom(ctx context.Context, in *RemoveBookingRoomRequest, opts ...grpc.CallOption) (*RemoveBookingRoomResponse, error)
+	UpdateBookingRoomGuests(ctx context.Context, in *UpdateBookingRoomGuestsRequest, opts ...grpc.CallOption) (*UpdateBookingRoomGuestsResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -77,6 +105,16 @@ func (c *bookingServiceClient) GetBooking(ctx context.Context, in *GetBookingReq
 	return out, nil
 }
 
+func (c *bookingServiceClient) FindBookingByCode(ctx context.Context, in *FindBookingByCodeRequest, opts ...grpc.CallOption) (*FindBookingByCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindBookingByCodeResponse)
+	err := c.cc.Invoke(ctx, BookingService_FindBookingByCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) ConfirmBookingStatus(ctx context.Context, in *ConfirmBookingStatusRequest, opts ...grpc.CallOption) (*ConfirmBookingStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ConfirmBookingStatusResponse)
@@ -97,6 +135,46 @@ func (c *bookingServiceClient) CancelBookingStatus(ctx context.Context, in *Canc
 	return out, nil
 }
 
+func (c *bookingServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, BookingService_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CheckOut(ctx context.Context, in *CheckOutRequest, opts ...grpc.CallOption) (*CheckOutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckOutResponse)
+	err := c.cc.Invoke(ctx, BookingService_CheckOut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) MarkNoShow(ctx context.Context, in *MarkNoShowRequest, opts ...grpc.CallOption) (*MarkNoShowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkNoShowResponse)
+	err := c.cc.Invoke(ctx, BookingService_MarkNoShow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) PreviewCancellation(ctx context.Context, in *PreviewCancellationRequest, opts ...grpc.CallOption) (*PreviewCancellationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewCancellationResponse)
+	err := c.cc.Invoke(ctx, BookingService_PreviewCancellation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) DeleteBooking(ctx context.Context, in *DeleteBookingRequest, opts ...grpc.CallOption) (*DeleteBookingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteBookingResponse)
@@ -107,6 +185,96 @@ func (c *bookingServiceClient) DeleteBooking(ctx context.Context, in *DeleteBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) GetActiveBookings(ctx context.Context, in *GetActiveBookingsRequest, opts ...grpc.CallOption) (*GetActiveBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetActiveBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CancelActiveBookings(ctx context.Context, in *CancelActiveBookingsRequest, opts ...grpc.CallOption) (*CancelActiveBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelActiveBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_CancelActiveBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ReassignBookingRoom(ctx context.Context, in *ReassignBookingRoomRequest, opts ...grpc.CallOption) (*ReassignBookingRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignBookingRoomResponse)
+	err := c.cc.Invoke(ctx, BookingService_ReassignBookingRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingHistoryResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetBookingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateBookingGuest(ctx context.Context, in *UpdateBookingGuestRequest, opts ...grpc.CallOption) (*UpdateBookingGuestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBookingGuestResponse)
+	err := c.cc.Invoke(ctx, BookingService_UpdateBookingGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ChangeBookingDates(ctx context.Context, in *ChangeBookingDatesRequest, opts ...grpc.CallOption) (*ChangeBookingDatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeBookingDatesResponse)
+	err := c.cc.Invoke(ctx, BookingService_ChangeBookingDates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) AddBookingRoom(ctx context.Context, in *AddBookingRoomRequest, opts ...grpc.CallOption) (*AddBookingRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBookingRoomResponse)
+	err := c.cc.Invoke(ctx, BookingService_AddBookingRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RemoveBookingRoom(ctx context.Context, in *RemoveBookingRoomRequest, opts ...grpc.CallOption) (*RemoveBookingRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveBookingRoomResponse)
+	err := c.cc.Invoke(ctx, BookingService_RemoveBookingRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateBookingRoomGuests(ctx context.Context, in *UpdateBookingRoomGuestsRequest, opts ...grpc.CallOption) (*UpdateBookingRoomGuestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBookingRoomGuestsResponse)
+	err := c.cc.Invoke(ctx, BookingService_UpdateBookingRoomGuests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility.
@@ -114,9 +282,23 @@ type BookingServiceServer interface {
 	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
 	GetBookings(context.Context, *GetBookingsRequest) (*GetBookingsResponse, error)
 	GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error)
+	FindBookingByCode(context.Context, *FindBookingByCodeRequest) (*FindBookingByCodeResponse, error)
 	ConfirmBookingStatus(context.Context, *ConfirmBookingStatusRequest) (*ConfirmBookingStatusResponse, error)
 	CancelBookingStatus(context.Context, *CancelBookingStatusRequest) (*CancelBookingStatusResponse, error)
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+	CheckOut(context.Context, *CheckOutRequest) (*CheckOutResponse, error)
+	MarkNoShow(context.Context, *MarkNoShowRequest) (*MarkNoShowResponse, error)
+	PreviewCancellation(context.Context, *PreviewCancellationRequest) (*PreviewCancellationResponse, error)
 	DeleteBooking(context.Context, *DeleteBookingRequest) (*DeleteBookingResponse, error)
+	GetActiveBookings(context.Context, *GetActiveBookingsRequest) (*GetActiveBookingsResponse, error)
+	CancelActiveBookings(context.Context, *CancelActiveBookingsRequest) (*CancelActiveBookingsResponse, error)
+	ReassignBookingRoom(context.Context, *ReassignBookingRoomRequest) (*ReassignBookingRoomResponse, error)
+	GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryResponse, error)
+	UpdateBookingGuest(context.Context, *UpdateBookingGuestRequest) (*UpdateBookingGuestResponse, error)
+	ChangeBookingDates(context.Context, *ChangeBookingDatesRequest) (*ChangeBookingDatesResponse, error)
+	AddBookingRoom(context.Context, *AddBookingRoomRequest) (*AddBookingRoomResponse, error)
+	RemoveBookingRoom(context.Context, *RemoveBookingRoomRequest) (*RemoveBookingRoomResponse, error)
+	UpdateBookingRoomGuests(context.Context, *UpdateBookingRoomGuestsRequest) (*UpdateBookingRoomGuestsResponse, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -136,15 +318,57 @@ func (UnimplementedBookingServiceServer) GetBookings(context.Context, *GetBookin
 func (UnimplementedBookingServiceServer) GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) FindBookingByCode(context.Context, *FindBookingByCodeRequest) (*FindBookingByCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindBookingByCode not implemented")
+}
 func (UnimplementedBookingServiceServer) ConfirmBookingStatus(context.Context, *ConfirmBookingStatusRequest) (*ConfirmBookingStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ConfirmBookingStatus not implemented")
 }
 func (UnimplementedBookingServiceServer) CancelBookingStatus(context.Context, *CancelBookingStatusRequest) (*CancelBookingStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelBookingStatus not implemented")
 }
+func (UnimplementedBookingServiceServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedBookingServiceServer) CheckOut(context.Context, *CheckOutRequest) (*CheckOutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckOut not implemented")
+}
+func (UnimplementedBookingServiceServer) MarkNoShow(context.Context, *MarkNoShowRequest) (*MarkNoShowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkNoShow not implemented")
+}
+func (UnimplementedBookingServiceServer) PreviewCancellation(context.Context, *PreviewCancellationRequest) (*PreviewCancellationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewCancellation not implemented")
+}
 func (UnimplementedBookingServiceServer) DeleteBooking(context.Context, *DeleteBookingRequest) (*DeleteBookingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) GetActiveBookings(context.Context, *GetActiveBookingsRequest) (*GetActiveBookingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActiveBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelActiveBookings(context.Context, *CancelActiveBookingsRequest) (*CancelActiveBookingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelActiveBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) ReassignBookingRoom(context.Context, *ReassignBookingRoomRequest) (*ReassignBookingRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignBookingRoom not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBookingHistory not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateBookingGuest(context.Context, *UpdateBookingGuestRequest) (*UpdateBookingGuestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateBookingGuest not implemented")
+}
+func (UnimplementedBookingServiceServer) ChangeBookingDates(context.Context, *ChangeBookingDatesRequest) (*ChangeBookingDatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeBookingDates not implemented")
+}
+func (UnimplementedBookingServiceServer) AddBookingRoom(context.Context, *AddBookingRoomRequest) (*AddBookingRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddBookingRoom not implemented")
+}
+func (UnimplementedBookingServiceServer) RemoveBookingRoom(context.Context, *RemoveBookingRoomRequest) (*RemoveBookingRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveBookingRoom not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateBookingRoomGuests(context.Context, *UpdateBookingRoomGuestsRequest) (*UpdateBookingRoomGuestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateBookingRoomGuests not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
@@ -220,6 +444,24 @@ func _BookingService_GetBooking_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_FindBookingByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindBookingByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).FindBookingByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_FindBookingByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).FindBookingByCode(ctx, req.(*FindBookingByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_ConfirmBookingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConfirmBookingStatusRequest)
 	if err := dec(in); err != nil {
@@ -256,6 +498,78 @@ func _BookingService_CancelBookingStatus_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CheckOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CheckOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CheckOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CheckOut(ctx, req.(*CheckOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_MarkNoShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNoShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).MarkNoShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_MarkNoShow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).MarkNoShow(ctx, req.(*MarkNoShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_PreviewCancellation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCancellationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).PreviewCancellation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_PreviewCancellation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).PreviewCancellation(ctx, req.(*PreviewCancellationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_DeleteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteBookingRequest)
 	if err := dec(in); err != nil {
@@ -274,36 +588,687 @@ func _BookingService_DeleteBooking_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var BookingService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "booking.v1.BookingService",
-	HandlerType: (*BookingServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateBooking",
-			Handler:    _BookingService_CreateBooking_Handler,
-		},
-		{
-			MethodName: "GetBookings",
-			Handler:    _BookingService_GetBookings_Handler,
-		},
-		{
-			MethodName: "GetBooking",
-			Handler:    _BookingService_GetBooking_Handler,
-		},
-		{
-			MethodName: "ConfirmBookingStatus",
-			Handler:    _BookingService_ConfirmBookingStatus_Handler,
-		},
-		{
-			MethodName: "CancelBookingStatus",
-			Handler:    _BookingService_CancelBookingStatus_Handler,
-		},
-		{
-			MethodName: "DeleteBooking",
-			Handler:    _BookingService_DeleteBooking_Handler,
+func _BookingService_GetActiveBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetActiveBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetActiveBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetActiveBookings(ctx, req.(*GetActiveBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelActiveBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelActiveBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelActiveBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelActiveBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelActiveBookings(ctx, req.(*CancelActiveBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ReassignBookingRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignBookingRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ReassignBookingRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ReassignBookingRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ReassignBookingRoom(ctx, req.(*ReassignBookingRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, req.(*GetBookingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateBookingGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookingGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateBookingGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateBookingGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateBookingGuest(ctx, req.(*UpdateBookingGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ChangeBookingDates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeBookingDatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ChangeBookingDates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ChangeBookingDates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ChangeBookingDates(ctx, req.(*ChangeBookingDatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_AddBookingRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookingRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).AddBookingRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_AddBookingRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).AddBookingRoom(ctx, req.(*AddBookingRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RemoveBookingRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookingRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RemoveBookingRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RemoveBookingRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RemoveBookingRoom(ctx, req.(*RemoveBookingRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateBookingRoomGuests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookingRoomGuestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateBookingRoomGuests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateBookingRoomGuests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateBookingRoomGuests(ctx, req.(*UpdateBookingRoomGuestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "booking.v1.BookingService",
+	HandlerType: (*BookingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBooking",
+			Handler:    _BookingService_CreateBooking_Handler,
+		},
+		{
+			MethodName: "GetBookings",
+			Handler:    _BookingService_GetBookings_Handler,
+		},
+		{
+			MethodName: "GetBooking",
+			Handler:    _BookingService_GetBooking_Handler,
+		},
+		{
+			MethodName: "FindBookingByCode",
+			Handler:    _BookingService_FindBookingByCode_Handler,
+		},
+		{
+			MethodName: "ConfirmBookingStatus",
+			Handler:    _BookingService_ConfirmBookingStatus_Handler,
+		},
+		{
+			MethodName: "CancelBookingStatus",
+			Handler:    _BookingService_CancelBookingStatus_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _BookingService_CheckIn_Handler,
+		},
+		{
+			MethodName: "CheckOut",
+			Handler:    _BookingService_CheckOut_Handler,
+		},
+		{
+			MethodName: "MarkNoShow",
+			Handler:    _BookingService_MarkNoShow_Handler,
+		},
+		{
+			MethodName: "PreviewCancellation",
+			Handler:    _BookingService_PreviewCancellation_Handler,
+		},
+		{
+			MethodName: "DeleteBooking",
+			Handler:    _BookingService_DeleteBooking_Handler,
+		},
+		{
+			MethodName: "GetActiveBookings",
+			Handler:    _BookingService_GetActiveBookings_Handler,
+		},
+		{
+			MethodName: "CancelActiveBookings",
+			Handler:    _BookingService_CancelActiveBookings_Handler,
+		},
+		{
+			MethodName: "ReassignBookingRoom",
+			Handler:    _BookingService_ReassignBookingRoom_Handler,
+		},
+		{
+			MethodName: "GetBookingHistory",
+			Handler:    _BookingService_GetBookingHistory_Handler,
+		},
+		{
+			MethodName: "UpdateBookingGuest",
+			Handler:    _BookingService_UpdateBookingGuest_Handler,
+		},
+		{
+			MethodName: "ChangeBookingDates",
+			Handler:    _BookingService_ChangeBookingDates_Handler,
+		},
+		{
+			MethodName: "AddBookingRoom",
+			Handler:    _BookingService_AddBookingRoom_Handler,
+		},
+		{
+			MethodName: "RemoveBookingRoom",
+			Handler:    _BookingService_RemoveBookingRoom_Handler,
+		},
+		{
+			MethodName: "UpdateBookingRoomGuests",
+			Handler:    _BookingService_UpdateBookingRoomGuests_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking/v1/booking_service.proto",
+}
+
+const (
+	RoomAvailabilityService_BlockRoom_FullMethodName               = "/booking.v1.RoomAvailabilityService/BlockRoom"
+	RoomAvailabilityService_UnblockRoom_FullMethodName             = "/booking.v1.RoomAvailabilityService/UnblockRoom"
+	RoomAvailabilityService_GetRoomAvailability_FullMethodName     = "/booking.v1.RoomAvailabilityService/GetRoomAvailability"
+	RoomAvailabilityService_GetCategoryAvailability_FullMethodName = "/booking.v1.RoomAvailabilityService/GetCategoryAvailability"
+)
+
+// RoomAvailabilityServiceClient is the client API for RoomAvailabilityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoomAvailabilityServiceClient interface {
+	BlockRoom(ctx context.Context, in *BlockRoomRequest, opts ...grpc.CallOption) (*BlockRoomResponse, error)
+	UnblockRoom(ctx context.Context, in *UnblockRoomRequest, opts ...grpc.CallOption) (*UnblockRoomResponse, error)
+	GetRoomAvailability(ctx context.Context, in *GetRoomAvailabilityRequest, opts ...grpc.CallOption) (*GetRoomAvailabilityResponse, error)
+	GetCategoryAvailability(ctx context.Context, in *GetCategoryAvailabilityRequest, opts ...grpc.CallOption) (*GetCategoryAvailabilityResponse, error)
+}
+
+type roomAvailabilityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoomAvailabilityServiceClient(cc grpc.ClientConnInterface) RoomAvailabilityServiceClient {
+	return &roomAvailabilityServiceClient{cc}
+}
+
+func (c *roomAvailabilityServiceClient) BlockRoom(ctx context.Context, in *BlockRoomRequest, opts ...grpc.CallOption) (*BlockRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockRoomResponse)
+	err := c.cc.Invoke(ctx, RoomAvailabilityService_BlockRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomAvailabilityServiceClient) UnblockRoom(ctx context.Context, in *UnblockRoomRequest, opts ...grpc.CallOption) (*UnblockRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockRoomResponse)
+	err := c.cc.Invoke(ctx, RoomAvailabilityService_UnblockRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomAvailabilityServiceClient) GetRoomAvailability(ctx context.Context, in *GetRoomAvailabilityRequest, opts ...grpc.CallOption) (*GetRoomAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoomAvailabilityResponse)
+	err := c.cc.Invoke(ctx, RoomAvailabilityService_GetRoomAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomAvailabilityServiceClient) GetCategoryAvailability(ctx context.Context, in *GetCategoryAvailabilityRequest, opts ...grpc.CallOption) (*GetCategoryAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryAvailabilityResponse)
+	err := c.cc.Invoke(ctx, RoomAvailabilityService_GetCategoryAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoomAvailabilityServiceServer is the server API for RoomAvailabilityService service.
+// All implementations must embed UnimplementedRoomAvailabilityServiceServer
+// for forward compatibility.
+type RoomAvailabilityServiceServer interface {
+	BlockRoom(context.Context, *BlockRoomRequest) (*BlockRoomResponse, error)
+	UnblockRoom(context.Context, *UnblockRoomRequest) (*UnblockRoomResponse, error)
+	GetRoomAvailability(context.Context, *GetRoomAvailabilityRequest) (*GetRoomAvailabilityResponse, error)
+	GetCategoryAvailability(context.Context, *GetCategoryAvailabilityRequest) (*GetCategoryAvailabilityResponse, error)
+	mustEmbedUnimplementedRoomAvailabilityServiceServer()
+}
+
+// UnimplementedRoomAvailabilityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRoomAvailabilityServiceServer struct{}
+
+func (UnimplementedRoomAvailabilityServiceServer) BlockRoom(context.Context, *BlockRoomRequest) (*BlockRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BlockRoom not implemented")
+}
+func (UnimplementedRoomAvailabilityServiceServer) UnblockRoom(context.Context, *UnblockRoomRequest) (*UnblockRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnblockRoom not implemented")
+}
+func (UnimplementedRoomAvailabilityServiceServer) GetRoomAvailability(context.Context, *GetRoomAvailabilityRequest) (*GetRoomAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRoomAvailability not implemented")
+}
+func (UnimplementedRoomAvailabilityServiceServer) GetCategoryAvailability(context.Context, *GetCategoryAvailabilityRequest) (*GetCategoryAvailabilityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryAvailability not implemented")
+}
+func (UnimplementedRoomAvailabilityServiceServer) mustEmbedUnimplementedRoomAvailabilityServiceServer() {
+}
+func (UnimplementedRoomAvailabilityServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRoomAvailabilityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoomAvailabilityServiceServer will
+// result in compilation errors.
+type UnsafeRoomAvailabilityServiceServer interface {
+	mustEmbedUnimplementedRoomAvailabilityServiceServer()
+}
+
+func RegisterRoomAvailabilityServiceServer(s grpc.ServiceRegistrar, srv RoomAvailabilityServiceServer) {
+	// If the following call panics, it indicates UnimplementedRoomAvailabilityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RoomAvailabilityService_ServiceDesc, srv)
+}
+
+func _RoomAvailabilityService_BlockRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomAvailabilityServiceServer).BlockRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomAvailabilityService_BlockRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomAvailabilityServiceServer).BlockRoom(ctx, req.(*BlockRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomAvailabilityService_UnblockRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomAvailabilityServiceServer).UnblockRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomAvailabilityService_UnblockRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomAvailabilityServiceServer).UnblockRoom(ctx, req.(*UnblockRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomAvailabilityService_GetRoomAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomAvailabilityServiceServer).GetRoomAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomAvailabilityService_GetRoomAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomAvailabilityServiceServer).GetRoomAvailability(ctx, req.(*GetRoomAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomAvailabilityService_GetCategoryAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomAvailabilityServiceServer).GetCategoryAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomAvailabilityService_GetCategoryAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomAvailabilityServiceServer).GetCategoryAvailability(ctx, req.(*GetCategoryAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoomAvailabilityService_ServiceDesc is the grpc.ServiceDesc for RoomAvailabilityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomAvailabilityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "booking.v1.RoomAvailabilityService",
+	HandlerType: (*RoomAvailabilityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockRoom",
+			Handler:    _RoomAvailabilityService_BlockRoom_Handler,
+		},
+		{
+			MethodName: "UnblockRoom",
+			Handler:    _RoomAvailabilityService_UnblockRoom_Handler,
+		},
+		{
+			MethodName: "GetRoomAvailability",
+			Handler:    _RoomAvailabilityService_GetRoomAvailability_Handler,
+		},
+		{
+			MethodName: "GetCategoryAvailability",
+			Handler:    _RoomAvailabilityService_GetCategoryAvailability_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking/v1/booking_service.proto",
+}
+
+const (
+	PaymentService_CreatePayment_FullMethodName      = "/booking.v1.PaymentService/CreatePayment"
+	PaymentService_CapturePayment_FullMethodName     = "/booking.v1.PaymentService/CapturePayment"
+	PaymentService_RefundPayment_FullMethodName      = "/booking.v1.PaymentService/RefundPayment"
+	PaymentService_GetBookingPayments_FullMethodName = "/booking.v1.PaymentService/GetBookingPayments"
+)
+
+// PaymentServiceClient is the client API for PaymentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PaymentServiceClient interface {
+	CreatePayment(ctx context.Context, in *CreatePaymentRequest, opts ...grpc.CallOption) (*CreatePaymentResponse, error)
+	CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*CapturePaymentResponse, error)
+	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error)
+	GetBookingPayments(ctx context.Context, in *GetBookingPaymentsRequest, opts ...grpc.CallOption) (*GetBookingPaymentsResponse, error)
+}
+
+type paymentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentServiceClient(cc grpc.ClientConnInterface) PaymentServiceClient {
+	return &paymentServiceClient{cc}
+}
+
+func (c *paymentServiceClient) CreatePayment(ctx context.Context, in *CreatePaymentRequest, opts ...grpc.CallOption) (*CreatePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CreatePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*CapturePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CapturePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CapturePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RefundPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetBookingPayments(ctx context.Context, in *GetBookingPaymentsRequest, opts ...grpc.CallOption) (*GetBookingPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingPaymentsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetBookingPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaymentServiceServer is the server API for PaymentService service.
+// All implementations must embed UnimplementedPaymentServiceServer
+// for forward compatibility.
+type PaymentServiceServer interface {
+	CreatePayment(context.Context, *CreatePaymentRequest) (*CreatePaymentResponse, error)
+	CapturePayment(context.Context, *CapturePaymentRequest) (*CapturePaymentResponse, error)
+	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error)
+	GetBookingPayments(context.Context, *GetBookingPaymentsRequest) (*GetBookingPaymentsResponse, error)
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+// UnimplementedPaymentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaymentServiceServer struct{}
+
+func (UnimplementedPaymentServiceServer) CreatePayment(context.Context, *CreatePaymentRequest) (*CreatePaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) CapturePayment(context.Context, *CapturePaymentRequest) (*CapturePaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CapturePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefundPayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetBookingPayments(context.Context, *GetBookingPaymentsRequest) (*GetBookingPaymentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBookingPayments not implemented")
+}
+func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
+func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentServiceServer will
+// result in compilation errors.
+type UnsafePaymentServiceServer interface {
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceServer) {
+	// If the following call panics, it indicates UnimplementedPaymentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaymentService_ServiceDesc, srv)
+}
+
+func _PaymentService_CreatePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreatePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreatePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreatePayment(ctx, req.(*CreatePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CapturePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapturePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CapturePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CapturePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CapturePayment(ctx, req.(*CapturePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RefundPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RefundPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RefundPayment(ctx, req.(*RefundPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetBookingPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetBookingPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetBookingPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetBookingPayments(ctx, req.(*GetBookingPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "booking.v1.PaymentService",
+	HandlerType: (*PaymentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePayment",
+			Handler:    _PaymentService_CreatePayment_Handler,
+		},
+		{
+			MethodName: "CapturePayment",
+			Handler:    _PaymentService_CapturePayment_Handler,
+		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _PaymentService_RefundPayment_Handler,
+		},
+		{
+			MethodName: "GetBookingPayments",
+			Handler:    _PaymentService_GetBookingPayments_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -28,6 +28,10 @@ const (
 	BookingStatus_BOOKING_STATUS_PENDING     BookingStatus = 1
 	BookingStatus_BOOKING_STATUS_CONFIRMED   BookingStatus = 2
 	BookingStatus_BOOKING_STATUS_CANCELLED   BookingStatus = 3
+	BookingStatus_BOOKING_STATUS_CHECKED_IN  BookingStatus = 4
+	BookingStatus_BOOKING_STATUS_CHECKED_OUT BookingStatus = 5
+	BookingStatus_BOOKING_STATUS_EXPIRED     BookingStatus = 6
+	BookingStatus_BOOKING_STATUS_NO_SHOW     BookingStatus = 7
 )
 
 // Enum value maps for BookingStatus.
@@ -37,12 +41,20 @@ var (
 		1: "BOOKING_STATUS_PENDING",
 		2: "BOOKING_STATUS_CONFIRMED",
 		3: "BOOKING_STATUS_CANCELLED",
+		4: "BOOKING_STATUS_CHECKED_IN",
+		5: "BOOKING_STATUS_CHECKED_OUT",
+		6: "BOOKING_STATUS_EXPIRED",
+		7: "BOOKING_STATUS_NO_SHOW",
 	}
 	BookingStatus_value = map[string]int32{
 		"BOOKING_STATUS_UNSPECIFIED": 0,
 		"BOOKING_STATUS_PENDING":     1,
 		"BOOKING_STATUS_CONFIRMED":   2,
 		"BOOKING_STATUS_CANCELLED":   3,
+		"BOOKING_STATUS_CHECKED_IN":  4,
+		"BOOKING_STATUS_CHECKED_OUT": 5,
+		"BOOKING_STATUS_EXPIRED":     6,
+		"BOOKING_STATUS_NO_SHOW":     7,
 	}
 )
 
@@ -78,12 +90,16 @@ var File_booking_v1_enums_booking_status_proto protoreflect.FileDescriptor
 const file_booking_v1_enums_booking_status_proto_rawDesc = "" +
 	"\n" +
 	"%booking/v1/enums/booking_status.proto\x12\n" +
-	"booking.v1*\x87\x01\n" +
+	"booking.v1*\xfe\x01\n" +
 	"\rBookingStatus\x12\x1e\n" +
 	"\x1aBOOKING_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16BOOKING_STATUS_PENDING\x10\x01\x12\x1c\n" +
 	"\x18BOOKING_STATUS_CONFIRMED\x10\x02\x12\x1c\n" +
-	"\x18BOOKING_STATUS_CANCELLED\x10\x03B\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+	"\x18BOOKING_STATUS_CANCELLED\x10\x03\x12\x1d\n" +
+	"\x19BOOKING_STATUS_CHECKED_IN\x10\x04\x12\x1e\n" +
+	"\x1aBOOKING_STATUS_CHECKED_OUT\x10\x05\x12\x1a\n" +
+	"\x16BOOKING_STATUS_EXPIRED\x10\x06\x12\x1a\n" +
+	"\x16BOOKING_STATUS_NO_SHOW\x10\aB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
 
 var (
 	file_booking_v1_enums_booking_status_proto_rawDescOnce sync.Once
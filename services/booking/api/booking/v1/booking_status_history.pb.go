@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/models/booking_status_history.proto
+
+package bookingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BookingStatusTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	FromStatus    BookingStatus          `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=booking.v1.BookingStatus" json:"from_status,omitempty"`
+	ToStatus      BookingStatus          `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=booking.v1.BookingStatus" json:"to_status,omitempty"`
+	ActorId       *int64                 `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingStatusTransition) Reset() {
+	*x = BookingStatusTransition{}
+	mi := &file_booking_v1_models_booking_status_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingStatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingStatusTransition) ProtoMessage() {}
+
+func (x *BookingStatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_booking_status_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingStatusTransition.ProtoReflect.Descriptor instead.
+func (*BookingStatusTransition) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_booking_status_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BookingStatusTransition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BookingStatusTransition) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingStatusTransition) GetFromStatus() BookingStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusTransition) GetToStatus() BookingStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusTransition) GetActorId() int64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *BookingStatusTransition) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *BookingStatusTransition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_booking_v1_models_booking_status_history_proto protoreflect.FileDescriptor
+
+const file_booking_v1_models_booking_status_history_proto_rawDesc = "" +
+	"\n" +
+	".booking/v1/models/booking_status_history.proto\x12\n" +
+	"booking.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%booking/v1/enums/booking_status.proto\"\xcc\x02\n" +
+	"\x17BookingStatusTransition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12:\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2\x19.booking.v1.BookingStatusR\n" +
+	"fromStatus\x126\n" +
+	"\tto_status\x18\x04 \x01(\x0e2\x19.booking.v1.BookingStatusR\btoStatus\x12\x1e\n" +
+	"\bactor_id\x18\x05 \x01(\x03H\x00R\aactorId\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x06 \x01(\tH\x01R\x06reason\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\v\n" +
+	"\t_actor_idB\t\n" +
+	"\a_reasonB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_models_booking_status_history_proto_rawDescOnce sync.Once
+	file_booking_v1_models_booking_status_history_proto_rawDescData []byte
+)
+
+func file_booking_v1_models_booking_status_history_proto_rawDescGZIP() []byte {
+	file_booking_v1_models_booking_status_history_proto_rawDescOnce.Do(func() {
+		file_booking_v1_models_booking_status_history_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_models_booking_status_history_proto_rawDesc), len(file_booking_v1_models_booking_status_history_proto_rawDesc)))
+	})
+	return file_booking_v1_models_booking_status_history_proto_rawDescData
+}
+
+var file_booking_v1_models_booking_status_history_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_booking_v1_models_booking_status_history_proto_goTypes = []any{
+	(*BookingStatusTransition)(nil), // 0: booking.v1.BookingStatusTransition
+	(BookingStatus)(0),              // 1: booking.v1.BookingStatus
+	(*timestamppb.Timestamp)(nil),   // 2: google.protobuf.Timestamp
+}
+var file_booking_v1_models_booking_status_history_proto_depIdxs = []int32{
+	1, // 0: booking.v1.BookingStatusTransition.from_status:type_name -> booking.v1.BookingStatus
+	1, // 1: booking.v1.BookingStatusTransition.to_status:type_name -> booking.v1.BookingStatus
+	2, // 2: booking.v1.BookingStatusTransition.created_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_models_booking_status_history_proto_init() }
+func file_booking_v1_models_booking_status_history_proto_init() {
+	if File_booking_v1_models_booking_status_history_proto != nil {
+		return
+	}
+	file_booking_v1_enums_booking_status_proto_init()
+	file_booking_v1_models_booking_status_history_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_models_booking_status_history_proto_rawDesc), len(file_booking_v1_models_booking_status_history_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_models_booking_status_history_proto_goTypes,
+		DependencyIndexes: file_booking_v1_models_booking_status_history_proto_depIdxs,
+		MessageInfos:      file_booking_v1_models_booking_status_history_proto_msgTypes,
+	}.Build()
+	File_booking_v1_models_booking_status_history_proto = out.File
+	file_booking_v1_models_booking_status_history_proto_goTypes = nil
+	file_booking_v1_models_booking_status_history_proto_depIdxs = nil
+}
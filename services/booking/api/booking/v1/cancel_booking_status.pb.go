@@ -23,10 +23,13 @@ const (
 )
 
 type CancelBookingStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	ActorId         *int64                 `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	Reason          *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CancelBookingStatusRequest) Reset() {
@@ -66,9 +69,32 @@ func (x *CancelBookingStatusRequest) GetId() string {
 	return ""
 }
 
+func (x *CancelBookingStatusRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *CancelBookingStatusRequest) GetActorId() int64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *CancelBookingStatusRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type CancelBookingStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        BookingStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Cancellation  *BookingCancellation   `protobuf:"bytes,3,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,16 +136,39 @@ func (x *CancelBookingStatusResponse) GetStatus() BookingStatus {
 	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
 }
 
+func (x *CancelBookingStatusResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CancelBookingStatusResponse) GetCancellation() *BookingCancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
 var File_booking_v1_rpc_cancel_booking_status_proto protoreflect.FileDescriptor
 
 const file_booking_v1_rpc_cancel_booking_status_proto_rawDesc = "" +
 	"\n" +
 	"*booking/v1/rpc/cancel_booking_status.proto\x12\n" +
-	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a%booking/v1/enums/booking_status.proto\"6\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a%booking/v1/enums/booking_status.proto\x1a,booking/v1/models/booking_cancellation.proto\"\xee\x01\n" +
 	"\x1aCancelBookingStatusRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"P\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x127\n" +
+	"\x10expected_version\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x00R\x0fexpectedVersion\x88\x01\x01\x12'\n" +
+	"\bactor_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x01R\aactorId\x88\x01\x01\x12'\n" +
+	"\x06reason\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03H\x02R\x06reason\x88\x01\x01B\x13\n" +
+	"\x11_expected_versionB\v\n" +
+	"\t_actor_idB\t\n" +
+	"\a_reason\"\xaf\x01\n" +
 	"\x1bCancelBookingStatusResponse\x121\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06statusB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+	"\x06status\x18\x01 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12C\n" +
+	"\fcancellation\x18\x03 \x01(\v2\x1f.booking.v1.BookingCancellationR\fcancellationB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
 
 var (
 	file_booking_v1_rpc_cancel_booking_status_proto_rawDescOnce sync.Once
@@ -138,14 +187,16 @@ var file_booking_v1_rpc_cancel_booking_status_proto_goTypes = []any{
 	(*CancelBookingStatusRequest)(nil),  // 0: booking.v1.CancelBookingStatusRequest
 	(*CancelBookingStatusResponse)(nil), // 1: booking.v1.CancelBookingStatusResponse
 	(BookingStatus)(0),                  // 2: booking.v1.BookingStatus
+	(*BookingCancellation)(nil),         // 3: booking.v1.BookingCancellation
 }
 var file_booking_v1_rpc_cancel_booking_status_proto_depIdxs = []int32{
 	2, // 0: booking.v1.CancelBookingStatusResponse.status:type_name -> booking.v1.BookingStatus
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 1: booking.v1.CancelBookingStatusResponse.cancellation:type_name -> booking.v1.BookingCancellation
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_booking_v1_rpc_cancel_booking_status_proto_init() }
@@ -154,6 +205,8 @@ func file_booking_v1_rpc_cancel_booking_status_proto_init() {
 		return
 	}
 	file_booking_v1_enums_booking_status_proto_init()
+	file_booking_v1_models_booking_cancellation_proto_init()
+	file_booking_v1_rpc_cancel_booking_status_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/enums/cancellation_penalty.proto
+
+package bookingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CancellationPenalty int32
+
+const (
+	CancellationPenalty_CANCELLATION_PENALTY_UNSPECIFIED CancellationPenalty = 0
+	CancellationPenalty_CANCELLATION_PENALTY_NONE        CancellationPenalty = 1
+	CancellationPenalty_CANCELLATION_PENALTY_FIRST_NIGHT CancellationPenalty = 2
+	CancellationPenalty_CANCELLATION_PENALTY_PERCENT     CancellationPenalty = 3
+	CancellationPenalty_CANCELLATION_PENALTY_FULL_STAY   CancellationPenalty = 4
+)
+
+// Enum value maps for CancellationPenalty.
+var (
+	CancellationPenalty_name = map[int32]string{
+		0: "CANCELLATION_PENALTY_UNSPECIFIED",
+		1: "CANCELLATION_PENALTY_NONE",
+		2: "CANCELLATION_PENALTY_FIRST_NIGHT",
+		3: "CANCELLATION_PENALTY_PERCENT",
+		4: "CANCELLATION_PENALTY_FULL_STAY",
+	}
+	CancellationPenalty_value = map[string]int32{
+		"CANCELLATION_PENALTY_UNSPECIFIED": 0,
+		"CANCELLATION_PENALTY_NONE":        1,
+		"CANCELLATION_PENALTY_FIRST_NIGHT": 2,
+		"CANCELLATION_PENALTY_PERCENT":     3,
+		"CANCELLATION_PENALTY_FULL_STAY":   4,
+	}
+)
+
+func (x CancellationPenalty) Enum() *CancellationPenalty {
+	p := new(CancellationPenalty)
+	*p = x
+	return p
+}
+
+func (x CancellationPenalty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancellationPenalty) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_enums_cancellation_penalty_proto_enumTypes[0].Descriptor()
+}
+
+func (CancellationPenalty) Type() protoreflect.EnumType {
+	return &file_booking_v1_enums_cancellation_penalty_proto_enumTypes[0]
+}
+
+func (x CancellationPenalty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancellationPenalty.Descriptor instead.
+func (CancellationPenalty) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_enums_cancellation_penalty_proto_rawDescGZIP(), []int{0}
+}
+
+var File_booking_v1_enums_cancellation_penalty_proto protoreflect.FileDescriptor
+
+const file_booking_v1_enums_cancellation_penalty_proto_rawDesc = "" +
+	"\n" +
+	"+booking/v1/enums/cancellation_penalty.proto\x12\n" +
+	"booking.v1*\xc6\x01\n" +
+	"\x13CancellationPenalty\x12$\n" +
+	" CANCELLATION_PENALTY_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19CANCELLATION_PENALTY_NONE\x10\x01\x12$\n" +
+	" CANCELLATION_PENALTY_FIRST_NIGHT\x10\x02\x12 \n" +
+	"\x1cCANCELLATION_PENALTY_PERCENT\x10\x03\x12\"\n" +
+	"\x1eCANCELLATION_PENALTY_FULL_STAY\x10\x04B\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_enums_cancellation_penalty_proto_rawDescOnce sync.Once
+	file_booking_v1_enums_cancellation_penalty_proto_rawDescData []byte
+)
+
+func file_booking_v1_enums_cancellation_penalty_proto_rawDescGZIP() []byte {
+	file_booking_v1_enums_cancellation_penalty_proto_rawDescOnce.Do(func() {
+		file_booking_v1_enums_cancellation_penalty_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_enums_cancellation_penalty_proto_rawDesc), len(file_booking_v1_enums_cancellation_penalty_proto_rawDesc)))
+	})
+	return file_booking_v1_enums_cancellation_penalty_proto_rawDescData
+}
+
+var file_booking_v1_enums_cancellation_penalty_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_booking_v1_enums_cancellation_penalty_proto_goTypes = []any{
+	(CancellationPenalty)(0), // 0: booking.v1.CancellationPenalty
+}
+var file_booking_v1_enums_cancellation_penalty_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_enums_cancellation_penalty_proto_init() }
+func file_booking_v1_enums_cancellation_penalty_proto_init() {
+	if File_booking_v1_enums_cancellation_penalty_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_enums_cancellation_penalty_proto_rawDesc), len(file_booking_v1_enums_cancellation_penalty_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_enums_cancellation_penalty_proto_goTypes,
+		DependencyIndexes: file_booking_v1_enums_cancellation_penalty_proto_depIdxs,
+		EnumInfos:         file_booking_v1_enums_cancellation_penalty_proto_enumTypes,
+	}.Build()
+	File_booking_v1_enums_cancellation_penalty_proto = out.File
+	file_booking_v1_enums_cancellation_penalty_proto_goTypes = nil
+	file_booking_v1_enums_cancellation_penalty_proto_depIdxs = nil
+}
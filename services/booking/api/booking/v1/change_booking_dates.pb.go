@@ -0,0 +1,218 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/change_booking_dates.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChangeBookingDatesRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CheckIn             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	ExpectedTotalAmount *string                `protobuf:"bytes,4,opt,name=expected_total_amount,json=expectedTotalAmount,proto3,oneof" json:"expected_total_amount,omitempty"`
+	ExpectedVersion     *int64                 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ChangeBookingDatesRequest) Reset() {
+	*x = ChangeBookingDatesRequest{}
+	mi := &file_booking_v1_rpc_change_booking_dates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeBookingDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeBookingDatesRequest) ProtoMessage() {}
+
+func (x *ChangeBookingDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_change_booking_dates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeBookingDatesRequest.ProtoReflect.Descriptor instead.
+func (*ChangeBookingDatesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_change_booking_dates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChangeBookingDatesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeBookingDatesRequest) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *ChangeBookingDatesRequest) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *ChangeBookingDatesRequest) GetExpectedTotalAmount() string {
+	if x != nil && x.ExpectedTotalAmount != nil {
+		return *x.ExpectedTotalAmount
+	}
+	return ""
+}
+
+func (x *ChangeBookingDatesRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type ChangeBookingDatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeBookingDatesResponse) Reset() {
+	*x = ChangeBookingDatesResponse{}
+	mi := &file_booking_v1_rpc_change_booking_dates_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeBookingDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeBookingDatesResponse) ProtoMessage() {}
+
+func (x *ChangeBookingDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_change_booking_dates_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeBookingDatesResponse.ProtoReflect.Descriptor instead.
+func (*ChangeBookingDatesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_change_booking_dates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChangeBookingDatesResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_change_booking_dates_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_change_booking_dates_proto_rawDesc = "" +
+	"\n" +
+	")booking/v1/rpc/change_booking_dates.proto\x12\n" +
+	"booking.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fbooking/v1/models/booking.proto\"\xd5\x03\n" +
+	"\x19ChangeBookingDatesRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12=\n" +
+	"\bcheck_in\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\acheckIn\x12?\n" +
+	"\tcheck_out\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bcheckOut\x12X\n" +
+	"\x15expected_total_amount\x18\x04 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[0-9]+(\\.[0-9]{1,18})?$H\x00R\x13expectedTotalAmount\x88\x01\x01\x127\n" +
+	"\x10expected_version\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x01R\x0fexpectedVersion\x88\x01\x01:\\\xbaHY\x1aW\n" +
+	"\x13booking.dates.order\x12 check_out must be after check_in\x1a\x1ethis.check_out > this.check_inB\x18\n" +
+	"\x16_expected_total_amountB\x13\n" +
+	"\x11_expected_version\"K\n" +
+	"\x1aChangeBookingDatesResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abookingB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_change_booking_dates_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_change_booking_dates_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_change_booking_dates_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_change_booking_dates_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_change_booking_dates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_change_booking_dates_proto_rawDesc), len(file_booking_v1_rpc_change_booking_dates_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_change_booking_dates_proto_rawDescData
+}
+
+var file_booking_v1_rpc_change_booking_dates_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_change_booking_dates_proto_goTypes = []any{
+	(*ChangeBookingDatesRequest)(nil),  // 0: booking.v1.ChangeBookingDatesRequest
+	(*ChangeBookingDatesResponse)(nil), // 1: booking.v1.ChangeBookingDatesResponse
+	(*timestamppb.Timestamp)(nil),      // 2: google.protobuf.Timestamp
+	(*Booking)(nil),                    // 3: booking.v1.Booking
+}
+var file_booking_v1_rpc_change_booking_dates_proto_depIdxs = []int32{
+	2, // 0: booking.v1.ChangeBookingDatesRequest.check_in:type_name -> google.protobuf.Timestamp
+	2, // 1: booking.v1.ChangeBookingDatesRequest.check_out:type_name -> google.protobuf.Timestamp
+	3, // 2: booking.v1.ChangeBookingDatesResponse.booking:type_name -> booking.v1.Booking
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_change_booking_dates_proto_init() }
+func file_booking_v1_rpc_change_booking_dates_proto_init() {
+	if File_booking_v1_rpc_change_booking_dates_proto != nil {
+		return
+	}
+	file_booking_v1_models_booking_proto_init()
+	file_booking_v1_rpc_change_booking_dates_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_change_booking_dates_proto_rawDesc), len(file_booking_v1_rpc_change_booking_dates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_change_booking_dates_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_change_booking_dates_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_change_booking_dates_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_change_booking_dates_proto = out.File
+	file_booking_v1_rpc_change_booking_dates_proto_goTypes = nil
+	file_booking_v1_rpc_change_booking_dates_proto_depIdxs = nil
+}
@@ -23,10 +23,13 @@ const (
 )
 
 type ConfirmBookingStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	ActorId         *int64                 `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	Reason          *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ConfirmBookingStatusRequest) Reset() {
@@ -66,9 +69,31 @@ func (x *ConfirmBookingStatusRequest) GetId() string {
 	return ""
 }
 
+func (x *ConfirmBookingStatusRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *ConfirmBookingStatusRequest) GetActorId() int64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *ConfirmBookingStatusRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type ConfirmBookingStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        BookingStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,16 +135,31 @@ func (x *ConfirmBookingStatusResponse) GetStatus() BookingStatus {
 	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
 }
 
+func (x *ConfirmBookingStatusResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 var File_booking_v1_rpc_confirm_booking_status_proto protoreflect.FileDescriptor
 
 const file_booking_v1_rpc_confirm_booking_status_proto_rawDesc = "" +
 	"\n" +
 	"+booking/v1/rpc/confirm_booking_status.proto\x12\n" +
-	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a%booking/v1/enums/booking_status.proto\"7\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a%booking/v1/enums/booking_status.proto\"\xef\x01\n" +
 	"\x1bConfirmBookingStatusRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"Q\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x127\n" +
+	"\x10expected_version\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x00R\x0fexpectedVersion\x88\x01\x01\x12'\n" +
+	"\bactor_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x01R\aactorId\x88\x01\x01\x12'\n" +
+	"\x06reason\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03H\x02R\x06reason\x88\x01\x01B\x13\n" +
+	"\x11_expected_versionB\v\n" +
+	"\t_actor_idB\t\n" +
+	"\a_reason\"k\n" +
 	"\x1cConfirmBookingStatusResponse\x121\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06statusB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+	"\x06status\x18\x01 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversionB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
 
 var (
 	file_booking_v1_rpc_confirm_booking_status_proto_rawDescOnce sync.Once
@@ -154,6 +194,7 @@ func file_booking_v1_rpc_confirm_booking_status_proto_init() {
 		return
 	}
 	file_booking_v1_enums_booking_status_proto_init()
+	file_booking_v1_rpc_confirm_booking_status_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -145,6 +145,7 @@ type CreateBookingRoomRequest struct {
 	Adults        uint32                 `protobuf:"varint,2,opt,name=adults,proto3" json:"adults,omitempty"`
 	Children      uint32                 `protobuf:"varint,3,opt,name=children,proto3" json:"children,omitempty"`
 	PricePerNight string                 `protobuf:"bytes,4,opt,name=price_per_night,json=pricePerNight,proto3" json:"price_per_night,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -207,6 +208,13 @@ func (x *CreateBookingRoomRequest) GetPricePerNight() string {
 	return ""
 }
 
+func (x *CreateBookingRoomRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
 type CreateBookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
@@ -256,11 +264,11 @@ var File_booking_v1_rpc_create_booking_proto protoreflect.FileDescriptor
 const file_booking_v1_rpc_create_booking_proto_rawDesc = "" +
 	"\n" +
 	"#booking/v1/rpc/create_booking.proto\x12\n" +
-	"booking.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fbooking/v1/models/booking.proto\"\xb4\x05\n" +
+	"booking.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fbooking/v1/models/booking.proto\"\xaf\x05\n" +
 	"\x14CreateBookingRequest\x12 \n" +
 	"\auser_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06userId\x12#\n" +
-	"\bhotel_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\ahotelId\x12B\n" +
-	"\bcheck_in\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\v\xbaH\b\xc8\x01\x01\xb2\x01\x02@\x01R\acheckIn\x12?\n" +
+	"\bhotel_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\ahotelId\x12=\n" +
+	"\bcheck_in\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\acheckIn\x12?\n" +
 	"\tcheck_out\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bcheckOut\x12&\n" +
 	"\n" +
 	"guest_name\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tguestName\x12-\n" +
@@ -275,12 +283,15 @@ const file_booking_v1_rpc_create_booking_proto_rawDesc = "" +
 	" \x03(\v2$.booking.v1.CreateBookingRoomRequestB\b\xbaH\x05\x92\x01\x02\b\x01R\x05rooms:\\\xbaHY\x1aW\n" +
 	"\x13booking.dates.order\x12 check_out must be after check_in\x1a\x1ethis.check_out > this.check_inB\x0e\n" +
 	"\f_guest_emailB\x0e\n" +
-	"\f_guest_phone\"\xd0\x01\n" +
-	"\x18CreateBookingRoomRequest\x12!\n" +
-	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
+	"\f_guest_phone\"\x84\x03\n" +
+	"\x18CreateBookingRoomRequest\x12$\n" +
+	"\aroom_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06roomId\x12!\n" +
 	"\x06adults\x18\x02 \x01(\rB\t\xbaH\x06*\x04\x18\x14(\x01R\x06adults\x12#\n" +
 	"\bchildren\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18\x14R\bchildren\x12I\n" +
-	"\x0fprice_per_night\x18\x04 \x01(\tB!\xbaH\x1er\x1c\x10\x012\x18^[0-9]+(\\.[0-9]{1,18})?$R\rpricePerNight\"F\n" +
+	"\x0fprice_per_night\x18\x04 \x01(\tB!\xbaH\x1er\x1c\x10\x012\x18^[0-9]+(\\.[0-9]{1,18})?$R\rpricePerNight\x12,\n" +
+	"\vcategory_id\x18\x05 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\n" +
+	"categoryId:\x80\x01\xbaH}\x1a{\n" +
+	"\x13booking_room.target\x122exactly one of room_id and category_id must be set\x1a0(this.room_id != '') != (this.category_id != '')\"F\n" +
 	"\x15CreateBookingResponse\x12-\n" +
 	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abookingB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
 
@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/create_payment.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequest) Reset() {
+	*x = CreatePaymentRequest{}
+	mi := &file_booking_v1_rpc_create_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequest) ProtoMessage() {}
+
+func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_create_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequest.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_create_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePaymentRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type CreatePaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePaymentResponse) Reset() {
+	*x = CreatePaymentResponse{}
+	mi := &file_booking_v1_rpc_create_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentResponse) ProtoMessage() {}
+
+func (x *CreatePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_create_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentResponse.ProtoReflect.Descriptor instead.
+func (*CreatePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_create_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_create_payment_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_create_payment_proto_rawDesc = "" +
+	"\n" +
+	"#booking/v1/rpc/create_payment.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fbooking/v1/models/payment.proto\"?\n" +
+	"\x14CreatePaymentRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"F\n" +
+	"\x15CreatePaymentResponse\x12-\n" +
+	"\apayment\x18\x01 \x01(\v2\x13.booking.v1.PaymentR\apaymentB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_create_payment_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_create_payment_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_create_payment_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_create_payment_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_create_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_create_payment_proto_rawDesc), len(file_booking_v1_rpc_create_payment_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_create_payment_proto_rawDescData
+}
+
+var file_booking_v1_rpc_create_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_create_payment_proto_goTypes = []any{
+	(*CreatePaymentRequest)(nil),  // 0: booking.v1.CreatePaymentRequest
+	(*CreatePaymentResponse)(nil), // 1: booking.v1.CreatePaymentResponse
+	(*Payment)(nil),               // 2: booking.v1.Payment
+}
+var file_booking_v1_rpc_create_payment_proto_depIdxs = []int32{
+	2, // 0: booking.v1.CreatePaymentResponse.payment:type_name -> booking.v1.Payment
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_create_payment_proto_init() }
+func file_booking_v1_rpc_create_payment_proto_init() {
+	if File_booking_v1_rpc_create_payment_proto != nil {
+		return
+	}
+	file_booking_v1_models_payment_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_create_payment_proto_rawDesc), len(file_booking_v1_rpc_create_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_create_payment_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_create_payment_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_create_payment_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_create_payment_proto = out.File
+	file_booking_v1_rpc_create_payment_proto_goTypes = nil
+	file_booking_v1_rpc_create_payment_proto_depIdxs = nil
+}
@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/find_booking_by_code.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FindBookingByCodeRequest looks a booking up by the reference code the guest
+// got at booking time; dashes and lower case are accepted. The guest proves the
+// booking is theirs with the guest email or their last name.
+type FindBookingByCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Types that are valid to be assigned to GuestCheck:
+	//
+	//	*FindBookingByCodeRequest_GuestEmail
+	//	*FindBookingByCodeRequest_LastName
+	GuestCheck    isFindBookingByCodeRequest_GuestCheck `protobuf_oneof:"guest_check"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindBookingByCodeRequest) Reset() {
+	*x = FindBookingByCodeRequest{}
+	mi := &file_booking_v1_rpc_find_booking_by_code_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindBookingByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindBookingByCodeRequest) ProtoMessage() {}
+
+func (x *FindBookingByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_find_booking_by_code_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindBookingByCodeRequest.ProtoReflect.Descriptor instead.
+func (*FindBookingByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_find_booking_by_code_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FindBookingByCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *FindBookingByCodeRequest) GetGuestCheck() isFindBookingByCodeRequest_GuestCheck {
+	if x != nil {
+		return x.GuestCheck
+	}
+	return nil
+}
+
+func (x *FindBookingByCodeRequest) GetGuestEmail() string {
+	if x != nil {
+		if x, ok := x.GuestCheck.(*FindBookingByCodeRequest_GuestEmail); ok {
+			return x.GuestEmail
+		}
+	}
+	return ""
+}
+
+func (x *FindBookingByCodeRequest) GetLastName() string {
+	if x != nil {
+		if x, ok := x.GuestCheck.(*FindBookingByCodeRequest_LastName); ok {
+			return x.LastName
+		}
+	}
+	return ""
+}
+
+type isFindBookingByCodeRequest_GuestCheck interface {
+	isFindBookingByCodeRequest_GuestCheck()
+}
+
+type FindBookingByCodeRequest_GuestEmail struct {
+	GuestEmail string `protobuf:"bytes,2,opt,name=guest_email,json=guestEmail,proto3,oneof"`
+}
+
+type FindBookingByCodeRequest_LastName struct {
+	LastName string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3,oneof"`
+}
+
+func (*FindBookingByCodeRequest_GuestEmail) isFindBookingByCodeRequest_GuestCheck() {}
+
+func (*FindBookingByCodeRequest_LastName) isFindBookingByCodeRequest_GuestCheck() {}
+
+type FindBookingByCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindBookingByCodeResponse) Reset() {
+	*x = FindBookingByCodeResponse{}
+	mi := &file_booking_v1_rpc_find_booking_by_code_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindBookingByCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindBookingByCodeResponse) ProtoMessage() {}
+
+func (x *FindBookingByCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_find_booking_by_code_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindBookingByCodeResponse.ProtoReflect.Descriptor instead.
+func (*FindBookingByCodeResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_find_booking_by_code_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindBookingByCodeResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_find_booking_by_code_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_find_booking_by_code_proto_rawDesc = "" +
+	"\n" +
+	")booking/v1/rpc/find_booking_by_code.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fbooking/v1/models/booking.proto\"\xc3\x01\n" +
+	"\x18FindBookingByCodeRequest\x12;\n" +
+	"\x04code\x18\x01 \x01(\tB'\xbaH$r\"2 ^[0-9A-Za-z]{4}-?[0-9A-Za-z]{4}$R\x04code\x12*\n" +
+	"\vguest_email\x18\x02 \x01(\tB\a\xbaH\x04r\x02`\x01H\x00R\n" +
+	"guestEmail\x12(\n" +
+	"\tlast_name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dH\x00R\blastNameB\x14\n" +
+	"\vguest_check\x12\x05\xbaH\x02\b\x01\"J\n" +
+	"\x19FindBookingByCodeResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abookingB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_find_booking_by_code_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_find_booking_by_code_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_find_booking_by_code_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_find_booking_by_code_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_find_booking_by_code_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_find_booking_by_code_proto_rawDesc), len(file_booking_v1_rpc_find_booking_by_code_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_find_booking_by_code_proto_rawDescData
+}
+
+var file_booking_v1_rpc_find_booking_by_code_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_find_booking_by_code_proto_goTypes = []any{
+	(*FindBookingByCodeRequest)(nil),  // 0: booking.v1.FindBookingByCodeRequest
+	(*FindBookingByCodeResponse)(nil), // 1: booking.v1.FindBookingByCodeResponse
+	(*Booking)(nil),                   // 2: booking.v1.Booking
+}
+var file_booking_v1_rpc_find_booking_by_code_proto_depIdxs = []int32{
+	2, // 0: booking.v1.FindBookingByCodeResponse.booking:type_name -> booking.v1.Booking
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_find_booking_by_code_proto_init() }
+func file_booking_v1_rpc_find_booking_by_code_proto_init() {
+	if File_booking_v1_rpc_find_booking_by_code_proto != nil {
+		return
+	}
+	file_booking_v1_models_booking_proto_init()
+	file_booking_v1_rpc_find_booking_by_code_proto_msgTypes[0].OneofWrappers = []any{
+		(*FindBookingByCodeRequest_GuestEmail)(nil),
+		(*FindBookingByCodeRequest_LastName)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_find_booking_by_code_proto_rawDesc), len(file_booking_v1_rpc_find_booking_by_code_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_find_booking_by_code_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_find_booking_by_code_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_find_booking_by_code_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_find_booking_by_code_proto = out.File
+	file_booking_v1_rpc_find_booking_by_code_proto_goTypes = nil
+	file_booking_v1_rpc_find_booking_by_code_proto_depIdxs = nil
+}
@@ -0,0 +1,216 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/get_active_bookings.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetActiveBookingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*GetActiveBookingsRequest_HotelId
+	//	*GetActiveBookingsRequest_RoomId
+	Target        isGetActiveBookingsRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveBookingsRequest) Reset() {
+	*x = GetActiveBookingsRequest{}
+	mi := &file_booking_v1_rpc_get_active_bookings_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveBookingsRequest) ProtoMessage() {}
+
+func (x *GetActiveBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_get_active_bookings_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveBookingsRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_get_active_bookings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetActiveBookingsRequest) GetTarget() isGetActiveBookingsRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *GetActiveBookingsRequest) GetHotelId() string {
+	if x != nil {
+		if x, ok := x.Target.(*GetActiveBookingsRequest_HotelId); ok {
+			return x.HotelId
+		}
+	}
+	return ""
+}
+
+func (x *GetActiveBookingsRequest) GetRoomId() string {
+	if x != nil {
+		if x, ok := x.Target.(*GetActiveBookingsRequest_RoomId); ok {
+			return x.RoomId
+		}
+	}
+	return ""
+}
+
+type isGetActiveBookingsRequest_Target interface {
+	isGetActiveBookingsRequest_Target()
+}
+
+type GetActiveBookingsRequest_HotelId struct {
+	HotelId string `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3,oneof"`
+}
+
+type GetActiveBookingsRequest_RoomId struct {
+	RoomId string `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3,oneof"`
+}
+
+func (*GetActiveBookingsRequest_HotelId) isGetActiveBookingsRequest_Target() {}
+
+func (*GetActiveBookingsRequest_RoomId) isGetActiveBookingsRequest_Target() {}
+
+type GetActiveBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingIds    []string               `protobuf:"bytes,1,rep,name=booking_ids,json=bookingIds,proto3" json:"booking_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActiveBookingsResponse) Reset() {
+	*x = GetActiveBookingsResponse{}
+	mi := &file_booking_v1_rpc_get_active_bookings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActiveBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveBookingsResponse) ProtoMessage() {}
+
+func (x *GetActiveBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_get_active_bookings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveBookingsResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_get_active_bookings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetActiveBookingsResponse) GetBookingIds() []string {
+	if x != nil {
+		return x.BookingIds
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_get_active_bookings_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_get_active_bookings_proto_rawDesc = "" +
+	"\n" +
+	"(booking/v1/rpc/get_active_bookings.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\"w\n" +
+	"\x18GetActiveBookingsRequest\x12%\n" +
+	"\bhotel_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\ahotelId\x12#\n" +
+	"\aroom_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06roomIdB\x0f\n" +
+	"\x06target\x12\x05\xbaH\x02\b\x01\"<\n" +
+	"\x19GetActiveBookingsResponse\x12\x1f\n" +
+	"\vbooking_ids\x18\x01 \x03(\tR\n" +
+	"bookingIdsB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_get_active_bookings_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_get_active_bookings_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_get_active_bookings_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_get_active_bookings_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_get_active_bookings_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_get_active_bookings_proto_rawDesc), len(file_booking_v1_rpc_get_active_bookings_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_get_active_bookings_proto_rawDescData
+}
+
+var file_booking_v1_rpc_get_active_bookings_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_get_active_bookings_proto_goTypes = []any{
+	(*GetActiveBookingsRequest)(nil),  // 0: booking.v1.GetActiveBookingsRequest
+	(*GetActiveBookingsResponse)(nil), // 1: booking.v1.GetActiveBookingsResponse
+}
+var file_booking_v1_rpc_get_active_bookings_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_get_active_bookings_proto_init() }
+func file_booking_v1_rpc_get_active_bookings_proto_init() {
+	if File_booking_v1_rpc_get_active_bookings_proto != nil {
+		return
+	}
+	file_booking_v1_rpc_get_active_bookings_proto_msgTypes[0].OneofWrappers = []any{
+		(*GetActiveBookingsRequest_HotelId)(nil),
+		(*GetActiveBookingsRequest_RoomId)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_get_active_bookings_proto_rawDesc), len(file_booking_v1_rpc_get_active_bookings_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_get_active_bookings_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_get_active_bookings_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_get_active_bookings_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_get_active_bookings_proto = out.File
+	file_booking_v1_rpc_get_active_bookings_proto_goTypes = nil
+	file_booking_v1_rpc_get_active_bookings_proto_depIdxs = nil
+}
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/get_booking_history.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetBookingHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryRequest) Reset() {
+	*x = GetBookingHistoryRequest{}
+	mi := &file_booking_v1_rpc_get_booking_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryRequest) ProtoMessage() {}
+
+func (x *GetBookingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_get_booking_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_get_booking_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetBookingHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBookingHistoryResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Transitions   []*BookingStatusTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryResponse) Reset() {
+	*x = GetBookingHistoryResponse{}
+	mi := &file_booking_v1_rpc_get_booking_history_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryResponse) ProtoMessage() {}
+
+func (x *GetBookingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_get_booking_history_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_get_booking_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetBookingHistoryResponse) GetTransitions() []*BookingStatusTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_get_booking_history_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_get_booking_history_proto_rawDesc = "" +
+	"\n" +
+	"(booking/v1/rpc/get_booking_history.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a.booking/v1/models/booking_status_history.proto\"4\n" +
+	"\x18GetBookingHistoryRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"b\n" +
+	"\x19GetBookingHistoryResponse\x12E\n" +
+	"\vtransitions\x18\x01 \x03(\v2#.booking.v1.BookingStatusTransitionR\vtransitionsB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_get_booking_history_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_get_booking_history_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_get_booking_history_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_get_booking_history_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_get_booking_history_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_get_booking_history_proto_rawDesc), len(file_booking_v1_rpc_get_booking_history_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_get_booking_history_proto_rawDescData
+}
+
+var file_booking_v1_rpc_get_booking_history_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_get_booking_history_proto_goTypes = []any{
+	(*GetBookingHistoryRequest)(nil),  // 0: booking.v1.GetBookingHistoryRequest
+	(*GetBookingHistoryResponse)(nil), // 1: booking.v1.GetBookingHistoryResponse
+	(*BookingStatusTransition)(nil),   // 2: booking.v1.BookingStatusTransition
+}
+var file_booking_v1_rpc_get_booking_history_proto_depIdxs = []int32{
+	2, // 0: booking.v1.GetBookingHistoryResponse.transitions:type_name -> booking.v1.BookingStatusTransition
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_get_booking_history_proto_init() }
+func file_booking_v1_rpc_get_booking_history_proto_init() {
+	if File_booking_v1_rpc_get_booking_history_proto != nil {
+		return
+	}
+	file_booking_v1_models_booking_status_history_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_get_booking_history_proto_rawDesc), len(file_booking_v1_rpc_get_booking_history_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_get_booking_history_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_get_booking_history_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_get_booking_history_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_get_booking_history_proto = out.File
+	file_booking_v1_rpc_get_booking_history_proto_goTypes = nil
+	file_booking_v1_rpc_get_booking_history_proto_depIdxs = nil
+}
@@ -0,0 +1,188 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/get_room_availability.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRoomAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomIds       []string               `protobuf:"bytes,1,rep,name=room_ids,json=roomIds,proto3" json:"room_ids,omitempty"`
+	StayRange     *DateRange             `protobuf:"bytes,2,opt,name=stay_range,json=stayRange,proto3" json:"stay_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomAvailabilityRequest) Reset() {
+	*x = GetRoomAvailabilityRequest{}
+	mi := &file_booking_v1_rpc_get_room_availability_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetRoomAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_get_room_availability_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_get_room_availability_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRoomAvailabilityRequest) GetRoomIds() []string {
+	if x != nil {
+		return x.RoomIds
+	}
+	return nil
+}
+
+func (x *GetRoomAvailabilityRequest) GetStayRange() *DateRange {
+	if x != nil {
+		return x.StayRange
+	}
+	return nil
+}
+
+type GetRoomAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*RoomAvailability    `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomAvailabilityResponse) Reset() {
+	*x = GetRoomAvailabilityResponse{}
+	mi := &file_booking_v1_rpc_get_room_availability_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetRoomAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_get_room_availability_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_get_room_availability_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRoomAvailabilityResponse) GetRooms() []*RoomAvailability {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_get_room_availability_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_get_room_availability_proto_rawDesc = "" +
+	"\n" +
+	"*booking/v1/rpc/get_room_availability.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ebooking/v1/models/common.proto\x1a)booking/v1/models/room_availability.proto\"\x81\x02\n" +
+	"\x1aGetRoomAvailabilityRequest\x12.\n" +
+	"\broom_ids\x18\x01 \x03(\tB\x13\xbaH\x10\x92\x01\r\b\x01\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\aroomIds\x12<\n" +
+	"\n" +
+	"stay_range\x18\x02 \x01(\v2\x15.booking.v1.DateRangeB\x06\xbaH\x03\xc8\x01\x01R\tstayRange:u\xbaHr\x1ap\n" +
+	"\x1droom_availability.dates.order\x12\"stay_range end must be after start\x1a+this.stay_range.end > this.stay_range.start\"Q\n" +
+	"\x1bGetRoomAvailabilityResponse\x122\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x1c.booking.v1.RoomAvailabilityR\x05roomsB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_get_room_availability_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_get_room_availability_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_get_room_availability_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_get_room_availability_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_get_room_availability_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_get_room_availability_proto_rawDesc), len(file_booking_v1_rpc_get_room_availability_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_get_room_availability_proto_rawDescData
+}
+
+var file_booking_v1_rpc_get_room_availability_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_get_room_availability_proto_goTypes = []any{
+	(*GetRoomAvailabilityRequest)(nil),  // 0: booking.v1.GetRoomAvailabilityRequest
+	(*GetRoomAvailabilityResponse)(nil), // 1: booking.v1.GetRoomAvailabilityResponse
+	(*DateRange)(nil),                   // 2: booking.v1.DateRange
+	(*RoomAvailability)(nil),            // 3: booking.v1.RoomAvailability
+}
+var file_booking_v1_rpc_get_room_availability_proto_depIdxs = []int32{
+	2, // 0: booking.v1.GetRoomAvailabilityRequest.stay_range:type_name -> booking.v1.DateRange
+	3, // 1: booking.v1.GetRoomAvailabilityResponse.rooms:type_name -> booking.v1.RoomAvailability
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_get_room_availability_proto_init() }
+func file_booking_v1_rpc_get_room_availability_proto_init() {
+	if File_booking_v1_rpc_get_room_availability_proto != nil {
+		return
+	}
+	file_booking_v1_models_common_proto_init()
+	file_booking_v1_models_room_availability_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_get_room_availability_proto_rawDesc), len(file_booking_v1_rpc_get_room_availability_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_get_room_availability_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_get_room_availability_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_get_room_availability_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_get_room_availability_proto = out.File
+	file_booking_v1_rpc_get_room_availability_proto_goTypes = nil
+	file_booking_v1_rpc_get_room_availability_proto_depIdxs = nil
+}
@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/mark_no_show.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MarkNoShowRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	ActorId         *int64                 `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	Reason          *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkNoShowRequest) Reset() {
+	*x = MarkNoShowRequest{}
+	mi := &file_booking_v1_rpc_mark_no_show_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNoShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNoShowRequest) ProtoMessage() {}
+
+func (x *MarkNoShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_mark_no_show_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNoShowRequest.ProtoReflect.Descriptor instead.
+func (*MarkNoShowRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_mark_no_show_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MarkNoShowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarkNoShowRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *MarkNoShowRequest) GetActorId() int64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *MarkNoShowRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type MarkNoShowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        BookingStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNoShowResponse) Reset() {
+	*x = MarkNoShowResponse{}
+	mi := &file_booking_v1_rpc_mark_no_show_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNoShowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNoShowResponse) ProtoMessage() {}
+
+func (x *MarkNoShowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_mark_no_show_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNoShowResponse.ProtoReflect.Descriptor instead.
+func (*MarkNoShowResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_mark_no_show_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarkNoShowResponse) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *MarkNoShowResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+var File_booking_v1_rpc_mark_no_show_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_mark_no_show_proto_rawDesc = "" +
+	"\n" +
+	"!booking/v1/rpc/mark_no_show.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a%booking/v1/enums/booking_status.proto\"\xe5\x01\n" +
+	"\x11MarkNoShowRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x127\n" +
+	"\x10expected_version\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x00R\x0fexpectedVersion\x88\x01\x01\x12'\n" +
+	"\bactor_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x01R\aactorId\x88\x01\x01\x12'\n" +
+	"\x06reason\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03H\x02R\x06reason\x88\x01\x01B\x13\n" +
+	"\x11_expected_versionB\v\n" +
+	"\t_actor_idB\t\n" +
+	"\a_reason\"a\n" +
+	"\x12MarkNoShowResponse\x121\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversionB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_mark_no_show_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_mark_no_show_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_mark_no_show_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_mark_no_show_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_mark_no_show_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_mark_no_show_proto_rawDesc), len(file_booking_v1_rpc_mark_no_show_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_mark_no_show_proto_rawDescData
+}
+
+var file_booking_v1_rpc_mark_no_show_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_mark_no_show_proto_goTypes = []any{
+	(*MarkNoShowRequest)(nil),  // 0: booking.v1.MarkNoShowRequest
+	(*MarkNoShowResponse)(nil), // 1: booking.v1.MarkNoShowResponse
+	(BookingStatus)(0),         // 2: booking.v1.BookingStatus
+}
+var file_booking_v1_rpc_mark_no_show_proto_depIdxs = []int32{
+	2, // 0: booking.v1.MarkNoShowResponse.status:type_name -> booking.v1.BookingStatus
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_mark_no_show_proto_init() }
+func file_booking_v1_rpc_mark_no_show_proto_init() {
+	if File_booking_v1_rpc_mark_no_show_proto != nil {
+		return
+	}
+	file_booking_v1_enums_booking_status_proto_init()
+	file_booking_v1_rpc_mark_no_show_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_mark_no_show_proto_rawDesc), len(file_booking_v1_rpc_mark_no_show_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_mark_no_show_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_mark_no_show_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_mark_no_show_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_mark_no_show_proto = out.File
+	file_booking_v1_rpc_mark_no_show_proto_goTypes = nil
+	file_booking_v1_rpc_mark_no_show_proto_depIdxs = nil
+}
@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/models/payment.proto
+
+package bookingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Payment struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId         string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Provider          string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderPaymentId string                 `protobuf:"bytes,4,opt,name=provider_payment_id,json=providerPaymentId,proto3" json:"provider_payment_id,omitempty"`
+	ClientSecret      string                 `protobuf:"bytes,5,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Status            PaymentStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=booking.v1.PaymentStatus" json:"status,omitempty"`
+	Amount            string                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency          string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	CapturedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Refunds           []*Refund              `protobuf:"bytes,12,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_booking_v1_models_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Payment) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Payment) GetProviderPaymentId() string {
+	if x != nil {
+		return x.ProviderPaymentId
+	}
+	return ""
+}
+
+func (x *Payment) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Payment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Payment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Payment) GetCapturedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return nil
+}
+
+func (x *Payment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Payment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Payment) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+type Refund struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PaymentId        string                 `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	ProviderRefundId *string                `protobuf:"bytes,3,opt,name=provider_refund_id,json=providerRefundId,proto3,oneof" json:"provider_refund_id,omitempty"`
+	Status           RefundStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=booking.v1.RefundStatus" json:"status,omitempty"`
+	Amount           string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason           *string                `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_booking_v1_models_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Refund) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Refund) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *Refund) GetProviderRefundId() string {
+	if x != nil && x.ProviderRefundId != nil {
+		return *x.ProviderRefundId
+	}
+	return ""
+}
+
+func (x *Refund) GetStatus() RefundStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RefundStatus_REFUND_STATUS_UNSPECIFIED
+}
+
+func (x *Refund) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Refund) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *Refund) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Refund) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_booking_v1_models_payment_proto protoreflect.FileDescriptor
+
+const file_booking_v1_models_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x1fbooking/v1/models/payment.proto\x12\n" +
+	"booking.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%booking/v1/enums/payment_status.proto\x1a$booking/v1/enums/refund_status.proto\"\xf1\x03\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12.\n" +
+	"\x13provider_payment_id\x18\x04 \x01(\tR\x11providerPaymentId\x12#\n" +
+	"\rclient_secret\x18\x05 \x01(\tR\fclientSecret\x121\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x19.booking.v1.PaymentStatusR\x06status\x12\x16\n" +
+	"\x06amount\x18\a \x01(\tR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12;\n" +
+	"\vcaptured_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"capturedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12,\n" +
+	"\arefunds\x18\f \x03(\v2\x12.booking.v1.RefundR\arefunds\"\xe9\x02\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x02 \x01(\tR\tpaymentId\x121\n" +
+	"\x12provider_refund_id\x18\x03 \x01(\tH\x00R\x10providerRefundId\x88\x01\x01\x120\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.booking.v1.RefundStatusR\x06status\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x1b\n" +
+	"\x06reason\x18\x06 \x01(\tH\x01R\x06reason\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x15\n" +
+	"\x13_provider_refund_idB\t\n" +
+	"\a_reasonB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_models_payment_proto_rawDescOnce sync.Once
+	file_booking_v1_models_payment_proto_rawDescData []byte
+)
+
+func file_booking_v1_models_payment_proto_rawDescGZIP() []byte {
+	file_booking_v1_models_payment_proto_rawDescOnce.Do(func() {
+		file_booking_v1_models_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_models_payment_proto_rawDesc), len(file_booking_v1_models_payment_proto_rawDesc)))
+	})
+	return file_booking_v1_models_payment_proto_rawDescData
+}
+
+var file_booking_v1_models_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_models_payment_proto_goTypes = []any{
+	(*Payment)(nil),               // 0: booking.v1.Payment
+	(*Refund)(nil),                // 1: booking.v1.Refund
+	(PaymentStatus)(0),            // 2: booking.v1.PaymentStatus
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(RefundStatus)(0),             // 4: booking.v1.RefundStatus
+}
+var file_booking_v1_models_payment_proto_depIdxs = []int32{
+	2, // 0: booking.v1.Payment.status:type_name -> booking.v1.PaymentStatus
+	3, // 1: booking.v1.Payment.captured_at:type_name -> google.protobuf.Timestamp
+	3, // 2: booking.v1.Payment.created_at:type_name -> google.protobuf.Timestamp
+	3, // 3: booking.v1.Payment.updated_at:type_name -> google.protobuf.Timestamp
+	1, // 4: booking.v1.Payment.refunds:type_name -> booking.v1.Refund
+	4, // 5: booking.v1.Refund.status:type_name -> booking.v1.RefundStatus
+	3, // 6: booking.v1.Refund.created_at:type_name -> google.protobuf.Timestamp
+	3, // 7: booking.v1.Refund.updated_at:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_models_payment_proto_init() }
+func file_booking_v1_models_payment_proto_init() {
+	if File_booking_v1_models_payment_proto != nil {
+		return
+	}
+	file_booking_v1_enums_payment_status_proto_init()
+	file_booking_v1_enums_refund_status_proto_init()
+	file_booking_v1_models_payment_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_models_payment_proto_rawDesc), len(file_booking_v1_models_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_models_payment_proto_goTypes,
+		DependencyIndexes: file_booking_v1_models_payment_proto_depIdxs,
+		MessageInfos:      file_booking_v1_models_payment_proto_msgTypes,
+	}.Build()
+	File_booking_v1_models_payment_proto = out.File
+	file_booking_v1_models_payment_proto_goTypes = nil
+	file_booking_v1_models_payment_proto_depIdxs = nil
+}
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/preview_cancellation.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PreviewCancellationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCancellationRequest) Reset() {
+	*x = PreviewCancellationRequest{}
+	mi := &file_booking_v1_rpc_preview_cancellation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCancellationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCancellationRequest) ProtoMessage() {}
+
+func (x *PreviewCancellationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_preview_cancellation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCancellationRequest.ProtoReflect.Descriptor instead.
+func (*PreviewCancellationRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_preview_cancellation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreviewCancellationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PreviewCancellationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cancellation  *BookingCancellation   `protobuf:"bytes,1,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCancellationResponse) Reset() {
+	*x = PreviewCancellationResponse{}
+	mi := &file_booking_v1_rpc_preview_cancellation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCancellationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCancellationResponse) ProtoMessage() {}
+
+func (x *PreviewCancellationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_preview_cancellation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCancellationResponse.ProtoReflect.Descriptor instead.
+func (*PreviewCancellationResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_preview_cancellation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreviewCancellationResponse) GetCancellation() *BookingCancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_preview_cancellation_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_preview_cancellation_proto_rawDesc = "" +
+	"\n" +
+	")booking/v1/rpc/preview_cancellation.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a,booking/v1/models/booking_cancellation.proto\"6\n" +
+	"\x1aPreviewCancellationRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"b\n" +
+	"\x1bPreviewCancellationResponse\x12C\n" +
+	"\fcancellation\x18\x01 \x01(\v2\x1f.booking.v1.BookingCancellationR\fcancellationB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_preview_cancellation_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_preview_cancellation_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_preview_cancellation_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_preview_cancellation_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_preview_cancellation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_preview_cancellation_proto_rawDesc), len(file_booking_v1_rpc_preview_cancellation_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_preview_cancellation_proto_rawDescData
+}
+
+var file_booking_v1_rpc_preview_cancellation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_preview_cancellation_proto_goTypes = []any{
+	(*PreviewCancellationRequest)(nil),  // 0: booking.v1.PreviewCancellationRequest
+	(*PreviewCancellationResponse)(nil), // 1: booking.v1.PreviewCancellationResponse
+	(*BookingCancellation)(nil),         // 2: booking.v1.BookingCancellation
+}
+var file_booking_v1_rpc_preview_cancellation_proto_depIdxs = []int32{
+	2, // 0: booking.v1.PreviewCancellationResponse.cancellation:type_name -> booking.v1.BookingCancellation
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_preview_cancellation_proto_init() }
+func file_booking_v1_rpc_preview_cancellation_proto_init() {
+	if File_booking_v1_rpc_preview_cancellation_proto != nil {
+		return
+	}
+	file_booking_v1_models_booking_cancellation_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_preview_cancellation_proto_rawDesc), len(file_booking_v1_rpc_preview_cancellation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_preview_cancellation_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_preview_cancellation_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_preview_cancellation_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_preview_cancellation_proto = out.File
+	file_booking_v1_rpc_preview_cancellation_proto_goTypes = nil
+	file_booking_v1_rpc_preview_cancellation_proto_depIdxs = nil
+}
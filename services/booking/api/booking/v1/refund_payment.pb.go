@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/refund_payment.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RefundPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_booking_v1_rpc_refund_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_refund_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_refund_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RefundPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type RefundPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refund        *Refund                `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	mi := &file_booking_v1_rpc_refund_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_refund_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_refund_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RefundPaymentResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_refund_payment_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_refund_payment_proto_rawDesc = "" +
+	"\n" +
+	"#booking/v1/rpc/refund_payment.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fbooking/v1/models/payment.proto\"\xad\x01\n" +
+	"\x14RefundPaymentRequest\x12'\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tpaymentId\x128\n" +
+	"\x06amount\x18\x02 \x01(\tB \xbaH\x1dr\x1b\x10\x012\x17^[0-9]+(\\.[0-9]{1,2})?$R\x06amount\x12'\n" +
+	"\x06reason\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03H\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"C\n" +
+	"\x15RefundPaymentResponse\x12*\n" +
+	"\x06refund\x18\x01 \x01(\v2\x12.booking.v1.RefundR\x06refundB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_refund_payment_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_refund_payment_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_refund_payment_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_refund_payment_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_refund_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_refund_payment_proto_rawDesc), len(file_booking_v1_rpc_refund_payment_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_refund_payment_proto_rawDescData
+}
+
+var file_booking_v1_rpc_refund_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_refund_payment_proto_goTypes = []any{
+	(*RefundPaymentRequest)(nil),  // 0: booking.v1.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil), // 1: booking.v1.RefundPaymentResponse
+	(*Refund)(nil),                // 2: booking.v1.Refund
+}
+var file_booking_v1_rpc_refund_payment_proto_depIdxs = []int32{
+	2, // 0: booking.v1.RefundPaymentResponse.refund:type_name -> booking.v1.Refund
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_refund_payment_proto_init() }
+func file_booking_v1_rpc_refund_payment_proto_init() {
+	if File_booking_v1_rpc_refund_payment_proto != nil {
+		return
+	}
+	file_booking_v1_models_payment_proto_init()
+	file_booking_v1_rpc_refund_payment_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_refund_payment_proto_rawDesc), len(file_booking_v1_rpc_refund_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_refund_payment_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_refund_payment_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_refund_payment_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_refund_payment_proto = out.File
+	file_booking_v1_rpc_refund_payment_proto_goTypes = nil
+	file_booking_v1_rpc_refund_payment_proto_depIdxs = nil
+}
@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/models/room_availability.proto
+
+package bookingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RoomOccupancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StayRange     *DateRange             `protobuf:"bytes,1,opt,name=stay_range,json=stayRange,proto3" json:"stay_range,omitempty"`
+	Kind          RoomOccupancyKind      `protobuf:"varint,2,opt,name=kind,proto3,enum=booking.v1.RoomOccupancyKind" json:"kind,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomOccupancy) Reset() {
+	*x = RoomOccupancy{}
+	mi := &file_booking_v1_models_room_availability_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomOccupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomOccupancy) ProtoMessage() {}
+
+func (x *RoomOccupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_room_availability_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomOccupancy.ProtoReflect.Descriptor instead.
+func (*RoomOccupancy) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_room_availability_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoomOccupancy) GetStayRange() *DateRange {
+	if x != nil {
+		return x.StayRange
+	}
+	return nil
+}
+
+func (x *RoomOccupancy) GetKind() RoomOccupancyKind {
+	if x != nil {
+		return x.Kind
+	}
+	return RoomOccupancyKind_ROOM_OCCUPANCY_KIND_UNSPECIFIED
+}
+
+func (x *RoomOccupancy) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+type RoomAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	Occupancies   []*RoomOccupancy       `protobuf:"bytes,3,rep,name=occupancies,proto3" json:"occupancies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomAvailability) Reset() {
+	*x = RoomAvailability{}
+	mi := &file_booking_v1_models_room_availability_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomAvailability) ProtoMessage() {}
+
+func (x *RoomAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_room_availability_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomAvailability.ProtoReflect.Descriptor instead.
+func (*RoomAvailability) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_room_availability_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RoomAvailability) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomAvailability) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *RoomAvailability) GetOccupancies() []*RoomOccupancy {
+	if x != nil {
+		return x.Occupancies
+	}
+	return nil
+}
+
+type CategoryAvailability struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId     string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TotalRooms     uint32                 `protobuf:"varint,2,opt,name=total_rooms,json=totalRooms,proto3" json:"total_rooms,omitempty"`
+	AvailableRooms uint32                 `protobuf:"varint,3,opt,name=available_rooms,json=availableRooms,proto3" json:"available_rooms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CategoryAvailability) Reset() {
+	*x = CategoryAvailability{}
+	mi := &file_booking_v1_models_room_availability_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAvailability) ProtoMessage() {}
+
+func (x *CategoryAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_models_room_availability_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAvailability.ProtoReflect.Descriptor instead.
+func (*CategoryAvailability) Descriptor() ([]byte, []int) {
+	return file_booking_v1_models_room_availability_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CategoryAvailability) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CategoryAvailability) GetTotalRooms() uint32 {
+	if x != nil {
+		return x.TotalRooms
+	}
+	return 0
+}
+
+func (x *CategoryAvailability) GetAvailableRooms() uint32 {
+	if x != nil {
+		return x.AvailableRooms
+	}
+	return 0
+}
+
+var File_booking_v1_models_room_availability_proto protoreflect.FileDescriptor
+
+const file_booking_v1_models_room_availability_proto_rawDesc = "" +
+	"\n" +
+	")booking/v1/models/room_availability.proto\x12\n" +
+	"booking.v1\x1a*booking/v1/enums/room_occupancy_kind.proto\x1a\x1ebooking/v1/models/common.proto\"\x9b\x01\n" +
+	"\rRoomOccupancy\x124\n" +
+	"\n" +
+	"stay_range\x18\x01 \x01(\v2\x15.booking.v1.DateRangeR\tstayRange\x121\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1d.booking.v1.RoomOccupancyKindR\x04kind\x12!\n" +
+	"\freference_id\x18\x03 \x01(\tR\vreferenceId\"\x86\x01\n" +
+	"\x10RoomAvailability\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x12;\n" +
+	"\voccupancies\x18\x03 \x03(\v2\x19.booking.v1.RoomOccupancyR\voccupancies\"\x81\x01\n" +
+	"\x14CategoryAvailability\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x1f\n" +
+	"\vtotal_rooms\x18\x02 \x01(\rR\n" +
+	"totalRooms\x12'\n" +
+	"\x0favailable_rooms\x18\x03 \x01(\rR\x0eavailableRoomsB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_models_room_availability_proto_rawDescOnce sync.Once
+	file_booking_v1_models_room_availability_proto_rawDescData []byte
+)
+
+func file_booking_v1_models_room_availability_proto_rawDescGZIP() []byte {
+	file_booking_v1_models_room_availability_proto_rawDescOnce.Do(func() {
+		file_booking_v1_models_room_availability_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_models_room_availability_proto_rawDesc), len(file_booking_v1_models_room_availability_proto_rawDesc)))
+	})
+	return file_booking_v1_models_room_availability_proto_rawDescData
+}
+
+var file_booking_v1_models_room_availability_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_booking_v1_models_room_availability_proto_goTypes = []any{
+	(*RoomOccupancy)(nil),        // 0: booking.v1.RoomOccupancy
+	(*RoomAvailability)(nil),     // 1: booking.v1.RoomAvailability
+	(*CategoryAvailability)(nil), // 2: booking.v1.CategoryAvailability
+	(*DateRange)(nil),            // 3: booking.v1.DateRange
+	(RoomOccupancyKind)(0),       // 4: booking.v1.RoomOccupancyKind
+}
+var file_booking_v1_models_room_availability_proto_depIdxs = []int32{
+	3, // 0: booking.v1.RoomOccupancy.stay_range:type_name -> booking.v1.DateRange
+	4, // 1: booking.v1.RoomOccupancy.kind:type_name -> booking.v1.RoomOccupancyKind
+	0, // 2: booking.v1.RoomAvailability.occupancies:type_name -> booking.v1.RoomOccupancy
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_models_room_availability_proto_init() }
+func file_booking_v1_models_room_availability_proto_init() {
+	if File_booking_v1_models_room_availability_proto != nil {
+		return
+	}
+	file_booking_v1_enums_room_occupancy_kind_proto_init()
+	file_booking_v1_models_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_models_room_availability_proto_rawDesc), len(file_booking_v1_models_room_availability_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_models_room_availability_proto_goTypes,
+		DependencyIndexes: file_booking_v1_models_room_availability_proto_depIdxs,
+		MessageInfos:      file_booking_v1_models_room_availability_proto_msgTypes,
+	}.Build()
+	File_booking_v1_models_room_availability_proto = out.File
+	file_booking_v1_models_room_availability_proto_goTypes = nil
+	file_booking_v1_models_room_availability_proto_depIdxs = nil
+}
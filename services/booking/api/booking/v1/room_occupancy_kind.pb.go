@@ -0,0 +1,130 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/enums/room_occupancy_kind.proto
+
+package bookingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RoomOccupancyKind int32
+
+const (
+	RoomOccupancyKind_ROOM_OCCUPANCY_KIND_UNSPECIFIED RoomOccupancyKind = 0
+	RoomOccupancyKind_ROOM_OCCUPANCY_KIND_BOOKING     RoomOccupancyKind = 1
+	RoomOccupancyKind_ROOM_OCCUPANCY_KIND_BLOCK       RoomOccupancyKind = 2
+)
+
+// Enum value maps for RoomOccupancyKind.
+var (
+	RoomOccupancyKind_name = map[int32]string{
+		0: "ROOM_OCCUPANCY_KIND_UNSPECIFIED",
+		1: "ROOM_OCCUPANCY_KIND_BOOKING",
+		2: "ROOM_OCCUPANCY_KIND_BLOCK",
+	}
+	RoomOccupancyKind_value = map[string]int32{
+		"ROOM_OCCUPANCY_KIND_UNSPECIFIED": 0,
+		"ROOM_OCCUPANCY_KIND_BOOKING":     1,
+		"ROOM_OCCUPANCY_KIND_BLOCK":       2,
+	}
+)
+
+func (x RoomOccupancyKind) Enum() *RoomOccupancyKind {
+	p := new(RoomOccupancyKind)
+	*p = x
+	return p
+}
+
+func (x RoomOccupancyKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomOccupancyKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_enums_room_occupancy_kind_proto_enumTypes[0].Descriptor()
+}
+
+func (RoomOccupancyKind) Type() protoreflect.EnumType {
+	return &file_booking_v1_enums_room_occupancy_kind_proto_enumTypes[0]
+}
+
+func (x RoomOccupancyKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomOccupancyKind.Descriptor instead.
+func (RoomOccupancyKind) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_enums_room_occupancy_kind_proto_rawDescGZIP(), []int{0}
+}
+
+var File_booking_v1_enums_room_occupancy_kind_proto protoreflect.FileDescriptor
+
+const file_booking_v1_enums_room_occupancy_kind_proto_rawDesc = "" +
+	"\n" +
+	"*booking/v1/enums/room_occupancy_kind.proto\x12\n" +
+	"booking.v1*x\n" +
+	"\x11RoomOccupancyKind\x12#\n" +
+	"\x1fROOM_OCCUPANCY_KIND_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bROOM_OCCUPANCY_KIND_BOOKING\x10\x01\x12\x1d\n" +
+	"\x19ROOM_OCCUPANCY_KIND_BLOCK\x10\x02B\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_enums_room_occupancy_kind_proto_rawDescOnce sync.Once
+	file_booking_v1_enums_room_occupancy_kind_proto_rawDescData []byte
+)
+
+func file_booking_v1_enums_room_occupancy_kind_proto_rawDescGZIP() []byte {
+	file_booking_v1_enums_room_occupancy_kind_proto_rawDescOnce.Do(func() {
+		file_booking_v1_enums_room_occupancy_kind_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_enums_room_occupancy_kind_proto_rawDesc), len(file_booking_v1_enums_room_occupancy_kind_proto_rawDesc)))
+	})
+	return file_booking_v1_enums_room_occupancy_kind_proto_rawDescData
+}
+
+var file_booking_v1_enums_room_occupancy_kind_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_booking_v1_enums_room_occupancy_kind_proto_goTypes = []any{
+	(RoomOccupancyKind)(0), // 0: booking.v1.RoomOccupancyKind
+}
+var file_booking_v1_enums_room_occupancy_kind_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_enums_room_occupancy_kind_proto_init() }
+func file_booking_v1_enums_room_occupancy_kind_proto_init() {
+	if File_booking_v1_enums_room_occupancy_kind_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_enums_room_occupancy_kind_proto_rawDesc), len(file_booking_v1_enums_room_occupancy_kind_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_enums_room_occupancy_kind_proto_goTypes,
+		DependencyIndexes: file_booking_v1_enums_room_occupancy_kind_proto_depIdxs,
+		EnumInfos:         file_booking_v1_enums_room_occupancy_kind_proto_enumTypes,
+	}.Build()
+	File_booking_v1_enums_room_occupancy_kind_proto = out.File
+	file_booking_v1_enums_room_occupancy_kind_proto_goTypes = nil
+	file_booking_v1_enums_room_occupancy_kind_proto_depIdxs = nil
+}
@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/update_booking_guest.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateBookingGuestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuestName       *string                `protobuf:"bytes,2,opt,name=guest_name,json=guestName,proto3,oneof" json:"guest_name,omitempty"`
+	GuestEmail      *string                `protobuf:"bytes,3,opt,name=guest_email,json=guestEmail,proto3,oneof" json:"guest_email,omitempty"`
+	GuestPhone      *string                `protobuf:"bytes,4,opt,name=guest_phone,json=guestPhone,proto3,oneof" json:"guest_phone,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateBookingGuestRequest) Reset() {
+	*x = UpdateBookingGuestRequest{}
+	mi := &file_booking_v1_rpc_update_booking_guest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingGuestRequest) ProtoMessage() {}
+
+func (x *UpdateBookingGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_update_booking_guest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingGuestRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookingGuestRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_update_booking_guest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateBookingGuestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBookingGuestRequest) GetGuestName() string {
+	if x != nil && x.GuestName != nil {
+		return *x.GuestName
+	}
+	return ""
+}
+
+func (x *UpdateBookingGuestRequest) GetGuestEmail() string {
+	if x != nil && x.GuestEmail != nil {
+		return *x.GuestEmail
+	}
+	return ""
+}
+
+func (x *UpdateBookingGuestRequest) GetGuestPhone() string {
+	if x != nil && x.GuestPhone != nil {
+		return *x.GuestPhone
+	}
+	return ""
+}
+
+func (x *UpdateBookingGuestRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateBookingGuestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingGuestResponse) Reset() {
+	*x = UpdateBookingGuestResponse{}
+	mi := &file_booking_v1_rpc_update_booking_guest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingGuestResponse) ProtoMessage() {}
+
+func (x *UpdateBookingGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_update_booking_guest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingGuestResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBookingGuestResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_update_booking_guest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateBookingGuestResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_update_booking_guest_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_update_booking_guest_proto_rawDesc = "" +
+	"\n" +
+	")booking/v1/rpc/update_booking_guest.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fbooking/v1/models/booking.proto\"\xed\x03\n" +
+	"\x19UpdateBookingGuestRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12+\n" +
+	"\n" +
+	"guest_name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\tguestName\x88\x01\x01\x12-\n" +
+	"\vguest_email\x18\x03 \x01(\tB\a\xbaH\x04r\x02`\x01H\x01R\n" +
+	"guestEmail\x88\x01\x01\x12/\n" +
+	"\vguest_phone\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x05\x18 H\x02R\n" +
+	"guestPhone\x88\x01\x01\x127\n" +
+	"\x10expected_version\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x03R\x0fexpectedVersion\x88\x01\x01:\xab\x01\xbaH\xa7\x01\x1a\xa4\x01\n" +
+	"\x15booking.guest.present\x12Cat least one of guest_name, guest_email and guest_phone must be set\x1aFhas(this.guest_name) || has(this.guest_email) || has(this.guest_phone)B\r\n" +
+	"\v_guest_nameB\x0e\n" +
+	"\f_guest_emailB\x0e\n" +
+	"\f_guest_phoneB\x13\n" +
+	"\x11_expected_version\"K\n" +
+	"\x1aUpdateBookingGuestResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abookingB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_update_booking_guest_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_update_booking_guest_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_update_booking_guest_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_update_booking_guest_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_update_booking_guest_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_update_booking_guest_proto_rawDesc), len(file_booking_v1_rpc_update_booking_guest_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_update_booking_guest_proto_rawDescData
+}
+
+var file_booking_v1_rpc_update_booking_guest_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_update_booking_guest_proto_goTypes = []any{
+	(*UpdateBookingGuestRequest)(nil),  // 0: booking.v1.UpdateBookingGuestRequest
+	(*UpdateBookingGuestResponse)(nil), // 1: booking.v1.UpdateBookingGuestResponse
+	(*Booking)(nil),                    // 2: booking.v1.Booking
+}
+var file_booking_v1_rpc_update_booking_guest_proto_depIdxs = []int32{
+	2, // 0: booking.v1.UpdateBookingGuestResponse.booking:type_name -> booking.v1.Booking
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_update_booking_guest_proto_init() }
+func file_booking_v1_rpc_update_booking_guest_proto_init() {
+	if File_booking_v1_rpc_update_booking_guest_proto != nil {
+		return
+	}
+	file_booking_v1_models_booking_proto_init()
+	file_booking_v1_rpc_update_booking_guest_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_update_booking_guest_proto_rawDesc), len(file_booking_v1_rpc_update_booking_guest_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_update_booking_guest_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_update_booking_guest_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_update_booking_guest_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_update_booking_guest_proto = out.File
+	file_booking_v1_rpc_update_booking_guest_proto_goTypes = nil
+	file_booking_v1_rpc_update_booking_guest_proto_depIdxs = nil
+}
@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: booking/v1/rpc/update_booking_room_guests.proto
+
+package bookingv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateBookingRoomGuestsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BookingRoomId   string                 `protobuf:"bytes,1,opt,name=booking_room_id,json=bookingRoomId,proto3" json:"booking_room_id,omitempty"`
+	Adults          uint32                 `protobuf:"varint,2,opt,name=adults,proto3" json:"adults,omitempty"`
+	Children        uint32                 `protobuf:"varint,3,opt,name=children,proto3" json:"children,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateBookingRoomGuestsRequest) Reset() {
+	*x = UpdateBookingRoomGuestsRequest{}
+	mi := &file_booking_v1_rpc_update_booking_room_guests_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingRoomGuestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingRoomGuestsRequest) ProtoMessage() {}
+
+func (x *UpdateBookingRoomGuestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_update_booking_room_guests_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingRoomGuestsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookingRoomGuestsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_update_booking_room_guests_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateBookingRoomGuestsRequest) GetBookingRoomId() string {
+	if x != nil {
+		return x.BookingRoomId
+	}
+	return ""
+}
+
+func (x *UpdateBookingRoomGuestsRequest) GetAdults() uint32 {
+	if x != nil {
+		return x.Adults
+	}
+	return 0
+}
+
+func (x *UpdateBookingRoomGuestsRequest) GetChildren() uint32 {
+	if x != nil {
+		return x.Children
+	}
+	return 0
+}
+
+func (x *UpdateBookingRoomGuestsRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateBookingRoomGuestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingRoomGuestsResponse) Reset() {
+	*x = UpdateBookingRoomGuestsResponse{}
+	mi := &file_booking_v1_rpc_update_booking_room_guests_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingRoomGuestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingRoomGuestsResponse) ProtoMessage() {}
+
+func (x *UpdateBookingRoomGuestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_rpc_update_booking_room_guests_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingRoomGuestsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBookingRoomGuestsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_rpc_update_booking_room_guests_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateBookingRoomGuestsResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+var File_booking_v1_rpc_update_booking_room_guests_proto protoreflect.FileDescriptor
+
+const file_booking_v1_rpc_update_booking_room_guests_proto_rawDesc = "" +
+	"\n" +
+	"/booking/v1/rpc/update_booking_room_guests.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fbooking/v1/models/booking.proto\"\xe8\x01\n" +
+	"\x1eUpdateBookingRoomGuestsRequest\x120\n" +
+	"\x0fbooking_room_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\rbookingRoomId\x12!\n" +
+	"\x06adults\x18\x02 \x01(\rB\t\xbaH\x06*\x04\x18\x14(\x01R\x06adults\x12#\n" +
+	"\bchildren\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18\x14R\bchildren\x127\n" +
+	"\x10expected_version\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"P\n" +
+	"\x1fUpdateBookingRoomGuestsResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abookingB\x1aZ\x18api/booking/v1;bookingv1b\x06proto3"
+
+var (
+	file_booking_v1_rpc_update_booking_room_guests_proto_rawDescOnce sync.Once
+	file_booking_v1_rpc_update_booking_room_guests_proto_rawDescData []byte
+)
+
+func file_booking_v1_rpc_update_booking_room_guests_proto_rawDescGZIP() []byte {
+	file_booking_v1_rpc_update_booking_room_guests_proto_rawDescOnce.Do(func() {
+		file_booking_v1_rpc_update_booking_room_guests_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_update_booking_room_guests_proto_rawDesc), len(file_booking_v1_rpc_update_booking_room_guests_proto_rawDesc)))
+	})
+	return file_booking_v1_rpc_update_booking_room_guests_proto_rawDescData
+}
+
+var file_booking_v1_rpc_update_booking_room_guests_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_v1_rpc_update_booking_room_guests_proto_goTypes = []any{
+	(*UpdateBookingRoomGuestsRequest)(nil),  // 0: booking.v1.UpdateBookingRoomGuestsRequest
+	(*UpdateBookingRoomGuestsResponse)(nil), // 1: booking.v1.UpdateBookingRoomGuestsResponse
+	(*Booking)(nil),                         // 2: booking.v1.Booking
+}
+var file_booking_v1_rpc_update_booking_room_guests_proto_depIdxs = []int32{
+	2, // 0: booking.v1.UpdateBookingRoomGuestsResponse.booking:type_name -> booking.v1.Booking
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_booking_v1_rpc_update_booking_room_guests_proto_init() }
+func file_booking_v1_rpc_update_booking_room_guests_proto_init() {
+	if File_booking_v1_rpc_update_booking_room_guests_proto != nil {
+		return
+	}
+	file_booking_v1_models_booking_proto_init()
+	file_booking_v1_rpc_update_booking_room_guests_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_rpc_update_booking_room_guests_proto_rawDesc), len(file_booking_v1_rpc_update_booking_room_guests_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_booking_v1_rpc_update_booking_room_guests_proto_goTypes,
+		DependencyIndexes: file_booking_v1_rpc_update_booking_room_guests_proto_depIdxs,
+		MessageInfos:      file_booking_v1_rpc_update_booking_room_guests_proto_msgTypes,
+	}.Build()
+	File_booking_v1_rpc_update_booking_room_guests_proto = out.File
+	file_booking_v1_rpc_update_booking_room_guests_proto_goTypes = nil
+	file_booking_v1_rpc_update_booking_room_guests_proto_depIdxs = nil
+}
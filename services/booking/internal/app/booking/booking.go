@@ -1,12 +1,16 @@
 package booking
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"google.golang.org/grpc"
@@ -14,9 +18,22 @@ import (
 
 	bookingv1 "booking/api/booking/v1"
 	"booking/internal/config"
+	"booking/internal/grpc/client"
 	"booking/internal/grpc/handler"
+	"booking/internal/grpc/interceptor"
+	httphandler "booking/internal/http/handler"
+	"booking/internal/http/router"
+	"booking/internal/payment/fake"
 	"booking/internal/repository/postgres"
 	"booking/internal/service"
+	"booking/internal/utils/consts"
+	"outbox"
+	"outbox/publisher"
+)
+
+const (
+	idempotencyPurgeInterval = time.Hour
+	shutdownTimeout          = 10 * time.Second
 )
 
 type App struct {
@@ -37,7 +54,18 @@ func (app *App) MustLoadGRPC() {
 		panic(err.Error())
 	}
 
-	svc := service.New(repo)
+	hotelClient, err := client.NewHotelClient(app.Config.HotelService)
+	if err != nil {
+		panic(err.Error())
+	}
+	defer func() { _ = hotelClient.Close() }()
+
+	payments, err := newPaymentProvider(app.Config.Payment)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	svc := service.New(repo, hotelClient, payments)
 	h := handler.New(svc, validator)
 
 	addr := fmt.Sprintf("%s:%d", app.Config.Server.Host, app.Config.Server.Port)
@@ -46,11 +74,32 @@ func (app *App) MustLoadGRPC() {
 		panic(err.Error())
 	}
 
-	grpcServer := newGRPCServer(app.Logger)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go purgeIdempotencyKeys(ctx, repo, idempotencyPurgeInterval)
+	go markNoShows(ctx, svc, app.Config.NoShow)
+	go expireHolds(ctx, svc, app.Config.HoldExpiry)
+
+	pub, err := publisher.New(app.Config.Outbox)
+	if err != nil {
+		panic(err.Error())
+	}
+	defer func() { _ = pub.Close() }()
+	go outbox.NewRelay(repo, pub, app.Config.Outbox).Run(ctx)
+
+	grpcServer := newGRPCServer(app.Logger, interceptor.Idempotency(repo, app.Config.Idempotency))
 
 	bookingv1.RegisterBookingServiceServer(grpcServer, h)
+	bookingv1.RegisterRoomAvailabilityServiceServer(grpcServer, h)
+	bookingv1.RegisterPaymentServiceServer(grpcServer, h)
 	reflection.Register(grpcServer)
 
+	webhookServer := &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", app.Config.WebhookServer.Host, app.Config.WebhookServer.Port),
+		Handler:           router.New(httphandler.New(svc)),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
 	go func() {
 		slog.Info("Starting gRPC server", "address", addr)
 		if err = grpcServer.Serve(lis); err != nil {
@@ -58,15 +107,100 @@ func (app *App) MustLoadGRPC() {
 		}
 	}()
 
-	app.gracefulShutdown(grpcServer)
+	go func() {
+		slog.Info("Starting webhook server", "address", webhookServer.Addr)
+		if err := webhookServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Failed to serve webhooks", "error", err)
+		}
+	}()
+
+	app.gracefulShutdown(grpcServer, webhookServer)
 }
 
-func (app *App) gracefulShutdown(grpcServer *grpc.Server) {
+func (app *App) gracefulShutdown(grpcServer *grpc.Server, webhookServer *http.Server) {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	<-quit
 
+	slog.Info("Shutting down webhook server...")
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := webhookServer.Shutdown(ctx); err != nil {
+		slog.Error("Failed to shut down webhook server", "error", err)
+	}
+
 	slog.Info("Shutting down gRPC server...")
 	grpcServer.GracefulStop()
 	slog.Info("gRPC server stopped")
 }
+
+func newPaymentProvider(cfg config.PaymentConfig) (service.PaymentProvider, error) {
+	switch cfg.Provider {
+	case fake.Name:
+		return fake.New(cfg.WebhookSecret), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", consts.ErrUnknownPaymentProvider, cfg.Provider)
+	}
+}
+
+// purgeIdempotencyKeys drops expired idempotency keys until ctx is cancelled.
+func purgeIdempotencyKeys(ctx context.Context, repo *postgres.Repository, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := repo.DeleteExpiredIdempotencyKeys(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to delete expired idempotency keys", "err", err)
+				continue
+			}
+			slog.DebugContext(ctx, "deleted expired idempotency keys", "count", deleted)
+		}
+	}
+}
+
+// markNoShows marks bookings whose guests never arrived as no-shows until ctx
+// is cancelled.
+func markNoShows(ctx context.Context, svc *service.Service, cfg config.NoShowConfig) {
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			marked, err := svc.MarkNoShows(ctx, cfg.BatchSize)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to mark no-show bookings", "err", err)
+				continue
+			}
+			slog.DebugContext(ctx, "marked no-show bookings", "count", marked)
+		}
+	}
+}
+
+// expireHolds expires pending bookings whose room hold ran out until ctx is
+// cancelled.
+func expireHolds(ctx context.Context, svc *service.Service, cfg config.HoldExpiryConfig) {
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := svc.ExpireHolds(ctx, cfg.BatchSize)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to expire booking holds", "err", err)
+				continue
+			}
+			slog.DebugContext(ctx, "expired booking holds", "count", expired)
+		}
+	}
+}
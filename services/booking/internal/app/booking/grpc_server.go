@@ -8,7 +8,7 @@ import (
 	"google.golang.org/grpc"
 )
 
-func newGRPCServer(logger *slog.Logger) *grpc.Server {
+func newGRPCServer(logger *slog.Logger, unary ...grpc.UnaryServerInterceptor) *grpc.Server {
 	opts := []logging.Option{
 		logging.WithLogOnEvents(logging.FinishCall),
 		logging.WithFieldsFromContext(
@@ -21,7 +21,10 @@ func newGRPCServer(logger *slog.Logger) *grpc.Server {
 
 	return grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
-			logging.UnaryServerInterceptor(interceptorLogger(logger), opts...),
+			append(
+				[]grpc.UnaryServerInterceptor{logging.UnaryServerInterceptor(interceptorLogger(logger), opts...)},
+				unary...,
+			)...,
 		),
 		grpc.ChainStreamInterceptor(
 			logging.StreamServerInterceptor(interceptorLogger(logger), opts...),
@@ -1,7 +1,11 @@
 package config
 
 import (
+	"time"
+
 	"github.com/ilyakaznacheev/cleanenv"
+
+	"outbox"
 )
 
 type ServerConfig struct {
@@ -18,11 +22,50 @@ type PostgresConfig struct {
 	Port     int    `yaml:"port"`
 }
 
+type ClientConfig struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+}
+
+// IdempotencyConfig keeps responses for TTL; a request holds its key for Lease,
+// which must outlast the slowest request, before a retry may take it over.
+type IdempotencyConfig struct {
+	TTL   time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL" env-default:"24h"`
+	Lease time.Duration `yaml:"lease" env:"IDEMPOTENCY_LEASE" env-default:"1m"`
+}
+
+// PaymentConfig picks the payment provider; only "fake" exists so far.
+type PaymentConfig struct {
+	Provider      string `yaml:"provider" env:"PAYMENT_PROVIDER" env-default:"fake"`
+	WebhookSecret string `yaml:"webhook_secret" env:"PAYMENT_WEBHOOK_SECRET"`
+}
+
+// NoShowConfig sets up the job marking confirmed bookings whose guests never
+// arrived as no-shows once their check-in day is over.
+type NoShowConfig struct {
+	Interval  time.Duration `yaml:"interval" env:"NO_SHOW_INTERVAL" env-default:"1h"`
+	BatchSize int           `yaml:"batch_size" env:"NO_SHOW_BATCH_SIZE" env-default:"100"`
+}
+
+// HoldExpiryConfig sets up the job expiring pending bookings whose room hold
+// ran out before they were paid.
+type HoldExpiryConfig struct {
+	Interval  time.Duration `yaml:"interval" env:"HOLD_EXPIRY_INTERVAL" env-default:"1m"`
+	BatchSize int           `yaml:"batch_size" env:"HOLD_EXPIRY_BATCH_SIZE" env-default:"100"`
+}
+
 type Config struct {
-	Env      string         `yaml:"env"`
-	LogLevel string         `yaml:"log_level"`
-	Postgres PostgresConfig `yaml:"postgres"`
-	Server   ServerConfig   `yaml:"server"`
+	Env           string            `yaml:"env"`
+	LogLevel      string            `yaml:"log_level"`
+	Postgres      PostgresConfig    `yaml:"postgres"`
+	Server        ServerConfig      `yaml:"server"`
+	WebhookServer ServerConfig      `yaml:"webhook_server"`
+	HotelService  ClientConfig      `yaml:"hotel_service"`
+	Idempotency   IdempotencyConfig `yaml:"idempotency"`
+	Payment       PaymentConfig     `yaml:"payment"`
+	Outbox        outbox.Config     `yaml:"outbox"`
+	NoShow        NoShowConfig      `yaml:"no_show"`
+	HoldExpiry    HoldExpiryConfig  `yaml:"hold_expiry"`
 }
 
 func New(configPath string) (*Config, error) {
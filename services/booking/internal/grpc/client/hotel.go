@@ -0,0 +1,248 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"booking/internal/config"
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+	hotelv1 "hotel/api/hotel/v1"
+)
+
+type HotelClient struct {
+	conn         *grpc.ClientConn
+	hotels       hotelv1.HotelServiceClient
+	rooms        hotelv1.RoomServiceClient
+	categories   hotelv1.RoomCategoryServiceClient
+	ratePlans    hotelv1.RatePlanServiceClient
+	restrictions hotelv1.StayRestrictionServiceClient
+}
+
+func NewHotelClient(cfg config.ClientConfig) (*HotelClient, error) {
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &HotelClient{
+		conn:         conn,
+		hotels:       hotelv1.NewHotelServiceClient(conn),
+		rooms:        hotelv1.NewRoomServiceClient(conn),
+		categories:   hotelv1.NewRoomCategoryServiceClient(conn),
+		ratePlans:    hotelv1.NewRatePlanServiceClient(conn),
+		restrictions: hotelv1.NewStayRestrictionServiceClient(conn),
+	}, nil
+}
+
+func (c *HotelClient) Close() error {
+	return c.conn.Close()
+}
+
+// GetHotelPolicy returns the hotel's stay times and policy as a booking snapshot.
+// Hotels without a policy yield a snapshot with only the stay times; deleted
+// hotels are reported as not found.
+func (c *HotelClient) GetHotelPolicy(ctx context.Context, hotelID uuid.UUID) (*models.PolicySnapshot, error) {
+	resp, err := c.hotels.GetHotelByID(ctx, &hotelv1.GetHotelByIDRequest{Id: hotelID.String()})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, consts.ErrHotelNotFound
+		}
+		return nil, fmt.Errorf("hotel service: %w", err)
+	}
+	if resp.Hotel.DeletedAt != nil {
+		return nil, consts.ErrHotelNotFound
+	}
+
+	hotel := resp.Hotel
+	snapshot := &models.PolicySnapshot{
+		Timezone:     hotel.Timezone,
+		CheckInTime:  hotel.CheckInTime,
+		CheckOutTime: hotel.CheckOutTime,
+	}
+	if hotel.Policy == nil {
+		return snapshot, nil
+	}
+
+	policy := hotel.Policy
+	snapshot.LatestCheckInTime = policy.LatestCheckInTime
+	snapshot.PrepaymentPercent = policy.PrepaymentPercent
+	snapshot.CancellationTiers = make([]models.CancellationTier, len(policy.CancellationTiers))
+	for i, tier := range policy.CancellationTiers {
+		snapshot.CancellationTiers[i] = models.CancellationTier{
+			Penalty:            models.CancellationPenalty(tier.Penalty.String()),
+			HoursBeforeCheckIn: tier.HoursBeforeCheckIn,
+			PenaltyPercent:     tier.PenaltyPercent,
+		}
+	}
+	snapshot.ChildAgeBands = make([]models.ChildAgeBand, len(policy.ChildAgeBands))
+	for i, band := range policy.ChildAgeBands {
+		snapshot.ChildAgeBands[i] = models.ChildAgeBand{
+			MinAge:       band.MinAge,
+			MaxAge:       band.MaxAge,
+			PricePercent: band.PricePercent,
+		}
+	}
+	snapshot.Pets = models.PetPolicy{
+		Allowed: policy.Pets.GetAllowed(),
+		MaxPets: policy.Pets.GetMaxPets(),
+	}
+	if fee := policy.Pets.GetFeePerNight(); fee != "" {
+		feePerNight, err := decimal.NewFromString(fee)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Pets.FeePerNight = &feePerNight
+	}
+
+	return snapshot, nil
+}
+
+func (c *HotelClient) QuoteStay(
+	ctx context.Context,
+	roomID uuid.UUID,
+	checkIn time.Time,
+	checkOut time.Time,
+) (*models.StayQuote, error) {
+	resp, err := c.ratePlans.QuoteStay(
+		ctx, &hotelv1.QuoteStayRequest{
+			RoomId:   roomID.String(),
+			CheckIn:  timestamppb.New(checkIn),
+			CheckOut: timestamppb.New(checkOut),
+		},
+	)
+	if err != nil {
+		return nil, hotelErrToDomain(err)
+	}
+
+	quote := &models.StayQuote{
+		Nights: make([]models.NightlyRate, len(resp.Quote.Nights)),
+	}
+	if quote.TotalAmount, err = decimal.NewFromString(resp.Quote.TotalAmount); err != nil {
+		return nil, err
+	}
+	for i, n := range resp.Quote.Nights {
+		price, err := decimal.NewFromString(n.Price)
+		if err != nil {
+			return nil, err
+		}
+		quote.Nights[i] = models.NightlyRate{Date: n.Date.AsTime(), Price: price}
+	}
+
+	return quote, nil
+}
+
+func (c *HotelClient) CheckStay(
+	ctx context.Context,
+	roomID uuid.UUID,
+	checkIn time.Time,
+	checkOut time.Time,
+) ([]models.StayViolation, error) {
+	resp, err := c.restrictions.CheckStay(
+		ctx, &hotelv1.CheckStayRequest{
+			RoomId:   roomID.String(),
+			CheckIn:  timestamppb.New(checkIn),
+			CheckOut: timestamppb.New(checkOut),
+		},
+	)
+	if err != nil {
+		return nil, hotelErrToDomain(err)
+	}
+
+	violations := make([]models.StayViolation, len(resp.Violations))
+	for i, v := range resp.Violations {
+		violations[i] = models.StayViolation{
+			Rule:    v.Rule.String(),
+			Message: v.Message,
+			RoomID:  roomID,
+		}
+	}
+
+	return violations, nil
+}
+
+// GetRoom returns the hotel and category a live room belongs to and its capacity.
+func (c *HotelClient) GetRoom(ctx context.Context, roomID uuid.UUID) (*models.HotelRoom, error) {
+	resp, err := c.rooms.GetRoom(ctx, &hotelv1.GetRoomRequest{Id: roomID.String()})
+	if err != nil {
+		return nil, hotelErrToDomain(err)
+	}
+
+	room := &models.HotelRoom{ID: roomID, Capacity: uint32(resp.Room.Capacity)}
+	if room.HotelID, err = uuid.Parse(resp.Room.HotelId); err != nil {
+		return nil, err
+	}
+	if resp.Room.CategoryId != nil {
+		categoryID, err := uuid.Parse(*resp.Room.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		room.CategoryID = &categoryID
+	}
+
+	return room, nil
+}
+
+func (c *HotelClient) GetRoomCategory(ctx context.Context, categoryID uuid.UUID) (*models.RoomCategory, error) {
+	resp, err := c.categories.GetRoomCategory(ctx, &hotelv1.GetRoomCategoryRequest{Id: categoryID.String()})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, consts.ErrRoomCategoryNotFound
+		}
+		return nil, fmt.Errorf("hotel service: %w", err)
+	}
+
+	category := resp.RoomCategory
+	result := &models.RoomCategory{
+		ID:       categoryID,
+		RoomIDs:  make([]uuid.UUID, len(category.RoomIds)),
+		Capacity: uint32(category.Capacity),
+	}
+	if result.HotelID, err = uuid.Parse(category.HotelId); err != nil {
+		return nil, err
+	}
+	for i, id := range category.RoomIds {
+		if result.RoomIDs[i], err = uuid.Parse(id); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// UpdateRoomStatus sets the housekeeping status of a room as the front desk
+// checks guests in and out.
+func (c *HotelClient) UpdateRoomStatus(ctx context.Context, roomID uuid.UUID, roomStatus models.RoomStatus) error {
+	_, err := c.rooms.UpdateRoomStatus(
+		ctx, &hotelv1.UpdateRoomStatusRequest{
+			Id:     roomID.String(),
+			Status: hotelv1.RoomStatus(hotelv1.RoomStatus_value[string(roomStatus)]),
+		},
+	)
+	if err != nil {
+		return hotelErrToDomain(err)
+	}
+
+	return nil
+}
+
+func hotelErrToDomain(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return consts.ErrRoomNotFound
+	case codes.InvalidArgument:
+		return consts.ErrInvalidDates
+	default:
+		return fmt.Errorf("hotel service: %w", err)
+	}
+}
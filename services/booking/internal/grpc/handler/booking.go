@@ -94,6 +94,25 @@ func (h *Handler) GetBooking(
 	}, nil
 }
 
+func (h *Handler) FindBookingByCode(
+	ctx context.Context,
+	req *bookingv1.FindBookingByCodeRequest,
+) (*bookingv1.FindBookingByCodeResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	booking, err := h.svc.FindBookingByCode(ctx, req.Code, mapper.FindBookingByCodeRequestToDomain(req))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.FindBookingByCodeResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
+
 func (h *Handler) ConfirmBookingStatus(
 	ctx context.Context,
 	req *bookingv1.ConfirmBookingStatusRequest,
@@ -107,13 +126,20 @@ func (h *Handler) ConfirmBookingStatus(
 		return nil, consts.ErrInvalidBookingID
 	}
 
-	if err = h.svc.UpdateBookingStatus(ctx, bookingId, models.BookingStatusConfirmed); err != nil {
+	change := &models.BookingStatusChange{
+		ActorID: req.ActorId,
+		Reason:  req.Reason,
+		To:      models.BookingStatusConfirmed,
+	}
+	version, err := h.svc.UpdateBookingStatus(ctx, bookingId, change, req.ExpectedVersion)
+	if err != nil {
 		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
 		return nil, helper.HandleDomainErr(err)
 	}
 
 	return &bookingv1.ConfirmBookingStatusResponse{
-		Status: mapper.BookingStatusToProto(models.BookingStatusConfirmed),
+		Status:  mapper.BookingStatusToProto(models.BookingStatusConfirmed),
+		Version: version,
 	}, nil
 }
 
@@ -130,13 +156,45 @@ func (h *Handler) CancelBookingStatus(
 		return nil, consts.ErrInvalidBookingID
 	}
 
-	if err = h.svc.UpdateBookingStatus(ctx, bookingId, models.BookingStatusCancelled); err != nil {
+	change := &models.BookingStatusChange{
+		ActorID: req.ActorId,
+		Reason:  req.Reason,
+		To:      models.BookingStatusCancelled,
+	}
+	cancellation, version, err := h.svc.CancelBooking(ctx, bookingId, change, req.ExpectedVersion)
+	if err != nil {
 		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
 		return nil, helper.HandleDomainErr(err)
 	}
 
 	return &bookingv1.CancelBookingStatusResponse{
-		Status: mapper.BookingStatusToProto(models.BookingStatusCancelled),
+		Status:       mapper.BookingStatusToProto(models.BookingStatusCancelled),
+		Version:      version,
+		Cancellation: mapper.BookingCancellationToProto(cancellation),
+	}, nil
+}
+
+func (h *Handler) PreviewCancellation(
+	ctx context.Context,
+	req *bookingv1.PreviewCancellationRequest,
+) (*bookingv1.PreviewCancellationResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingId, err := mapper.GetBookingRequestToDomain(req.Id)
+	if err != nil {
+		return nil, consts.ErrInvalidBookingID
+	}
+
+	cancellation, err := h.svc.PreviewCancellation(ctx, bookingId)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.PreviewCancellationResponse{
+		Cancellation: mapper.BookingCancellationToProto(cancellation),
 	}, nil
 }
 
@@ -162,3 +220,99 @@ func (h *Handler) DeleteBooking(
 		Message: "success",
 	}, nil
 }
+
+func (h *Handler) GetActiveBookings(
+	ctx context.Context,
+	req *bookingv1.GetActiveBookingsRequest,
+) (*bookingv1.GetActiveBookingsResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	target, err := mapper.ActiveBookingTargetRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	ids, err := h.svc.GetActiveBookings(ctx, target)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.GetActiveBookingsResponse{
+		BookingIds: mapper.BookingIDsToProto(ids),
+	}, nil
+}
+
+func (h *Handler) CancelActiveBookings(
+	ctx context.Context,
+	req *bookingv1.CancelActiveBookingsRequest,
+) (*bookingv1.CancelActiveBookingsResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	target, err := mapper.ActiveBookingTargetRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	ids, err := h.svc.CancelActiveBookings(ctx, target)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.CancelActiveBookingsResponse{
+		BookingIds: mapper.BookingIDsToProto(ids),
+	}, nil
+}
+
+func (h *Handler) ReassignBookingRoom(
+	ctx context.Context,
+	req *bookingv1.ReassignBookingRoomRequest,
+) (*bookingv1.ReassignBookingRoomResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingRoomID, roomID, err := mapper.ReassignBookingRoomRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	bookingRoom, err := h.svc.ReassignBookingRoom(ctx, bookingRoomID, roomID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.ReassignBookingRoomResponse{
+		BookingRoom: mapper.BookingRoomWithLockToProto(bookingRoom),
+	}, nil
+}
+
+func (h *Handler) GetBookingHistory(
+	ctx context.Context,
+	req *bookingv1.GetBookingHistoryRequest,
+) (*bookingv1.GetBookingHistoryResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingId, err := mapper.GetBookingRequestToDomain(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	history, err := h.svc.GetBookingHistory(ctx, bookingId)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.GetBookingHistoryResponse{
+		Transitions: mapper.BookingStatusHistoryToProto(history),
+	}, nil
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/grpc/utils/helper"
+	"booking/internal/grpc/utils/mapper"
+)
+
+func (h *Handler) UpdateBookingGuest(
+	ctx context.Context,
+	req *bookingv1.UpdateBookingGuestRequest,
+) (*bookingv1.UpdateBookingGuestResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingID, guest, err := mapper.UpdateBookingGuestRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	booking, err := h.svc.UpdateBookingGuest(ctx, bookingID, guest, req.ExpectedVersion)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.UpdateBookingGuestResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
+
+func (h *Handler) ChangeBookingDates(
+	ctx context.Context,
+	req *bookingv1.ChangeBookingDatesRequest,
+) (*bookingv1.ChangeBookingDatesResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingID, stay, expectedTotal, err := mapper.ChangeBookingDatesRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	booking, err := h.svc.ChangeBookingDates(ctx, bookingID, stay, expectedTotal, req.ExpectedVersion)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.ChangeBookingDatesResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
+
+func (h *Handler) AddBookingRoom(
+	ctx context.Context,
+	req *bookingv1.AddBookingRoomRequest,
+) (*bookingv1.AddBookingRoomResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingID, room, expectedTotal, err := mapper.AddBookingRoomRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	booking, err := h.svc.AddBookingRoom(ctx, bookingID, room, expectedTotal, req.ExpectedVersion)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.AddBookingRoomResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
+
+func (h *Handler) RemoveBookingRoom(
+	ctx context.Context,
+	req *bookingv1.RemoveBookingRoomRequest,
+) (*bookingv1.RemoveBookingRoomResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingRoomID, err := mapper.BookingRoomIDToDomain(req.BookingRoomId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	booking, err := h.svc.RemoveBookingRoom(ctx, bookingRoomID, req.ExpectedVersion)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.RemoveBookingRoomResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
+
+func (h *Handler) UpdateBookingRoomGuests(
+	ctx context.Context,
+	req *bookingv1.UpdateBookingRoomGuestsRequest,
+) (*bookingv1.UpdateBookingRoomGuestsResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingRoomID, counts, err := mapper.UpdateBookingRoomGuestsRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	booking, err := h.svc.UpdateBookingRoomGuests(ctx, bookingRoomID, counts, req.ExpectedVersion)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.UpdateBookingRoomGuestsResponse{
+		Booking: mapper.BookingToProto(booking),
+	}, nil
+}
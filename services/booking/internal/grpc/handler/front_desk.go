@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/grpc/utils/helper"
+	"booking/internal/grpc/utils/mapper"
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+func (h *Handler) CheckIn(
+	ctx context.Context,
+	req *bookingv1.CheckInRequest,
+) (*bookingv1.CheckInResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingId, err := mapper.GetBookingRequestToDomain(req.Id)
+	if err != nil {
+		return nil, consts.ErrInvalidBookingID
+	}
+
+	change := &models.BookingStatusChange{
+		ActorID: req.ActorId,
+		Reason:  req.Reason,
+		To:      models.BookingStatusCheckedIn,
+	}
+	version, err := h.svc.CheckIn(ctx, bookingId, change, req.ExpectedVersion)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.CheckInResponse{
+		Status:  mapper.BookingStatusToProto(models.BookingStatusCheckedIn),
+		Version: version,
+	}, nil
+}
+
+func (h *Handler) CheckOut(
+	ctx context.Context,
+	req *bookingv1.CheckOutRequest,
+) (*bookingv1.CheckOutResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingId, err := mapper.GetBookingRequestToDomain(req.Id)
+	if err != nil {
+		return nil, consts.ErrInvalidBookingID
+	}
+
+	change := &models.BookingStatusChange{
+		ActorID: req.ActorId,
+		Reason:  req.Reason,
+		To:      models.BookingStatusCheckedOut,
+	}
+	version, err := h.svc.CheckOut(ctx, bookingId, change, req.ExpectedVersion)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.CheckOutResponse{
+		Status:  mapper.BookingStatusToProto(models.BookingStatusCheckedOut),
+		Version: version,
+	}, nil
+}
+
+func (h *Handler) MarkNoShow(
+	ctx context.Context,
+	req *bookingv1.MarkNoShowRequest,
+) (*bookingv1.MarkNoShowResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingId, err := mapper.GetBookingRequestToDomain(req.Id)
+	if err != nil {
+		return nil, consts.ErrInvalidBookingID
+	}
+
+	change := &models.BookingStatusChange{
+		ActorID: req.ActorId,
+		Reason:  req.Reason,
+		To:      models.BookingStatusNoShow,
+	}
+	version, err := h.svc.MarkNoShow(ctx, bookingId, change, req.ExpectedVersion)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.MarkNoShowResponse{
+		Status:  mapper.BookingStatusToProto(models.BookingStatusNoShow),
+		Version: version,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"buf.build/go/protovalidate"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
 	bookingv1 "booking/api/booking/v1"
 	"booking/internal/repository/models"
@@ -18,16 +19,77 @@ type BookingService interface {
 		ctx context.Context, bookingRef models.BookingRef, page uint64, limit uint64,
 	) (*models.BookingList, error)
 	GetBookingById(ctx context.Context, bookingID uuid.UUID) (*models.Booking, error)
-	UpdateBookingStatus(ctx context.Context, bookingID uuid.UUID, status models.BookingStatus) error
+	FindBookingByCode(ctx context.Context, code string, check models.BookingGuestCheck) (*models.Booking, error)
+	UpdateBookingStatus(
+		ctx context.Context, bookingID uuid.UUID, change *models.BookingStatusChange, expectedVersion *int64,
+	) (int64, error)
+	CancelBooking(
+		ctx context.Context, bookingID uuid.UUID, change *models.BookingStatusChange, expectedVersion *int64,
+	) (*models.BookingCancellation, int64, error)
+	CheckIn(
+		ctx context.Context, bookingID uuid.UUID, change *models.BookingStatusChange, expectedVersion *int64,
+	) (int64, error)
+	CheckOut(
+		ctx context.Context, bookingID uuid.UUID, change *models.BookingStatusChange, expectedVersion *int64,
+	) (int64, error)
+	MarkNoShow(
+		ctx context.Context, bookingID uuid.UUID, change *models.BookingStatusChange, expectedVersion *int64,
+	) (int64, error)
+	PreviewCancellation(ctx context.Context, bookingID uuid.UUID) (*models.BookingCancellation, error)
+	GetBookingHistory(ctx context.Context, bookingID uuid.UUID) ([]*models.BookingStatusTransition, error)
 	DeleteBookingByID(ctx context.Context, id uuid.UUID) error
+	GetActiveBookings(ctx context.Context, target models.ActiveBookingTarget) ([]uuid.UUID, error)
+	CancelActiveBookings(ctx context.Context, target models.ActiveBookingTarget) ([]uuid.UUID, error)
+	ReassignBookingRoom(
+		ctx context.Context, bookingRoomID uuid.UUID, roomID uuid.UUID,
+	) (*models.BookingRoomWithLock, error)
+	UpdateBookingGuest(
+		ctx context.Context, bookingID uuid.UUID, guest *models.UpdateBooking, expectedVersion *int64,
+	) (*models.Booking, error)
+	ChangeBookingDates(
+		ctx context.Context, bookingID uuid.UUID, stay models.DateRange, expectedTotal decimal.Decimal,
+		expectedVersion *int64,
+	) (*models.Booking, error)
+	AddBookingRoom(
+		ctx context.Context, bookingID uuid.UUID, room *models.CreateBookingRoom, expectedTotal decimal.Decimal,
+		expectedVersion *int64,
+	) (*models.Booking, error)
+	RemoveBookingRoom(ctx context.Context, bookingRoomID uuid.UUID, expectedVersion *int64) (*models.Booking, error)
+	UpdateBookingRoomGuests(
+		ctx context.Context, bookingRoomID uuid.UUID, counts models.BookingRoomGuestCounts, expectedVersion *int64,
+	) (*models.Booking, error)
+}
+
+type RoomAvailabilityService interface {
+	BlockRoom(ctx context.Context, block *models.CreateRoomBlock) (*models.RoomLockDetail, error)
+	UnblockRoom(ctx context.Context, blockID uuid.UUID) error
+	GetRoomAvailability(
+		ctx context.Context, roomIDs []uuid.UUID, stayRange models.DateRange,
+	) ([]*models.RoomAvailability, error)
+	GetCategoryAvailability(
+		ctx context.Context, categoryIDs []uuid.UUID, stayRange models.DateRange,
+	) ([]*models.CategoryAvailability, error)
+}
+
+type PaymentService interface {
+	CreatePayment(ctx context.Context, bookingID uuid.UUID) (*models.Payment, error)
+	CapturePayment(ctx context.Context, paymentID uuid.UUID) (*models.Payment, error)
+	RefundPayment(
+		ctx context.Context, paymentID uuid.UUID, amount decimal.Decimal, reason *string,
+	) (*models.Refund, error)
+	GetBookingPayments(ctx context.Context, bookingID uuid.UUID) ([]*models.Payment, error)
 }
 
 type Service interface {
 	BookingService
+	RoomAvailabilityService
+	PaymentService
 }
 
 type Handler struct {
 	bookingv1.UnimplementedBookingServiceServer
+	bookingv1.UnimplementedRoomAvailabilityServiceServer
+	bookingv1.UnimplementedPaymentServiceServer
 	svc       Service
 	validator protovalidate.Validator
 }
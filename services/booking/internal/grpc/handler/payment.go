@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/grpc/utils/helper"
+	"booking/internal/grpc/utils/mapper"
+)
+
+func (h *Handler) CreatePayment(
+	ctx context.Context,
+	req *bookingv1.CreatePaymentRequest,
+) (*bookingv1.CreatePaymentResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingID, err := mapper.GetBookingRequestToDomain(req.BookingId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	payment, err := h.svc.CreatePayment(ctx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.CreatePaymentResponse{
+		Payment: mapper.PaymentToProto(payment),
+	}, nil
+}
+
+func (h *Handler) CapturePayment(
+	ctx context.Context,
+	req *bookingv1.CapturePaymentRequest,
+) (*bookingv1.CapturePaymentResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	paymentID, err := mapper.PaymentIDToDomain(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	payment, err := h.svc.CapturePayment(ctx, paymentID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.CapturePaymentResponse{
+		Payment: mapper.PaymentToProto(payment),
+	}, nil
+}
+
+func (h *Handler) RefundPayment(
+	ctx context.Context,
+	req *bookingv1.RefundPaymentRequest,
+) (*bookingv1.RefundPaymentResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	paymentID, amount, err := mapper.RefundPaymentRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	refund, err := h.svc.RefundPayment(ctx, paymentID, amount, req.Reason)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.RefundPaymentResponse{
+		Refund: mapper.RefundToProto(refund),
+	}, nil
+}
+
+func (h *Handler) GetBookingPayments(
+	ctx context.Context,
+	req *bookingv1.GetBookingPaymentsRequest,
+) (*bookingv1.GetBookingPaymentsResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingID, err := mapper.GetBookingRequestToDomain(req.BookingId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	payments, err := h.svc.GetBookingPayments(ctx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.GetBookingPaymentsResponse{
+		Payments: mapper.PaymentsToProto(payments),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/grpc/utils/helper"
+	"booking/internal/grpc/utils/mapper"
+	"booking/internal/utils/consts"
+)
+
+func (h *Handler) BlockRoom(
+	ctx context.Context,
+	req *bookingv1.BlockRoomRequest,
+) (*bookingv1.BlockRoomResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	block, err := mapper.BlockRoomRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	lock, err := h.svc.BlockRoom(ctx, block)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.BlockRoomResponse{
+		RoomLock: mapper.RoomBlockLockToProto(lock),
+	}, nil
+}
+
+func (h *Handler) UnblockRoom(
+	ctx context.Context,
+	req *bookingv1.UnblockRoomRequest,
+) (*bookingv1.UnblockRoomResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	blockID, err := uuid.Parse(req.BlockId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(consts.ErrInvalidBlockID)
+	}
+
+	if err = h.svc.UnblockRoom(ctx, blockID); err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.UnblockRoomResponse{
+		Message: "success",
+	}, nil
+}
+
+func (h *Handler) GetRoomAvailability(
+	ctx context.Context,
+	req *bookingv1.GetRoomAvailabilityRequest,
+) (*bookingv1.GetRoomAvailabilityResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	roomIDs, err := mapper.RoomIDsToDomain(req.RoomIds)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	rooms, err := h.svc.GetRoomAvailability(ctx, roomIDs, mapper.DateRangeToDomain(req.StayRange))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.GetRoomAvailabilityResponse{
+		Rooms: mapper.RoomAvailabilityListToProto(rooms),
+	}, nil
+}
+
+func (h *Handler) GetCategoryAvailability(
+	ctx context.Context,
+	req *bookingv1.GetCategoryAvailabilityRequest,
+) (*bookingv1.GetCategoryAvailabilityResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	categoryIDs, err := mapper.CategoryIDsToDomain(req.CategoryIds)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	categories, err := h.svc.GetCategoryAvailability(ctx, categoryIDs, mapper.DateRangeToDomain(req.StayRange))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &bookingv1.GetCategoryAvailabilityResponse{
+		Categories: mapper.CategoryAvailabilityListToProto(categories),
+	}, nil
+}
@@ -0,0 +1,211 @@
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/config"
+	"booking/internal/grpc/utils/helper"
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+// IdempotencyKeyHeader is the metadata key, and so the HTTP/2 header, clients
+// send a unique key per logical request in.
+const IdempotencyKeyHeader = "idempotency-key"
+
+const maxIdempotencyKeyLen = 255
+
+// idempotentMethods are the mutating RPCs that honour idempotency keys.
+var idempotentMethods = map[string]struct{}{
+	bookingv1.BookingService_CreateBooking_FullMethodName:           {},
+	bookingv1.BookingService_ConfirmBookingStatus_FullMethodName:    {},
+	bookingv1.BookingService_CancelBookingStatus_FullMethodName:     {},
+	bookingv1.BookingService_CheckIn_FullMethodName:                 {},
+	bookingv1.BookingService_CheckOut_FullMethodName:                {},
+	bookingv1.BookingService_MarkNoShow_FullMethodName:              {},
+	bookingv1.BookingService_DeleteBooking_FullMethodName:           {},
+	bookingv1.BookingService_CancelActiveBookings_FullMethodName:    {},
+	bookingv1.BookingService_ReassignBookingRoom_FullMethodName:     {},
+	bookingv1.BookingService_UpdateBookingGuest_FullMethodName:      {},
+	bookingv1.BookingService_ChangeBookingDates_FullMethodName:      {},
+	bookingv1.BookingService_AddBookingRoom_FullMethodName:          {},
+	bookingv1.BookingService_RemoveBookingRoom_FullMethodName:       {},
+	bookingv1.BookingService_UpdateBookingRoomGuests_FullMethodName: {},
+	bookingv1.RoomAvailabilityService_BlockRoom_FullMethodName:      {},
+	bookingv1.RoomAvailabilityService_UnblockRoom_FullMethodName:    {},
+	bookingv1.PaymentService_CreatePayment_FullMethodName:           {},
+	bookingv1.PaymentService_CapturePayment_FullMethodName:          {},
+	bookingv1.PaymentService_RefundPayment_FullMethodName:           {},
+}
+
+type IdempotencyStore interface {
+	ReserveIdempotencyKey(
+		ctx context.Context, rec *models.IdempotencyRecord,
+	) (*models.IdempotencyRecord, bool, error)
+	CompleteIdempotencyKey(ctx context.Context, rec *models.IdempotencyRecord, response []byte) error
+	ReleaseIdempotencyKey(ctx context.Context, rec *models.IdempotencyRecord) error
+}
+
+// Idempotency replays the stored response of a request repeated by the same
+// caller of the same method under the same idempotency key within cfg.TTL.
+// Only successful responses are stored, so a failed request can be retried with
+// its key, and a request still pending after cfg.Lease is taken over by its
+// retry.
+func Idempotency(store IdempotencyStore, cfg config.IdempotencyConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := idempotentMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		key := idempotencyKey(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, helper.HandleDomainErr(consts.ErrInvalidIdempotencyKey)
+		}
+
+		fingerprint, err := requestFingerprint(info.FullMethod, req)
+		if err != nil {
+			return nil, helper.HandleDomainErr(err)
+		}
+
+		now := time.Now()
+		claim := &models.IdempotencyRecord{
+			ExpiresAt:   now.Add(cfg.TTL),
+			LockedUntil: now.Add(cfg.Lease),
+			Key:         key,
+			Method:      info.FullMethod,
+			Caller:      requestCaller(req),
+			Fingerprint: fingerprint,
+			Holder:      uuid.New(),
+		}
+		rec, reserved, err := store.ReserveIdempotencyKey(ctx, claim)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to reserve idempotency key", "err", err)
+			return nil, helper.HandleDomainErr(err)
+		}
+		if !reserved {
+			return replay(rec, fingerprint)
+		}
+
+		// The outcome is recorded even when the client has gone away.
+		storeCtx := context.WithoutCancel(ctx)
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if releaseErr := store.ReleaseIdempotencyKey(storeCtx, claim); releaseErr != nil {
+				slog.ErrorContext(ctx, "failed to release idempotency key", "err", releaseErr)
+			}
+			return nil, err
+		}
+
+		response, err := marshalResponse(resp)
+		if err == nil {
+			err = store.CompleteIdempotencyKey(storeCtx, claim, response)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to store idempotent response", "err", err)
+		}
+
+		return resp, nil
+	}
+}
+
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// requestCaller names the user a request acts for, so that keys chosen by
+// different users never meet; requests acting for no one share the empty caller.
+func requestCaller(req any) string {
+	var id int64
+	switch r := req.(type) {
+	case interface{ GetActorId() int64 }:
+		id = r.GetActorId()
+	case interface{ GetUserId() int64 }:
+		id = r.GetUserId()
+	}
+	if id == 0 {
+		return ""
+	}
+
+	return "user:" + strconv.FormatInt(id, 10)
+}
+
+// requestFingerprint hashes the method with the deterministic encoding of the request.
+func requestFingerprint(method string, req any) ([]byte, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, consts.ErrInternalServer
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write(body)
+
+	return hash.Sum(nil), nil
+}
+
+func marshalResponse(resp any) ([]byte, error) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil, consts.ErrInternalServer
+	}
+
+	packed, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(packed)
+}
+
+func replay(rec *models.IdempotencyRecord, fingerprint []byte) (any, error) {
+	if !bytes.Equal(rec.Fingerprint, fingerprint) {
+		return nil, helper.HandleDomainErr(consts.ErrIdempotencyKeyReused)
+	}
+	if rec.Response == nil {
+		return nil, helper.HandleDomainErr(consts.ErrIdempotencyKeyInProgress)
+	}
+
+	var packed anypb.Any
+	if err := proto.Unmarshal(rec.Response, &packed); err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	resp, err := packed.UnmarshalNew()
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return resp, nil
+}
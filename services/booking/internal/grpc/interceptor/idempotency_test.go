@@ -0,0 +1,178 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/config"
+	"booking/internal/repository/models"
+)
+
+type recordKey struct {
+	method, caller, key string
+}
+
+// memoryStore keeps idempotency records the way the postgres queries do:
+// scoped by method and caller, with pending records taken over once their
+// lease has run out.
+type memoryStore struct {
+	records map[recordKey]*models.IdempotencyRecord
+}
+
+func (s *memoryStore) ReserveIdempotencyKey(
+	_ context.Context, rec *models.IdempotencyRecord,
+) (*models.IdempotencyRecord, bool, error) {
+	k := recordKey{rec.Method, rec.Caller, rec.Key}
+	if existing, ok := s.records[k]; ok {
+		now := time.Now()
+		live := existing.ExpiresAt.After(now) && (existing.Response != nil || existing.LockedUntil.After(now))
+		if live {
+			return existing, false, nil
+		}
+	}
+	stored := *rec
+	s.records[k] = &stored
+
+	return rec, true, nil
+}
+
+func (s *memoryStore) CompleteIdempotencyKey(_ context.Context, rec *models.IdempotencyRecord, response []byte) error {
+	if existing := s.records[recordKey{rec.Method, rec.Caller, rec.Key}]; existing != nil && existing.Holder == rec.Holder {
+		existing.Response = response
+	}
+
+	return nil
+}
+
+func (s *memoryStore) ReleaseIdempotencyKey(_ context.Context, rec *models.IdempotencyRecord) error {
+	k := recordKey{rec.Method, rec.Caller, rec.Key}
+	if existing := s.records[k]; existing != nil && existing.Holder == rec.Holder && existing.Response == nil {
+		delete(s.records, k)
+	}
+
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	const key = "5f0c2a9e-retry"
+	cancelInfo := &grpc.UnaryServerInfo{FullMethod: bookingv1.BookingService_CancelBookingStatus_FullMethodName}
+	checkInInfo := &grpc.UnaryServerInfo{FullMethod: bookingv1.BookingService_CheckIn_FullMethodName}
+	cancelBy := func(actorID int64) *bookingv1.CancelBookingStatusRequest {
+		return &bookingv1.CancelBookingStatusRequest{
+			Id:      "0b9e3b1c-54a2-4b8f-9c55-1f1c7b0e1a01",
+			ActorId: proto.Int64(actorID),
+		}
+	}
+	checkInBy := func(actorID int64) *bookingv1.CheckInRequest {
+		return &bookingv1.CheckInRequest{
+			Id:      "0b9e3b1c-54a2-4b8f-9c55-1f1c7b0e1a01",
+			ActorId: proto.Int64(actorID),
+		}
+	}
+
+	type call struct {
+		info *grpc.UnaryServerInfo
+		req  proto.Message
+	}
+	tests := []struct {
+		name string
+		// pending, when set, is how long the lease of a first request that
+		// never finished, as if its server died, has left.
+		pending   *time.Duration
+		first     call
+		second    call
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{
+			name:      "retry by the same caller is replayed",
+			first:     call{cancelInfo, cancelBy(7)},
+			second:    call{cancelInfo, cancelBy(7)},
+			wantCalls: 1,
+		},
+		{
+			name:      "another caller with the same key runs",
+			first:     call{cancelInfo, cancelBy(7)},
+			second:    call{cancelInfo, cancelBy(8)},
+			wantCalls: 2,
+		},
+		{
+			name:      "another method with the same key runs",
+			first:     call{cancelInfo, cancelBy(7)},
+			second:    call{checkInInfo, checkInBy(7)},
+			wantCalls: 2,
+		},
+		{
+			name:     "retry while the first request holds its lease waits",
+			pending:  durationPtr(time.Minute),
+			first:    call{cancelInfo, cancelBy(7)},
+			second:   call{cancelInfo, cancelBy(7)},
+			wantCode: codes.Aborted,
+		},
+		{
+			name:      "retry after the lease ran out takes the key over",
+			pending:   durationPtr(-time.Second),
+			first:     call{cancelInfo, cancelBy(7)},
+			second:    call{cancelInfo, cancelBy(7)},
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &memoryStore{records: make(map[recordKey]*models.IdempotencyRecord)}
+			intercept := Idempotency(store, config.IdempotencyConfig{TTL: time.Hour, Lease: time.Minute})
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, key))
+
+			var calls int
+			handler := func(context.Context, any) (any, error) {
+				calls++
+				return &bookingv1.CancelBookingStatusResponse{Version: int64(calls)}, nil
+			}
+
+			if tt.pending != nil {
+				fingerprint, err := requestFingerprint(tt.first.info.FullMethod, tt.first.req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				rec := &models.IdempotencyRecord{
+					ExpiresAt:   time.Now().Add(time.Hour),
+					LockedUntil: time.Now().Add(*tt.pending),
+					Key:         key,
+					Method:      tt.first.info.FullMethod,
+					Caller:      requestCaller(tt.first.req),
+					Fingerprint: fingerprint,
+				}
+				store.records[recordKey{rec.Method, rec.Caller, rec.Key}] = rec
+			} else if _, err := intercept(ctx, tt.first.req, tt.first.info, handler); err != nil {
+				t.Fatalf("first call error = %v", err)
+			}
+
+			resp, err := intercept(ctx, tt.second.req, tt.second.info, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("second call code = %v, want %v", got, tt.wantCode)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("handler calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if err != nil {
+				return
+			}
+			if got := resp.(*bookingv1.CancelBookingStatusResponse).GetVersion(); got != 1 && tt.wantCalls == 1 {
+				t.Errorf("response version = %d, want the first response", got)
+			}
+		})
+	}
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"booking/internal/repository/models"
 	"booking/internal/utils/consts"
 )
 
@@ -21,6 +22,48 @@ var (
 	errRoomLockAlreadyExist = domainErr{consts.MsgRoomLockAlreadyExist, codes.AlreadyExists}
 	errPriceChanged         = domainErr{consts.MsgPriceChanged, codes.FailedPrecondition}
 	errInternalServer       = domainErr{consts.MsgInternalServer, codes.Internal}
+	errRoomNotFound         = domainErr{consts.MsgRoomNotFound, codes.NotFound}
+	errInvalidDates         = domainErr{consts.MsgInvalidDates, codes.InvalidArgument}
+	errStayRestricted       = domainErr{consts.MsgStayRestricted, codes.FailedPrecondition}
+	errRoomLockNotFound     = domainErr{consts.MsgRoomLockNotFound, codes.NotFound}
+	errInvalidRoomID        = domainErr{consts.MsgInvalidRoomID, codes.InvalidArgument}
+	errInvalidBlockID       = domainErr{consts.MsgInvalidBlockID, codes.InvalidArgument}
+	errHotelNotFound        = domainErr{consts.MsgHotelNotFound, codes.NotFound}
+	errCheckInPassed        = domainErr{consts.MsgCheckInPassed, codes.InvalidArgument}
+	errVersionMismatch      = domainErr{consts.MsgVersionMismatch, codes.Aborted}
+	errInvalidHotelID       = domainErr{consts.MsgInvalidHotelID, codes.InvalidArgument}
+	errInvalidBookingRoomID = domainErr{consts.MsgInvalidBookingRoomID, codes.InvalidArgument}
+	errInvalidBookingStatus = domainErr{consts.MsgInvalidBookingStatus, codes.FailedPrecondition}
+
+	errRoomCategoryNotFound    = domainErr{consts.MsgRoomCategoryNotFound, codes.NotFound}
+	errInvalidRoomCategoryID   = domainErr{consts.MsgInvalidRoomCategoryID, codes.InvalidArgument}
+	errRoomCategoryUnavailable = domainErr{consts.MsgRoomCategoryUnavailable, codes.FailedPrecondition}
+	errRoomCategoryMismatch    = domainErr{consts.MsgRoomCategoryMismatch, codes.FailedPrecondition}
+
+	errInvalidBookingTransition = domainErr{consts.MsgInvalidBookingTransition, codes.FailedPrecondition}
+
+	errInvalidIdempotencyKey    = domainErr{consts.MsgInvalidIdempotencyKey, codes.InvalidArgument}
+	errIdempotencyKeyReused     = domainErr{consts.MsgIdempotencyKeyReused, codes.FailedPrecondition}
+	errIdempotencyKeyInProgress = domainErr{consts.MsgIdempotencyKeyInProgress, codes.Aborted}
+
+	errBookingNotModifiable = domainErr{consts.MsgBookingNotModifiable, codes.FailedPrecondition}
+	errLastBookingRoom      = domainErr{consts.MsgLastBookingRoom, codes.FailedPrecondition}
+	errRoomCapacityExceeded = domainErr{consts.MsgRoomCapacityExceeded, codes.FailedPrecondition}
+
+	errModificationWindowClosed = domainErr{consts.MsgModificationWindowClosed, codes.FailedPrecondition}
+
+	errPaymentNotFound      = domainErr{consts.MsgPaymentNotFound, codes.NotFound}
+	errRefundNotFound       = domainErr{consts.MsgRefundNotFound, codes.NotFound}
+	errInvalidPaymentID     = domainErr{consts.MsgInvalidPaymentID, codes.InvalidArgument}
+	errInvalidRefundAmount  = domainErr{consts.MsgInvalidRefundAmount, codes.InvalidArgument}
+	errBookingNotPayable    = domainErr{consts.MsgBookingNotPayable, codes.FailedPrecondition}
+	errPaymentNotCapturable = domainErr{consts.MsgPaymentNotCapturable, codes.FailedPrecondition}
+	errPaymentNotRefundable = domainErr{consts.MsgPaymentNotRefundable, codes.FailedPrecondition}
+	errRefundExceedsPayment = domainErr{consts.MsgRefundExceedsPayment, codes.FailedPrecondition}
+
+	errCheckInTooEarly = domainErr{consts.MsgCheckInTooEarly, codes.FailedPrecondition}
+	errStayEnded       = domainErr{consts.MsgStayEnded, codes.FailedPrecondition}
+	errNoShowTooEarly  = domainErr{consts.MsgNoShowTooEarly, codes.FailedPrecondition}
 )
 
 func HandleDomainErr(err error) error {
@@ -28,6 +71,11 @@ func HandleDomainErr(err error) error {
 		return nil
 	}
 
+	var restrictionErr *models.StayRestrictionError
+	if errors.As(err, &restrictionErr) {
+		return handleStayRestrictionErr(restrictionErr)
+	}
+
 	var domErr domainErr
 	switch {
 	case errors.Is(err, consts.ErrBookingNotFound):
@@ -38,6 +86,74 @@ func HandleDomainErr(err error) error {
 		domErr = errRoomLockAlreadyExist
 	case errors.Is(err, consts.ErrPriceChanged):
 		domErr = errPriceChanged
+	case errors.Is(err, consts.ErrRoomNotFound):
+		domErr = errRoomNotFound
+	case errors.Is(err, consts.ErrInvalidDates):
+		domErr = errInvalidDates
+	case errors.Is(err, consts.ErrRoomLockNotFound):
+		domErr = errRoomLockNotFound
+	case errors.Is(err, consts.ErrInvalidRoomID):
+		domErr = errInvalidRoomID
+	case errors.Is(err, consts.ErrInvalidBlockID):
+		domErr = errInvalidBlockID
+	case errors.Is(err, consts.ErrHotelNotFound):
+		domErr = errHotelNotFound
+	case errors.Is(err, consts.ErrCheckInPassed):
+		domErr = errCheckInPassed
+	case errors.Is(err, consts.ErrVersionMismatch):
+		domErr = errVersionMismatch
+	case errors.Is(err, consts.ErrInvalidHotelID):
+		domErr = errInvalidHotelID
+	case errors.Is(err, consts.ErrInvalidBookingRoomID):
+		domErr = errInvalidBookingRoomID
+	case errors.Is(err, consts.ErrInvalidBookingStatus):
+		domErr = errInvalidBookingStatus
+	case errors.Is(err, consts.ErrRoomCategoryNotFound):
+		domErr = errRoomCategoryNotFound
+	case errors.Is(err, consts.ErrInvalidRoomCategoryID):
+		domErr = errInvalidRoomCategoryID
+	case errors.Is(err, consts.ErrRoomCategoryUnavailable):
+		domErr = errRoomCategoryUnavailable
+	case errors.Is(err, consts.ErrRoomCategoryMismatch):
+		domErr = errRoomCategoryMismatch
+	case errors.Is(err, consts.ErrInvalidBookingTransition):
+		domErr = errInvalidBookingTransition
+	case errors.Is(err, consts.ErrInvalidIdempotencyKey):
+		domErr = errInvalidIdempotencyKey
+	case errors.Is(err, consts.ErrIdempotencyKeyReused):
+		domErr = errIdempotencyKeyReused
+	case errors.Is(err, consts.ErrIdempotencyKeyInProgress):
+		domErr = errIdempotencyKeyInProgress
+	case errors.Is(err, consts.ErrBookingNotModifiable):
+		domErr = errBookingNotModifiable
+	case errors.Is(err, consts.ErrModificationWindowClosed):
+		domErr = errModificationWindowClosed
+	case errors.Is(err, consts.ErrLastBookingRoom):
+		domErr = errLastBookingRoom
+	case errors.Is(err, consts.ErrRoomCapacityExceeded):
+		domErr = errRoomCapacityExceeded
+	case errors.Is(err, consts.ErrPaymentNotFound):
+		domErr = errPaymentNotFound
+	case errors.Is(err, consts.ErrRefundNotFound):
+		domErr = errRefundNotFound
+	case errors.Is(err, consts.ErrInvalidPaymentID):
+		domErr = errInvalidPaymentID
+	case errors.Is(err, consts.ErrInvalidRefundAmount):
+		domErr = errInvalidRefundAmount
+	case errors.Is(err, consts.ErrBookingNotPayable):
+		domErr = errBookingNotPayable
+	case errors.Is(err, consts.ErrPaymentNotCapturable):
+		domErr = errPaymentNotCapturable
+	case errors.Is(err, consts.ErrPaymentNotRefundable):
+		domErr = errPaymentNotRefundable
+	case errors.Is(err, consts.ErrRefundExceedsPayment):
+		domErr = errRefundExceedsPayment
+	case errors.Is(err, consts.ErrCheckInTooEarly):
+		domErr = errCheckInTooEarly
+	case errors.Is(err, consts.ErrStayEnded):
+		domErr = errStayEnded
+	case errors.Is(err, consts.ErrNoShowTooEarly):
+		domErr = errNoShowTooEarly
 	default:
 		domErr = errInternalServer
 	}
@@ -50,3 +166,24 @@ func HandleDomainErr(err error) error {
 	st, _ := status.New(domErr.code, "operation failed").WithDetails(ei)
 	return st.Err()
 }
+
+func handleStayRestrictionErr(err *models.StayRestrictionError) error {
+	ei := &errdetails.ErrorInfo{
+		Reason: errStayRestricted.message,
+		Domain: "user-service",
+	}
+
+	pf := &errdetails.PreconditionFailure{}
+	for _, v := range err.Violations {
+		pf.Violations = append(
+			pf.Violations, &errdetails.PreconditionFailure_Violation{
+				Type:        v.Rule,
+				Subject:     "room/" + v.RoomID.String(),
+				Description: v.Message,
+			},
+		)
+	}
+
+	st, _ := status.New(errStayRestricted.code, "operation failed").WithDetails(ei, pf)
+	return st.Err()
+}
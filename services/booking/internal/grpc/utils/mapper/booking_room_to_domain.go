@@ -13,23 +13,92 @@ func CreateBookingRoomsToDomain(rooms []*bookingv1.CreateBookingRoomRequest) ([]
 	result := make([]*models.CreateBookingRoom, len(rooms))
 
 	for i, r := range rooms {
-		roomID, err := uuid.Parse(r.RoomId)
-		if err != nil {
-			return nil, consts.ErrInvalidBookingRoomID
-		}
-
 		price, err := decimal.NewFromString(r.PricePerNight)
 		if err != nil {
 			return nil, consts.ErrInvalidPricePerNightID
 		}
 
-		result[i] = &models.CreateBookingRoom{
-			RoomID:        roomID,
+		room := &models.CreateBookingRoom{
 			Adults:        r.Adults,
 			Children:      r.Children,
 			PricePerNight: price,
 		}
+		if r.RoomId != "" {
+			roomID, err := uuid.Parse(r.RoomId)
+			if err != nil {
+				return nil, consts.ErrInvalidBookingRoomID
+			}
+			room.RoomID = &roomID
+		}
+		if r.CategoryId != "" {
+			categoryID, err := uuid.Parse(r.CategoryId)
+			if err != nil {
+				return nil, consts.ErrInvalidRoomCategoryID
+			}
+			room.CategoryID = &categoryID
+		}
+		result[i] = room
 	}
 
 	return result, nil
 }
+
+func AddBookingRoomRequestToDomain(
+	req *bookingv1.AddBookingRoomRequest,
+) (uuid.UUID, *models.CreateBookingRoom, decimal.Decimal, error) {
+	bookingID, err := uuid.Parse(req.BookingId)
+	if err != nil {
+		return uuid.Nil, nil, decimal.Zero, consts.ErrInvalidBookingID
+	}
+
+	rooms, err := CreateBookingRoomsToDomain([]*bookingv1.CreateBookingRoomRequest{req.Room})
+	if err != nil {
+		return uuid.Nil, nil, decimal.Zero, err
+	}
+
+	expectedTotal, err := ExpectedTotalAmountToDomain(req.ExpectedTotalAmount)
+	if err != nil {
+		return uuid.Nil, nil, decimal.Zero, err
+	}
+
+	return bookingID, rooms[0], expectedTotal, nil
+}
+
+func UpdateBookingRoomGuestsRequestToDomain(
+	req *bookingv1.UpdateBookingRoomGuestsRequest,
+) (uuid.UUID, models.BookingRoomGuestCounts, error) {
+	bookingRoomID, err := uuid.Parse(req.BookingRoomId)
+	if err != nil {
+		return uuid.Nil, models.BookingRoomGuestCounts{}, consts.ErrInvalidBookingRoomID
+	}
+
+	counts := models.BookingRoomGuestCounts{
+		Adults:   req.Adults,
+		Children: req.Children,
+	}
+
+	return bookingRoomID, counts, nil
+}
+
+func BookingRoomIDToDomain(idStr string) (uuid.UUID, error) {
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return uuid.Nil, consts.ErrInvalidBookingRoomID
+	}
+
+	return id, nil
+}
+
+func ReassignBookingRoomRequestToDomain(req *bookingv1.ReassignBookingRoomRequest) (uuid.UUID, uuid.UUID, error) {
+	bookingRoomID, err := uuid.Parse(req.BookingRoomId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, consts.ErrInvalidBookingRoomID
+	}
+
+	roomID, err := uuid.Parse(req.RoomId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, consts.ErrInvalidRoomID
+	}
+
+	return bookingRoomID, roomID, nil
+}
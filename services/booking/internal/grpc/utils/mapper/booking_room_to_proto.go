@@ -1,6 +1,8 @@
 package mapper
 
 import (
+	"github.com/google/uuid"
+
 	bookingv1 "booking/api/booking/v1"
 	"booking/internal/repository/models"
 )
@@ -8,7 +10,8 @@ import (
 func BookingRoomToProto(r *models.BookingRoom) *bookingv1.BookingRoom {
 	return &bookingv1.BookingRoom{
 		Id:            r.ID.String(),
-		RoomId:        r.RoomID.String(),
+		RoomId:        optionalIDToProto(r.RoomID),
+		CategoryId:    optionalIDToProto(r.CategoryID),
 		Adults:        r.Adults,
 		Children:      r.Children,
 		PricePerNight: r.PricePerNight.String(),
@@ -16,14 +19,19 @@ func BookingRoomToProto(r *models.BookingRoom) *bookingv1.BookingRoom {
 }
 
 func BookingRoomWithLockToProto(r *models.BookingRoomWithLock) *bookingv1.BookingRoomWithLock {
-	return &bookingv1.BookingRoomWithLock{
+	room := &bookingv1.BookingRoomWithLock{
 		Id:            r.ID.String(),
-		RoomId:        r.RoomID.String(),
+		RoomId:        optionalIDToProto(r.RoomID),
+		CategoryId:    optionalIDToProto(r.CategoryID),
 		Adults:        r.Adults,
 		Children:      r.Children,
 		PricePerNight: r.PricePerNight.String(),
-		RoomLock:      RoomLockToProto(&r.RoomLock),
 	}
+	if r.RoomLock != nil {
+		room.RoomLock = RoomLockToProto(r.RoomLock)
+	}
+
+	return room
 }
 
 func BookingRoomsWithLockToProto(rooms []*models.BookingRoomWithLock) []*bookingv1.BookingRoomWithLock {
@@ -41,3 +49,11 @@ func BookingRoomsToProto(rooms []*models.BookingRoom) []*bookingv1.BookingRoom {
 	}
 	return result
 }
+
+// optionalIDToProto renders a missing id as the empty string.
+func optionalIDToProto(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/repository/models"
+)
+
+func BookingStatusTransitionToProto(t *models.BookingStatusTransition) *bookingv1.BookingStatusTransition {
+	transition := &bookingv1.BookingStatusTransition{
+		Id:        t.ID.String(),
+		BookingId: t.BookingID.String(),
+		ToStatus:  BookingStatusToProto(t.To),
+		ActorId:   t.ActorID,
+		Reason:    t.Reason,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+	if t.From != nil {
+		transition.FromStatus = BookingStatusToProto(*t.From)
+	}
+
+	return transition
+}
+
+func BookingStatusHistoryToProto(history []*models.BookingStatusTransition) []*bookingv1.BookingStatusTransition {
+	result := make([]*bookingv1.BookingStatusTransition, len(history))
+	for i, t := range history {
+		result[i] = BookingStatusTransitionToProto(t)
+	}
+	return result
+}
@@ -35,6 +35,55 @@ func CreateBookingRequestToDomain(req *bookingv1.CreateBookingRequest) (*models.
 	return b, nil
 }
 
+func UpdateBookingGuestRequestToDomain(
+	req *bookingv1.UpdateBookingGuestRequest,
+) (uuid.UUID, *models.UpdateBooking, error) {
+	bookingID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return uuid.Nil, nil, consts.ErrInvalidBookingID
+	}
+
+	guest := &models.UpdateBooking{
+		GuestName:  req.GuestName,
+		GuestEmail: req.GuestEmail,
+		GuestPhone: req.GuestPhone,
+	}
+
+	return bookingID, guest, nil
+}
+
+func ChangeBookingDatesRequestToDomain(
+	req *bookingv1.ChangeBookingDatesRequest,
+) (uuid.UUID, models.DateRange, decimal.Decimal, error) {
+	bookingID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return uuid.Nil, models.DateRange{}, decimal.Zero, consts.ErrInvalidBookingID
+	}
+
+	expectedTotal, err := ExpectedTotalAmountToDomain(req.ExpectedTotalAmount)
+	if err != nil {
+		return uuid.Nil, models.DateRange{}, decimal.Zero, err
+	}
+
+	stay := models.DateRange{Start: req.CheckIn.AsTime(), End: req.CheckOut.AsTime()}
+	return bookingID, stay, expectedTotal, nil
+}
+
+// ExpectedTotalAmountToDomain reads an optional expected total; zero leaves the
+// total unchecked.
+func ExpectedTotalAmountToDomain(amount *string) (decimal.Decimal, error) {
+	if amount == nil {
+		return decimal.Zero, nil
+	}
+
+	expectedTotal, err := decimal.NewFromString(*amount)
+	if err != nil {
+		return decimal.Zero, consts.ErrInvalidExpectedTotalAmountID
+	}
+
+	return expectedTotal, nil
+}
+
 func GetBookingsRequestToDomain(req *bookingv1.GetBookingsRequest) (models.BookingRef, error) {
 	bookingRef := models.BookingRef{
 		UserID: req.UserId,
@@ -68,8 +117,57 @@ func BookingStatusToDomain(status bookingv1.BookingStatus) models.BookingStatus
 		s = models.BookingStatusConfirmed
 	case bookingv1.BookingStatus_BOOKING_STATUS_CANCELLED:
 		s = models.BookingStatusCancelled
+	case bookingv1.BookingStatus_BOOKING_STATUS_CHECKED_IN:
+		s = models.BookingStatusCheckedIn
+	case bookingv1.BookingStatus_BOOKING_STATUS_CHECKED_OUT:
+		s = models.BookingStatusCheckedOut
+	case bookingv1.BookingStatus_BOOKING_STATUS_EXPIRED:
+		s = models.BookingStatusExpired
+	case bookingv1.BookingStatus_BOOKING_STATUS_NO_SHOW:
+		s = models.BookingStatusNoShow
 	default:
 		s = models.BookingStatusUnspecified
 	}
 	return s
 }
+
+// FindBookingByCodeRequestToDomain reads how the guest proves the booking is
+// theirs; validation guarantees exactly one of the fields is set.
+func FindBookingByCodeRequestToDomain(req *bookingv1.FindBookingByCodeRequest) models.BookingGuestCheck {
+	var check models.BookingGuestCheck
+	switch c := req.GuestCheck.(type) {
+	case *bookingv1.FindBookingByCodeRequest_GuestEmail:
+		check.GuestEmail = &c.GuestEmail
+	case *bookingv1.FindBookingByCodeRequest_LastName:
+		check.LastName = &c.LastName
+	}
+
+	return check
+}
+
+type activeBookingTargetGetter interface {
+	GetHotelId() string
+	GetRoomId() string
+}
+
+// ActiveBookingTargetRequestToDomain reads the hotel or room the request points at;
+// validation guarantees exactly one of them is set.
+func ActiveBookingTargetRequestToDomain[T activeBookingTargetGetter](req T) (models.ActiveBookingTarget, error) {
+	var target models.ActiveBookingTarget
+	if hotelIDStr := req.GetHotelId(); hotelIDStr != "" {
+		hotelID, err := uuid.Parse(hotelIDStr)
+		if err != nil {
+			return models.ActiveBookingTarget{}, consts.ErrInvalidHotelID
+		}
+		target.HotelID = &hotelID
+	}
+	if roomIDStr := req.GetRoomId(); roomIDStr != "" {
+		roomID, err := uuid.Parse(roomIDStr)
+		if err != nil {
+			return models.ActiveBookingTarget{}, consts.ErrInvalidRoomID
+		}
+		target.RoomID = &roomID
+	}
+
+	return target, nil
+}
@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	bookingv1 "booking/api/booking/v1"
@@ -24,6 +25,9 @@ func BookingToProto(b *models.Booking) *bookingv1.Booking {
 		CreatedAt:           timestamppb.New(b.CreatedAt),
 		UpdatedAt:           timestamppb.New(b.UpdatedAt),
 		BookingRooms:        BookingRoomsWithLockToProto(b.BookingRooms),
+		Policy:              PolicySnapshotToProto(b.Policy),
+		Version:             b.Version,
+		Code:                b.Code,
 	}
 
 	return p
@@ -51,6 +55,7 @@ func BookingShortToProto(b *models.BookingShort) *bookingv1.BookingShort {
 		Currency:            b.Currency,
 		ExpectedTotalAmount: b.ExpectedTotalAmount.String(),
 		FinalTotalAmount:    b.FinalTotalAmount.String(),
+		Code:                b.Code,
 		BookingRooms:        BookingRoomsToProto(b.BookingRooms),
 	}
 }
@@ -63,7 +68,23 @@ func BookingStatusToProto(s models.BookingStatus) bookingv1.BookingStatus {
 		return bookingv1.BookingStatus_BOOKING_STATUS_CONFIRMED
 	case models.BookingStatusCancelled:
 		return bookingv1.BookingStatus_BOOKING_STATUS_CANCELLED
+	case models.BookingStatusCheckedIn:
+		return bookingv1.BookingStatus_BOOKING_STATUS_CHECKED_IN
+	case models.BookingStatusCheckedOut:
+		return bookingv1.BookingStatus_BOOKING_STATUS_CHECKED_OUT
+	case models.BookingStatusExpired:
+		return bookingv1.BookingStatus_BOOKING_STATUS_EXPIRED
+	case models.BookingStatusNoShow:
+		return bookingv1.BookingStatus_BOOKING_STATUS_NO_SHOW
 	default:
 		return bookingv1.BookingStatus_BOOKING_STATUS_UNSPECIFIED
 	}
 }
+
+func BookingIDsToProto(ids []uuid.UUID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
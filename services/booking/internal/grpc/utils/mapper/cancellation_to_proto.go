@@ -0,0 +1,21 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/repository/models"
+)
+
+func BookingCancellationToProto(c *models.BookingCancellation) *bookingv1.BookingCancellation {
+	return &bookingv1.BookingCancellation{
+		BookingId:      c.BookingID.String(),
+		Penalty:        cancellationPenaltyToProto(c.Penalty),
+		Fee:            c.Fee.StringFixed(2),
+		PaidAmount:     c.PaidAmount.StringFixed(2),
+		RetainedAmount: c.RetainedAmount.StringFixed(2),
+		RefundAmount:   c.RefundAmount.StringFixed(2),
+		Currency:       c.Currency,
+		CreatedAt:      timestamppb.New(c.CreatedAt),
+	}
+}
@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/utils/consts"
+)
+
+func PaymentIDToDomain(idStr string) (uuid.UUID, error) {
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return uuid.Nil, consts.ErrInvalidPaymentID
+	}
+
+	return id, nil
+}
+
+func RefundPaymentRequestToDomain(req *bookingv1.RefundPaymentRequest) (uuid.UUID, decimal.Decimal, error) {
+	paymentID, err := PaymentIDToDomain(req.PaymentId)
+	if err != nil {
+		return uuid.Nil, decimal.Zero, err
+	}
+
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil || !amount.IsPositive() {
+		return uuid.Nil, decimal.Zero, consts.ErrInvalidRefundAmount
+	}
+
+	return paymentID, amount, nil
+}
@@ -0,0 +1,84 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/repository/models"
+)
+
+func PaymentToProto(p *models.Payment) *bookingv1.Payment {
+	payment := &bookingv1.Payment{
+		Id:                p.ID.String(),
+		BookingId:         p.BookingID.String(),
+		Provider:          p.Provider,
+		ProviderPaymentId: p.ProviderPaymentID,
+		ClientSecret:      p.ClientSecret,
+		Status:            PaymentStatusToProto(p.Status),
+		Amount:            p.Amount.StringFixed(2),
+		Currency:          p.Currency,
+		CreatedAt:         timestamppb.New(p.CreatedAt),
+		UpdatedAt:         timestamppb.New(p.UpdatedAt),
+		Refunds:           RefundsToProto(p.Refunds),
+	}
+	if p.CapturedAt != nil {
+		payment.CapturedAt = timestamppb.New(*p.CapturedAt)
+	}
+
+	return payment
+}
+
+func PaymentsToProto(payments []*models.Payment) []*bookingv1.Payment {
+	result := make([]*bookingv1.Payment, len(payments))
+	for i, p := range payments {
+		result[i] = PaymentToProto(p)
+	}
+	return result
+}
+
+func RefundToProto(r *models.Refund) *bookingv1.Refund {
+	return &bookingv1.Refund{
+		Id:               r.ID.String(),
+		PaymentId:        r.PaymentID.String(),
+		ProviderRefundId: r.ProviderRefundID,
+		Status:           RefundStatusToProto(r.Status),
+		Amount:           r.Amount.StringFixed(2),
+		Reason:           r.Reason,
+		CreatedAt:        timestamppb.New(r.CreatedAt),
+		UpdatedAt:        timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func RefundsToProto(refunds []*models.Refund) []*bookingv1.Refund {
+	result := make([]*bookingv1.Refund, len(refunds))
+	for i, r := range refunds {
+		result[i] = RefundToProto(r)
+	}
+	return result
+}
+
+func PaymentStatusToProto(s models.PaymentStatus) bookingv1.PaymentStatus {
+	switch s {
+	case models.PaymentStatusPending:
+		return bookingv1.PaymentStatus_PAYMENT_STATUS_PENDING
+	case models.PaymentStatusSucceeded:
+		return bookingv1.PaymentStatus_PAYMENT_STATUS_SUCCEEDED
+	case models.PaymentStatusFailed:
+		return bookingv1.PaymentStatus_PAYMENT_STATUS_FAILED
+	default:
+		return bookingv1.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	}
+}
+
+func RefundStatusToProto(s models.RefundStatus) bookingv1.RefundStatus {
+	switch s {
+	case models.RefundStatusPending:
+		return bookingv1.RefundStatus_REFUND_STATUS_PENDING
+	case models.RefundStatusSucceeded:
+		return bookingv1.RefundStatus_REFUND_STATUS_SUCCEEDED
+	case models.RefundStatusFailed:
+		return bookingv1.RefundStatus_REFUND_STATUS_FAILED
+	default:
+		return bookingv1.RefundStatus_REFUND_STATUS_UNSPECIFIED
+	}
+}
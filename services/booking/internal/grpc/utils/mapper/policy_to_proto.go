@@ -0,0 +1,64 @@
+package mapper
+
+import (
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/repository/models"
+)
+
+func cancellationPenaltyToProto(penalty models.CancellationPenalty) bookingv1.CancellationPenalty {
+	switch penalty {
+	case models.CancellationPenaltyNone:
+		return bookingv1.CancellationPenalty_CANCELLATION_PENALTY_NONE
+	case models.CancellationPenaltyFirstNight:
+		return bookingv1.CancellationPenalty_CANCELLATION_PENALTY_FIRST_NIGHT
+	case models.CancellationPenaltyPercent:
+		return bookingv1.CancellationPenalty_CANCELLATION_PENALTY_PERCENT
+	case models.CancellationPenaltyFullStay:
+		return bookingv1.CancellationPenalty_CANCELLATION_PENALTY_FULL_STAY
+	default:
+		return bookingv1.CancellationPenalty_CANCELLATION_PENALTY_UNSPECIFIED
+	}
+}
+
+func PolicySnapshotToProto(p *models.PolicySnapshot) *bookingv1.BookingPolicy {
+	if p == nil {
+		return nil
+	}
+
+	policy := &bookingv1.BookingPolicy{
+		Timezone:          p.Timezone,
+		CheckInTime:       p.CheckInTime,
+		CheckOutTime:      p.CheckOutTime,
+		LatestCheckInTime: p.LatestCheckInTime,
+		CancellationTiers: make([]*bookingv1.CancellationTier, len(p.CancellationTiers)),
+		PrepaymentPercent: p.PrepaymentPercent,
+		ChildAgeBands:     make([]*bookingv1.ChildAgeBand, len(p.ChildAgeBands)),
+		Pets: &bookingv1.PetPolicy{
+			Allowed: p.Pets.Allowed,
+			MaxPets: p.Pets.MaxPets,
+		},
+	}
+
+	for i, tier := range p.CancellationTiers {
+		policy.CancellationTiers[i] = &bookingv1.CancellationTier{
+			HoursBeforeCheckIn: tier.HoursBeforeCheckIn,
+			Penalty:            cancellationPenaltyToProto(tier.Penalty),
+			PenaltyPercent:     tier.PenaltyPercent,
+		}
+	}
+
+	for i, band := range p.ChildAgeBands {
+		policy.ChildAgeBands[i] = &bookingv1.ChildAgeBand{
+			MinAge:       band.MinAge,
+			MaxAge:       band.MaxAge,
+			PricePercent: band.PricePercent,
+		}
+	}
+
+	if p.Pets.FeePerNight != nil {
+		fee := p.Pets.FeePerNight.StringFixed(2)
+		policy.Pets.FeePerNight = &fee
+	}
+
+	return policy
+}
@@ -0,0 +1,60 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+func DateRangeToDomain(r *bookingv1.DateRange) models.DateRange {
+	return models.DateRange{
+		Start: r.Start.AsTime(),
+		End:   r.End.AsTime(),
+	}
+}
+
+func BlockRoomRequestToDomain(req *bookingv1.BlockRoomRequest) (*models.CreateRoomBlock, error) {
+	roomID, err := uuid.Parse(req.RoomId)
+	if err != nil {
+		return nil, consts.ErrInvalidRoomID
+	}
+
+	blockID, err := uuid.Parse(req.BlockId)
+	if err != nil {
+		return nil, consts.ErrInvalidBlockID
+	}
+
+	return &models.CreateRoomBlock{
+		StayRange: DateRangeToDomain(req.StayRange),
+		RoomID:    roomID,
+		BlockID:   blockID,
+	}, nil
+}
+
+func RoomIDsToDomain(ids []string) ([]uuid.UUID, error) {
+	roomIDs := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		roomID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, consts.ErrInvalidRoomID
+		}
+		roomIDs[i] = roomID
+	}
+
+	return roomIDs, nil
+}
+
+func CategoryIDsToDomain(ids []string) ([]uuid.UUID, error) {
+	categoryIDs := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		categoryID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, consts.ErrInvalidRoomCategoryID
+		}
+		categoryIDs[i] = categoryID
+	}
+
+	return categoryIDs, nil
+}
@@ -0,0 +1,70 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/repository/models"
+)
+
+func DateRangeToProto(r models.DateRange) *bookingv1.DateRange {
+	return &bookingv1.DateRange{
+		Start: timestamppb.New(r.Start),
+		End:   timestamppb.New(r.End),
+	}
+}
+
+func RoomOccupancyKindToProto(kind models.RoomOccupancyKind) bookingv1.RoomOccupancyKind {
+	switch kind {
+	case models.RoomOccupancyKindBooking:
+		return bookingv1.RoomOccupancyKind_ROOM_OCCUPANCY_KIND_BOOKING
+	case models.RoomOccupancyKindBlock:
+		return bookingv1.RoomOccupancyKind_ROOM_OCCUPANCY_KIND_BLOCK
+	default:
+		return bookingv1.RoomOccupancyKind_ROOM_OCCUPANCY_KIND_UNSPECIFIED
+	}
+}
+
+func RoomBlockLockToProto(r *models.RoomLockDetail) *bookingv1.RoomLock {
+	return &bookingv1.RoomLock{
+		Id:        r.ID.String(),
+		StayRange: DateRangeToProto(r.StayRange),
+		IsActive:  r.ISActive,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+}
+
+func RoomAvailabilityListToProto(rooms []*models.RoomAvailability) []*bookingv1.RoomAvailability {
+	result := make([]*bookingv1.RoomAvailability, len(rooms))
+	for i, room := range rooms {
+		occupancies := make([]*bookingv1.RoomOccupancy, len(room.Occupancies))
+		for j, o := range room.Occupancies {
+			occupancies[j] = &bookingv1.RoomOccupancy{
+				StayRange:   DateRangeToProto(o.StayRange),
+				Kind:        RoomOccupancyKindToProto(o.Kind),
+				ReferenceId: o.ReferenceID.String(),
+			}
+		}
+
+		result[i] = &bookingv1.RoomAvailability{
+			RoomId:      room.RoomID.String(),
+			Available:   room.Available,
+			Occupancies: occupancies,
+		}
+	}
+
+	return result
+}
+
+func CategoryAvailabilityListToProto(categories []*models.CategoryAvailability) []*bookingv1.CategoryAvailability {
+	result := make([]*bookingv1.CategoryAvailability, len(categories))
+	for i, c := range categories {
+		result[i] = &bookingv1.CategoryAvailability{
+			CategoryId:     c.CategoryID.String(),
+			TotalRooms:     c.TotalRooms,
+			AvailableRooms: c.AvailableRooms,
+		}
+	}
+
+	return result
+}
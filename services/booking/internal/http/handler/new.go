@@ -0,0 +1,15 @@
+package handler
+
+import "context"
+
+type PaymentWebhookService interface {
+	HandlePaymentWebhook(ctx context.Context, payload []byte, signature string) error
+}
+
+type Handler struct {
+	svc PaymentWebhookService
+}
+
+func New(svc PaymentWebhookService) *Handler {
+	return &Handler{svc: svc}
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"booking/internal/utils/consts"
+)
+
+// maxWebhookBytes caps the webhook body read into memory.
+const maxWebhookBytes = 64 << 10
+
+// PaymentWebhook applies an event of the payment provider. Any non-2xx answer
+// makes the provider retry, so events for unknown payments are reported as
+// not found rather than accepted.
+func (h *Handler) PaymentWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBytes))
+	if err != nil {
+		http.Error(w, consts.MsgInvalidWebhookPayload, http.StatusBadRequest)
+		return
+	}
+
+	err = h.svc.HandlePaymentWebhook(ctx, payload, r.Header.Get(consts.PaymentSignatureHeader))
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusNoContent)
+	case errors.Is(err, consts.ErrInvalidWebhookSignature):
+		http.Error(w, consts.MsgInvalidWebhookSignature, http.StatusUnauthorized)
+	case errors.Is(err, consts.ErrInvalidWebhookPayload):
+		http.Error(w, consts.MsgInvalidWebhookPayload, http.StatusBadRequest)
+	case errors.Is(err, consts.ErrPaymentNotFound):
+		http.Error(w, consts.MsgPaymentNotFound, http.StatusNotFound)
+	case errors.Is(err, consts.ErrRefundNotFound):
+		http.Error(w, consts.MsgRefundNotFound, http.StatusNotFound)
+	default:
+		slog.ErrorContext(ctx, "failed to handle payment webhook", slog.String("error", err.Error()))
+		http.Error(w, consts.MsgInternalServer, http.StatusInternalServerError)
+	}
+}
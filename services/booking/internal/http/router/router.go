@@ -0,0 +1,14 @@
+package router
+
+import (
+	"net/http"
+
+	"booking/internal/http/handler"
+)
+
+func New(h *handler.Handler) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/webhooks/payments", h.PaymentWebhook)
+
+	return mux
+}
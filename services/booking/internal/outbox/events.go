@@ -0,0 +1,114 @@
+package outbox
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bookingv1 "booking/api/booking/v1"
+	"booking/internal/repository/models"
+	"outbox"
+)
+
+const AggregateBooking = "booking"
+
+const (
+	EventBookingCreated       = "booking.created"
+	EventBookingConfirmed     = "booking.confirmed"
+	EventBookingCancelled     = "booking.cancelled"
+	EventBookingStatusChanged = "booking.status_changed"
+	EventBookingModified      = "booking.modified"
+)
+
+func BookingCreated(b *models.Booking, at time.Time) (*outbox.Event, error) {
+	return newEvent(b, EventBookingCreated, &bookingv1.BookingCreated{
+		BookingId:   b.ID.String(),
+		UserId:      b.UserID,
+		HotelId:     b.HotelID.String(),
+		CheckIn:     timestamppb.New(b.CheckIn),
+		CheckOut:    timestamppb.New(b.CheckOut),
+		GuestName:   b.GuestName,
+		GuestEmail:  b.GuestEmail,
+		GuestPhone:  b.GuestPhone,
+		Currency:    b.Currency,
+		TotalAmount: b.FinalTotalAmount.StringFixed(2),
+		OccurredAt:  timestamppb.New(at),
+	})
+}
+
+// BookingStatusChanged describes the move of b from its current status to
+// change.To; confirmations and cancellations have events of their own.
+func BookingStatusChanged(
+	b *models.Booking,
+	change *models.BookingStatusChange,
+	at time.Time,
+) (*outbox.Event, error) {
+	switch change.To {
+	case models.BookingStatusConfirmed:
+		return newEvent(b, EventBookingConfirmed, &bookingv1.BookingConfirmed{
+			BookingId:  b.ID.String(),
+			UserId:     b.UserID,
+			HotelId:    b.HotelID.String(),
+			CheckIn:    timestamppb.New(b.CheckIn),
+			CheckOut:   timestamppb.New(b.CheckOut),
+			GuestName:  b.GuestName,
+			GuestEmail: b.GuestEmail,
+			GuestPhone: b.GuestPhone,
+			OccurredAt: timestamppb.New(at),
+		})
+	case models.BookingStatusCancelled:
+		return newEvent(b, EventBookingCancelled, &bookingv1.BookingCancelled{
+			BookingId:  b.ID.String(),
+			UserId:     b.UserID,
+			HotelId:    b.HotelID.String(),
+			GuestName:  b.GuestName,
+			GuestEmail: b.GuestEmail,
+			GuestPhone: b.GuestPhone,
+			ActorId:    change.ActorID,
+			Reason:     change.Reason,
+			OccurredAt: timestamppb.New(at),
+		})
+	default:
+		return newEvent(b, EventBookingStatusChanged, &bookingv1.BookingStatusChanged{
+			BookingId:  b.ID.String(),
+			UserId:     b.UserID,
+			HotelId:    b.HotelID.String(),
+			From:       bookingStatusToProto(b.Status),
+			To:         bookingStatusToProto(change.To),
+			ActorId:    change.ActorID,
+			Reason:     change.Reason,
+			OccurredAt: timestamppb.New(at),
+		})
+	}
+}
+
+// BookingModified carries b as it is after a change of its stay, rooms or
+// guest details.
+func BookingModified(b *models.Booking, at time.Time) (*outbox.Event, error) {
+	return newEvent(b, EventBookingModified, &bookingv1.BookingModified{
+		BookingId:   b.ID.String(),
+		UserId:      b.UserID,
+		HotelId:     b.HotelID.String(),
+		Status:      bookingStatusToProto(b.Status),
+		CheckIn:     timestamppb.New(b.CheckIn),
+		CheckOut:    timestamppb.New(b.CheckOut),
+		GuestName:   b.GuestName,
+		GuestEmail:  b.GuestEmail,
+		GuestPhone:  b.GuestPhone,
+		Currency:    b.Currency,
+		TotalAmount: b.FinalTotalAmount.StringFixed(2),
+		Version:     b.Version,
+		OccurredAt:  timestamppb.New(at),
+	})
+}
+
+func newEvent(b *models.Booking, eventType string, msg proto.Message) (*outbox.Event, error) {
+	return outbox.NewEvent(AggregateBooking, b.ID.String(), eventType, msg)
+}
+
+// bookingStatusToProto relies on the stored statuses being named after the
+// proto enum values.
+func bookingStatusToProto(s models.BookingStatus) bookingv1.BookingStatus {
+	return bookingv1.BookingStatus(bookingv1.BookingStatus_value[string(s)])
+}
@@ -0,0 +1,150 @@
+package fake
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+const Name = "fake"
+
+type intent struct {
+	status   models.PaymentStatus
+	amount   decimal.Decimal
+	refunded decimal.Decimal
+}
+
+// Provider is an in-process payment provider for local runs and tests. Intents
+// live in memory, captures succeed at once and webhooks are signed with
+// HMAC-SHA256 of the raw payload.
+type Provider struct {
+	intents map[string]*intent
+	secret  []byte
+	mu      sync.Mutex
+}
+
+// webhookEvent is the JSON body of a fake provider webhook.
+type webhookEvent struct {
+	RefundID  *string                 `json:"refund_id,omitempty"`
+	Type      models.PaymentEventType `json:"type"`
+	PaymentID string                  `json:"payment_id"`
+}
+
+func New(webhookSecret string) *Provider {
+	return &Provider{
+		intents: make(map[string]*intent),
+		secret:  []byte(webhookSecret),
+	}
+}
+
+func (p *Provider) Name() string {
+	return Name
+}
+
+func (p *Provider) CreateIntent(_ context.Context, req *models.CreatePaymentIntent) (*models.PaymentIntent, error) {
+	if req == nil {
+		return nil, consts.ErrNilObject
+	}
+
+	id := "pi_" + uuid.NewString()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.intents[id] = &intent{status: models.PaymentStatusPending, amount: req.Amount}
+
+	return &models.PaymentIntent{
+		Status:            models.PaymentStatusPending,
+		ProviderPaymentID: id,
+		ClientSecret:      id + "_secret_" + uuid.NewString(),
+	}, nil
+}
+
+func (p *Provider) Capture(_ context.Context, providerPaymentID string) (*models.PaymentIntent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	in, ok := p.intents[providerPaymentID]
+	if !ok {
+		return nil, consts.ErrPaymentNotFound
+	}
+	if in.status == models.PaymentStatusPending {
+		in.status = models.PaymentStatusSucceeded
+	}
+
+	return &models.PaymentIntent{Status: in.status, ProviderPaymentID: providerPaymentID}, nil
+}
+
+func (p *Provider) Refund(
+	_ context.Context,
+	providerPaymentID string,
+	amount decimal.Decimal,
+) (*models.ProviderRefund, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	in, ok := p.intents[providerPaymentID]
+	if !ok {
+		return nil, consts.ErrPaymentNotFound
+	}
+	if in.status != models.PaymentStatusSucceeded {
+		return nil, consts.ErrPaymentNotRefundable
+	}
+	if in.refunded.Add(amount).GreaterThan(in.amount) {
+		return nil, consts.ErrRefundExceedsPayment
+	}
+	in.refunded = in.refunded.Add(amount)
+
+	return &models.ProviderRefund{
+		Status:           models.RefundStatusSucceeded,
+		ProviderRefundID: "re_" + uuid.NewString(),
+	}, nil
+}
+
+func (p *Provider) VerifyWebhook(payload []byte, signature string) (*models.PaymentEvent, error) {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, p.sign(payload)) {
+		return nil, consts.ErrInvalidWebhookSignature
+	}
+
+	var event webhookEvent
+	if err = json.Unmarshal(payload, &event); err != nil || event.PaymentID == "" {
+		return nil, consts.ErrInvalidWebhookPayload
+	}
+
+	switch event.Type {
+	case models.PaymentEventSucceeded, models.PaymentEventFailed:
+	case models.PaymentEventRefundSucceeded, models.PaymentEventRefundFailed:
+		if event.RefundID == nil {
+			return nil, consts.ErrInvalidWebhookPayload
+		}
+	default:
+		return nil, consts.ErrInvalidWebhookPayload
+	}
+
+	return &models.PaymentEvent{
+		ProviderRefundID:  event.RefundID,
+		Type:              event.Type,
+		ProviderPaymentID: event.PaymentID,
+	}, nil
+}
+
+// Sign returns the signature header value for payload, so local tools can
+// send webhooks the provider accepts.
+func (p *Provider) Sign(payload []byte) string {
+	return hex.EncodeToString(p.sign(payload))
+}
+
+func (p *Provider) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
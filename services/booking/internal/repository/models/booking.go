@@ -8,28 +8,29 @@ import (
 )
 
 type CreateBooking struct {
+	Policy              *PolicySnapshot
 	CheckIn             time.Time
 	CheckOut            time.Time
 	GuestEmail          *string
 	GuestPhone          *string
 	GuestName           string
 	Currency            string
+	Code                string
 	ExpectedTotalAmount decimal.Decimal
 	FinalTotalAmount    decimal.Decimal
 	UserID              int64
 	HotelID             uuid.UUID
 }
 
+// UpdateBooking changes only the guest fields that are set.
 type UpdateBooking struct {
-	GuestName   *string
-	GuestEmail  *string
-	GuestPhone  *string
-	CheckIn     *time.Time
-	CheckOut    *time.Time
-	TotalAmount *string
+	GuestName  *string
+	GuestEmail *string
+	GuestPhone *string
 }
 
 type Booking struct {
+	Policy              *PolicySnapshot
 	CreatedAt           time.Time
 	CheckIn             time.Time
 	UpdatedAt           time.Time
@@ -39,10 +40,12 @@ type Booking struct {
 	Status              BookingStatus
 	Currency            string
 	GuestName           string
+	Code                string
 	ExpectedTotalAmount decimal.Decimal
 	FinalTotalAmount    decimal.Decimal
 	BookingRooms        []*BookingRoomWithLock
 	UserID              int64
+	Version             int64
 	ID                  uuid.UUID
 	HotelID             uuid.UUID
 }
@@ -55,6 +58,7 @@ type BookingShort struct {
 	Status              BookingStatus
 	GuestName           string
 	Currency            string
+	Code                string
 	ExpectedTotalAmount decimal.Decimal
 	FinalTotalAmount    decimal.Decimal
 	BookingRooms        []*BookingRoom
@@ -68,6 +72,19 @@ type BookingList struct {
 	TotalCount uint64
 }
 
+// BookingGuestCheck proves a guest without an account owns the booking they
+// look up by code: exactly one of the fields is set.
+type BookingGuestCheck struct {
+	GuestEmail *string
+	LastName   *string
+}
+
+// ActiveBookingTarget selects the bookings of either a hotel or a single room.
+type ActiveBookingTarget struct {
+	HotelID *uuid.UUID
+	RoomID  *uuid.UUID
+}
+
 type BookingRef struct {
 	Status  BookingStatus
 	UserID  int64
@@ -84,7 +101,9 @@ func (b *CreateBooking) ToRead() *Booking {
 		GuestEmail:          b.GuestEmail,
 		GuestPhone:          b.GuestPhone,
 		Currency:            b.Currency,
+		Code:                b.Code,
 		ExpectedTotalAmount: b.ExpectedTotalAmount,
 		FinalTotalAmount:    b.FinalTotalAmount,
+		Policy:              b.Policy,
 	}
 }
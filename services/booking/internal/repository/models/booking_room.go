@@ -7,10 +7,15 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// CreateBookingRoom books either a physical room or, when only CategoryID is
+// set, any room of that category assigned later.
 type CreateBookingRoom struct {
+	RoomID        *uuid.UUID
+	CategoryID    *uuid.UUID
 	PricePerNight decimal.Decimal
+	StayAmount    decimal.Decimal
+	NightlyPrices []decimal.Decimal
 	BookingID     uuid.UUID
-	RoomID        uuid.UUID
 	Adults        uint32
 	Children      uint32
 }
@@ -21,20 +26,32 @@ type BookingRoomGuestCounts struct {
 }
 
 type BookingRoom struct {
+	RoomID        *uuid.UUID
+	CategoryID    *uuid.UUID
 	PricePerNight decimal.Decimal
 	BookingID     uuid.UUID
 	ID            uuid.UUID
-	RoomID        uuid.UUID
 	Adults        uint32
 	Children      uint32
 }
 
+// BookingRoomWithLock has no lock while its category room is not assigned yet.
 type BookingRoomWithLock struct {
 	CreatedAt     time.Time
+	RoomLock      *RoomLockShort
+	RoomID        *uuid.UUID
+	CategoryID    *uuid.UUID
 	PricePerNight decimal.Decimal
-	RoomLock      RoomLockShort
+	StayAmount    decimal.Decimal
+	NightlyPrices []decimal.Decimal
 	ID            uuid.UUID
-	RoomID        uuid.UUID
+	BookingID     uuid.UUID
 	Adults        uint32
 	Children      uint32
 }
+
+// UnassignedBookingRoom is a category booking room still waiting for a room.
+type UnassignedBookingRoom struct {
+	ID         uuid.UUID
+	CategoryID uuid.UUID
+}
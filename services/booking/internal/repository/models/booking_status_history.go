@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BookingStatusChange is a requested move of a booking to another status; a
+// nil ActorID marks a change made by the system.
+type BookingStatusChange struct {
+	ActorID *int64
+	Reason  *string
+	To      BookingStatus
+}
+
+// BookingStatusTransition has no From status for the creation of the booking.
+type BookingStatusTransition struct {
+	CreatedAt time.Time
+	ActorID   *int64
+	Reason    *string
+	From      *BookingStatus
+	To        BookingStatus
+	ID        uuid.UUID
+	BookingID uuid.UUID
+}
+
+func (c *BookingStatusChange) ToTransition(bookingID uuid.UUID, from BookingStatus) *BookingStatusTransition {
+	return &BookingStatusTransition{
+		ActorID:   c.ActorID,
+		Reason:    c.Reason,
+		From:      &from,
+		To:        c.To,
+		BookingID: bookingID,
+	}
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BookingCancellation is what cancelling a booking costs the guest. Fee comes
+// from the cancellation policy; the hotel retains the paid part of it and
+// refunds the rest of PaidAmount.
+type BookingCancellation struct {
+	CreatedAt      time.Time
+	Penalty        CancellationPenalty
+	Currency       string
+	Fee            decimal.Decimal
+	PaidAmount     decimal.Decimal
+	RetainedAmount decimal.Decimal
+	RefundAmount   decimal.Decimal
+	BookingID      uuid.UUID
+}
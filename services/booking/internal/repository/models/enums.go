@@ -1,10 +1,52 @@
 package models
 
 type BookingStatus string
+type RoomOccupancyKind string
+type PaymentStatus string
+type RefundStatus string
+type PaymentEventType string
+type RoomStatus string
 
 const (
 	BookingStatusPending     BookingStatus = "BOOKING_STATUS_PENDING"
 	BookingStatusConfirmed   BookingStatus = "BOOKING_STATUS_CONFIRMED"
 	BookingStatusCancelled   BookingStatus = "BOOKING_STATUS_CANCELLED"
+	BookingStatusCheckedIn   BookingStatus = "BOOKING_STATUS_CHECKED_IN"
+	BookingStatusCheckedOut  BookingStatus = "BOOKING_STATUS_CHECKED_OUT"
+	BookingStatusExpired     BookingStatus = "BOOKING_STATUS_EXPIRED"
+	BookingStatusNoShow      BookingStatus = "BOOKING_STATUS_NO_SHOW"
 	BookingStatusUnspecified BookingStatus = "BOOKING_STATUS_UNSPECIFIED"
 )
+
+const (
+	RoomOccupancyKindBooking RoomOccupancyKind = "ROOM_OCCUPANCY_KIND_BOOKING"
+	RoomOccupancyKindBlock   RoomOccupancyKind = "ROOM_OCCUPANCY_KIND_BLOCK"
+)
+
+const (
+	PaymentStatusPending     PaymentStatus = "PAYMENT_STATUS_PENDING"
+	PaymentStatusSucceeded   PaymentStatus = "PAYMENT_STATUS_SUCCEEDED"
+	PaymentStatusFailed      PaymentStatus = "PAYMENT_STATUS_FAILED"
+	PaymentStatusUnspecified PaymentStatus = "PAYMENT_STATUS_UNSPECIFIED"
+)
+
+const (
+	RefundStatusPending     RefundStatus = "REFUND_STATUS_PENDING"
+	RefundStatusSucceeded   RefundStatus = "REFUND_STATUS_SUCCEEDED"
+	RefundStatusFailed      RefundStatus = "REFUND_STATUS_FAILED"
+	RefundStatusUnspecified RefundStatus = "REFUND_STATUS_UNSPECIFIED"
+)
+
+const (
+	PaymentEventSucceeded       PaymentEventType = "payment.succeeded"
+	PaymentEventFailed          PaymentEventType = "payment.failed"
+	PaymentEventRefundSucceeded PaymentEventType = "refund.succeeded"
+	PaymentEventRefundFailed    PaymentEventType = "refund.failed"
+)
+
+// RoomStatus mirrors the room status names of the hotel service that the front
+// desk sets.
+const (
+	RoomStatusOccupied RoomStatus = "ROOM_STATUS_OCCUPIED"
+	RoomStatusCleaning RoomStatus = "ROOM_STATUS_CLEANING"
+)
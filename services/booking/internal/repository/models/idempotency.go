@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyRecord remembers a request made under an idempotency key by a
+// caller of a method; Response stays nil until the request has succeeded.
+// Holder owns a pending record until LockedUntil, after which a retry may take
+// it over.
+type IdempotencyRecord struct {
+	ExpiresAt   time.Time
+	LockedUntil time.Time
+	Key         string
+	Method      string
+	Caller      string
+	Fingerprint []byte
+	Response    []byte
+	Holder      uuid.UUID
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Payment is a payment intent opened at the payment provider for a booking;
+// CapturedAt is set once the money has been taken.
+type Payment struct {
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	CapturedAt        *time.Time
+	Status            PaymentStatus
+	Provider          string
+	ProviderPaymentID string
+	ClientSecret      string
+	Currency          string
+	Amount            decimal.Decimal
+	Refunds           []*Refund
+	ID                uuid.UUID
+	BookingID         uuid.UUID
+}
+
+// Refund has no ProviderRefundID until the provider has accepted it.
+type Refund struct {
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	ProviderRefundID *string
+	Reason           *string
+	Status           RefundStatus
+	Amount           decimal.Decimal
+	ID               uuid.UUID
+	PaymentID        uuid.UUID
+}
+
+type CreatePaymentIntent struct {
+	Currency  string
+	Amount    decimal.Decimal
+	BookingID uuid.UUID
+}
+
+// PaymentIntent is a payment as the provider reports it.
+type PaymentIntent struct {
+	Status            PaymentStatus
+	ProviderPaymentID string
+	ClientSecret      string
+}
+
+// ProviderRefund is a refund as the provider reports it.
+type ProviderRefund struct {
+	Status           RefundStatus
+	ProviderRefundID string
+}
+
+// PaymentEvent is a verified provider webhook; ProviderRefundID is set for
+// refund events only.
+type PaymentEvent struct {
+	ProviderRefundID  *string
+	Type              PaymentEventType
+	ProviderPaymentID string
+}
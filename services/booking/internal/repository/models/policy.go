@@ -0,0 +1,46 @@
+package models
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type CancellationPenalty string
+
+const (
+	CancellationPenaltyUnspecified CancellationPenalty = "CANCELLATION_PENALTY_UNSPECIFIED"
+	CancellationPenaltyNone        CancellationPenalty = "CANCELLATION_PENALTY_NONE"
+	CancellationPenaltyFirstNight  CancellationPenalty = "CANCELLATION_PENALTY_FIRST_NIGHT"
+	CancellationPenaltyPercent     CancellationPenalty = "CANCELLATION_PENALTY_PERCENT"
+	CancellationPenaltyFullStay    CancellationPenalty = "CANCELLATION_PENALTY_FULL_STAY"
+)
+
+type CancellationTier struct {
+	Penalty            CancellationPenalty `json:"penalty"`
+	HoursBeforeCheckIn uint32              `json:"hours_before_check_in"`
+	PenaltyPercent     uint32              `json:"penalty_percent"`
+}
+
+type ChildAgeBand struct {
+	MinAge       uint32 `json:"min_age"`
+	MaxAge       uint32 `json:"max_age"`
+	PricePercent uint32 `json:"price_percent"`
+}
+
+type PetPolicy struct {
+	FeePerNight *decimal.Decimal `json:"fee_per_night,omitempty"`
+	Allowed     bool             `json:"allowed"`
+	MaxPets     uint32           `json:"max_pets"`
+}
+
+// PolicySnapshot is the hotel's policy as it was when the booking was created,
+// stored with the booking so later edits by the hotel do not change it.
+type PolicySnapshot struct {
+	LatestCheckInTime *string            `json:"latest_check_in_time,omitempty"`
+	Timezone          string             `json:"timezone"`
+	CheckInTime       string             `json:"check_in_time"`
+	CheckOutTime      string             `json:"check_out_time"`
+	CancellationTiers []CancellationTier `json:"cancellation_tiers"`
+	ChildAgeBands     []ChildAgeBand     `json:"child_age_bands"`
+	Pets              PetPolicy          `json:"pets"`
+	PrepaymentPercent uint32             `json:"prepayment_percent"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import "github.com/google/uuid"
+
+// RoomCategory is the part of a hotel room category bookings rely on; RoomIDs
+// lists its live rooms in assignment order.
+type RoomCategory struct {
+	RoomIDs  []uuid.UUID
+	Capacity uint32
+	ID       uuid.UUID
+	HotelID  uuid.UUID
+}
+
+type HotelRoom struct {
+	CategoryID *uuid.UUID
+	Capacity   uint32
+	ID         uuid.UUID
+	HotelID    uuid.UUID
+}
@@ -18,6 +18,12 @@ type CreateRoomLock struct {
 	BookingID uuid.UUID
 }
 
+type CreateRoomBlock struct {
+	StayRange DateRange
+	RoomID    uuid.UUID
+	BlockID   uuid.UUID
+}
+
 type RoomLockActivity struct {
 	ExpiresAt *time.Time
 	IsActive  bool
@@ -48,6 +54,19 @@ type RoomLockShort struct {
 	ISActive  bool
 }
 
+type RoomOccupancy struct {
+	StayRange   DateRange
+	Kind        RoomOccupancyKind
+	ReferenceID uuid.UUID
+	RoomID      uuid.UUID
+}
+
+type RoomAvailability struct {
+	Occupancies []RoomOccupancy
+	RoomID      uuid.UUID
+	Available   bool
+}
+
 func (roomLock *CreateRoomLock) ToRead() RoomLockDetail {
 	return RoomLockDetail{
 		RoomID:    roomLock.RoomID,
@@ -56,3 +75,9 @@ func (roomLock *CreateRoomLock) ToRead() RoomLockDetail {
 		ExpiresAt: roomLock.ExpiresAt,
 	}
 }
+
+type CategoryAvailability struct {
+	CategoryID     uuid.UUID
+	TotalRooms     uint32
+	AvailableRooms uint32
+}
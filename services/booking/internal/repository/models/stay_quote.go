@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type NightlyRate struct {
+	Date  time.Time
+	Price decimal.Decimal
+}
+
+type StayQuote struct {
+	Nights      []NightlyRate
+	TotalAmount decimal.Decimal
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"github.com/google/uuid"
+
+	"booking/internal/utils/consts"
+)
+
+type StayViolation struct {
+	Rule    string
+	Message string
+	RoomID  uuid.UUID
+}
+
+// StayRestrictionError carries the hotel restrictions a requested stay breaks.
+type StayRestrictionError struct {
+	Violations []StayViolation
+}
+
+func (e *StayRestrictionError) Error() string {
+	return consts.MsgStayRestricted
+}
+
+func (e *StayRestrictionError) Unwrap() error {
+	return consts.ErrStayRestricted
+}
@@ -34,13 +34,19 @@ func (r *Repository) CreateBooking(ctx context.Context, tx pgx.Tx, b *models.Cre
 		b.Currency,
 		b.ExpectedTotalAmount,
 		b.FinalTotalAmount,
+		b.Policy,
+		b.Code,
 	).Scan(
 		&newBooking.ID,
 		&newBooking.Status,
+		&newBooking.Version,
 		&newBooking.CreatedAt,
 		&newBooking.UpdatedAt,
 	)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrBookingCodeTaken
+		}
 		return nil, err
 	}
 
@@ -87,6 +93,7 @@ func (r *Repository) GetBookingsByHotelInfo(
 			&b.Currency,
 			&b.ExpectedTotalAmount,
 			&b.FinalTotalAmount,
+			&b.Code,
 		)
 		if err != nil {
 			return nil, err
@@ -121,10 +128,34 @@ func (r *Repository) GetBookingsByHotelInfo(
 }
 
 func (r *Repository) GetBookingByID(ctx context.Context, tx pgx.Tx, bookingID uuid.UUID) (*models.Booking, error) {
+	return r.getBooking(ctx, tx, query.GetBookingByID, bookingID)
+}
+
+// GetBookingByIDForUpdate reads the booking and locks it until tx ends.
+func (r *Repository) GetBookingByIDForUpdate(
+	ctx context.Context,
+	tx pgx.Tx,
+	bookingID uuid.UUID,
+) (*models.Booking, error) {
+	return r.getBooking(ctx, tx, query.GetBookingByIDForUpdate, bookingID)
+}
+
+// GetBookingByCode finds the booking with the reference code whose guest
+// passes check; a wrong email or last name reads as a missing booking.
+func (r *Repository) GetBookingByCode(
+	ctx context.Context,
+	tx pgx.Tx,
+	code string,
+	check models.BookingGuestCheck,
+) (*models.Booking, error) {
+	return r.getBooking(ctx, tx, query.GetBookingByCode, code, check.GuestEmail, check.LastName)
+}
+
+func (r *Repository) getBooking(ctx context.Context, tx pgx.Tx, sql string, args ...any) (*models.Booking, error) {
 	db := r.executor(tx)
 
 	var b models.Booking
-	err := db.QueryRow(ctx, query.GetBookingByID, bookingID).Scan(
+	err := db.QueryRow(ctx, sql, args...).Scan(
 		&b.ID,
 		&b.UserID,
 		&b.HotelID,
@@ -137,6 +168,9 @@ func (r *Repository) GetBookingByID(ctx context.Context, tx pgx.Tx, bookingID uu
 		&b.Currency,
 		&b.ExpectedTotalAmount,
 		&b.FinalTotalAmount,
+		&b.Policy,
+		&b.Version,
+		&b.Code,
 		&b.CreatedAt,
 		&b.UpdatedAt,
 	)
@@ -155,45 +189,158 @@ func (r *Repository) UpdateBookingGuestInfoByID(
 	tx pgx.Tx,
 	id uuid.UUID,
 	b *models.UpdateBooking,
-) error {
+	expectedVersion *int64,
+) (int64, error) {
 	db := r.executor(tx)
 
-	row, err := db.Exec(
+	var version int64
+	err := db.QueryRow(
 		ctx,
 		query.UpdateBookingGuestInfoByID,
 		id,
 		b.GuestName,
 		b.GuestEmail,
 		b.GuestPhone,
-	)
+		expectedVersion,
+	).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, r.bookingVersionErr(ctx, tx, id, expectedVersion)
+		}
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (r *Repository) UpdateBookingDates(ctx context.Context, tx pgx.Tx, id uuid.UUID, stay models.DateRange) error {
+	db := r.executor(tx)
+
+	row, err := db.Exec(ctx, query.UpdateBookingDatesByID, id, stay.Start, stay.End)
 	if err != nil {
 		return err
 	}
-	if rowAffected := row.RowsAffected(); rowAffected == 0 {
+	if row.RowsAffected() == 0 {
 		return consts.ErrBookingNotFound
 	}
 
 	return nil
 }
 
+// RecalculateBookingTotal stores the total of the booking rooms as the final
+// amount and returns the new booking version.
+func (r *Repository) RecalculateBookingTotal(ctx context.Context, tx pgx.Tx, id uuid.UUID) (int64, error) {
+	db := r.executor(tx)
+
+	var version int64
+	if err := db.QueryRow(ctx, query.RecalculateBookingTotalByID, id).Scan(&version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, consts.ErrBookingNotFound
+		}
+		return 0, err
+	}
+
+	return version, nil
+}
+
 func (r *Repository) UpdateBookingStatusByID(
 	ctx context.Context,
 	tx pgx.Tx,
 	id uuid.UUID,
 	status models.BookingStatus,
-) (time.Time, error) {
+	expectedVersion *int64,
+) (time.Time, int64, error) {
 	db := r.executor(tx)
 
 	var checkOut time.Time
-	err := db.QueryRow(ctx, query.UpdateBookingStatusByID, id, status).Scan(&checkOut)
+	var version int64
+	err := db.QueryRow(ctx, query.UpdateBookingStatusByID, id, status, expectedVersion).Scan(&checkOut, &version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return time.Time{}, consts.ErrBookingNotFound
+			return time.Time{}, 0, r.bookingVersionErr(ctx, tx, id, expectedVersion)
 		}
-		return time.Time{}, err
+		return time.Time{}, 0, err
+	}
+
+	return checkOut, version, nil
+}
+
+// bookingVersionErr tells a stale expected version apart from a missing booking
+// once a versioned update has matched nothing.
+func (r *Repository) bookingVersionErr(ctx context.Context, tx pgx.Tx, id uuid.UUID, expectedVersion *int64) error {
+	if expectedVersion == nil {
+		return consts.ErrBookingNotFound
+	}
+
+	var exists bool
+	if err := r.executor(tx).QueryRow(ctx, query.BookingExistsByID, id).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return consts.ErrVersionMismatch
+	}
+
+	return consts.ErrBookingNotFound
+}
+
+func (r *Repository) GetActiveBookingIDs(
+	ctx context.Context,
+	tx pgx.Tx,
+	target models.ActiveBookingTarget,
+) ([]uuid.UUID, error) {
+	return r.queryBookingIDs(ctx, tx, query.SelectActiveBookingIDs, target)
+}
+
+// GetNoShowBookingIDs lists up to limit confirmed bookings whose guests did not
+// arrive on their check-in day.
+func (r *Repository) GetNoShowBookingIDs(ctx context.Context, tx pgx.Tx, limit int) ([]uuid.UUID, error) {
+	return r.selectBookingIDs(ctx, tx, query.SelectNoShowBookingIDs, limit)
+}
+
+// GetExpiredHoldBookingIDs lists up to limit pending bookings whose room hold
+// has run out; holdMinutes is how long bookings without locks are held.
+func (r *Repository) GetExpiredHoldBookingIDs(
+	ctx context.Context,
+	tx pgx.Tx,
+	holdMinutes int,
+	limit int,
+) ([]uuid.UUID, error) {
+	return r.selectBookingIDs(ctx, tx, query.SelectExpiredHoldBookingIDs, holdMinutes, limit)
+}
+
+func (r *Repository) queryBookingIDs(
+	ctx context.Context,
+	tx pgx.Tx,
+	sql string,
+	target models.ActiveBookingTarget,
+	args ...any,
+) ([]uuid.UUID, error) {
+	return r.selectBookingIDs(ctx, tx, sql, append([]any{target.HotelID, target.RoomID}, args...)...)
+}
+
+func (r *Repository) selectBookingIDs(ctx context.Context, tx pgx.Tx, sql string, args ...any) ([]uuid.UUID, error) {
+	db := r.executor(tx)
+
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
-	return checkOut, nil
+	return ids, nil
 }
 
 func (r *Repository) DeleteBookingByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
@@ -2,9 +2,14 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
 
 	"booking/internal/repository/models"
 	"booking/internal/repository/postgres/query"
@@ -19,10 +24,13 @@ func (r *Repository) CreateBookingRooms(
 ) ([]*models.BookingRoomWithLock, error) {
 	db := r.executor(tx)
 
-	roomIDs := make([]uuid.UUID, len(rooms))
+	roomIDs := make([]*uuid.UUID, len(rooms))
+	categoryIDs := make([]*uuid.UUID, len(rooms))
 	adults := make([]uint32, len(rooms))
 	children := make([]uint32, len(rooms))
 	prices := make([]string, len(rooms))
+	stayAmounts := make([]string, len(rooms))
+	nightlyPrices := make([]string, len(rooms))
 
 	for i, room := range rooms {
 		if room.BookingID != bookingID {
@@ -30,12 +38,18 @@ func (r *Repository) CreateBookingRooms(
 		}
 
 		roomIDs[i] = room.RoomID
+		categoryIDs[i] = room.CategoryID
 		adults[i] = room.Adults
 		children[i] = room.Children
 		prices[i] = room.PricePerNight.StringFixed(2)
+		stayAmounts[i] = room.StayAmount.StringFixed(2)
+		nightlyPrices[i] = numericArrayLiteral(room.NightlyPrices)
 	}
 
-	rows, err := db.Query(ctx, query.CreateBookingRooms, bookingID, roomIDs, adults, children, prices)
+	rows, err := db.Query(
+		ctx, query.CreateBookingRooms,
+		bookingID, roomIDs, adults, children, prices, categoryIDs, stayAmounts, nightlyPrices,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -48,16 +62,24 @@ func (r *Repository) CreateBookingRooms(
 		if err = rows.Scan(&br.ID, &br.CreatedAt); err != nil {
 			return nil, err
 		}
+		br.BookingID = bookingID
 		br.RoomID = rooms[idx].RoomID
+		br.CategoryID = rooms[idx].CategoryID
 		br.Adults = rooms[idx].Adults
 		br.Children = rooms[idx].Children
 		br.PricePerNight = rooms[idx].PricePerNight
+		br.StayAmount = rooms[idx].StayAmount
+		br.NightlyPrices = rooms[idx].NightlyPrices
 		values[idx] = br
 		idx++
 	}
 	values = values[:idx]
 
 	if err = rows.Err(); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, consts.ErrRoomLockAlreadyExist
+		}
 		return nil, err
 	}
 
@@ -83,8 +105,8 @@ func (r *Repository) GetBookingRoomsByBookingIDs(
 	}
 	defer rows.Close()
 
-	var bRoom models.BookingRoom
 	for rows.Next() {
+		var bRoom models.BookingRoom
 		err = rows.Scan(
 			&bRoom.ID,
 			&bRoom.BookingID,
@@ -92,6 +114,7 @@ func (r *Repository) GetBookingRoomsByBookingIDs(
 			&bRoom.Adults,
 			&bRoom.Children,
 			&bRoom.PricePerNight,
+			&bRoom.CategoryID,
 		)
 		if err != nil {
 			return nil, err
@@ -126,21 +149,9 @@ func (r *Repository) GetBookingRoomsWithLockByBookingIDs(
 	}
 	defer rows.Close()
 
-	var bRoom models.BookingRoomWithLock
 	for rows.Next() {
-		err = rows.Scan(
-			&bRoom.ID,
-			&bRoom.RoomID,
-			&bRoom.Adults,
-			&bRoom.Children,
-			&bRoom.PricePerNight,
-			&bRoom.CreatedAt,
-			&bRoom.RoomLock.ID,
-			&bRoom.RoomLock.ISActive,
-			&bRoom.RoomLock.ExpiresAt,
-			&bRoom.RoomLock.CreatedAt,
-		)
-		if err != nil {
+		var bRoom models.BookingRoomWithLock
+		if err = scanBookingRoomWithLock(rows, &bRoom); err != nil {
 			return nil, err
 		}
 
@@ -158,3 +169,188 @@ func (r *Repository) GetBookingRoomsWithLockByBookingIDs(
 
 	return out, nil
 }
+
+func (r *Repository) GetBookingRoomByID(
+	ctx context.Context,
+	tx pgx.Tx,
+	id uuid.UUID,
+) (*models.BookingRoomWithLock, error) {
+	db := r.executor(tx)
+
+	var bRoom models.BookingRoomWithLock
+	err := scanBookingRoomWithLock(db.QueryRow(ctx, query.GetBookingRoomWithLockByID, id), &bRoom, &bRoom.BookingID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrBookingRoomNotFound
+		}
+		return nil, err
+	}
+
+	return &bRoom, nil
+}
+
+func (r *Repository) GetUnassignedBookingRooms(
+	ctx context.Context,
+	tx pgx.Tx,
+	bookingID uuid.UUID,
+) ([]models.UnassignedBookingRoom, error) {
+	db := r.executor(tx)
+
+	rows, err := db.Query(ctx, query.GetUnassignedBookingRoomsByBookingID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rooms []models.UnassignedBookingRoom
+	for rows.Next() {
+		var room models.UnassignedBookingRoom
+		if err = rows.Scan(&room.ID, &room.CategoryID); err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, room)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rooms, nil
+}
+
+func (r *Repository) AssignBookingRoom(ctx context.Context, tx pgx.Tx, id uuid.UUID, roomID uuid.UUID) error {
+	db := r.executor(tx)
+
+	row, err := db.Exec(ctx, query.UpdateBookingRoomRoomByID, id, roomID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return consts.ErrRoomLockAlreadyExist
+		}
+		return err
+	}
+	if row.RowsAffected() == 0 {
+		return consts.ErrBookingRoomNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdateBookingRoomPrice(
+	ctx context.Context,
+	tx pgx.Tx,
+	id uuid.UUID,
+	pricePerNight decimal.Decimal,
+	stayAmount decimal.Decimal,
+	nightlyPrices []decimal.Decimal,
+) error {
+	db := r.executor(tx)
+
+	row, err := db.Exec(
+		ctx, query.UpdateBookingRoomPriceByID,
+		id, pricePerNight.StringFixed(2), stayAmount.StringFixed(2), numericArrayLiteral(nightlyPrices),
+	)
+	if err != nil {
+		return err
+	}
+	if row.RowsAffected() == 0 {
+		return consts.ErrBookingRoomNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdateBookingRoomGuestCounts(
+	ctx context.Context,
+	tx pgx.Tx,
+	id uuid.UUID,
+	counts models.BookingRoomGuestCounts,
+) error {
+	db := r.executor(tx)
+
+	row, err := db.Exec(ctx, query.UpdateBookingRoomGuestCountsByID, id, counts.Adults, counts.Children)
+	if err != nil {
+		return err
+	}
+	if row.RowsAffected() == 0 {
+		return consts.ErrBookingRoomNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteBookingRoomByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	db := r.executor(tx)
+
+	row, err := db.Exec(ctx, query.DeleteBookingRoomByID, id)
+	if err != nil {
+		return err
+	}
+	if row.RowsAffected() == 0 {
+		return consts.ErrBookingRoomNotFound
+	}
+
+	return nil
+}
+
+// scanBookingRoomWithLock reads a booking room joined with its lock, which is
+// missing while a category room is unassigned; extra destinations follow the
+// nightly prices.
+func scanBookingRoomWithLock(row pgx.Row, bRoom *models.BookingRoomWithLock, extra ...any) error {
+	var lockID *uuid.UUID
+	var lockActive *bool
+	var lockExpiresAt, lockCreatedAt *time.Time
+	var nightlyPrices []string
+
+	dest := []any{
+		&bRoom.ID,
+		&bRoom.RoomID,
+		&bRoom.Adults,
+		&bRoom.Children,
+		&bRoom.PricePerNight,
+		&bRoom.CreatedAt,
+		&lockID,
+		&lockActive,
+		&lockExpiresAt,
+		&lockCreatedAt,
+		&bRoom.CategoryID,
+		&bRoom.StayAmount,
+		&nightlyPrices,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+
+	bRoom.NightlyPrices = make([]decimal.Decimal, len(nightlyPrices))
+	for i, price := range nightlyPrices {
+		parsed, err := decimal.NewFromString(price)
+		if err != nil {
+			return err
+		}
+		bRoom.NightlyPrices[i] = parsed
+	}
+
+	if lockID != nil {
+		bRoom.RoomLock = &models.RoomLockShort{
+			ID:        *lockID,
+			ISActive:  *lockActive,
+			CreatedAt: *lockCreatedAt,
+		}
+		if lockExpiresAt != nil {
+			bRoom.RoomLock.ExpiresAt = *lockExpiresAt
+		}
+	}
+
+	return nil
+}
+
+// numericArrayLiteral renders prices as a Postgres array literal, which lets a
+// whole array travel as one element of an unnested text[] parameter.
+func numericArrayLiteral(prices []decimal.Decimal) string {
+	parts := make([]string, len(prices))
+	for i, price := range prices {
+		parts[i] = price.StringFixed(2)
+	}
+
+	return "{" + strings.Join(parts, ",") + "}"
+}
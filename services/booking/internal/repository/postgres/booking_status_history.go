@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"booking/internal/repository/models"
+	"booking/internal/repository/postgres/query"
+)
+
+func (r *Repository) CreateBookingStatusTransition(
+	ctx context.Context,
+	tx pgx.Tx,
+	t *models.BookingStatusTransition,
+) (*models.BookingStatusTransition, error) {
+	db := r.executor(tx)
+
+	err := db.QueryRow(
+		ctx,
+		query.InsertBookingStatusTransition,
+		t.BookingID,
+		t.From,
+		t.To,
+		t.ActorID,
+		t.Reason,
+	).Scan(&t.ID, &t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (r *Repository) GetBookingStatusHistory(
+	ctx context.Context,
+	tx pgx.Tx,
+	bookingID uuid.UUID,
+) ([]*models.BookingStatusTransition, error) {
+	db := r.executor(tx)
+
+	rows, err := db.Query(ctx, query.SelectBookingStatusHistory, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []*models.BookingStatusTransition
+	for rows.Next() {
+		var t models.BookingStatusTransition
+		if err = rows.Scan(
+			&t.ID,
+			&t.BookingID,
+			&t.From,
+			&t.To,
+			&t.ActorID,
+			&t.Reason,
+			&t.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		history = append(history, &t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
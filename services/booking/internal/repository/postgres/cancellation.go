@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"booking/internal/repository/models"
+	"booking/internal/repository/postgres/query"
+	"booking/internal/utils/consts"
+)
+
+func (r *Repository) CreateBookingCancellation(
+	ctx context.Context,
+	tx pgx.Tx,
+	c *models.BookingCancellation,
+) (*models.BookingCancellation, error) {
+	if c == nil {
+		return nil, consts.ErrNilObject
+	}
+
+	db := r.executor(tx)
+
+	cancellation := *c
+	err := db.QueryRow(
+		ctx,
+		query.CreateBookingCancellation,
+		c.BookingID,
+		c.Penalty,
+		c.Fee.StringFixed(2),
+		c.PaidAmount.StringFixed(2),
+		c.RetainedAmount.StringFixed(2),
+		c.RefundAmount.StringFixed(2),
+		c.Currency,
+	).Scan(&cancellation.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cancellation, nil
+}
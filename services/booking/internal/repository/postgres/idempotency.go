@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"booking/internal/repository/models"
+	"booking/internal/repository/postgres/query"
+	"booking/internal/utils/consts"
+)
+
+// ReserveIdempotencyKey claims rec.Key of rec.Method and rec.Caller for a new
+// request. When a live record already holds the key it is returned instead and
+// reserved is false.
+func (r *Repository) ReserveIdempotencyKey(
+	ctx context.Context,
+	rec *models.IdempotencyRecord,
+) (*models.IdempotencyRecord, bool, error) {
+	var key string
+	err := r.db.QueryRow(
+		ctx,
+		query.ReserveIdempotencyKey,
+		rec.Method,
+		rec.Caller,
+		rec.Key,
+		rec.Fingerprint,
+		rec.Holder,
+		rec.LockedUntil,
+		rec.ExpiresAt,
+	).Scan(&key)
+	if err == nil {
+		return rec, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, err
+	}
+
+	existing := &models.IdempotencyRecord{Key: rec.Key, Method: rec.Method, Caller: rec.Caller}
+	err = r.db.QueryRow(ctx, query.SelectIdempotencyKey, rec.Method, rec.Caller, rec.Key).Scan(
+		&existing.Fingerprint,
+		&existing.Response,
+		&existing.LockedUntil,
+		&existing.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The holder released the key in between; let the client retry.
+			return nil, false, consts.ErrIdempotencyKeyInProgress
+		}
+		return nil, false, err
+	}
+
+	return existing, false, nil
+}
+
+func (r *Repository) CompleteIdempotencyKey(ctx context.Context, rec *models.IdempotencyRecord, response []byte) error {
+	_, err := r.db.Exec(ctx, query.CompleteIdempotencyKey, rec.Method, rec.Caller, rec.Key, rec.Holder, response)
+	return err
+}
+
+// ReleaseIdempotencyKey frees a key whose request failed so it can be retried.
+func (r *Repository) ReleaseIdempotencyKey(ctx context.Context, rec *models.IdempotencyRecord) error {
+	_, err := r.db.Exec(ctx, query.ReleaseIdempotencyKey, rec.Method, rec.Caller, rec.Key, rec.Holder)
+	return err
+}
+
+func (r *Repository) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	tag, err := r.db.Exec(ctx, query.DeleteExpiredIdempotencyKeys)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
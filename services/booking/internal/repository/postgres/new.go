@@ -11,6 +11,7 @@ import (
 	_ "github.com/lib/pq"
 
 	"booking/internal/config"
+	outboxpg "outbox/postgres"
 )
 
 type DBTX interface {
@@ -19,7 +20,11 @@ type DBTX interface {
 	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
 }
 
+// Repository embeds the outbox store, so it stores the events of the changes
+// it makes and serves as the repository of the outbox relay.
 type Repository struct {
+	*outboxpg.Store
+
 	db   DBTX
 	pool *pgxpool.Pool
 }
@@ -60,8 +65,9 @@ func New(cfgApp *config.Config) (*Repository, error) {
 	}
 
 	return &Repository{
-		db:   pool,
-		pool: pool,
+		Store: outboxpg.New(pool),
+		db:    pool,
+		pool:  pool,
 	}, nil
 }
 
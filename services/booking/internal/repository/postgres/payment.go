@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+
+	"booking/internal/repository/models"
+	"booking/internal/repository/postgres/query"
+	"booking/internal/utils/consts"
+)
+
+func (r *Repository) CreatePayment(ctx context.Context, tx pgx.Tx, p *models.Payment) (*models.Payment, error) {
+	if p == nil {
+		return nil, consts.ErrNilObject
+	}
+
+	db := r.executor(tx)
+
+	payment := *p
+	err := db.QueryRow(
+		ctx,
+		query.CreatePayment,
+		p.BookingID,
+		p.Provider,
+		p.ProviderPaymentID,
+		p.ClientSecret,
+		p.Status,
+		p.Amount.StringFixed(2),
+		p.Currency,
+	).Scan(&payment.ID, &payment.CreatedAt, &payment.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
+func (r *Repository) GetPaymentByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*models.Payment, error) {
+	return r.getPayment(ctx, tx, query.GetPaymentByID, id)
+}
+
+// GetPaymentByIDForUpdate reads the payment and locks it until tx ends.
+func (r *Repository) GetPaymentByIDForUpdate(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*models.Payment, error) {
+	return r.getPayment(ctx, tx, query.GetPaymentByIDForUpdate, id)
+}
+
+// GetPaymentByProviderIDForUpdate finds the payment behind a provider intent
+// and locks it until tx ends.
+func (r *Repository) GetPaymentByProviderIDForUpdate(
+	ctx context.Context,
+	tx pgx.Tx,
+	provider string,
+	providerPaymentID string,
+) (*models.Payment, error) {
+	return r.getPayment(ctx, tx, query.GetPaymentByProviderIDForUpdate, provider, providerPaymentID)
+}
+
+func (r *Repository) GetPendingPaymentByBookingID(
+	ctx context.Context,
+	tx pgx.Tx,
+	bookingID uuid.UUID,
+) (*models.Payment, error) {
+	return r.getPayment(ctx, tx, query.GetPendingPaymentByBookingID, bookingID)
+}
+
+func (r *Repository) getPayment(ctx context.Context, tx pgx.Tx, sql string, args ...any) (*models.Payment, error) {
+	db := r.executor(tx)
+
+	var p models.Payment
+	if err := scanPayment(db.QueryRow(ctx, sql, args...), &p); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func (r *Repository) GetPaymentsByBookingID(
+	ctx context.Context,
+	tx pgx.Tx,
+	bookingID uuid.UUID,
+) ([]*models.Payment, error) {
+	db := r.executor(tx)
+
+	rows, err := db.Query(ctx, query.GetPaymentsByBookingID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []*models.Payment
+	for rows.Next() {
+		var p models.Payment
+		if err = scanPayment(rows, &p); err != nil {
+			return nil, err
+		}
+		payments = append(payments, &p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (r *Repository) UpdatePaymentStatus(
+	ctx context.Context,
+	tx pgx.Tx,
+	p *models.Payment,
+	status models.PaymentStatus,
+) error {
+	db := r.executor(tx)
+
+	err := db.QueryRow(ctx, query.UpdatePaymentStatusByID, p.ID, status).Scan(&p.CapturedAt, &p.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return consts.ErrPaymentNotFound
+		}
+		return err
+	}
+	p.Status = status
+
+	return nil
+}
+
+func (r *Repository) CreateRefund(ctx context.Context, tx pgx.Tx, refund *models.Refund) (*models.Refund, error) {
+	if refund == nil {
+		return nil, consts.ErrNilObject
+	}
+
+	db := r.executor(tx)
+
+	created := *refund
+	err := db.QueryRow(
+		ctx,
+		query.CreateRefund,
+		refund.PaymentID,
+		refund.Status,
+		refund.Amount.StringFixed(2),
+		refund.Reason,
+	).Scan(&created.ID, &created.CreatedAt, &created.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (r *Repository) GetRefundsByPaymentIDs(
+	ctx context.Context,
+	tx pgx.Tx,
+	paymentIDs []uuid.UUID,
+) ([]*models.Refund, error) {
+	db := r.executor(tx)
+
+	rows, err := db.Query(ctx, query.GetRefundsByPaymentIDs, paymentIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var refunds []*models.Refund
+	for rows.Next() {
+		var refund models.Refund
+		if err = scanRefund(rows, &refund); err != nil {
+			return nil, err
+		}
+		refunds = append(refunds, &refund)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return refunds, nil
+}
+
+// GetRefundByProviderIDForUpdate finds a refund by its id at the provider and
+// locks it until tx ends.
+func (r *Repository) GetRefundByProviderIDForUpdate(
+	ctx context.Context,
+	tx pgx.Tx,
+	provider string,
+	providerRefundID string,
+) (*models.Refund, error) {
+	db := r.executor(tx)
+
+	var refund models.Refund
+	err := scanRefund(db.QueryRow(ctx, query.GetRefundByProviderIDForUpdate, provider, providerRefundID), &refund)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrRefundNotFound
+		}
+		return nil, err
+	}
+
+	return &refund, nil
+}
+
+// UpdateRefund records the provider's answer; a nil providerRefundID keeps the
+// stored one.
+func (r *Repository) UpdateRefund(
+	ctx context.Context,
+	tx pgx.Tx,
+	refund *models.Refund,
+	providerRefundID *string,
+	status models.RefundStatus,
+) error {
+	db := r.executor(tx)
+
+	err := db.QueryRow(ctx, query.UpdateRefundByID, refund.ID, providerRefundID, status).Scan(&refund.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return consts.ErrRefundNotFound
+		}
+		return err
+	}
+	if providerRefundID != nil {
+		refund.ProviderRefundID = providerRefundID
+	}
+	refund.Status = status
+
+	return nil
+}
+
+// SumReservedRefunds is the part of the payment already refunded or being refunded.
+func (r *Repository) SumReservedRefunds(ctx context.Context, tx pgx.Tx, paymentID uuid.UUID) (decimal.Decimal, error) {
+	db := r.executor(tx)
+
+	var sum decimal.Decimal
+	if err := db.QueryRow(ctx, query.SumReservedRefunds, paymentID).Scan(&sum); err != nil {
+		return decimal.Zero, err
+	}
+
+	return sum, nil
+}
+
+func scanPayment(row pgx.Row, p *models.Payment) error {
+	return row.Scan(
+		&p.ID,
+		&p.BookingID,
+		&p.Provider,
+		&p.ProviderPaymentID,
+		&p.ClientSecret,
+		&p.Status,
+		&p.Amount,
+		&p.Currency,
+		&p.CapturedAt,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+}
+
+func scanRefund(row pgx.Row, refund *models.Refund) error {
+	return row.Scan(
+		&refund.ID,
+		&refund.PaymentID,
+		&refund.ProviderRefundID,
+		&refund.Status,
+		&refund.Amount,
+		&refund.Reason,
+		&refund.CreatedAt,
+		&refund.UpdatedAt,
+	)
+}
@@ -12,10 +12,13 @@ const (
 			guest_phone,
 			currency,
 			expected_total_amount,
-		    final_total_amount
+		    final_total_amount,
+			policy_snapshot,
+			code
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		RETURNING id, status, created_at, updated_at;`
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (code) DO NOTHING
+		RETURNING id, status, version, created_at, updated_at;`
 
 	GetBookingsByHotelInfo = `
 			SELECT
@@ -30,7 +33,8 @@ const (
 				guest_phone,
 				currency,
 				expected_total_amount,
-				final_total_amount
+				final_total_amount,
+				code
 			FROM booking
 			WHERE ($1::bigint IS NULL OR user_id = $1)
 			  AND ($2::uuid IS NULL OR hotel_id = $2)
@@ -52,24 +56,149 @@ const (
 			currency,
 			expected_total_amount,
 			final_total_amount,
+			policy_snapshot,
+			version,
+			code,
 			created_at,
 			updated_at
 		FROM booking
 		WHERE id = $1;`
 
+	// GetBookingByIDForUpdate locks the booking row until the transaction ends.
+	GetBookingByIDForUpdate = `
+		SELECT
+		    id,
+			user_id,
+			hotel_id::uuid,
+			check_in,
+			check_out,
+			status,
+			guest_name,
+			guest_email,
+			guest_phone,
+			currency,
+			expected_total_amount,
+			final_total_amount,
+			policy_snapshot,
+			version,
+			code,
+			created_at,
+			updated_at
+		FROM booking
+		WHERE id = $1
+		FOR UPDATE;`
+
+	// GetBookingByCode finds a booking by its reference code ($1) for a guest
+	// who proves it is theirs with the guest email ($2) or the last word of the
+	// guest name ($3), both compared case-insensitively.
+	GetBookingByCode = `
+		SELECT
+		    id,
+			user_id,
+			hotel_id::uuid,
+			check_in,
+			check_out,
+			status,
+			guest_name,
+			guest_email,
+			guest_phone,
+			currency,
+			expected_total_amount,
+			final_total_amount,
+			policy_snapshot,
+			version,
+			code,
+			created_at,
+			updated_at
+		FROM booking
+		WHERE code = $1
+		  AND ($2::text IS NULL OR lower(guest_email) = lower($2))
+		  AND ($3::text IS NULL OR lower(regexp_replace(btrim(guest_name), '^.*\s', '')) = lower($3));`
+
+	// UpdateBookingGuestInfoByID keeps the guest fields passed as NULL.
 	UpdateBookingGuestInfoByID = `
 		UPDATE booking
 		SET
-			guest_name = $2,
-			guest_email = $3,
-			guest_phone = $4
+			guest_name = COALESCE($2, guest_name),
+			guest_email = COALESCE($3, guest_email),
+			guest_phone = COALESCE($4, guest_phone),
+			version = version + 1
+		WHERE id = $1
+		  AND ($5::bigint IS NULL OR version = $5)
+		RETURNING version`
+
+	UpdateBookingDatesByID = `
+		UPDATE booking
+		SET
+			check_in = $2,
+			check_out = $3
 		WHERE id = $1`
 
+	// RecalculateBookingTotalByID sets the final total to the sum of the stay
+	// amounts of the booking rooms.
+	RecalculateBookingTotalByID = `
+		UPDATE booking
+		SET
+			final_total_amount = (
+				SELECT COALESCE(SUM(stay_amount), 0)
+				FROM booking_room
+				WHERE booking_id = $1
+			),
+			version = version + 1
+		WHERE id = $1
+		RETURNING version`
+
 	UpdateBookingStatusByID = `
 		UPDATE booking
-		SET status = $2
+		SET
+			status = $2,
+			version = version + 1
 		WHERE id = $1
-		RETURNING check_out`
+		  AND ($3::bigint IS NULL OR version = $3)
+		RETURNING check_out, version`
+
+	BookingExistsByID = `
+		SELECT EXISTS (
+			SELECT 1 FROM booking
+			WHERE id = $1
+		);`
+
+	// SelectActiveBookingIDs lists pending and confirmed bookings of a hotel ($1)
+	// or a room ($2) whose stay has not ended yet.
+	SelectActiveBookingIDs = `
+		SELECT b.id
+		FROM booking b
+		WHERE b.status IN ('BOOKING_STATUS_PENDING', 'BOOKING_STATUS_CONFIRMED')
+		  AND b.check_out > CURRENT_DATE
+		  AND ($1::uuid IS NULL OR b.hotel_id = $1)
+		  AND ($2::uuid IS NULL OR EXISTS (
+		      SELECT 1 FROM booking_room br WHERE br.booking_id = b.id AND br.room_id = $2
+		  ))
+		ORDER BY b.check_in, b.id;`
+
+	// SelectNoShowBookingIDs lists confirmed bookings whose check-in day is over
+	// in the hotel's timezone; bookings without a policy snapshot use UTC.
+	SelectNoShowBookingIDs = `
+		SELECT id
+		FROM booking
+		WHERE status = 'BOOKING_STATUS_CONFIRMED'
+		  AND check_in < (now() AT TIME ZONE COALESCE(policy_snapshot->>'timezone', 'UTC'))::date
+		ORDER BY check_in, id
+		LIMIT $1;`
+
+	// SelectExpiredHoldBookingIDs lists pending bookings whose room hold is over:
+	// the first active lock has expired or, for bookings holding no lock yet,
+	// $1 minutes have passed since they were made.
+	SelectExpiredHoldBookingIDs = `
+		SELECT b.id
+		FROM booking b
+		WHERE b.status = 'BOOKING_STATUS_PENDING'
+		  AND COALESCE(
+		      (SELECT MIN(rl.expires_at) FROM room_lock rl WHERE rl.booking_id = b.id AND rl.is_active),
+		      b.created_at + make_interval(mins => $1)
+		  ) <= now()
+		ORDER BY b.created_at, b.id
+		LIMIT $2;`
 
 	DeleteBookingByID = `
 		DELETE FROM booking
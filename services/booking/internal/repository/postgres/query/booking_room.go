@@ -8,10 +8,15 @@ const (
 			unnest($2::uuid[])    AS room_id,
 			unnest($3::int[])     AS adults,
 			unnest($4::int[])     AS children,
-			unnest($5::numeric[]) AS price_per_night
+			unnest($5::numeric[]) AS price_per_night,
+			unnest($6::uuid[])    AS category_id,
+			unnest($7::numeric[]) AS stay_amount,
+			unnest($8::text[])    AS nightly_prices
 		)
-		INSERT INTO booking_room (booking_id, room_id, adults, children, price_per_night)
-		SELECT booking_id, room_id, adults, children, price_per_night
+		INSERT INTO booking_room (
+		  booking_id, room_id, adults, children, price_per_night, category_id, stay_amount, nightly_prices
+		)
+		SELECT booking_id, room_id, adults, children, price_per_night, category_id, stay_amount, nightly_prices::numeric[]
 		FROM input
 		RETURNING id, created_at;`
 
@@ -22,7 +27,8 @@ const (
 			room_id::uuid,
 			adults,
 			children,
-			price_per_night
+			price_per_night,
+			category_id
 		FROM booking_room
 		WHERE booking_id = ANY($1)
 		ORDER BY created_at;`
@@ -38,7 +44,10 @@ const (
 			rl.id::uuid,
 			rl.is_active,
 			rl.expires_at,
-			rl.created_at
+			rl.created_at,
+			br.category_id,
+			br.stay_amount,
+			br.nightly_prices::text[]
 		FROM booking_room br
 		LEFT JOIN room_lock rl ON rl.booking_id = br.booking_id AND rl.room_id = br.room_id
 		WHERE br.booking_id = ANY($1)
@@ -73,11 +82,26 @@ const (
 			rl.id::uuid,
 			rl.is_active,
 			rl.expires_at,
-			rl.created_at
+			rl.created_at,
+			br.category_id,
+			br.stay_amount,
+			br.nightly_prices::text[],
+			br.booking_id
 		FROM booking_room br
 		LEFT JOIN room_lock rl ON rl.booking_id = br.booking_id AND rl.room_id = br.room_id
 		WHERE br.id = $1;`
 
+	GetUnassignedBookingRoomsByBookingID = `
+		SELECT id, category_id
+		FROM booking_room
+		WHERE booking_id = $1 AND room_id IS NULL
+		ORDER BY created_at;`
+
+	UpdateBookingRoomRoomByID = `
+		UPDATE booking_room
+		SET room_id = $2
+		WHERE id = $1;`
+
 	UpdateBookingRoomGuestCountsByID = `
 		UPDATE booking_room
 		SET
@@ -85,6 +109,14 @@ const (
 		  children = $3
 		WHERE id = $1;`
 
+	UpdateBookingRoomPriceByID = `
+		UPDATE booking_room
+		SET
+		  price_per_night = $2,
+		  stay_amount = $3,
+		  nightly_prices = $4::numeric[]
+		WHERE id = $1;`
+
 	DeleteBookingRoomByID = `
 		DELETE FROM booking_room
 		WHERE id = $1;`
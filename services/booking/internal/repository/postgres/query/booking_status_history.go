@@ -0,0 +1,20 @@
+package query
+
+const (
+	InsertBookingStatusTransition = `
+		INSERT INTO booking_status_history (booking_id, from_status, to_status, actor_id, reason)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at;`
+
+	SelectBookingStatusHistory = `
+		SELECT id,
+			   booking_id,
+			   from_status,
+			   to_status,
+			   actor_id,
+			   reason,
+			   created_at
+		FROM booking_status_history
+		WHERE booking_id = $1
+		ORDER BY created_at, id;`
+)
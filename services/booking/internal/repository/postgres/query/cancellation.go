@@ -0,0 +1,16 @@
+package query
+
+const (
+	CreateBookingCancellation = `
+		INSERT INTO booking_cancellation (
+			booking_id,
+			penalty,
+			fee,
+			paid_amount,
+			retained_amount,
+			refund_amount,
+			currency
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at;`
+)
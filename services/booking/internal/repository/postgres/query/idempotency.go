@@ -0,0 +1,41 @@
+package query
+
+const (
+	// ReserveIdempotencyKey claims the key of the method and caller unless a
+	// live record holds it; expired records, and pending ones whose holder let
+	// its lease run out, are taken over.
+	ReserveIdempotencyKey = `
+		INSERT INTO idempotency_key (method, caller, key, fingerprint, holder, locked_until, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (method, caller, key) DO UPDATE
+		SET fingerprint  = EXCLUDED.fingerprint,
+		    response     = NULL,
+		    holder       = EXCLUDED.holder,
+		    locked_until = EXCLUDED.locked_until,
+		    created_at   = now(),
+		    expires_at   = EXCLUDED.expires_at
+		WHERE idempotency_key.expires_at <= now()
+		   OR (idempotency_key.response IS NULL AND idempotency_key.locked_until <= now())
+		RETURNING key;`
+
+	SelectIdempotencyKey = `
+		SELECT fingerprint, response, locked_until, expires_at
+		FROM idempotency_key
+		WHERE method = $1 AND caller = $2 AND key = $3;`
+
+	// CompleteIdempotencyKey and ReleaseIdempotencyKey only touch the record
+	// while $4 still holds it, so a request outliving its lease cannot clobber
+	// the retry that took over.
+	CompleteIdempotencyKey = `
+		UPDATE idempotency_key
+		SET response = $5
+		WHERE method = $1 AND caller = $2 AND key = $3 AND holder = $4;`
+
+	ReleaseIdempotencyKey = `
+		DELETE FROM idempotency_key
+		WHERE method = $1 AND caller = $2 AND key = $3 AND holder = $4 AND response IS NULL;`
+
+	DeleteExpiredIdempotencyKeys = `
+		DELETE FROM idempotency_key
+		WHERE expires_at <= now();`
+)
@@ -0,0 +1,157 @@
+package query
+
+const (
+	CreatePayment = `
+		INSERT INTO payment (
+			booking_id,
+			provider,
+			provider_payment_id,
+			client_secret,
+			status,
+			amount,
+			currency
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at;`
+
+	GetPaymentByID = `
+		SELECT
+			id,
+			booking_id,
+			provider,
+			provider_payment_id,
+			client_secret,
+			status,
+			amount,
+			currency,
+			captured_at,
+			created_at,
+			updated_at
+		FROM payment
+		WHERE id = $1;`
+
+	GetPaymentByIDForUpdate = `
+		SELECT
+			id,
+			booking_id,
+			provider,
+			provider_payment_id,
+			client_secret,
+			status,
+			amount,
+			currency,
+			captured_at,
+			created_at,
+			updated_at
+		FROM payment
+		WHERE id = $1
+		FOR UPDATE;`
+
+	GetPaymentByProviderIDForUpdate = `
+		SELECT
+			id,
+			booking_id,
+			provider,
+			provider_payment_id,
+			client_secret,
+			status,
+			amount,
+			currency,
+			captured_at,
+			created_at,
+			updated_at
+		FROM payment
+		WHERE provider = $1 AND provider_payment_id = $2
+		FOR UPDATE;`
+
+	GetPendingPaymentByBookingID = `
+		SELECT
+			id,
+			booking_id,
+			provider,
+			provider_payment_id,
+			client_secret,
+			status,
+			amount,
+			currency,
+			captured_at,
+			created_at,
+			updated_at
+		FROM payment
+		WHERE booking_id = $1 AND status = 'PAYMENT_STATUS_PENDING';`
+
+	GetPaymentsByBookingID = `
+		SELECT
+			id,
+			booking_id,
+			provider,
+			provider_payment_id,
+			client_secret,
+			status,
+			amount,
+			currency,
+			captured_at,
+			created_at,
+			updated_at
+		FROM payment
+		WHERE booking_id = $1
+		ORDER BY created_at;`
+
+	// UpdatePaymentStatusByID stamps captured_at when the payment succeeds.
+	UpdatePaymentStatusByID = `
+		UPDATE payment
+		SET
+			status = $2,
+			captured_at = CASE WHEN $2 = 'PAYMENT_STATUS_SUCCEEDED' THEN now() ELSE captured_at END
+		WHERE id = $1
+		RETURNING captured_at, updated_at;`
+
+	CreateRefund = `
+		INSERT INTO refund (payment_id, status, amount, reason)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at;`
+
+	GetRefundsByPaymentIDs = `
+		SELECT
+			id,
+			payment_id,
+			provider_refund_id,
+			status,
+			amount,
+			reason,
+			created_at,
+			updated_at
+		FROM refund
+		WHERE payment_id = ANY($1)
+		ORDER BY created_at;`
+
+	GetRefundByProviderIDForUpdate = `
+		SELECT
+			r.id,
+			r.payment_id,
+			r.provider_refund_id,
+			r.status,
+			r.amount,
+			r.reason,
+			r.created_at,
+			r.updated_at
+		FROM refund r
+		JOIN payment p ON p.id = r.payment_id
+		WHERE p.provider = $1 AND r.provider_refund_id = $2
+		FOR UPDATE OF r;`
+
+	UpdateRefundByID = `
+		UPDATE refund
+		SET
+			provider_refund_id = COALESCE($2, provider_refund_id),
+			status = $3
+		WHERE id = $1
+		RETURNING updated_at;`
+
+	// SumReservedRefunds adds up the refunds of a payment that have not failed.
+	SumReservedRefunds = `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM refund
+		WHERE payment_id = $1
+		  AND status IN ('REFUND_STATUS_PENDING', 'REFUND_STATUS_SUCCEEDED');`
+)
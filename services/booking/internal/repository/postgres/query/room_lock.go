@@ -21,6 +21,62 @@ const (
 		FROM input
 		RETURNING id, room_id, booking_id, is_active, created_at;`
 
+	CreateRoomBlock = `
+		INSERT INTO room_lock (room_id, block_id, stay_range)
+		VALUES ($1, $2, daterange($3::date, $4::date, '[)'))
+		RETURNING id, is_active, created_at;`
+
+	SelectActiveRoomLocks = `
+		SELECT room_id,
+			   booking_id,
+			   block_id,
+			   lower(stay_range),
+			   upper(stay_range)
+		FROM room_lock
+		WHERE room_id = ANY($1::uuid[])
+		  AND is_active = TRUE
+		  AND stay_range && daterange($2::date, $3::date, '[)')
+		ORDER BY room_id, lower(stay_range);`
+
+	// SelectOccupiedRoomIDs lists the rooms of $1 that cannot take the stay: an
+	// active lock overlaps it, or a booking confirmed before confirmations kept
+	// their locks active still holds the room.
+	SelectOccupiedRoomIDs = `
+		SELECT DISTINCT rl.room_id
+		FROM room_lock rl
+		LEFT JOIN booking b ON b.id = rl.booking_id
+		WHERE rl.room_id = ANY($1::uuid[])
+		  AND rl.stay_range && daterange($2::date, $3::date, '[)')
+		  AND (rl.is_active = TRUE OR b.status = 'BOOKING_STATUS_CONFIRMED');`
+
+	// CountUnassignedCategoryRooms counts the category booking rooms of pending
+	// and confirmed bookings overlapping the stay that have no room yet, leaving
+	// out the booking $4.
+	CountUnassignedCategoryRooms = `
+		SELECT COUNT(*)
+		FROM booking_room br
+		JOIN booking b ON b.id = br.booking_id
+		WHERE br.category_id = $1
+		  AND br.room_id IS NULL
+		  AND b.status IN ('BOOKING_STATUS_PENDING', 'BOOKING_STATUS_CONFIRMED')
+		  AND daterange(b.check_in, b.check_out, '[)') && daterange($2::date, $3::date, '[)')
+		  AND ($4::uuid IS NULL OR b.id <> $4);`
+
+	// LockRoomCategory serialises bookings of one category until the transaction ends.
+	LockRoomCategory = `
+		SELECT pg_advisory_xact_lock(hashtextextended($1::text, 0));`
+
+	MoveRoomLock = `
+		UPDATE room_lock
+		SET room_id = $3
+		WHERE booking_id = $1 AND room_id = $2
+		RETURNING id, is_active, expires_at, created_at;`
+
+	UpdateRoomLocksStayRangeByBookingID = `
+		UPDATE room_lock
+		SET stay_range = daterange($2::date, $3::date, '[)')
+		WHERE booking_id = $1;`
+
 	UpdateRoomLocksActivityByID = `
 		UPDATE room_lock
 		SET
@@ -28,6 +84,16 @@ const (
 		  expires_at = COALESCE($3, expires_at)
 		WHERE booking_id = $1;`
 
+	// SelectBookingHoldDeadline is when the first active lock of the booking expires.
+	SelectBookingHoldDeadline = `
+		SELECT MIN(expires_at)
+		FROM room_lock
+		WHERE booking_id = $1 AND is_active = TRUE;`
+
+	DeleteRoomBlockByID = `
+		DELETE FROM room_lock
+		WHERE block_id = $1;`
+
 	DeleteRoomLockByID = `
 		DELETE FROM room_lock
 		WHERE id = $1;`
@@ -97,3 +97,229 @@ func (r *Repository) UpdateRoomLocksActivityByID(
 
 	return nil
 }
+
+// UpdateRoomLocksStayRange moves every lock of the booking to the new stay;
+// bookings whose rooms are all unassigned have none to move.
+func (r *Repository) UpdateRoomLocksStayRange(
+	ctx context.Context,
+	tx pgx.Tx,
+	bookingID uuid.UUID,
+	stayRange models.DateRange,
+) error {
+	db := r.executor(tx)
+
+	_, err := db.Exec(ctx, query.UpdateRoomLocksStayRangeByBookingID, bookingID, stayRange.Start, stayRange.End)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23P01" {
+			return consts.ErrRoomLockAlreadyExist
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteRoomLockByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	db := r.executor(tx)
+
+	row, err := db.Exec(ctx, query.DeleteRoomLockByID, id)
+	if err != nil {
+		return err
+	}
+	if row.RowsAffected() == 0 {
+		return consts.ErrRoomLockNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateRoomBlock(
+	ctx context.Context,
+	tx pgx.Tx,
+	block *models.CreateRoomBlock,
+) (*models.RoomLockDetail, error) {
+	db := r.executor(tx)
+
+	rl := &models.RoomLockDetail{
+		StayRange: block.StayRange,
+		RoomID:    block.RoomID,
+	}
+	err := db.QueryRow(
+		ctx, query.CreateRoomBlock,
+		block.RoomID,
+		block.BlockID,
+		block.StayRange.Start,
+		block.StayRange.End,
+	).Scan(&rl.ID, &rl.ISActive, &rl.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && (pgErr.Code == "23P01" || pgErr.Code == "23505") {
+			return nil, consts.ErrRoomLockAlreadyExist
+		}
+		return nil, err
+	}
+
+	return rl, nil
+}
+
+func (r *Repository) DeleteRoomBlockByID(ctx context.Context, tx pgx.Tx, blockID uuid.UUID) error {
+	db := r.executor(tx)
+
+	row, err := db.Exec(ctx, query.DeleteRoomBlockByID, blockID)
+	if err != nil {
+		return err
+	}
+	if row.RowsAffected() == 0 {
+		return consts.ErrRoomLockNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) GetActiveRoomLocks(
+	ctx context.Context,
+	tx pgx.Tx,
+	roomIDs []uuid.UUID,
+	stayRange models.DateRange,
+) ([]models.RoomOccupancy, error) {
+	db := r.executor(tx)
+
+	rows, err := db.Query(ctx, query.SelectActiveRoomLocks, roomIDs, stayRange.Start, stayRange.End)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var occupancies []models.RoomOccupancy
+	for rows.Next() {
+		var o models.RoomOccupancy
+		var bookingID, blockID *uuid.UUID
+		if err = rows.Scan(
+			&o.RoomID,
+			&bookingID,
+			&blockID,
+			&o.StayRange.Start,
+			&o.StayRange.End,
+		); err != nil {
+			return nil, err
+		}
+
+		if blockID != nil {
+			o.Kind = models.RoomOccupancyKindBlock
+			o.ReferenceID = *blockID
+		} else if bookingID != nil {
+			o.Kind = models.RoomOccupancyKindBooking
+			o.ReferenceID = *bookingID
+		}
+		occupancies = append(occupancies, o)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return occupancies, nil
+}
+
+func (r *Repository) GetOccupiedRoomIDs(
+	ctx context.Context,
+	tx pgx.Tx,
+	roomIDs []uuid.UUID,
+	stayRange models.DateRange,
+) (map[uuid.UUID]bool, error) {
+	db := r.executor(tx)
+
+	rows, err := db.Query(ctx, query.SelectOccupiedRoomIDs, roomIDs, stayRange.Start, stayRange.End)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	occupied := make(map[uuid.UUID]bool)
+	for rows.Next() {
+		var roomID uuid.UUID
+		if err = rows.Scan(&roomID); err != nil {
+			return nil, err
+		}
+		occupied[roomID] = true
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return occupied, nil
+}
+
+func (r *Repository) CountUnassignedCategoryRooms(
+	ctx context.Context,
+	tx pgx.Tx,
+	categoryID uuid.UUID,
+	stayRange models.DateRange,
+	excludeBookingID *uuid.UUID,
+) (uint32, error) {
+	db := r.executor(tx)
+
+	var count uint32
+	err := db.QueryRow(
+		ctx, query.CountUnassignedCategoryRooms, categoryID, stayRange.Start, stayRange.End, excludeBookingID,
+	).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetBookingHoldDeadline returns when the first active lock of the booking
+// expires, or nil when it holds no active lock.
+func (r *Repository) GetBookingHoldDeadline(ctx context.Context, tx pgx.Tx, bookingID uuid.UUID) (*time.Time, error) {
+	db := r.executor(tx)
+
+	var deadline *time.Time
+	if err := db.QueryRow(ctx, query.SelectBookingHoldDeadline, bookingID).Scan(&deadline); err != nil {
+		return nil, err
+	}
+
+	return deadline, nil
+}
+
+func (r *Repository) LockRoomCategory(ctx context.Context, tx pgx.Tx, categoryID uuid.UUID) error {
+	_, err := r.executor(tx).Exec(ctx, query.LockRoomCategory, categoryID)
+	return err
+}
+
+func (r *Repository) MoveRoomLock(
+	ctx context.Context,
+	tx pgx.Tx,
+	bookingID uuid.UUID,
+	fromRoomID uuid.UUID,
+	toRoomID uuid.UUID,
+) (*models.RoomLockShort, error) {
+	db := r.executor(tx)
+
+	var lock models.RoomLockShort
+	var expiresAt *time.Time
+	err := db.QueryRow(ctx, query.MoveRoomLock, bookingID, fromRoomID, toRoomID).Scan(
+		&lock.ID,
+		&lock.ISActive,
+		&expiresAt,
+		&lock.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrRoomLockNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23P01" {
+			return nil, consts.ErrRoomLockAlreadyExist
+		}
+		return nil, err
+	}
+	if expiresAt != nil {
+		lock.ExpiresAt = *expiresAt
+	}
+
+	return &lock, nil
+}
@@ -2,14 +2,17 @@ package service
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"time"
 
+	"booking/internal/outbox"
 	"booking/internal/repository/models"
 	"booking/internal/service/utils/helper"
 	"booking/internal/utils/consts"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 func (s *Service) BookingCreate(
@@ -22,7 +25,38 @@ func (s *Service) BookingCreate(
 	}
 
 	var err error
-	b.FinalTotalAmount, err = helper.CalculateTotalAmount(b.CheckIn, b.CheckOut, rooms, b.ExpectedTotalAmount)
+	b.Policy, err = s.hotel.GetHotelPolicy(ctx, b.HotelID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get hotel policy", "err", err)
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(b.Policy.Timezone)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load hotel timezone", "err", err)
+		return nil, err
+	}
+
+	b.CheckIn = helper.LocalDate(b.CheckIn, loc)
+	b.CheckOut = helper.LocalDate(b.CheckOut, loc)
+	if !helper.CheckInOpen(b.CheckIn, time.Now(), loc) {
+		return nil, consts.ErrCheckInPassed
+	}
+
+	categories, err := s.resolveCategories(ctx, b.HotelID, rooms)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkStayRestrictions(ctx, b, rooms, categories); err != nil {
+		return nil, err
+	}
+
+	if err = s.quoteRooms(ctx, b, rooms, categories); err != nil {
+		return nil, err
+	}
+
+	b.FinalTotalAmount, err = helper.CalculateTotalAmount(b.CheckIn, b.CheckOut, loc, rooms, b.ExpectedTotalAmount)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed calculate total amount", "err", err)
 		return nil, err
@@ -35,33 +69,48 @@ func (s *Service) BookingCreate(
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	newBooking, err := s.repo.CreateBooking(ctx, tx, b)
+	newBooking, err := s.createBookingWithCode(ctx, tx, b)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to create booking", "err", err)
 		return nil, err
 	}
 
+	created := &models.BookingStatusTransition{
+		ActorID:   &b.UserID,
+		To:        newBooking.Status,
+		BookingID: newBooking.ID,
+	}
+	if _, err = s.repo.CreateBookingStatusTransition(ctx, tx, created); err != nil {
+		slog.ErrorContext(ctx, "failed to create booking status transition", "err", err)
+		return nil, err
+	}
+
 	for _, room := range rooms {
 		room.BookingID = newBooking.ID
 	}
 
+	stay := models.DateRange{Start: b.CheckIn, End: b.CheckOut}
+	if err = s.reserveCategories(ctx, tx, rooms, categories, stay, nil); err != nil {
+		return nil, err
+	}
+
 	newRooms, err := s.repo.CreateBookingRooms(ctx, tx, newBooking.ID, rooms)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to create booking rooms", "err", err)
 		return nil, err
 	}
 
-	locks := make([]*models.CreateRoomLock, len(newRooms))
-	for i, nr := range newRooms {
-		locks[i] = &models.CreateRoomLock{
-			RoomID:    nr.RoomID,
+	locks := make([]*models.CreateRoomLock, 0, len(newRooms))
+	for _, nr := range newRooms {
+		if nr.RoomID == nil {
+			continue
+		}
+		locks = append(locks, &models.CreateRoomLock{
+			RoomID:    *nr.RoomID,
 			BookingID: newBooking.ID,
-			StayRange: models.DateRange{
-				Start: b.CheckIn,
-				End:   b.CheckOut,
-			},
+			StayRange: stay,
 			ExpiresAt: time.Now().Add(consts.ExpireRoomLockMinutes * time.Minute),
-		}
+		})
 	}
 
 	newLocks, err := s.repo.CreateRoomLocks(ctx, tx, locks)
@@ -70,23 +119,28 @@ func (s *Service) BookingCreate(
 		return nil, err
 	}
 
-	locksByRoomID := make(map[uuid.UUID]models.RoomLockShort)
+	locksByRoomID := make(map[uuid.UUID]*models.RoomLockShort)
 	for _, lock := range newLocks {
-		locksByRoomID[lock.RoomID] = models.RoomLockShort{
-			ID:        lock.ID,
-			ISActive:  lock.ISActive,
-			ExpiresAt: lock.ExpiresAt,
-			CreatedAt: lock.CreatedAt,
-		}
+		locksByRoomID[lock.RoomID] = roomLockShort(lock)
 	}
 
 	for i := range newRooms {
-		if lock, exists := locksByRoomID[newRooms[i].RoomID]; exists {
-			newRooms[i].RoomLock = lock
+		if newRooms[i].RoomID != nil {
+			newRooms[i].RoomLock = locksByRoomID[*newRooms[i].RoomID]
 		}
 	}
 	newBooking.BookingRooms = newRooms
 
+	event, err := outbox.BookingCreated(newBooking, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build booking created event", "err", err)
+		return nil, err
+	}
+	if err = s.repo.CreateOutboxEvent(ctx, tx, event); err != nil {
+		slog.ErrorContext(ctx, "failed to create outbox event", "err", err)
+		return nil, err
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
 		return nil, err
@@ -95,6 +149,50 @@ func (s *Service) BookingCreate(
 	return newBooking, nil
 }
 
+func (s *Service) checkStayRestrictions(
+	ctx context.Context,
+	b *models.CreateBooking,
+	rooms []*models.CreateBookingRoom,
+	categories map[uuid.UUID]*models.RoomCategory,
+) error {
+	var violations []models.StayViolation
+	for _, room := range rooms {
+		roomViolations, err := s.hotel.CheckStay(ctx, quotedRoomID(room, categories), b.CheckIn, b.CheckOut)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to check stay restrictions", "err", err)
+			return err
+		}
+		violations = append(violations, roomViolations...)
+	}
+
+	if len(violations) > 0 {
+		return &models.StayRestrictionError{Violations: violations}
+	}
+
+	return nil
+}
+
+func (s *Service) quoteRooms(
+	ctx context.Context,
+	b *models.CreateBooking,
+	rooms []*models.CreateBookingRoom,
+	categories map[uuid.UUID]*models.RoomCategory,
+) error {
+	for _, room := range rooms {
+		quote, err := s.hotel.QuoteStay(ctx, quotedRoomID(room, categories), b.CheckIn, b.CheckOut)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to quote stay", "err", err)
+			return err
+		}
+
+		room.StayAmount = quote.TotalAmount
+		room.PricePerNight = helper.AverageNightlyPrice(quote)
+		room.NightlyPrices = helper.NightlyPrices(quote)
+	}
+
+	return nil
+}
+
 func (s *Service) GetBookings(
 	ctx context.Context,
 	bookingRef models.BookingRef,
@@ -148,49 +246,137 @@ func (s *Service) GetBookingById(ctx context.Context, bookingID uuid.UUID) (*mod
 	return booking, nil
 }
 
-func (s *Service) UpdateBookingStatus(
+// FindBookingByCode lets a guest without an account open their booking by its
+// reference code, proving it is theirs with the guest email or last name.
+func (s *Service) FindBookingByCode(
 	ctx context.Context,
-	bookingID uuid.UUID,
-	status models.BookingStatus,
-) error {
-	checkOut, err := s.repo.UpdateBookingStatusByID(ctx, nil, bookingID, status)
+	code string,
+	check models.BookingGuestCheck,
+) (*models.Booking, error) {
+	booking, err := s.repo.GetBookingByCode(ctx, nil, helper.NormalizeBookingCode(code), check)
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to update booking status", "err", err)
-		return err
+		slog.ErrorContext(ctx, "failed to get booking by code", "err", err)
+		return nil, err
 	}
 
-	var roomLockStatus = &models.RoomLockActivity{}
+	allRooms, err := s.repo.GetBookingRoomsWithLockByBookingIDs(ctx, nil, []uuid.UUID{booking.ID})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking rooms by booking id", "err", err)
+		return nil, err
+	}
+
+	booking.BookingRooms = allRooms
+	return booking, nil
+}
 
-	switch status {
-	case models.BookingStatusConfirmed:
-		roomLockStatus.IsActive = false
-		roomLockStatus.ExpiresAt = &checkOut
-	case models.BookingStatusCancelled:
-		now := time.Now()
-		roomLockStatus.IsActive = true
-		roomLockStatus.ExpiresAt = &now
-	default:
-		return consts.ErrInvalidBookingStatus
+// UpdateBookingStatus moves the booking through its state machine; the booking
+// row stays locked until the new status, its room locks and the history entry
+// are written.
+func (s *Service) UpdateBookingStatus(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	change *models.BookingStatusChange,
+	expectedVersion *int64,
+) (int64, error) {
+	if change == nil {
+		return 0, consts.ErrNilObject
 	}
 
 	tx, err := s.repo.BeginTx(ctx)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
-		return err
+		return 0, err
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	if err = s.repo.UpdateRoomLocksActivityByID(ctx, nil, bookingID, roomLockStatus); err != nil {
-		slog.ErrorContext(ctx, "failed to update room locks activity", "err", err)
-		return err
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return 0, err
+	}
+	if expectedVersion != nil && *expectedVersion != booking.Version {
+		return 0, consts.ErrVersionMismatch
+	}
+
+	version, err := s.transitionBooking(ctx, tx, booking, change)
+	if err != nil {
+		return 0, err
 	}
 
 	if err = tx.Commit(ctx); err != nil {
 		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
-		return err
+		return 0, err
 	}
 
-	return nil
+	return version, nil
+}
+
+// transitionBooking moves a booking locked for update to change.To, updating
+// its room locks and history in tx.
+func (s *Service) transitionBooking(
+	ctx context.Context,
+	tx pgx.Tx,
+	booking *models.Booking,
+	change *models.BookingStatusChange,
+) (int64, error) {
+	if err := helper.CheckBookingTransition(booking.Status, change.To); err != nil {
+		return 0, err
+	}
+
+	// Category rooms get a room at confirmation; ones added to a confirmed
+	// booking get theirs when the guest checks in.
+	if change.To == models.BookingStatusConfirmed || change.To == models.BookingStatusCheckedIn {
+		if err := s.assignCategoryRooms(ctx, tx, booking); err != nil {
+			return 0, err
+		}
+	}
+
+	checkOut, version, err := s.repo.UpdateBookingStatusByID(ctx, tx, booking.ID, change.To, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update booking status", "err", err)
+		return 0, err
+	}
+
+	if roomLockStatus := helper.RoomLockActivityFor(change.To, checkOut, time.Now()); roomLockStatus != nil {
+		// Bookings whose category rooms are not assigned yet hold no locks.
+		err = s.repo.UpdateRoomLocksActivityByID(ctx, tx, booking.ID, roomLockStatus)
+		if err != nil && !errors.Is(err, consts.ErrRoomLockNotFound) {
+			slog.ErrorContext(ctx, "failed to update room locks activity", "err", err)
+			return 0, err
+		}
+	}
+
+	if _, err = s.repo.CreateBookingStatusTransition(ctx, tx, change.ToTransition(booking.ID, booking.Status)); err != nil {
+		slog.ErrorContext(ctx, "failed to create booking status transition", "err", err)
+		return 0, err
+	}
+
+	event, err := outbox.BookingStatusChanged(booking, change, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build booking status event", "err", err)
+		return 0, err
+	}
+	if err = s.repo.CreateOutboxEvent(ctx, tx, event); err != nil {
+		slog.ErrorContext(ctx, "failed to create outbox event", "err", err)
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (s *Service) GetBookingHistory(ctx context.Context, bookingID uuid.UUID) ([]*models.BookingStatusTransition, error) {
+	if _, err := s.repo.GetBookingByID(ctx, nil, bookingID); err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return nil, err
+	}
+
+	history, err := s.repo.GetBookingStatusHistory(ctx, nil, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking status history", "err", err)
+		return nil, err
+	}
+
+	return history, nil
 }
 
 func (s *Service) DeleteBookingByID(ctx context.Context, id uuid.UUID) error {
@@ -201,3 +387,116 @@ func (s *Service) DeleteBookingByID(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+func (s *Service) GetActiveBookings(ctx context.Context, target models.ActiveBookingTarget) ([]uuid.UUID, error) {
+	ids, err := s.repo.GetActiveBookingIDs(ctx, nil, target)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get active bookings", "err", err)
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ExpireHolds expires up to batchSize pending bookings whose room hold ran out
+// before they were paid and returns how many were expired.
+func (s *Service) ExpireHolds(ctx context.Context, batchSize int) (int, error) {
+	ids, err := s.repo.GetExpiredHoldBookingIDs(ctx, nil, consts.ExpireRoomLockMinutes, batchSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get expired hold bookings", "err", err)
+		return 0, err
+	}
+
+	var expired int
+	for _, id := range ids {
+		ok, err := s.expireHold(ctx, id)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to expire booking hold", "booking_id", id, "err", err)
+			continue
+		}
+		if ok {
+			expired++
+		}
+	}
+
+	return expired, nil
+}
+
+// expireHold expires the booking unless it was paid or cancelled since it was
+// listed, reporting whether it did.
+func (s *Service) expireHold(ctx context.Context, bookingID uuid.UUID) (bool, error) {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return false, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return false, err
+	}
+	if booking.Status != models.BookingStatusPending {
+		return false, nil
+	}
+
+	deadline, err := s.holdDeadline(ctx, tx, booking)
+	if err != nil {
+		return false, err
+	}
+	if time.Now().Before(deadline) {
+		return false, nil
+	}
+
+	reason := consts.ReasonHoldExpired
+	change := &models.BookingStatusChange{Reason: &reason, To: models.BookingStatusExpired}
+	if _, err = s.transitionBooking(ctx, tx, booking, change); err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// holdDeadline is when the room hold of a pending booking runs out: its first
+// active lock expires then. Bookings without locks, whose category rooms are
+// not assigned yet, are held as long as a fresh lock would be.
+func (s *Service) holdDeadline(ctx context.Context, tx pgx.Tx, booking *models.Booking) (time.Time, error) {
+	deadline, err := s.repo.GetBookingHoldDeadline(ctx, tx, booking.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking hold deadline", "err", err)
+		return time.Time{}, err
+	}
+	if deadline == nil {
+		return booking.CreatedAt.Add(consts.ExpireRoomLockMinutes * time.Minute), nil
+	}
+
+	return *deadline, nil
+}
+
+// createBookingWithCode inserts the booking under a fresh reference code,
+// drawing another one when the code is already taken.
+func (s *Service) createBookingWithCode(
+	ctx context.Context,
+	tx pgx.Tx,
+	b *models.CreateBooking,
+) (*models.Booking, error) {
+	var err error
+	for range consts.BookingCodeAttempts {
+		if b.Code, err = helper.NewBookingCode(); err != nil {
+			return nil, err
+		}
+
+		newBooking, err := s.repo.CreateBooking(ctx, tx, b)
+		if !errors.Is(err, consts.ErrBookingCodeTaken) {
+			return newBooking, err
+		}
+	}
+
+	return nil, consts.ErrBookingCodeTaken
+}
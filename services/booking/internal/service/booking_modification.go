@@ -0,0 +1,502 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+
+	"booking/internal/outbox"
+	"booking/internal/repository/models"
+	"booking/internal/service/utils/helper"
+	"booking/internal/utils/consts"
+)
+
+// modifiableStatuses are the booking statuses whose stay can still be changed.
+var modifiableStatuses = []models.BookingStatus{
+	models.BookingStatusPending,
+	models.BookingStatusConfirmed,
+}
+
+// guestEditableStatuses are the booking statuses whose guest details can still
+// be corrected.
+var guestEditableStatuses = []models.BookingStatus{
+	models.BookingStatusPending,
+	models.BookingStatusConfirmed,
+	models.BookingStatusCheckedIn,
+}
+
+// bookingModification changes a booking locked for update; loc is the hotel
+// timezone its dates are kept in.
+type bookingModification func(tx pgx.Tx, booking *models.Booking, loc *time.Location) error
+
+// modifyBooking locks the booking, makes sure its version, status, check-in and
+// the cancellation tiers of its policy still allow a change, runs modify and
+// stores the recomputed total in the same transaction.
+func (s *Service) modifyBooking(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	expectedVersion *int64,
+	modify bookingModification,
+) (*models.Booking, error) {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return nil, err
+	}
+	if expectedVersion != nil && *expectedVersion != booking.Version {
+		return nil, consts.ErrVersionMismatch
+	}
+	if !slices.Contains(modifiableStatuses, booking.Status) {
+		return nil, consts.ErrBookingNotModifiable
+	}
+
+	policy, err := s.bookingPolicy(ctx, booking)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(policy.Timezone)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load hotel timezone", "err", err)
+		return nil, err
+	}
+
+	now := time.Now()
+	if !helper.CheckInOpen(booking.CheckIn, now, loc) {
+		return nil, consts.ErrCheckInPassed
+	}
+	checkInAt, err := helper.CheckInAt(booking.CheckIn, policy.CheckInTime, loc)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to parse hotel check-in time", "err", err)
+		return nil, err
+	}
+	if err = helper.CheckModificationWindow(policy.CancellationTiers, checkInAt.Sub(now).Hours()); err != nil {
+		return nil, err
+	}
+
+	if err = modify(tx, booking, loc); err != nil {
+		return nil, err
+	}
+
+	if _, err = s.repo.RecalculateBookingTotal(ctx, tx, booking.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to recalculate booking total", "err", err)
+		return nil, err
+	}
+
+	if err = s.recordBookingModified(ctx, tx, booking.ID); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return nil, err
+	}
+
+	return s.GetBookingById(ctx, booking.ID)
+}
+
+// recordBookingModified stores booking.modified in tx with the booking as the
+// change left it.
+func (s *Service) recordBookingModified(ctx context.Context, tx pgx.Tx, bookingID uuid.UUID) error {
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return err
+	}
+
+	event, err := outbox.BookingModified(booking, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build booking modified event", "err", err)
+		return err
+	}
+	if err = s.repo.CreateOutboxEvent(ctx, tx, event); err != nil {
+		slog.ErrorContext(ctx, "failed to create outbox event", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// bookingPolicy is the policy the booking was made under, asking the hotel for
+// bookings made before policies were kept.
+func (s *Service) bookingPolicy(ctx context.Context, booking *models.Booking) (*models.PolicySnapshot, error) {
+	if booking.Policy != nil {
+		return booking.Policy, nil
+	}
+
+	policy, err := s.hotel.GetHotelPolicy(ctx, booking.HotelID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get hotel policy", "err", err)
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// bookingLocation loads the hotel timezone from the policy of the booking.
+func (s *Service) bookingLocation(ctx context.Context, booking *models.Booking) (*time.Location, error) {
+	policy, err := s.bookingPolicy(ctx, booking)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(policy.Timezone)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load hotel timezone", "err", err)
+		return nil, err
+	}
+
+	return loc, nil
+}
+
+// UpdateBookingGuest corrects the guest details; unlike the stay it can change
+// until the guest checks out.
+func (s *Service) UpdateBookingGuest(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	guest *models.UpdateBooking,
+	expectedVersion *int64,
+) (*models.Booking, error) {
+	if guest == nil {
+		return nil, consts.ErrNilObject
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return nil, err
+	}
+	if !slices.Contains(guestEditableStatuses, booking.Status) {
+		return nil, consts.ErrBookingNotModifiable
+	}
+
+	if _, err = s.repo.UpdateBookingGuestInfoByID(ctx, tx, bookingID, guest, expectedVersion); err != nil {
+		slog.ErrorContext(ctx, "failed to update booking guest info", "err", err)
+		return nil, err
+	}
+
+	if err = s.recordBookingModified(ctx, tx, bookingID); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return nil, err
+	}
+
+	return s.GetBookingById(ctx, bookingID)
+}
+
+// ChangeBookingDates moves the whole booking to a new stay: every room is
+// checked and priced again, the room locks are moved under the overlap
+// constraint and category rooms still waiting for a room are reserved anew.
+func (s *Service) ChangeBookingDates(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	stay models.DateRange,
+	expectedTotal decimal.Decimal,
+	expectedVersion *int64,
+) (*models.Booking, error) {
+	return s.modifyBooking(ctx, bookingID, expectedVersion,
+		func(tx pgx.Tx, booking *models.Booking, loc *time.Location) error {
+			change := &models.CreateBooking{
+				CheckIn:  helper.LocalDate(stay.Start, loc),
+				CheckOut: helper.LocalDate(stay.End, loc),
+			}
+			if !helper.CheckInOpen(change.CheckIn, time.Now(), loc) {
+				return consts.ErrCheckInPassed
+			}
+
+			bRooms, err := s.repo.GetBookingRoomsWithLockByBookingIDs(ctx, tx, []uuid.UUID{booking.ID})
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to get booking rooms by booking id", "err", err)
+				return err
+			}
+
+			rooms := make([]*models.CreateBookingRoom, len(bRooms))
+			var unassigned []*models.CreateBookingRoom
+			for i, bRoom := range bRooms {
+				rooms[i] = stayedBookingRoom(bRoom)
+				if bRoom.RoomID == nil {
+					unassigned = append(unassigned, rooms[i])
+				}
+			}
+
+			categories, err := s.resolveCategories(ctx, booking.HotelID, unassigned)
+			if err != nil {
+				return err
+			}
+
+			if err = s.checkStayRestrictions(ctx, change, rooms, categories); err != nil {
+				return err
+			}
+
+			if err = s.quoteRooms(ctx, change, rooms, categories); err != nil {
+				return err
+			}
+
+			_, err = helper.CalculateTotalAmount(change.CheckIn, change.CheckOut, loc, rooms, expectedTotal)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed calculate total amount", "err", err)
+				return err
+			}
+
+			newStay := models.DateRange{Start: change.CheckIn, End: change.CheckOut}
+			if err = s.repo.UpdateBookingDates(ctx, tx, booking.ID, newStay); err != nil {
+				slog.ErrorContext(ctx, "failed to update booking dates", "err", err)
+				return err
+			}
+
+			if err = s.repo.UpdateRoomLocksStayRange(ctx, tx, booking.ID, newStay); err != nil {
+				slog.ErrorContext(ctx, "failed to update room locks stay range", "err", err)
+				return err
+			}
+
+			for i, bRoom := range bRooms {
+				err = s.repo.UpdateBookingRoomPrice(
+					ctx, tx, bRoom.ID, rooms[i].PricePerNight, rooms[i].StayAmount, rooms[i].NightlyPrices,
+				)
+				if err != nil {
+					slog.ErrorContext(ctx, "failed to update booking room price", "err", err)
+					return err
+				}
+			}
+
+			return s.reserveCategories(ctx, tx, unassigned, categories, newStay, &booking.ID)
+		},
+	)
+}
+
+// AddBookingRoom books one more room or category room for the stay of the
+// booking at the current price.
+func (s *Service) AddBookingRoom(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	room *models.CreateBookingRoom,
+	expectedTotal decimal.Decimal,
+	expectedVersion *int64,
+) (*models.Booking, error) {
+	if room == nil {
+		return nil, consts.ErrNilObject
+	}
+
+	return s.modifyBooking(ctx, bookingID, expectedVersion,
+		func(tx pgx.Tx, booking *models.Booking, loc *time.Location) error {
+			room.BookingID = booking.ID
+			added := []*models.CreateBookingRoom{room}
+
+			categories, err := s.resolveCategories(ctx, booking.HotelID, added)
+			if err != nil {
+				return err
+			}
+
+			if err = s.checkRoomCapacity(ctx, booking.HotelID, room, categories); err != nil {
+				return err
+			}
+
+			stayBooking := &models.CreateBooking{CheckIn: booking.CheckIn, CheckOut: booking.CheckOut}
+			if err = s.checkStayRestrictions(ctx, stayBooking, added, categories); err != nil {
+				return err
+			}
+
+			if err = s.quoteRooms(ctx, stayBooking, added, categories); err != nil {
+				return err
+			}
+
+			bRooms, err := s.repo.GetBookingRoomsWithLockByBookingIDs(ctx, tx, []uuid.UUID{booking.ID})
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to get booking rooms by booking id", "err", err)
+				return err
+			}
+
+			rooms := make([]*models.CreateBookingRoom, 0, len(bRooms)+1)
+			for _, bRoom := range bRooms {
+				rooms = append(rooms, stayedBookingRoom(bRoom))
+			}
+			rooms = append(rooms, room)
+
+			_, err = helper.CalculateTotalAmount(booking.CheckIn, booking.CheckOut, loc, rooms, expectedTotal)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed calculate total amount", "err", err)
+				return err
+			}
+
+			stay := models.DateRange{Start: booking.CheckIn, End: booking.CheckOut}
+			if err = s.reserveCategories(ctx, tx, added, categories, stay, nil); err != nil {
+				return err
+			}
+
+			if _, err = s.repo.CreateBookingRooms(ctx, tx, booking.ID, added); err != nil {
+				slog.ErrorContext(ctx, "failed to create booking rooms", "err", err)
+				return err
+			}
+
+			if room.RoomID != nil {
+				if _, err = s.lockRoom(ctx, tx, booking, *room.RoomID); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+}
+
+// RemoveBookingRoom drops a room from the booking and releases its lock; the
+// last room cannot be removed, the booking has to be cancelled instead.
+func (s *Service) RemoveBookingRoom(
+	ctx context.Context,
+	bookingRoomID uuid.UUID,
+	expectedVersion *int64,
+) (*models.Booking, error) {
+	bRoom, err := s.repo.GetBookingRoomByID(ctx, nil, bookingRoomID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking room by id", "err", err)
+		return nil, err
+	}
+
+	return s.modifyBooking(ctx, bRoom.BookingID, expectedVersion,
+		func(tx pgx.Tx, booking *models.Booking, _ *time.Location) error {
+			bRooms, err := s.repo.GetBookingRoomsWithLockByBookingIDs(ctx, tx, []uuid.UUID{booking.ID})
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to get booking rooms by booking id", "err", err)
+				return err
+			}
+
+			idx := slices.IndexFunc(bRooms, func(br *models.BookingRoomWithLock) bool { return br.ID == bookingRoomID })
+			if idx < 0 {
+				return consts.ErrBookingRoomNotFound
+			}
+			if len(bRooms) == 1 {
+				return consts.ErrLastBookingRoom
+			}
+
+			if lock := bRooms[idx].RoomLock; lock != nil {
+				if err = s.repo.DeleteRoomLockByID(ctx, tx, lock.ID); err != nil {
+					slog.ErrorContext(ctx, "failed to delete room lock", "err", err)
+					return err
+				}
+			}
+
+			if err = s.repo.DeleteBookingRoomByID(ctx, tx, bookingRoomID); err != nil {
+				slog.ErrorContext(ctx, "failed to delete booking room", "err", err)
+				return err
+			}
+
+			return nil
+		},
+	)
+}
+
+// UpdateBookingRoomGuests changes how many guests stay in a booking room
+// within the capacity of its room or category.
+func (s *Service) UpdateBookingRoomGuests(
+	ctx context.Context,
+	bookingRoomID uuid.UUID,
+	counts models.BookingRoomGuestCounts,
+	expectedVersion *int64,
+) (*models.Booking, error) {
+	bRoom, err := s.repo.GetBookingRoomByID(ctx, nil, bookingRoomID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking room by id", "err", err)
+		return nil, err
+	}
+
+	return s.modifyBooking(ctx, bRoom.BookingID, expectedVersion,
+		func(tx pgx.Tx, booking *models.Booking, _ *time.Location) error {
+			// Reread the booking room now that its booking is locked.
+			if bRoom, err = s.repo.GetBookingRoomByID(ctx, tx, bookingRoomID); err != nil {
+				slog.ErrorContext(ctx, "failed to get booking room by id", "err", err)
+				return err
+			}
+
+			room := stayedBookingRoom(bRoom)
+			room.Adults = counts.Adults
+			room.Children = counts.Children
+
+			var categories map[uuid.UUID]*models.RoomCategory
+			if room.RoomID == nil {
+				if categories, err = s.resolveCategories(ctx, booking.HotelID, []*models.CreateBookingRoom{room}); err != nil {
+					return err
+				}
+			}
+
+			if err = s.checkRoomCapacity(ctx, booking.HotelID, room, categories); err != nil {
+				return err
+			}
+
+			if err = s.repo.UpdateBookingRoomGuestCounts(ctx, tx, bookingRoomID, counts); err != nil {
+				slog.ErrorContext(ctx, "failed to update booking room guest counts", "err", err)
+				return err
+			}
+
+			return nil
+		},
+	)
+}
+
+// checkRoomCapacity makes sure the guests of the room fit its physical room,
+// which must belong to the hotel and booked category, or else its category.
+func (s *Service) checkRoomCapacity(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	room *models.CreateBookingRoom,
+	categories map[uuid.UUID]*models.RoomCategory,
+) error {
+	var capacity uint32
+	if room.RoomID != nil {
+		hotelRoom, err := s.hotel.GetRoom(ctx, *room.RoomID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get room", "err", err)
+			return err
+		}
+		if hotelRoom.HotelID != hotelID {
+			return consts.ErrRoomCategoryMismatch
+		}
+		if room.CategoryID != nil && (hotelRoom.CategoryID == nil || *hotelRoom.CategoryID != *room.CategoryID) {
+			return consts.ErrRoomCategoryMismatch
+		}
+		capacity = hotelRoom.Capacity
+	} else {
+		capacity = categories[*room.CategoryID].Capacity
+	}
+
+	if room.Adults+room.Children > capacity {
+		return consts.ErrRoomCapacityExceeded
+	}
+
+	return nil
+}
+
+// stayedBookingRoom turns a stored booking room back into the shape rooms are
+// checked, priced and totalled in.
+func stayedBookingRoom(bRoom *models.BookingRoomWithLock) *models.CreateBookingRoom {
+	return &models.CreateBookingRoom{
+		RoomID:        bRoom.RoomID,
+		CategoryID:    bRoom.CategoryID,
+		PricePerNight: bRoom.PricePerNight,
+		StayAmount:    bRoom.StayAmount,
+		NightlyPrices: bRoom.NightlyPrices,
+		BookingID:     bRoom.BookingID,
+		Adults:        bRoom.Adults,
+		Children:      bRoom.Children,
+	}
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+func TestModifyBookingPolicy(t *testing.T) {
+	tiers := []models.CancellationTier{
+		{HoursBeforeCheckIn: 72, Penalty: models.CancellationPenaltyNone},
+		{HoursBeforeCheckIn: 0, Penalty: models.CancellationPenaltyPercent, PenaltyPercent: 50},
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		tiers   []models.CancellationTier
+		checkIn time.Time
+		wantErr error
+	}{
+		{name: "free cancellation window", tiers: tiers, checkIn: today.AddDate(0, 0, 10)},
+		{
+			name:    "penalty window",
+			tiers:   tiers,
+			checkIn: today.AddDate(0, 0, 2),
+			wantErr: consts.ErrModificationWindowClosed,
+		},
+		{name: "policy without tiers", checkIn: today.AddDate(0, 0, 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := newBooking(models.BookingStatusConfirmed)
+			booking.CheckIn = tt.checkIn
+			booking.CheckOut = tt.checkIn.AddDate(0, 0, 3)
+			booking.Policy = &models.PolicySnapshot{
+				Timezone:          "UTC",
+				CheckInTime:       "14:00",
+				CheckOutTime:      "12:00",
+				CancellationTiers: tt.tiers,
+			}
+			f := newFixture(t, booking)
+			if tt.wantErr == nil {
+				f.repo.EXPECT().RecalculateBookingTotal(mock.Anything, mock.Anything, booking.ID).
+					RunAndReturn(f.recalculateBookingTotal)
+			}
+
+			var modified bool
+			_, err := f.service().modifyBooking(context.Background(), booking.ID, nil,
+				func(pgx.Tx, *models.Booking, *time.Location) error {
+					modified = true
+					return nil
+				},
+			)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("modifyBooking() error = %v, want %v", err, tt.wantErr)
+			}
+			if modified != (tt.wantErr == nil) {
+				t.Errorf("modification ran = %v, want %v", modified, tt.wantErr == nil)
+			}
+			if tt.wantErr != nil && f.commits != 0 {
+				t.Errorf("commits = %d, want none", f.commits)
+			}
+		})
+	}
+}
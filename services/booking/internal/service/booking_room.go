@@ -0,0 +1,372 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+// resolveCategories loads the categories booked by the rooms, making sure they
+// belong to the booked hotel and have rooms to assign.
+func (s *Service) resolveCategories(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	rooms []*models.CreateBookingRoom,
+) (map[uuid.UUID]*models.RoomCategory, error) {
+	categories := make(map[uuid.UUID]*models.RoomCategory)
+	for _, room := range rooms {
+		if room.CategoryID == nil {
+			continue
+		}
+		if _, exists := categories[*room.CategoryID]; exists {
+			continue
+		}
+
+		category, err := s.hotel.GetRoomCategory(ctx, *room.CategoryID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get room category", "err", err)
+			return nil, err
+		}
+		if category.HotelID != hotelID {
+			return nil, consts.ErrRoomCategoryMismatch
+		}
+		if len(category.RoomIDs) == 0 {
+			return nil, consts.ErrRoomCategoryUnavailable
+		}
+		categories[category.ID] = category
+	}
+
+	return categories, nil
+}
+
+// quotedRoomID is the room a booking room is priced and checked against; a
+// category stands in with its first room, which shares the category price.
+func quotedRoomID(room *models.CreateBookingRoom, categories map[uuid.UUID]*models.RoomCategory) uuid.UUID {
+	if room.RoomID != nil {
+		return *room.RoomID
+	}
+	return categories[*room.CategoryID].RoomIDs[0]
+}
+
+// reserveCategories makes sure every category still has a free room for each
+// of its booking rooms, not counting the rooms excludeBookingID is already
+// waiting for. The category locks are held until tx ends, so two bookings
+// cannot both count the last room.
+func (s *Service) reserveCategories(
+	ctx context.Context,
+	tx pgx.Tx,
+	rooms []*models.CreateBookingRoom,
+	categories map[uuid.UUID]*models.RoomCategory,
+	stay models.DateRange,
+	excludeBookingID *uuid.UUID,
+) error {
+	requested := make(map[uuid.UUID]uint32, len(categories))
+	for _, room := range rooms {
+		if room.CategoryID != nil {
+			requested[*room.CategoryID]++
+		}
+	}
+
+	if err := s.lockCategories(ctx, tx, requested); err != nil {
+		return err
+	}
+
+	for categoryID, count := range requested {
+		availability, err := s.categoryAvailability(ctx, tx, categories[categoryID], stay, excludeBookingID)
+		if err != nil {
+			return err
+		}
+		if availability.AvailableRooms < count {
+			return consts.ErrRoomCategoryUnavailable
+		}
+	}
+
+	return nil
+}
+
+// lockCategories takes the category locks in id order to avoid deadlocks
+// between bookings of several categories.
+func (s *Service) lockCategories(ctx context.Context, tx pgx.Tx, categories map[uuid.UUID]uint32) error {
+	ids := make([]uuid.UUID, 0, len(categories))
+	for id := range categories {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+
+	for _, id := range ids {
+		if err := s.repo.LockRoomCategory(ctx, tx, id); err != nil {
+			slog.ErrorContext(ctx, "failed to lock room category", "err", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// categoryAvailability counts the category rooms free for the whole stay,
+// minus the rooms already promised to bookings other than excludeBookingID
+// waiting for an assignment.
+func (s *Service) categoryAvailability(
+	ctx context.Context,
+	tx pgx.Tx,
+	category *models.RoomCategory,
+	stay models.DateRange,
+	excludeBookingID *uuid.UUID,
+) (*models.CategoryAvailability, error) {
+	availability := &models.CategoryAvailability{
+		CategoryID: category.ID,
+		TotalRooms: uint32(len(category.RoomIDs)),
+	}
+	if len(category.RoomIDs) == 0 {
+		return availability, nil
+	}
+
+	occupied, err := s.repo.GetOccupiedRoomIDs(ctx, tx, category.RoomIDs, stay)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get occupied rooms", "err", err)
+		return nil, err
+	}
+
+	unassigned, err := s.repo.CountUnassignedCategoryRooms(ctx, tx, category.ID, stay, excludeBookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count unassigned category rooms", "err", err)
+		return nil, err
+	}
+
+	taken := uint32(len(occupied)) + unassigned
+	if taken < availability.TotalRooms {
+		availability.AvailableRooms = availability.TotalRooms - taken
+	}
+
+	return availability, nil
+}
+
+// assignCategoryRooms gives every unassigned category room of the booking the
+// first free room of its category and locks it for the stay.
+func (s *Service) assignCategoryRooms(ctx context.Context, tx pgx.Tx, booking *models.Booking) error {
+	unassigned, err := s.repo.GetUnassignedBookingRooms(ctx, tx, booking.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get unassigned booking rooms", "err", err)
+		return err
+	}
+	if len(unassigned) == 0 {
+		return nil
+	}
+
+	categories := make(map[uuid.UUID]*models.RoomCategory)
+	requested := make(map[uuid.UUID]uint32)
+	for _, bRoom := range unassigned {
+		requested[bRoom.CategoryID]++
+		if _, exists := categories[bRoom.CategoryID]; exists {
+			continue
+		}
+		if categories[bRoom.CategoryID], err = s.hotel.GetRoomCategory(ctx, bRoom.CategoryID); err != nil {
+			slog.ErrorContext(ctx, "failed to get room category", "err", err)
+			return err
+		}
+	}
+
+	if err = s.lockCategories(ctx, tx, requested); err != nil {
+		return err
+	}
+
+	stay := models.DateRange{Start: booking.CheckIn, End: booking.CheckOut}
+	for _, bRoom := range unassigned {
+		category := categories[bRoom.CategoryID]
+		occupied, err := s.repo.GetOccupiedRoomIDs(ctx, tx, category.RoomIDs, stay)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get occupied rooms", "err", err)
+			return err
+		}
+
+		idx := slices.IndexFunc(category.RoomIDs, func(id uuid.UUID) bool { return !occupied[id] })
+		if idx < 0 {
+			return consts.ErrRoomCategoryUnavailable
+		}
+
+		if _, err = s.assignRoom(ctx, tx, booking, bRoom.ID, category.RoomIDs[idx]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assignRoom points a booking room without a lock at roomID and locks the room
+// for the stay.
+func (s *Service) assignRoom(
+	ctx context.Context,
+	tx pgx.Tx,
+	booking *models.Booking,
+	bookingRoomID uuid.UUID,
+	roomID uuid.UUID,
+) (*models.RoomLockShort, error) {
+	if err := s.repo.AssignBookingRoom(ctx, tx, bookingRoomID, roomID); err != nil {
+		slog.ErrorContext(ctx, "failed to assign booking room", "err", err)
+		return nil, err
+	}
+
+	return s.lockRoom(ctx, tx, booking, roomID)
+}
+
+// lockRoom locks roomID for the stay of the booking; confirmed bookings keep
+// the lock until check-out.
+func (s *Service) lockRoom(
+	ctx context.Context,
+	tx pgx.Tx,
+	booking *models.Booking,
+	roomID uuid.UUID,
+) (*models.RoomLockShort, error) {
+	expiresAt := booking.CheckOut
+	if booking.Status == models.BookingStatusPending {
+		expiresAt = time.Now().Add(consts.ExpireRoomLockMinutes * time.Minute)
+	}
+
+	locks, err := s.repo.CreateRoomLocks(ctx, tx, []*models.CreateRoomLock{{
+		RoomID:    roomID,
+		BookingID: booking.ID,
+		StayRange: models.DateRange{Start: booking.CheckIn, End: booking.CheckOut},
+		ExpiresAt: expiresAt,
+	}})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create room locks", "err", err)
+		return nil, err
+	}
+
+	return roomLockShort(locks[0]), nil
+}
+
+// reassignableStatuses are the booking statuses whose rooms can still be changed.
+var reassignableStatuses = []models.BookingStatus{
+	models.BookingStatusPending,
+	models.BookingStatusConfirmed,
+	models.BookingStatusCheckedIn,
+}
+
+// ReassignBookingRoom moves a booking room to another room of the same hotel
+// and, for category bookings, of the same category. Moving a checked-in guest
+// also updates both rooms in the hotel service.
+func (s *Service) ReassignBookingRoom(
+	ctx context.Context,
+	bookingRoomID uuid.UUID,
+	roomID uuid.UUID,
+) (*models.BookingRoomWithLock, error) {
+	bRoom, err := s.repo.GetBookingRoomByID(ctx, nil, bookingRoomID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking room by id", "err", err)
+		return nil, err
+	}
+	if bRoom.RoomID != nil && *bRoom.RoomID == roomID {
+		return bRoom, nil
+	}
+
+	booking, err := s.repo.GetBookingByID(ctx, nil, bRoom.BookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return nil, err
+	}
+
+	room, err := s.hotel.GetRoom(ctx, roomID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get room", "err", err)
+		return nil, err
+	}
+	if room.HotelID != booking.HotelID {
+		return nil, consts.ErrRoomCategoryMismatch
+	}
+	if bRoom.CategoryID != nil && (room.CategoryID == nil || *room.CategoryID != *bRoom.CategoryID) {
+		return nil, consts.ErrRoomCategoryMismatch
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if booking, err = s.repo.GetBookingByIDForUpdate(ctx, tx, booking.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return nil, err
+	}
+	if !slices.Contains(reassignableStatuses, booking.Status) {
+		return nil, consts.ErrInvalidBookingStatus
+	}
+	// Reread the booking room now that its booking is locked.
+	if bRoom, err = s.repo.GetBookingRoomByID(ctx, tx, bookingRoomID); err != nil {
+		slog.ErrorContext(ctx, "failed to get booking room by id", "err", err)
+		return nil, err
+	}
+
+	if bRoom.CategoryID != nil {
+		if err = s.repo.LockRoomCategory(ctx, tx, *bRoom.CategoryID); err != nil {
+			slog.ErrorContext(ctx, "failed to lock room category", "err", err)
+			return nil, err
+		}
+	}
+
+	stay := models.DateRange{Start: booking.CheckIn, End: booking.CheckOut}
+	occupied, err := s.repo.GetOccupiedRoomIDs(ctx, tx, []uuid.UUID{roomID}, stay)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get occupied rooms", "err", err)
+		return nil, err
+	}
+	if occupied[roomID] {
+		return nil, consts.ErrRoomLockAlreadyExist
+	}
+
+	previousRoomID := bRoom.RoomID
+	var lock *models.RoomLockShort
+	if bRoom.RoomLock != nil {
+		if err = s.repo.AssignBookingRoom(ctx, tx, bRoom.ID, roomID); err != nil {
+			slog.ErrorContext(ctx, "failed to assign booking room", "err", err)
+			return nil, err
+		}
+		lock, err = s.repo.MoveRoomLock(ctx, tx, booking.ID, *bRoom.RoomID, roomID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to move room lock", "err", err)
+			return nil, err
+		}
+	} else if lock, err = s.assignRoom(ctx, tx, booking, bRoom.ID, roomID); err != nil {
+		return nil, err
+	}
+
+	if err = s.recordBookingModified(ctx, tx, booking.ID); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return nil, err
+	}
+
+	// A guest already in the house moves rooms: the new one is occupied and the
+	// one they left needs cleaning.
+	if booking.Status == models.BookingStatusCheckedIn {
+		s.updateRoomStatus(ctx, []uuid.UUID{roomID}, models.RoomStatusOccupied)
+		if previousRoomID != nil {
+			s.updateRoomStatus(ctx, []uuid.UUID{*previousRoomID}, models.RoomStatusCleaning)
+		}
+	}
+
+	bRoom.RoomID = &roomID
+	bRoom.RoomLock = lock
+	return bRoom, nil
+}
+
+func roomLockShort(lock *models.RoomLockDetail) *models.RoomLockShort {
+	return &models.RoomLockShort{
+		ID:        lock.ID,
+		ISActive:  lock.ISActive,
+		ExpiresAt: lock.ExpiresAt,
+		CreatedAt: lock.CreatedAt,
+	}
+}
@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+// newCategory adds a category of size free rooms to the hotel.
+func (f *fixture) newCategory(hotelID uuid.UUID, size int) *models.RoomCategory {
+	category := &models.RoomCategory{ID: uuid.New(), HotelID: hotelID}
+	for range size {
+		roomID := f.newRoom(hotelID)
+		f.rooms[roomID].CategoryID = &category.ID
+		category.RoomIDs = append(category.RoomIDs, roomID)
+	}
+
+	return category
+}
+
+// addCategoryRoom books a room of category for the booking without assigning
+// one and returns the booking room.
+func addCategoryRoom(booking *models.Booking, category *models.RoomCategory) *models.BookingRoomWithLock {
+	bRoom := &models.BookingRoomWithLock{ID: uuid.New(), BookingID: booking.ID, CategoryID: &category.ID}
+	booking.BookingRooms = append(booking.BookingRooms, bRoom)
+
+	return bRoom
+}
+
+func TestResolveCategories(t *testing.T) {
+	hotelID := uuid.New()
+	roomID := uuid.New()
+
+	tests := []struct {
+		name     string
+		category *models.RoomCategory
+		wantErr  error
+	}{
+		{
+			name:     "category of the hotel",
+			category: &models.RoomCategory{ID: uuid.New(), HotelID: hotelID, RoomIDs: []uuid.UUID{roomID}},
+		},
+		{
+			name:     "category of another hotel",
+			category: &models.RoomCategory{ID: uuid.New(), HotelID: uuid.New(), RoomIDs: []uuid.UUID{roomID}},
+			wantErr:  consts.ErrRoomCategoryMismatch,
+		},
+		{
+			name:     "category without rooms",
+			category: &models.RoomCategory{ID: uuid.New(), HotelID: hotelID},
+			wantErr:  consts.ErrRoomCategoryUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			// Two rooms of one category load it once; a room booked by id
+			// needs no category.
+			f.hotel.EXPECT().GetRoomCategory(mock.Anything, tt.category.ID).Return(tt.category, nil).Once()
+
+			rooms := []*models.CreateBookingRoom{
+				{CategoryID: &tt.category.ID},
+				{CategoryID: &tt.category.ID},
+				{RoomID: &roomID},
+			}
+			categories, err := f.service().resolveCategories(context.Background(), hotelID, rooms)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("resolveCategories() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (len(categories) != 1 || categories[tt.category.ID] != tt.category) {
+				t.Errorf("categories = %v, want only %s", categories, tt.category.ID)
+			}
+		})
+	}
+}
+
+func TestReserveCategories(t *testing.T) {
+	tests := []struct {
+		name       string
+		requested  int
+		occupied   int
+		unassigned uint32
+		exclude    bool
+		wantErr    error
+	}{
+		{name: "every room free", requested: 3},
+		{name: "room taken by a lock", requested: 3, occupied: 1, wantErr: consts.ErrRoomCategoryUnavailable},
+		{
+			name:       "room promised to another booking",
+			requested:  3,
+			unassigned: 1,
+			wantErr:    consts.ErrRoomCategoryUnavailable,
+		},
+		{name: "more rooms than the category has", requested: 4, wantErr: consts.ErrRoomCategoryUnavailable},
+		{name: "the modified booking's own rooms", requested: 3, exclude: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := newBooking(models.BookingStatusConfirmed)
+			f := newFixture(t, booking)
+			category := f.newCategory(booking.HotelID, 3)
+			stay := models.DateRange{Start: booking.CheckIn, End: booking.CheckOut}
+
+			var excludeBookingID *uuid.UUID
+			if tt.exclude {
+				excludeBookingID = &booking.ID
+			}
+			occupied := make(map[uuid.UUID]bool)
+			for _, roomID := range category.RoomIDs[:tt.occupied] {
+				occupied[roomID] = true
+			}
+
+			repo := f.repo.EXPECT()
+			repo.LockRoomCategory(mock.Anything, mock.Anything, category.ID).Return(nil).Once()
+			repo.GetOccupiedRoomIDs(mock.Anything, mock.Anything, category.RoomIDs, stay).Return(occupied, nil)
+			repo.CountUnassignedCategoryRooms(mock.Anything, mock.Anything, category.ID, stay, excludeBookingID).
+				Return(tt.unassigned, nil)
+
+			rooms := make([]*models.CreateBookingRoom, tt.requested)
+			for i := range rooms {
+				rooms[i] = &models.CreateBookingRoom{CategoryID: &category.ID}
+			}
+			categories := map[uuid.UUID]*models.RoomCategory{category.ID: category}
+			err := f.service().reserveCategories(context.Background(), nil, rooms, categories, stay, excludeBookingID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("reserveCategories() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCategoryAvailability(t *testing.T) {
+	booking := newBooking(models.BookingStatusConfirmed)
+	f := newFixture(t, booking)
+	category := f.newCategory(booking.HotelID, 3)
+	stay := models.DateRange{Start: booking.CheckIn, End: booking.CheckOut}
+
+	// More rooms are taken than the category has left, say after a room was
+	// moved out of it: availability bottoms out at zero.
+	repo := f.repo.EXPECT()
+	repo.GetOccupiedRoomIDs(mock.Anything, mock.Anything, category.RoomIDs, stay).
+		Return(map[uuid.UUID]bool{category.RoomIDs[0]: true, category.RoomIDs[1]: true}, nil)
+	repo.CountUnassignedCategoryRooms(mock.Anything, mock.Anything, category.ID, stay, (*uuid.UUID)(nil)).
+		Return(2, nil)
+
+	got, err := f.service().categoryAvailability(context.Background(), nil, category, stay, nil)
+	if err != nil {
+		t.Fatalf("categoryAvailability() error = %v", err)
+	}
+	want := models.CategoryAvailability{CategoryID: category.ID, TotalRooms: 3}
+	if *got != want {
+		t.Errorf("availability = %+v, want %+v", *got, want)
+	}
+}
+
+func TestCheckInAssignsCategoryRoom(t *testing.T) {
+	tests := []struct {
+		name     string
+		occupied int
+		wantErr  error
+	}{
+		{name: "first free room", occupied: 1},
+		{name: "category full", occupied: 2, wantErr: consts.ErrRoomCategoryUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := newBooking(models.BookingStatusConfirmed)
+			f := newFixture(t, booking)
+			category := f.newCategory(booking.HotelID, 2)
+			// The category room was added after the booking was confirmed.
+			bRoom := addCategoryRoom(booking, category)
+			stay := models.DateRange{Start: booking.CheckIn, End: booking.CheckOut}
+
+			occupied := make(map[uuid.UUID]bool)
+			for _, roomID := range category.RoomIDs[:tt.occupied] {
+				occupied[roomID] = true
+			}
+
+			repo := f.repo.EXPECT()
+			repo.GetUnassignedBookingRooms(mock.Anything, mock.Anything, booking.ID).
+				Return([]models.UnassignedBookingRoom{{ID: bRoom.ID, CategoryID: category.ID}}, nil)
+			repo.LockRoomCategory(mock.Anything, mock.Anything, category.ID).Return(nil)
+			repo.GetOccupiedRoomIDs(mock.Anything, mock.Anything, category.RoomIDs, stay).Return(occupied, nil)
+			f.hotel.EXPECT().GetRoomCategory(mock.Anything, category.ID).Return(category, nil)
+
+			var want []roomStatusUpdate
+			if tt.wantErr == nil {
+				free := category.RoomIDs[tt.occupied]
+				repo.AssignBookingRoom(mock.Anything, mock.Anything, bRoom.ID, free).RunAndReturn(f.assignBookingRoom)
+				repo.CreateRoomLocks(mock.Anything, mock.Anything, mock.MatchedBy(func(locks []*models.CreateRoomLock) bool {
+					return len(locks) == 1 && locks[0].RoomID == free && locks[0].ExpiresAt.Equal(booking.CheckOut)
+				})).Return([]*models.RoomLockDetail{{ID: uuid.New(), RoomID: free, ISActive: true}}, nil)
+				want = []roomStatusUpdate{{status: models.RoomStatusOccupied, roomID: free, committed: true}}
+			}
+
+			change := &models.BookingStatusChange{To: models.BookingStatusCheckedIn}
+			_, err := f.service().CheckIn(context.Background(), booking.ID, change, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckIn() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil && f.commits != 0 {
+				t.Errorf("commits = %d, want none", f.commits)
+			}
+			if !slices.Equal(f.statuses, want) {
+				t.Errorf("room statuses = %+v, want %+v", f.statuses, want)
+			}
+		})
+	}
+}
+
+func TestReassignBookingRoomConflict(t *testing.T) {
+	tests := []struct {
+		name     string
+		occupied bool
+		moveErr  error
+		wantErr  error
+	}{
+		{
+			name:     "room held by another booking or block",
+			occupied: true,
+			wantErr:  consts.ErrRoomLockAlreadyExist,
+		},
+		{
+			// Another booking locked the room after the occupancy check.
+			name:    "lock taken meanwhile",
+			moveErr: consts.ErrRoomLockAlreadyExist,
+			wantErr: consts.ErrRoomLockAlreadyExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := newBooking(models.BookingStatusCheckedIn)
+			f := newFixture(t, booking)
+			oldRoomID := f.addRoom(booking)
+			newRoomID := f.newRoom(booking.HotelID)
+			bRoom := booking.BookingRooms[0]
+
+			repo := f.repo.EXPECT()
+			repo.GetOccupiedRoomIDs(mock.Anything, mock.Anything, []uuid.UUID{newRoomID}, mock.Anything).
+				Return(map[uuid.UUID]bool{newRoomID: tt.occupied}, nil)
+			if !tt.occupied {
+				repo.AssignBookingRoom(mock.Anything, mock.Anything, bRoom.ID, newRoomID).Return(nil)
+				repo.MoveRoomLock(mock.Anything, mock.Anything, booking.ID, oldRoomID, newRoomID).Return(nil, tt.moveErr)
+			}
+
+			_, err := f.service().ReassignBookingRoom(context.Background(), bRoom.ID, newRoomID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReassignBookingRoom() error = %v, want %v", err, tt.wantErr)
+			}
+			if f.commits != 0 {
+				t.Errorf("commits = %d, want none", f.commits)
+			}
+			if len(f.statuses) != 0 {
+				t.Errorf("room statuses = %+v, want none", f.statuses)
+			}
+		})
+	}
+}
+
+func TestReassignBookingRoomCategory(t *testing.T) {
+	booking := newBooking(models.BookingStatusCheckedIn)
+	f := newFixture(t, booking)
+	category := f.newCategory(booking.HotelID, 1)
+	bRoom := addCategoryRoom(booking, category)
+	other := f.newCategory(booking.HotelID, 1)
+
+	_, err := f.service().ReassignBookingRoom(context.Background(), bRoom.ID, other.RoomIDs[0])
+	if !errors.Is(err, consts.ErrRoomCategoryMismatch) {
+		t.Errorf("ReassignBookingRoom() error = %v, want %v", err, consts.ErrRoomCategoryMismatch)
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+func TestExpireHolds(t *testing.T) {
+	lapsed := newBooking(models.BookingStatusPending)
+	unlocked := newBooking(models.BookingStatusPending)
+	extended := newBooking(models.BookingStatusPending)
+	paid := newBooking(models.BookingStatusConfirmed)
+
+	f := newFixture(t, lapsed, unlocked, extended, paid)
+	f.holds[lapsed.ID] = time.Now().Add(-time.Minute)
+	f.holds[extended.ID] = time.Now().Add(time.Minute)
+	// Listed by the query but paid, or re-held, before the job got to them.
+	f.repo.EXPECT().GetExpiredHoldBookingIDs(mock.Anything, mock.Anything, mock.Anything, 10).
+		Return([]uuid.UUID{lapsed.ID, unlocked.ID, extended.ID, paid.ID}, nil)
+
+	expired, err := f.service().ExpireHolds(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("ExpireHolds() error = %v", err)
+	}
+	if expired != 2 {
+		t.Errorf("expired = %d, want 2", expired)
+	}
+
+	want := map[uuid.UUID]models.BookingStatus{
+		lapsed.ID:   models.BookingStatusExpired,
+		unlocked.ID: models.BookingStatusExpired,
+		extended.ID: models.BookingStatusPending,
+		paid.ID:     models.BookingStatusConfirmed,
+	}
+	for id, status := range want {
+		if got := f.bookings[id].Status; got != status {
+			t.Errorf("booking %s status = %s, want %s", id, got, status)
+		}
+	}
+	for _, id := range []uuid.UUID{lapsed.ID, unlocked.ID} {
+		if lock := f.locks[id]; lock == nil || lock.IsActive {
+			t.Errorf("booking %s locks = %+v, want released", id, lock)
+		}
+	}
+	if len(f.history) != 2 {
+		t.Errorf("history entries = %d, want 2", len(f.history))
+	}
+}
+
+func TestCreateBookingWithCode(t *testing.T) {
+	errInsert := errors.New("insert failed")
+
+	tests := []struct {
+		name      string
+		results   []error
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "free code", results: []error{nil}, wantCalls: 1},
+		{
+			name:      "taken code is drawn again",
+			results:   []error{consts.ErrBookingCodeTaken, consts.ErrBookingCodeTaken, nil},
+			wantCalls: 3,
+		},
+		{
+			name:      "every attempt taken",
+			results:   []error{consts.ErrBookingCodeTaken},
+			wantErr:   consts.ErrBookingCodeTaken,
+			wantCalls: consts.BookingCodeAttempts,
+		},
+		{name: "other error", results: []error{errInsert}, wantErr: errInsert, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+
+			// ON CONFLICT DO NOTHING leaves a taken code unwritten; the
+			// repository reports it as ErrBookingCodeTaken.
+			var codes []string
+			f.repo.EXPECT().CreateBooking(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+				func(_ context.Context, _ pgx.Tx, b *models.CreateBooking) (*models.Booking, error) {
+					codes = append(codes, b.Code)
+					if err := tt.results[min(len(codes), len(tt.results))-1]; err != nil {
+						return nil, err
+					}
+					return &models.Booking{ID: uuid.New(), Code: b.Code}, nil
+				},
+			)
+
+			booking, err := f.service().createBookingWithCode(context.Background(), nil, &models.CreateBooking{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("createBookingWithCode() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(codes) != tt.wantCalls {
+				t.Fatalf("inserts = %d, want %d", len(codes), tt.wantCalls)
+			}
+			for i := 1; i < len(codes); i++ {
+				if codes[i] == codes[i-1] {
+					t.Errorf("attempt %d reused code %q", i+1, codes[i])
+				}
+			}
+			if tt.wantErr == nil && booking.Code != codes[len(codes)-1] {
+				t.Errorf("booking code = %q, want the last one drawn %q", booking.Code, codes[len(codes)-1])
+			}
+		})
+	}
+}
+
+func TestFindBookingByCode(t *testing.T) {
+	email := "ivan@example.com"
+	check := models.BookingGuestCheck{GuestEmail: &email}
+
+	tests := []struct {
+		name    string
+		found   bool
+		wantErr error
+	}{
+		{name: "found", found: true},
+		{name: "unknown code or guest", wantErr: consts.ErrBookingNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := newBooking(models.BookingStatusConfirmed)
+			booking.Code = "7K3M0QXA"
+			f := newFixture(t, booking)
+			f.addRoom(booking)
+
+			// The guest typed the code in lower case, with a dash and an O for
+			// the zero.
+			lookup := f.repo.EXPECT().GetBookingByCode(mock.Anything, mock.Anything, "7K3M0QXA", check)
+			if tt.found {
+				lookup.RunAndReturn(
+					func(ctx context.Context, tx pgx.Tx, _ string, _ models.BookingGuestCheck) (*models.Booking, error) {
+						return f.getBooking(ctx, tx, booking.ID)
+					},
+				)
+			} else {
+				lookup.Return(nil, consts.ErrBookingNotFound)
+			}
+
+			got, err := f.service().FindBookingByCode(context.Background(), "7k3m-oqxa", check)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindBookingByCode() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.ID != booking.ID || len(got.BookingRooms) != 1 {
+				t.Errorf("booking = %s with %d rooms, want %s with 1", got.ID, len(got.BookingRooms), booking.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+
+	"booking/internal/repository/models"
+	"booking/internal/service/utils/helper"
+	"booking/internal/utils/consts"
+)
+
+// refundablePayment is a succeeded payment with the part of it that has not
+// been refunded yet.
+type refundablePayment struct {
+	payment    *models.Payment
+	refundable decimal.Decimal
+}
+
+// PreviewCancellation quotes what cancelling the booking now would cost the
+// guest without cancelling it.
+func (s *Service) PreviewCancellation(ctx context.Context, bookingID uuid.UUID) (*models.BookingCancellation, error) {
+	booking, err := s.GetBookingById(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if err = helper.CheckBookingTransition(booking.Status, models.BookingStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	quote, _, err := s.quoteCancellation(ctx, nil, booking, time.Now(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+// CancelBooking cancels the booking under its cancellation policy: the fee is
+// recorded with the booking and whatever the guest paid above it is refunded
+// through the payment provider once the cancellation is committed.
+func (s *Service) CancelBooking(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	change *models.BookingStatusChange,
+	expectedVersion *int64,
+) (*models.BookingCancellation, int64, error) {
+	if change == nil {
+		return nil, 0, consts.ErrNilObject
+	}
+
+	return s.cancelBooking(ctx, bookingID, change, expectedVersion, false)
+}
+
+// CancelActiveBookings cancels the pending and confirmed bookings of a hotel or
+// room that is being deleted and returns their ids. The hotel calls the stay
+// off, so every booking is cancelled like CancelBooking but without a fee: the
+// guest gets back everything they paid.
+func (s *Service) CancelActiveBookings(ctx context.Context, target models.ActiveBookingTarget) ([]uuid.UUID, error) {
+	ids, err := s.repo.GetActiveBookingIDs(ctx, nil, target)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get active bookings", "err", err)
+		return nil, err
+	}
+
+	reason := consts.ReasonTargetDeleted
+	cancelled := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		change := &models.BookingStatusChange{Reason: &reason, To: models.BookingStatusCancelled}
+		if _, _, err = s.cancelBooking(ctx, id, change, nil, true); err != nil {
+			// The booking was cancelled or checked in meanwhile.
+			if errors.Is(err, consts.ErrInvalidBookingTransition) {
+				continue
+			}
+			return nil, err
+		}
+		cancelled = append(cancelled, id)
+	}
+
+	return cancelled, nil
+}
+
+// cancelBooking cancels the booking, charging the fee of its policy unless
+// waiveFee is set, and refunds the rest of what was paid once committed.
+func (s *Service) cancelBooking(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	change *models.BookingStatusChange,
+	expectedVersion *int64,
+	waiveFee bool,
+) (*models.BookingCancellation, int64, error) {
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return nil, 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return nil, 0, err
+	}
+	if expectedVersion != nil && *expectedVersion != booking.Version {
+		return nil, 0, consts.ErrVersionMismatch
+	}
+
+	booking.BookingRooms, err = s.repo.GetBookingRoomsWithLockByBookingIDs(ctx, tx, []uuid.UUID{booking.ID})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking rooms by booking id", "err", err)
+		return nil, 0, err
+	}
+
+	version, err := s.transitionBooking(ctx, tx, booking, change)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	quote, payments, err := s.quoteCancellation(ctx, tx, booking, time.Now(), waiveFee)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cancellation, err := s.repo.CreateBookingCancellation(ctx, tx, quote)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create booking cancellation", "err", err)
+		return nil, 0, err
+	}
+
+	reason := consts.ReasonBookingCancelled
+	refunds := make([]*models.Refund, 0, len(payments))
+	due := cancellation.RefundAmount
+	for _, p := range payments {
+		if !due.IsPositive() {
+			break
+		}
+		amount := decimal.Min(due, p.refundable)
+		_, refund, err := s.reserveRefund(ctx, tx, p.payment.ID, amount, &reason)
+		if err != nil {
+			return nil, 0, err
+		}
+		refunds = append(refunds, refund)
+		due = due.Sub(amount)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return nil, 0, err
+	}
+
+	// The booking stays cancelled when the provider rejects a refund; the
+	// failed refund is kept so it can be issued again.
+	for i, refund := range refunds {
+		if err = s.requestRefund(ctx, payments[i].payment, refund); err != nil {
+			slog.ErrorContext(ctx, "failed to refund cancelled booking", "booking_id", bookingID, "err", err)
+		}
+	}
+
+	return cancellation, version, nil
+}
+
+// quoteCancellation prices cancelling the booking at now, for free when
+// waiveFee is set, and lists the payments the refund is taken from. The paid
+// amount leaves out what has already been refunded.
+func (s *Service) quoteCancellation(
+	ctx context.Context,
+	tx pgx.Tx,
+	booking *models.Booking,
+	now time.Time,
+	waiveFee bool,
+) (*models.BookingCancellation, []refundablePayment, error) {
+	penalty, fee := models.CancellationPenaltyNone, decimal.Zero
+	if !waiveFee {
+		var err error
+		if penalty, fee, err = s.cancellationFee(ctx, booking, now); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	payments, err := s.repo.GetPaymentsByBookingID(ctx, tx, booking.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get payments by booking id", "err", err)
+		return nil, nil, err
+	}
+
+	paid := decimal.Zero
+	refundable := make([]refundablePayment, 0, len(payments))
+	for _, payment := range payments {
+		if payment.Status != models.PaymentStatusSucceeded {
+			continue
+		}
+
+		refunded, err := s.repo.SumReservedRefunds(ctx, tx, payment.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to sum refunds", "err", err)
+			return nil, nil, err
+		}
+
+		left := payment.Amount.Sub(refunded)
+		if left.IsPositive() {
+			refundable = append(refundable, refundablePayment{payment: payment, refundable: left})
+			paid = paid.Add(left)
+		}
+	}
+
+	retained := decimal.Min(fee, paid)
+	return &models.BookingCancellation{
+		CreatedAt:      now,
+		Penalty:        penalty,
+		Currency:       booking.Currency,
+		Fee:            fee,
+		PaidAmount:     paid,
+		RetainedAmount: retained,
+		RefundAmount:   paid.Sub(retained),
+		BookingID:      booking.ID,
+	}, refundable, nil
+}
+
+// cancellationFee prices cancelling the booking at now under its policy.
+func (s *Service) cancellationFee(
+	ctx context.Context,
+	booking *models.Booking,
+	now time.Time,
+) (models.CancellationPenalty, decimal.Decimal, error) {
+	policy, err := s.bookingPolicy(ctx, booking)
+	if err != nil {
+		return "", decimal.Zero, err
+	}
+
+	loc, err := time.LoadLocation(policy.Timezone)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load hotel timezone", "err", err)
+		return "", decimal.Zero, err
+	}
+
+	checkInAt, err := helper.CheckInAt(booking.CheckIn, policy.CheckInTime, loc)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to parse hotel check-in time", "err", err)
+		return "", decimal.Zero, err
+	}
+
+	penalty, fee := helper.CancellationFee(
+		policy.CancellationTiers,
+		checkInAt.Sub(now).Hours(),
+		booking.BookingRooms,
+		booking.FinalTotalAmount,
+	)
+
+	return penalty, fee, nil
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+
+	"booking/internal/repository/models"
+)
+
+func TestCancelActiveBookings(t *testing.T) {
+	// Cancelling this late would forfeit the whole stay under the policy.
+	strict := &models.PolicySnapshot{
+		Timezone:    "UTC",
+		CheckInTime: "14:00",
+		CancellationTiers: []models.CancellationTier{
+			{HoursBeforeCheckIn: 720, Penalty: models.CancellationPenaltyNone},
+		},
+	}
+	paid := newBooking(models.BookingStatusConfirmed)
+	paid.Policy = strict
+	paid.FinalTotalAmount = decimal.RequireFromString("300.00")
+	unpaid := newBooking(models.BookingStatusPending)
+	unpaid.Policy = strict
+	arrived := newBooking(models.BookingStatusCheckedIn)
+
+	hotelID := paid.HotelID
+	target := models.ActiveBookingTarget{HotelID: &hotelID}
+	f := newFixture(t, paid, unpaid, arrived)
+	// The guest checked in after the bookings were listed.
+	f.repo.EXPECT().GetActiveBookingIDs(mock.Anything, mock.Anything, target).
+		Return([]uuid.UUID{paid.ID, unpaid.ID, arrived.ID}, nil)
+	payment := f.addPayment(paid, "300.00", models.PaymentStatusSucceeded)
+	f.expectRefund(payment)
+
+	cancelled, err := f.service().CancelActiveBookings(context.Background(), target)
+	if err != nil {
+		t.Fatalf("CancelActiveBookings() error = %v", err)
+	}
+	if want := []uuid.UUID{paid.ID, unpaid.ID}; !slices.Equal(cancelled, want) {
+		t.Errorf("cancelled = %v, want %v", cancelled, want)
+	}
+
+	for id, want := range map[uuid.UUID]models.BookingStatus{
+		paid.ID:    models.BookingStatusCancelled,
+		unpaid.ID:  models.BookingStatusCancelled,
+		arrived.ID: models.BookingStatusCheckedIn,
+	} {
+		if got := f.bookings[id].Status; got != want {
+			t.Errorf("booking %s status = %s, want %s", id, got, want)
+		}
+	}
+
+	if len(f.cancels) != 2 {
+		t.Fatalf("cancellation records = %d, want 2", len(f.cancels))
+	}
+	for _, c := range f.cancels {
+		if !c.Fee.IsZero() || c.Penalty != models.CancellationPenaltyNone {
+			t.Errorf("booking %s charged %s (%s), want no fee", c.BookingID, c.Fee, c.Penalty)
+		}
+	}
+	if len(f.events) != 2 {
+		t.Errorf("outbox events = %d, want one per cancelled booking", len(f.events))
+	}
+}
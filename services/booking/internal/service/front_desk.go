@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"booking/internal/repository/models"
+	"booking/internal/service/utils/helper"
+	"booking/internal/utils/consts"
+)
+
+// frontDeskAction is a status change hotel staff make at the desk: checkDay
+// tells whether it is allowed on the current day at the hotel and roomStatus,
+// when set, is what the booked rooms switch to in the hotel service.
+type frontDeskAction struct {
+	checkDay   func(b *models.Booking, now time.Time, loc *time.Location) error
+	roomStatus models.RoomStatus
+}
+
+var (
+	checkInAction = frontDeskAction{
+		checkDay: func(b *models.Booking, now time.Time, loc *time.Location) error {
+			return helper.CheckCheckInDay(b.CheckIn, b.CheckOut, now, loc)
+		},
+		roomStatus: models.RoomStatusOccupied,
+	}
+	checkOutAction = frontDeskAction{
+		roomStatus: models.RoomStatusCleaning,
+	}
+	noShowAction = frontDeskAction{
+		checkDay: func(b *models.Booking, now time.Time, loc *time.Location) error {
+			return helper.CheckNoShowDay(b.CheckIn, now, loc)
+		},
+	}
+)
+
+// CheckIn records the arrival of the guest of a confirmed booking, from the
+// check-in day until check-out, and marks the booked rooms occupied.
+func (s *Service) CheckIn(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	change *models.BookingStatusChange,
+	expectedVersion *int64,
+) (int64, error) {
+	return s.frontDeskTransition(ctx, bookingID, change, expectedVersion, checkInAction)
+}
+
+// CheckOut records the departure of a checked-in guest and sends the rooms to
+// cleaning. The room locks are released, so an early check-out frees the
+// remaining nights for new bookings.
+func (s *Service) CheckOut(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	change *models.BookingStatusChange,
+	expectedVersion *int64,
+) (int64, error) {
+	return s.frontDeskTransition(ctx, bookingID, change, expectedVersion, checkOutAction)
+}
+
+// MarkNoShow closes a confirmed booking whose guest did not arrive; it is only
+// allowed once the check-in day is over and releases the rooms.
+func (s *Service) MarkNoShow(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	change *models.BookingStatusChange,
+	expectedVersion *int64,
+) (int64, error) {
+	return s.frontDeskTransition(ctx, bookingID, change, expectedVersion, noShowAction)
+}
+
+// MarkNoShows marks up to batchSize confirmed bookings whose check-in day is
+// over as no-shows on behalf of the system and returns how many were marked.
+func (s *Service) MarkNoShows(ctx context.Context, batchSize int) (int, error) {
+	ids, err := s.repo.GetNoShowBookingIDs(ctx, nil, batchSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get no-show bookings", "err", err)
+		return 0, err
+	}
+
+	var marked int
+	for _, id := range ids {
+		reason := consts.ReasonNoShow
+		change := &models.BookingStatusChange{Reason: &reason, To: models.BookingStatusNoShow}
+		if _, err = s.MarkNoShow(ctx, id, change, nil); err != nil {
+			// The guest may have checked in meanwhile, or the check-in day is not
+			// over yet in the hotel's own timezone.
+			if !errors.Is(err, consts.ErrInvalidBookingTransition) && !errors.Is(err, consts.ErrNoShowTooEarly) {
+				slog.ErrorContext(ctx, "failed to mark booking no-show", "booking_id", id, "err", err)
+			}
+			continue
+		}
+		marked++
+	}
+
+	return marked, nil
+}
+
+// frontDeskTransition moves the booking like UpdateBookingStatus once the day
+// check of action passes. The rooms are updated in the hotel service only once
+// the booking has committed, so the hotel never shows a stay that did not
+// happen.
+func (s *Service) frontDeskTransition(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	change *models.BookingStatusChange,
+	expectedVersion *int64,
+	action frontDeskAction,
+) (int64, error) {
+	if change == nil {
+		return 0, consts.ErrNilObject
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return 0, err
+	}
+	if expectedVersion != nil && *expectedVersion != booking.Version {
+		return 0, consts.ErrVersionMismatch
+	}
+	if err = helper.CheckBookingTransition(booking.Status, change.To); err != nil {
+		return 0, err
+	}
+
+	if action.checkDay != nil {
+		loc, err := s.bookingLocation(ctx, booking)
+		if err != nil {
+			return 0, err
+		}
+		if err = action.checkDay(booking, time.Now(), loc); err != nil {
+			return 0, err
+		}
+	}
+
+	version, err := s.transitionBooking(ctx, tx, booking, change)
+	if err != nil {
+		return 0, err
+	}
+
+	var roomIDs []uuid.UUID
+	if action.roomStatus != "" {
+		if roomIDs, err = s.bookingRoomIDs(ctx, tx, booking.ID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return 0, err
+	}
+
+	s.updateRoomStatus(ctx, roomIDs, action.roomStatus)
+
+	return version, nil
+}
+
+// bookingRoomIDs returns the rooms assigned to the booking.
+func (s *Service) bookingRoomIDs(ctx context.Context, tx pgx.Tx, bookingID uuid.UUID) ([]uuid.UUID, error) {
+	rooms, err := s.repo.GetBookingRoomsByBookingIDs(ctx, tx, []uuid.UUID{bookingID})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking rooms", "err", err)
+		return nil, err
+	}
+
+	var roomIDs []uuid.UUID
+	for _, room := range rooms {
+		if room.RoomID != nil {
+			roomIDs = append(roomIDs, *room.RoomID)
+		}
+	}
+
+	return roomIDs, nil
+}
+
+// updateRoomStatus sets the status of the rooms in the hotel service after the
+// booking change committed. The booking stays as it is when a call fails; the
+// room is logged so staff can set its status by hand.
+func (s *Service) updateRoomStatus(ctx context.Context, roomIDs []uuid.UUID, roomStatus models.RoomStatus) {
+	for _, roomID := range roomIDs {
+		if err := s.hotel.UpdateRoomStatus(ctx, roomID, roomStatus); err != nil {
+			slog.ErrorContext(ctx, "failed to update room status",
+				"room_id", roomID, "status", roomStatus, "err", err)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+var errCommit = errors.New("commit failed")
+
+func TestCheckIn(t *testing.T) {
+	tests := []struct {
+		name       string
+		checkIn    time.Duration
+		commitErr  error
+		wantErr    error
+		wantStatus models.RoomStatus
+	}{
+		{
+			name:       "on the check-in day",
+			wantStatus: models.RoomStatusOccupied,
+		},
+		{
+			name:    "before the check-in day",
+			checkIn: 24 * time.Hour,
+			wantErr: consts.ErrCheckInTooEarly,
+		},
+		{
+			name:      "failed commit leaves the room alone",
+			commitErr: errCommit,
+			wantErr:   errCommit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := newBooking(models.BookingStatusConfirmed)
+			booking.CheckIn = booking.CheckIn.Add(tt.checkIn)
+			booking.CheckOut = booking.CheckOut.Add(tt.checkIn)
+			f := newFixture(t, booking)
+			f.commitErr = tt.commitErr
+			roomID := f.addRoom(booking)
+			f.repo.EXPECT().GetUnassignedBookingRooms(mock.Anything, mock.Anything, booking.ID).Return(nil, nil).Maybe()
+
+			change := &models.BookingStatusChange{To: models.BookingStatusCheckedIn}
+			_, err := f.service().CheckIn(context.Background(), booking.ID, change, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckIn() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var want []roomStatusUpdate
+			if tt.wantStatus != "" {
+				want = []roomStatusUpdate{{status: tt.wantStatus, roomID: roomID, committed: true}}
+			}
+			if !slices.Equal(f.statuses, want) {
+				t.Errorf("room statuses = %+v, want %+v", f.statuses, want)
+			}
+		})
+	}
+}
+
+func TestCheckOut(t *testing.T) {
+	booking := newBooking(models.BookingStatusCheckedIn)
+	f := newFixture(t, booking)
+	roomID := f.addRoom(booking)
+
+	change := &models.BookingStatusChange{To: models.BookingStatusCheckedOut}
+	if _, err := f.service().CheckOut(context.Background(), booking.ID, change, nil); err != nil {
+		t.Fatalf("CheckOut() error = %v", err)
+	}
+
+	if got := f.bookings[booking.ID].Status; got != models.BookingStatusCheckedOut {
+		t.Errorf("status = %s, want %s", got, models.BookingStatusCheckedOut)
+	}
+	if lock := f.locks[booking.ID]; lock == nil || lock.IsActive {
+		t.Errorf("locks = %+v, want released", lock)
+	}
+	want := []roomStatusUpdate{{status: models.RoomStatusCleaning, roomID: roomID, committed: true}}
+	if !slices.Equal(f.statuses, want) {
+		t.Errorf("room statuses = %+v, want %+v", f.statuses, want)
+	}
+}
+
+func TestMarkNoShows(t *testing.T) {
+	missed := newBooking(models.BookingStatusConfirmed)
+	missed.CheckIn = missed.CheckIn.Add(-24 * time.Hour)
+	today := newBooking(models.BookingStatusConfirmed)
+	arrived := newBooking(models.BookingStatusCheckedIn)
+	arrived.CheckIn = arrived.CheckIn.Add(-24 * time.Hour)
+
+	f := newFixture(t, missed, today, arrived)
+	// Listed by the query, but the guest of arrived checked in before the job
+	// got to it and the check-in day of today is not over yet.
+	f.repo.EXPECT().GetNoShowBookingIDs(mock.Anything, mock.Anything, 10).
+		Return([]uuid.UUID{missed.ID, today.ID, arrived.ID}, nil)
+
+	marked, err := f.service().MarkNoShows(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("MarkNoShows() error = %v", err)
+	}
+	if marked != 1 {
+		t.Errorf("marked = %d, want 1", marked)
+	}
+
+	want := map[uuid.UUID]models.BookingStatus{
+		missed.ID:  models.BookingStatusNoShow,
+		today.ID:   models.BookingStatusConfirmed,
+		arrived.ID: models.BookingStatusCheckedIn,
+	}
+	for id, status := range want {
+		if got := f.bookings[id].Status; got != status {
+			t.Errorf("booking %s status = %s, want %s", id, got, status)
+		}
+	}
+	if lock := f.locks[missed.ID]; lock == nil || lock.IsActive {
+		t.Errorf("no-show locks = %+v, want released", lock)
+	}
+	if len(f.statuses) != 0 {
+		t.Errorf("room statuses = %+v, want none", f.statuses)
+	}
+}
+
+func TestReassignBookingRoomCheckedIn(t *testing.T) {
+	tests := []struct {
+		status  models.BookingStatus
+		updates bool
+	}{
+		{status: models.BookingStatusConfirmed},
+		{status: models.BookingStatusCheckedIn, updates: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			booking := newBooking(tt.status)
+			f := newFixture(t, booking)
+			oldRoomID := f.addRoom(booking)
+			newRoomID := f.newRoom(booking.HotelID)
+			bRoom := booking.BookingRooms[0]
+
+			repo := f.repo.EXPECT()
+			repo.GetOccupiedRoomIDs(mock.Anything, mock.Anything, []uuid.UUID{newRoomID}, mock.Anything).
+				Return(map[uuid.UUID]bool{}, nil)
+			repo.AssignBookingRoom(mock.Anything, mock.Anything, bRoom.ID, newRoomID).RunAndReturn(f.assignBookingRoom)
+			repo.MoveRoomLock(mock.Anything, mock.Anything, booking.ID, oldRoomID, newRoomID).
+				Return(&models.RoomLockShort{ISActive: true}, nil)
+
+			if _, err := f.service().ReassignBookingRoom(context.Background(), bRoom.ID, newRoomID); err != nil {
+				t.Fatalf("ReassignBookingRoom() error = %v", err)
+			}
+
+			if got := *bRoom.RoomID; got != newRoomID {
+				t.Errorf("room = %s, want %s", got, newRoomID)
+			}
+			var want []roomStatusUpdate
+			if tt.updates {
+				want = []roomStatusUpdate{
+					{status: models.RoomStatusOccupied, roomID: newRoomID, committed: true},
+					{status: models.RoomStatusCleaning, roomID: oldRoomID, committed: true},
+				}
+			}
+			if !slices.Equal(f.statuses, want) {
+				t.Errorf("room statuses = %+v, want %+v", f.statuses, want)
+			}
+		})
+	}
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 
 	"booking/internal/repository/models"
+	"outbox"
 )
 
 type BookingTransactionRepository interface {
@@ -20,11 +22,31 @@ type BookingRepository interface {
 		ctx context.Context, tx pgx.Tx, bookingRef models.BookingRef, limit uint64, offset uint64,
 	) (*models.BookingList, error)
 	GetBookingByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*models.Booking, error)
-	UpdateBookingGuestInfoByID(ctx context.Context, tx pgx.Tx, id uuid.UUID, b *models.UpdateBooking) error
+	GetBookingByIDForUpdate(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*models.Booking, error)
+	GetBookingByCode(
+		ctx context.Context, tx pgx.Tx, code string, check models.BookingGuestCheck,
+	) (*models.Booking, error)
+	UpdateBookingGuestInfoByID(
+		ctx context.Context, tx pgx.Tx, id uuid.UUID, b *models.UpdateBooking, expectedVersion *int64,
+	) (int64, error)
+	UpdateBookingDates(ctx context.Context, tx pgx.Tx, id uuid.UUID, stay models.DateRange) error
+	RecalculateBookingTotal(ctx context.Context, tx pgx.Tx, id uuid.UUID) (int64, error)
 	UpdateBookingStatusByID(
-		ctx context.Context, tx pgx.Tx, id uuid.UUID, status models.BookingStatus,
-	) (time.Time, error)
+		ctx context.Context, tx pgx.Tx, id uuid.UUID, status models.BookingStatus, expectedVersion *int64,
+	) (time.Time, int64, error)
 	DeleteBookingByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+	GetActiveBookingIDs(ctx context.Context, tx pgx.Tx, target models.ActiveBookingTarget) ([]uuid.UUID, error)
+	GetNoShowBookingIDs(ctx context.Context, tx pgx.Tx, limit int) ([]uuid.UUID, error)
+	GetExpiredHoldBookingIDs(ctx context.Context, tx pgx.Tx, holdMinutes int, limit int) ([]uuid.UUID, error)
+}
+
+type BookingStatusHistoryRepository interface {
+	CreateBookingStatusTransition(
+		ctx context.Context, tx pgx.Tx, t *models.BookingStatusTransition,
+	) (*models.BookingStatusTransition, error)
+	GetBookingStatusHistory(
+		ctx context.Context, tx pgx.Tx, bookingID uuid.UUID,
+	) ([]*models.BookingStatusTransition, error)
 }
 
 type BookingRoomRepository interface {
@@ -37,6 +59,23 @@ type BookingRoomRepository interface {
 	GetBookingRoomsWithLockByBookingIDs(
 		ctx context.Context, tx pgx.Tx, bookingIDs []uuid.UUID,
 	) ([]*models.BookingRoomWithLock, error)
+	GetBookingRoomByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*models.BookingRoomWithLock, error)
+	GetUnassignedBookingRooms(
+		ctx context.Context, tx pgx.Tx, bookingID uuid.UUID,
+	) ([]models.UnassignedBookingRoom, error)
+	AssignBookingRoom(ctx context.Context, tx pgx.Tx, id uuid.UUID, roomID uuid.UUID) error
+	UpdateBookingRoomPrice(
+		ctx context.Context,
+		tx pgx.Tx,
+		id uuid.UUID,
+		pricePerNight decimal.Decimal,
+		stayAmount decimal.Decimal,
+		nightlyPrices []decimal.Decimal,
+	) error
+	UpdateBookingRoomGuestCounts(
+		ctx context.Context, tx pgx.Tx, id uuid.UUID, counts models.BookingRoomGuestCounts,
+	) error
+	DeleteBookingRoomByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
 }
 
 type RoomLockRepository interface {
@@ -44,19 +83,93 @@ type RoomLockRepository interface {
 	UpdateRoomLocksActivityByID(
 		ctx context.Context, tx pgx.Tx, id uuid.UUID, roomLock *models.RoomLockActivity,
 	) error
+	UpdateRoomLocksStayRange(ctx context.Context, tx pgx.Tx, bookingID uuid.UUID, stayRange models.DateRange) error
+	DeleteRoomLockByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+	CreateRoomBlock(ctx context.Context, tx pgx.Tx, block *models.CreateRoomBlock) (*models.RoomLockDetail, error)
+	DeleteRoomBlockByID(ctx context.Context, tx pgx.Tx, blockID uuid.UUID) error
+	GetActiveRoomLocks(
+		ctx context.Context, tx pgx.Tx, roomIDs []uuid.UUID, stayRange models.DateRange,
+	) ([]models.RoomOccupancy, error)
+	GetOccupiedRoomIDs(
+		ctx context.Context, tx pgx.Tx, roomIDs []uuid.UUID, stayRange models.DateRange,
+	) (map[uuid.UUID]bool, error)
+	CountUnassignedCategoryRooms(
+		ctx context.Context, tx pgx.Tx, categoryID uuid.UUID, stayRange models.DateRange, excludeBookingID *uuid.UUID,
+	) (uint32, error)
+	GetBookingHoldDeadline(ctx context.Context, tx pgx.Tx, bookingID uuid.UUID) (*time.Time, error)
+	LockRoomCategory(ctx context.Context, tx pgx.Tx, categoryID uuid.UUID) error
+	MoveRoomLock(
+		ctx context.Context, tx pgx.Tx, bookingID uuid.UUID, fromRoomID uuid.UUID, toRoomID uuid.UUID,
+	) (*models.RoomLockShort, error)
+}
+
+type PaymentRepository interface {
+	CreatePayment(ctx context.Context, tx pgx.Tx, p *models.Payment) (*models.Payment, error)
+	GetPaymentByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*models.Payment, error)
+	GetPaymentByIDForUpdate(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*models.Payment, error)
+	GetPaymentByProviderIDForUpdate(
+		ctx context.Context, tx pgx.Tx, provider string, providerPaymentID string,
+	) (*models.Payment, error)
+	GetPendingPaymentByBookingID(ctx context.Context, tx pgx.Tx, bookingID uuid.UUID) (*models.Payment, error)
+	GetPaymentsByBookingID(ctx context.Context, tx pgx.Tx, bookingID uuid.UUID) ([]*models.Payment, error)
+	UpdatePaymentStatus(ctx context.Context, tx pgx.Tx, p *models.Payment, status models.PaymentStatus) error
+	CreateRefund(ctx context.Context, tx pgx.Tx, refund *models.Refund) (*models.Refund, error)
+	GetRefundsByPaymentIDs(ctx context.Context, tx pgx.Tx, paymentIDs []uuid.UUID) ([]*models.Refund, error)
+	GetRefundByProviderIDForUpdate(
+		ctx context.Context, tx pgx.Tx, provider string, providerRefundID string,
+	) (*models.Refund, error)
+	UpdateRefund(
+		ctx context.Context, tx pgx.Tx, refund *models.Refund, providerRefundID *string, status models.RefundStatus,
+	) error
+	SumReservedRefunds(ctx context.Context, tx pgx.Tx, paymentID uuid.UUID) (decimal.Decimal, error)
+}
+
+type BookingCancellationRepository interface {
+	CreateBookingCancellation(
+		ctx context.Context, tx pgx.Tx, c *models.BookingCancellation,
+	) (*models.BookingCancellation, error)
+}
+
+type OutboxRepository interface {
+	CreateOutboxEvent(ctx context.Context, tx pgx.Tx, e *outbox.Event) error
 }
 
 type Repository interface {
 	BookingTransactionRepository
 	BookingRepository
+	BookingStatusHistoryRepository
 	BookingRoomRepository
 	RoomLockRepository
+	PaymentRepository
+	BookingCancellationRepository
+	OutboxRepository
+}
+
+type HotelClient interface {
+	GetHotelPolicy(ctx context.Context, hotelID uuid.UUID) (*models.PolicySnapshot, error)
+	QuoteStay(ctx context.Context, roomID uuid.UUID, checkIn, checkOut time.Time) (*models.StayQuote, error)
+	CheckStay(ctx context.Context, roomID uuid.UUID, checkIn, checkOut time.Time) ([]models.StayViolation, error)
+	GetRoom(ctx context.Context, roomID uuid.UUID) (*models.HotelRoom, error)
+	GetRoomCategory(ctx context.Context, categoryID uuid.UUID) (*models.RoomCategory, error)
+	UpdateRoomStatus(ctx context.Context, roomID uuid.UUID, status models.RoomStatus) error
+}
+
+// PaymentProvider opens and settles payments at an external gateway and
+// verifies the webhooks it sends back.
+type PaymentProvider interface {
+	Name() string
+	CreateIntent(ctx context.Context, req *models.CreatePaymentIntent) (*models.PaymentIntent, error)
+	Capture(ctx context.Context, providerPaymentID string) (*models.PaymentIntent, error)
+	Refund(ctx context.Context, providerPaymentID string, amount decimal.Decimal) (*models.ProviderRefund, error)
+	VerifyWebhook(payload []byte, signature string) (*models.PaymentEvent, error)
 }
 
 type Service struct {
-	repo Repository
+	repo     Repository
+	hotel    HotelClient
+	payments PaymentProvider
 }
 
-func New(repo Repository) *Service {
-	return &Service{repo}
+func New(repo Repository, hotel HotelClient, payments PaymentProvider) *Service {
+	return &Service{repo: repo, hotel: hotel, payments: payments}
 }
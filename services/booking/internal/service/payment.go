@@ -0,0 +1,373 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+// CreatePayment opens a payment for the final total of a pending booking, or
+// returns the one the guest has not completed yet.
+func (s *Service) CreatePayment(ctx context.Context, bookingID uuid.UUID) (*models.Payment, error) {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return nil, err
+	}
+	if booking.Status != models.BookingStatusPending || !booking.FinalTotalAmount.IsPositive() {
+		return nil, consts.ErrBookingNotPayable
+	}
+
+	pending, err := s.repo.GetPendingPaymentByBookingID(ctx, tx, bookingID)
+	if err == nil {
+		return pending, nil
+	}
+	if !errors.Is(err, consts.ErrPaymentNotFound) {
+		slog.ErrorContext(ctx, "failed to get pending payment", "err", err)
+		return nil, err
+	}
+
+	intent, err := s.payments.CreateIntent(ctx, &models.CreatePaymentIntent{
+		Currency:  booking.Currency,
+		Amount:    booking.FinalTotalAmount,
+		BookingID: booking.ID,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create payment intent", "err", err)
+		return nil, err
+	}
+
+	payment, err := s.repo.CreatePayment(ctx, tx, &models.Payment{
+		Status:            intent.Status,
+		Provider:          s.payments.Name(),
+		ProviderPaymentID: intent.ProviderPaymentID,
+		ClientSecret:      intent.ClientSecret,
+		Currency:          booking.Currency,
+		Amount:            booking.FinalTotalAmount,
+		BookingID:         booking.ID,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create payment", "err", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// CapturePayment takes the money of a pending payment at the provider and
+// applies the result the same way its webhook would.
+func (s *Service) CapturePayment(ctx context.Context, paymentID uuid.UUID) (*models.Payment, error) {
+	payment, err := s.repo.GetPaymentByID(ctx, nil, paymentID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get payment by id", "err", err)
+		return nil, err
+	}
+	if payment.Status != models.PaymentStatusPending {
+		return nil, consts.ErrPaymentNotCapturable
+	}
+
+	intent, err := s.payments.Capture(ctx, payment.ProviderPaymentID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to capture payment", "err", err)
+		return nil, err
+	}
+
+	return s.settlePayment(ctx, payment.ProviderPaymentID, intent.Status)
+}
+
+// RefundPayment gives back part or all of a succeeded payment. The refund is
+// stored before the provider is asked, so the money can never be refunded
+// twice by concurrent requests.
+func (s *Service) RefundPayment(
+	ctx context.Context,
+	paymentID uuid.UUID,
+	amount decimal.Decimal,
+	reason *string,
+) (*models.Refund, error) {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	payment, refund, err := s.reserveRefund(ctx, tx, paymentID, amount, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return nil, err
+	}
+
+	if err = s.requestRefund(ctx, payment, refund); err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// reserveRefund stores a pending refund of amount in tx once the payment,
+// locked until tx ends, has that much left to refund.
+func (s *Service) reserveRefund(
+	ctx context.Context,
+	tx pgx.Tx,
+	paymentID uuid.UUID,
+	amount decimal.Decimal,
+	reason *string,
+) (*models.Payment, *models.Refund, error) {
+	payment, err := s.repo.GetPaymentByIDForUpdate(ctx, tx, paymentID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get payment by id", "err", err)
+		return nil, nil, err
+	}
+	if payment.Status != models.PaymentStatusSucceeded {
+		return nil, nil, consts.ErrPaymentNotRefundable
+	}
+
+	refunded, err := s.repo.SumReservedRefunds(ctx, tx, paymentID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to sum refunds", "err", err)
+		return nil, nil, err
+	}
+	if refunded.Add(amount).GreaterThan(payment.Amount) {
+		return nil, nil, consts.ErrRefundExceedsPayment
+	}
+
+	refund, err := s.repo.CreateRefund(ctx, tx, &models.Refund{
+		Reason:    reason,
+		Status:    models.RefundStatusPending,
+		Amount:    amount,
+		PaymentID: paymentID,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create refund", "err", err)
+		return nil, nil, err
+	}
+
+	return payment, refund, nil
+}
+
+// requestRefund asks the provider for a reserved refund; a refund the provider
+// rejects is marked failed so its amount can be refunded again.
+func (s *Service) requestRefund(ctx context.Context, payment *models.Payment, refund *models.Refund) error {
+	status := models.RefundStatusFailed
+	var providerRefundID *string
+	providerRefund, providerErr := s.payments.Refund(ctx, payment.ProviderPaymentID, refund.Amount)
+	if providerErr != nil {
+		slog.ErrorContext(ctx, "failed to refund payment", "err", providerErr)
+	} else {
+		status = providerRefund.Status
+		providerRefundID = &providerRefund.ProviderRefundID
+	}
+
+	if err := s.repo.UpdateRefund(ctx, nil, refund, providerRefundID, status); err != nil {
+		slog.ErrorContext(ctx, "failed to update refund", "err", err)
+		return err
+	}
+
+	return providerErr
+}
+
+func (s *Service) GetBookingPayments(ctx context.Context, bookingID uuid.UUID) ([]*models.Payment, error) {
+	if _, err := s.repo.GetBookingByID(ctx, nil, bookingID); err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return nil, err
+	}
+
+	payments, err := s.repo.GetPaymentsByBookingID(ctx, nil, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get payments by booking id", "err", err)
+		return nil, err
+	}
+
+	paymentIDs := make([]uuid.UUID, len(payments))
+	paymentsByID := make(map[uuid.UUID]*models.Payment, len(payments))
+	for i, payment := range payments {
+		paymentIDs[i] = payment.ID
+		paymentsByID[payment.ID] = payment
+	}
+
+	refunds, err := s.repo.GetRefundsByPaymentIDs(ctx, nil, paymentIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get refunds by payment ids", "err", err)
+		return nil, err
+	}
+	for _, refund := range refunds {
+		payment := paymentsByID[refund.PaymentID]
+		payment.Refunds = append(payment.Refunds, refund)
+	}
+
+	return payments, nil
+}
+
+// HandlePaymentWebhook applies a webhook of the payment provider once its
+// signature checks out. Providers retry webhooks, so events already applied
+// are accepted without changing anything.
+func (s *Service) HandlePaymentWebhook(ctx context.Context, payload []byte, signature string) error {
+	event, err := s.payments.VerifyWebhook(payload, signature)
+	if err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case models.PaymentEventSucceeded:
+		_, err = s.settlePayment(ctx, event.ProviderPaymentID, models.PaymentStatusSucceeded)
+	case models.PaymentEventFailed:
+		_, err = s.settlePayment(ctx, event.ProviderPaymentID, models.PaymentStatusFailed)
+	case models.PaymentEventRefundSucceeded:
+		err = s.settleRefund(ctx, *event.ProviderRefundID, models.RefundStatusSucceeded)
+	case models.PaymentEventRefundFailed:
+		err = s.settleRefund(ctx, *event.ProviderRefundID, models.RefundStatusFailed)
+	default:
+		err = consts.ErrInvalidWebhookPayload
+	}
+
+	return err
+}
+
+// settlePayment records the final status of a pending payment; a payment that
+// succeeds while its booking still holds the rooms confirms the booking, any
+// other successful payment is refunded in full once the status is committed.
+func (s *Service) settlePayment(
+	ctx context.Context,
+	providerPaymentID string,
+	status models.PaymentStatus,
+) (*models.Payment, error) {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	payment, err := s.repo.GetPaymentByProviderIDForUpdate(ctx, tx, s.payments.Name(), providerPaymentID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get payment by provider id", "err", err)
+		return nil, err
+	}
+	if payment.Status != models.PaymentStatusPending || status == models.PaymentStatusPending {
+		return payment, nil
+	}
+
+	if err = s.repo.UpdatePaymentStatus(ctx, tx, payment, status); err != nil {
+		slog.ErrorContext(ctx, "failed to update payment status", "err", err)
+		return nil, err
+	}
+
+	var refunded *models.Payment
+	var refund *models.Refund
+	if status == models.PaymentStatusSucceeded {
+		if refunded, refund, err = s.confirmPaidBooking(ctx, tx, payment); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return nil, err
+	}
+
+	// The failed refund is kept so it can be issued again.
+	if refund != nil {
+		if err = s.requestRefund(ctx, refunded, refund); err != nil {
+			slog.ErrorContext(ctx, "failed to refund late payment", "payment_id", payment.ID, "err", err)
+		}
+	}
+
+	return payment, nil
+}
+
+// confirmPaidBooking confirms a pending booking paid before its room hold
+// expired. When the booking no longer holds its rooms, because the hold ran out
+// or it was cancelled, expired or already paid, a refund of the whole payment
+// is reserved instead and returned with the payment; a lapsed pending booking
+// is expired on the way.
+func (s *Service) confirmPaidBooking(
+	ctx context.Context,
+	tx pgx.Tx,
+	payment *models.Payment,
+) (*models.Payment, *models.Refund, error) {
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, payment.BookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking by id", "err", err)
+		return nil, nil, err
+	}
+
+	if booking.Status == models.BookingStatusPending {
+		deadline, err := s.holdDeadline(ctx, tx, booking)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reason := consts.ReasonPaymentSucceeded
+		change := &models.BookingStatusChange{Reason: &reason, To: models.BookingStatusConfirmed}
+		if !time.Now().Before(deadline) {
+			reason = consts.ReasonHoldExpired
+			change.To = models.BookingStatusExpired
+		}
+		if _, err = s.transitionBooking(ctx, tx, booking, change); err != nil {
+			return nil, nil, err
+		}
+		if change.To == models.BookingStatusConfirmed {
+			return nil, nil, nil
+		}
+	}
+
+	slog.WarnContext(ctx, "refunding payment for a booking no longer held",
+		"booking_id", booking.ID, "status", booking.Status)
+	reason := consts.ReasonPaymentTooLate
+
+	return s.reserveRefund(ctx, tx, payment.ID, payment.Amount, &reason)
+}
+
+func (s *Service) settleRefund(ctx context.Context, providerRefundID string, status models.RefundStatus) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	refund, err := s.repo.GetRefundByProviderIDForUpdate(ctx, tx, s.payments.Name(), providerRefundID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get refund by provider id", "err", err)
+		return err
+	}
+	if refund.Status != models.RefundStatusPending {
+		return nil
+	}
+
+	if err = s.repo.UpdateRefund(ctx, tx, refund, nil, status); err != nil {
+		slog.ErrorContext(ctx, "failed to update refund", "err", err)
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return err
+	}
+
+	return nil
+}
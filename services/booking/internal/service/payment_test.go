@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"booking/internal/repository/models"
+)
+
+func TestHandlePaymentWebhookSucceeded(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     models.BookingStatus
+		hold       time.Duration
+		wantStatus models.BookingStatus
+		wantRefund bool
+	}{
+		{
+			name:       "paid within the hold",
+			status:     models.BookingStatusPending,
+			hold:       time.Minute,
+			wantStatus: models.BookingStatusConfirmed,
+		},
+		{
+			name:       "paid after the hold expired",
+			status:     models.BookingStatusPending,
+			hold:       -time.Minute,
+			wantStatus: models.BookingStatusExpired,
+			wantRefund: true,
+		},
+		{
+			name:       "paid after the hold expiry job",
+			status:     models.BookingStatusExpired,
+			wantStatus: models.BookingStatusExpired,
+			wantRefund: true,
+		},
+		{
+			name:       "paid after the booking was cancelled",
+			status:     models.BookingStatusCancelled,
+			wantStatus: models.BookingStatusCancelled,
+			wantRefund: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := newBooking(tt.status)
+			f := newFixture(t, booking)
+			f.holds[booking.ID] = time.Now().Add(tt.hold)
+			payment := f.addPayment(booking, "300.00", models.PaymentStatusPending)
+
+			payload := []byte("payload")
+			f.provider.EXPECT().VerifyWebhook(payload, "signature").Return(&models.PaymentEvent{
+				Type:              models.PaymentEventSucceeded,
+				ProviderPaymentID: payment.ProviderPaymentID,
+			}, nil)
+			if tt.wantRefund {
+				f.expectRefund(payment)
+			} else {
+				// Every room of the booking was picked by id already.
+				f.repo.EXPECT().GetUnassignedBookingRooms(mock.Anything, mock.Anything, booking.ID).Return(nil, nil)
+			}
+
+			if err := f.service().HandlePaymentWebhook(context.Background(), payload, "signature"); err != nil {
+				t.Fatalf("HandlePaymentWebhook() error = %v", err)
+			}
+
+			if got := f.bookings[booking.ID].Status; got != tt.wantStatus {
+				t.Errorf("booking status = %s, want %s", got, tt.wantStatus)
+			}
+			if got := f.payments[payment.ID].Status; got != models.PaymentStatusSucceeded {
+				t.Errorf("payment status = %s, want succeeded", got)
+			}
+			if !tt.wantRefund {
+				return
+			}
+			if len(f.refunds) != 1 || f.refunds[0].Status != models.RefundStatusSucceeded {
+				t.Errorf("refunds = %+v, want one succeeded refund", f.refunds)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"booking/internal/repository/models"
+)
+
+func (s *Service) BlockRoom(ctx context.Context, block *models.CreateRoomBlock) (*models.RoomLockDetail, error) {
+	lock, err := s.repo.CreateRoomBlock(ctx, nil, block)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create room block", "err", err)
+		return nil, err
+	}
+
+	return lock, nil
+}
+
+func (s *Service) UnblockRoom(ctx context.Context, blockID uuid.UUID) error {
+	if err := s.repo.DeleteRoomBlockByID(ctx, nil, blockID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete room block", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetRoomAvailability(
+	ctx context.Context,
+	roomIDs []uuid.UUID,
+	stayRange models.DateRange,
+) ([]*models.RoomAvailability, error) {
+	occupancies, err := s.repo.GetActiveRoomLocks(ctx, nil, roomIDs, stayRange)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get room locks", "err", err)
+		return nil, err
+	}
+
+	byRoomID := make(map[uuid.UUID]*models.RoomAvailability, len(roomIDs))
+	availability := make([]*models.RoomAvailability, len(roomIDs))
+	for i, roomID := range roomIDs {
+		availability[i] = &models.RoomAvailability{RoomID: roomID, Available: true}
+		byRoomID[roomID] = availability[i]
+	}
+
+	for _, o := range occupancies {
+		if room, exists := byRoomID[o.RoomID]; exists {
+			room.Occupancies = append(room.Occupancies, o)
+			room.Available = false
+		}
+	}
+
+	return availability, nil
+}
+
+func (s *Service) GetCategoryAvailability(
+	ctx context.Context,
+	categoryIDs []uuid.UUID,
+	stayRange models.DateRange,
+) ([]*models.CategoryAvailability, error) {
+	availability := make([]*models.CategoryAvailability, len(categoryIDs))
+	for i, categoryID := range categoryIDs {
+		category, err := s.hotel.GetRoomCategory(ctx, categoryID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get room category", "err", err)
+			return nil, err
+		}
+
+		if availability[i], err = s.categoryAvailability(ctx, nil, category, stayRange, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return availability, nil
+}
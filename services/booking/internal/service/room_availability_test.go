@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+func TestBlockRoom(t *testing.T) {
+	tests := []struct {
+		name      string
+		createErr error
+	}{
+		{name: "free dates"},
+		{
+			// The exclusion constraint on the room locks rejects a block over a
+			// booking or another block.
+			name:      "dates already locked",
+			createErr: consts.ErrRoomLockAlreadyExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := newBooking(models.BookingStatusConfirmed)
+			f := newFixture(t)
+			block := &models.CreateRoomBlock{
+				RoomID:    uuid.New(),
+				BlockID:   uuid.New(),
+				StayRange: models.DateRange{Start: booking.CheckIn, End: booking.CheckOut},
+			}
+
+			created := f.repo.EXPECT().CreateRoomBlock(mock.Anything, mock.Anything, block)
+			if tt.createErr != nil {
+				created.Return(nil, tt.createErr)
+			} else {
+				created.Return(&models.RoomLockDetail{ID: uuid.New(), RoomID: block.RoomID, ISActive: true}, nil)
+			}
+
+			lock, err := f.service().BlockRoom(context.Background(), block)
+			if !errors.Is(err, tt.createErr) {
+				t.Fatalf("BlockRoom() error = %v, want %v", err, tt.createErr)
+			}
+			if tt.createErr == nil && lock.RoomID != block.RoomID {
+				t.Errorf("lock room = %s, want %s", lock.RoomID, block.RoomID)
+			}
+		})
+	}
+}
+
+func TestGetRoomAvailability(t *testing.T) {
+	booking := newBooking(models.BookingStatusConfirmed)
+	stay := models.DateRange{Start: booking.CheckIn, End: booking.CheckOut}
+	blocked, booked, free := uuid.New(), uuid.New(), uuid.New()
+	blockID := uuid.New()
+
+	f := newFixture(t)
+	f.repo.EXPECT().GetActiveRoomLocks(mock.Anything, mock.Anything, []uuid.UUID{blocked, booked, free}, stay).
+		Return([]models.RoomOccupancy{
+			{RoomID: blocked, Kind: models.RoomOccupancyKindBlock, ReferenceID: blockID, StayRange: stay},
+			{RoomID: booked, Kind: models.RoomOccupancyKindBooking, ReferenceID: booking.ID, StayRange: stay},
+		}, nil)
+
+	availability, err := f.service().GetRoomAvailability(context.Background(), []uuid.UUID{blocked, booked, free}, stay)
+	if err != nil {
+		t.Fatalf("GetRoomAvailability() error = %v", err)
+	}
+
+	want := map[uuid.UUID]models.RoomOccupancyKind{
+		blocked: models.RoomOccupancyKindBlock,
+		booked:  models.RoomOccupancyKindBooking,
+	}
+	for _, room := range availability {
+		kind, taken := want[room.RoomID]
+		if room.Available == taken {
+			t.Errorf("room %s available = %v, want %v", room.RoomID, room.Available, !taken)
+		}
+		if taken && (len(room.Occupancies) != 1 || room.Occupancies[0].Kind != kind) {
+			t.Errorf("room %s occupancies = %+v, want one %s", room.RoomID, room.Occupancies, kind)
+		}
+	}
+}
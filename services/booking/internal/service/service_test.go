@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+
+	"booking/internal/mocks"
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+	"outbox"
+)
+
+// fixture keeps bookings, payments and hotel rooms in memory and answers the
+// reads and writes every booking flow makes through the mocks from them. Calls
+// that tell one scenario from another are expected by the tests themselves.
+type fixture struct {
+	repo     *mocks.MockRepository
+	hotel    *mocks.MockHotelClient
+	provider *mocks.MockPaymentProvider
+
+	bookings  map[uuid.UUID]*models.Booking
+	holds     map[uuid.UUID]time.Time
+	locks     map[uuid.UUID]*models.RoomLockActivity
+	payments  map[uuid.UUID]*models.Payment
+	rooms     map[uuid.UUID]*models.HotelRoom
+	refunds   []*models.Refund
+	cancels   []*models.BookingCancellation
+	history   []*models.BookingStatusTransition
+	events    []*outbox.Event
+	statuses  []roomStatusUpdate
+	commitErr error
+	commits   int
+}
+
+type roomStatusUpdate struct {
+	status    models.RoomStatus
+	roomID    uuid.UUID
+	committed bool
+}
+
+func newFixture(t *testing.T, bookings ...*models.Booking) *fixture {
+	f := &fixture{
+		repo:     mocks.NewMockRepository(t),
+		hotel:    mocks.NewMockHotelClient(t),
+		provider: mocks.NewMockPaymentProvider(t),
+		bookings: make(map[uuid.UUID]*models.Booking),
+		holds:    make(map[uuid.UUID]time.Time),
+		locks:    make(map[uuid.UUID]*models.RoomLockActivity),
+		payments: make(map[uuid.UUID]*models.Payment),
+		rooms:    make(map[uuid.UUID]*models.HotelRoom),
+	}
+	for _, b := range bookings {
+		f.bookings[b.ID] = b
+	}
+
+	tx := mocks.NewMockTx(t)
+	tx.EXPECT().Commit(mock.Anything).RunAndReturn(f.commit).Maybe()
+	tx.EXPECT().Rollback(mock.Anything).Return(nil).Maybe()
+
+	repo := f.repo.EXPECT()
+	repo.BeginTx(mock.Anything).Return(tx, nil).Maybe()
+	repo.GetBookingByID(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(f.getBooking).Maybe()
+	repo.GetBookingByIDForUpdate(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(f.getBooking).Maybe()
+	repo.UpdateBookingStatusByID(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(f.updateBookingStatus).Maybe()
+	repo.UpdateRoomLocksActivityByID(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(f.updateRoomLocksActivity).Maybe()
+	repo.CreateBookingStatusTransition(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(f.createBookingStatusTransition).Maybe()
+	repo.CreateOutboxEvent(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(f.createOutboxEvent).Maybe()
+	repo.GetBookingRoomsWithLockByBookingIDs(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(f.getBookingRoomsWithLock).Maybe()
+	repo.GetBookingRoomsByBookingIDs(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(f.getBookingRooms).Maybe()
+	repo.GetBookingRoomByID(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(f.getBookingRoom).Maybe()
+	repo.GetBookingHoldDeadline(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(f.getHoldDeadline).Maybe()
+	repo.GetPaymentByProviderIDForUpdate(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(f.getPaymentByProviderID).Maybe()
+	repo.GetPaymentByIDForUpdate(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(f.getPayment).Maybe()
+	repo.GetPaymentsByBookingID(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(f.getBookingPayments).Maybe()
+	repo.UpdatePaymentStatus(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(f.updatePaymentStatus).Maybe()
+	repo.SumReservedRefunds(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(f.sumReservedRefunds).Maybe()
+	repo.CreateRefund(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(f.createRefund).Maybe()
+	repo.UpdateRefund(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(f.updateRefund).Maybe()
+	repo.CreateBookingCancellation(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(f.createBookingCancellation).Maybe()
+
+	hotel := f.hotel.EXPECT()
+	hotel.GetHotelPolicy(mock.Anything, mock.Anything).
+		Return(&models.PolicySnapshot{Timezone: "UTC", CheckInTime: "14:00", CheckOutTime: "12:00"}, nil).Maybe()
+	hotel.GetRoom(mock.Anything, mock.Anything).RunAndReturn(f.getRoom).Maybe()
+	hotel.UpdateRoomStatus(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(f.updateRoomStatus).Maybe()
+
+	f.provider.EXPECT().Name().Return("fake").Maybe()
+
+	return f
+}
+
+func (f *fixture) service() *Service {
+	return New(f.repo, f.hotel, f.provider)
+}
+
+func (f *fixture) commit(context.Context) error {
+	if f.commitErr != nil {
+		return f.commitErr
+	}
+	f.commits++
+
+	return nil
+}
+
+func (f *fixture) getBooking(_ context.Context, _ pgx.Tx, id uuid.UUID) (*models.Booking, error) {
+	b, ok := f.bookings[id]
+	if !ok {
+		return nil, consts.ErrBookingNotFound
+	}
+	booking := *b
+
+	return &booking, nil
+}
+
+func (f *fixture) updateBookingStatus(
+	_ context.Context, _ pgx.Tx, id uuid.UUID, status models.BookingStatus, _ *int64,
+) (time.Time, int64, error) {
+	b := f.bookings[id]
+	b.Status = status
+	b.Version++
+
+	return b.CheckOut, b.Version, nil
+}
+
+func (f *fixture) updateRoomLocksActivity(
+	_ context.Context, _ pgx.Tx, id uuid.UUID, activity *models.RoomLockActivity,
+) error {
+	f.locks[id] = activity
+
+	return nil
+}
+
+func (f *fixture) createBookingStatusTransition(
+	_ context.Context, _ pgx.Tx, t *models.BookingStatusTransition,
+) (*models.BookingStatusTransition, error) {
+	f.history = append(f.history, t)
+
+	return t, nil
+}
+
+func (f *fixture) createOutboxEvent(_ context.Context, _ pgx.Tx, e *outbox.Event) error {
+	f.events = append(f.events, e)
+
+	return nil
+}
+
+// recalculateBookingTotal only bumps the version; the tests use fixed totals.
+func (f *fixture) recalculateBookingTotal(_ context.Context, _ pgx.Tx, id uuid.UUID) (int64, error) {
+	b := f.bookings[id]
+	b.Version++
+
+	return b.Version, nil
+}
+
+func (f *fixture) getBookingRoomsWithLock(
+	_ context.Context, _ pgx.Tx, bookingIDs []uuid.UUID,
+) ([]*models.BookingRoomWithLock, error) {
+	var rooms []*models.BookingRoomWithLock
+	for _, id := range bookingIDs {
+		rooms = append(rooms, f.bookings[id].BookingRooms...)
+	}
+
+	return rooms, nil
+}
+
+func (f *fixture) getBookingRooms(
+	_ context.Context, _ pgx.Tx, bookingIDs []uuid.UUID,
+) ([]*models.BookingRoom, error) {
+	var rooms []*models.BookingRoom
+	for _, id := range bookingIDs {
+		for _, room := range f.bookings[id].BookingRooms {
+			rooms = append(rooms, &models.BookingRoom{RoomID: room.RoomID, BookingID: id, ID: room.ID})
+		}
+	}
+
+	return rooms, nil
+}
+
+func (f *fixture) getBookingRoom(_ context.Context, _ pgx.Tx, id uuid.UUID) (*models.BookingRoomWithLock, error) {
+	for _, b := range f.bookings {
+		for _, room := range b.BookingRooms {
+			if room.ID == id {
+				bRoom := *room
+				return &bRoom, nil
+			}
+		}
+	}
+
+	return nil, consts.ErrBookingRoomNotFound
+}
+
+func (f *fixture) assignBookingRoom(_ context.Context, _ pgx.Tx, id uuid.UUID, roomID uuid.UUID) error {
+	for _, b := range f.bookings {
+		for _, room := range b.BookingRooms {
+			if room.ID == id {
+				room.RoomID = &roomID
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f *fixture) getHoldDeadline(_ context.Context, _ pgx.Tx, bookingID uuid.UUID) (*time.Time, error) {
+	deadline, ok := f.holds[bookingID]
+	if !ok {
+		return nil, nil
+	}
+
+	return &deadline, nil
+}
+
+func (f *fixture) getPaymentByProviderID(
+	_ context.Context, _ pgx.Tx, _ string, providerPaymentID string,
+) (*models.Payment, error) {
+	for _, p := range f.payments {
+		if p.ProviderPaymentID == providerPaymentID {
+			return p, nil
+		}
+	}
+
+	return nil, consts.ErrPaymentNotFound
+}
+
+func (f *fixture) getPayment(_ context.Context, _ pgx.Tx, id uuid.UUID) (*models.Payment, error) {
+	p, ok := f.payments[id]
+	if !ok {
+		return nil, consts.ErrPaymentNotFound
+	}
+
+	return p, nil
+}
+
+func (f *fixture) getBookingPayments(_ context.Context, _ pgx.Tx, bookingID uuid.UUID) ([]*models.Payment, error) {
+	var payments []*models.Payment
+	for _, p := range f.payments {
+		if p.BookingID == bookingID {
+			payments = append(payments, p)
+		}
+	}
+
+	return payments, nil
+}
+
+func (f *fixture) updatePaymentStatus(
+	_ context.Context, _ pgx.Tx, p *models.Payment, status models.PaymentStatus,
+) error {
+	f.payments[p.ID].Status = status
+
+	return nil
+}
+
+func (f *fixture) sumReservedRefunds(_ context.Context, _ pgx.Tx, paymentID uuid.UUID) (decimal.Decimal, error) {
+	sum := decimal.Zero
+	for _, refund := range f.refunds {
+		if refund.PaymentID == paymentID && refund.Status != models.RefundStatusFailed {
+			sum = sum.Add(refund.Amount)
+		}
+	}
+
+	return sum, nil
+}
+
+func (f *fixture) createRefund(_ context.Context, _ pgx.Tx, refund *models.Refund) (*models.Refund, error) {
+	refund.ID = uuid.New()
+	f.refunds = append(f.refunds, refund)
+
+	return refund, nil
+}
+
+func (f *fixture) updateRefund(
+	_ context.Context, _ pgx.Tx, refund *models.Refund, providerRefundID *string, status models.RefundStatus,
+) error {
+	refund.ProviderRefundID = providerRefundID
+	refund.Status = status
+
+	return nil
+}
+
+func (f *fixture) createBookingCancellation(
+	_ context.Context, _ pgx.Tx, c *models.BookingCancellation,
+) (*models.BookingCancellation, error) {
+	f.cancels = append(f.cancels, c)
+
+	return c, nil
+}
+
+func (f *fixture) getRoom(_ context.Context, roomID uuid.UUID) (*models.HotelRoom, error) {
+	return f.rooms[roomID], nil
+}
+
+// updateRoomStatus records the update, noting whether the booking change had
+// committed by then.
+func (f *fixture) updateRoomStatus(_ context.Context, roomID uuid.UUID, status models.RoomStatus) error {
+	f.statuses = append(f.statuses, roomStatusUpdate{status: status, roomID: roomID, committed: f.commits > 0})
+
+	return nil
+}
+
+// expectRefund expects the whole payment to be refunded at the provider.
+func (f *fixture) expectRefund(payment *models.Payment) {
+	f.provider.EXPECT().Refund(mock.Anything, payment.ProviderPaymentID, payment.Amount).
+		Return(&models.ProviderRefund{
+			Status:           models.RefundStatusSucceeded,
+			ProviderRefundID: "re_" + payment.ProviderPaymentID,
+		}, nil).
+		Once()
+}
+
+// addPayment stores a payment of amount for the booking in status.
+func (f *fixture) addPayment(
+	booking *models.Booking, amount string, status models.PaymentStatus,
+) *models.Payment {
+	id := uuid.New()
+	p := &models.Payment{
+		ID:                id,
+		BookingID:         booking.ID,
+		Provider:          "fake",
+		ProviderPaymentID: "pi_" + id.String(),
+		Status:            status,
+		Currency:          booking.Currency,
+		Amount:            decimal.RequireFromString(amount),
+	}
+	f.payments[id] = p
+
+	return p
+}
+
+// addRoom assigns a locked room of the booking's hotel to the booking and
+// returns its id.
+func (f *fixture) addRoom(booking *models.Booking) uuid.UUID {
+	roomID := f.newRoom(booking.HotelID)
+	booking.BookingRooms = append(booking.BookingRooms, &models.BookingRoomWithLock{
+		RoomLock:  &models.RoomLockShort{ID: uuid.New(), ISActive: true},
+		RoomID:    &roomID,
+		ID:        uuid.New(),
+		BookingID: booking.ID,
+	})
+
+	return roomID
+}
+
+// newRoom adds a free room to the hotel.
+func (f *fixture) newRoom(hotelID uuid.UUID) uuid.UUID {
+	roomID := uuid.New()
+	f.rooms[roomID] = &models.HotelRoom{ID: roomID, HotelID: hotelID}
+
+	return roomID
+}
+
+func newBooking(status models.BookingStatus) *models.Booking {
+	checkIn := time.Now().UTC().Truncate(24 * time.Hour)
+
+	return &models.Booking{
+		ID:        uuid.New(),
+		HotelID:   uuid.New(),
+		UserID:    42,
+		Status:    status,
+		GuestName: "Ivan Petrov",
+		Currency:  "RUB",
+		CheckIn:   checkIn,
+		CheckOut:  checkIn.Add(48 * time.Hour),
+		CreatedAt: time.Now().Add(-time.Hour),
+		Version:   1,
+	}
+}
@@ -0,0 +1,37 @@
+package helper
+
+import (
+	"crypto/rand"
+	"strings"
+)
+
+const (
+	// bookingCodeAlphabet is Crockford's base32 alphabet: digits and capitals
+	// without I, L, O and U, so codes read out over the phone are not misheard.
+	bookingCodeAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	bookingCodeLength   = 8
+)
+
+// bookingCodeReplacer maps what guests type for a code to its canonical form:
+// dashes are dropped and the letters Crockford decodes as digits are replaced.
+var bookingCodeReplacer = strings.NewReplacer("-", "", "I", "1", "L", "1", "O", "0")
+
+// NewBookingCode returns a random booking reference code.
+func NewBookingCode() (string, error) {
+	b := make([]byte, bookingCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	for i := range b {
+		b[i] = bookingCodeAlphabet[b[i]%byte(len(bookingCodeAlphabet))]
+	}
+
+	return string(b), nil
+}
+
+// NormalizeBookingCode turns a code as a guest typed it, e.g. "abcd-efgh", into
+// the form it is stored in.
+func NormalizeBookingCode(code string) string {
+	return bookingCodeReplacer.Replace(strings.ToUpper(strings.TrimSpace(code)))
+}
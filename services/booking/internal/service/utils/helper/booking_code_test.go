@@ -0,0 +1,65 @@
+package helper
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewBookingCode(t *testing.T) {
+	seen := make(map[string]bool)
+	for range 1000 {
+		code, err := NewBookingCode()
+		if err != nil {
+			t.Fatalf("NewBookingCode() error = %v", err)
+		}
+		if len(code) != bookingCodeLength {
+			t.Fatalf("code %q has length %d, want %d", code, len(code), bookingCodeLength)
+		}
+		if i := strings.IndexFunc(code, func(r rune) bool { return !strings.ContainsRune(bookingCodeAlphabet, r) }); i >= 0 {
+			t.Fatalf("code %q has %q outside the alphabet", code, code[i])
+		}
+		if NormalizeBookingCode(code) != code {
+			t.Fatalf("code %q is not in normal form", code)
+		}
+		seen[code] = true
+	}
+
+	// 40 random bits per code: a repeat among a thousand means the codes are
+	// not random.
+	if len(seen) != 1000 {
+		t.Errorf("distinct codes = %d, want 1000", len(seen))
+	}
+}
+
+func TestBookingCodeAlphabet(t *testing.T) {
+	if len(bookingCodeAlphabet) != 32 {
+		t.Fatalf("alphabet has %d symbols, want 32 so random bytes map onto it evenly", len(bookingCodeAlphabet))
+	}
+	for _, r := range "ILOU" {
+		if strings.ContainsRune(bookingCodeAlphabet, r) {
+			t.Errorf("alphabet contains the ambiguous %q", r)
+		}
+	}
+}
+
+func TestNormalizeBookingCode(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want string
+	}{
+		{name: "canonical", code: "7K3M9QXA", want: "7K3M9QXA"},
+		{name: "lower case", code: "7k3m9qxa", want: "7K3M9QXA"},
+		{name: "dashes and spaces", code: "  7K3M-9QXA ", want: "7K3M9QXA"},
+		{name: "letter o for zero", code: "7K3MOQXA", want: "7K3M0QXA"},
+		{name: "letters i and l for one", code: "iK3lM9QX", want: "1K31M9QX"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeBookingCode(tt.code); got != tt.want {
+				t.Errorf("NormalizeBookingCode(%q) = %q, want %q", tt.code, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package helper
+
+import (
+	"time"
+
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+// bookingTransitions lists the statuses each booking status may move to;
+// statuses missing from it are final.
+var bookingTransitions = map[models.BookingStatus][]models.BookingStatus{
+	models.BookingStatusPending: {
+		models.BookingStatusConfirmed,
+		models.BookingStatusCancelled,
+		models.BookingStatusExpired,
+	},
+	models.BookingStatusConfirmed: {
+		models.BookingStatusCheckedIn,
+		models.BookingStatusCancelled,
+		models.BookingStatusNoShow,
+	},
+	models.BookingStatusCheckedIn: {
+		models.BookingStatusCheckedOut,
+	},
+}
+
+func CheckBookingTransition(from, to models.BookingStatus) error {
+	for _, allowed := range bookingTransitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+
+	return consts.ErrInvalidBookingTransition
+}
+
+// RoomLockActivityFor tells how the room locks of a booking change when it
+// enters status: confirmed bookings hold their rooms until check-out, final
+// statuses release them. A nil result leaves the locks untouched.
+func RoomLockActivityFor(status models.BookingStatus, checkOut, now time.Time) *models.RoomLockActivity {
+	switch status {
+	case models.BookingStatusConfirmed:
+		return &models.RoomLockActivity{IsActive: true, ExpiresAt: &checkOut}
+	case models.BookingStatusCheckedIn:
+		return nil
+	default:
+		return &models.RoomLockActivity{IsActive: false, ExpiresAt: &now}
+	}
+}
@@ -0,0 +1,75 @@
+package helper
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"booking/internal/repository/models"
+	"booking/internal/utils/consts"
+)
+
+const checkInTimeLayout = "15:04"
+
+// CheckInAt is the moment the stay starting on checkIn opens in the hotel's
+// timezone.
+func CheckInAt(checkIn time.Time, checkInTime string, loc *time.Location) (time.Time, error) {
+	at, err := time.Parse(checkInTimeLayout, checkInTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	date := LocalDate(checkIn, loc)
+	return time.Date(date.Year(), date.Month(), date.Day(), at.Hour(), at.Minute(), 0, 0, loc), nil
+}
+
+// CancellationFee prices cancelling a stay that costs total hoursLeft hours
+// before check-in. The tier with the earliest deadline the cancellation still
+// meets applies; cancellations later than every tier forfeit the whole stay and
+// a policy without tiers cancels for free.
+func CancellationFee(
+	tiers []models.CancellationTier,
+	hoursLeft float64,
+	rooms []*models.BookingRoomWithLock,
+	total decimal.Decimal,
+) (models.CancellationPenalty, decimal.Decimal) {
+	if len(tiers) == 0 {
+		return models.CancellationPenaltyNone, decimal.Zero
+	}
+
+	var tier *models.CancellationTier
+	for i := range tiers {
+		t := &tiers[i]
+		if hoursLeft >= float64(t.HoursBeforeCheckIn) && (tier == nil || t.HoursBeforeCheckIn > tier.HoursBeforeCheckIn) {
+			tier = t
+		}
+	}
+	if tier == nil {
+		return models.CancellationPenaltyFullStay, total
+	}
+
+	fee := decimal.Zero
+	switch tier.Penalty {
+	case models.CancellationPenaltyFirstNight:
+		for _, room := range rooms {
+			fee = fee.Add(FirstNightPrice(room))
+		}
+	case models.CancellationPenaltyPercent:
+		fee = total.Mul(decimal.NewFromInt(int64(tier.PenaltyPercent))).Div(decimal.NewFromInt(100)).Round(2)
+	case models.CancellationPenaltyFullStay:
+		fee = total
+	}
+
+	return tier.Penalty, decimal.Min(fee, total)
+}
+
+// CheckModificationWindow allows changing the stay only while the policy still
+// lets the booking be cancelled for free: past that, shrinking the stay would
+// dodge the cancellation fee.
+func CheckModificationWindow(tiers []models.CancellationTier, hoursLeft float64) error {
+	if penalty, _ := CancellationFee(tiers, hoursLeft, nil, decimal.Zero); penalty != models.CancellationPenaltyNone {
+		return consts.ErrModificationWindowClosed
+	}
+
+	return nil
+}
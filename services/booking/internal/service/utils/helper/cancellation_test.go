@@ -0,0 +1,63 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"booking/internal/repository/models"
+)
+
+func TestCancellationFee(t *testing.T) {
+	tiers := []models.CancellationTier{
+		{HoursBeforeCheckIn: 72, Penalty: models.CancellationPenaltyNone},
+		{HoursBeforeCheckIn: 24, Penalty: models.CancellationPenaltyFirstNight},
+		{HoursBeforeCheckIn: 0, Penalty: models.CancellationPenaltyPercent, PenaltyPercent: 50},
+	}
+	// A Friday check-in priced up by a weekend modifier: the first night costs
+	// more than the average of the stay.
+	rooms := []*models.BookingRoomWithLock{
+		{
+			PricePerNight: decimal.RequireFromString("110.00"),
+			NightlyPrices: []decimal.Decimal{
+				decimal.RequireFromString("150.00"),
+				decimal.RequireFromString("100.00"),
+				decimal.RequireFromString("80.00"),
+			},
+		},
+		{PricePerNight: decimal.RequireFromString("90.00")},
+	}
+	total := decimal.RequireFromString("600.00")
+
+	tests := []struct {
+		name        string
+		tiers       []models.CancellationTier
+		hoursLeft   float64
+		wantPenalty models.CancellationPenalty
+		wantFee     string
+	}{
+		{name: "no tiers", hoursLeft: 1, wantPenalty: models.CancellationPenaltyNone, wantFee: "0"},
+		{name: "free window", tiers: tiers, hoursLeft: 100, wantPenalty: models.CancellationPenaltyNone, wantFee: "0"},
+		{
+			name:        "first night uses the quoted first night",
+			tiers:       tiers,
+			hoursLeft:   48,
+			wantPenalty: models.CancellationPenaltyFirstNight,
+			wantFee:     "240",
+		},
+		{name: "percent", tiers: tiers, hoursLeft: 5, wantPenalty: models.CancellationPenaltyPercent, wantFee: "300"},
+		{name: "after every tier", tiers: tiers, hoursLeft: -1, wantPenalty: models.CancellationPenaltyFullStay, wantFee: "600"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			penalty, fee := CancellationFee(tt.tiers, tt.hoursLeft, rooms, total)
+			if penalty != tt.wantPenalty {
+				t.Errorf("penalty = %v, want %v", penalty, tt.wantPenalty)
+			}
+			if !fee.Equal(decimal.RequireFromString(tt.wantFee)) {
+				t.Errorf("fee = %s, want %s", fee, tt.wantFee)
+			}
+		})
+	}
+}
@@ -2,19 +2,59 @@ package helper
 
 import (
 	"time"
+	_ "time/tzdata"
 
 	"booking/internal/utils/consts"
 )
 
-func Nights(checkIn, checkOut time.Time) (int, error) {
-	in := time.Date(
-		checkIn.Year(), checkIn.Month(), checkIn.Day(),
-		0, 0, 0, 0, time.UTC,
-	)
-	out := time.Date(
-		checkOut.Year(), checkOut.Month(), checkOut.Day(),
-		0, 0, 0, 0, time.UTC,
-	)
+// LocalDate returns the calendar date of t in the hotel's timezone as a UTC midnight.
+// A timestamp already at UTC midnight is a date-only value, which is how stored
+// dates leave the service, and keeps its date.
+func LocalDate(t time.Time, loc *time.Location) time.Time {
+	t = t.UTC()
+	if t.Equal(truncateDate(t)) {
+		return t
+	}
+
+	return truncateDate(t.In(loc))
+}
+
+// CheckInOpen reports whether a stay starting on checkIn can still be booked at now.
+// Same-day bookings stay open until midnight in the hotel's timezone.
+func CheckInOpen(checkIn, now time.Time, loc *time.Location) bool {
+	today := truncateDate(now.In(loc))
+	return !LocalDate(checkIn, loc).Before(today)
+}
+
+// CheckCheckInDay reports whether a guest staying from checkIn to checkOut can
+// check in at now: from the check-in day until the day before check-out in the
+// hotel's timezone.
+func CheckCheckInDay(checkIn, checkOut, now time.Time, loc *time.Location) error {
+	today := truncateDate(now.In(loc))
+	if today.Before(LocalDate(checkIn, loc)) {
+		return consts.ErrCheckInTooEarly
+	}
+	if !today.Before(LocalDate(checkOut, loc)) {
+		return consts.ErrStayEnded
+	}
+
+	return nil
+}
+
+// CheckNoShowDay reports whether a guest who has not arrived by now can be
+// marked no-show: only once the check-in day is over in the hotel's timezone.
+func CheckNoShowDay(checkIn, now time.Time, loc *time.Location) error {
+	today := truncateDate(now.In(loc))
+	if !today.After(LocalDate(checkIn, loc)) {
+		return consts.ErrNoShowTooEarly
+	}
+
+	return nil
+}
+
+func Nights(checkIn, checkOut time.Time, loc *time.Location) (int, error) {
+	in := LocalDate(checkIn, loc)
+	out := LocalDate(checkOut, loc)
 
 	nights := int(out.Sub(in).Hours() / 24)
 	if nights <= 0 {
@@ -22,3 +62,7 @@ func Nights(checkIn, checkOut time.Time) (int, error) {
 	}
 	return nights, nil
 }
+
+func truncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
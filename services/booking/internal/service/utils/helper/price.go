@@ -12,11 +12,12 @@ import (
 func CalculateTotalAmount(
 	checkIn time.Time,
 	checkOut time.Time,
+	loc *time.Location,
 	rooms []*models.CreateBookingRoom,
 	expected decimal.Decimal,
 ) (decimal.Decimal, error) {
 
-	nights, err := Nights(checkIn, checkOut)
+	nights, err := Nights(checkIn, checkOut, loc)
 	if err != nil {
 		return decimal.Zero, err
 	}
@@ -25,7 +26,10 @@ func CalculateTotalAmount(
 	nightsDec := decimal.NewFromInt(int64(nights))
 
 	for _, room := range rooms {
-		roomTotal := room.PricePerNight.Mul(nightsDec)
+		roomTotal := room.StayAmount
+		if roomTotal.IsZero() {
+			roomTotal = room.PricePerNight.Mul(nightsDec)
+		}
 		total = total.Add(roomTotal)
 	}
 
@@ -38,3 +42,31 @@ func CalculateTotalAmount(
 
 	return total, nil
 }
+
+// NightlyPrices lists the quoted price of every night of the stay in order.
+func NightlyPrices(quote *models.StayQuote) []decimal.Decimal {
+	prices := make([]decimal.Decimal, len(quote.Nights))
+	for i, night := range quote.Nights {
+		prices[i] = night.Price
+	}
+
+	return prices
+}
+
+// FirstNightPrice is what the first night of the room's stay was quoted at;
+// rooms without a nightly breakdown fall back to their average nightly price.
+func FirstNightPrice(room *models.BookingRoomWithLock) decimal.Decimal {
+	if len(room.NightlyPrices) == 0 {
+		return room.PricePerNight
+	}
+
+	return room.NightlyPrices[0]
+}
+
+func AverageNightlyPrice(quote *models.StayQuote) decimal.Decimal {
+	if len(quote.Nights) == 0 {
+		return decimal.Zero
+	}
+
+	return quote.TotalAmount.Div(decimal.NewFromInt(int64(len(quote.Nights)))).Round(2)
+}
@@ -2,4 +2,14 @@ package consts
 
 const (
 	ExpireRoomLockMinutes = 15
+	BookingCodeAttempts   = 5
+
+	ReasonTargetDeleted    = "hotel or room was deleted"
+	ReasonPaymentSucceeded = "payment succeeded"
+	ReasonBookingCancelled = "booking cancelled"
+	ReasonNoShow           = "guest did not arrive on the check-in day"
+	ReasonHoldExpired      = "room hold expired before payment"
+	ReasonPaymentTooLate   = "payment arrived after the booking was no longer held"
+
+	PaymentSignatureHeader = "Payment-Signature"
 )
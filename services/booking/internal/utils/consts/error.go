@@ -18,6 +18,40 @@ const (
 	MsgInvalidPricePerNightID       = "invalid price per night. example: 123.45"
 	MsgInvalidExpectedTotalAmountID = "invalid expected total amount. example: 123.45"
 	MsgInternalServer               = "internal server error"
+	MsgRoomNotFound                 = "room not found"
+	MsgStayRestricted               = "stay violates hotel restrictions"
+	MsgInvalidRoomID                = "invalid room ID"
+	MsgInvalidBlockID               = "invalid block ID"
+	MsgHotelNotFound                = "hotel not found"
+	MsgCheckInPassed                = "check-in date has already passed in the hotel's timezone"
+	MsgVersionMismatch              = "booking was modified by someone else, reload it and retry"
+	MsgRoomCategoryNotFound         = "room category not found"
+	MsgInvalidRoomCategoryID        = "invalid room category ID"
+	MsgRoomCategoryUnavailable      = "no room of the category is available for these dates"
+	MsgRoomCategoryMismatch         = "room does not belong to the booked hotel or category"
+	MsgInvalidBookingTransition     = "booking status does not allow this transition"
+	MsgInvalidIdempotencyKey        = "idempotency key must be 1 to 255 characters long"
+	MsgIdempotencyKeyReused         = "idempotency key was already used with a different request"
+	MsgIdempotencyKeyInProgress     = "a request with this idempotency key is still in progress"
+	MsgBookingNotModifiable         = "booking status does not allow modifications"
+	MsgModificationWindowClosed     = "hotel policy no longer allows changing the stay"
+	MsgLastBookingRoom              = "booking must keep at least one room"
+	MsgRoomCapacityExceeded         = "guests exceed the room capacity"
+	MsgPaymentNotFound              = "payment not found"
+	MsgRefundNotFound               = "refund not found"
+	MsgInvalidPaymentID             = "invalid payment ID"
+	MsgInvalidRefundAmount          = "invalid refund amount. example: 123.45"
+	MsgBookingNotPayable            = "only pending bookings with an amount due can be paid"
+	MsgPaymentNotCapturable         = "payment is not waiting for capture"
+	MsgPaymentNotRefundable         = "only succeeded payments can be refunded"
+	MsgRefundExceedsPayment         = "refund exceeds the amount left on the payment"
+	MsgInvalidWebhookSignature      = "invalid webhook signature"
+	MsgInvalidWebhookPayload        = "invalid webhook payload"
+	MsgUnknownPaymentProvider       = "unknown payment provider"
+	MsgBookingCodeTaken             = "booking code is already taken"
+	MsgCheckInTooEarly              = "check-in day has not come yet in the hotel's timezone"
+	MsgStayEnded                    = "stay has already ended in the hotel's timezone"
+	MsgNoShowTooEarly               = "a booking can only be marked no-show after its check-in day"
 )
 
 var (
@@ -36,4 +70,38 @@ var (
 	ErrInvalidPricePerNightID       = errors.New(MsgInvalidPricePerNightID)
 	ErrInvalidExpectedTotalAmountID = errors.New(MsgInvalidExpectedTotalAmountID)
 	ErrInternalServer               = errors.New(MsgInternalServer)
+	ErrRoomNotFound                 = errors.New(MsgRoomNotFound)
+	ErrStayRestricted               = errors.New(MsgStayRestricted)
+	ErrInvalidRoomID                = errors.New(MsgInvalidRoomID)
+	ErrInvalidBlockID               = errors.New(MsgInvalidBlockID)
+	ErrHotelNotFound                = errors.New(MsgHotelNotFound)
+	ErrCheckInPassed                = errors.New(MsgCheckInPassed)
+	ErrVersionMismatch              = errors.New(MsgVersionMismatch)
+	ErrRoomCategoryNotFound         = errors.New(MsgRoomCategoryNotFound)
+	ErrInvalidRoomCategoryID        = errors.New(MsgInvalidRoomCategoryID)
+	ErrRoomCategoryUnavailable      = errors.New(MsgRoomCategoryUnavailable)
+	ErrRoomCategoryMismatch         = errors.New(MsgRoomCategoryMismatch)
+	ErrInvalidBookingTransition     = errors.New(MsgInvalidBookingTransition)
+	ErrInvalidIdempotencyKey        = errors.New(MsgInvalidIdempotencyKey)
+	ErrIdempotencyKeyReused         = errors.New(MsgIdempotencyKeyReused)
+	ErrIdempotencyKeyInProgress     = errors.New(MsgIdempotencyKeyInProgress)
+	ErrBookingNotModifiable         = errors.New(MsgBookingNotModifiable)
+	ErrModificationWindowClosed     = errors.New(MsgModificationWindowClosed)
+	ErrLastBookingRoom              = errors.New(MsgLastBookingRoom)
+	ErrRoomCapacityExceeded         = errors.New(MsgRoomCapacityExceeded)
+	ErrPaymentNotFound              = errors.New(MsgPaymentNotFound)
+	ErrRefundNotFound               = errors.New(MsgRefundNotFound)
+	ErrInvalidPaymentID             = errors.New(MsgInvalidPaymentID)
+	ErrInvalidRefundAmount          = errors.New(MsgInvalidRefundAmount)
+	ErrBookingNotPayable            = errors.New(MsgBookingNotPayable)
+	ErrPaymentNotCapturable         = errors.New(MsgPaymentNotCapturable)
+	ErrPaymentNotRefundable         = errors.New(MsgPaymentNotRefundable)
+	ErrRefundExceedsPayment         = errors.New(MsgRefundExceedsPayment)
+	ErrInvalidWebhookSignature      = errors.New(MsgInvalidWebhookSignature)
+	ErrInvalidWebhookPayload        = errors.New(MsgInvalidWebhookPayload)
+	ErrUnknownPaymentProvider       = errors.New(MsgUnknownPaymentProvider)
+	ErrBookingCodeTaken             = errors.New(MsgBookingCodeTaken)
+	ErrCheckInTooEarly              = errors.New(MsgCheckInTooEarly)
+	ErrStayEnded                    = errors.New(MsgStayEnded)
+	ErrNoShowTooEarly               = errors.New(MsgNoShowTooEarly)
 )
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE room_lock
+    ALTER COLUMN booking_id DROP NOT NULL;
+
+ALTER TABLE room_lock
+    ADD COLUMN block_id UUID UNIQUE;
+
+ALTER TABLE room_lock
+    ADD CONSTRAINT room_lock_owner
+        CHECK (num_nonnulls(booking_id, block_id) = 1);
+
+CREATE INDEX IF NOT EXISTS idx_room_lock_stay_range ON room_lock USING gist (room_id, stay_range) WHERE is_active = TRUE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_room_lock_stay_range;
+
+DELETE FROM room_lock WHERE block_id IS NOT NULL;
+
+ALTER TABLE room_lock
+    DROP CONSTRAINT IF EXISTS room_lock_owner;
+
+ALTER TABLE room_lock
+    DROP COLUMN IF EXISTS block_id;
+
+ALTER TABLE room_lock
+    ALTER COLUMN booking_id SET NOT NULL;
+-- +goose StatementEnd
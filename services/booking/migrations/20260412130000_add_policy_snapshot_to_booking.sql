@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE booking
+    ADD COLUMN policy_snapshot JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE booking
+    DROP COLUMN IF EXISTS policy_snapshot;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE booking
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE booking
+    DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
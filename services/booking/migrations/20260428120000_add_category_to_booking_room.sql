@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A booking room either names a physical room or a room category; category
+-- rooms get their room_id once a room is assigned.
+ALTER TABLE booking_room
+    ALTER COLUMN room_id DROP NOT NULL,
+    ADD COLUMN category_id UUID,
+    ADD CONSTRAINT booking_room_target_present
+        CHECK (num_nonnulls(room_id, category_id) >= 1);
+
+CREATE INDEX IF NOT EXISTS idx_booking_room_category ON booking_room(category_id)
+    WHERE category_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_booking_room_category;
+
+DELETE FROM booking_room WHERE room_id IS NULL;
+
+ALTER TABLE booking_room
+    DROP CONSTRAINT IF EXISTS booking_room_target_present,
+    DROP COLUMN IF EXISTS category_id,
+    ALTER COLUMN room_id SET NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE booking_status ADD VALUE IF NOT EXISTS 'BOOKING_STATUS_CHECKED_IN';
+ALTER TYPE booking_status ADD VALUE IF NOT EXISTS 'BOOKING_STATUS_CHECKED_OUT';
+ALTER TYPE booking_status ADD VALUE IF NOT EXISTS 'BOOKING_STATUS_EXPIRED';
+ALTER TYPE booking_status ADD VALUE IF NOT EXISTS 'BOOKING_STATUS_NO_SHOW';
+
+-- from_status is NULL for the creation of the booking, actor_id for
+-- transitions made by the system.
+CREATE TABLE IF NOT EXISTS booking_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    booking_id UUID NOT NULL REFERENCES booking(id) ON DELETE CASCADE,
+    from_status booking_status,
+    to_status booking_status NOT NULL,
+    actor_id BIGINT,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_status_history_booking_id
+    ON booking_status_history(booking_id, created_at);
+
+-- Cancelled bookings used to keep their locks active; release them so the
+-- rooms can be booked again.
+UPDATE room_lock rl
+SET is_active = FALSE
+FROM booking b
+WHERE b.id = rl.booking_id
+  AND b.status = 'BOOKING_STATUS_CANCELLED';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_booking_status_history_booking_id;
+
+DROP TABLE IF EXISTS booking_status_history;
+
+-- Enum values cannot be dropped; bookings in the new statuses are left as they are.
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A key without a response belongs to a request that is still running.
+CREATE TABLE IF NOT EXISTS idempotency_key (
+    key TEXT PRIMARY KEY,
+    method TEXT NOT NULL,
+    fingerprint BYTEA NOT NULL,
+    response BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_key_expires ON idempotency_key(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_idempotency_key_expires;
+
+DROP TABLE IF EXISTS idempotency_key;
+-- +goose StatementEnd
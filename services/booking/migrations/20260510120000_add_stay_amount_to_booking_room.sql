@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- stay_amount is the quoted price of the whole stay, so the booking total can
+-- be recomputed exactly after its rooms or dates change.
+ALTER TABLE booking_room
+    ADD COLUMN stay_amount NUMERIC(12,2) NOT NULL DEFAULT 0 CHECK (stay_amount >= 0);
+
+UPDATE booking_room br
+SET stay_amount = br.price_per_night * (b.check_out - b.check_in)
+FROM booking b
+WHERE b.id = br.booking_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE booking_room
+    DROP COLUMN IF EXISTS stay_amount;
+-- +goose StatementEnd
@@ -0,0 +1,76 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE payment_status AS ENUM ('PAYMENT_STATUS_UNSPECIFIED',
+                                    'PAYMENT_STATUS_PENDING',
+                                    'PAYMENT_STATUS_SUCCEEDED',
+                                    'PAYMENT_STATUS_FAILED');
+
+CREATE TYPE refund_status AS ENUM ('REFUND_STATUS_UNSPECIFIED',
+                                   'REFUND_STATUS_PENDING',
+                                   'REFUND_STATUS_SUCCEEDED',
+                                   'REFUND_STATUS_FAILED');
+
+-- provider_payment_id is the intent id at the payment provider; a booking has
+-- at most one payment waiting for the guest at a time.
+CREATE TABLE IF NOT EXISTS payment (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    booking_id UUID NOT NULL REFERENCES booking(id) ON DELETE CASCADE,
+    provider TEXT NOT NULL,
+    provider_payment_id TEXT NOT NULL,
+    client_secret TEXT NOT NULL,
+    status payment_status NOT NULL DEFAULT 'PAYMENT_STATUS_PENDING',
+    amount NUMERIC(12,2) NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+    captured_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (provider, provider_payment_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_booking ON payment(booking_id, created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_booking_pending ON payment(booking_id)
+    WHERE status = 'PAYMENT_STATUS_PENDING';
+
+CREATE TRIGGER update_payment_updated_at
+    BEFORE UPDATE ON payment
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+-- provider_refund_id is NULL until the provider has accepted the refund.
+CREATE TABLE IF NOT EXISTS refund (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id UUID NOT NULL REFERENCES payment(id) ON DELETE CASCADE,
+    provider_refund_id TEXT,
+    status refund_status NOT NULL DEFAULT 'REFUND_STATUS_PENDING',
+    amount NUMERIC(12,2) NOT NULL CHECK (amount > 0),
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_refund_payment ON refund(payment_id, created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refund_provider ON refund(provider_refund_id)
+    WHERE provider_refund_id IS NOT NULL;
+
+CREATE TRIGGER update_refund_updated_at
+    BEFORE UPDATE ON refund
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_refund_updated_at ON refund;
+DROP INDEX IF EXISTS idx_refund_provider;
+DROP INDEX IF EXISTS idx_refund_payment;
+DROP TABLE IF EXISTS refund;
+
+DROP TRIGGER IF EXISTS update_payment_updated_at ON payment;
+DROP INDEX IF EXISTS idx_payment_booking_pending;
+DROP INDEX IF EXISTS idx_payment_booking;
+DROP TABLE IF EXISTS payment;
+
+DROP TYPE IF EXISTS refund_status;
+DROP TYPE IF EXISTS payment_status;
+-- +goose StatementEnd
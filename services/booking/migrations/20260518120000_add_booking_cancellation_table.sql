@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- fee is the penalty of the cancellation policy; the hotel keeps the paid part
+-- of it as retained_amount and refund_amount goes back to the guest.
+CREATE TABLE IF NOT EXISTS booking_cancellation (
+    booking_id UUID PRIMARY KEY REFERENCES booking(id) ON DELETE CASCADE,
+    penalty TEXT NOT NULL,
+    fee NUMERIC(12,2) NOT NULL CHECK (fee >= 0),
+    paid_amount NUMERIC(12,2) NOT NULL CHECK (paid_amount >= 0),
+    retained_amount NUMERIC(12,2) NOT NULL CHECK (retained_amount >= 0),
+    refund_amount NUMERIC(12,2) NOT NULL CHECK (refund_amount >= 0),
+    currency CHAR(3) NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CHECK (retained_amount + refund_amount = paid_amount)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS booking_cancellation;
+-- +goose StatementEnd
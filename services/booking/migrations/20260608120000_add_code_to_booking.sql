@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+-- random_booking_code draws 8 characters from the Crockford base32 alphabet,
+-- which leaves out I, L, O and U; it only backfills existing bookings, new
+-- codes are generated by the service.
+CREATE OR REPLACE FUNCTION random_booking_code()
+    RETURNS TEXT AS $$
+DECLARE
+    alphabet CONSTANT TEXT := '0123456789ABCDEFGHJKMNPQRSTVWXYZ';
+    bytes BYTEA := gen_random_bytes(8);
+    code TEXT := '';
+BEGIN
+    FOR i IN 0..7 LOOP
+        code := code || substr(alphabet, 1 + get_byte(bytes, i) % 32, 1);
+    END LOOP;
+    RETURN code;
+END;
+$$ language 'plpgsql' VOLATILE;
+
+ALTER TABLE booking
+    ADD COLUMN code TEXT;
+
+UPDATE booking
+SET code = random_booking_code();
+
+ALTER TABLE booking
+    ALTER COLUMN code SET NOT NULL,
+    ADD CONSTRAINT booking_code_check CHECK (code ~ '^[0-9A-HJKMNP-TV-Z]{8}$');
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_booking_code ON booking(code);
+
+DROP FUNCTION random_booking_code();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_booking_code;
+
+ALTER TABLE booking
+    DROP COLUMN IF EXISTS code;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- nightly_prices is the quoted price of every night of the stay in order, so
+-- penalties charging a single night use that night's price rather than the
+-- average in price_per_night.
+ALTER TABLE booking_room
+    ADD COLUMN nightly_prices NUMERIC(10,2)[] NOT NULL DEFAULT '{}';
+
+UPDATE booking_room br
+SET nightly_prices = array_fill(br.price_per_night, ARRAY[b.check_out - b.check_in])
+FROM booking b
+WHERE b.id = br.booking_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE booking_room
+    DROP COLUMN IF EXISTS nightly_prices;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keys are only unique per method and caller, and a pending key is held by
+-- holder until locked_until: a request whose server died mid-way stops
+-- blocking retries once its lease runs out.
+ALTER TABLE idempotency_key
+    ADD COLUMN caller TEXT NOT NULL DEFAULT '',
+    ADD COLUMN holder UUID NOT NULL DEFAULT gen_random_uuid(),
+    ADD COLUMN locked_until TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+ALTER TABLE idempotency_key
+    ALTER COLUMN caller DROP DEFAULT,
+    ALTER COLUMN holder DROP DEFAULT,
+    ALTER COLUMN locked_until DROP DEFAULT,
+    DROP CONSTRAINT idempotency_key_pkey,
+    ADD PRIMARY KEY (method, caller, key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM idempotency_key a
+USING idempotency_key b
+WHERE a.key = b.key AND a.ctid < b.ctid;
+
+ALTER TABLE idempotency_key
+    DROP CONSTRAINT idempotency_key_pkey,
+    ADD PRIMARY KEY (key),
+    DROP COLUMN locked_until,
+    DROP COLUMN holder,
+    DROP COLUMN caller;
+-- +goose StatementEnd
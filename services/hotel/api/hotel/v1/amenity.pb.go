@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/models/amenity.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Amenity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Category      AmenityCategory        `protobuf:"varint,2,opt,name=category,proto3,enum=hotel.v1.AmenityCategory" json:"category,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Names         map[string]string      `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Amenity) Reset() {
+	*x = Amenity{}
+	mi := &file_hotel_v1_models_amenity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Amenity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Amenity) ProtoMessage() {}
+
+func (x *Amenity) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_amenity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Amenity.ProtoReflect.Descriptor instead.
+func (*Amenity) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_amenity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Amenity) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Amenity) GetCategory() AmenityCategory {
+	if x != nil {
+		return x.Category
+	}
+	return AmenityCategory_AMENITY_CATEGORY_UNSPECIFIED
+}
+
+func (x *Amenity) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Amenity) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *Amenity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Amenity) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_hotel_v1_models_amenity_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_models_amenity_proto_rawDesc = "" +
+	"\n" +
+	"\x1dhotel/v1/models/amenity.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%hotel/v1/enums/amenity_category.proto\"\xcc\x02\n" +
+	"\aAmenity\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x125\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x19.hotel.v1.AmenityCategoryR\bcategory\x12\x12\n" +
+	"\x04icon\x18\x03 \x01(\tR\x04icon\x122\n" +
+	"\x05names\x18\x04 \x03(\v2\x1c.hotel.v1.Amenity.NamesEntryR\x05names\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a8\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_models_amenity_proto_rawDescOnce sync.Once
+	file_hotel_v1_models_amenity_proto_rawDescData []byte
+)
+
+func file_hotel_v1_models_amenity_proto_rawDescGZIP() []byte {
+	file_hotel_v1_models_amenity_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_models_amenity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_models_amenity_proto_rawDesc), len(file_hotel_v1_models_amenity_proto_rawDesc)))
+	})
+	return file_hotel_v1_models_amenity_proto_rawDescData
+}
+
+var file_hotel_v1_models_amenity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_models_amenity_proto_goTypes = []any{
+	(*Amenity)(nil),               // 0: hotel.v1.Amenity
+	nil,                           // 1: hotel.v1.Amenity.NamesEntry
+	(AmenityCategory)(0),          // 2: hotel.v1.AmenityCategory
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_hotel_v1_models_amenity_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.Amenity.category:type_name -> hotel.v1.AmenityCategory
+	1, // 1: hotel.v1.Amenity.names:type_name -> hotel.v1.Amenity.NamesEntry
+	3, // 2: hotel.v1.Amenity.created_at:type_name -> google.protobuf.Timestamp
+	3, // 3: hotel.v1.Amenity.updated_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_models_amenity_proto_init() }
+func file_hotel_v1_models_amenity_proto_init() {
+	if File_hotel_v1_models_amenity_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_amenity_category_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_models_amenity_proto_rawDesc), len(file_hotel_v1_models_amenity_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_models_amenity_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_models_amenity_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_models_amenity_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_models_amenity_proto = out.File
+	file_hotel_v1_models_amenity_proto_goTypes = nil
+	file_hotel_v1_models_amenity_proto_depIdxs = nil
+}
@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/enums/amenity_category.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AmenityCategory int32
+
+const (
+	AmenityCategory_AMENITY_CATEGORY_UNSPECIFIED    AmenityCategory = 0
+	AmenityCategory_AMENITY_CATEGORY_GENERAL        AmenityCategory = 1
+	AmenityCategory_AMENITY_CATEGORY_INTERNET       AmenityCategory = 2
+	AmenityCategory_AMENITY_CATEGORY_BATHROOM       AmenityCategory = 3
+	AmenityCategory_AMENITY_CATEGORY_KITCHEN        AmenityCategory = 4
+	AmenityCategory_AMENITY_CATEGORY_FOOD_AND_DRINK AmenityCategory = 5
+	AmenityCategory_AMENITY_CATEGORY_ENTERTAINMENT  AmenityCategory = 6
+	AmenityCategory_AMENITY_CATEGORY_WELLNESS       AmenityCategory = 7
+	AmenityCategory_AMENITY_CATEGORY_PARKING        AmenityCategory = 8
+	AmenityCategory_AMENITY_CATEGORY_ACCESSIBILITY  AmenityCategory = 9
+	AmenityCategory_AMENITY_CATEGORY_OTHER          AmenityCategory = 10
+)
+
+// Enum value maps for AmenityCategory.
+var (
+	AmenityCategory_name = map[int32]string{
+		0:  "AMENITY_CATEGORY_UNSPECIFIED",
+		1:  "AMENITY_CATEGORY_GENERAL",
+		2:  "AMENITY_CATEGORY_INTERNET",
+		3:  "AMENITY_CATEGORY_BATHROOM",
+		4:  "AMENITY_CATEGORY_KITCHEN",
+		5:  "AMENITY_CATEGORY_FOOD_AND_DRINK",
+		6:  "AMENITY_CATEGORY_ENTERTAINMENT",
+		7:  "AMENITY_CATEGORY_WELLNESS",
+		8:  "AMENITY_CATEGORY_PARKING",
+		9:  "AMENITY_CATEGORY_ACCESSIBILITY",
+		10: "AMENITY_CATEGORY_OTHER",
+	}
+	AmenityCategory_value = map[string]int32{
+		"AMENITY_CATEGORY_UNSPECIFIED":    0,
+		"AMENITY_CATEGORY_GENERAL":        1,
+		"AMENITY_CATEGORY_INTERNET":       2,
+		"AMENITY_CATEGORY_BATHROOM":       3,
+		"AMENITY_CATEGORY_KITCHEN":        4,
+		"AMENITY_CATEGORY_FOOD_AND_DRINK": 5,
+		"AMENITY_CATEGORY_ENTERTAINMENT":  6,
+		"AMENITY_CATEGORY_WELLNESS":       7,
+		"AMENITY_CATEGORY_PARKING":        8,
+		"AMENITY_CATEGORY_ACCESSIBILITY":  9,
+		"AMENITY_CATEGORY_OTHER":          10,
+	}
+)
+
+func (x AmenityCategory) Enum() *AmenityCategory {
+	p := new(AmenityCategory)
+	*p = x
+	return p
+}
+
+func (x AmenityCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AmenityCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_hotel_v1_enums_amenity_category_proto_enumTypes[0].Descriptor()
+}
+
+func (AmenityCategory) Type() protoreflect.EnumType {
+	return &file_hotel_v1_enums_amenity_category_proto_enumTypes[0]
+}
+
+func (x AmenityCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AmenityCategory.Descriptor instead.
+func (AmenityCategory) EnumDescriptor() ([]byte, []int) {
+	return file_hotel_v1_enums_amenity_category_proto_rawDescGZIP(), []int{0}
+}
+
+var File_hotel_v1_enums_amenity_category_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_enums_amenity_category_proto_rawDesc = "" +
+	"\n" +
+	"%hotel/v1/enums/amenity_category.proto\x12\bhotel.v1*\xf3\x02\n" +
+	"\x0fAmenityCategory\x12 \n" +
+	"\x1cAMENITY_CATEGORY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18AMENITY_CATEGORY_GENERAL\x10\x01\x12\x1d\n" +
+	"\x19AMENITY_CATEGORY_INTERNET\x10\x02\x12\x1d\n" +
+	"\x19AMENITY_CATEGORY_BATHROOM\x10\x03\x12\x1c\n" +
+	"\x18AMENITY_CATEGORY_KITCHEN\x10\x04\x12#\n" +
+	"\x1fAMENITY_CATEGORY_FOOD_AND_DRINK\x10\x05\x12\"\n" +
+	"\x1eAMENITY_CATEGORY_ENTERTAINMENT\x10\x06\x12\x1d\n" +
+	"\x19AMENITY_CATEGORY_WELLNESS\x10\a\x12\x1c\n" +
+	"\x18AMENITY_CATEGORY_PARKING\x10\b\x12\"\n" +
+	"\x1eAMENITY_CATEGORY_ACCESSIBILITY\x10\t\x12\x1a\n" +
+	"\x16AMENITY_CATEGORY_OTHER\x10\n" +
+	"B\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_enums_amenity_category_proto_rawDescOnce sync.Once
+	file_hotel_v1_enums_amenity_category_proto_rawDescData []byte
+)
+
+func file_hotel_v1_enums_amenity_category_proto_rawDescGZIP() []byte {
+	file_hotel_v1_enums_amenity_category_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_enums_amenity_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_enums_amenity_category_proto_rawDesc), len(file_hotel_v1_enums_amenity_category_proto_rawDesc)))
+	})
+	return file_hotel_v1_enums_amenity_category_proto_rawDescData
+}
+
+var file_hotel_v1_enums_amenity_category_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_hotel_v1_enums_amenity_category_proto_goTypes = []any{
+	(AmenityCategory)(0), // 0: hotel.v1.AmenityCategory
+}
+var file_hotel_v1_enums_amenity_category_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_enums_amenity_category_proto_init() }
+func file_hotel_v1_enums_amenity_category_proto_init() {
+	if File_hotel_v1_enums_amenity_category_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_enums_amenity_category_proto_rawDesc), len(file_hotel_v1_enums_amenity_category_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_enums_amenity_category_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_enums_amenity_category_proto_depIdxs,
+		EnumInfos:         file_hotel_v1_enums_amenity_category_proto_enumTypes,
+	}.Build()
+	File_hotel_v1_enums_amenity_category_proto = out.File
+	file_hotel_v1_enums_amenity_category_proto_goTypes = nil
+	file_hotel_v1_enums_amenity_category_proto_depIdxs = nil
+}
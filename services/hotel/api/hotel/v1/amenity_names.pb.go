@@ -0,0 +1,130 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/amenity/amenity_names.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AmenityNames struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         map[string]string      `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AmenityNames) Reset() {
+	*x = AmenityNames{}
+	mi := &file_hotel_v1_rpc_amenity_amenity_names_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmenityNames) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmenityNames) ProtoMessage() {}
+
+func (x *AmenityNames) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_amenity_amenity_names_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmenityNames.ProtoReflect.Descriptor instead.
+func (*AmenityNames) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_amenity_amenity_names_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AmenityNames) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_amenity_amenity_names_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_amenity_amenity_names_proto_rawDesc = "" +
+	"\n" +
+	"(hotel/v1/rpc/amenity/amenity_names.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"\x84\x02\n" +
+	"\fAmenityNames\x12e\n" +
+	"\x05names\x18\x01 \x03(\v2!.hotel.v1.AmenityNames.NamesEntryB,\xbaH)\x9a\x01&\b\x01\"\x1ar\x182\x16^[a-z]{2}(-[A-Z]{2})?$*\x06r\x04\x10\x01\x18dR\x05names\x1a8\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:S\xbaHP\x1aN\n" +
+	"\x10amenity.names.en\x12&names must contain an 'en' translation\x1a\x12'en' in this.namesB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_amenity_amenity_names_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_amenity_amenity_names_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_amenity_amenity_names_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_amenity_amenity_names_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_amenity_amenity_names_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_amenity_amenity_names_proto_rawDesc), len(file_hotel_v1_rpc_amenity_amenity_names_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_amenity_amenity_names_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_amenity_amenity_names_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_amenity_amenity_names_proto_goTypes = []any{
+	(*AmenityNames)(nil), // 0: hotel.v1.AmenityNames
+	nil,                  // 1: hotel.v1.AmenityNames.NamesEntry
+}
+var file_hotel_v1_rpc_amenity_amenity_names_proto_depIdxs = []int32{
+	1, // 0: hotel.v1.AmenityNames.names:type_name -> hotel.v1.AmenityNames.NamesEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_amenity_amenity_names_proto_init() }
+func file_hotel_v1_rpc_amenity_amenity_names_proto_init() {
+	if File_hotel_v1_rpc_amenity_amenity_names_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_amenity_amenity_names_proto_rawDesc), len(file_hotel_v1_rpc_amenity_amenity_names_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_amenity_amenity_names_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_amenity_amenity_names_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_amenity_amenity_names_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_amenity_amenity_names_proto = out.File
+	file_hotel_v1_rpc_amenity_amenity_names_proto_goTypes = nil
+	file_hotel_v1_rpc_amenity_amenity_names_proto_depIdxs = nil
+}
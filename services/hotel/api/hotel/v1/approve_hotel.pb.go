@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/hotel_moderation/approve_hotel.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApproveHotelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CitySlug      string                 `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
+	HotelSlug     string                 `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveHotelRequest) Reset() {
+	*x = ApproveHotelRequest{}
+	mi := &file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveHotelRequest) ProtoMessage() {}
+
+func (x *ApproveHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveHotelRequest.ProtoReflect.Descriptor instead.
+func (*ApproveHotelRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApproveHotelRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *ApproveHotelRequest) GetCitySlug() string {
+	if x != nil {
+		return x.CitySlug
+	}
+	return ""
+}
+
+func (x *ApproveHotelRequest) GetHotelSlug() string {
+	if x != nil {
+		return x.HotelSlug
+	}
+	return ""
+}
+
+type ApproveHotelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transition    *HotelStatusTransition `protobuf:"bytes,1,opt,name=transition,proto3" json:"transition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveHotelResponse) Reset() {
+	*x = ApproveHotelResponse{}
+	mi := &file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveHotelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveHotelResponse) ProtoMessage() {}
+
+func (x *ApproveHotelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveHotelResponse.ProtoReflect.Descriptor instead.
+func (*ApproveHotelResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApproveHotelResponse) GetTransition() *HotelStatusTransition {
+	if x != nil {
+		return x.Transition
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_hotel_moderation_approve_hotel_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDesc = "" +
+	"\n" +
+	"1hotel/v1/rpc/hotel_moderation/approve_hotel.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a&hotel/v1/models/hotel_moderation.proto\"\xeb\x01\n" +
+	"\x13ApproveHotelRequest\x124\n" +
+	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[a-z]{2}$R\vcountryCode\x12<\n" +
+	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
+	"\n" +
+	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlugJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\bactor_idR\n" +
+	"actor_role\"W\n" +
+	"\x14ApproveHotelResponse\x12?\n" +
+	"\n" +
+	"transition\x18\x01 \x01(\v2\x1f.hotel.v1.HotelStatusTransitionR\n" +
+	"transitionB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDesc), len(file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_goTypes = []any{
+	(*ApproveHotelRequest)(nil),   // 0: hotel.v1.ApproveHotelRequest
+	(*ApproveHotelResponse)(nil),  // 1: hotel.v1.ApproveHotelResponse
+	(*HotelStatusTransition)(nil), // 2: hotel.v1.HotelStatusTransition
+}
+var file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.ApproveHotelResponse.transition:type_name -> hotel.v1.HotelStatusTransition
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_init() }
+func file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_init() {
+	if File_hotel_v1_rpc_hotel_moderation_approve_hotel_proto != nil {
+		return
+	}
+	file_hotel_v1_models_hotel_moderation_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDesc), len(file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_hotel_moderation_approve_hotel_proto = out.File
+	file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_goTypes = nil
+	file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_depIdxs = nil
+}
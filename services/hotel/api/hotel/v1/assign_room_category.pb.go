@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/room_category/assign_room_category.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AssignRoomCategoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RoomId          string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CategoryId      *string                `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AssignRoomCategoryRequest) Reset() {
+	*x = AssignRoomCategoryRequest{}
+	mi := &file_hotel_v1_rpc_room_category_assign_room_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoomCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoomCategoryRequest) ProtoMessage() {}
+
+func (x *AssignRoomCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_category_assign_room_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoomCategoryRequest.ProtoReflect.Descriptor instead.
+func (*AssignRoomCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AssignRoomCategoryRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *AssignRoomCategoryRequest) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
+func (x *AssignRoomCategoryRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type AssignRoomCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRoomCategoryResponse) Reset() {
+	*x = AssignRoomCategoryResponse{}
+	mi := &file_hotel_v1_rpc_room_category_assign_room_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoomCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoomCategoryResponse) ProtoMessage() {}
+
+func (x *AssignRoomCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_category_assign_room_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRoomCategoryResponse.ProtoReflect.Descriptor instead.
+func (*AssignRoomCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AssignRoomCategoryResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+var File_hotel_v1_rpc_room_category_assign_room_category_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDesc = "" +
+	"\n" +
+	"5hotel/v1/rpc/room_category/assign_room_category.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"\xcc\x01\n" +
+	"\x19AssignRoomCategoryRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12.\n" +
+	"\vcategory_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\n" +
+	"categoryId\x88\x01\x01\x127\n" +
+	"\x10expected_version\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x01R\x0fexpectedVersion\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\x13\n" +
+	"\x11_expected_version\"6\n" +
+	"\x1aAssignRoomCategoryResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversionB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDesc), len(file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_room_category_assign_room_category_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_room_category_assign_room_category_proto_goTypes = []any{
+	(*AssignRoomCategoryRequest)(nil),  // 0: hotel.v1.AssignRoomCategoryRequest
+	(*AssignRoomCategoryResponse)(nil), // 1: hotel.v1.AssignRoomCategoryResponse
+}
+var file_hotel_v1_rpc_room_category_assign_room_category_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_room_category_assign_room_category_proto_init() }
+func file_hotel_v1_rpc_room_category_assign_room_category_proto_init() {
+	if File_hotel_v1_rpc_room_category_assign_room_category_proto != nil {
+		return
+	}
+	file_hotel_v1_rpc_room_category_assign_room_category_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDesc), len(file_hotel_v1_rpc_room_category_assign_room_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_room_category_assign_room_category_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_room_category_assign_room_category_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_room_category_assign_room_category_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_room_category_assign_room_category_proto = out.File
+	file_hotel_v1_rpc_room_category_assign_room_category_proto_goTypes = nil
+	file_hotel_v1_rpc_room_category_assign_room_category_proto_depIdxs = nil
+}
@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/stay_restriction/check_stay.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CheckStayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CheckIn       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStayRequest) Reset() {
+	*x = CheckStayRequest{}
+	mi := &file_hotel_v1_rpc_stay_restriction_check_stay_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStayRequest) ProtoMessage() {}
+
+func (x *CheckStayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_stay_restriction_check_stay_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStayRequest.ProtoReflect.Descriptor instead.
+func (*CheckStayRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CheckStayRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CheckStayRequest) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *CheckStayRequest) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+type CheckStayResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Allowed       bool                        `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Violations    []*StayRestrictionViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStayResponse) Reset() {
+	*x = CheckStayResponse{}
+	mi := &file_hotel_v1_rpc_stay_restriction_check_stay_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStayResponse) ProtoMessage() {}
+
+func (x *CheckStayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_stay_restriction_check_stay_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStayResponse.ProtoReflect.Descriptor instead.
+func (*CheckStayResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CheckStayResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckStayResponse) GetViolations() []*StayRestrictionViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_stay_restriction_check_stay_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDesc = "" +
+	"\n" +
+	".hotel/v1/rpc/stay_restriction/check_stay.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a&hotel/v1/models/stay_restriction.proto\"\x96\x02\n" +
+	"\x10CheckStayRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12=\n" +
+	"\bcheck_in\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\acheckIn\x12?\n" +
+	"\tcheck_out\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bcheckOut:_\xbaH\\\x1aZ\n" +
+	"\x16check_stay.dates.order\x12 check_out must be after check_in\x1a\x1ethis.check_out > this.check_in\"q\n" +
+	"\x11CheckStayResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12B\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\".hotel.v1.StayRestrictionViolationR\n" +
+	"violationsB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDesc), len(file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_stay_restriction_check_stay_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_stay_restriction_check_stay_proto_goTypes = []any{
+	(*CheckStayRequest)(nil),         // 0: hotel.v1.CheckStayRequest
+	(*CheckStayResponse)(nil),        // 1: hotel.v1.CheckStayResponse
+	(*timestamppb.Timestamp)(nil),    // 2: google.protobuf.Timestamp
+	(*StayRestrictionViolation)(nil), // 3: hotel.v1.StayRestrictionViolation
+}
+var file_hotel_v1_rpc_stay_restriction_check_stay_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.CheckStayRequest.check_in:type_name -> google.protobuf.Timestamp
+	2, // 1: hotel.v1.CheckStayRequest.check_out:type_name -> google.protobuf.Timestamp
+	3, // 2: hotel.v1.CheckStayResponse.violations:type_name -> hotel.v1.StayRestrictionViolation
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_stay_restriction_check_stay_proto_init() }
+func file_hotel_v1_rpc_stay_restriction_check_stay_proto_init() {
+	if File_hotel_v1_rpc_stay_restriction_check_stay_proto != nil {
+		return
+	}
+	file_hotel_v1_models_stay_restriction_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDesc), len(file_hotel_v1_rpc_stay_restriction_check_stay_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_stay_restriction_check_stay_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_stay_restriction_check_stay_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_stay_restriction_check_stay_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_stay_restriction_check_stay_proto = out.File
+	file_hotel_v1_rpc_stay_restriction_check_stay_proto_goTypes = nil
+	file_hotel_v1_rpc_stay_restriction_check_stay_proto_depIdxs = nil
+}
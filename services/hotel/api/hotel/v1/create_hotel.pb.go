@@ -83,6 +83,9 @@ type CreateHotelRequest struct {
 	Description   *string                     `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	Address       string                      `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
 	Location      *CreateHotelLocationRequest `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Timezone      *string                     `protobuf:"bytes,8,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	CheckInTime   *string                     `protobuf:"bytes,9,opt,name=check_in_time,json=checkInTime,proto3,oneof" json:"check_in_time,omitempty"`
+	CheckOutTime  *string                     `protobuf:"bytes,10,opt,name=check_out_time,json=checkOutTime,proto3,oneof" json:"check_out_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -166,6 +169,27 @@ func (x *CreateHotelRequest) GetLocation() *CreateHotelLocationRequest {
 	return nil
 }
 
+func (x *CreateHotelRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateHotelRequest) GetCheckInTime() string {
+	if x != nil && x.CheckInTime != nil {
+		return *x.CheckInTime
+	}
+	return ""
+}
+
+func (x *CreateHotelRequest) GetCheckOutTime() string {
+	if x != nil && x.CheckOutTime != nil {
+		return *x.CheckOutTime
+	}
+	return ""
+}
+
 type CreateHotelResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hotel         *CreateHotel           `protobuf:"bytes,1,opt,name=hotel,proto3" json:"hotel,omitempty"`
@@ -221,7 +245,7 @@ const file_hotel_v1_rpc_hotel_create_hotel_proto_rawDesc = "" +
 	"\x1d\x00\x00\xb4B-\x00\x00\xb4\xc2R\blatitude\x12-\n" +
 	"\tlongitude\x18\x02 \x01(\x02B\x0f\xbaH\f\n" +
 	"\n" +
-	"\x1d\x00\x004C-\x00\x004\xc3R\tlongitude\"\xec\x02\n" +
+	"\x1d\x00\x004C-\x00\x004\xc3R\tlongitude\"\xee\x04\n" +
 	"\x12CreateHotelRequest\x124\n" +
 	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
 	"^[a-z]{2}$R\vcountryCode\x12<\n" +
@@ -230,8 +254,15 @@ const file_hotel_v1_rpc_hotel_create_hotel_proto_rawDesc = "" +
 	"\bowner_id\x18\x04 \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\aownerId\x12%\n" +
 	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01\x12 \n" +
 	"\aaddress\x18\x06 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aaddress\x12H\n" +
-	"\blocation\x18\a \x01(\v2$.hotel.v1.CreateHotelLocationRequestB\x06\xbaH\x03\xc8\x01\x01R\blocationB\x0e\n" +
-	"\f_description\"B\n" +
+	"\blocation\x18\a \x01(\v2$.hotel.v1.CreateHotelLocationRequestB\x06\xbaH\x03\xc8\x01\x01R\blocation\x12*\n" +
+	"\btimezone\x18\b \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@H\x01R\btimezone\x88\x01\x01\x12O\n" +
+	"\rcheck_in_time\x18\t \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$H\x02R\vcheckInTime\x88\x01\x01\x12Q\n" +
+	"\x0echeck_out_time\x18\n" +
+	" \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$H\x03R\fcheckOutTime\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_timezoneB\x10\n" +
+	"\x0e_check_in_timeB\x11\n" +
+	"\x0f_check_out_time\"B\n" +
 	"\x13CreateHotelResponse\x12+\n" +
 	"\x05hotel\x18\x01 \x01(\v2\x15.hotel.v1.CreateHotelR\x05hotelB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
 
@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/rate_plan/create_rate_plan.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateRatePlanRequest struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	CountryCode      string                    `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CitySlug         string                    `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
+	HotelSlug        string                    `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	RoomId           *string                   `protobuf:"bytes,4,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	RoomType         RoomType                  `protobuf:"varint,5,opt,name=room_type,json=roomType,proto3,enum=hotel.v1.RoomType" json:"room_type,omitempty"`
+	Title            string                    `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	StartDate        *timestamppb.Timestamp    `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate          *timestamppb.Timestamp    `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Price            string                    `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	WeekdayModifiers []*WeekdayModifierRequest `protobuf:"bytes,10,rep,name=weekday_modifiers,json=weekdayModifiers,proto3" json:"weekday_modifiers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateRatePlanRequest) Reset() {
+	*x = CreateRatePlanRequest{}
+	mi := &file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRatePlanRequest) ProtoMessage() {}
+
+func (x *CreateRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRatePlanRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *CreateRatePlanRequest) GetCitySlug() string {
+	if x != nil {
+		return x.CitySlug
+	}
+	return ""
+}
+
+func (x *CreateRatePlanRequest) GetHotelSlug() string {
+	if x != nil {
+		return x.HotelSlug
+	}
+	return ""
+}
+
+func (x *CreateRatePlanRequest) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateRatePlanRequest) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNSPECIFIED
+}
+
+func (x *CreateRatePlanRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateRatePlanRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateRatePlanRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateRatePlanRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *CreateRatePlanRequest) GetWeekdayModifiers() []*WeekdayModifierRequest {
+	if x != nil {
+		return x.WeekdayModifiers
+	}
+	return nil
+}
+
+type CreateRatePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatePlan      *RatePlan              `protobuf:"bytes,1,opt,name=rate_plan,json=ratePlan,proto3" json:"rate_plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRatePlanResponse) Reset() {
+	*x = CreateRatePlanResponse{}
+	mi := &file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRatePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRatePlanResponse) ProtoMessage() {}
+
+func (x *CreateRatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRatePlanResponse.ProtoReflect.Descriptor instead.
+func (*CreateRatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRatePlanResponse) GetRatePlan() *RatePlan {
+	if x != nil {
+		return x.RatePlan
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_rate_plan_create_rate_plan_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDesc = "" +
+	"\n" +
+	"-hotel/v1/rpc/rate_plan/create_rate_plan.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1ehotel/v1/enums/room_type.proto\x1a\x1fhotel/v1/models/rate_plan.proto\x1a-hotel/v1/rpc/rate_plan/weekday_modifier.proto\"\xad\x06\n" +
+	"\x15CreateRatePlanRequest\x124\n" +
+	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[a-z]{2}$R\vcountryCode\x12<\n" +
+	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
+	"\n" +
+	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\x12&\n" +
+	"\aroom_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06roomId\x88\x01\x01\x12/\n" +
+	"\troom_type\x18\x05 \x01(\x0e2\x12.hotel.v1.RoomTypeR\broomType\x12\x1f\n" +
+	"\x05title\x18\x06 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x05title\x12A\n" +
+	"\n" +
+	"start_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tstartDate\x12=\n" +
+	"\bend_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\aendDate\x124\n" +
+	"\x05price\x18\t \x01(\tB\x1e\xbaH\x1br\x192\x17^[0-9]+(\\.[0-9]{1,2})?$R\x05price\x12W\n" +
+	"\x11weekday_modifiers\x18\n" +
+	" \x03(\v2 .hotel.v1.WeekdayModifierRequestB\b\xbaH\x05\x92\x01\x02\x10\aR\x10weekdayModifiers:\xc8\x01\xbaH\xc4\x01\x1ae\n" +
+	"\x10rate_plan.target\x12'either room_id or room_type must be set\x1a(has(this.room_id) || this.room_type != 0\x1a[\n" +
+	"\x15rate_plan.dates.order\x12!end_date must be after start_date\x1a\x1fthis.end_date > this.start_dateB\n" +
+	"\n" +
+	"\b_room_id\"I\n" +
+	"\x16CreateRatePlanResponse\x12/\n" +
+	"\trate_plan\x18\x01 \x01(\v2\x12.hotel.v1.RatePlanR\bratePlanB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDesc), len(file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_goTypes = []any{
+	(*CreateRatePlanRequest)(nil),  // 0: hotel.v1.CreateRatePlanRequest
+	(*CreateRatePlanResponse)(nil), // 1: hotel.v1.CreateRatePlanResponse
+	(RoomType)(0),                  // 2: hotel.v1.RoomType
+	(*timestamppb.Timestamp)(nil),  // 3: google.protobuf.Timestamp
+	(*WeekdayModifierRequest)(nil), // 4: hotel.v1.WeekdayModifierRequest
+	(*RatePlan)(nil),               // 5: hotel.v1.RatePlan
+}
+var file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.CreateRatePlanRequest.room_type:type_name -> hotel.v1.RoomType
+	3, // 1: hotel.v1.CreateRatePlanRequest.start_date:type_name -> google.protobuf.Timestamp
+	3, // 2: hotel.v1.CreateRatePlanRequest.end_date:type_name -> google.protobuf.Timestamp
+	4, // 3: hotel.v1.CreateRatePlanRequest.weekday_modifiers:type_name -> hotel.v1.WeekdayModifierRequest
+	5, // 4: hotel.v1.CreateRatePlanResponse.rate_plan:type_name -> hotel.v1.RatePlan
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_init() }
+func file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_init() {
+	if File_hotel_v1_rpc_rate_plan_create_rate_plan_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_room_type_proto_init()
+	file_hotel_v1_models_rate_plan_proto_init()
+	file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_init()
+	file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDesc), len(file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_rate_plan_create_rate_plan_proto = out.File
+	file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_goTypes = nil
+	file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_depIdxs = nil
+}
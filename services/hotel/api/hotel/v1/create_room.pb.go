@@ -210,7 +210,7 @@ var File_hotel_v1_rpc_room_create_room_proto protoreflect.FileDescriptor
 
 const file_hotel_v1_rpc_room_create_room_proto_rawDesc = "" +
 	"\n" +
-	"#hotel/v1/rpc/room/create_room.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ehotel/v1/enums/room_type.proto\x1a\x1ahotel/v1/models/room.proto\"\xae\x04\n" +
+	"#hotel/v1/rpc/room/create_room.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ehotel/v1/enums/room_type.proto\x1a\x1ahotel/v1/models/room.proto\"\xd3\x04\n" +
 	"\x11CreateRoomRequest\x124\n" +
 	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
 	"^[a-z]{2}$R\vcountryCode\x12<\n" +
@@ -226,8 +226,8 @@ const file_hotel_v1_rpc_room_create_room_proto_rawDesc = "" +
 	"\bcapacity\x18\t \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\bcapacity\x12!\n" +
 	"\barea_sqm\x18\n" +
 	" \x01(\x02B\x06\xbaH\x03\xc8\x01\x01R\aareaSqm\x12\x1c\n" +
-	"\x05floor\x18\v \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05floor\x12\x1c\n" +
-	"\tamenities\x18\f \x03(\tR\tamenities\x12\x16\n" +
+	"\x05floor\x18\v \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05floor\x12A\n" +
+	"\tamenities\x18\f \x03(\tB#\xbaH \x92\x01\x1d\x102\x18\x01\"\x17r\x15\x18@2\x11^[a-z][a-z0-9_]*$R\tamenities\x12\x16\n" +
 	"\x06images\x18\r \x03(\tR\x06imagesB\x0e\n" +
 	"\f_description\"8\n" +
 	"\x12CreateRoomResponse\x12\"\n" +
@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/room_block/create_room_block.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateRoomBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Reason        RoomBlockReason        `protobuf:"varint,4,opt,name=reason,proto3,enum=hotel.v1.RoomBlockReason" json:"reason,omitempty"`
+	Note          *string                `protobuf:"bytes,5,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomBlockRequest) Reset() {
+	*x = CreateRoomBlockRequest{}
+	mi := &file_hotel_v1_rpc_room_block_create_room_block_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomBlockRequest) ProtoMessage() {}
+
+func (x *CreateRoomBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_block_create_room_block_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomBlockRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomBlockRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_block_create_room_block_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRoomBlockRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateRoomBlockRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateRoomBlockRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateRoomBlockRequest) GetReason() RoomBlockReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RoomBlockReason_ROOM_BLOCK_REASON_UNSPECIFIED
+}
+
+func (x *CreateRoomBlockRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type CreateRoomBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomBlock     *RoomBlock             `protobuf:"bytes,1,opt,name=room_block,json=roomBlock,proto3" json:"room_block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomBlockResponse) Reset() {
+	*x = CreateRoomBlockResponse{}
+	mi := &file_hotel_v1_rpc_room_block_create_room_block_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomBlockResponse) ProtoMessage() {}
+
+func (x *CreateRoomBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_block_create_room_block_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomBlockResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomBlockResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_block_create_room_block_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRoomBlockResponse) GetRoomBlock() *RoomBlock {
+	if x != nil {
+		return x.RoomBlock
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_room_block_create_room_block_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_room_block_create_room_block_proto_rawDesc = "" +
+	"\n" +
+	"/hotel/v1/rpc/room_block/create_room_block.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a&hotel/v1/enums/room_block_reason.proto\x1a hotel/v1/models/room_block.proto\"\x87\x03\n" +
+	"\x16CreateRoomBlockRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12A\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tstartDate\x12=\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\aendDate\x129\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x19.hotel.v1.RoomBlockReasonB\x06\xbaH\x03\xc8\x01\x01R\x06reason\x12!\n" +
+	"\x04note\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x00R\x04note\x88\x01\x01:a\xbaH^\x1a\\\n" +
+	"\x16room_block.dates.order\x12!end_date must be after start_date\x1a\x1fthis.end_date > this.start_dateB\a\n" +
+	"\x05_note\"M\n" +
+	"\x17CreateRoomBlockResponse\x122\n" +
+	"\n" +
+	"room_block\x18\x01 \x01(\v2\x13.hotel.v1.RoomBlockR\troomBlockB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_room_block_create_room_block_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_room_block_create_room_block_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_room_block_create_room_block_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_room_block_create_room_block_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_room_block_create_room_block_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_block_create_room_block_proto_rawDesc), len(file_hotel_v1_rpc_room_block_create_room_block_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_room_block_create_room_block_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_room_block_create_room_block_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_room_block_create_room_block_proto_goTypes = []any{
+	(*CreateRoomBlockRequest)(nil),  // 0: hotel.v1.CreateRoomBlockRequest
+	(*CreateRoomBlockResponse)(nil), // 1: hotel.v1.CreateRoomBlockResponse
+	(*timestamppb.Timestamp)(nil),   // 2: google.protobuf.Timestamp
+	(RoomBlockReason)(0),            // 3: hotel.v1.RoomBlockReason
+	(*RoomBlock)(nil),               // 4: hotel.v1.RoomBlock
+}
+var file_hotel_v1_rpc_room_block_create_room_block_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.CreateRoomBlockRequest.start_date:type_name -> google.protobuf.Timestamp
+	2, // 1: hotel.v1.CreateRoomBlockRequest.end_date:type_name -> google.protobuf.Timestamp
+	3, // 2: hotel.v1.CreateRoomBlockRequest.reason:type_name -> hotel.v1.RoomBlockReason
+	4, // 3: hotel.v1.CreateRoomBlockResponse.room_block:type_name -> hotel.v1.RoomBlock
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_room_block_create_room_block_proto_init() }
+func file_hotel_v1_rpc_room_block_create_room_block_proto_init() {
+	if File_hotel_v1_rpc_room_block_create_room_block_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_room_block_reason_proto_init()
+	file_hotel_v1_models_room_block_proto_init()
+	file_hotel_v1_rpc_room_block_create_room_block_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_block_create_room_block_proto_rawDesc), len(file_hotel_v1_rpc_room_block_create_room_block_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_room_block_create_room_block_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_room_block_create_room_block_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_room_block_create_room_block_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_room_block_create_room_block_proto = out.File
+	file_hotel_v1_rpc_room_block_create_room_block_proto_goTypes = nil
+	file_hotel_v1_rpc_room_block_create_room_block_proto_depIdxs = nil
+}
@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/room_category/create_room_category.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateRoomCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CitySlug      string                 `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
+	HotelSlug     string                 `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Type          RoomType               `protobuf:"varint,6,opt,name=type,proto3,enum=hotel.v1.RoomType" json:"type,omitempty"`
+	Price         string                 `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	Capacity      int64                  `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	AreaSqm       float32                `protobuf:"fixed32,9,opt,name=area_sqm,json=areaSqm,proto3" json:"area_sqm,omitempty"`
+	Amenities     []string               `protobuf:"bytes,10,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	Images        []string               `protobuf:"bytes,11,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomCategoryRequest) Reset() {
+	*x = CreateRoomCategoryRequest{}
+	mi := &file_hotel_v1_rpc_room_category_create_room_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomCategoryRequest) ProtoMessage() {}
+
+func (x *CreateRoomCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_category_create_room_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_category_create_room_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRoomCategoryRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *CreateRoomCategoryRequest) GetCitySlug() string {
+	if x != nil {
+		return x.CitySlug
+	}
+	return ""
+}
+
+func (x *CreateRoomCategoryRequest) GetHotelSlug() string {
+	if x != nil {
+		return x.HotelSlug
+	}
+	return ""
+}
+
+func (x *CreateRoomCategoryRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateRoomCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateRoomCategoryRequest) GetType() RoomType {
+	if x != nil {
+		return x.Type
+	}
+	return RoomType_ROOM_TYPE_UNSPECIFIED
+}
+
+func (x *CreateRoomCategoryRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *CreateRoomCategoryRequest) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateRoomCategoryRequest) GetAreaSqm() float32 {
+	if x != nil {
+		return x.AreaSqm
+	}
+	return 0
+}
+
+func (x *CreateRoomCategoryRequest) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *CreateRoomCategoryRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type CreateRoomCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomCategory  *RoomCategory          `protobuf:"bytes,1,opt,name=room_category,json=roomCategory,proto3" json:"room_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomCategoryResponse) Reset() {
+	*x = CreateRoomCategoryResponse{}
+	mi := &file_hotel_v1_rpc_room_category_create_room_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomCategoryResponse) ProtoMessage() {}
+
+func (x *CreateRoomCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_category_create_room_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_category_create_room_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRoomCategoryResponse) GetRoomCategory() *RoomCategory {
+	if x != nil {
+		return x.RoomCategory
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_room_category_create_room_category_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_room_category_create_room_category_proto_rawDesc = "" +
+	"\n" +
+	"5hotel/v1/rpc/room_category/create_room_category.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ehotel/v1/enums/room_type.proto\x1a#hotel/v1/models/room_category.proto\"\xb6\x04\n" +
+	"\x19CreateRoomCategoryRequest\x124\n" +
+	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[a-z]{2}$R\vcountryCode\x12<\n" +
+	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
+	"\n" +
+	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\x12\x1f\n" +
+	"\x05title\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x05title\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01\x12.\n" +
+	"\x04type\x18\x06 \x01(\x0e2\x12.hotel.v1.RoomTypeB\x06\xbaH\x03\xc8\x01\x01R\x04type\x124\n" +
+	"\x05price\x18\a \x01(\tB\x1e\xbaH\x1br\x192\x17^[0-9]+(\\.[0-9]{1,2})?$R\x05price\x12%\n" +
+	"\bcapacity\x18\b \x01(\x03B\t\xbaH\x06\"\x04\x18\n" +
+	"(\x01R\bcapacity\x12%\n" +
+	"\barea_sqm\x18\t \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05%\x00\x00\x00\x00R\aareaSqm\x12A\n" +
+	"\tamenities\x18\n" +
+	" \x03(\tB#\xbaH \x92\x01\x1d\x102\x18\x01\"\x17r\x15\x18@2\x11^[a-z][a-z0-9_]*$R\tamenities\x12\x16\n" +
+	"\x06images\x18\v \x03(\tR\x06imagesB\x0e\n" +
+	"\f_description\"Y\n" +
+	"\x1aCreateRoomCategoryResponse\x12;\n" +
+	"\rroom_category\x18\x01 \x01(\v2\x16.hotel.v1.RoomCategoryR\froomCategoryB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_room_category_create_room_category_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_room_category_create_room_category_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_room_category_create_room_category_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_room_category_create_room_category_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_room_category_create_room_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_category_create_room_category_proto_rawDesc), len(file_hotel_v1_rpc_room_category_create_room_category_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_room_category_create_room_category_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_room_category_create_room_category_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_room_category_create_room_category_proto_goTypes = []any{
+	(*CreateRoomCategoryRequest)(nil),  // 0: hotel.v1.CreateRoomCategoryRequest
+	(*CreateRoomCategoryResponse)(nil), // 1: hotel.v1.CreateRoomCategoryResponse
+	(RoomType)(0),                      // 2: hotel.v1.RoomType
+	(*RoomCategory)(nil),               // 3: hotel.v1.RoomCategory
+}
+var file_hotel_v1_rpc_room_category_create_room_category_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.CreateRoomCategoryRequest.type:type_name -> hotel.v1.RoomType
+	3, // 1: hotel.v1.CreateRoomCategoryResponse.room_category:type_name -> hotel.v1.RoomCategory
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_room_category_create_room_category_proto_init() }
+func file_hotel_v1_rpc_room_category_create_room_category_proto_init() {
+	if File_hotel_v1_rpc_room_category_create_room_category_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_room_type_proto_init()
+	file_hotel_v1_models_room_category_proto_init()
+	file_hotel_v1_rpc_room_category_create_room_category_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_category_create_room_category_proto_rawDesc), len(file_hotel_v1_rpc_room_category_create_room_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_room_category_create_room_category_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_room_category_create_room_category_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_room_category_create_room_category_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_room_category_create_room_category_proto = out.File
+	file_hotel_v1_rpc_room_category_create_room_category_proto_goTypes = nil
+	file_hotel_v1_rpc_room_category_create_room_category_proto_depIdxs = nil
+}
@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/stay_restriction/create_stay_restriction.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateStayRestrictionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CitySlug      string                 `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
+	HotelSlug     string                 `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	RoomId        *string                `protobuf:"bytes,4,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Rules         *StayRulesRequest      `protobuf:"bytes,7,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStayRestrictionRequest) Reset() {
+	*x = CreateStayRestrictionRequest{}
+	mi := &file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStayRestrictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStayRestrictionRequest) ProtoMessage() {}
+
+func (x *CreateStayRestrictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStayRestrictionRequest.ProtoReflect.Descriptor instead.
+func (*CreateStayRestrictionRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateStayRestrictionRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *CreateStayRestrictionRequest) GetCitySlug() string {
+	if x != nil {
+		return x.CitySlug
+	}
+	return ""
+}
+
+func (x *CreateStayRestrictionRequest) GetHotelSlug() string {
+	if x != nil {
+		return x.HotelSlug
+	}
+	return ""
+}
+
+func (x *CreateStayRestrictionRequest) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *CreateStayRestrictionRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateStayRestrictionRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateStayRestrictionRequest) GetRules() *StayRulesRequest {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreateStayRestrictionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StayRestriction *StayRestriction       `protobuf:"bytes,1,opt,name=stay_restriction,json=stayRestriction,proto3" json:"stay_restriction,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateStayRestrictionResponse) Reset() {
+	*x = CreateStayRestrictionResponse{}
+	mi := &file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStayRestrictionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStayRestrictionResponse) ProtoMessage() {}
+
+func (x *CreateStayRestrictionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStayRestrictionResponse.ProtoReflect.Descriptor instead.
+func (*CreateStayRestrictionResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateStayRestrictionResponse) GetStayRestriction() *StayRestriction {
+	if x != nil {
+		return x.StayRestriction
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDesc = "" +
+	"\n" +
+	";hotel/v1/rpc/stay_restriction/create_stay_restriction.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a&hotel/v1/models/stay_restriction.proto\x1a.hotel/v1/rpc/stay_restriction/stay_rules.proto\"\xab\x04\n" +
+	"\x1cCreateStayRestrictionRequest\x124\n" +
+	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[a-z]{2}$R\vcountryCode\x12<\n" +
+	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
+	"\n" +
+	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\x12&\n" +
+	"\aroom_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06roomId\x88\x01\x01\x12A\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tstartDate\x12=\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\aendDate\x128\n" +
+	"\x05rules\x18\a \x01(\v2\x1a.hotel.v1.StayRulesRequestB\x06\xbaH\x03\xc8\x01\x01R\x05rules:g\xbaHd\x1ab\n" +
+	"\x1cstay_restriction.dates.order\x12!end_date must be after start_date\x1a\x1fthis.end_date > this.start_dateB\n" +
+	"\n" +
+	"\b_room_id\"e\n" +
+	"\x1dCreateStayRestrictionResponse\x12D\n" +
+	"\x10stay_restriction\x18\x01 \x01(\v2\x19.hotel.v1.StayRestrictionR\x0fstayRestrictionB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDesc), len(file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_goTypes = []any{
+	(*CreateStayRestrictionRequest)(nil),  // 0: hotel.v1.CreateStayRestrictionRequest
+	(*CreateStayRestrictionResponse)(nil), // 1: hotel.v1.CreateStayRestrictionResponse
+	(*timestamppb.Timestamp)(nil),         // 2: google.protobuf.Timestamp
+	(*StayRulesRequest)(nil),              // 3: hotel.v1.StayRulesRequest
+	(*StayRestriction)(nil),               // 4: hotel.v1.StayRestriction
+}
+var file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.CreateStayRestrictionRequest.start_date:type_name -> google.protobuf.Timestamp
+	2, // 1: hotel.v1.CreateStayRestrictionRequest.end_date:type_name -> google.protobuf.Timestamp
+	3, // 2: hotel.v1.CreateStayRestrictionRequest.rules:type_name -> hotel.v1.StayRulesRequest
+	4, // 3: hotel.v1.CreateStayRestrictionResponse.stay_restriction:type_name -> hotel.v1.StayRestriction
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_init() }
+func file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_init() {
+	if File_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto != nil {
+		return
+	}
+	file_hotel_v1_models_stay_restriction_proto_init()
+	file_hotel_v1_rpc_stay_restriction_stay_rules_proto_init()
+	file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDesc), len(file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto = out.File
+	file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_goTypes = nil
+	file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_depIdxs = nil
+}
@@ -0,0 +1,170 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/amenity/delete_amenity.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteAmenityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAmenityRequest) Reset() {
+	*x = DeleteAmenityRequest{}
+	mi := &file_hotel_v1_rpc_amenity_delete_amenity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAmenityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAmenityRequest) ProtoMessage() {}
+
+func (x *DeleteAmenityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_amenity_delete_amenity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAmenityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAmenityRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteAmenityRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeleteAmenityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAmenityResponse) Reset() {
+	*x = DeleteAmenityResponse{}
+	mi := &file_hotel_v1_rpc_amenity_delete_amenity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAmenityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAmenityResponse) ProtoMessage() {}
+
+func (x *DeleteAmenityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_amenity_delete_amenity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAmenityResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAmenityResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeleteAmenityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_hotel_v1_rpc_amenity_delete_amenity_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDesc = "" +
+	"\n" +
+	")hotel/v1/rpc/amenity/delete_amenity.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"F\n" +
+	"\x14DeleteAmenityRequest\x12.\n" +
+	"\x04code\x18\x01 \x01(\tB\x1a\xbaH\x17r\x15\x18@2\x11^[a-z][a-z0-9_]*$R\x04code\"1\n" +
+	"\x15DeleteAmenityResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessageB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDesc), len(file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_amenity_delete_amenity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_amenity_delete_amenity_proto_goTypes = []any{
+	(*DeleteAmenityRequest)(nil),  // 0: hotel.v1.DeleteAmenityRequest
+	(*DeleteAmenityResponse)(nil), // 1: hotel.v1.DeleteAmenityResponse
+}
+var file_hotel_v1_rpc_amenity_delete_amenity_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_amenity_delete_amenity_proto_init() }
+func file_hotel_v1_rpc_amenity_delete_amenity_proto_init() {
+	if File_hotel_v1_rpc_amenity_delete_amenity_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDesc), len(file_hotel_v1_rpc_amenity_delete_amenity_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_amenity_delete_amenity_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_amenity_delete_amenity_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_amenity_delete_amenity_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_amenity_delete_amenity_proto = out.File
+	file_hotel_v1_rpc_amenity_delete_amenity_proto_goTypes = nil
+	file_hotel_v1_rpc_amenity_delete_amenity_proto_depIdxs = nil
+}
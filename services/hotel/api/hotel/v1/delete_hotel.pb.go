@@ -27,6 +27,7 @@ type DeleteHotelRequest struct {
 	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
 	CitySlug      string                 `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
 	HotelSlug     string                 `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	Force         bool                   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,11 +83,19 @@ func (x *DeleteHotelRequest) GetHotelSlug() string {
 	return ""
 }
 
+func (x *DeleteHotelRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type DeleteHotelResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Message             string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CancelledBookingIds []string               `protobuf:"bytes,2,rep,name=cancelled_booking_ids,json=cancelledBookingIds,proto3" json:"cancelled_booking_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *DeleteHotelResponse) Reset() {
@@ -126,19 +135,28 @@ func (x *DeleteHotelResponse) GetMessage() string {
 	return ""
 }
 
+func (x *DeleteHotelResponse) GetCancelledBookingIds() []string {
+	if x != nil {
+		return x.CancelledBookingIds
+	}
+	return nil
+}
+
 var File_hotel_v1_rpc_hotel_delete_hotel_proto protoreflect.FileDescriptor
 
 const file_hotel_v1_rpc_hotel_delete_hotel_proto_rawDesc = "" +
 	"\n" +
-	"%hotel/v1/rpc/hotel/delete_hotel.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"\xc8\x01\n" +
+	"%hotel/v1/rpc/hotel/delete_hotel.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"\xde\x01\n" +
 	"\x12DeleteHotelRequest\x124\n" +
 	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
 	"^[a-z]{2}$R\vcountryCode\x12<\n" +
 	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
 	"\n" +
-	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\"/\n" +
+	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\"c\n" +
 	"\x13DeleteHotelResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessageB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+	"\amessage\x18\x01 \x01(\tR\amessage\x122\n" +
+	"\x15cancelled_booking_ids\x18\x02 \x03(\tR\x13cancelledBookingIdsB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
 
 var (
 	file_hotel_v1_rpc_hotel_delete_hotel_proto_rawDescOnce sync.Once
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/hotel_policy/delete_hotel_policy.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteHotelPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CitySlug      string                 `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
+	HotelSlug     string                 `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHotelPolicyRequest) Reset() {
+	*x = DeleteHotelPolicyRequest{}
+	mi := &file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHotelPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHotelPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteHotelPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHotelPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHotelPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteHotelPolicyRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *DeleteHotelPolicyRequest) GetCitySlug() string {
+	if x != nil {
+		return x.CitySlug
+	}
+	return ""
+}
+
+func (x *DeleteHotelPolicyRequest) GetHotelSlug() string {
+	if x != nil {
+		return x.HotelSlug
+	}
+	return ""
+}
+
+type DeleteHotelPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHotelPolicyResponse) Reset() {
+	*x = DeleteHotelPolicyResponse{}
+	mi := &file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHotelPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHotelPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteHotelPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHotelPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHotelPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeleteHotelPolicyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDesc = "" +
+	"\n" +
+	"3hotel/v1/rpc/hotel_policy/delete_hotel_policy.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"\xce\x01\n" +
+	"\x18DeleteHotelPolicyRequest\x124\n" +
+	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[a-z]{2}$R\vcountryCode\x12<\n" +
+	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
+	"\n" +
+	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\"5\n" +
+	"\x19DeleteHotelPolicyResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessageB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDesc), len(file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_goTypes = []any{
+	(*DeleteHotelPolicyRequest)(nil),  // 0: hotel.v1.DeleteHotelPolicyRequest
+	(*DeleteHotelPolicyResponse)(nil), // 1: hotel.v1.DeleteHotelPolicyResponse
+}
+var file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_init() }
+func file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_init() {
+	if File_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDesc), len(file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto = out.File
+	file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_goTypes = nil
+	file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_depIdxs = nil
+}
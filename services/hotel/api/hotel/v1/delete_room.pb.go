@@ -25,6 +25,7 @@ const (
 type DeleteRoomRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Force         bool                   `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,11 +67,19 @@ func (x *DeleteRoomRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteRoomRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type DeleteRoomResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Message             string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CancelledBookingIds []string               `protobuf:"bytes,2,rep,name=cancelled_booking_ids,json=cancelledBookingIds,proto3" json:"cancelled_booking_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *DeleteRoomResponse) Reset() {
@@ -110,15 +119,24 @@ func (x *DeleteRoomResponse) GetMessage() string {
 	return ""
 }
 
+func (x *DeleteRoomResponse) GetCancelledBookingIds() []string {
+	if x != nil {
+		return x.CancelledBookingIds
+	}
+	return nil
+}
+
 var File_hotel_v1_rpc_room_delete_room_proto protoreflect.FileDescriptor
 
 const file_hotel_v1_rpc_room_delete_room_proto_rawDesc = "" +
 	"\n" +
-	"#hotel/v1/rpc/room/delete_room.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"-\n" +
+	"#hotel/v1/rpc/room/delete_room.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"C\n" +
 	"\x11DeleteRoomRequest\x12\x18\n" +
-	"\x02id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\".\n" +
+	"\x02id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x14\n" +
+	"\x05force\x18\x05 \x01(\bR\x05force\"b\n" +
 	"\x12DeleteRoomResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessageB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+	"\amessage\x18\x01 \x01(\tR\amessage\x122\n" +
+	"\x15cancelled_booking_ids\x18\x02 \x03(\tR\x13cancelledBookingIdsB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
 
 var (
 	file_hotel_v1_rpc_room_delete_room_proto_rawDescOnce sync.Once
@@ -0,0 +1,261 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/models/geo.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Country struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	IsoAlpha3     *string                `protobuf:"bytes,2,opt,name=iso_alpha3,json=isoAlpha3,proto3,oneof" json:"iso_alpha3,omitempty"`
+	Names         map[string]string      `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	HotelCount    uint64                 `protobuf:"varint,4,opt,name=hotel_count,json=hotelCount,proto3" json:"hotel_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Country) Reset() {
+	*x = Country{}
+	mi := &file_hotel_v1_models_geo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Country) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Country) ProtoMessage() {}
+
+func (x *Country) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_geo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Country.ProtoReflect.Descriptor instead.
+func (*Country) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_geo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Country) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Country) GetIsoAlpha3() string {
+	if x != nil && x.IsoAlpha3 != nil {
+		return *x.IsoAlpha3
+	}
+	return ""
+}
+
+func (x *Country) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *Country) GetHotelCount() uint64 {
+	if x != nil {
+		return x.HotelCount
+	}
+	return 0
+}
+
+type City struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Names         map[string]string      `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Centroid      *Location              `protobuf:"bytes,5,opt,name=centroid,proto3" json:"centroid,omitempty"`
+	HotelCount    uint64                 `protobuf:"varint,6,opt,name=hotel_count,json=hotelCount,proto3" json:"hotel_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *City) Reset() {
+	*x = City{}
+	mi := &file_hotel_v1_models_geo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *City) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*City) ProtoMessage() {}
+
+func (x *City) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_geo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use City.ProtoReflect.Descriptor instead.
+func (*City) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_geo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *City) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *City) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *City) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *City) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *City) GetCentroid() *Location {
+	if x != nil {
+		return x.Centroid
+	}
+	return nil
+}
+
+func (x *City) GetHotelCount() uint64 {
+	if x != nil {
+		return x.HotelCount
+	}
+	return 0
+}
+
+var File_hotel_v1_models_geo_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_models_geo_proto_rawDesc = "" +
+	"\n" +
+	"\x19hotel/v1/models/geo.proto\x12\bhotel.v1\x1a\x1bhotel/v1/models/hotel.proto\"\xdf\x01\n" +
+	"\aCountry\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\"\n" +
+	"\n" +
+	"iso_alpha3\x18\x02 \x01(\tH\x00R\tisoAlpha3\x88\x01\x01\x122\n" +
+	"\x05names\x18\x03 \x03(\v2\x1c.hotel.v1.Country.NamesEntryR\x05names\x12\x1f\n" +
+	"\vhotel_count\x18\x04 \x01(\x04R\n" +
+	"hotelCount\x1a8\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
+	"\v_iso_alpha3\"\x95\x02\n" +
+	"\x04City\x12!\n" +
+	"\fcountry_code\x18\x01 \x01(\tR\vcountryCode\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12/\n" +
+	"\x05names\x18\x03 \x03(\v2\x19.hotel.v1.City.NamesEntryR\x05names\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12.\n" +
+	"\bcentroid\x18\x05 \x01(\v2\x12.hotel.v1.LocationR\bcentroid\x12\x1f\n" +
+	"\vhotel_count\x18\x06 \x01(\x04R\n" +
+	"hotelCount\x1a8\n" +
+	"\n" +
+	"NamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_models_geo_proto_rawDescOnce sync.Once
+	file_hotel_v1_models_geo_proto_rawDescData []byte
+)
+
+func file_hotel_v1_models_geo_proto_rawDescGZIP() []byte {
+	file_hotel_v1_models_geo_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_models_geo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_models_geo_proto_rawDesc), len(file_hotel_v1_models_geo_proto_rawDesc)))
+	})
+	return file_hotel_v1_models_geo_proto_rawDescData
+}
+
+var file_hotel_v1_models_geo_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_hotel_v1_models_geo_proto_goTypes = []any{
+	(*Country)(nil),  // 0: hotel.v1.Country
+	(*City)(nil),     // 1: hotel.v1.City
+	nil,              // 2: hotel.v1.Country.NamesEntry
+	nil,              // 3: hotel.v1.City.NamesEntry
+	(*Location)(nil), // 4: hotel.v1.Location
+}
+var file_hotel_v1_models_geo_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.Country.names:type_name -> hotel.v1.Country.NamesEntry
+	3, // 1: hotel.v1.City.names:type_name -> hotel.v1.City.NamesEntry
+	4, // 2: hotel.v1.City.centroid:type_name -> hotel.v1.Location
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_models_geo_proto_init() }
+func file_hotel_v1_models_geo_proto_init() {
+	if File_hotel_v1_models_geo_proto != nil {
+		return
+	}
+	file_hotel_v1_models_hotel_proto_init()
+	file_hotel_v1_models_geo_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_models_geo_proto_rawDesc), len(file_hotel_v1_models_geo_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_models_geo_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_models_geo_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_models_geo_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_models_geo_proto = out.File
+	file_hotel_v1_models_geo_proto_goTypes = nil
+	file_hotel_v1_models_geo_proto_depIdxs = nil
+}
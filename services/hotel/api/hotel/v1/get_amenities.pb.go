@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/amenity/get_amenities.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetAmenitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *AmenityCategory       `protobuf:"varint,1,opt,name=category,proto3,enum=hotel.v1.AmenityCategory,oneof" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAmenitiesRequest) Reset() {
+	*x = GetAmenitiesRequest{}
+	mi := &file_hotel_v1_rpc_amenity_get_amenities_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAmenitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAmenitiesRequest) ProtoMessage() {}
+
+func (x *GetAmenitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_amenity_get_amenities_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAmenitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetAmenitiesRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_amenity_get_amenities_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAmenitiesRequest) GetCategory() AmenityCategory {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return AmenityCategory_AMENITY_CATEGORY_UNSPECIFIED
+}
+
+type GetAmenitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amenities     []*Amenity             `protobuf:"bytes,1,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAmenitiesResponse) Reset() {
+	*x = GetAmenitiesResponse{}
+	mi := &file_hotel_v1_rpc_amenity_get_amenities_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAmenitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAmenitiesResponse) ProtoMessage() {}
+
+func (x *GetAmenitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_amenity_get_amenities_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAmenitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetAmenitiesResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_amenity_get_amenities_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAmenitiesResponse) GetAmenities() []*Amenity {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_amenity_get_amenities_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_amenity_get_amenities_proto_rawDesc = "" +
+	"\n" +
+	"(hotel/v1/rpc/amenity/get_amenities.proto\x12\bhotel.v1\x1a%hotel/v1/enums/amenity_category.proto\x1a\x1dhotel/v1/models/amenity.proto\"^\n" +
+	"\x13GetAmenitiesRequest\x12:\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x19.hotel.v1.AmenityCategoryH\x00R\bcategory\x88\x01\x01B\v\n" +
+	"\t_category\"G\n" +
+	"\x14GetAmenitiesResponse\x12/\n" +
+	"\tamenities\x18\x01 \x03(\v2\x11.hotel.v1.AmenityR\tamenitiesB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_amenity_get_amenities_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_amenity_get_amenities_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_amenity_get_amenities_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_amenity_get_amenities_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_amenity_get_amenities_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_amenity_get_amenities_proto_rawDesc), len(file_hotel_v1_rpc_amenity_get_amenities_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_amenity_get_amenities_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_amenity_get_amenities_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_amenity_get_amenities_proto_goTypes = []any{
+	(*GetAmenitiesRequest)(nil),  // 0: hotel.v1.GetAmenitiesRequest
+	(*GetAmenitiesResponse)(nil), // 1: hotel.v1.GetAmenitiesResponse
+	(AmenityCategory)(0),         // 2: hotel.v1.AmenityCategory
+	(*Amenity)(nil),              // 3: hotel.v1.Amenity
+}
+var file_hotel_v1_rpc_amenity_get_amenities_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.GetAmenitiesRequest.category:type_name -> hotel.v1.AmenityCategory
+	3, // 1: hotel.v1.GetAmenitiesResponse.amenities:type_name -> hotel.v1.Amenity
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_amenity_get_amenities_proto_init() }
+func file_hotel_v1_rpc_amenity_get_amenities_proto_init() {
+	if File_hotel_v1_rpc_amenity_get_amenities_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_amenity_category_proto_init()
+	file_hotel_v1_models_amenity_proto_init()
+	file_hotel_v1_rpc_amenity_get_amenities_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_amenity_get_amenities_proto_rawDesc), len(file_hotel_v1_rpc_amenity_get_amenities_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_amenity_get_amenities_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_amenity_get_amenities_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_amenity_get_amenities_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_amenity_get_amenities_proto = out.File
+	file_hotel_v1_rpc_amenity_get_amenities_proto_goTypes = nil
+	file_hotel_v1_rpc_amenity_get_amenities_proto_depIdxs = nil
+}
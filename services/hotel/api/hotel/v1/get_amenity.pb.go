@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/amenity/get_amenity.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetAmenityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAmenityRequest) Reset() {
+	*x = GetAmenityRequest{}
+	mi := &file_hotel_v1_rpc_amenity_get_amenity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAmenityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAmenityRequest) ProtoMessage() {}
+
+func (x *GetAmenityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_amenity_get_amenity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAmenityRequest.ProtoReflect.Descriptor instead.
+func (*GetAmenityRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_amenity_get_amenity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAmenityRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GetAmenityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amenity       *Amenity               `protobuf:"bytes,1,opt,name=amenity,proto3" json:"amenity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAmenityResponse) Reset() {
+	*x = GetAmenityResponse{}
+	mi := &file_hotel_v1_rpc_amenity_get_amenity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAmenityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAmenityResponse) ProtoMessage() {}
+
+func (x *GetAmenityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_amenity_get_amenity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAmenityResponse.ProtoReflect.Descriptor instead.
+func (*GetAmenityResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_amenity_get_amenity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAmenityResponse) GetAmenity() *Amenity {
+	if x != nil {
+		return x.Amenity
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_amenity_get_amenity_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_amenity_get_amenity_proto_rawDesc = "" +
+	"\n" +
+	"&hotel/v1/rpc/amenity/get_amenity.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1dhotel/v1/models/amenity.proto\"C\n" +
+	"\x11GetAmenityRequest\x12.\n" +
+	"\x04code\x18\x01 \x01(\tB\x1a\xbaH\x17r\x15\x18@2\x11^[a-z][a-z0-9_]*$R\x04code\"A\n" +
+	"\x12GetAmenityResponse\x12+\n" +
+	"\aamenity\x18\x01 \x01(\v2\x11.hotel.v1.AmenityR\aamenityB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_amenity_get_amenity_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_amenity_get_amenity_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_amenity_get_amenity_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_amenity_get_amenity_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_amenity_get_amenity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_amenity_get_amenity_proto_rawDesc), len(file_hotel_v1_rpc_amenity_get_amenity_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_amenity_get_amenity_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_amenity_get_amenity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_amenity_get_amenity_proto_goTypes = []any{
+	(*GetAmenityRequest)(nil),  // 0: hotel.v1.GetAmenityRequest
+	(*GetAmenityResponse)(nil), // 1: hotel.v1.GetAmenityResponse
+	(*Amenity)(nil),            // 2: hotel.v1.Amenity
+}
+var file_hotel_v1_rpc_amenity_get_amenity_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.GetAmenityResponse.amenity:type_name -> hotel.v1.Amenity
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_amenity_get_amenity_proto_init() }
+func file_hotel_v1_rpc_amenity_get_amenity_proto_init() {
+	if File_hotel_v1_rpc_amenity_get_amenity_proto != nil {
+		return
+	}
+	file_hotel_v1_models_amenity_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_amenity_get_amenity_proto_rawDesc), len(file_hotel_v1_rpc_amenity_get_amenity_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_amenity_get_amenity_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_amenity_get_amenity_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_amenity_get_amenity_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_amenity_get_amenity_proto = out.File
+	file_hotel_v1_rpc_amenity_get_amenity_proto_goTypes = nil
+	file_hotel_v1_rpc_amenity_get_amenity_proto_depIdxs = nil
+}
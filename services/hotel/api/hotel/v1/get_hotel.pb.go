@@ -83,10 +83,12 @@ func (x *GetHotelRequest) GetHotelSlug() string {
 }
 
 type GetHotelResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hotel         *Hotel                 `protobuf:"bytes,1,opt,name=hotel,proto3" json:"hotel,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hotel          *Hotel                 `protobuf:"bytes,1,opt,name=hotel,proto3" json:"hotel,omitempty"`
+	SlugRedirected bool                   `protobuf:"varint,2,opt,name=slug_redirected,json=slugRedirected,proto3" json:"slug_redirected,omitempty"`
+	CanonicalSlug  string                 `protobuf:"bytes,3,opt,name=canonical_slug,json=canonicalSlug,proto3" json:"canonical_slug,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetHotelResponse) Reset() {
@@ -126,19 +128,35 @@ func (x *GetHotelResponse) GetHotel() *Hotel {
 	return nil
 }
 
+func (x *GetHotelResponse) GetSlugRedirected() bool {
+	if x != nil {
+		return x.SlugRedirected
+	}
+	return false
+}
+
+func (x *GetHotelResponse) GetCanonicalSlug() string {
+	if x != nil {
+		return x.CanonicalSlug
+	}
+	return ""
+}
+
 var File_hotel_v1_rpc_hotel_get_hotel_proto protoreflect.FileDescriptor
 
 const file_hotel_v1_rpc_hotel_get_hotel_proto_rawDesc = "" +
 	"\n" +
-	"\"hotel/v1/rpc/hotel/get_hotel.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bhotel/v1/models/hotel.proto\"\xc5\x01\n" +
+	"\"hotel/v1/rpc/hotel/get_hotel.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bhotel/v1/models/hotel.proto\"\xe9\x01\n" +
 	"\x0fGetHotelRequest\x124\n" +
 	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
 	"^[a-z]{2}$R\vcountryCode\x12<\n" +
 	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
 	"\n" +
-	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\"9\n" +
+	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlugJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\tviewer_idR\vviewer_role\"\x89\x01\n" +
 	"\x10GetHotelResponse\x12%\n" +
-	"\x05hotel\x18\x01 \x01(\v2\x0f.hotel.v1.HotelR\x05hotelB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+	"\x05hotel\x18\x01 \x01(\v2\x0f.hotel.v1.HotelR\x05hotel\x12'\n" +
+	"\x0fslug_redirected\x18\x02 \x01(\bR\x0eslugRedirected\x12%\n" +
+	"\x0ecanonical_slug\x18\x03 \x01(\tR\rcanonicalSlugB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
 
 var (
 	file_hotel_v1_rpc_hotel_get_hotel_proto_rawDescOnce sync.Once
@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/hotel/get_hotels_by_ids.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetHotelsByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotelsByIDsRequest) Reset() {
+	*x = GetHotelsByIDsRequest{}
+	mi := &file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotelsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotelsByIDsRequest) ProtoMessage() {}
+
+func (x *GetHotelsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotelsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetHotelsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetHotelsByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetHotelsByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hotels        []*Hotel               `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
+	NotFoundIds   []string               `protobuf:"bytes,2,rep,name=not_found_ids,json=notFoundIds,proto3" json:"not_found_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotelsByIDsResponse) Reset() {
+	*x = GetHotelsByIDsResponse{}
+	mi := &file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotelsByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotelsByIDsResponse) ProtoMessage() {}
+
+func (x *GetHotelsByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotelsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetHotelsByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetHotelsByIDsResponse) GetHotels() []*Hotel {
+	if x != nil {
+		return x.Hotels
+	}
+	return nil
+}
+
+func (x *GetHotelsByIDsResponse) GetNotFoundIds() []string {
+	if x != nil {
+		return x.NotFoundIds
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_hotel_get_hotels_by_ids_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDesc = "" +
+	"\n" +
+	"*hotel/v1/rpc/hotel/get_hotels_by_ids.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bhotel/v1/models/hotel.proto\">\n" +
+	"\x15GetHotelsByIDsRequest\x12%\n" +
+	"\x03ids\x18\x01 \x03(\tB\x13\xbaH\x10\x92\x01\r\b\x01\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\x03ids\"e\n" +
+	"\x16GetHotelsByIDsResponse\x12'\n" +
+	"\x06hotels\x18\x01 \x03(\v2\x0f.hotel.v1.HotelR\x06hotels\x12\"\n" +
+	"\rnot_found_ids\x18\x02 \x03(\tR\vnotFoundIdsB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDesc), len(file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_goTypes = []any{
+	(*GetHotelsByIDsRequest)(nil),  // 0: hotel.v1.GetHotelsByIDsRequest
+	(*GetHotelsByIDsResponse)(nil), // 1: hotel.v1.GetHotelsByIDsResponse
+	(*Hotel)(nil),                  // 2: hotel.v1.Hotel
+}
+var file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.GetHotelsByIDsResponse.hotels:type_name -> hotel.v1.Hotel
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_init() }
+func file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_init() {
+	if File_hotel_v1_rpc_hotel_get_hotels_by_ids_proto != nil {
+		return
+	}
+	file_hotel_v1_models_hotel_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDesc), len(file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_hotel_get_hotels_by_ids_proto = out.File
+	file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_goTypes = nil
+	file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_depIdxs = nil
+}
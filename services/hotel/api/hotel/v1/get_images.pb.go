@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/image/get_images.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *ImageTarget           `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImagesRequest) Reset() {
+	*x = GetImagesRequest{}
+	mi := &file_hotel_v1_rpc_image_get_images_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImagesRequest) ProtoMessage() {}
+
+func (x *GetImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_image_get_images_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImagesRequest.ProtoReflect.Descriptor instead.
+func (*GetImagesRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_image_get_images_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetImagesRequest) GetTarget() *ImageTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type GetImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*Image               `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImagesResponse) Reset() {
+	*x = GetImagesResponse{}
+	mi := &file_hotel_v1_rpc_image_get_images_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImagesResponse) ProtoMessage() {}
+
+func (x *GetImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_image_get_images_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImagesResponse.ProtoReflect.Descriptor instead.
+func (*GetImagesResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_image_get_images_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetImagesResponse) GetImages() []*Image {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_image_get_images_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_image_get_images_proto_rawDesc = "" +
+	"\n" +
+	"#hotel/v1/rpc/image/get_images.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bhotel/v1/models/image.proto\x1a%hotel/v1/rpc/image/image_target.proto\"I\n" +
+	"\x10GetImagesRequest\x125\n" +
+	"\x06target\x18\x01 \x01(\v2\x15.hotel.v1.ImageTargetB\x06\xbaH\x03\xc8\x01\x01R\x06target\"<\n" +
+	"\x11GetImagesResponse\x12'\n" +
+	"\x06images\x18\x01 \x03(\v2\x0f.hotel.v1.ImageR\x06imagesB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_image_get_images_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_image_get_images_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_image_get_images_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_image_get_images_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_image_get_images_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_image_get_images_proto_rawDesc), len(file_hotel_v1_rpc_image_get_images_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_image_get_images_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_image_get_images_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_image_get_images_proto_goTypes = []any{
+	(*GetImagesRequest)(nil),  // 0: hotel.v1.GetImagesRequest
+	(*GetImagesResponse)(nil), // 1: hotel.v1.GetImagesResponse
+	(*ImageTarget)(nil),       // 2: hotel.v1.ImageTarget
+	(*Image)(nil),             // 3: hotel.v1.Image
+}
+var file_hotel_v1_rpc_image_get_images_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.GetImagesRequest.target:type_name -> hotel.v1.ImageTarget
+	3, // 1: hotel.v1.GetImagesResponse.images:type_name -> hotel.v1.Image
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_image_get_images_proto_init() }
+func file_hotel_v1_rpc_image_get_images_proto_init() {
+	if File_hotel_v1_rpc_image_get_images_proto != nil {
+		return
+	}
+	file_hotel_v1_models_image_proto_init()
+	file_hotel_v1_rpc_image_image_target_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_image_get_images_proto_rawDesc), len(file_hotel_v1_rpc_image_get_images_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_image_get_images_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_image_get_images_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_image_get_images_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_image_get_images_proto = out.File
+	file_hotel_v1_rpc_image_get_images_proto_goTypes = nil
+	file_hotel_v1_rpc_image_get_images_proto_depIdxs = nil
+}
@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/room_block/get_room_blocks.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRoomBlocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Page          uint64                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint64                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomBlocksRequest) Reset() {
+	*x = GetRoomBlocksRequest{}
+	mi := &file_hotel_v1_rpc_room_block_get_room_blocks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomBlocksRequest) ProtoMessage() {}
+
+func (x *GetRoomBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_block_get_room_blocks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomBlocksRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRoomBlocksRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *GetRoomBlocksRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRoomBlocksRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRoomBlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomBlocks    []*RoomBlock           `protobuf:"bytes,1,rep,name=room_blocks,json=roomBlocks,proto3" json:"room_blocks,omitempty"`
+	TotalCount    uint64                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          uint64                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint64                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomBlocksResponse) Reset() {
+	*x = GetRoomBlocksResponse{}
+	mi := &file_hotel_v1_rpc_room_block_get_room_blocks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomBlocksResponse) ProtoMessage() {}
+
+func (x *GetRoomBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_block_get_room_blocks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomBlocksResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRoomBlocksResponse) GetRoomBlocks() []*RoomBlock {
+	if x != nil {
+		return x.RoomBlocks
+	}
+	return nil
+}
+
+func (x *GetRoomBlocksResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetRoomBlocksResponse) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRoomBlocksResponse) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+var File_hotel_v1_rpc_room_block_get_room_blocks_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDesc = "" +
+	"\n" +
+	"-hotel/v1/rpc/room_block/get_room_blocks.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a hotel/v1/models/room_block.proto\"w\n" +
+	"\x14GetRoomBlocksRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x04B\a\xbaH\x042\x02(\x01R\x04page\x12\x1f\n" +
+	"\x05limit\x18\x03 \x01(\x04B\t\xbaH\x062\x04\x18d(\x01R\x05limit\"\x98\x01\n" +
+	"\x15GetRoomBlocksResponse\x124\n" +
+	"\vroom_blocks\x18\x01 \x03(\v2\x13.hotel.v1.RoomBlockR\n" +
+	"roomBlocks\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x04R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x04R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x04R\x05limitB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDesc), len(file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_room_block_get_room_blocks_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_room_block_get_room_blocks_proto_goTypes = []any{
+	(*GetRoomBlocksRequest)(nil),  // 0: hotel.v1.GetRoomBlocksRequest
+	(*GetRoomBlocksResponse)(nil), // 1: hotel.v1.GetRoomBlocksResponse
+	(*RoomBlock)(nil),             // 2: hotel.v1.RoomBlock
+}
+var file_hotel_v1_rpc_room_block_get_room_blocks_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.GetRoomBlocksResponse.room_blocks:type_name -> hotel.v1.RoomBlock
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_room_block_get_room_blocks_proto_init() }
+func file_hotel_v1_rpc_room_block_get_room_blocks_proto_init() {
+	if File_hotel_v1_rpc_room_block_get_room_blocks_proto != nil {
+		return
+	}
+	file_hotel_v1_models_room_block_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDesc), len(file_hotel_v1_rpc_room_block_get_room_blocks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_room_block_get_room_blocks_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_room_block_get_room_blocks_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_room_block_get_room_blocks_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_room_block_get_room_blocks_proto = out.File
+	file_hotel_v1_rpc_room_block_get_room_blocks_proto_goTypes = nil
+	file_hotel_v1_rpc_room_block_get_room_blocks_proto_depIdxs = nil
+}
@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/room_category/get_room_category.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRoomCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomCategoryRequest) Reset() {
+	*x = GetRoomCategoryRequest{}
+	mi := &file_hotel_v1_rpc_room_category_get_room_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomCategoryRequest) ProtoMessage() {}
+
+func (x *GetRoomCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_category_get_room_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_category_get_room_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRoomCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRoomCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomCategory  *RoomCategory          `protobuf:"bytes,1,opt,name=room_category,json=roomCategory,proto3" json:"room_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomCategoryResponse) Reset() {
+	*x = GetRoomCategoryResponse{}
+	mi := &file_hotel_v1_rpc_room_category_get_room_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomCategoryResponse) ProtoMessage() {}
+
+func (x *GetRoomCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_category_get_room_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_category_get_room_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRoomCategoryResponse) GetRoomCategory() *RoomCategory {
+	if x != nil {
+		return x.RoomCategory
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_room_category_get_room_category_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_room_category_get_room_category_proto_rawDesc = "" +
+	"\n" +
+	"2hotel/v1/rpc/room_category/get_room_category.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a#hotel/v1/models/room_category.proto\"2\n" +
+	"\x16GetRoomCategoryRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"V\n" +
+	"\x17GetRoomCategoryResponse\x12;\n" +
+	"\rroom_category\x18\x01 \x01(\v2\x16.hotel.v1.RoomCategoryR\froomCategoryB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_room_category_get_room_category_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_room_category_get_room_category_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_room_category_get_room_category_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_room_category_get_room_category_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_room_category_get_room_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_category_get_room_category_proto_rawDesc), len(file_hotel_v1_rpc_room_category_get_room_category_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_room_category_get_room_category_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_room_category_get_room_category_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_room_category_get_room_category_proto_goTypes = []any{
+	(*GetRoomCategoryRequest)(nil),  // 0: hotel.v1.GetRoomCategoryRequest
+	(*GetRoomCategoryResponse)(nil), // 1: hotel.v1.GetRoomCategoryResponse
+	(*RoomCategory)(nil),            // 2: hotel.v1.RoomCategory
+}
+var file_hotel_v1_rpc_room_category_get_room_category_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.GetRoomCategoryResponse.room_category:type_name -> hotel.v1.RoomCategory
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_room_category_get_room_category_proto_init() }
+func file_hotel_v1_rpc_room_category_get_room_category_proto_init() {
+	if File_hotel_v1_rpc_room_category_get_room_category_proto != nil {
+		return
+	}
+	file_hotel_v1_models_room_category_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_category_get_room_category_proto_rawDesc), len(file_hotel_v1_rpc_room_category_get_room_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_room_category_get_room_category_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_room_category_get_room_category_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_room_category_get_room_category_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_room_category_get_room_category_proto = out.File
+	file_hotel_v1_rpc_room_category_get_room_category_proto_goTypes = nil
+	file_hotel_v1_rpc_room_category_get_room_category_proto_depIdxs = nil
+}
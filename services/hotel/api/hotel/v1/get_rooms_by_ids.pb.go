@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/room/get_rooms_by_ids.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetRoomsByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomsByIDsRequest) Reset() {
+	*x = GetRoomsByIDsRequest{}
+	mi := &file_hotel_v1_rpc_room_get_rooms_by_ids_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomsByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomsByIDsRequest) ProtoMessage() {}
+
+func (x *GetRoomsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_get_rooms_by_ids_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRoomsByIDsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetRoomsByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*Room                `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	NotFoundIds   []string               `protobuf:"bytes,2,rep,name=not_found_ids,json=notFoundIds,proto3" json:"not_found_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomsByIDsResponse) Reset() {
+	*x = GetRoomsByIDsResponse{}
+	mi := &file_hotel_v1_rpc_room_get_rooms_by_ids_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomsByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomsByIDsResponse) ProtoMessage() {}
+
+func (x *GetRoomsByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_get_rooms_by_ids_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomsByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRoomsByIDsResponse) GetRooms() []*Room {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+func (x *GetRoomsByIDsResponse) GetNotFoundIds() []string {
+	if x != nil {
+		return x.NotFoundIds
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_room_get_rooms_by_ids_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDesc = "" +
+	"\n" +
+	"(hotel/v1/rpc/room/get_rooms_by_ids.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ahotel/v1/models/room.proto\"=\n" +
+	"\x14GetRoomsByIDsRequest\x12%\n" +
+	"\x03ids\x18\x01 \x03(\tB\x13\xbaH\x10\x92\x01\r\b\x01\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\x03ids\"a\n" +
+	"\x15GetRoomsByIDsResponse\x12$\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x0e.hotel.v1.RoomR\x05rooms\x12\"\n" +
+	"\rnot_found_ids\x18\x02 \x03(\tR\vnotFoundIdsB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDesc), len(file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_room_get_rooms_by_ids_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_room_get_rooms_by_ids_proto_goTypes = []any{
+	(*GetRoomsByIDsRequest)(nil),  // 0: hotel.v1.GetRoomsByIDsRequest
+	(*GetRoomsByIDsResponse)(nil), // 1: hotel.v1.GetRoomsByIDsResponse
+	(*Room)(nil),                  // 2: hotel.v1.Room
+}
+var file_hotel_v1_rpc_room_get_rooms_by_ids_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.GetRoomsByIDsResponse.rooms:type_name -> hotel.v1.Room
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_room_get_rooms_by_ids_proto_init() }
+func file_hotel_v1_rpc_room_get_rooms_by_ids_proto_init() {
+	if File_hotel_v1_rpc_room_get_rooms_by_ids_proto != nil {
+		return
+	}
+	file_hotel_v1_models_room_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDesc), len(file_hotel_v1_rpc_room_get_rooms_by_ids_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_room_get_rooms_by_ids_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_room_get_rooms_by_ids_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_room_get_rooms_by_ids_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_room_get_rooms_by_ids_proto = out.File
+	file_hotel_v1_rpc_room_get_rooms_by_ids_proto_goTypes = nil
+	file_hotel_v1_rpc_room_get_rooms_by_ids_proto_depIdxs = nil
+}
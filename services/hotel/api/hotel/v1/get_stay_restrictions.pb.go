@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/stay_restriction/get_stay_restrictions.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetStayRestrictionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CitySlug      string                 `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
+	HotelSlug     string                 `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	Page          uint64                 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint64                 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStayRestrictionsRequest) Reset() {
+	*x = GetStayRestrictionsRequest{}
+	mi := &file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStayRestrictionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStayRestrictionsRequest) ProtoMessage() {}
+
+func (x *GetStayRestrictionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStayRestrictionsRequest.ProtoReflect.Descriptor instead.
+func (*GetStayRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetStayRestrictionsRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *GetStayRestrictionsRequest) GetCitySlug() string {
+	if x != nil {
+		return x.CitySlug
+	}
+	return ""
+}
+
+func (x *GetStayRestrictionsRequest) GetHotelSlug() string {
+	if x != nil {
+		return x.HotelSlug
+	}
+	return ""
+}
+
+func (x *GetStayRestrictionsRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetStayRestrictionsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetStayRestrictionsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StayRestrictions []*StayRestriction     `protobuf:"bytes,1,rep,name=stay_restrictions,json=stayRestrictions,proto3" json:"stay_restrictions,omitempty"`
+	TotalCount       uint64                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page             uint64                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit            uint64                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetStayRestrictionsResponse) Reset() {
+	*x = GetStayRestrictionsResponse{}
+	mi := &file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStayRestrictionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStayRestrictionsResponse) ProtoMessage() {}
+
+func (x *GetStayRestrictionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStayRestrictionsResponse.ProtoReflect.Descriptor instead.
+func (*GetStayRestrictionsResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetStayRestrictionsResponse) GetStayRestrictions() []*StayRestriction {
+	if x != nil {
+		return x.StayRestrictions
+	}
+	return nil
+}
+
+func (x *GetStayRestrictionsResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetStayRestrictionsResponse) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetStayRestrictionsResponse) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+var File_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDesc = "" +
+	"\n" +
+	"9hotel/v1/rpc/stay_restriction/get_stay_restrictions.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a&hotel/v1/models/stay_restriction.proto\"\x8e\x02\n" +
+	"\x1aGetStayRestrictionsRequest\x124\n" +
+	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[a-z]{2}$R\vcountryCode\x12<\n" +
+	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
+	"\n" +
+	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\x12\x1b\n" +
+	"\x04page\x18\x04 \x01(\x04B\a\xbaH\x042\x02(\x01R\x04page\x12\x1f\n" +
+	"\x05limit\x18\x05 \x01(\x04B\t\xbaH\x062\x04\x18d(\x01R\x05limit\"\xb0\x01\n" +
+	"\x1bGetStayRestrictionsResponse\x12F\n" +
+	"\x11stay_restrictions\x18\x01 \x03(\v2\x19.hotel.v1.StayRestrictionR\x10stayRestrictions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x04R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x04R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x04R\x05limitB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDesc), len(file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_goTypes = []any{
+	(*GetStayRestrictionsRequest)(nil),  // 0: hotel.v1.GetStayRestrictionsRequest
+	(*GetStayRestrictionsResponse)(nil), // 1: hotel.v1.GetStayRestrictionsResponse
+	(*StayRestriction)(nil),             // 2: hotel.v1.StayRestriction
+}
+var file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.GetStayRestrictionsResponse.stay_restrictions:type_name -> hotel.v1.StayRestriction
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_init() }
+func file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_init() {
+	if File_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto != nil {
+		return
+	}
+	file_hotel_v1_models_stay_restriction_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDesc), len(file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto = out.File
+	file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_goTypes = nil
+	file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_depIdxs = nil
+}
@@ -85,6 +85,11 @@ type CreateHotel struct {
 	Location      *Location              `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Timezone      string                 `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CheckInTime   string                 `protobuf:"bytes,11,opt,name=check_in_time,json=checkInTime,proto3" json:"check_in_time,omitempty"`
+	CheckOutTime  string                 `protobuf:"bytes,12,opt,name=check_out_time,json=checkOutTime,proto3" json:"check_out_time,omitempty"`
+	Version       int64                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	Status        HotelStatus            `protobuf:"varint,14,opt,name=status,proto3,enum=hotel.v1.HotelStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,6 +187,41 @@ func (x *CreateHotel) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateHotel) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateHotel) GetCheckInTime() string {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return ""
+}
+
+func (x *CreateHotel) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
+}
+
+func (x *CreateHotel) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CreateHotel) GetStatus() HotelStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HotelStatus_HOTEL_STATUS_UNSPECIFIED
+}
+
 type Hotel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -193,6 +233,16 @@ type Hotel struct {
 	Location      *Location              `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HotelSlug     string                 `protobuf:"bytes,10,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,11,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CitySlug      string                 `protobuf:"bytes,12,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
+	Timezone      string                 `protobuf:"bytes,13,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CheckInTime   string                 `protobuf:"bytes,14,opt,name=check_in_time,json=checkInTime,proto3" json:"check_in_time,omitempty"`
+	CheckOutTime  string                 `protobuf:"bytes,15,opt,name=check_out_time,json=checkOutTime,proto3" json:"check_out_time,omitempty"`
+	Policy        *HotelPolicy           `protobuf:"bytes,16,opt,name=policy,proto3" json:"policy,omitempty"`
+	Version       int64                  `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`
+	Status        HotelStatus            `protobuf:"varint,18,opt,name=status,proto3,enum=hotel.v1.HotelStatus" json:"status,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -290,6 +340,76 @@ func (x *Hotel) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Hotel) GetHotelSlug() string {
+	if x != nil {
+		return x.HotelSlug
+	}
+	return ""
+}
+
+func (x *Hotel) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *Hotel) GetCitySlug() string {
+	if x != nil {
+		return x.CitySlug
+	}
+	return ""
+}
+
+func (x *Hotel) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Hotel) GetCheckInTime() string {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return ""
+}
+
+func (x *Hotel) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
+}
+
+func (x *Hotel) GetPolicy() *HotelPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *Hotel) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Hotel) GetStatus() HotelStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HotelStatus_HOTEL_STATUS_UNSPECIFIED
+}
+
+func (x *Hotel) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 type HotelShort struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -387,6 +507,10 @@ type UpdateHotel struct {
 	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
 	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Location      *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Timezone      *string                `protobuf:"bytes,4,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	CheckInTime   *string                `protobuf:"bytes,5,opt,name=check_in_time,json=checkInTime,proto3,oneof" json:"check_in_time,omitempty"`
+	CheckOutTime  *string                `protobuf:"bytes,6,opt,name=check_out_time,json=checkOutTime,proto3,oneof" json:"check_out_time,omitempty"`
+	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -442,10 +566,39 @@ func (x *UpdateHotel) GetLocation() *Location {
 	return nil
 }
 
+func (x *UpdateHotel) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateHotel) GetCheckInTime() string {
+	if x != nil && x.CheckInTime != nil {
+		return *x.CheckInTime
+	}
+	return ""
+}
+
+func (x *UpdateHotel) GetCheckOutTime() string {
+	if x != nil && x.CheckOutTime != nil {
+		return *x.CheckOutTime
+	}
+	return ""
+}
+
+func (x *UpdateHotel) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateHotelTitle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	HotelSlug     string                 `protobuf:"bytes,2,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -494,14 +647,21 @@ func (x *UpdateHotelTitle) GetHotelSlug() string {
 	return ""
 }
 
+func (x *UpdateHotelTitle) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 var File_hotel_v1_models_hotel_proto protoreflect.FileDescriptor
 
 const file_hotel_v1_models_hotel_proto_rawDesc = "" +
 	"\n" +
-	"\x1bhotel/v1/models/hotel.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"D\n" +
+	"\x1bhotel/v1/models/hotel.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"hotel/v1/models/hotel_policy.proto\x1a!hotel/v1/enums/hotel_status.proto\"D\n" +
 	"\bLocation\x12\x1a\n" +
 	"\blatitude\x18\x01 \x01(\x02R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x02 \x01(\x02R\tlongitude\"\xcf\x02\n" +
+	"\tlongitude\x18\x02 \x01(\x02R\tlongitude\"\xfe\x03\n" +
 	"\vCreateHotel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
@@ -514,7 +674,13 @@ const file_hotel_v1_models_hotel_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd2\x02\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\btimezone\x18\n" +
+	" \x01(\tR\btimezone\x12\"\n" +
+	"\rcheck_in_time\x18\v \x01(\tR\vcheckInTime\x12$\n" +
+	"\x0echeck_out_time\x18\f \x01(\tR\fcheckOutTime\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\x12-\n" +
+	"\x06status\x18\x0e \x01(\x0e2\x15.hotel.v1.HotelStatusR\x06status\"\xca\x05\n" +
 	"\x05Hotel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x19\n" +
@@ -526,7 +692,20 @@ const file_hotel_v1_models_hotel_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\t\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"hotel_slug\x18\n" +
+	" \x01(\tR\thotelSlug\x12!\n" +
+	"\fcountry_code\x18\v \x01(\tR\vcountryCode\x12\x1b\n" +
+	"\tcity_slug\x18\f \x01(\tR\bcitySlug\x12\x1a\n" +
+	"\btimezone\x18\r \x01(\tR\btimezone\x12\"\n" +
+	"\rcheck_in_time\x18\x0e \x01(\tR\vcheckInTime\x12$\n" +
+	"\x0echeck_out_time\x18\x0f \x01(\tR\fcheckOutTime\x12-\n" +
+	"\x06policy\x18\x10 \x01(\v2\x15.hotel.v1.HotelPolicyR\x06policy\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\x03R\aversion\x12-\n" +
+	"\x06status\x18\x12 \x01(\x0e2\x15.hotel.v1.HotelStatusR\x06status\x129\n" +
+	"\n" +
+	"deleted_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAtB\t\n" +
 	"\a_rating\"\xde\x01\n" +
 	"\n" +
 	"HotelShort\x12\x0e\n" +
@@ -538,15 +717,23 @@ const file_hotel_v1_models_hotel_proto_rawDesc = "" +
 	"\x06rating\x18\x05 \x01(\x02H\x00R\x06rating\x88\x01\x01\x12\x18\n" +
 	"\aaddress\x18\x06 \x01(\tR\aaddress\x12.\n" +
 	"\blocation\x18\a \x01(\v2\x12.hotel.v1.LocationR\blocationB\t\n" +
-	"\a_rating\"y\n" +
+	"\a_rating\"\xba\x02\n" +
 	"\vUpdateHotel\x12 \n" +
 	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x12.\n" +
-	"\blocation\x18\x03 \x01(\v2\x12.hotel.v1.LocationR\blocation\"G\n" +
+	"\blocation\x18\x03 \x01(\v2\x12.hotel.v1.LocationR\blocation\x12\x1f\n" +
+	"\btimezone\x18\x04 \x01(\tH\x00R\btimezone\x88\x01\x01\x12'\n" +
+	"\rcheck_in_time\x18\x05 \x01(\tH\x01R\vcheckInTime\x88\x01\x01\x12)\n" +
+	"\x0echeck_out_time\x18\x06 \x01(\tH\x02R\fcheckOutTime\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversionB\v\n" +
+	"\t_timezoneB\x10\n" +
+	"\x0e_check_in_timeB\x11\n" +
+	"\x0f_check_out_time\"a\n" +
 	"\x10UpdateHotelTitle\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
 	"\n" +
-	"hotel_slug\x18\x02 \x01(\tR\thotelSlugB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+	"hotel_slug\x18\x02 \x01(\tR\thotelSlug\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversionB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
 
 var (
 	file_hotel_v1_models_hotel_proto_rawDescOnce sync.Once
@@ -569,21 +756,27 @@ var file_hotel_v1_models_hotel_proto_goTypes = []any{
 	(*UpdateHotel)(nil),           // 4: hotel.v1.UpdateHotel
 	(*UpdateHotelTitle)(nil),      // 5: hotel.v1.UpdateHotelTitle
 	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(HotelStatus)(0),              // 7: hotel.v1.HotelStatus
+	(*HotelPolicy)(nil),           // 8: hotel.v1.HotelPolicy
 }
 var file_hotel_v1_models_hotel_proto_depIdxs = []int32{
-	0, // 0: hotel.v1.CreateHotel.location:type_name -> hotel.v1.Location
-	6, // 1: hotel.v1.CreateHotel.created_at:type_name -> google.protobuf.Timestamp
-	6, // 2: hotel.v1.CreateHotel.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 3: hotel.v1.Hotel.location:type_name -> hotel.v1.Location
-	6, // 4: hotel.v1.Hotel.created_at:type_name -> google.protobuf.Timestamp
-	6, // 5: hotel.v1.Hotel.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 6: hotel.v1.HotelShort.location:type_name -> hotel.v1.Location
-	0, // 7: hotel.v1.UpdateHotel.location:type_name -> hotel.v1.Location
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: hotel.v1.CreateHotel.location:type_name -> hotel.v1.Location
+	6,  // 1: hotel.v1.CreateHotel.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 2: hotel.v1.CreateHotel.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: hotel.v1.CreateHotel.status:type_name -> hotel.v1.HotelStatus
+	0,  // 4: hotel.v1.Hotel.location:type_name -> hotel.v1.Location
+	6,  // 5: hotel.v1.Hotel.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: hotel.v1.Hotel.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 7: hotel.v1.Hotel.policy:type_name -> hotel.v1.HotelPolicy
+	7,  // 8: hotel.v1.Hotel.status:type_name -> hotel.v1.HotelStatus
+	6,  // 9: hotel.v1.Hotel.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 10: hotel.v1.HotelShort.location:type_name -> hotel.v1.Location
+	0,  // 11: hotel.v1.UpdateHotel.location:type_name -> hotel.v1.Location
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_hotel_v1_models_hotel_proto_init() }
@@ -591,8 +784,11 @@ func file_hotel_v1_models_hotel_proto_init() {
 	if File_hotel_v1_models_hotel_proto != nil {
 		return
 	}
+	file_hotel_v1_models_hotel_policy_proto_init()
+	file_hotel_v1_enums_hotel_status_proto_init()
 	file_hotel_v1_models_hotel_proto_msgTypes[2].OneofWrappers = []any{}
 	file_hotel_v1_models_hotel_proto_msgTypes[3].OneofWrappers = []any{}
+	file_hotel_v1_models_hotel_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,569 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/events/hotel_events.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HotelUpdated tells that the hotel changed; consumers read the new state from
+// the hotel service.
+type HotelUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotelUpdated) Reset() {
+	*x = HotelUpdated{}
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotelUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotelUpdated) ProtoMessage() {}
+
+func (x *HotelUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotelUpdated.ProtoReflect.Descriptor instead.
+func (*HotelUpdated) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_events_hotel_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HotelUpdated) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *HotelUpdated) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *HotelUpdated) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type RoomUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomUpdated) Reset() {
+	*x = RoomUpdated{}
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomUpdated) ProtoMessage() {}
+
+func (x *RoomUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomUpdated.ProtoReflect.Descriptor instead.
+func (*RoomUpdated) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_events_hotel_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RoomUpdated) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomUpdated) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *RoomUpdated) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RoomUpdated) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type RoomPriceChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	OldPrice      string                 `protobuf:"bytes,3,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice      string                 `protobuf:"bytes,4,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomPriceChanged) Reset() {
+	*x = RoomPriceChanged{}
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomPriceChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomPriceChanged) ProtoMessage() {}
+
+func (x *RoomPriceChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomPriceChanged.ProtoReflect.Descriptor instead.
+func (*RoomPriceChanged) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_events_hotel_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RoomPriceChanged) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomPriceChanged) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *RoomPriceChanged) GetOldPrice() string {
+	if x != nil {
+		return x.OldPrice
+	}
+	return ""
+}
+
+func (x *RoomPriceChanged) GetNewPrice() string {
+	if x != nil {
+		return x.NewPrice
+	}
+	return ""
+}
+
+func (x *RoomPriceChanged) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RoomPriceChanged) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// HotelDeleted tells that the hotel was deleted together with its rooms.
+type HotelDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotelDeleted) Reset() {
+	*x = HotelDeleted{}
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotelDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotelDeleted) ProtoMessage() {}
+
+func (x *HotelDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotelDeleted.ProtoReflect.Descriptor instead.
+func (*HotelDeleted) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_events_hotel_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HotelDeleted) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *HotelDeleted) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// HotelRestored tells that a deleted hotel is back with the rooms deleted
+// along with it.
+type HotelRestored struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotelRestored) Reset() {
+	*x = HotelRestored{}
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotelRestored) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotelRestored) ProtoMessage() {}
+
+func (x *HotelRestored) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotelRestored.ProtoReflect.Descriptor instead.
+func (*HotelRestored) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_events_hotel_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HotelRestored) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *HotelRestored) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type RoomDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomDeleted) Reset() {
+	*x = RoomDeleted{}
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomDeleted) ProtoMessage() {}
+
+func (x *RoomDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomDeleted.ProtoReflect.Descriptor instead.
+func (*RoomDeleted) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_events_hotel_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoomDeleted) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomDeleted) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *RoomDeleted) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type RoomRestored struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomRestored) Reset() {
+	*x = RoomRestored{}
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomRestored) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomRestored) ProtoMessage() {}
+
+func (x *RoomRestored) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_events_hotel_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomRestored.ProtoReflect.Descriptor instead.
+func (*RoomRestored) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_events_hotel_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RoomRestored) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomRestored) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *RoomRestored) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_hotel_v1_events_hotel_events_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_events_hotel_events_proto_rawDesc = "" +
+	"\n" +
+	"\"hotel/v1/events/hotel_events.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x80\x01\n" +
+	"\fHotelUpdated\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x98\x01\n" +
+	"\vRoomUpdated\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\tR\ahotelId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xd7\x01\n" +
+	"\x10RoomPriceChanged\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\tR\ahotelId\x12\x1b\n" +
+	"\told_price\x18\x03 \x01(\tR\boldPrice\x12\x1b\n" +
+	"\tnew_price\x18\x04 \x01(\tR\bnewPrice\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"f\n" +
+	"\fHotelDeleted\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"g\n" +
+	"\rHotelRestored\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"~\n" +
+	"\vRoomDeleted\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\tR\ahotelId\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x7f\n" +
+	"\fRoomRestored\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\tR\ahotelId\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAtB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_events_hotel_events_proto_rawDescOnce sync.Once
+	file_hotel_v1_events_hotel_events_proto_rawDescData []byte
+)
+
+func file_hotel_v1_events_hotel_events_proto_rawDescGZIP() []byte {
+	file_hotel_v1_events_hotel_events_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_events_hotel_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_events_hotel_events_proto_rawDesc), len(file_hotel_v1_events_hotel_events_proto_rawDesc)))
+	})
+	return file_hotel_v1_events_hotel_events_proto_rawDescData
+}
+
+var file_hotel_v1_events_hotel_events_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_hotel_v1_events_hotel_events_proto_goTypes = []any{
+	(*HotelUpdated)(nil),          // 0: hotel.v1.HotelUpdated
+	(*RoomUpdated)(nil),           // 1: hotel.v1.RoomUpdated
+	(*RoomPriceChanged)(nil),      // 2: hotel.v1.RoomPriceChanged
+	(*HotelDeleted)(nil),          // 3: hotel.v1.HotelDeleted
+	(*HotelRestored)(nil),         // 4: hotel.v1.HotelRestored
+	(*RoomDeleted)(nil),           // 5: hotel.v1.RoomDeleted
+	(*RoomRestored)(nil),          // 6: hotel.v1.RoomRestored
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_hotel_v1_events_hotel_events_proto_depIdxs = []int32{
+	7, // 0: hotel.v1.HotelUpdated.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 1: hotel.v1.RoomUpdated.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 2: hotel.v1.RoomPriceChanged.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 3: hotel.v1.HotelDeleted.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 4: hotel.v1.HotelRestored.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 5: hotel.v1.RoomDeleted.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 6: hotel.v1.RoomRestored.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_events_hotel_events_proto_init() }
+func file_hotel_v1_events_hotel_events_proto_init() {
+	if File_hotel_v1_events_hotel_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_events_hotel_events_proto_rawDesc), len(file_hotel_v1_events_hotel_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_events_hotel_events_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_events_hotel_events_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_events_hotel_events_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_events_hotel_events_proto = out.File
+	file_hotel_v1_events_hotel_events_proto_goTypes = nil
+	file_hotel_v1_events_hotel_events_proto_depIdxs = nil
+}
@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/models/hotel_moderation.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HotelStatusTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	FromStatus    HotelStatus            `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=hotel.v1.HotelStatus" json:"from_status,omitempty"`
+	ToStatus      HotelStatus            `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=hotel.v1.HotelStatus" json:"to_status,omitempty"`
+	ActorId       int64                  `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole     UserRole               `protobuf:"varint,6,opt,name=actor_role,json=actorRole,proto3,enum=hotel.v1.UserRole" json:"actor_role,omitempty"`
+	Reason        *string                `protobuf:"bytes,7,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotelStatusTransition) Reset() {
+	*x = HotelStatusTransition{}
+	mi := &file_hotel_v1_models_hotel_moderation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotelStatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotelStatusTransition) ProtoMessage() {}
+
+func (x *HotelStatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_hotel_moderation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotelStatusTransition.ProtoReflect.Descriptor instead.
+func (*HotelStatusTransition) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_hotel_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HotelStatusTransition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HotelStatusTransition) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *HotelStatusTransition) GetFromStatus() HotelStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return HotelStatus_HOTEL_STATUS_UNSPECIFIED
+}
+
+func (x *HotelStatusTransition) GetToStatus() HotelStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return HotelStatus_HOTEL_STATUS_UNSPECIFIED
+}
+
+func (x *HotelStatusTransition) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *HotelStatusTransition) GetActorRole() UserRole {
+	if x != nil {
+		return x.ActorRole
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *HotelStatusTransition) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *HotelStatusTransition) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_hotel_v1_models_hotel_moderation_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_models_hotel_moderation_proto_rawDesc = "" +
+	"\n" +
+	"&hotel/v1/models/hotel_moderation.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!hotel/v1/enums/hotel_status.proto\x1a\x1ehotel/v1/enums/user_role.proto\"\xdf\x02\n" +
+	"\x15HotelStatusTransition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\tR\ahotelId\x126\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2\x15.hotel.v1.HotelStatusR\n" +
+	"fromStatus\x122\n" +
+	"\tto_status\x18\x04 \x01(\x0e2\x15.hotel.v1.HotelStatusR\btoStatus\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\x03R\aactorId\x121\n" +
+	"\n" +
+	"actor_role\x18\x06 \x01(\x0e2\x12.hotel.v1.UserRoleR\tactorRole\x12\x1b\n" +
+	"\x06reason\x18\a \x01(\tH\x00R\x06reason\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\t\n" +
+	"\a_reasonB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_models_hotel_moderation_proto_rawDescOnce sync.Once
+	file_hotel_v1_models_hotel_moderation_proto_rawDescData []byte
+)
+
+func file_hotel_v1_models_hotel_moderation_proto_rawDescGZIP() []byte {
+	file_hotel_v1_models_hotel_moderation_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_models_hotel_moderation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_models_hotel_moderation_proto_rawDesc), len(file_hotel_v1_models_hotel_moderation_proto_rawDesc)))
+	})
+	return file_hotel_v1_models_hotel_moderation_proto_rawDescData
+}
+
+var file_hotel_v1_models_hotel_moderation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hotel_v1_models_hotel_moderation_proto_goTypes = []any{
+	(*HotelStatusTransition)(nil), // 0: hotel.v1.HotelStatusTransition
+	(HotelStatus)(0),              // 1: hotel.v1.HotelStatus
+	(UserRole)(0),                 // 2: hotel.v1.UserRole
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_hotel_v1_models_hotel_moderation_proto_depIdxs = []int32{
+	1, // 0: hotel.v1.HotelStatusTransition.from_status:type_name -> hotel.v1.HotelStatus
+	1, // 1: hotel.v1.HotelStatusTransition.to_status:type_name -> hotel.v1.HotelStatus
+	2, // 2: hotel.v1.HotelStatusTransition.actor_role:type_name -> hotel.v1.UserRole
+	3, // 3: hotel.v1.HotelStatusTransition.created_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_models_hotel_moderation_proto_init() }
+func file_hotel_v1_models_hotel_moderation_proto_init() {
+	if File_hotel_v1_models_hotel_moderation_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_hotel_status_proto_init()
+	file_hotel_v1_enums_user_role_proto_init()
+	file_hotel_v1_models_hotel_moderation_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_models_hotel_moderation_proto_rawDesc), len(file_hotel_v1_models_hotel_moderation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_models_hotel_moderation_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_models_hotel_moderation_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_models_hotel_moderation_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_models_hotel_moderation_proto = out.File
+	file_hotel_v1_models_hotel_moderation_proto_goTypes = nil
+	file_hotel_v1_models_hotel_moderation_proto_depIdxs = nil
+}
@@ -0,0 +1,396 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/models/hotel_policy.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CancellationTier struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HoursBeforeCheckIn uint32                 `protobuf:"varint,1,opt,name=hours_before_check_in,json=hoursBeforeCheckIn,proto3" json:"hours_before_check_in,omitempty"`
+	Penalty            CancellationPenalty    `protobuf:"varint,2,opt,name=penalty,proto3,enum=hotel.v1.CancellationPenalty" json:"penalty,omitempty"`
+	PenaltyPercent     uint32                 `protobuf:"varint,3,opt,name=penalty_percent,json=penaltyPercent,proto3" json:"penalty_percent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CancellationTier) Reset() {
+	*x = CancellationTier{}
+	mi := &file_hotel_v1_models_hotel_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationTier) ProtoMessage() {}
+
+func (x *CancellationTier) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_hotel_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationTier.ProtoReflect.Descriptor instead.
+func (*CancellationTier) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_hotel_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CancellationTier) GetHoursBeforeCheckIn() uint32 {
+	if x != nil {
+		return x.HoursBeforeCheckIn
+	}
+	return 0
+}
+
+func (x *CancellationTier) GetPenalty() CancellationPenalty {
+	if x != nil {
+		return x.Penalty
+	}
+	return CancellationPenalty_CANCELLATION_PENALTY_UNSPECIFIED
+}
+
+func (x *CancellationTier) GetPenaltyPercent() uint32 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
+type ChildAgeBand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinAge        uint32                 `protobuf:"varint,1,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`
+	MaxAge        uint32                 `protobuf:"varint,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	PricePercent  uint32                 `protobuf:"varint,3,opt,name=price_percent,json=pricePercent,proto3" json:"price_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChildAgeBand) Reset() {
+	*x = ChildAgeBand{}
+	mi := &file_hotel_v1_models_hotel_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChildAgeBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildAgeBand) ProtoMessage() {}
+
+func (x *ChildAgeBand) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_hotel_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildAgeBand.ProtoReflect.Descriptor instead.
+func (*ChildAgeBand) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_hotel_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChildAgeBand) GetMinAge() uint32 {
+	if x != nil {
+		return x.MinAge
+	}
+	return 0
+}
+
+func (x *ChildAgeBand) GetMaxAge() uint32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *ChildAgeBand) GetPricePercent() uint32 {
+	if x != nil {
+		return x.PricePercent
+	}
+	return 0
+}
+
+type PetPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	FeePerNight   *string                `protobuf:"bytes,2,opt,name=fee_per_night,json=feePerNight,proto3,oneof" json:"fee_per_night,omitempty"`
+	MaxPets       uint32                 `protobuf:"varint,3,opt,name=max_pets,json=maxPets,proto3" json:"max_pets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetPolicy) Reset() {
+	*x = PetPolicy{}
+	mi := &file_hotel_v1_models_hotel_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetPolicy) ProtoMessage() {}
+
+func (x *PetPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_hotel_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetPolicy.ProtoReflect.Descriptor instead.
+func (*PetPolicy) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_hotel_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PetPolicy) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *PetPolicy) GetFeePerNight() string {
+	if x != nil && x.FeePerNight != nil {
+		return *x.FeePerNight
+	}
+	return ""
+}
+
+func (x *PetPolicy) GetMaxPets() uint32 {
+	if x != nil {
+		return x.MaxPets
+	}
+	return 0
+}
+
+type HotelPolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	HotelId           string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	CancellationTiers []*CancellationTier    `protobuf:"bytes,2,rep,name=cancellation_tiers,json=cancellationTiers,proto3" json:"cancellation_tiers,omitempty"`
+	PrepaymentPercent uint32                 `protobuf:"varint,3,opt,name=prepayment_percent,json=prepaymentPercent,proto3" json:"prepayment_percent,omitempty"`
+	ChildAgeBands     []*ChildAgeBand        `protobuf:"bytes,4,rep,name=child_age_bands,json=childAgeBands,proto3" json:"child_age_bands,omitempty"`
+	Pets              *PetPolicy             `protobuf:"bytes,5,opt,name=pets,proto3" json:"pets,omitempty"`
+	LatestCheckInTime *string                `protobuf:"bytes,6,opt,name=latest_check_in_time,json=latestCheckInTime,proto3,oneof" json:"latest_check_in_time,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HotelPolicy) Reset() {
+	*x = HotelPolicy{}
+	mi := &file_hotel_v1_models_hotel_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotelPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotelPolicy) ProtoMessage() {}
+
+func (x *HotelPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_hotel_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotelPolicy.ProtoReflect.Descriptor instead.
+func (*HotelPolicy) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_hotel_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HotelPolicy) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *HotelPolicy) GetCancellationTiers() []*CancellationTier {
+	if x != nil {
+		return x.CancellationTiers
+	}
+	return nil
+}
+
+func (x *HotelPolicy) GetPrepaymentPercent() uint32 {
+	if x != nil {
+		return x.PrepaymentPercent
+	}
+	return 0
+}
+
+func (x *HotelPolicy) GetChildAgeBands() []*ChildAgeBand {
+	if x != nil {
+		return x.ChildAgeBands
+	}
+	return nil
+}
+
+func (x *HotelPolicy) GetPets() *PetPolicy {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+func (x *HotelPolicy) GetLatestCheckInTime() string {
+	if x != nil && x.LatestCheckInTime != nil {
+		return *x.LatestCheckInTime
+	}
+	return ""
+}
+
+func (x *HotelPolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *HotelPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_hotel_v1_models_hotel_policy_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_models_hotel_policy_proto_rawDesc = "" +
+	"\n" +
+	"\"hotel/v1/models/hotel_policy.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)hotel/v1/enums/cancellation_penalty.proto\"\xa7\x01\n" +
+	"\x10CancellationTier\x121\n" +
+	"\x15hours_before_check_in\x18\x01 \x01(\rR\x12hoursBeforeCheckIn\x127\n" +
+	"\apenalty\x18\x02 \x01(\x0e2\x1d.hotel.v1.CancellationPenaltyR\apenalty\x12'\n" +
+	"\x0fpenalty_percent\x18\x03 \x01(\rR\x0epenaltyPercent\"e\n" +
+	"\fChildAgeBand\x12\x17\n" +
+	"\amin_age\x18\x01 \x01(\rR\x06minAge\x12\x17\n" +
+	"\amax_age\x18\x02 \x01(\rR\x06maxAge\x12#\n" +
+	"\rprice_percent\x18\x03 \x01(\rR\fpricePercent\"{\n" +
+	"\tPetPolicy\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12'\n" +
+	"\rfee_per_night\x18\x02 \x01(\tH\x00R\vfeePerNight\x88\x01\x01\x12\x19\n" +
+	"\bmax_pets\x18\x03 \x01(\rR\amaxPetsB\x10\n" +
+	"\x0e_fee_per_night\"\xd0\x03\n" +
+	"\vHotelPolicy\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12I\n" +
+	"\x12cancellation_tiers\x18\x02 \x03(\v2\x1a.hotel.v1.CancellationTierR\x11cancellationTiers\x12-\n" +
+	"\x12prepayment_percent\x18\x03 \x01(\rR\x11prepaymentPercent\x12>\n" +
+	"\x0fchild_age_bands\x18\x04 \x03(\v2\x16.hotel.v1.ChildAgeBandR\rchildAgeBands\x12'\n" +
+	"\x04pets\x18\x05 \x01(\v2\x13.hotel.v1.PetPolicyR\x04pets\x124\n" +
+	"\x14latest_check_in_time\x18\x06 \x01(\tH\x00R\x11latestCheckInTime\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x17\n" +
+	"\x15_latest_check_in_timeB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_models_hotel_policy_proto_rawDescOnce sync.Once
+	file_hotel_v1_models_hotel_policy_proto_rawDescData []byte
+)
+
+func file_hotel_v1_models_hotel_policy_proto_rawDescGZIP() []byte {
+	file_hotel_v1_models_hotel_policy_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_models_hotel_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_models_hotel_policy_proto_rawDesc), len(file_hotel_v1_models_hotel_policy_proto_rawDesc)))
+	})
+	return file_hotel_v1_models_hotel_policy_proto_rawDescData
+}
+
+var file_hotel_v1_models_hotel_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_hotel_v1_models_hotel_policy_proto_goTypes = []any{
+	(*CancellationTier)(nil),      // 0: hotel.v1.CancellationTier
+	(*ChildAgeBand)(nil),          // 1: hotel.v1.ChildAgeBand
+	(*PetPolicy)(nil),             // 2: hotel.v1.PetPolicy
+	(*HotelPolicy)(nil),           // 3: hotel.v1.HotelPolicy
+	(CancellationPenalty)(0),      // 4: hotel.v1.CancellationPenalty
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_hotel_v1_models_hotel_policy_proto_depIdxs = []int32{
+	4, // 0: hotel.v1.CancellationTier.penalty:type_name -> hotel.v1.CancellationPenalty
+	0, // 1: hotel.v1.HotelPolicy.cancellation_tiers:type_name -> hotel.v1.CancellationTier
+	1, // 2: hotel.v1.HotelPolicy.child_age_bands:type_name -> hotel.v1.ChildAgeBand
+	2, // 3: hotel.v1.HotelPolicy.pets:type_name -> hotel.v1.PetPolicy
+	5, // 4: hotel.v1.HotelPolicy.created_at:type_name -> google.protobuf.Timestamp
+	5, // 5: hotel.v1.HotelPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_models_hotel_policy_proto_init() }
+func file_hotel_v1_models_hotel_policy_proto_init() {
+	if File_hotel_v1_models_hotel_policy_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_cancellation_penalty_proto_init()
+	file_hotel_v1_models_hotel_policy_proto_msgTypes[2].OneofWrappers = []any{}
+	file_hotel_v1_models_hotel_policy_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_models_hotel_policy_proto_rawDesc), len(file_hotel_v1_models_hotel_policy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_models_hotel_policy_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_models_hotel_policy_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_models_hotel_policy_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_models_hotel_policy_proto = out.File
+	file_hotel_v1_models_hotel_policy_proto_goTypes = nil
+	file_hotel_v1_models_hotel_policy_proto_depIdxs = nil
+}
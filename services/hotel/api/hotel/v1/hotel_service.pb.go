@@ -24,81 +24,334 @@ var File_hotel_v1_hotel_service_proto protoreflect.FileDescriptor
 
 const file_hotel_v1_hotel_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1chotel/v1/hotel_service.proto\x12\bhotel.v1\x1a%hotel/v1/rpc/hotel/create_hotel.proto\x1a#hotel/v1/rpc/room/create_room.proto\x1a#hotel/v1/rpc/hotel/get_hotels.proto\x1a!hotel/v1/rpc/room/get_rooms.proto\x1a\"hotel/v1/rpc/hotel/get_hotel.proto\x1a hotel/v1/rpc/room/get_room.proto\x1a%hotel/v1/rpc/hotel/update_hotel.proto\x1a#hotel/v1/rpc/room/update_room.proto\x1a*hotel/v1/rpc/room/update_room_status.proto\x1a%hotel/v1/rpc/hotel/delete_hotel.proto\x1a#hotel/v1/rpc/room/delete_room.proto\x1a+hotel/v1/rpc/hotel/update_hotel_title.proto2\xd6\x03\n" +
+	"\x1chotel/v1/hotel_service.proto\x12\bhotel.v1\x1a%hotel/v1/rpc/hotel/create_hotel.proto\x1a#hotel/v1/rpc/room/create_room.proto\x1a#hotel/v1/rpc/hotel/get_hotels.proto\x1a!hotel/v1/rpc/room/get_rooms.proto\x1a\"hotel/v1/rpc/hotel/get_hotel.proto\x1a hotel/v1/rpc/room/get_room.proto\x1a%hotel/v1/rpc/hotel/update_hotel.proto\x1a#hotel/v1/rpc/room/update_room.proto\x1a$hotel/v1/rpc/hotel/patch_hotel.proto\x1a\"hotel/v1/rpc/room/patch_room.proto\x1a*hotel/v1/rpc/room/update_room_status.proto\x1a%hotel/v1/rpc/hotel/delete_hotel.proto\x1a#hotel/v1/rpc/room/delete_room.proto\x1a+hotel/v1/rpc/hotel/update_hotel_title.proto\x1a(hotel/v1/rpc/hotel/get_hotel_by_id.proto\x1a*hotel/v1/rpc/hotel/get_hotels_by_ids.proto\x1a,hotel/v1/rpc/hotel/update_hotel_rating.proto\x1a(hotel/v1/rpc/room/get_rooms_by_ids.proto\x1a%hotel/v1/rpc/geo/list_countries.proto\x1a\"hotel/v1/rpc/geo/list_cities.proto\x1a-hotel/v1/rpc/rate_plan/create_rate_plan.proto\x1a+hotel/v1/rpc/rate_plan/get_rate_plans.proto\x1a*hotel/v1/rpc/rate_plan/get_rate_plan.proto\x1a-hotel/v1/rpc/rate_plan/update_rate_plan.proto\x1a-hotel/v1/rpc/rate_plan/delete_rate_plan.proto\x1a'hotel/v1/rpc/rate_plan/quote_stay.proto\x1a;hotel/v1/rpc/stay_restriction/create_stay_restriction.proto\x1a9hotel/v1/rpc/stay_restriction/get_stay_restrictions.proto\x1a8hotel/v1/rpc/stay_restriction/get_stay_restriction.proto\x1a;hotel/v1/rpc/stay_restriction/update_stay_restriction.proto\x1a;hotel/v1/rpc/stay_restriction/delete_stay_restriction.proto\x1a.hotel/v1/rpc/stay_restriction/check_stay.proto\x1a/hotel/v1/rpc/room_block/create_room_block.proto\x1a-hotel/v1/rpc/room_block/get_room_blocks.proto\x1a/hotel/v1/rpc/room_block/delete_room_block.proto\x1a%hotel/v1/rpc/image/upload_image.proto\x1a#hotel/v1/rpc/image/get_images.proto\x1a'hotel/v1/rpc/image/reorder_images.proto\x1a%hotel/v1/rpc/image/delete_image.proto\x1a)hotel/v1/rpc/amenity/create_amenity.proto\x1a(hotel/v1/rpc/amenity/get_amenities.proto\x1a&hotel/v1/rpc/amenity/get_amenity.proto\x1a)hotel/v1/rpc/amenity/update_amenity.proto\x1a)hotel/v1/rpc/amenity/delete_amenity.proto\x1a.hotel/v1/rpc/amenity/set_hotel_amenities.proto\x1a.hotel/v1/rpc/amenity/get_hotel_amenities.proto\x1a0hotel/v1/rpc/hotel_policy/set_hotel_policy.proto\x1a0hotel/v1/rpc/hotel_policy/get_hotel_policy.proto\x1a3hotel/v1/rpc/hotel_policy/delete_hotel_policy.proto\x1a;hotel/v1/rpc/hotel_moderation/submit_hotel_for_review.proto\x1a1hotel/v1/rpc/hotel_moderation/approve_hotel.proto\x1a0hotel/v1/rpc/hotel_moderation/reject_hotel.proto\x1a1hotel/v1/rpc/hotel_moderation/suspend_hotel.proto\x1a<hotel/v1/rpc/hotel_moderation/get_hotel_status_history.proto\x1a5hotel/v1/rpc/room_category/create_room_category.proto\x1a4hotel/v1/rpc/room_category/get_room_categories.proto\x1a2hotel/v1/rpc/room_category/get_room_category.proto\x1a5hotel/v1/rpc/room_category/update_room_category.proto\x1a5hotel/v1/rpc/room_category/delete_room_category.proto\x1a5hotel/v1/rpc/room_category/assign_room_category.proto2\xa1\x06\n" +
 	"\fHotelService\x12J\n" +
 	"\vCreateHotel\x12\x1c.hotel.v1.CreateHotelRequest\x1a\x1d.hotel.v1.CreateHotelResponse\x12D\n" +
 	"\tGetHotels\x12\x1a.hotel.v1.GetHotelsRequest\x1a\x1b.hotel.v1.GetHotelsResponse\x12A\n" +
-	"\bGetHotel\x12\x19.hotel.v1.GetHotelRequest\x1a\x1a.hotel.v1.GetHotelResponse\x12J\n" +
-	"\vUpdateHotel\x12\x1c.hotel.v1.UpdateHotelRequest\x1a\x1d.hotel.v1.UpdateHotelResponse\x12Y\n" +
-	"\x10UpdateHotelTitle\x12!.hotel.v1.UpdateHotelTitleRequest\x1a\".hotel.v1.UpdateHotelTitleResponse\x12J\n" +
-	"\vDeleteHotel\x12\x1c.hotel.v1.DeleteHotelRequest\x1a\x1d.hotel.v1.DeleteHotelResponse2\xc6\x03\n" +
+	"\bGetHotel\x12\x19.hotel.v1.GetHotelRequest\x1a\x1a.hotel.v1.GetHotelResponse\x12M\n" +
+	"\fGetHotelByID\x12\x1d.hotel.v1.GetHotelByIDRequest\x1a\x1e.hotel.v1.GetHotelByIDResponse\x12S\n" +
+	"\x0eGetHotelsByIDs\x12\x1f.hotel.v1.GetHotelsByIDsRequest\x1a .hotel.v1.GetHotelsByIDsResponse\x12J\n" +
+	"\vUpdateHotel\x12\x1c.hotel.v1.UpdateHotelRequest\x1a\x1d.hotel.v1.UpdateHotelResponse\x12G\n" +
+	"\n" +
+	"PatchHotel\x12\x1b.hotel.v1.PatchHotelRequest\x1a\x1c.hotel.v1.PatchHotelResponse\x12Y\n" +
+	"\x10UpdateHotelTitle\x12!.hotel.v1.UpdateHotelTitleRequest\x1a\".hotel.v1.UpdateHotelTitleResponse\x12\\\n" +
+	"\x11UpdateHotelRating\x12\".hotel.v1.UpdateHotelRatingRequest\x1a#.hotel.v1.UpdateHotelRatingResponse\x12J\n" +
+	"\vDeleteHotel\x12\x1c.hotel.v1.DeleteHotelRequest\x1a\x1d.hotel.v1.DeleteHotelResponse2\xde\x04\n" +
 	"\vRoomService\x12G\n" +
 	"\n" +
 	"CreateRoom\x12\x1b.hotel.v1.CreateRoomRequest\x1a\x1c.hotel.v1.CreateRoomResponse\x12A\n" +
 	"\bGetRooms\x12\x19.hotel.v1.GetRoomsRequest\x1a\x1a.hotel.v1.GetRoomsResponse\x12>\n" +
-	"\aGetRoom\x12\x18.hotel.v1.GetRoomRequest\x1a\x19.hotel.v1.GetRoomResponse\x12G\n" +
+	"\aGetRoom\x12\x18.hotel.v1.GetRoomRequest\x1a\x19.hotel.v1.GetRoomResponse\x12P\n" +
+	"\rGetRoomsByIDs\x12\x1e.hotel.v1.GetRoomsByIDsRequest\x1a\x1f.hotel.v1.GetRoomsByIDsResponse\x12G\n" +
 	"\n" +
-	"UpdateRoom\x12\x1b.hotel.v1.UpdateRoomRequest\x1a\x1c.hotel.v1.UpdateRoomResponse\x12Y\n" +
+	"UpdateRoom\x12\x1b.hotel.v1.UpdateRoomRequest\x1a\x1c.hotel.v1.UpdateRoomResponse\x12D\n" +
+	"\tPatchRoom\x12\x1a.hotel.v1.PatchRoomRequest\x1a\x1b.hotel.v1.PatchRoomResponse\x12Y\n" +
 	"\x10UpdateRoomStatus\x12!.hotel.v1.UpdateRoomStatusRequest\x1a\".hotel.v1.UpdateRoomStatusResponse\x12G\n" +
 	"\n" +
-	"DeleteRoom\x12\x1b.hotel.v1.DeleteRoomRequest\x1a\x1c.hotel.v1.DeleteRoomResponseB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+	"DeleteRoom\x12\x1b.hotel.v1.DeleteRoomRequest\x1a\x1c.hotel.v1.DeleteRoomResponse2\xf1\x03\n" +
+	"\x0fRatePlanService\x12S\n" +
+	"\x0eCreateRatePlan\x12\x1f.hotel.v1.CreateRatePlanRequest\x1a .hotel.v1.CreateRatePlanResponse\x12M\n" +
+	"\fGetRatePlans\x12\x1d.hotel.v1.GetRatePlansRequest\x1a\x1e.hotel.v1.GetRatePlansResponse\x12J\n" +
+	"\vGetRatePlan\x12\x1c.hotel.v1.GetRatePlanRequest\x1a\x1d.hotel.v1.GetRatePlanResponse\x12S\n" +
+	"\x0eUpdateRatePlan\x12\x1f.hotel.v1.UpdateRatePlanRequest\x1a .hotel.v1.UpdateRatePlanResponse\x12S\n" +
+	"\x0eDeleteRatePlan\x12\x1f.hotel.v1.DeleteRatePlanRequest\x1a .hotel.v1.DeleteRatePlanResponse\x12D\n" +
+	"\tQuoteStay\x12\x1a.hotel.v1.QuoteStayRequest\x1a\x1b.hotel.v1.QuoteStayResponse2\xe1\x04\n" +
+	"\x16StayRestrictionService\x12h\n" +
+	"\x15CreateStayRestriction\x12&.hotel.v1.CreateStayRestrictionRequest\x1a'.hotel.v1.CreateStayRestrictionResponse\x12b\n" +
+	"\x13GetStayRestrictions\x12$.hotel.v1.GetStayRestrictionsRequest\x1a%.hotel.v1.GetStayRestrictionsResponse\x12_\n" +
+	"\x12GetStayRestriction\x12#.hotel.v1.GetStayRestrictionRequest\x1a$.hotel.v1.GetStayRestrictionResponse\x12h\n" +
+	"\x15UpdateStayRestriction\x12&.hotel.v1.UpdateStayRestrictionRequest\x1a'.hotel.v1.UpdateStayRestrictionResponse\x12h\n" +
+	"\x15DeleteStayRestriction\x12&.hotel.v1.DeleteStayRestrictionRequest\x1a'.hotel.v1.DeleteStayRestrictionResponse\x12D\n" +
+	"\tCheckStay\x12\x1a.hotel.v1.CheckStayRequest\x1a\x1b.hotel.v1.CheckStayResponse2\x94\x02\n" +
+	"\x10RoomBlockService\x12V\n" +
+	"\x0fCreateRoomBlock\x12 .hotel.v1.CreateRoomBlockRequest\x1a!.hotel.v1.CreateRoomBlockResponse\x12P\n" +
+	"\rGetRoomBlocks\x12\x1e.hotel.v1.GetRoomBlocksRequest\x1a\x1f.hotel.v1.GetRoomBlocksResponse\x12V\n" +
+	"\x0fDeleteRoomBlock\x12 .hotel.v1.DeleteRoomBlockRequest\x1a!.hotel.v1.DeleteRoomBlockResponse2\xc0\x02\n" +
+	"\fImageService\x12L\n" +
+	"\vUploadImage\x12\x1c.hotel.v1.UploadImageRequest\x1a\x1d.hotel.v1.UploadImageResponse(\x01\x12D\n" +
+	"\tGetImages\x12\x1a.hotel.v1.GetImagesRequest\x1a\x1b.hotel.v1.GetImagesResponse\x12P\n" +
+	"\rReorderImages\x12\x1e.hotel.v1.ReorderImagesRequest\x1a\x1f.hotel.v1.ReorderImagesResponse\x12J\n" +
+	"\vDeleteImage\x12\x1c.hotel.v1.DeleteImageRequest\x1a\x1d.hotel.v1.DeleteImageResponse2\xda\x04\n" +
+	"\x0eAmenityService\x12P\n" +
+	"\rCreateAmenity\x12\x1e.hotel.v1.CreateAmenityRequest\x1a\x1f.hotel.v1.CreateAmenityResponse\x12M\n" +
+	"\fGetAmenities\x12\x1d.hotel.v1.GetAmenitiesRequest\x1a\x1e.hotel.v1.GetAmenitiesResponse\x12G\n" +
+	"\n" +
+	"GetAmenity\x12\x1b.hotel.v1.GetAmenityRequest\x1a\x1c.hotel.v1.GetAmenityResponse\x12P\n" +
+	"\rUpdateAmenity\x12\x1e.hotel.v1.UpdateAmenityRequest\x1a\x1f.hotel.v1.UpdateAmenityResponse\x12P\n" +
+	"\rDeleteAmenity\x12\x1e.hotel.v1.DeleteAmenityRequest\x1a\x1f.hotel.v1.DeleteAmenityResponse\x12\\\n" +
+	"\x11SetHotelAmenities\x12\".hotel.v1.SetHotelAmenitiesRequest\x1a#.hotel.v1.SetHotelAmenitiesResponse\x12\\\n" +
+	"\x11GetHotelAmenities\x12\".hotel.v1.GetHotelAmenitiesRequest\x1a#.hotel.v1.GetHotelAmenitiesResponse2\xa7\x01\n" +
+	"\n" +
+	"GeoService\x12P\n" +
+	"\rListCountries\x12\x1e.hotel.v1.ListCountriesRequest\x1a\x1f.hotel.v1.ListCountriesResponse\x12G\n" +
+	"\n" +
+	"ListCities\x12\x1b.hotel.v1.ListCitiesRequest\x1a\x1c.hotel.v1.ListCitiesResponse2\x9c\x02\n" +
+	"\x12HotelPolicyService\x12S\n" +
+	"\x0eSetHotelPolicy\x12\x1f.hotel.v1.SetHotelPolicyRequest\x1a .hotel.v1.SetHotelPolicyResponse\x12S\n" +
+	"\x0eGetHotelPolicy\x12\x1f.hotel.v1.GetHotelPolicyRequest\x1a .hotel.v1.GetHotelPolicyResponse\x12\\\n" +
+	"\x11DeleteHotelPolicy\x12\".hotel.v1.DeleteHotelPolicyRequest\x1a#.hotel.v1.DeleteHotelPolicyResponse2\xd3\x03\n" +
+	"\x16HotelModerationService\x12e\n" +
+	"\x14SubmitHotelForReview\x12%.hotel.v1.SubmitHotelForReviewRequest\x1a&.hotel.v1.SubmitHotelForReviewResponse\x12M\n" +
+	"\fApproveHotel\x12\x1d.hotel.v1.ApproveHotelRequest\x1a\x1e.hotel.v1.ApproveHotelResponse\x12J\n" +
+	"\vRejectHotel\x12\x1c.hotel.v1.RejectHotelRequest\x1a\x1d.hotel.v1.RejectHotelResponse\x12M\n" +
+	"\fSuspendHotel\x12\x1d.hotel.v1.SuspendHotelRequest\x1a\x1e.hotel.v1.SuspendHotelResponse\x12h\n" +
+	"\x15GetHotelStatusHistory\x12&.hotel.v1.GetHotelStatusHistoryRequest\x1a'.hotel.v1.GetHotelStatusHistoryResponse2\xcf\x04\n" +
+	"\x13RoomCategoryService\x12_\n" +
+	"\x12CreateRoomCategory\x12#.hotel.v1.CreateRoomCategoryRequest\x1a$.hotel.v1.CreateRoomCategoryResponse\x12\\\n" +
+	"\x11GetRoomCategories\x12\".hotel.v1.GetRoomCategoriesRequest\x1a#.hotel.v1.GetRoomCategoriesResponse\x12V\n" +
+	"\x0fGetRoomCategory\x12 .hotel.v1.GetRoomCategoryRequest\x1a!.hotel.v1.GetRoomCategoryResponse\x12_\n" +
+	"\x12UpdateRoomCategory\x12#.hotel.v1.UpdateRoomCategoryRequest\x1a$.hotel.v1.UpdateRoomCategoryResponse\x12_\n" +
+	"\x12DeleteRoomCategory\x12#.hotel.v1.DeleteRoomCategoryRequest\x1a$.hotel.v1.DeleteRoomCategoryResponse\x12_\n" +
+	"\x12AssignRoomCategory\x12#.hotel.v1.AssignRoomCategoryRequest\x1a$.hotel.v1.AssignRoomCategoryResponseB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
 
 var file_hotel_v1_hotel_service_proto_goTypes = []any{
-	(*CreateHotelRequest)(nil),       // 0: hotel.v1.CreateHotelRequest
-	(*GetHotelsRequest)(nil),         // 1: hotel.v1.GetHotelsRequest
-	(*GetHotelRequest)(nil),          // 2: hotel.v1.GetHotelRequest
-	(*UpdateHotelRequest)(nil),       // 3: hotel.v1.UpdateHotelRequest
-	(*UpdateHotelTitleRequest)(nil),  // 4: hotel.v1.UpdateHotelTitleRequest
-	(*DeleteHotelRequest)(nil),       // 5: hotel.v1.DeleteHotelRequest
-	(*CreateRoomRequest)(nil),        // 6: hotel.v1.CreateRoomRequest
-	(*GetRoomsRequest)(nil),          // 7: hotel.v1.GetRoomsRequest
-	(*GetRoomRequest)(nil),           // 8: hotel.v1.GetRoomRequest
-	(*UpdateRoomRequest)(nil),        // 9: hotel.v1.UpdateRoomRequest
-	(*UpdateRoomStatusRequest)(nil),  // 10: hotel.v1.UpdateRoomStatusRequest
-	(*DeleteRoomRequest)(nil),        // 11: hotel.v1.DeleteRoomRequest
-	(*CreateHotelResponse)(nil),      // 12: hotel.v1.CreateHotelResponse
-	(*GetHotelsResponse)(nil),        // 13: hotel.v1.GetHotelsResponse
-	(*GetHotelResponse)(nil),         // 14: hotel.v1.GetHotelResponse
-	(*UpdateHotelResponse)(nil),      // 15: hotel.v1.UpdateHotelResponse
-	(*UpdateHotelTitleResponse)(nil), // 16: hotel.v1.UpdateHotelTitleResponse
-	(*DeleteHotelResponse)(nil),      // 17: hotel.v1.DeleteHotelResponse
-	(*CreateRoomResponse)(nil),       // 18: hotel.v1.CreateRoomResponse
-	(*GetRoomsResponse)(nil),         // 19: hotel.v1.GetRoomsResponse
-	(*GetRoomResponse)(nil),          // 20: hotel.v1.GetRoomResponse
-	(*UpdateRoomResponse)(nil),       // 21: hotel.v1.UpdateRoomResponse
-	(*UpdateRoomStatusResponse)(nil), // 22: hotel.v1.UpdateRoomStatusResponse
-	(*DeleteRoomResponse)(nil),       // 23: hotel.v1.DeleteRoomResponse
+	(*CreateHotelRequest)(nil),            // 0: hotel.v1.CreateHotelRequest
+	(*GetHotelsRequest)(nil),              // 1: hotel.v1.GetHotelsRequest
+	(*GetHotelRequest)(nil),               // 2: hotel.v1.GetHotelRequest
+	(*GetHotelByIDRequest)(nil),           // 3: hotel.v1.GetHotelByIDRequest
+	(*GetHotelsByIDsRequest)(nil),         // 4: hotel.v1.GetHotelsByIDsRequest
+	(*UpdateHotelRequest)(nil),            // 5: hotel.v1.UpdateHotelRequest
+	(*PatchHotelRequest)(nil),             // 6: hotel.v1.PatchHotelRequest
+	(*UpdateHotelTitleRequest)(nil),       // 7: hotel.v1.UpdateHotelTitleRequest
+	(*UpdateHotelRatingRequest)(nil),      // 8: hotel.v1.UpdateHotelRatingRequest
+	(*DeleteHotelRequest)(nil),            // 9: hotel.v1.DeleteHotelRequest
+	(*CreateRoomRequest)(nil),             // 10: hotel.v1.CreateRoomRequest
+	(*GetRoomsRequest)(nil),               // 11: hotel.v1.GetRoomsRequest
+	(*GetRoomRequest)(nil),                // 12: hotel.v1.GetRoomRequest
+	(*GetRoomsByIDsRequest)(nil),          // 13: hotel.v1.GetRoomsByIDsRequest
+	(*UpdateRoomRequest)(nil),             // 14: hotel.v1.UpdateRoomRequest
+	(*PatchRoomRequest)(nil),              // 15: hotel.v1.PatchRoomRequest
+	(*UpdateRoomStatusRequest)(nil),       // 16: hotel.v1.UpdateRoomStatusRequest
+	(*DeleteRoomRequest)(nil),             // 17: hotel.v1.DeleteRoomRequest
+	(*CreateRatePlanRequest)(nil),         // 18: hotel.v1.CreateRatePlanRequest
+	(*GetRatePlansRequest)(nil),           // 19: hotel.v1.GetRatePlansRequest
+	(*GetRatePlanRequest)(nil),            // 20: hotel.v1.GetRatePlanRequest
+	(*UpdateRatePlanRequest)(nil),         // 21: hotel.v1.UpdateRatePlanRequest
+	(*DeleteRatePlanRequest)(nil),         // 22: hotel.v1.DeleteRatePlanRequest
+	(*QuoteStayRequest)(nil),              // 23: hotel.v1.QuoteStayRequest
+	(*CreateStayRestrictionRequest)(nil),  // 24: hotel.v1.CreateStayRestrictionRequest
+	(*GetStayRestrictionsRequest)(nil),    // 25: hotel.v1.GetStayRestrictionsRequest
+	(*GetStayRestrictionRequest)(nil),     // 26: hotel.v1.GetStayRestrictionRequest
+	(*UpdateStayRestrictionRequest)(nil),  // 27: hotel.v1.UpdateStayRestrictionRequest
+	(*DeleteStayRestrictionRequest)(nil),  // 28: hotel.v1.DeleteStayRestrictionRequest
+	(*CheckStayRequest)(nil),              // 29: hotel.v1.CheckStayRequest
+	(*CreateRoomBlockRequest)(nil),        // 30: hotel.v1.CreateRoomBlockRequest
+	(*GetRoomBlocksRequest)(nil),          // 31: hotel.v1.GetRoomBlocksRequest
+	(*DeleteRoomBlockRequest)(nil),        // 32: hotel.v1.DeleteRoomBlockRequest
+	(*UploadImageRequest)(nil),            // 33: hotel.v1.UploadImageRequest
+	(*GetImagesRequest)(nil),              // 34: hotel.v1.GetImagesRequest
+	(*ReorderImagesRequest)(nil),          // 35: hotel.v1.ReorderImagesRequest
+	(*DeleteImageRequest)(nil),            // 36: hotel.v1.DeleteImageRequest
+	(*CreateAmenityRequest)(nil),          // 37: hotel.v1.CreateAmenityRequest
+	(*GetAmenitiesRequest)(nil),           // 38: hotel.v1.GetAmenitiesRequest
+	(*GetAmenityRequest)(nil),             // 39: hotel.v1.GetAmenityRequest
+	(*UpdateAmenityRequest)(nil),          // 40: hotel.v1.UpdateAmenityRequest
+	(*DeleteAmenityRequest)(nil),          // 41: hotel.v1.DeleteAmenityRequest
+	(*SetHotelAmenitiesRequest)(nil),      // 42: hotel.v1.SetHotelAmenitiesRequest
+	(*GetHotelAmenitiesRequest)(nil),      // 43: hotel.v1.GetHotelAmenitiesRequest
+	(*ListCountriesRequest)(nil),          // 44: hotel.v1.ListCountriesRequest
+	(*ListCitiesRequest)(nil),             // 45: hotel.v1.ListCitiesRequest
+	(*SetHotelPolicyRequest)(nil),         // 46: hotel.v1.SetHotelPolicyRequest
+	(*GetHotelPolicyRequest)(nil),         // 47: hotel.v1.GetHotelPolicyRequest
+	(*DeleteHotelPolicyRequest)(nil),      // 48: hotel.v1.DeleteHotelPolicyRequest
+	(*SubmitHotelForReviewRequest)(nil),   // 49: hotel.v1.SubmitHotelForReviewRequest
+	(*ApproveHotelRequest)(nil),           // 50: hotel.v1.ApproveHotelRequest
+	(*RejectHotelRequest)(nil),            // 51: hotel.v1.RejectHotelRequest
+	(*SuspendHotelRequest)(nil),           // 52: hotel.v1.SuspendHotelRequest
+	(*GetHotelStatusHistoryRequest)(nil),  // 53: hotel.v1.GetHotelStatusHistoryRequest
+	(*CreateRoomCategoryRequest)(nil),     // 54: hotel.v1.CreateRoomCategoryRequest
+	(*GetRoomCategoriesRequest)(nil),      // 55: hotel.v1.GetRoomCategoriesRequest
+	(*GetRoomCategoryRequest)(nil),        // 56: hotel.v1.GetRoomCategoryRequest
+	(*UpdateRoomCategoryRequest)(nil),     // 57: hotel.v1.UpdateRoomCategoryRequest
+	(*DeleteRoomCategoryRequest)(nil),     // 58: hotel.v1.DeleteRoomCategoryRequest
+	(*AssignRoomCategoryRequest)(nil),     // 59: hotel.v1.AssignRoomCategoryRequest
+	(*CreateHotelResponse)(nil),           // 60: hotel.v1.CreateHotelResponse
+	(*GetHotelsResponse)(nil),             // 61: hotel.v1.GetHotelsResponse
+	(*GetHotelResponse)(nil),              // 62: hotel.v1.GetHotelResponse
+	(*GetHotelByIDResponse)(nil),          // 63: hotel.v1.GetHotelByIDResponse
+	(*GetHotelsByIDsResponse)(nil),        // 64: hotel.v1.GetHotelsByIDsResponse
+	(*UpdateHotelResponse)(nil),           // 65: hotel.v1.UpdateHotelResponse
+	(*PatchHotelResponse)(nil),            // 66: hotel.v1.PatchHotelResponse
+	(*UpdateHotelTitleResponse)(nil),      // 67: hotel.v1.UpdateHotelTitleResponse
+	(*UpdateHotelRatingResponse)(nil),     // 68: hotel.v1.UpdateHotelRatingResponse
+	(*DeleteHotelResponse)(nil),           // 69: hotel.v1.DeleteHotelResponse
+	(*CreateRoomResponse)(nil),            // 70: hotel.v1.CreateRoomResponse
+	(*GetRoomsResponse)(nil),              // 71: hotel.v1.GetRoomsResponse
+	(*GetRoomResponse)(nil),               // 72: hotel.v1.GetRoomResponse
+	(*GetRoomsByIDsResponse)(nil),         // 73: hotel.v1.GetRoomsByIDsResponse
+	(*UpdateRoomResponse)(nil),            // 74: hotel.v1.UpdateRoomResponse
+	(*PatchRoomResponse)(nil),             // 75: hotel.v1.PatchRoomResponse
+	(*UpdateRoomStatusResponse)(nil),      // 76: hotel.v1.UpdateRoomStatusResponse
+	(*DeleteRoomResponse)(nil),            // 77: hotel.v1.DeleteRoomResponse
+	(*CreateRatePlanResponse)(nil),        // 78: hotel.v1.CreateRatePlanResponse
+	(*GetRatePlansResponse)(nil),          // 79: hotel.v1.GetRatePlansResponse
+	(*GetRatePlanResponse)(nil),           // 80: hotel.v1.GetRatePlanResponse
+	(*UpdateRatePlanResponse)(nil),        // 81: hotel.v1.UpdateRatePlanResponse
+	(*DeleteRatePlanResponse)(nil),        // 82: hotel.v1.DeleteRatePlanResponse
+	(*QuoteStayResponse)(nil),             // 83: hotel.v1.QuoteStayResponse
+	(*CreateStayRestrictionResponse)(nil), // 84: hotel.v1.CreateStayRestrictionResponse
+	(*GetStayRestrictionsResponse)(nil),   // 85: hotel.v1.GetStayRestrictionsResponse
+	(*GetStayRestrictionResponse)(nil),    // 86: hotel.v1.GetStayRestrictionResponse
+	(*UpdateStayRestrictionResponse)(nil), // 87: hotel.v1.UpdateStayRestrictionResponse
+	(*DeleteStayRestrictionResponse)(nil), // 88: hotel.v1.DeleteStayRestrictionResponse
+	(*CheckStayResponse)(nil),             // 89: hotel.v1.CheckStayResponse
+	(*CreateRoomBlockResponse)(nil),       // 90: hotel.v1.CreateRoomBlockResponse
+	(*GetRoomBlocksResponse)(nil),         // 91: hotel.v1.GetRoomBlocksResponse
+	(*DeleteRoomBlockResponse)(nil),       // 92: hotel.v1.DeleteRoomBlockResponse
+	(*UploadImageResponse)(nil),           // 93: hotel.v1.UploadImageResponse
+	(*GetImagesResponse)(nil),             // 94: hotel.v1.GetImagesResponse
+	(*ReorderImagesResponse)(nil),         // 95: hotel.v1.ReorderImagesResponse
+	(*DeleteImageResponse)(nil),           // 96: hotel.v1.DeleteImageResponse
+	(*CreateAmenityResponse)(nil),         // 97: hotel.v1.CreateAmenityResponse
+	(*GetAmenitiesResponse)(nil),          // 98: hotel.v1.GetAmenitiesResponse
+	(*GetAmenityResponse)(nil),            // 99: hotel.v1.GetAmenityResponse
+	(*UpdateAmenityResponse)(nil),         // 100: hotel.v1.UpdateAmenityResponse
+	(*DeleteAmenityResponse)(nil),         // 101: hotel.v1.DeleteAmenityResponse
+	(*SetHotelAmenitiesResponse)(nil),     // 102: hotel.v1.SetHotelAmenitiesResponse
+	(*GetHotelAmenitiesResponse)(nil),     // 103: hotel.v1.GetHotelAmenitiesResponse
+	(*ListCountriesResponse)(nil),         // 104: hotel.v1.ListCountriesResponse
+	(*ListCitiesResponse)(nil),            // 105: hotel.v1.ListCitiesResponse
+	(*SetHotelPolicyResponse)(nil),        // 106: hotel.v1.SetHotelPolicyResponse
+	(*GetHotelPolicyResponse)(nil),        // 107: hotel.v1.GetHotelPolicyResponse
+	(*DeleteHotelPolicyResponse)(nil),     // 108: hotel.v1.DeleteHotelPolicyResponse
+	(*SubmitHotelForReviewResponse)(nil),  // 109: hotel.v1.SubmitHotelForReviewResponse
+	(*ApproveHotelResponse)(nil),          // 110: hotel.v1.ApproveHotelResponse
+	(*RejectHotelResponse)(nil),           // 111: hotel.v1.RejectHotelResponse
+	(*SuspendHotelResponse)(nil),          // 112: hotel.v1.SuspendHotelResponse
+	(*GetHotelStatusHistoryResponse)(nil), // 113: hotel.v1.GetHotelStatusHistoryResponse
+	(*CreateRoomCategoryResponse)(nil),    // 114: hotel.v1.CreateRoomCategoryResponse
+	(*GetRoomCategoriesResponse)(nil),     // 115: hotel.v1.GetRoomCategoriesResponse
+	(*GetRoomCategoryResponse)(nil),       // 116: hotel.v1.GetRoomCategoryResponse
+	(*UpdateRoomCategoryResponse)(nil),    // 117: hotel.v1.UpdateRoomCategoryResponse
+	(*DeleteRoomCategoryResponse)(nil),    // 118: hotel.v1.DeleteRoomCategoryResponse
+	(*AssignRoomCategoryResponse)(nil),    // 119: hotel.v1.AssignRoomCategoryResponse
 }
 var file_hotel_v1_hotel_service_proto_depIdxs = []int32{
-	0,  // 0: hotel.v1.HotelService.CreateHotel:input_type -> hotel.v1.CreateHotelRequest
-	1,  // 1: hotel.v1.HotelService.GetHotels:input_type -> hotel.v1.GetHotelsRequest
-	2,  // 2: hotel.v1.HotelService.GetHotel:input_type -> hotel.v1.GetHotelRequest
-	3,  // 3: hotel.v1.HotelService.UpdateHotel:input_type -> hotel.v1.UpdateHotelRequest
-	4,  // 4: hotel.v1.HotelService.UpdateHotelTitle:input_type -> hotel.v1.UpdateHotelTitleRequest
-	5,  // 5: hotel.v1.HotelService.DeleteHotel:input_type -> hotel.v1.DeleteHotelRequest
-	6,  // 6: hotel.v1.RoomService.CreateRoom:input_type -> hotel.v1.CreateRoomRequest
-	7,  // 7: hotel.v1.RoomService.GetRooms:input_type -> hotel.v1.GetRoomsRequest
-	8,  // 8: hotel.v1.RoomService.GetRoom:input_type -> hotel.v1.GetRoomRequest
-	9,  // 9: hotel.v1.RoomService.UpdateRoom:input_type -> hotel.v1.UpdateRoomRequest
-	10, // 10: hotel.v1.RoomService.UpdateRoomStatus:input_type -> hotel.v1.UpdateRoomStatusRequest
-	11, // 11: hotel.v1.RoomService.DeleteRoom:input_type -> hotel.v1.DeleteRoomRequest
-	12, // 12: hotel.v1.HotelService.CreateHotel:output_type -> hotel.v1.CreateHotelResponse
-	13, // 13: hotel.v1.HotelService.GetHotels:output_type -> hotel.v1.GetHotelsResponse
-	14, // 14: hotel.v1.HotelService.GetHotel:output_type -> hotel.v1.GetHotelResponse
-	15, // 15: hotel.v1.HotelService.UpdateHotel:output_type -> hotel.v1.UpdateHotelResponse
-	16, // 16: hotel.v1.HotelService.UpdateHotelTitle:output_type -> hotel.v1.UpdateHotelTitleResponse
-	17, // 17: hotel.v1.HotelService.DeleteHotel:output_type -> hotel.v1.DeleteHotelResponse
-	18, // 18: hotel.v1.RoomService.CreateRoom:output_type -> hotel.v1.CreateRoomResponse
-	19, // 19: hotel.v1.RoomService.GetRooms:output_type -> hotel.v1.GetRoomsResponse
-	20, // 20: hotel.v1.RoomService.GetRoom:output_type -> hotel.v1.GetRoomResponse
-	21, // 21: hotel.v1.RoomService.UpdateRoom:output_type -> hotel.v1.UpdateRoomResponse
-	22, // 22: hotel.v1.RoomService.UpdateRoomStatus:output_type -> hotel.v1.UpdateRoomStatusResponse
-	23, // 23: hotel.v1.RoomService.DeleteRoom:output_type -> hotel.v1.DeleteRoomResponse
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: hotel.v1.HotelService.CreateHotel:input_type -> hotel.v1.CreateHotelRequest
+	1,   // 1: hotel.v1.HotelService.GetHotels:input_type -> hotel.v1.GetHotelsRequest
+	2,   // 2: hotel.v1.HotelService.GetHotel:input_type -> hotel.v1.GetHotelRequest
+	3,   // 3: hotel.v1.HotelService.GetHotelByID:input_type -> hotel.v1.GetHotelByIDRequest
+	4,   // 4: hotel.v1.HotelService.GetHotelsByIDs:input_type -> hotel.v1.GetHotelsByIDsRequest
+	5,   // 5: hotel.v1.HotelService.UpdateHotel:input_type -> hotel.v1.UpdateHotelRequest
+	6,   // 6: hotel.v1.HotelService.PatchHotel:input_type -> hotel.v1.PatchHotelRequest
+	7,   // 7: hotel.v1.HotelService.UpdateHotelTitle:input_type -> hotel.v1.UpdateHotelTitleRequest
+	8,   // 8: hotel.v1.HotelService.UpdateHotelRating:input_type -> hotel.v1.UpdateHotelRatingRequest
+	9,   // 9: hotel.v1.HotelService.DeleteHotel:input_type -> hotel.v1.DeleteHotelRequest
+	10,  // 10: hotel.v1.RoomService.CreateRoom:input_type -> hotel.v1.CreateRoomRequest
+	11,  // 11: hotel.v1.RoomService.GetRooms:input_type -> hotel.v1.GetRoomsRequest
+	12,  // 12: hotel.v1.RoomService.GetRoom:input_type -> hotel.v1.GetRoomRequest
+	13,  // 13: hotel.v1.RoomService.GetRoomsByIDs:input_type -> hotel.v1.GetRoomsByIDsRequest
+	14,  // 14: hotel.v1.RoomService.UpdateRoom:input_type -> hotel.v1.UpdateRoomRequest
+	15,  // 15: hotel.v1.RoomService.PatchRoom:input_type -> hotel.v1.PatchRoomRequest
+	16,  // 16: hotel.v1.RoomService.UpdateRoomStatus:input_type -> hotel.v1.UpdateRoomStatusRequest
+	17,  // 17: hotel.v1.RoomService.DeleteRoom:input_type -> hotel.v1.DeleteRoomRequest
+	18,  // 18: hotel.v1.RatePlanService.CreateRatePlan:input_type -> hotel.v1.CreateRatePlanRequest
+	19,  // 19: hotel.v1.RatePlanService.GetRatePlans:input_type -> hotel.v1.GetRatePlansRequest
+	20,  // 20: hotel.v1.RatePlanService.GetRatePlan:input_type -> hotel.v1.GetRatePlanRequest
+	21,  // 21: hotel.v1.RatePlanService.UpdateRatePlan:input_type -> hotel.v1.UpdateRatePlanRequest
+	22,  // 22: hotel.v1.RatePlanService.DeleteRatePlan:input_type -> hotel.v1.DeleteRatePlanRequest
+	23,  // 23: hotel.v1.RatePlanService.QuoteStay:input_type -> hotel.v1.QuoteStayRequest
+	24,  // 24: hotel.v1.StayRestrictionService.CreateStayRestriction:input_type -> hotel.v1.CreateStayRestrictionRequest
+	25,  // 25: hotel.v1.StayRestrictionService.GetStayRestrictions:input_type -> hotel.v1.GetStayRestrictionsRequest
+	26,  // 26: hotel.v1.StayRestrictionService.GetStayRestriction:input_type -> hotel.v1.GetStayRestrictionRequest
+	27,  // 27: hotel.v1.StayRestrictionService.UpdateStayRestriction:input_type -> hotel.v1.UpdateStayRestrictionRequest
+	28,  // 28: hotel.v1.StayRestrictionService.DeleteStayRestriction:input_type -> hotel.v1.DeleteStayRestrictionRequest
+	29,  // 29: hotel.v1.StayRestrictionService.CheckStay:input_type -> hotel.v1.CheckStayRequest
+	30,  // 30: hotel.v1.RoomBlockService.CreateRoomBlock:input_type -> hotel.v1.CreateRoomBlockRequest
+	31,  // 31: hotel.v1.RoomBlockService.GetRoomBlocks:input_type -> hotel.v1.GetRoomBlocksRequest
+	32,  // 32: hotel.v1.RoomBlockService.DeleteRoomBlock:input_type -> hotel.v1.DeleteRoomBlockRequest
+	33,  // 33: hotel.v1.ImageService.UploadImage:input_type -> hotel.v1.UploadImageRequest
+	34,  // 34: hotel.v1.ImageService.GetImages:input_type -> hotel.v1.GetImagesRequest
+	35,  // 35: hotel.v1.ImageService.ReorderImages:input_type -> hotel.v1.ReorderImagesRequest
+	36,  // 36: hotel.v1.ImageService.DeleteImage:input_type -> hotel.v1.DeleteImageRequest
+	37,  // 37: hotel.v1.AmenityService.CreateAmenity:input_type -> hotel.v1.CreateAmenityRequest
+	38,  // 38: hotel.v1.AmenityService.GetAmenities:input_type -> hotel.v1.GetAmenitiesRequest
+	39,  // 39: hotel.v1.AmenityService.GetAmenity:input_type -> hotel.v1.GetAmenityRequest
+	40,  // 40: hotel.v1.AmenityService.UpdateAmenity:input_type -> hotel.v1.UpdateAmenityRequest
+	41,  // 41: hotel.v1.AmenityService.DeleteAmenity:input_type -> hotel.v1.DeleteAmenityRequest
+	42,  // 42: hotel.v1.AmenityService.SetHotelAmenities:input_type -> hotel.v1.SetHotelAmenitiesRequest
+	43,  // 43: hotel.v1.AmenityService.GetHotelAmenities:input_type -> hotel.v1.GetHotelAmenitiesRequest
+	44,  // 44: hotel.v1.GeoService.ListCountries:input_type -> hotel.v1.ListCountriesRequest
+	45,  // 45: hotel.v1.GeoService.ListCities:input_type -> hotel.v1.ListCitiesRequest
+	46,  // 46: hotel.v1.HotelPolicyService.SetHotelPolicy:input_type -> hotel.v1.SetHotelPolicyRequest
+	47,  // 47: hotel.v1.HotelPolicyService.GetHotelPolicy:input_type -> hotel.v1.GetHotelPolicyRequest
+	48,  // 48: hotel.v1.HotelPolicyService.DeleteHotelPolicy:input_type -> hotel.v1.DeleteHotelPolicyRequest
+	49,  // 49: hotel.v1.HotelModerationService.SubmitHotelForReview:input_type -> hotel.v1.SubmitHotelForReviewRequest
+	50,  // 50: hotel.v1.HotelModerationService.ApproveHotel:input_type -> hotel.v1.ApproveHotelRequest
+	51,  // 51: hotel.v1.HotelModerationService.RejectHotel:input_type -> hotel.v1.RejectHotelRequest
+	52,  // 52: hotel.v1.HotelModerationService.SuspendHotel:input_type -> hotel.v1.SuspendHotelRequest
+	53,  // 53: hotel.v1.HotelModerationService.GetHotelStatusHistory:input_type -> hotel.v1.GetHotelStatusHistoryRequest
+	54,  // 54: hotel.v1.RoomCategoryService.CreateRoomCategory:input_type -> hotel.v1.CreateRoomCategoryRequest
+	55,  // 55: hotel.v1.RoomCategoryService.GetRoomCategories:input_type -> hotel.v1.GetRoomCategoriesRequest
+	56,  // 56: hotel.v1.RoomCategoryService.GetRoomCategory:input_type -> hotel.v1.GetRoomCategoryRequest
+	57,  // 57: hotel.v1.RoomCategoryService.UpdateRoomCategory:input_type -> hotel.v1.UpdateRoomCategoryRequest
+	58,  // 58: hotel.v1.RoomCategoryService.DeleteRoomCategory:input_type -> hotel.v1.DeleteRoomCategoryRequest
+	59,  // 59: hotel.v1.RoomCategoryService.AssignRoomCategory:input_type -> hotel.v1.AssignRoomCategoryRequest
+	60,  // 60: hotel.v1.HotelService.CreateHotel:output_type -> hotel.v1.CreateHotelResponse
+	61,  // 61: hotel.v1.HotelService.GetHotels:output_type -> hotel.v1.GetHotelsResponse
+	62,  // 62: hotel.v1.HotelService.GetHotel:output_type -> hotel.v1.GetHotelResponse
+	63,  // 63: hotel.v1.HotelService.GetHotelByID:output_type -> hotel.v1.GetHotelByIDResponse
+	64,  // 64: hotel.v1.HotelService.GetHotelsByIDs:output_type -> hotel.v1.GetHotelsByIDsResponse
+	65,  // 65: hotel.v1.HotelService.UpdateHotel:output_type -> hotel.v1.UpdateHotelResponse
+	66,  // 66: hotel.v1.HotelService.PatchHotel:output_type -> hotel.v1.PatchHotelResponse
+	67,  // 67: hotel.v1.HotelService.UpdateHotelTitle:output_type -> hotel.v1.UpdateHotelTitleResponse
+	68,  // 68: hotel.v1.HotelService.UpdateHotelRating:output_type -> hotel.v1.UpdateHotelRatingResponse
+	69,  // 69: hotel.v1.HotelService.DeleteHotel:output_type -> hotel.v1.DeleteHotelResponse
+	70,  // 70: hotel.v1.RoomService.CreateRoom:output_type -> hotel.v1.CreateRoomResponse
+	71,  // 71: hotel.v1.RoomService.GetRooms:output_type -> hotel.v1.GetRoomsResponse
+	72,  // 72: hotel.v1.RoomService.GetRoom:output_type -> hotel.v1.GetRoomResponse
+	73,  // 73: hotel.v1.RoomService.GetRoomsByIDs:output_type -> hotel.v1.GetRoomsByIDsResponse
+	74,  // 74: hotel.v1.RoomService.UpdateRoom:output_type -> hotel.v1.UpdateRoomResponse
+	75,  // 75: hotel.v1.RoomService.PatchRoom:output_type -> hotel.v1.PatchRoomResponse
+	76,  // 76: hotel.v1.RoomService.UpdateRoomStatus:output_type -> hotel.v1.UpdateRoomStatusResponse
+	77,  // 77: hotel.v1.RoomService.DeleteRoom:output_type -> hotel.v1.DeleteRoomResponse
+	78,  // 78: hotel.v1.RatePlanService.CreateRatePlan:output_type -> hotel.v1.CreateRatePlanResponse
+	79,  // 79: hotel.v1.RatePlanService.GetRatePlans:output_type -> hotel.v1.GetRatePlansResponse
+	80,  // 80: hotel.v1.RatePlanService.GetRatePlan:output_type -> hotel.v1.GetRatePlanResponse
+	81,  // 81: hotel.v1.RatePlanService.UpdateRatePlan:output_type -> hotel.v1.UpdateRatePlanResponse
+	82,  // 82: hotel.v1.RatePlanService.DeleteRatePlan:output_type -> hotel.v1.DeleteRatePlanResponse
+	83,  // 83: hotel.v1.RatePlanService.QuoteStay:output_type -> hotel.v1.QuoteStayResponse
+	84,  // 84: hotel.v1.StayRestrictionService.CreateStayRestriction:output_type -> hotel.v1.CreateStayRestrictionResponse
+	85,  // 85: hotel.v1.StayRestrictionService.GetStayRestrictions:output_type -> hotel.v1.GetStayRestrictionsResponse
+	86,  // 86: hotel.v1.StayRestrictionService.GetStayRestriction:output_type -> hotel.v1.GetStayRestrictionResponse
+	87,  // 87: hotel.v1.StayRestrictionService.UpdateStayRestriction:output_type -> hotel.v1.UpdateStayRestrictionResponse
+	88,  // 88: hotel.v1.StayRestrictionService.DeleteStayRestriction:output_type -> hotel.v1.DeleteStayRestrictionResponse
+	89,  // 89: hotel.v1.StayRestrictionService.CheckStay:output_type -> hotel.v1.CheckStayResponse
+	90,  // 90: hotel.v1.RoomBlockService.CreateRoomBlock:output_type -> hotel.v1.CreateRoomBlockResponse
+	91,  // 91: hotel.v1.RoomBlockService.GetRoomBlocks:output_type -> hotel.v1.GetRoomBlocksResponse
+	92,  // 92: hotel.v1.RoomBlockService.DeleteRoomBlock:output_type -> hotel.v1.DeleteRoomBlockResponse
+	93,  // 93: hotel.v1.ImageService.UploadImage:output_type -> hotel.v1.UploadImageResponse
+	94,  // 94: hotel.v1.ImageService.GetImages:output_type -> hotel.v1.GetImagesResponse
+	95,  // 95: hotel.v1.ImageService.ReorderImages:output_type -> hotel.v1.ReorderImagesResponse
+	96,  // 96: hotel.v1.ImageService.DeleteImage:output_type -> hotel.v1.DeleteImageResponse
+	97,  // 97: hotel.v1.AmenityService.CreateAmenity:output_type -> hotel.v1.CreateAmenityResponse
+	98,  // 98: hotel.v1.AmenityService.GetAmenities:output_type -> hotel.v1.GetAmenitiesResponse
+	99,  // 99: hotel.v1.AmenityService.GetAmenity:output_type -> hotel.v1.GetAmenityResponse
+	100, // 100: hotel.v1.AmenityService.UpdateAmenity:output_type -> hotel.v1.UpdateAmenityResponse
+	101, // 101: hotel.v1.AmenityService.DeleteAmenity:output_type -> hotel.v1.DeleteAmenityResponse
+	102, // 102: hotel.v1.AmenityService.SetHotelAmenities:output_type -> hotel.v1.SetHotelAmenitiesResponse
+	103, // 103: hotel.v1.AmenityService.GetHotelAmenities:output_type -> hotel.v1.GetHotelAmenitiesResponse
+	104, // 104: hotel.v1.GeoService.ListCountries:output_type -> hotel.v1.ListCountriesResponse
+	105, // 105: hotel.v1.GeoService.ListCities:output_type -> hotel.v1.ListCitiesResponse
+	106, // 106: hotel.v1.HotelPolicyService.SetHotelPolicy:output_type -> hotel.v1.SetHotelPolicyResponse
+	107, // 107: hotel.v1.HotelPolicyService.GetHotelPolicy:output_type -> hotel.v1.GetHotelPolicyResponse
+	108, // 108: hotel.v1.HotelPolicyService.DeleteHotelPolicy:output_type -> hotel.v1.DeleteHotelPolicyResponse
+	109, // 109: hotel.v1.HotelModerationService.SubmitHotelForReview:output_type -> hotel.v1.SubmitHotelForReviewResponse
+	110, // 110: hotel.v1.HotelModerationService.ApproveHotel:output_type -> hotel.v1.ApproveHotelResponse
+	111, // 111: hotel.v1.HotelModerationService.RejectHotel:output_type -> hotel.v1.RejectHotelResponse
+	112, // 112: hotel.v1.HotelModerationService.SuspendHotel:output_type -> hotel.v1.SuspendHotelResponse
+	113, // 113: hotel.v1.HotelModerationService.GetHotelStatusHistory:output_type -> hotel.v1.GetHotelStatusHistoryResponse
+	114, // 114: hotel.v1.RoomCategoryService.CreateRoomCategory:output_type -> hotel.v1.CreateRoomCategoryResponse
+	115, // 115: hotel.v1.RoomCategoryService.GetRoomCategories:output_type -> hotel.v1.GetRoomCategoriesResponse
+	116, // 116: hotel.v1.RoomCategoryService.GetRoomCategory:output_type -> hotel.v1.GetRoomCategoryResponse
+	117, // 117: hotel.v1.RoomCategoryService.UpdateRoomCategory:output_type -> hotel.v1.UpdateRoomCategoryResponse
+	118, // 118: hotel.v1.RoomCategoryService.DeleteRoomCategory:output_type -> hotel.v1.DeleteRoomCategoryResponse
+	119, // 119: hotel.v1.RoomCategoryService.AssignRoomCategory:output_type -> hotel.v1.AssignRoomCategoryResponse
+	60,  // [60:120] is the sub-list for method output_type
+	0,   // [0:60] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_hotel_v1_hotel_service_proto_init() }
@@ -114,10 +367,58 @@ func file_hotel_v1_hotel_service_proto_init() {
 	file_hotel_v1_rpc_room_get_room_proto_init()
 	file_hotel_v1_rpc_hotel_update_hotel_proto_init()
 	file_hotel_v1_rpc_room_update_room_proto_init()
+	file_hotel_v1_rpc_hotel_patch_hotel_proto_init()
+	file_hotel_v1_rpc_room_patch_room_proto_init()
 	file_hotel_v1_rpc_room_update_room_status_proto_init()
 	file_hotel_v1_rpc_hotel_delete_hotel_proto_init()
 	file_hotel_v1_rpc_room_delete_room_proto_init()
 	file_hotel_v1_rpc_hotel_update_hotel_title_proto_init()
+	file_hotel_v1_rpc_hotel_get_hotel_by_id_proto_init()
+	file_hotel_v1_rpc_hotel_get_hotels_by_ids_proto_init()
+	file_hotel_v1_rpc_hotel_update_hotel_rating_proto_init()
+	file_hotel_v1_rpc_room_get_rooms_by_ids_proto_init()
+	file_hotel_v1_rpc_geo_list_countries_proto_init()
+	file_hotel_v1_rpc_geo_list_cities_proto_init()
+	file_hotel_v1_rpc_rate_plan_create_rate_plan_proto_init()
+	file_hotel_v1_rpc_rate_plan_get_rate_plans_proto_init()
+	file_hotel_v1_rpc_rate_plan_get_rate_plan_proto_init()
+	file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_init()
+	file_hotel_v1_rpc_rate_plan_delete_rate_plan_proto_init()
+	file_hotel_v1_rpc_rate_plan_quote_stay_proto_init()
+	file_hotel_v1_rpc_stay_restriction_create_stay_restriction_proto_init()
+	file_hotel_v1_rpc_stay_restriction_get_stay_restrictions_proto_init()
+	file_hotel_v1_rpc_stay_restriction_get_stay_restriction_proto_init()
+	file_hotel_v1_rpc_stay_restriction_update_stay_restriction_proto_init()
+	file_hotel_v1_rpc_stay_restriction_delete_stay_restriction_proto_init()
+	file_hotel_v1_rpc_stay_restriction_check_stay_proto_init()
+	file_hotel_v1_rpc_room_block_create_room_block_proto_init()
+	file_hotel_v1_rpc_room_block_get_room_blocks_proto_init()
+	file_hotel_v1_rpc_room_block_delete_room_block_proto_init()
+	file_hotel_v1_rpc_image_upload_image_proto_init()
+	file_hotel_v1_rpc_image_get_images_proto_init()
+	file_hotel_v1_rpc_image_reorder_images_proto_init()
+	file_hotel_v1_rpc_image_delete_image_proto_init()
+	file_hotel_v1_rpc_amenity_create_amenity_proto_init()
+	file_hotel_v1_rpc_amenity_get_amenities_proto_init()
+	file_hotel_v1_rpc_amenity_get_amenity_proto_init()
+	file_hotel_v1_rpc_amenity_update_amenity_proto_init()
+	file_hotel_v1_rpc_amenity_delete_amenity_proto_init()
+	file_hotel_v1_rpc_amenity_set_hotel_amenities_proto_init()
+	file_hotel_v1_rpc_amenity_get_hotel_amenities_proto_init()
+	file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_init()
+	file_hotel_v1_rpc_hotel_policy_get_hotel_policy_proto_init()
+	file_hotel_v1_rpc_hotel_policy_delete_hotel_policy_proto_init()
+	file_hotel_v1_rpc_hotel_moderation_submit_hotel_for_review_proto_init()
+	file_hotel_v1_rpc_hotel_moderation_approve_hotel_proto_init()
+	file_hotel_v1_rpc_hotel_moderation_reject_hotel_proto_init()
+	file_hotel_v1_rpc_hotel_moderation_suspend_hotel_proto_init()
+	file_hotel_v1_rpc_hotel_moderation_get_hotel_status_history_proto_init()
+	file_hotel_v1_rpc_room_category_create_room_category_proto_init()
+	file_hotel_v1_rpc_room_category_get_room_categories_proto_init()
+	file_hotel_v1_rpc_room_category_get_room_category_proto_init()
+	file_hotel_v1_rpc_room_category_update_room_category_proto_init()
+	file_hotel_v1_rpc_room_category_delete_room_category_proto_init()
+	file_hotel_v1_rpc_room_category_assign_room_category_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -126,7 +427,7 @@ func file_hotel_v1_hotel_service_proto_init() {
 			NumEnums:      0,
 			NumMessages:   0,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   11,
 		},
 		GoTypes:           file_hotel_v1_hotel_service_proto_goTypes,
 		DependencyIndexes: file_hotel_v1_hotel_service_proto_depIdxs,
@@ -19,12 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HotelService_CreateHotel_FullMethodName      = "/hotel.v1.HotelService/CreateHotel"
-	HotelService_GetHotels_FullMethodName        = "/hotel.v1.HotelService/GetHotels"
-	HotelService_GetHotel_FullMethodName         = "/hotel.v1.HotelService/GetHotel"
-	HotelService_UpdateHotel_FullMethodName      = "/hotel.v1.HotelService/UpdateHotel"
-	HotelService_UpdateHotelTitle_FullMethodName = "/hotel.v1.HotelService/UpdateHotelTitle"
-	HotelService_DeleteHotel_FullMethodName      = "/hotel.v1.HotelService/DeleteHotel"
+	HotelService_CreateHotel_FullMethodName       = "/hotel.v1.HotelService/CreateHotel"
+	HotelService_GetHotels_FullMethodName         = "/hotel.v1.HotelService/GetHotels"
+	HotelService_GetHotel_FullMethodName          = "/hotel.v1.HotelService/GetHotel"
+	HotelService_GetHotelByID_FullMethodName      = "/hotel.v1.HotelService/GetHotelByID"
+	HotelService_GetHotelsByIDs_FullMethodName    = "/hotel.v1.HotelService/GetHotelsByIDs"
+	HotelService_UpdateHotel_FullMethodName       = "/hotel.v1.HotelService/UpdateHotel"
+	HotelService_PatchHotel_FullMethodName        = "/hotel.v1.HotelService/PatchHotel"
+	HotelService_UpdateHotelTitle_FullMethodName  = "/hotel.v1.HotelService/UpdateHotelTitle"
+	HotelService_UpdateHotelRating_FullMethodName = "/hotel.v1.HotelService/UpdateHotelRating"
+	HotelService_DeleteHotel_FullMethodName       = "/hotel.v1.HotelService/DeleteHotel"
 )
 
 // HotelServiceClient is the client API for HotelService service.
@@ -34,8 +38,12 @@ type HotelServiceClient interface {
 	CreateHotel(ctx context.Context, in *CreateHotelRequest, opts ...grpc.CallOption) (*CreateHotelResponse, error)
 	GetHotels(ctx context.Context, in *GetHotelsRequest, opts ...grpc.CallOption) (*GetHotelsResponse, error)
 	GetHotel(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*GetHotelResponse, error)
+	GetHotelByID(ctx context.Context, in *GetHotelByIDRequest, opts ...grpc.CallOption) (*GetHotelByIDResponse, error)
+	GetHotelsByIDs(ctx context.Context, in *GetHotelsByIDsRequest, opts ...grpc.CallOption) (*GetHotelsByIDsResponse, error)
 	UpdateHotel(ctx context.Context, in *UpdateHotelRequest, opts ...grpc.CallOption) (*UpdateHotelResponse, error)
+	PatchHotel(ctx context.Context, in *PatchHotelRequest, opts ...grpc.CallOption) (*PatchHotelResponse, error)
 	UpdateHotelTitle(ctx context.Context, in *UpdateHotelTitleRequest, opts ...grpc.CallOption) (*UpdateHotelTitleResponse, error)
+	UpdateHotelRating(ctx context.Context, in *UpdateHotelRatingRequest, opts ...grpc.CallOption) (*UpdateHotelRatingResponse, error)
 	DeleteHotel(ctx context.Context, in *DeleteHotelRequest, opts ...grpc.CallOption) (*DeleteHotelResponse, error)
 }
 
@@ -77,6 +85,26 @@ func (c *hotelServiceClient) GetHotel(ctx context.Context, in *GetHotelRequest,
 	return out, nil
 }
 
+func (c *hotelServiceClient) GetHotelByID(ctx context.Context, in *GetHotelByIDRequest, opts ...grpc.CallOption) (*GetHotelByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHotelByIDResponse)
+	err := c.cc.Invoke(ctx, HotelService_GetHotelByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelServiceClient) GetHotelsByIDs(ctx context.Context, in *GetHotelsByIDsRequest, opts ...grpc.CallOption) (*GetHotelsByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHotelsByIDsResponse)
+	err := c.cc.Invoke(ctx, HotelService_GetHotelsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hotelServiceClient) UpdateHotel(ctx context.Context, in *UpdateHotelRequest, opts ...grpc.CallOption) (*UpdateHotelResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateHotelResponse)
@@ -87,6 +115,16 @@ func (c *hotelServiceClient) UpdateHotel(ctx context.Context, in *UpdateHotelReq
 	return out, nil
 }
 
+func (c *hotelServiceClient) PatchHotel(ctx context.Context, in *PatchHotelRequest, opts ...grpc.CallOption) (*PatchHotelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchHotelResponse)
+	err := c.cc.Invoke(ctx, HotelService_PatchHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hotelServiceClient) UpdateHotelTitle(ctx context.Context, in *UpdateHotelTitleRequest, opts ...grpc.CallOption) (*UpdateHotelTitleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateHotelTitleResponse)
@@ -97,6 +135,16 @@ func (c *hotelServiceClient) UpdateHotelTitle(ctx context.Context, in *UpdateHot
 	return out, nil
 }
 
+func (c *hotelServiceClient) UpdateHotelRating(ctx context.Context, in *UpdateHotelRatingRequest, opts ...grpc.CallOption) (*UpdateHotelRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateHotelRatingResponse)
+	err := c.cc.Invoke(ctx, HotelService_UpdateHotelRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hotelServiceClient) DeleteHotel(ctx context.Context, in *DeleteHotelRequest, opts ...grpc.CallOption) (*DeleteHotelResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteHotelResponse)
@@ -114,8 +162,12 @@ type HotelServiceServer interface {
 	CreateHotel(context.Context, *CreateHotelRequest) (*CreateHotelResponse, error)
 	GetHotels(context.Context, *GetHotelsRequest) (*GetHotelsResponse, error)
 	GetHotel(context.Context, *GetHotelRequest) (*GetHotelResponse, error)
+	GetHotelByID(context.Context, *GetHotelByIDRequest) (*GetHotelByIDResponse, error)
+	GetHotelsByIDs(context.Context, *GetHotelsByIDsRequest) (*GetHotelsByIDsResponse, error)
 	UpdateHotel(context.Context, *UpdateHotelRequest) (*UpdateHotelResponse, error)
+	PatchHotel(context.Context, *PatchHotelRequest) (*PatchHotelResponse, error)
 	UpdateHotelTitle(context.Context, *UpdateHotelTitleRequest) (*UpdateHotelTitleResponse, error)
+	UpdateHotelRating(context.Context, *UpdateHotelRatingRequest) (*UpdateHotelRatingResponse, error)
 	DeleteHotel(context.Context, *DeleteHotelRequest) (*DeleteHotelResponse, error)
 	mustEmbedUnimplementedHotelServiceServer()
 }
@@ -136,12 +188,24 @@ func (UnimplementedHotelServiceServer) GetHotels(context.Context, *GetHotelsRequ
 func (UnimplementedHotelServiceServer) GetHotel(context.Context, *GetHotelRequest) (*GetHotelResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetHotel not implemented")
 }
+func (UnimplementedHotelServiceServer) GetHotelByID(context.Context, *GetHotelByIDRequest) (*GetHotelByIDResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHotelByID not implemented")
+}
+func (UnimplementedHotelServiceServer) GetHotelsByIDs(context.Context, *GetHotelsByIDsRequest) (*GetHotelsByIDsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHotelsByIDs not implemented")
+}
 func (UnimplementedHotelServiceServer) UpdateHotel(context.Context, *UpdateHotelRequest) (*UpdateHotelResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateHotel not implemented")
 }
+func (UnimplementedHotelServiceServer) PatchHotel(context.Context, *PatchHotelRequest) (*PatchHotelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchHotel not implemented")
+}
 func (UnimplementedHotelServiceServer) UpdateHotelTitle(context.Context, *UpdateHotelTitleRequest) (*UpdateHotelTitleResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateHotelTitle not implemented")
 }
+func (UnimplementedHotelServiceServer) UpdateHotelRating(context.Context, *UpdateHotelRatingRequest) (*UpdateHotelRatingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateHotelRating not implemented")
+}
 func (UnimplementedHotelServiceServer) DeleteHotel(context.Context, *DeleteHotelRequest) (*DeleteHotelResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteHotel not implemented")
 }
@@ -220,6 +284,42 @@ func _HotelService_GetHotel_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HotelService_GetHotelByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).GetHotelByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_GetHotelByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).GetHotelByID(ctx, req.(*GetHotelByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelService_GetHotelsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).GetHotelsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_GetHotelsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).GetHotelsByIDs(ctx, req.(*GetHotelsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HotelService_UpdateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateHotelRequest)
 	if err := dec(in); err != nil {
@@ -238,6 +338,24 @@ func _HotelService_UpdateHotel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HotelService_PatchHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).PatchHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_PatchHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).PatchHotel(ctx, req.(*PatchHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HotelService_UpdateHotelTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateHotelTitleRequest)
 	if err := dec(in); err != nil {
@@ -256,6 +374,24 @@ func _HotelService_UpdateHotelTitle_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HotelService_UpdateHotelRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHotelRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelServiceServer).UpdateHotelRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelService_UpdateHotelRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelServiceServer).UpdateHotelRating(ctx, req.(*UpdateHotelRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HotelService_DeleteHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteHotelRequest)
 	if err := dec(in); err != nil {
@@ -293,14 +429,30 @@ var HotelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHotel",
 			Handler:    _HotelService_GetHotel_Handler,
 		},
+		{
+			MethodName: "GetHotelByID",
+			Handler:    _HotelService_GetHotelByID_Handler,
+		},
+		{
+			MethodName: "GetHotelsByIDs",
+			Handler:    _HotelService_GetHotelsByIDs_Handler,
+		},
 		{
 			MethodName: "UpdateHotel",
 			Handler:    _HotelService_UpdateHotel_Handler,
 		},
+		{
+			MethodName: "PatchHotel",
+			Handler:    _HotelService_PatchHotel_Handler,
+		},
 		{
 			MethodName: "UpdateHotelTitle",
 			Handler:    _HotelService_UpdateHotelTitle_Handler,
 		},
+		{
+			MethodName: "UpdateHotelRating",
+			Handler:    _HotelService_UpdateHotelRating_Handler,
+		},
 		{
 			MethodName: "DeleteHotel",
 			Handler:    _HotelService_DeleteHotel_Handler,
@@ -314,7 +466,9 @@ const (
 	RoomService_CreateRoom_FullMethodName       = "/hotel.v1.RoomService/CreateRoom"
 	RoomService_GetRooms_FullMethodName         = "/hotel.v1.RoomService/GetRooms"
 	RoomService_GetRoom_FullMethodName          = "/hotel.v1.RoomService/GetRoom"
+	RoomService_GetRoomsByIDs_FullMethodName    = "/hotel.v1.RoomService/GetRoomsByIDs"
 	RoomService_UpdateRoom_FullMethodName       = "/hotel.v1.RoomService/UpdateRoom"
+	RoomService_PatchRoom_FullMethodName        = "/hotel.v1.RoomService/PatchRoom"
 	RoomService_UpdateRoomStatus_FullMethodName = "/hotel.v1.RoomService/UpdateRoomStatus"
 	RoomService_DeleteRoom_FullMethodName       = "/hotel.v1.RoomService/DeleteRoom"
 )
@@ -326,7 +480,9 @@ type RoomServiceClient interface {
 	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error)
 	GetRooms(ctx context.Context, in *GetRoomsRequest, opts ...grpc.CallOption) (*GetRoomsResponse, error)
 	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error)
+	GetRoomsByIDs(ctx context.Context, in *GetRoomsByIDsRequest, opts ...grpc.CallOption) (*GetRoomsByIDsResponse, error)
 	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error)
+	PatchRoom(ctx context.Context, in *PatchRoomRequest, opts ...grpc.CallOption) (*PatchRoomResponse, error)
 	UpdateRoomStatus(ctx context.Context, in *UpdateRoomStatusRequest, opts ...grpc.CallOption) (*UpdateRoomStatusResponse, error)
 	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error)
 }
@@ -369,6 +525,16 @@ func (c *roomServiceClient) GetRoom(ctx context.Context, in *GetRoomRequest, opt
 	return out, nil
 }
 
+func (c *roomServiceClient) GetRoomsByIDs(ctx context.Context, in *GetRoomsByIDsRequest, opts ...grpc.CallOption) (*GetRoomsByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoomsByIDsResponse)
+	err := c.cc.Invoke(ctx, RoomService_GetRoomsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roomServiceClient) UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateRoomResponse)
@@ -379,6 +545,16 @@ func (c *roomServiceClient) UpdateRoom(ctx context.Context, in *UpdateRoomReques
 	return out, nil
 }
 
+func (c *roomServiceClient) PatchRoom(ctx context.Context, in *PatchRoomRequest, opts ...grpc.CallOption) (*PatchRoomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchRoomResponse)
+	err := c.cc.Invoke(ctx, RoomService_PatchRoom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roomServiceClient) UpdateRoomStatus(ctx context.Context, in *UpdateRoomStatusRequest, opts ...grpc.CallOption) (*UpdateRoomStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateRoomStatusResponse)
@@ -406,7 +582,9 @@ type RoomServiceServer interface {
 	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
 	GetRooms(context.Context, *GetRoomsRequest) (*GetRoomsResponse, error)
 	GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error)
+	GetRoomsByIDs(context.Context, *GetRoomsByIDsRequest) (*GetRoomsByIDsResponse, error)
 	UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error)
+	PatchRoom(context.Context, *PatchRoomRequest) (*PatchRoomResponse, error)
 	UpdateRoomStatus(context.Context, *UpdateRoomStatusRequest) (*UpdateRoomStatusResponse, error)
 	DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error)
 	mustEmbedUnimplementedRoomServiceServer()
@@ -428,9 +606,15 @@ func (UnimplementedRoomServiceServer) GetRooms(context.Context, *GetRoomsRequest
 func (UnimplementedRoomServiceServer) GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetRoom not implemented")
 }
+func (UnimplementedRoomServiceServer) GetRoomsByIDs(context.Context, *GetRoomsByIDsRequest) (*GetRoomsByIDsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRoomsByIDs not implemented")
+}
 func (UnimplementedRoomServiceServer) UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateRoom not implemented")
 }
+func (UnimplementedRoomServiceServer) PatchRoom(context.Context, *PatchRoomRequest) (*PatchRoomResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchRoom not implemented")
+}
 func (UnimplementedRoomServiceServer) UpdateRoomStatus(context.Context, *UpdateRoomStatusRequest) (*UpdateRoomStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateRoomStatus not implemented")
 }
@@ -512,6 +696,24 @@ func _RoomService_GetRoom_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoomService_GetRoomsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GetRoomsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_GetRoomsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GetRoomsByIDs(ctx, req.(*GetRoomsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoomService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateRoomRequest)
 	if err := dec(in); err != nil {
@@ -530,6 +732,24 @@ func _RoomService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoomService_PatchRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).PatchRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomService_PatchRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).PatchRoom(ctx, req.(*PatchRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoomService_UpdateRoomStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateRoomStatusRequest)
 	if err := dec(in); err != nil {
@@ -585,10 +805,18 @@ var RoomService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoom",
 			Handler:    _RoomService_GetRoom_Handler,
 		},
+		{
+			MethodName: "GetRoomsByIDs",
+			Handler:    _RoomService_GetRoomsByIDs_Handler,
+		},
 		{
 			MethodName: "UpdateRoom",
 			Handler:    _RoomService_UpdateRoom_Handler,
 		},
+		{
+			MethodName: "PatchRoom",
+			Handler:    _RoomService_PatchRoom_Handler,
+		},
 		{
 			MethodName: "UpdateRoomStatus",
 			Handler:    _RoomService_UpdateRoomStatus_Handler,
@@ -601,3 +829,2171 @@ var RoomService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hotel/v1/hotel_service.proto",
 }
+
+const (
+	RatePlanService_CreateRatePlan_FullMethodName = "/hotel.v1.RatePlanService/CreateRatePlan"
+	RatePlanService_GetRatePlans_FullMethodName   = "/hotel.v1.RatePlanService/GetRatePlans"
+	RatePlanService_GetRatePlan_FullMethodName    = "/hotel.v1.RatePlanService/GetRatePlan"
+	RatePlanService_UpdateRatePlan_FullMethodName = "/hotel.v1.RatePlanService/UpdateRatePlan"
+	RatePlanService_DeleteRatePlan_FullMethodName = "/hotel.v1.RatePlanService/DeleteRatePlan"
+	RatePlanService_QuoteStay_FullMethodName      = "/hotel.v1.RatePlanService/QuoteStay"
+)
+
+// RatePlanServiceClient is the client API for RatePlanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RatePlanServiceClient interface {
+	CreateRatePlan(ctx context.Context, in *CreateRatePlanRequest, opts ...grpc.CallOption) (*CreateRatePlanResponse, error)
+	GetRatePlans(ctx context.Context, in *GetRatePlansRequest, opts ...grpc.CallOption) (*GetRatePlansResponse, error)
+	GetRatePlan(ctx context.Context, in *GetRatePlanRequest, opts ...grpc.CallOption) (*GetRatePlanResponse, error)
+	UpdateRatePlan(ctx context.Context, in *UpdateRatePlanRequest, opts ...grpc.CallOption) (*UpdateRatePlanResponse, error)
+	DeleteRatePlan(ctx context.Context, in *DeleteRatePlanRequest, opts ...grpc.CallOption) (*DeleteRatePlanResponse, error)
+	QuoteStay(ctx context.Context, in *QuoteStayRequest, opts ...grpc.CallOption) (*QuoteStayResponse, error)
+}
+
+type ratePlanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRatePlanServiceClient(cc grpc.ClientConnInterface) RatePlanServiceClient {
+	return &ratePlanServiceClient{cc}
+}
+
+func (c *ratePlanServiceClient) CreateRatePlan(ctx context.Context, in *CreateRatePlanRequest, opts ...grpc.CallOption) (*CreateRatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRatePlanResponse)
+	err := c.cc.Invoke(ctx, RatePlanService_CreateRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePlanServiceClient) GetRatePlans(ctx context.Context, in *GetRatePlansRequest, opts ...grpc.CallOption) (*GetRatePlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRatePlansResponse)
+	err := c.cc.Invoke(ctx, RatePlanService_GetRatePlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePlanServiceClient) GetRatePlan(ctx context.Context, in *GetRatePlanRequest, opts ...grpc.CallOption) (*GetRatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRatePlanResponse)
+	err := c.cc.Invoke(ctx, RatePlanService_GetRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePlanServiceClient) UpdateRatePlan(ctx context.Context, in *UpdateRatePlanRequest, opts ...grpc.CallOption) (*UpdateRatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRatePlanResponse)
+	err := c.cc.Invoke(ctx, RatePlanService_UpdateRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePlanServiceClient) DeleteRatePlan(ctx context.Context, in *DeleteRatePlanRequest, opts ...grpc.CallOption) (*DeleteRatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRatePlanResponse)
+	err := c.cc.Invoke(ctx, RatePlanService_DeleteRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ratePlanServiceClient) QuoteStay(ctx context.Context, in *QuoteStayRequest, opts ...grpc.CallOption) (*QuoteStayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteStayResponse)
+	err := c.cc.Invoke(ctx, RatePlanService_QuoteStay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RatePlanServiceServer is the server API for RatePlanService service.
+// All implementations must embed UnimplementedRatePlanServiceServer
+// for forward compatibility.
+type RatePlanServiceServer interface {
+	CreateRatePlan(context.Context, *CreateRatePlanRequest) (*CreateRatePlanResponse, error)
+	GetRatePlans(context.Context, *GetRatePlansRequest) (*GetRatePlansResponse, error)
+	GetRatePlan(context.Context, *GetRatePlanRequest) (*GetRatePlanResponse, error)
+	UpdateRatePlan(context.Context, *UpdateRatePlanRequest) (*UpdateRatePlanResponse, error)
+	DeleteRatePlan(context.Context, *DeleteRatePlanRequest) (*DeleteRatePlanResponse, error)
+	QuoteStay(context.Context, *QuoteStayRequest) (*QuoteStayResponse, error)
+	mustEmbedUnimplementedRatePlanServiceServer()
+}
+
+// UnimplementedRatePlanServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRatePlanServiceServer struct{}
+
+func (UnimplementedRatePlanServiceServer) CreateRatePlan(context.Context, *CreateRatePlanRequest) (*CreateRatePlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRatePlan not implemented")
+}
+func (UnimplementedRatePlanServiceServer) GetRatePlans(context.Context, *GetRatePlansRequest) (*GetRatePlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRatePlans not implemented")
+}
+func (UnimplementedRatePlanServiceServer) GetRatePlan(context.Context, *GetRatePlanRequest) (*GetRatePlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRatePlan not implemented")
+}
+func (UnimplementedRatePlanServiceServer) UpdateRatePlan(context.Context, *UpdateRatePlanRequest) (*UpdateRatePlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRatePlan not implemented")
+}
+func (UnimplementedRatePlanServiceServer) DeleteRatePlan(context.Context, *DeleteRatePlanRequest) (*DeleteRatePlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRatePlan not implemented")
+}
+func (UnimplementedRatePlanServiceServer) QuoteStay(context.Context, *QuoteStayRequest) (*QuoteStayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuoteStay not implemented")
+}
+func (UnimplementedRatePlanServiceServer) mustEmbedUnimplementedRatePlanServiceServer() {}
+func (UnimplementedRatePlanServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeRatePlanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RatePlanServiceServer will
+// result in compilation errors.
+type UnsafeRatePlanServiceServer interface {
+	mustEmbedUnimplementedRatePlanServiceServer()
+}
+
+func RegisterRatePlanServiceServer(s grpc.ServiceRegistrar, srv RatePlanServiceServer) {
+	// If the following call panics, it indicates UnimplementedRatePlanServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RatePlanService_ServiceDesc, srv)
+}
+
+func _RatePlanService_CreateRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePlanServiceServer).CreateRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePlanService_CreateRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePlanServiceServer).CreateRatePlan(ctx, req.(*CreateRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePlanService_GetRatePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatePlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePlanServiceServer).GetRatePlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePlanService_GetRatePlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePlanServiceServer).GetRatePlans(ctx, req.(*GetRatePlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePlanService_GetRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePlanServiceServer).GetRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePlanService_GetRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePlanServiceServer).GetRatePlan(ctx, req.(*GetRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePlanService_UpdateRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePlanServiceServer).UpdateRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePlanService_UpdateRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePlanServiceServer).UpdateRatePlan(ctx, req.(*UpdateRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePlanService_DeleteRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePlanServiceServer).DeleteRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePlanService_DeleteRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePlanServiceServer).DeleteRatePlan(ctx, req.(*DeleteRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RatePlanService_QuoteStay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteStayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RatePlanServiceServer).QuoteStay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RatePlanService_QuoteStay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RatePlanServiceServer).QuoteStay(ctx, req.(*QuoteStayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RatePlanService_ServiceDesc is the grpc.ServiceDesc for RatePlanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RatePlanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.RatePlanService",
+	HandlerType: (*RatePlanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRatePlan",
+			Handler:    _RatePlanService_CreateRatePlan_Handler,
+		},
+		{
+			MethodName: "GetRatePlans",
+			Handler:    _RatePlanService_GetRatePlans_Handler,
+		},
+		{
+			MethodName: "GetRatePlan",
+			Handler:    _RatePlanService_GetRatePlan_Handler,
+		},
+		{
+			MethodName: "UpdateRatePlan",
+			Handler:    _RatePlanService_UpdateRatePlan_Handler,
+		},
+		{
+			MethodName: "DeleteRatePlan",
+			Handler:    _RatePlanService_DeleteRatePlan_Handler,
+		},
+		{
+			MethodName: "QuoteStay",
+			Handler:    _RatePlanService_QuoteStay_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel/v1/hotel_service.proto",
+}
+
+const (
+	StayRestrictionService_CreateStayRestriction_FullMethodName = "/hotel.v1.StayRestrictionService/CreateStayRestriction"
+	StayRestrictionService_GetStayRestrictions_FullMethodName   = "/hotel.v1.StayRestrictionService/GetStayRestrictions"
+	StayRestrictionService_GetStayRestriction_FullMethodName    = "/hotel.v1.StayRestrictionService/GetStayRestriction"
+	StayRestrictionService_UpdateStayRestriction_FullMethodName = "/hotel.v1.StayRestrictionService/UpdateStayRestriction"
+	StayRestrictionService_DeleteStayRestriction_FullMethodName = "/hotel.v1.StayRestrictionService/DeleteStayRestriction"
+	StayRestrictionService_CheckStay_FullMethodName             = "/hotel.v1.StayRestrictionService/CheckStay"
+)
+
+// StayRestrictionServiceClient is the client API for StayRestrictionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StayRestrictionServiceClient interface {
+	CreateStayRestriction(ctx context.Context, in *CreateStayRestrictionRequest, opts ...grpc.CallOption) (*CreateStayRestrictionResponse, error)
+	GetStayRestrictions(ctx context.Context, in *GetStayRestrictionsRequest, opts ...grpc.CallOption) (*GetStayRestrictionsResponse, error)
+	GetStayRestriction(ctx context.Context, in *GetStayRestrictionRequest, opts ...grpc.CallOption) (*GetStayRestrictionResponse, error)
+	UpdateStayRestriction(ctx context.Context, in *UpdateStayRestrictionRequest, opts ...grpc.CallOption) (*UpdateStayRestrictionResponse, error)
+	DeleteStayRestriction(ctx context.Context, in *DeleteStayRestrictionRequest, opts ...grpc.CallOption) (*DeleteStayRestrictionResponse, error)
+	CheckStay(ctx context.Context, in *CheckStayRequest, opts ...grpc.CallOption) (*CheckStayResponse, error)
+}
+
+type stayRestrictionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStayRestrictionServiceClient(cc grpc.ClientConnInterface) StayRestrictionServiceClient {
+	return &stayRestrictionServiceClient{cc}
+}
+
+func (c *stayRestrictionServiceClient) CreateStayRestriction(ctx context.Context, in *CreateStayRestrictionRequest, opts ...grpc.CallOption) (*CreateStayRestrictionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateStayRestrictionResponse)
+	err := c.cc.Invoke(ctx, StayRestrictionService_CreateStayRestriction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stayRestrictionServiceClient) GetStayRestrictions(ctx context.Context, in *GetStayRestrictionsRequest, opts ...grpc.CallOption) (*GetStayRestrictionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStayRestrictionsResponse)
+	err := c.cc.Invoke(ctx, StayRestrictionService_GetStayRestrictions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stayRestrictionServiceClient) GetStayRestriction(ctx context.Context, in *GetStayRestrictionRequest, opts ...grpc.CallOption) (*GetStayRestrictionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStayRestrictionResponse)
+	err := c.cc.Invoke(ctx, StayRestrictionService_GetStayRestriction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stayRestrictionServiceClient) UpdateStayRestriction(ctx context.Context, in *UpdateStayRestrictionRequest, opts ...grpc.CallOption) (*UpdateStayRestrictionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateStayRestrictionResponse)
+	err := c.cc.Invoke(ctx, StayRestrictionService_UpdateStayRestriction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stayRestrictionServiceClient) DeleteStayRestriction(ctx context.Context, in *DeleteStayRestrictionRequest, opts ...grpc.CallOption) (*DeleteStayRestrictionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteStayRestrictionResponse)
+	err := c.cc.Invoke(ctx, StayRestrictionService_DeleteStayRestriction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stayRestrictionServiceClient) CheckStay(ctx context.Context, in *CheckStayRequest, opts ...grpc.CallOption) (*CheckStayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckStayResponse)
+	err := c.cc.Invoke(ctx, StayRestrictionService_CheckStay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StayRestrictionServiceServer is the server API for StayRestrictionService service.
+// All implementations must embed UnimplementedStayRestrictionServiceServer
+// for forward compatibility.
+type StayRestrictionServiceServer interface {
+	CreateStayRestriction(context.Context, *CreateStayRestrictionRequest) (*CreateStayRestrictionResponse, error)
+	GetStayRestrictions(context.Context, *GetStayRestrictionsRequest) (*GetStayRestrictionsResponse, error)
+	GetStayRestriction(context.Context, *GetStayRestrictionRequest) (*GetStayRestrictionResponse, error)
+	UpdateStayRestriction(context.Context, *UpdateStayRestrictionRequest) (*UpdateStayRestrictionResponse, error)
+	DeleteStayRestriction(context.Context, *DeleteStayRestrictionRequest) (*DeleteStayRestrictionResponse, error)
+	CheckStay(context.Context, *CheckStayRequest) (*CheckStayResponse, error)
+	mustEmbedUnimplementedStayRestrictionServiceServer()
+}
+
+// UnimplementedStayRestrictionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStayRestrictionServiceServer struct{}
+
+func (UnimplementedStayRestrictionServiceServer) CreateStayRestriction(context.Context, *CreateStayRestrictionRequest) (*CreateStayRestrictionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateStayRestriction not implemented")
+}
+func (UnimplementedStayRestrictionServiceServer) GetStayRestrictions(context.Context, *GetStayRestrictionsRequest) (*GetStayRestrictionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStayRestrictions not implemented")
+}
+func (UnimplementedStayRestrictionServiceServer) GetStayRestriction(context.Context, *GetStayRestrictionRequest) (*GetStayRestrictionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStayRestriction not implemented")
+}
+func (UnimplementedStayRestrictionServiceServer) UpdateStayRestriction(context.Context, *UpdateStayRestrictionRequest) (*UpdateStayRestrictionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateStayRestriction not implemented")
+}
+func (UnimplementedStayRestrictionServiceServer) DeleteStayRestriction(context.Context, *DeleteStayRestrictionRequest) (*DeleteStayRestrictionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteStayRestriction not implemented")
+}
+func (UnimplementedStayRestrictionServiceServer) CheckStay(context.Context, *CheckStayRequest) (*CheckStayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckStay not implemented")
+}
+func (UnimplementedStayRestrictionServiceServer) mustEmbedUnimplementedStayRestrictionServiceServer() {
+}
+func (UnimplementedStayRestrictionServiceServer) testEmbeddedByValue() {}
+
+// UnsafeStayRestrictionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StayRestrictionServiceServer will
+// result in compilation errors.
+type UnsafeStayRestrictionServiceServer interface {
+	mustEmbedUnimplementedStayRestrictionServiceServer()
+}
+
+func RegisterStayRestrictionServiceServer(s grpc.ServiceRegistrar, srv StayRestrictionServiceServer) {
+	// If the following call panics, it indicates UnimplementedStayRestrictionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StayRestrictionService_ServiceDesc, srv)
+}
+
+func _StayRestrictionService_CreateStayRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStayRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StayRestrictionServiceServer).CreateStayRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StayRestrictionService_CreateStayRestriction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StayRestrictionServiceServer).CreateStayRestriction(ctx, req.(*CreateStayRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StayRestrictionService_GetStayRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStayRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StayRestrictionServiceServer).GetStayRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StayRestrictionService_GetStayRestrictions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StayRestrictionServiceServer).GetStayRestrictions(ctx, req.(*GetStayRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StayRestrictionService_GetStayRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStayRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StayRestrictionServiceServer).GetStayRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StayRestrictionService_GetStayRestriction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StayRestrictionServiceServer).GetStayRestriction(ctx, req.(*GetStayRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StayRestrictionService_UpdateStayRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStayRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StayRestrictionServiceServer).UpdateStayRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StayRestrictionService_UpdateStayRestriction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StayRestrictionServiceServer).UpdateStayRestriction(ctx, req.(*UpdateStayRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StayRestrictionService_DeleteStayRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStayRestrictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StayRestrictionServiceServer).DeleteStayRestriction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StayRestrictionService_DeleteStayRestriction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StayRestrictionServiceServer).DeleteStayRestriction(ctx, req.(*DeleteStayRestrictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StayRestrictionService_CheckStay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckStayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StayRestrictionServiceServer).CheckStay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StayRestrictionService_CheckStay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StayRestrictionServiceServer).CheckStay(ctx, req.(*CheckStayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StayRestrictionService_ServiceDesc is the grpc.ServiceDesc for StayRestrictionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StayRestrictionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.StayRestrictionService",
+	HandlerType: (*StayRestrictionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStayRestriction",
+			Handler:    _StayRestrictionService_CreateStayRestriction_Handler,
+		},
+		{
+			MethodName: "GetStayRestrictions",
+			Handler:    _StayRestrictionService_GetStayRestrictions_Handler,
+		},
+		{
+			MethodName: "GetStayRestriction",
+			Handler:    _StayRestrictionService_GetStayRestriction_Handler,
+		},
+		{
+			MethodName: "UpdateStayRestriction",
+			Handler:    _StayRestrictionService_UpdateStayRestriction_Handler,
+		},
+		{
+			MethodName: "DeleteStayRestriction",
+			Handler:    _StayRestrictionService_DeleteStayRestriction_Handler,
+		},
+		{
+			MethodName: "CheckStay",
+			Handler:    _StayRestrictionService_CheckStay_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel/v1/hotel_service.proto",
+}
+
+const (
+	RoomBlockService_CreateRoomBlock_FullMethodName = "/hotel.v1.RoomBlockService/CreateRoomBlock"
+	RoomBlockService_GetRoomBlocks_FullMethodName   = "/hotel.v1.RoomBlockService/GetRoomBlocks"
+	RoomBlockService_DeleteRoomBlock_FullMethodName = "/hotel.v1.RoomBlockService/DeleteRoomBlock"
+)
+
+// RoomBlockServiceClient is the client API for RoomBlockService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoomBlockServiceClient interface {
+	CreateRoomBlock(ctx context.Context, in *CreateRoomBlockRequest, opts ...grpc.CallOption) (*CreateRoomBlockResponse, error)
+	GetRoomBlocks(ctx context.Context, in *GetRoomBlocksRequest, opts ...grpc.CallOption) (*GetRoomBlocksResponse, error)
+	DeleteRoomBlock(ctx context.Context, in *DeleteRoomBlockRequest, opts ...grpc.CallOption) (*DeleteRoomBlockResponse, error)
+}
+
+type roomBlockServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoomBlockServiceClient(cc grpc.ClientConnInterface) RoomBlockServiceClient {
+	return &roomBlockServiceClient{cc}
+}
+
+func (c *roomBlockServiceClient) CreateRoomBlock(ctx context.Context, in *CreateRoomBlockRequest, opts ...grpc.CallOption) (*CreateRoomBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoomBlockResponse)
+	err := c.cc.Invoke(ctx, RoomBlockService_CreateRoomBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomBlockServiceClient) GetRoomBlocks(ctx context.Context, in *GetRoomBlocksRequest, opts ...grpc.CallOption) (*GetRoomBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoomBlocksResponse)
+	err := c.cc.Invoke(ctx, RoomBlockService_GetRoomBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomBlockServiceClient) DeleteRoomBlock(ctx context.Context, in *DeleteRoomBlockRequest, opts ...grpc.CallOption) (*DeleteRoomBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoomBlockResponse)
+	err := c.cc.Invoke(ctx, RoomBlockService_DeleteRoomBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoomBlockServiceServer is the server API for RoomBlockService service.
+// All implementations must embed UnimplementedRoomBlockServiceServer
+// for forward compatibility.
+type RoomBlockServiceServer interface {
+	CreateRoomBlock(context.Context, *CreateRoomBlockRequest) (*CreateRoomBlockResponse, error)
+	GetRoomBlocks(context.Context, *GetRoomBlocksRequest) (*GetRoomBlocksResponse, error)
+	DeleteRoomBlock(context.Context, *DeleteRoomBlockRequest) (*DeleteRoomBlockResponse, error)
+	mustEmbedUnimplementedRoomBlockServiceServer()
+}
+
+// UnimplementedRoomBlockServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRoomBlockServiceServer struct{}
+
+func (UnimplementedRoomBlockServiceServer) CreateRoomBlock(context.Context, *CreateRoomBlockRequest) (*CreateRoomBlockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRoomBlock not implemented")
+}
+func (UnimplementedRoomBlockServiceServer) GetRoomBlocks(context.Context, *GetRoomBlocksRequest) (*GetRoomBlocksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRoomBlocks not implemented")
+}
+func (UnimplementedRoomBlockServiceServer) DeleteRoomBlock(context.Context, *DeleteRoomBlockRequest) (*DeleteRoomBlockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRoomBlock not implemented")
+}
+func (UnimplementedRoomBlockServiceServer) mustEmbedUnimplementedRoomBlockServiceServer() {}
+func (UnimplementedRoomBlockServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeRoomBlockServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoomBlockServiceServer will
+// result in compilation errors.
+type UnsafeRoomBlockServiceServer interface {
+	mustEmbedUnimplementedRoomBlockServiceServer()
+}
+
+func RegisterRoomBlockServiceServer(s grpc.ServiceRegistrar, srv RoomBlockServiceServer) {
+	// If the following call panics, it indicates UnimplementedRoomBlockServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RoomBlockService_ServiceDesc, srv)
+}
+
+func _RoomBlockService_CreateRoomBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomBlockServiceServer).CreateRoomBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomBlockService_CreateRoomBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomBlockServiceServer).CreateRoomBlock(ctx, req.(*CreateRoomBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomBlockService_GetRoomBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomBlockServiceServer).GetRoomBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomBlockService_GetRoomBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomBlockServiceServer).GetRoomBlocks(ctx, req.(*GetRoomBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomBlockService_DeleteRoomBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomBlockServiceServer).DeleteRoomBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomBlockService_DeleteRoomBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomBlockServiceServer).DeleteRoomBlock(ctx, req.(*DeleteRoomBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoomBlockService_ServiceDesc is the grpc.ServiceDesc for RoomBlockService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomBlockService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.RoomBlockService",
+	HandlerType: (*RoomBlockServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoomBlock",
+			Handler:    _RoomBlockService_CreateRoomBlock_Handler,
+		},
+		{
+			MethodName: "GetRoomBlocks",
+			Handler:    _RoomBlockService_GetRoomBlocks_Handler,
+		},
+		{
+			MethodName: "DeleteRoomBlock",
+			Handler:    _RoomBlockService_DeleteRoomBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel/v1/hotel_service.proto",
+}
+
+const (
+	ImageService_UploadImage_FullMethodName   = "/hotel.v1.ImageService/UploadImage"
+	ImageService_GetImages_FullMethodName     = "/hotel.v1.ImageService/GetImages"
+	ImageService_ReorderImages_FullMethodName = "/hotel.v1.ImageService/ReorderImages"
+	ImageService_DeleteImage_FullMethodName   = "/hotel.v1.ImageService/DeleteImage"
+)
+
+// ImageServiceClient is the client API for ImageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImageServiceClient interface {
+	UploadImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadImageRequest, UploadImageResponse], error)
+	GetImages(ctx context.Context, in *GetImagesRequest, opts ...grpc.CallOption) (*GetImagesResponse, error)
+	ReorderImages(ctx context.Context, in *ReorderImagesRequest, opts ...grpc.CallOption) (*ReorderImagesResponse, error)
+	DeleteImage(ctx context.Context, in *DeleteImageRequest, opts ...grpc.CallOption) (*DeleteImageResponse, error)
+}
+
+type imageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImageServiceClient(cc grpc.ClientConnInterface) ImageServiceClient {
+	return &imageServiceClient{cc}
+}
+
+func (c *imageServiceClient) UploadImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadImageRequest, UploadImageResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ImageService_ServiceDesc.Streams[0], ImageService_UploadImage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadImageRequest, UploadImageResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ImageService_UploadImageClient = grpc.ClientStreamingClient[UploadImageRequest, UploadImageResponse]
+
+func (c *imageServiceClient) GetImages(ctx context.Context, in *GetImagesRequest, opts ...grpc.CallOption) (*GetImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetImagesResponse)
+	err := c.cc.Invoke(ctx, ImageService_GetImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ReorderImages(ctx context.Context, in *ReorderImagesRequest, opts ...grpc.CallOption) (*ReorderImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderImagesResponse)
+	err := c.cc.Invoke(ctx, ImageService_ReorderImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) DeleteImage(ctx context.Context, in *DeleteImageRequest, opts ...grpc.CallOption) (*DeleteImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteImageResponse)
+	err := c.cc.Invoke(ctx, ImageService_DeleteImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImageServiceServer is the server API for ImageService service.
+// All implementations must embed UnimplementedImageServiceServer
+// for forward compatibility.
+type ImageServiceServer interface {
+	UploadImage(grpc.ClientStreamingServer[UploadImageRequest, UploadImageResponse]) error
+	GetImages(context.Context, *GetImagesRequest) (*GetImagesResponse, error)
+	ReorderImages(context.Context, *ReorderImagesRequest) (*ReorderImagesResponse, error)
+	DeleteImage(context.Context, *DeleteImageRequest) (*DeleteImageResponse, error)
+	mustEmbedUnimplementedImageServiceServer()
+}
+
+// UnimplementedImageServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedImageServiceServer struct{}
+
+func (UnimplementedImageServiceServer) UploadImage(grpc.ClientStreamingServer[UploadImageRequest, UploadImageResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadImage not implemented")
+}
+func (UnimplementedImageServiceServer) GetImages(context.Context, *GetImagesRequest) (*GetImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetImages not implemented")
+}
+func (UnimplementedImageServiceServer) ReorderImages(context.Context, *ReorderImagesRequest) (*ReorderImagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderImages not implemented")
+}
+func (UnimplementedImageServiceServer) DeleteImage(context.Context, *DeleteImageRequest) (*DeleteImageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteImage not implemented")
+}
+func (UnimplementedImageServiceServer) mustEmbedUnimplementedImageServiceServer() {}
+func (UnimplementedImageServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeImageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImageServiceServer will
+// result in compilation errors.
+type UnsafeImageServiceServer interface {
+	mustEmbedUnimplementedImageServiceServer()
+}
+
+func RegisterImageServiceServer(s grpc.ServiceRegistrar, srv ImageServiceServer) {
+	// If the following call panics, it indicates UnimplementedImageServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ImageService_ServiceDesc, srv)
+}
+
+func _ImageService_UploadImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ImageServiceServer).UploadImage(&grpc.GenericServerStream[UploadImageRequest, UploadImageResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ImageService_UploadImageServer = grpc.ClientStreamingServer[UploadImageRequest, UploadImageResponse]
+
+func _ImageService_GetImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).GetImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_GetImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).GetImages(ctx, req.(*GetImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_ReorderImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ReorderImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_ReorderImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ReorderImages(ctx, req.(*ReorderImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_DeleteImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).DeleteImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_DeleteImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).DeleteImage(ctx, req.(*DeleteImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ImageService_ServiceDesc is the grpc.ServiceDesc for ImageService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ImageService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.ImageService",
+	HandlerType: (*ImageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetImages",
+			Handler:    _ImageService_GetImages_Handler,
+		},
+		{
+			MethodName: "ReorderImages",
+			Handler:    _ImageService_ReorderImages_Handler,
+		},
+		{
+			MethodName: "DeleteImage",
+			Handler:    _ImageService_DeleteImage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadImage",
+			Handler:       _ImageService_UploadImage_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "hotel/v1/hotel_service.proto",
+}
+
+const (
+	AmenityService_CreateAmenity_FullMethodName     = "/hotel.v1.AmenityService/CreateAmenity"
+	AmenityService_GetAmenities_FullMethodName      = "/hotel.v1.AmenityService/GetAmenities"
+	AmenityService_GetAmenity_FullMethodName        = "/hotel.v1.AmenityService/GetAmenity"
+	AmenityService_UpdateAmenity_FullMethodName     = "/hotel.v1.AmenityService/UpdateAmenity"
+	AmenityService_DeleteAmenity_FullMethodName     = "/hotel.v1.AmenityService/DeleteAmenity"
+	AmenityService_SetHotelAmenities_FullMethodName = "/hotel.v1.AmenityService/SetHotelAmenities"
+	AmenityService_GetHotelAmenities_FullMethodName = "/hotel.v1.AmenityService/GetHotelAmenities"
+)
+
+// AmenityServiceClient is the client API for AmenityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AmenityServiceClient interface {
+	CreateAmenity(ctx context.Context, in *CreateAmenityRequest, opts ...grpc.CallOption) (*CreateAmenityResponse, error)
+	GetAmenities(ctx context.Context, in *GetAmenitiesRequest, opts ...grpc.CallOption) (*GetAmenitiesResponse, error)
+	GetAmenity(ctx context.Context, in *GetAmenityRequest, opts ...grpc.CallOption) (*GetAmenityResponse, error)
+	UpdateAmenity(ctx context.Context, in *UpdateAmenityRequest, opts ...grpc.CallOption) (*UpdateAmenityResponse, error)
+	DeleteAmenity(ctx context.Context, in *DeleteAmenityRequest, opts ...grpc.CallOption) (*DeleteAmenityResponse, error)
+	SetHotelAmenities(ctx context.Context, in *SetHotelAmenitiesRequest, opts ...grpc.CallOption) (*SetHotelAmenitiesResponse, error)
+	GetHotelAmenities(ctx context.Context, in *GetHotelAmenitiesRequest, opts ...grpc.CallOption) (*GetHotelAmenitiesResponse, error)
+}
+
+type amenityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAmenityServiceClient(cc grpc.ClientConnInterface) AmenityServiceClient {
+	return &amenityServiceClient{cc}
+}
+
+func (c *amenityServiceClient) CreateAmenity(ctx context.Context, in *CreateAmenityRequest, opts ...grpc.CallOption) (*CreateAmenityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAmenityResponse)
+	err := c.cc.Invoke(ctx, AmenityService_CreateAmenity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amenityServiceClient) GetAmenities(ctx context.Context, in *GetAmenitiesRequest, opts ...grpc.CallOption) (*GetAmenitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAmenitiesResponse)
+	err := c.cc.Invoke(ctx, AmenityService_GetAmenities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amenityServiceClient) GetAmenity(ctx context.Context, in *GetAmenityRequest, opts ...grpc.CallOption) (*GetAmenityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAmenityResponse)
+	err := c.cc.Invoke(ctx, AmenityService_GetAmenity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amenityServiceClient) UpdateAmenity(ctx context.Context, in *UpdateAmenityRequest, opts ...grpc.CallOption) (*UpdateAmenityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAmenityResponse)
+	err := c.cc.Invoke(ctx, AmenityService_UpdateAmenity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amenityServiceClient) DeleteAmenity(ctx context.Context, in *DeleteAmenityRequest, opts ...grpc.CallOption) (*DeleteAmenityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAmenityResponse)
+	err := c.cc.Invoke(ctx, AmenityService_DeleteAmenity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amenityServiceClient) SetHotelAmenities(ctx context.Context, in *SetHotelAmenitiesRequest, opts ...grpc.CallOption) (*SetHotelAmenitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetHotelAmenitiesResponse)
+	err := c.cc.Invoke(ctx, AmenityService_SetHotelAmenities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amenityServiceClient) GetHotelAmenities(ctx context.Context, in *GetHotelAmenitiesRequest, opts ...grpc.CallOption) (*GetHotelAmenitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHotelAmenitiesResponse)
+	err := c.cc.Invoke(ctx, AmenityService_GetHotelAmenities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AmenityServiceServer is the server API for AmenityService service.
+// All implementations must embed UnimplementedAmenityServiceServer
+// for forward compatibility.
+type AmenityServiceServer interface {
+	CreateAmenity(context.Context, *CreateAmenityRequest) (*CreateAmenityResponse, error)
+	GetAmenities(context.Context, *GetAmenitiesRequest) (*GetAmenitiesResponse, error)
+	GetAmenity(context.Context, *GetAmenityRequest) (*GetAmenityResponse, error)
+	UpdateAmenity(context.Context, *UpdateAmenityRequest) (*UpdateAmenityResponse, error)
+	DeleteAmenity(context.Context, *DeleteAmenityRequest) (*DeleteAmenityResponse, error)
+	SetHotelAmenities(context.Context, *SetHotelAmenitiesRequest) (*SetHotelAmenitiesResponse, error)
+	GetHotelAmenities(context.Context, *GetHotelAmenitiesRequest) (*GetHotelAmenitiesResponse, error)
+	mustEmbedUnimplementedAmenityServiceServer()
+}
+
+// UnimplementedAmenityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAmenityServiceServer struct{}
+
+func (UnimplementedAmenityServiceServer) CreateAmenity(context.Context, *CreateAmenityRequest) (*CreateAmenityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAmenity not implemented")
+}
+func (UnimplementedAmenityServiceServer) GetAmenities(context.Context, *GetAmenitiesRequest) (*GetAmenitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAmenities not implemented")
+}
+func (UnimplementedAmenityServiceServer) GetAmenity(context.Context, *GetAmenityRequest) (*GetAmenityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAmenity not implemented")
+}
+func (UnimplementedAmenityServiceServer) UpdateAmenity(context.Context, *UpdateAmenityRequest) (*UpdateAmenityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAmenity not implemented")
+}
+func (UnimplementedAmenityServiceServer) DeleteAmenity(context.Context, *DeleteAmenityRequest) (*DeleteAmenityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAmenity not implemented")
+}
+func (UnimplementedAmenityServiceServer) SetHotelAmenities(context.Context, *SetHotelAmenitiesRequest) (*SetHotelAmenitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHotelAmenities not implemented")
+}
+func (UnimplementedAmenityServiceServer) GetHotelAmenities(context.Context, *GetHotelAmenitiesRequest) (*GetHotelAmenitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHotelAmenities not implemented")
+}
+func (UnimplementedAmenityServiceServer) mustEmbedUnimplementedAmenityServiceServer() {}
+func (UnimplementedAmenityServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeAmenityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AmenityServiceServer will
+// result in compilation errors.
+type UnsafeAmenityServiceServer interface {
+	mustEmbedUnimplementedAmenityServiceServer()
+}
+
+func RegisterAmenityServiceServer(s grpc.ServiceRegistrar, srv AmenityServiceServer) {
+	// If the following call panics, it indicates UnimplementedAmenityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AmenityService_ServiceDesc, srv)
+}
+
+func _AmenityService_CreateAmenity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAmenityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmenityServiceServer).CreateAmenity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmenityService_CreateAmenity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmenityServiceServer).CreateAmenity(ctx, req.(*CreateAmenityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmenityService_GetAmenities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAmenitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmenityServiceServer).GetAmenities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmenityService_GetAmenities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmenityServiceServer).GetAmenities(ctx, req.(*GetAmenitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmenityService_GetAmenity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAmenityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmenityServiceServer).GetAmenity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmenityService_GetAmenity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmenityServiceServer).GetAmenity(ctx, req.(*GetAmenityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmenityService_UpdateAmenity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAmenityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmenityServiceServer).UpdateAmenity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmenityService_UpdateAmenity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmenityServiceServer).UpdateAmenity(ctx, req.(*UpdateAmenityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmenityService_DeleteAmenity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAmenityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmenityServiceServer).DeleteAmenity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmenityService_DeleteAmenity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmenityServiceServer).DeleteAmenity(ctx, req.(*DeleteAmenityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmenityService_SetHotelAmenities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHotelAmenitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmenityServiceServer).SetHotelAmenities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmenityService_SetHotelAmenities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmenityServiceServer).SetHotelAmenities(ctx, req.(*SetHotelAmenitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmenityService_GetHotelAmenities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelAmenitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmenityServiceServer).GetHotelAmenities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmenityService_GetHotelAmenities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmenityServiceServer).GetHotelAmenities(ctx, req.(*GetHotelAmenitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AmenityService_ServiceDesc is the grpc.ServiceDesc for AmenityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AmenityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.AmenityService",
+	HandlerType: (*AmenityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAmenity",
+			Handler:    _AmenityService_CreateAmenity_Handler,
+		},
+		{
+			MethodName: "GetAmenities",
+			Handler:    _AmenityService_GetAmenities_Handler,
+		},
+		{
+			MethodName: "GetAmenity",
+			Handler:    _AmenityService_GetAmenity_Handler,
+		},
+		{
+			MethodName: "UpdateAmenity",
+			Handler:    _AmenityService_UpdateAmenity_Handler,
+		},
+		{
+			MethodName: "DeleteAmenity",
+			Handler:    _AmenityService_DeleteAmenity_Handler,
+		},
+		{
+			MethodName: "SetHotelAmenities",
+			Handler:    _AmenityService_SetHotelAmenities_Handler,
+		},
+		{
+			MethodName: "GetHotelAmenities",
+			Handler:    _AmenityService_GetHotelAmenities_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel/v1/hotel_service.proto",
+}
+
+const (
+	GeoService_ListCountries_FullMethodName = "/hotel.v1.GeoService/ListCountries"
+	GeoService_ListCities_FullMethodName    = "/hotel.v1.GeoService/ListCities"
+)
+
+// GeoServiceClient is the client API for GeoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GeoServiceClient interface {
+	ListCountries(ctx context.Context, in *ListCountriesRequest, opts ...grpc.CallOption) (*ListCountriesResponse, error)
+	ListCities(ctx context.Context, in *ListCitiesRequest, opts ...grpc.CallOption) (*ListCitiesResponse, error)
+}
+
+type geoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGeoServiceClient(cc grpc.ClientConnInterface) GeoServiceClient {
+	return &geoServiceClient{cc}
+}
+
+func (c *geoServiceClient) ListCountries(ctx context.Context, in *ListCountriesRequest, opts ...grpc.CallOption) (*ListCountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCountriesResponse)
+	err := c.cc.Invoke(ctx, GeoService_ListCountries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoServiceClient) ListCities(ctx context.Context, in *ListCitiesRequest, opts ...grpc.CallOption) (*ListCitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCitiesResponse)
+	err := c.cc.Invoke(ctx, GeoService_ListCities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GeoServiceServer is the server API for GeoService service.
+// All implementations must embed UnimplementedGeoServiceServer
+// for forward compatibility.
+type GeoServiceServer interface {
+	ListCountries(context.Context, *ListCountriesRequest) (*ListCountriesResponse, error)
+	ListCities(context.Context, *ListCitiesRequest) (*ListCitiesResponse, error)
+	mustEmbedUnimplementedGeoServiceServer()
+}
+
+// UnimplementedGeoServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGeoServiceServer struct{}
+
+func (UnimplementedGeoServiceServer) ListCountries(context.Context, *ListCountriesRequest) (*ListCountriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCountries not implemented")
+}
+func (UnimplementedGeoServiceServer) ListCities(context.Context, *ListCitiesRequest) (*ListCitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCities not implemented")
+}
+func (UnimplementedGeoServiceServer) mustEmbedUnimplementedGeoServiceServer() {}
+func (UnimplementedGeoServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeGeoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GeoServiceServer will
+// result in compilation errors.
+type UnsafeGeoServiceServer interface {
+	mustEmbedUnimplementedGeoServiceServer()
+}
+
+func RegisterGeoServiceServer(s grpc.ServiceRegistrar, srv GeoServiceServer) {
+	// If the following call panics, it indicates UnimplementedGeoServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GeoService_ServiceDesc, srv)
+}
+
+func _GeoService_ListCountries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCountriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServiceServer).ListCountries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeoService_ListCountries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServiceServer).ListCountries(ctx, req.(*ListCountriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoService_ListCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServiceServer).ListCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeoService_ListCities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServiceServer).ListCities(ctx, req.(*ListCitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GeoService_ServiceDesc is the grpc.ServiceDesc for GeoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GeoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.GeoService",
+	HandlerType: (*GeoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCountries",
+			Handler:    _GeoService_ListCountries_Handler,
+		},
+		{
+			MethodName: "ListCities",
+			Handler:    _GeoService_ListCities_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel/v1/hotel_service.proto",
+}
+
+const (
+	HotelPolicyService_SetHotelPolicy_FullMethodName    = "/hotel.v1.HotelPolicyService/SetHotelPolicy"
+	HotelPolicyService_GetHotelPolicy_FullMethodName    = "/hotel.v1.HotelPolicyService/GetHotelPolicy"
+	HotelPolicyService_DeleteHotelPolicy_FullMethodName = "/hotel.v1.HotelPolicyService/DeleteHotelPolicy"
+)
+
+// HotelPolicyServiceClient is the client API for HotelPolicyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HotelPolicyServiceClient interface {
+	SetHotelPolicy(ctx context.Context, in *SetHotelPolicyRequest, opts ...grpc.CallOption) (*SetHotelPolicyResponse, error)
+	GetHotelPolicy(ctx context.Context, in *GetHotelPolicyRequest, opts ...grpc.CallOption) (*GetHotelPolicyResponse, error)
+	DeleteHotelPolicy(ctx context.Context, in *DeleteHotelPolicyRequest, opts ...grpc.CallOption) (*DeleteHotelPolicyResponse, error)
+}
+
+type hotelPolicyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHotelPolicyServiceClient(cc grpc.ClientConnInterface) HotelPolicyServiceClient {
+	return &hotelPolicyServiceClient{cc}
+}
+
+func (c *hotelPolicyServiceClient) SetHotelPolicy(ctx context.Context, in *SetHotelPolicyRequest, opts ...grpc.CallOption) (*SetHotelPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetHotelPolicyResponse)
+	err := c.cc.Invoke(ctx, HotelPolicyService_SetHotelPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelPolicyServiceClient) GetHotelPolicy(ctx context.Context, in *GetHotelPolicyRequest, opts ...grpc.CallOption) (*GetHotelPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHotelPolicyResponse)
+	err := c.cc.Invoke(ctx, HotelPolicyService_GetHotelPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelPolicyServiceClient) DeleteHotelPolicy(ctx context.Context, in *DeleteHotelPolicyRequest, opts ...grpc.CallOption) (*DeleteHotelPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHotelPolicyResponse)
+	err := c.cc.Invoke(ctx, HotelPolicyService_DeleteHotelPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HotelPolicyServiceServer is the server API for HotelPolicyService service.
+// All implementations must embed UnimplementedHotelPolicyServiceServer
+// for forward compatibility.
+type HotelPolicyServiceServer interface {
+	SetHotelPolicy(context.Context, *SetHotelPolicyRequest) (*SetHotelPolicyResponse, error)
+	GetHotelPolicy(context.Context, *GetHotelPolicyRequest) (*GetHotelPolicyResponse, error)
+	DeleteHotelPolicy(context.Context, *DeleteHotelPolicyRequest) (*DeleteHotelPolicyResponse, error)
+	mustEmbedUnimplementedHotelPolicyServiceServer()
+}
+
+// UnimplementedHotelPolicyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHotelPolicyServiceServer struct{}
+
+func (UnimplementedHotelPolicyServiceServer) SetHotelPolicy(context.Context, *SetHotelPolicyRequest) (*SetHotelPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetHotelPolicy not implemented")
+}
+func (UnimplementedHotelPolicyServiceServer) GetHotelPolicy(context.Context, *GetHotelPolicyRequest) (*GetHotelPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHotelPolicy not implemented")
+}
+func (UnimplementedHotelPolicyServiceServer) DeleteHotelPolicy(context.Context, *DeleteHotelPolicyRequest) (*DeleteHotelPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteHotelPolicy not implemented")
+}
+func (UnimplementedHotelPolicyServiceServer) mustEmbedUnimplementedHotelPolicyServiceServer() {}
+func (UnimplementedHotelPolicyServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeHotelPolicyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HotelPolicyServiceServer will
+// result in compilation errors.
+type UnsafeHotelPolicyServiceServer interface {
+	mustEmbedUnimplementedHotelPolicyServiceServer()
+}
+
+func RegisterHotelPolicyServiceServer(s grpc.ServiceRegistrar, srv HotelPolicyServiceServer) {
+	// If the following call panics, it indicates UnimplementedHotelPolicyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HotelPolicyService_ServiceDesc, srv)
+}
+
+func _HotelPolicyService_SetHotelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHotelPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelPolicyServiceServer).SetHotelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelPolicyService_SetHotelPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelPolicyServiceServer).SetHotelPolicy(ctx, req.(*SetHotelPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelPolicyService_GetHotelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelPolicyServiceServer).GetHotelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelPolicyService_GetHotelPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelPolicyServiceServer).GetHotelPolicy(ctx, req.(*GetHotelPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelPolicyService_DeleteHotelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHotelPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelPolicyServiceServer).DeleteHotelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelPolicyService_DeleteHotelPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelPolicyServiceServer).DeleteHotelPolicy(ctx, req.(*DeleteHotelPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HotelPolicyService_ServiceDesc is the grpc.ServiceDesc for HotelPolicyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HotelPolicyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.HotelPolicyService",
+	HandlerType: (*HotelPolicyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetHotelPolicy",
+			Handler:    _HotelPolicyService_SetHotelPolicy_Handler,
+		},
+		{
+			MethodName: "GetHotelPolicy",
+			Handler:    _HotelPolicyService_GetHotelPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteHotelPolicy",
+			Handler:    _HotelPolicyService_DeleteHotelPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel/v1/hotel_service.proto",
+}
+
+const (
+	HotelModerationService_SubmitHotelForReview_FullMethodName  = "/hotel.v1.HotelModerationService/SubmitHotelForReview"
+	HotelModerationService_ApproveHotel_FullMethodName          = "/hotel.v1.HotelModerationService/ApproveHotel"
+	HotelModerationService_RejectHotel_FullMethodName           = "/hotel.v1.HotelModerationService/RejectHotel"
+	HotelModerationService_SuspendHotel_FullMethodName          = "/hotel.v1.HotelModerationService/SuspendHotel"
+	HotelModerationService_GetHotelStatusHistory_FullMethodName = "/hotel.v1.HotelModerationService/GetHotelStatusHistory"
+)
+
+// HotelModerationServiceClient is the client API for HotelModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HotelModerationServiceClient interface {
+	SubmitHotelForReview(ctx context.Context, in *SubmitHotelForReviewRequest, opts ...grpc.CallOption) (*SubmitHotelForReviewResponse, error)
+	ApproveHotel(ctx context.Context, in *ApproveHotelRequest, opts ...grpc.CallOption) (*ApproveHotelResponse, error)
+	RejectHotel(ctx context.Context, in *RejectHotelRequest, opts ...grpc.CallOption) (*RejectHotelResponse, error)
+	SuspendHotel(ctx context.Context, in *SuspendHotelRequest, opts ...grpc.CallOption) (*SuspendHotelResponse, error)
+	GetHotelStatusHistory(ctx context.Context, in *GetHotelStatusHistoryRequest, opts ...grpc.CallOption) (*GetHotelStatusHistoryResponse, error)
+}
+
+type hotelModerationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHotelModerationServiceClient(cc grpc.ClientConnInterface) HotelModerationServiceClient {
+	return &hotelModerationServiceClient{cc}
+}
+
+func (c *hotelModerationServiceClient) SubmitHotelForReview(ctx context.Context, in *SubmitHotelForReviewRequest, opts ...grpc.CallOption) (*SubmitHotelForReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitHotelForReviewResponse)
+	err := c.cc.Invoke(ctx, HotelModerationService_SubmitHotelForReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelModerationServiceClient) ApproveHotel(ctx context.Context, in *ApproveHotelRequest, opts ...grpc.CallOption) (*ApproveHotelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveHotelResponse)
+	err := c.cc.Invoke(ctx, HotelModerationService_ApproveHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelModerationServiceClient) RejectHotel(ctx context.Context, in *RejectHotelRequest, opts ...grpc.CallOption) (*RejectHotelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectHotelResponse)
+	err := c.cc.Invoke(ctx, HotelModerationService_RejectHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelModerationServiceClient) SuspendHotel(ctx context.Context, in *SuspendHotelRequest, opts ...grpc.CallOption) (*SuspendHotelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendHotelResponse)
+	err := c.cc.Invoke(ctx, HotelModerationService_SuspendHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelModerationServiceClient) GetHotelStatusHistory(ctx context.Context, in *GetHotelStatusHistoryRequest, opts ...grpc.CallOption) (*GetHotelStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHotelStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, HotelModerationService_GetHotelStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HotelModerationServiceServer is the server API for HotelModerationService service.
+// All implementations must embed UnimplementedHotelModerationServiceServer
+// for forward compatibility.
+type HotelModerationServiceServer interface {
+	SubmitHotelForReview(context.Context, *SubmitHotelForReviewRequest) (*SubmitHotelForReviewResponse, error)
+	ApproveHotel(context.Context, *ApproveHotelRequest) (*ApproveHotelResponse, error)
+	RejectHotel(context.Context, *RejectHotelRequest) (*RejectHotelResponse, error)
+	SuspendHotel(context.Context, *SuspendHotelRequest) (*SuspendHotelResponse, error)
+	GetHotelStatusHistory(context.Context, *GetHotelStatusHistoryRequest) (*GetHotelStatusHistoryResponse, error)
+	mustEmbedUnimplementedHotelModerationServiceServer()
+}
+
+// UnimplementedHotelModerationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHotelModerationServiceServer struct{}
+
+func (UnimplementedHotelModerationServiceServer) SubmitHotelForReview(context.Context, *SubmitHotelForReviewRequest) (*SubmitHotelForReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitHotelForReview not implemented")
+}
+func (UnimplementedHotelModerationServiceServer) ApproveHotel(context.Context, *ApproveHotelRequest) (*ApproveHotelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveHotel not implemented")
+}
+func (UnimplementedHotelModerationServiceServer) RejectHotel(context.Context, *RejectHotelRequest) (*RejectHotelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectHotel not implemented")
+}
+func (UnimplementedHotelModerationServiceServer) SuspendHotel(context.Context, *SuspendHotelRequest) (*SuspendHotelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendHotel not implemented")
+}
+func (UnimplementedHotelModerationServiceServer) GetHotelStatusHistory(context.Context, *GetHotelStatusHistoryRequest) (*GetHotelStatusHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHotelStatusHistory not implemented")
+}
+func (UnimplementedHotelModerationServiceServer) mustEmbedUnimplementedHotelModerationServiceServer() {
+}
+func (UnimplementedHotelModerationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeHotelModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HotelModerationServiceServer will
+// result in compilation errors.
+type UnsafeHotelModerationServiceServer interface {
+	mustEmbedUnimplementedHotelModerationServiceServer()
+}
+
+func RegisterHotelModerationServiceServer(s grpc.ServiceRegistrar, srv HotelModerationServiceServer) {
+	// If the following call panics, it indicates UnimplementedHotelModerationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HotelModerationService_ServiceDesc, srv)
+}
+
+func _HotelModerationService_SubmitHotelForReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitHotelForReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelModerationServiceServer).SubmitHotelForReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelModerationService_SubmitHotelForReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelModerationServiceServer).SubmitHotelForReview(ctx, req.(*SubmitHotelForReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelModerationService_ApproveHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelModerationServiceServer).ApproveHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelModerationService_ApproveHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelModerationServiceServer).ApproveHotel(ctx, req.(*ApproveHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelModerationService_RejectHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelModerationServiceServer).RejectHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelModerationService_RejectHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelModerationServiceServer).RejectHotel(ctx, req.(*RejectHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelModerationService_SuspendHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelModerationServiceServer).SuspendHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelModerationService_SuspendHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelModerationServiceServer).SuspendHotel(ctx, req.(*SuspendHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelModerationService_GetHotelStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelModerationServiceServer).GetHotelStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelModerationService_GetHotelStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelModerationServiceServer).GetHotelStatusHistory(ctx, req.(*GetHotelStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HotelModerationService_ServiceDesc is the grpc.ServiceDesc for HotelModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HotelModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.HotelModerationService",
+	HandlerType: (*HotelModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitHotelForReview",
+			Handler:    _HotelModerationService_SubmitHotelForReview_Handler,
+		},
+		{
+			MethodName: "ApproveHotel",
+			Handler:    _HotelModerationService_ApproveHotel_Handler,
+		},
+		{
+			MethodName: "RejectHotel",
+			Handler:    _HotelModerationService_RejectHotel_Handler,
+		},
+		{
+			MethodName: "SuspendHotel",
+			Handler:    _HotelModerationService_SuspendHotel_Handler,
+		},
+		{
+			MethodName: "GetHotelStatusHistory",
+			Handler:    _HotelModerationService_GetHotelStatusHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel/v1/hotel_service.proto",
+}
+
+const (
+	RoomCategoryService_CreateRoomCategory_FullMethodName = "/hotel.v1.RoomCategoryService/CreateRoomCategory"
+	RoomCategoryService_GetRoomCategories_FullMethodName  = "/hotel.v1.RoomCategoryService/GetRoomCategories"
+	RoomCategoryService_GetRoomCategory_FullMethodName    = "/hotel.v1.RoomCategoryService/GetRoomCategory"
+	RoomCategoryService_UpdateRoomCategory_FullMethodName = "/hotel.v1.RoomCategoryService/UpdateRoomCategory"
+	RoomCategoryService_DeleteRoomCategory_FullMethodName = "/hotel.v1.RoomCategoryService/DeleteRoomCategory"
+	RoomCategoryService_AssignRoomCategory_FullMethodName = "/hotel.v1.RoomCategoryService/AssignRoomCategory"
+)
+
+// RoomCategoryServiceClient is the client API for RoomCategoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RoomCategoryServiceClient interface {
+	CreateRoomCategory(ctx context.Context, in *CreateRoomCategoryRequest, opts ...grpc.CallOption) (*CreateRoomCategoryResponse, error)
+	GetRoomCategories(ctx context.Context, in *GetRoomCategoriesRequest, opts ...grpc.CallOption) (*GetRoomCategoriesResponse, error)
+	GetRoomCategory(ctx context.Context, in *GetRoomCategoryRequest, opts ...grpc.CallOption) (*GetRoomCategoryResponse, error)
+	UpdateRoomCategory(ctx context.Context, in *UpdateRoomCategoryRequest, opts ...grpc.CallOption) (*UpdateRoomCategoryResponse, error)
+	DeleteRoomCategory(ctx context.Context, in *DeleteRoomCategoryRequest, opts ...grpc.CallOption) (*DeleteRoomCategoryResponse, error)
+	AssignRoomCategory(ctx context.Context, in *AssignRoomCategoryRequest, opts ...grpc.CallOption) (*AssignRoomCategoryResponse, error)
+}
+
+type roomCategoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoomCategoryServiceClient(cc grpc.ClientConnInterface) RoomCategoryServiceClient {
+	return &roomCategoryServiceClient{cc}
+}
+
+func (c *roomCategoryServiceClient) CreateRoomCategory(ctx context.Context, in *CreateRoomCategoryRequest, opts ...grpc.CallOption) (*CreateRoomCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoomCategoryResponse)
+	err := c.cc.Invoke(ctx, RoomCategoryService_CreateRoomCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomCategoryServiceClient) GetRoomCategories(ctx context.Context, in *GetRoomCategoriesRequest, opts ...grpc.CallOption) (*GetRoomCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoomCategoriesResponse)
+	err := c.cc.Invoke(ctx, RoomCategoryService_GetRoomCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomCategoryServiceClient) GetRoomCategory(ctx context.Context, in *GetRoomCategoryRequest, opts ...grpc.CallOption) (*GetRoomCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoomCategoryResponse)
+	err := c.cc.Invoke(ctx, RoomCategoryService_GetRoomCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomCategoryServiceClient) UpdateRoomCategory(ctx context.Context, in *UpdateRoomCategoryRequest, opts ...grpc.CallOption) (*UpdateRoomCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRoomCategoryResponse)
+	err := c.cc.Invoke(ctx, RoomCategoryService_UpdateRoomCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomCategoryServiceClient) DeleteRoomCategory(ctx context.Context, in *DeleteRoomCategoryRequest, opts ...grpc.CallOption) (*DeleteRoomCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRoomCategoryResponse)
+	err := c.cc.Invoke(ctx, RoomCategoryService_DeleteRoomCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomCategoryServiceClient) AssignRoomCategory(ctx context.Context, in *AssignRoomCategoryRequest, opts ...grpc.CallOption) (*AssignRoomCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignRoomCategoryResponse)
+	err := c.cc.Invoke(ctx, RoomCategoryService_AssignRoomCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoomCategoryServiceServer is the server API for RoomCategoryService service.
+// All implementations must embed UnimplementedRoomCategoryServiceServer
+// for forward compatibility.
+type RoomCategoryServiceServer interface {
+	CreateRoomCategory(context.Context, *CreateRoomCategoryRequest) (*CreateRoomCategoryResponse, error)
+	GetRoomCategories(context.Context, *GetRoomCategoriesRequest) (*GetRoomCategoriesResponse, error)
+	GetRoomCategory(context.Context, *GetRoomCategoryRequest) (*GetRoomCategoryResponse, error)
+	UpdateRoomCategory(context.Context, *UpdateRoomCategoryRequest) (*UpdateRoomCategoryResponse, error)
+	DeleteRoomCategory(context.Context, *DeleteRoomCategoryRequest) (*DeleteRoomCategoryResponse, error)
+	AssignRoomCategory(context.Context, *AssignRoomCategoryRequest) (*AssignRoomCategoryResponse, error)
+	mustEmbedUnimplementedRoomCategoryServiceServer()
+}
+
+// UnimplementedRoomCategoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRoomCategoryServiceServer struct{}
+
+func (UnimplementedRoomCategoryServiceServer) CreateRoomCategory(context.Context, *CreateRoomCategoryRequest) (*CreateRoomCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRoomCategory not implemented")
+}
+func (UnimplementedRoomCategoryServiceServer) GetRoomCategories(context.Context, *GetRoomCategoriesRequest) (*GetRoomCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRoomCategories not implemented")
+}
+func (UnimplementedRoomCategoryServiceServer) GetRoomCategory(context.Context, *GetRoomCategoryRequest) (*GetRoomCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRoomCategory not implemented")
+}
+func (UnimplementedRoomCategoryServiceServer) UpdateRoomCategory(context.Context, *UpdateRoomCategoryRequest) (*UpdateRoomCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRoomCategory not implemented")
+}
+func (UnimplementedRoomCategoryServiceServer) DeleteRoomCategory(context.Context, *DeleteRoomCategoryRequest) (*DeleteRoomCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRoomCategory not implemented")
+}
+func (UnimplementedRoomCategoryServiceServer) AssignRoomCategory(context.Context, *AssignRoomCategoryRequest) (*AssignRoomCategoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignRoomCategory not implemented")
+}
+func (UnimplementedRoomCategoryServiceServer) mustEmbedUnimplementedRoomCategoryServiceServer() {}
+func (UnimplementedRoomCategoryServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeRoomCategoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RoomCategoryServiceServer will
+// result in compilation errors.
+type UnsafeRoomCategoryServiceServer interface {
+	mustEmbedUnimplementedRoomCategoryServiceServer()
+}
+
+func RegisterRoomCategoryServiceServer(s grpc.ServiceRegistrar, srv RoomCategoryServiceServer) {
+	// If the following call panics, it indicates UnimplementedRoomCategoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RoomCategoryService_ServiceDesc, srv)
+}
+
+func _RoomCategoryService_CreateRoomCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomCategoryServiceServer).CreateRoomCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomCategoryService_CreateRoomCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomCategoryServiceServer).CreateRoomCategory(ctx, req.(*CreateRoomCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomCategoryService_GetRoomCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomCategoryServiceServer).GetRoomCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomCategoryService_GetRoomCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomCategoryServiceServer).GetRoomCategories(ctx, req.(*GetRoomCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomCategoryService_GetRoomCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomCategoryServiceServer).GetRoomCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomCategoryService_GetRoomCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomCategoryServiceServer).GetRoomCategory(ctx, req.(*GetRoomCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomCategoryService_UpdateRoomCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomCategoryServiceServer).UpdateRoomCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomCategoryService_UpdateRoomCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomCategoryServiceServer).UpdateRoomCategory(ctx, req.(*UpdateRoomCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomCategoryService_DeleteRoomCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomCategoryServiceServer).DeleteRoomCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomCategoryService_DeleteRoomCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomCategoryServiceServer).DeleteRoomCategory(ctx, req.(*DeleteRoomCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomCategoryService_AssignRoomCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoomCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomCategoryServiceServer).AssignRoomCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoomCategoryService_AssignRoomCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomCategoryServiceServer).AssignRoomCategory(ctx, req.(*AssignRoomCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RoomCategoryService_ServiceDesc is the grpc.ServiceDesc for RoomCategoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RoomCategoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hotel.v1.RoomCategoryService",
+	HandlerType: (*RoomCategoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoomCategory",
+			Handler:    _RoomCategoryService_CreateRoomCategory_Handler,
+		},
+		{
+			MethodName: "GetRoomCategories",
+			Handler:    _RoomCategoryService_GetRoomCategories_Handler,
+		},
+		{
+			MethodName: "GetRoomCategory",
+			Handler:    _RoomCategoryService_GetRoomCategory_Handler,
+		},
+		{
+			MethodName: "UpdateRoomCategory",
+			Handler:    _RoomCategoryService_UpdateRoomCategory_Handler,
+		},
+		{
+			MethodName: "DeleteRoomCategory",
+			Handler:    _RoomCategoryService_DeleteRoomCategory_Handler,
+		},
+		{
+			MethodName: "AssignRoomCategory",
+			Handler:    _RoomCategoryService_AssignRoomCategory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hotel/v1/hotel_service.proto",
+}
@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/enums/hotel_status.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HotelStatus int32
+
+const (
+	HotelStatus_HOTEL_STATUS_UNSPECIFIED    HotelStatus = 0
+	HotelStatus_HOTEL_STATUS_DRAFT          HotelStatus = 1
+	HotelStatus_HOTEL_STATUS_PENDING_REVIEW HotelStatus = 2
+	HotelStatus_HOTEL_STATUS_PUBLISHED      HotelStatus = 3
+	HotelStatus_HOTEL_STATUS_SUSPENDED      HotelStatus = 4
+)
+
+// Enum value maps for HotelStatus.
+var (
+	HotelStatus_name = map[int32]string{
+		0: "HOTEL_STATUS_UNSPECIFIED",
+		1: "HOTEL_STATUS_DRAFT",
+		2: "HOTEL_STATUS_PENDING_REVIEW",
+		3: "HOTEL_STATUS_PUBLISHED",
+		4: "HOTEL_STATUS_SUSPENDED",
+	}
+	HotelStatus_value = map[string]int32{
+		"HOTEL_STATUS_UNSPECIFIED":    0,
+		"HOTEL_STATUS_DRAFT":          1,
+		"HOTEL_STATUS_PENDING_REVIEW": 2,
+		"HOTEL_STATUS_PUBLISHED":      3,
+		"HOTEL_STATUS_SUSPENDED":      4,
+	}
+)
+
+func (x HotelStatus) Enum() *HotelStatus {
+	p := new(HotelStatus)
+	*p = x
+	return p
+}
+
+func (x HotelStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HotelStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_hotel_v1_enums_hotel_status_proto_enumTypes[0].Descriptor()
+}
+
+func (HotelStatus) Type() protoreflect.EnumType {
+	return &file_hotel_v1_enums_hotel_status_proto_enumTypes[0]
+}
+
+func (x HotelStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HotelStatus.Descriptor instead.
+func (HotelStatus) EnumDescriptor() ([]byte, []int) {
+	return file_hotel_v1_enums_hotel_status_proto_rawDescGZIP(), []int{0}
+}
+
+var File_hotel_v1_enums_hotel_status_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_enums_hotel_status_proto_rawDesc = "" +
+	"\n" +
+	"!hotel/v1/enums/hotel_status.proto\x12\bhotel.v1*\x9c\x01\n" +
+	"\vHotelStatus\x12\x1c\n" +
+	"\x18HOTEL_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12HOTEL_STATUS_DRAFT\x10\x01\x12\x1f\n" +
+	"\x1bHOTEL_STATUS_PENDING_REVIEW\x10\x02\x12\x1a\n" +
+	"\x16HOTEL_STATUS_PUBLISHED\x10\x03\x12\x1a\n" +
+	"\x16HOTEL_STATUS_SUSPENDED\x10\x04B\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_enums_hotel_status_proto_rawDescOnce sync.Once
+	file_hotel_v1_enums_hotel_status_proto_rawDescData []byte
+)
+
+func file_hotel_v1_enums_hotel_status_proto_rawDescGZIP() []byte {
+	file_hotel_v1_enums_hotel_status_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_enums_hotel_status_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_enums_hotel_status_proto_rawDesc), len(file_hotel_v1_enums_hotel_status_proto_rawDesc)))
+	})
+	return file_hotel_v1_enums_hotel_status_proto_rawDescData
+}
+
+var file_hotel_v1_enums_hotel_status_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_hotel_v1_enums_hotel_status_proto_goTypes = []any{
+	(HotelStatus)(0), // 0: hotel.v1.HotelStatus
+}
+var file_hotel_v1_enums_hotel_status_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_enums_hotel_status_proto_init() }
+func file_hotel_v1_enums_hotel_status_proto_init() {
+	if File_hotel_v1_enums_hotel_status_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_enums_hotel_status_proto_rawDesc), len(file_hotel_v1_enums_hotel_status_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_enums_hotel_status_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_enums_hotel_status_proto_depIdxs,
+		EnumInfos:         file_hotel_v1_enums_hotel_status_proto_enumTypes,
+	}.Build()
+	File_hotel_v1_enums_hotel_status_proto = out.File
+	file_hotel_v1_enums_hotel_status_proto_goTypes = nil
+	file_hotel_v1_enums_hotel_status_proto_depIdxs = nil
+}
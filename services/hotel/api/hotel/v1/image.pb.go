@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/models/image.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImageUrls struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Original      string                 `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	Thumbnail     string                 `protobuf:"bytes,2,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	ThumbnailWebp string                 `protobuf:"bytes,3,opt,name=thumbnail_webp,json=thumbnailWebp,proto3" json:"thumbnail_webp,omitempty"`
+	Webp          string                 `protobuf:"bytes,4,opt,name=webp,proto3" json:"webp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageUrls) Reset() {
+	*x = ImageUrls{}
+	mi := &file_hotel_v1_models_image_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageUrls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageUrls) ProtoMessage() {}
+
+func (x *ImageUrls) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_image_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageUrls.ProtoReflect.Descriptor instead.
+func (*ImageUrls) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_image_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImageUrls) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+func (x *ImageUrls) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
+func (x *ImageUrls) GetThumbnailWebp() string {
+	if x != nil {
+		return x.ThumbnailWebp
+	}
+	return ""
+}
+
+func (x *ImageUrls) GetWebp() string {
+	if x != nil {
+		return x.Webp
+	}
+	return ""
+}
+
+type Image struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        *string                `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	Position      uint32                 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     uint64                 `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Width         uint32                 `protobuf:"varint,7,opt,name=width,proto3" json:"width,omitempty"`
+	Height        uint32                 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Urls          *ImageUrls             `protobuf:"bytes,9,opt,name=urls,proto3" json:"urls,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_hotel_v1_models_image_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_image_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_image_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Image) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Image) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *Image) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *Image) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Image) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Image) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Image) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Image) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Image) GetUrls() *ImageUrls {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *Image) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_hotel_v1_models_image_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_models_image_proto_rawDesc = "" +
+	"\n" +
+	"\x1bhotel/v1/models/image.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x80\x01\n" +
+	"\tImageUrls\x12\x1a\n" +
+	"\boriginal\x18\x01 \x01(\tR\boriginal\x12\x1c\n" +
+	"\tthumbnail\x18\x02 \x01(\tR\tthumbnail\x12%\n" +
+	"\x0ethumbnail_webp\x18\x03 \x01(\tR\rthumbnailWebp\x12\x12\n" +
+	"\x04webp\x18\x04 \x01(\tR\x04webp\"\xcc\x02\n" +
+	"\x05Image\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\tR\ahotelId\x12\x1c\n" +
+	"\aroom_id\x18\x03 \x01(\tH\x00R\x06roomId\x88\x01\x01\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\rR\bposition\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x04R\tsizeBytes\x12\x14\n" +
+	"\x05width\x18\a \x01(\rR\x05width\x12\x16\n" +
+	"\x06height\x18\b \x01(\rR\x06height\x12'\n" +
+	"\x04urls\x18\t \x01(\v2\x13.hotel.v1.ImageUrlsR\x04urls\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\n" +
+	"\n" +
+	"\b_room_idB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_models_image_proto_rawDescOnce sync.Once
+	file_hotel_v1_models_image_proto_rawDescData []byte
+)
+
+func file_hotel_v1_models_image_proto_rawDescGZIP() []byte {
+	file_hotel_v1_models_image_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_models_image_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_models_image_proto_rawDesc), len(file_hotel_v1_models_image_proto_rawDesc)))
+	})
+	return file_hotel_v1_models_image_proto_rawDescData
+}
+
+var file_hotel_v1_models_image_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_models_image_proto_goTypes = []any{
+	(*ImageUrls)(nil),             // 0: hotel.v1.ImageUrls
+	(*Image)(nil),                 // 1: hotel.v1.Image
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_hotel_v1_models_image_proto_depIdxs = []int32{
+	0, // 0: hotel.v1.Image.urls:type_name -> hotel.v1.ImageUrls
+	2, // 1: hotel.v1.Image.created_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_models_image_proto_init() }
+func file_hotel_v1_models_image_proto_init() {
+	if File_hotel_v1_models_image_proto != nil {
+		return
+	}
+	file_hotel_v1_models_image_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_models_image_proto_rawDesc), len(file_hotel_v1_models_image_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_models_image_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_models_image_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_models_image_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_models_image_proto = out.File
+	file_hotel_v1_models_image_proto_goTypes = nil
+	file_hotel_v1_models_image_proto_depIdxs = nil
+}
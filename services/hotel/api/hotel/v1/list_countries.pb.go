@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/geo/list_countries.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListCountriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCountriesRequest) Reset() {
+	*x = ListCountriesRequest{}
+	mi := &file_hotel_v1_rpc_geo_list_countries_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCountriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCountriesRequest) ProtoMessage() {}
+
+func (x *ListCountriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_geo_list_countries_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCountriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCountriesRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_geo_list_countries_proto_rawDescGZIP(), []int{0}
+}
+
+type ListCountriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Countries     []*Country             `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCountriesResponse) Reset() {
+	*x = ListCountriesResponse{}
+	mi := &file_hotel_v1_rpc_geo_list_countries_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCountriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCountriesResponse) ProtoMessage() {}
+
+func (x *ListCountriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_geo_list_countries_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCountriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCountriesResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_geo_list_countries_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListCountriesResponse) GetCountries() []*Country {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_geo_list_countries_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_geo_list_countries_proto_rawDesc = "" +
+	"\n" +
+	"%hotel/v1/rpc/geo/list_countries.proto\x12\bhotel.v1\x1a\x19hotel/v1/models/geo.proto\"\x16\n" +
+	"\x14ListCountriesRequest\"H\n" +
+	"\x15ListCountriesResponse\x12/\n" +
+	"\tcountries\x18\x01 \x03(\v2\x11.hotel.v1.CountryR\tcountriesB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_geo_list_countries_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_geo_list_countries_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_geo_list_countries_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_geo_list_countries_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_geo_list_countries_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_geo_list_countries_proto_rawDesc), len(file_hotel_v1_rpc_geo_list_countries_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_geo_list_countries_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_geo_list_countries_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_geo_list_countries_proto_goTypes = []any{
+	(*ListCountriesRequest)(nil),  // 0: hotel.v1.ListCountriesRequest
+	(*ListCountriesResponse)(nil), // 1: hotel.v1.ListCountriesResponse
+	(*Country)(nil),               // 2: hotel.v1.Country
+}
+var file_hotel_v1_rpc_geo_list_countries_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.ListCountriesResponse.countries:type_name -> hotel.v1.Country
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_geo_list_countries_proto_init() }
+func file_hotel_v1_rpc_geo_list_countries_proto_init() {
+	if File_hotel_v1_rpc_geo_list_countries_proto != nil {
+		return
+	}
+	file_hotel_v1_models_geo_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_geo_list_countries_proto_rawDesc), len(file_hotel_v1_rpc_geo_list_countries_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_geo_list_countries_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_geo_list_countries_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_geo_list_countries_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_geo_list_countries_proto = out.File
+	file_hotel_v1_rpc_geo_list_countries_proto_goTypes = nil
+	file_hotel_v1_rpc_geo_list_countries_proto_depIdxs = nil
+}
@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/hotel/patch_hotel.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PatchHotelFields struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Description   string                      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Address       string                      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Location      *UpdateHotelLocationRequest `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Timezone      string                      `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CheckInTime   string                      `protobuf:"bytes,5,opt,name=check_in_time,json=checkInTime,proto3" json:"check_in_time,omitempty"`
+	CheckOutTime  string                      `protobuf:"bytes,6,opt,name=check_out_time,json=checkOutTime,proto3" json:"check_out_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchHotelFields) Reset() {
+	*x = PatchHotelFields{}
+	mi := &file_hotel_v1_rpc_hotel_patch_hotel_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchHotelFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchHotelFields) ProtoMessage() {}
+
+func (x *PatchHotelFields) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_patch_hotel_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchHotelFields.ProtoReflect.Descriptor instead.
+func (*PatchHotelFields) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PatchHotelFields) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PatchHotelFields) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PatchHotelFields) GetLocation() *UpdateHotelLocationRequest {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PatchHotelFields) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PatchHotelFields) GetCheckInTime() string {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return ""
+}
+
+func (x *PatchHotelFields) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
+}
+
+type PatchHotelRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode     string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CitySlug        string                 `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
+	HotelSlug       string                 `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	Hotel           *PatchHotelFields      `protobuf:"bytes,4,opt,name=hotel,proto3" json:"hotel,omitempty"`
+	UpdateMask      *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PatchHotelRequest) Reset() {
+	*x = PatchHotelRequest{}
+	mi := &file_hotel_v1_rpc_hotel_patch_hotel_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchHotelRequest) ProtoMessage() {}
+
+func (x *PatchHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_patch_hotel_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchHotelRequest.ProtoReflect.Descriptor instead.
+func (*PatchHotelRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PatchHotelRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *PatchHotelRequest) GetCitySlug() string {
+	if x != nil {
+		return x.CitySlug
+	}
+	return ""
+}
+
+func (x *PatchHotelRequest) GetHotelSlug() string {
+	if x != nil {
+		return x.HotelSlug
+	}
+	return ""
+}
+
+func (x *PatchHotelRequest) GetHotel() *PatchHotelFields {
+	if x != nil {
+		return x.Hotel
+	}
+	return nil
+}
+
+func (x *PatchHotelRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *PatchHotelRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type PatchHotelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hotel         *Hotel                 `protobuf:"bytes,1,opt,name=hotel,proto3" json:"hotel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchHotelResponse) Reset() {
+	*x = PatchHotelResponse{}
+	mi := &file_hotel_v1_rpc_hotel_patch_hotel_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchHotelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchHotelResponse) ProtoMessage() {}
+
+func (x *PatchHotelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_patch_hotel_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchHotelResponse.ProtoReflect.Descriptor instead.
+func (*PatchHotelResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PatchHotelResponse) GetHotel() *Hotel {
+	if x != nil {
+		return x.Hotel
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_hotel_patch_hotel_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDesc = "" +
+	"\n" +
+	"$hotel/v1/rpc/hotel/patch_hotel.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1bhotel/v1/models/hotel.proto\x1a%hotel/v1/rpc/hotel/update_hotel.proto\"\xf3\x02\n" +
+	"\x10PatchHotelFields\x12*\n" +
+	"\vdescription\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\xd0\x0fR\vdescription\x12'\n" +
+	"\aaddress\x18\x02 \x01(\tB\r\xbaH\n" +
+	"\xd8\x01\x01r\x05\x10\x05\x18\xf4\x03R\aaddress\x12@\n" +
+	"\blocation\x18\x03 \x01(\v2$.hotel.v1.UpdateHotelLocationRequestR\blocation\x12(\n" +
+	"\btimezone\x18\x04 \x01(\tB\f\xbaH\t\xd8\x01\x01r\x04\x10\x01\x18@R\btimezone\x12M\n" +
+	"\rcheck_in_time\x18\x05 \x01(\tB)\xbaH&\xd8\x01\x01r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\vcheckInTime\x12O\n" +
+	"\x0echeck_out_time\x18\x06 \x01(\tB)\xbaH&\xd8\x01\x01r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\fcheckOutTime\"\x94\x03\n" +
+	"\x11PatchHotelRequest\x124\n" +
+	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[a-z]{2}$R\vcountryCode\x12<\n" +
+	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
+	"\n" +
+	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\x128\n" +
+	"\x05hotel\x18\x04 \x01(\v2\x1a.hotel.v1.PatchHotelFieldsB\x06\xbaH\x03\xc8\x01\x01R\x05hotel\x12C\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"updateMask\x127\n" +
+	"\x10expected_version\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\";\n" +
+	"\x12PatchHotelResponse\x12%\n" +
+	"\x05hotel\x18\x01 \x01(\v2\x0f.hotel.v1.HotelR\x05hotelB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDesc), len(file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_hotel_patch_hotel_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_hotel_v1_rpc_hotel_patch_hotel_proto_goTypes = []any{
+	(*PatchHotelFields)(nil),           // 0: hotel.v1.PatchHotelFields
+	(*PatchHotelRequest)(nil),          // 1: hotel.v1.PatchHotelRequest
+	(*PatchHotelResponse)(nil),         // 2: hotel.v1.PatchHotelResponse
+	(*UpdateHotelLocationRequest)(nil), // 3: hotel.v1.UpdateHotelLocationRequest
+	(*fieldmaskpb.FieldMask)(nil),      // 4: google.protobuf.FieldMask
+	(*Hotel)(nil),                      // 5: hotel.v1.Hotel
+}
+var file_hotel_v1_rpc_hotel_patch_hotel_proto_depIdxs = []int32{
+	3, // 0: hotel.v1.PatchHotelFields.location:type_name -> hotel.v1.UpdateHotelLocationRequest
+	0, // 1: hotel.v1.PatchHotelRequest.hotel:type_name -> hotel.v1.PatchHotelFields
+	4, // 2: hotel.v1.PatchHotelRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5, // 3: hotel.v1.PatchHotelResponse.hotel:type_name -> hotel.v1.Hotel
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_hotel_patch_hotel_proto_init() }
+func file_hotel_v1_rpc_hotel_patch_hotel_proto_init() {
+	if File_hotel_v1_rpc_hotel_patch_hotel_proto != nil {
+		return
+	}
+	file_hotel_v1_models_hotel_proto_init()
+	file_hotel_v1_rpc_hotel_update_hotel_proto_init()
+	file_hotel_v1_rpc_hotel_patch_hotel_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDesc), len(file_hotel_v1_rpc_hotel_patch_hotel_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_hotel_patch_hotel_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_hotel_patch_hotel_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_hotel_patch_hotel_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_hotel_patch_hotel_proto = out.File
+	file_hotel_v1_rpc_hotel_patch_hotel_proto_goTypes = nil
+	file_hotel_v1_rpc_hotel_patch_hotel_proto_depIdxs = nil
+}
@@ -0,0 +1,346 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/room/patch_room.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PatchRoomFields struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	RoomNumber    string                 `protobuf:"bytes,3,opt,name=room_number,json=roomNumber,proto3" json:"room_number,omitempty"`
+	Type          RoomType               `protobuf:"varint,4,opt,name=type,proto3,enum=hotel.v1.RoomType" json:"type,omitempty"`
+	Price         string                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	Capacity      int64                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	AreaSqm       float32                `protobuf:"fixed32,7,opt,name=area_sqm,json=areaSqm,proto3" json:"area_sqm,omitempty"`
+	Floor         *int64                 `protobuf:"varint,8,opt,name=floor,proto3,oneof" json:"floor,omitempty"`
+	Amenities     []string               `protobuf:"bytes,9,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	Images        []string               `protobuf:"bytes,10,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchRoomFields) Reset() {
+	*x = PatchRoomFields{}
+	mi := &file_hotel_v1_rpc_room_patch_room_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchRoomFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchRoomFields) ProtoMessage() {}
+
+func (x *PatchRoomFields) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_patch_room_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchRoomFields.ProtoReflect.Descriptor instead.
+func (*PatchRoomFields) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_patch_room_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PatchRoomFields) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PatchRoomFields) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PatchRoomFields) GetRoomNumber() string {
+	if x != nil {
+		return x.RoomNumber
+	}
+	return ""
+}
+
+func (x *PatchRoomFields) GetType() RoomType {
+	if x != nil {
+		return x.Type
+	}
+	return RoomType_ROOM_TYPE_UNSPECIFIED
+}
+
+func (x *PatchRoomFields) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PatchRoomFields) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *PatchRoomFields) GetAreaSqm() float32 {
+	if x != nil {
+		return x.AreaSqm
+	}
+	return 0
+}
+
+func (x *PatchRoomFields) GetFloor() int64 {
+	if x != nil && x.Floor != nil {
+		return *x.Floor
+	}
+	return 0
+}
+
+func (x *PatchRoomFields) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *PatchRoomFields) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type PatchRoomRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Room            *PatchRoomFields       `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	UpdateMask      *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PatchRoomRequest) Reset() {
+	*x = PatchRoomRequest{}
+	mi := &file_hotel_v1_rpc_room_patch_room_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchRoomRequest) ProtoMessage() {}
+
+func (x *PatchRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_patch_room_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchRoomRequest.ProtoReflect.Descriptor instead.
+func (*PatchRoomRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_patch_room_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PatchRoomRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PatchRoomRequest) GetRoom() *PatchRoomFields {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *PatchRoomRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *PatchRoomRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type PatchRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchRoomResponse) Reset() {
+	*x = PatchRoomResponse{}
+	mi := &file_hotel_v1_rpc_room_patch_room_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchRoomResponse) ProtoMessage() {}
+
+func (x *PatchRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_room_patch_room_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchRoomResponse.ProtoReflect.Descriptor instead.
+func (*PatchRoomResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_room_patch_room_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PatchRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_room_patch_room_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_room_patch_room_proto_rawDesc = "" +
+	"\n" +
+	"\"hotel/v1/rpc/room/patch_room.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1ahotel/v1/models/room.proto\x1a\x1ehotel/v1/enums/room_type.proto\"\xcd\x03\n" +
+	"\x0fPatchRoomFields\x12\"\n" +
+	"\x05title\x18\x01 \x01(\tB\f\xbaH\t\xd8\x01\x01r\x04\x10\x03\x18dR\x05title\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x12-\n" +
+	"\vroom_number\x18\x03 \x01(\tB\f\xbaH\t\xd8\x01\x01r\x04\x10\x01\x18\n" +
+	"R\n" +
+	"roomNumber\x120\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x12.hotel.v1.RoomTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\tR\x05price\x12(\n" +
+	"\bcapacity\x18\x06 \x01(\x03B\f\xbaH\t\xd8\x01\x01\"\x04\x18\n" +
+	"(\x01R\bcapacity\x12-\n" +
+	"\barea_sqm\x18\a \x01(\x02B\x12\xbaH\x0f\xd8\x01\x01\n" +
+	"\n" +
+	"\x1d\xf6?\x1cF%\x00\x00\x00\x00R\aareaSqm\x12\"\n" +
+	"\x05floor\x18\b \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x00R\x05floor\x88\x01\x01\x12A\n" +
+	"\tamenities\x18\t \x03(\tB#\xbaH \x92\x01\x1d\x102\x18\x01\"\x17r\x15\x18@2\x11^[a-z][a-z0-9_]*$R\tamenities\x12)\n" +
+	"\x06images\x18\n" +
+	" \x03(\tB\x11\xbaH\x0e\x92\x01\v\x102\"\ar\x05\x10\x01\x18\xf4\x03R\x06imagesB\b\n" +
+	"\x06_floor\"\xf6\x01\n" +
+	"\x10PatchRoomRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x125\n" +
+	"\x04room\x18\x02 \x01(\v2\x19.hotel.v1.PatchRoomFieldsB\x06\xbaH\x03\xc8\x01\x01R\x04room\x12C\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"updateMask\x127\n" +
+	"\x10expected_version\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"7\n" +
+	"\x11PatchRoomResponse\x12\"\n" +
+	"\x04room\x18\x01 \x01(\v2\x0e.hotel.v1.RoomR\x04roomB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_room_patch_room_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_room_patch_room_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_room_patch_room_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_room_patch_room_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_room_patch_room_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_patch_room_proto_rawDesc), len(file_hotel_v1_rpc_room_patch_room_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_room_patch_room_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_room_patch_room_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_hotel_v1_rpc_room_patch_room_proto_goTypes = []any{
+	(*PatchRoomFields)(nil),       // 0: hotel.v1.PatchRoomFields
+	(*PatchRoomRequest)(nil),      // 1: hotel.v1.PatchRoomRequest
+	(*PatchRoomResponse)(nil),     // 2: hotel.v1.PatchRoomResponse
+	(RoomType)(0),                 // 3: hotel.v1.RoomType
+	(*fieldmaskpb.FieldMask)(nil), // 4: google.protobuf.FieldMask
+	(*Room)(nil),                  // 5: hotel.v1.Room
+}
+var file_hotel_v1_rpc_room_patch_room_proto_depIdxs = []int32{
+	3, // 0: hotel.v1.PatchRoomFields.type:type_name -> hotel.v1.RoomType
+	0, // 1: hotel.v1.PatchRoomRequest.room:type_name -> hotel.v1.PatchRoomFields
+	4, // 2: hotel.v1.PatchRoomRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5, // 3: hotel.v1.PatchRoomResponse.room:type_name -> hotel.v1.Room
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_room_patch_room_proto_init() }
+func file_hotel_v1_rpc_room_patch_room_proto_init() {
+	if File_hotel_v1_rpc_room_patch_room_proto != nil {
+		return
+	}
+	file_hotel_v1_models_room_proto_init()
+	file_hotel_v1_enums_room_type_proto_init()
+	file_hotel_v1_rpc_room_patch_room_proto_msgTypes[0].OneofWrappers = []any{}
+	file_hotel_v1_rpc_room_patch_room_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_room_patch_room_proto_rawDesc), len(file_hotel_v1_rpc_room_patch_room_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_room_patch_room_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_room_patch_room_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_room_patch_room_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_room_patch_room_proto = out.File
+	file_hotel_v1_rpc_room_patch_room_proto_goTypes = nil
+	file_hotel_v1_rpc_room_patch_room_proto_depIdxs = nil
+}
@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/rate_plan/quote_stay.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QuoteStayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CheckIn       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteStayRequest) Reset() {
+	*x = QuoteStayRequest{}
+	mi := &file_hotel_v1_rpc_rate_plan_quote_stay_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteStayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteStayRequest) ProtoMessage() {}
+
+func (x *QuoteStayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_rate_plan_quote_stay_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteStayRequest.ProtoReflect.Descriptor instead.
+func (*QuoteStayRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuoteStayRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *QuoteStayRequest) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *QuoteStayRequest) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+type QuoteStayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *StayQuote             `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteStayResponse) Reset() {
+	*x = QuoteStayResponse{}
+	mi := &file_hotel_v1_rpc_rate_plan_quote_stay_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteStayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteStayResponse) ProtoMessage() {}
+
+func (x *QuoteStayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_rate_plan_quote_stay_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteStayResponse.ProtoReflect.Descriptor instead.
+func (*QuoteStayResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuoteStayResponse) GetQuote() *StayQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_rate_plan_quote_stay_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDesc = "" +
+	"\n" +
+	"'hotel/v1/rpc/rate_plan/quote_stay.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fhotel/v1/models/rate_plan.proto\"\x91\x02\n" +
+	"\x10QuoteStayRequest\x12!\n" +
+	"\aroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06roomId\x12=\n" +
+	"\bcheck_in\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\acheckIn\x12?\n" +
+	"\tcheck_out\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bcheckOut:Z\xbaHW\x1aU\n" +
+	"\x11quote.dates.order\x12 check_out must be after check_in\x1a\x1ethis.check_out > this.check_in\">\n" +
+	"\x11QuoteStayResponse\x12)\n" +
+	"\x05quote\x18\x01 \x01(\v2\x13.hotel.v1.StayQuoteR\x05quoteB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDesc), len(file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_rate_plan_quote_stay_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_rate_plan_quote_stay_proto_goTypes = []any{
+	(*QuoteStayRequest)(nil),      // 0: hotel.v1.QuoteStayRequest
+	(*QuoteStayResponse)(nil),     // 1: hotel.v1.QuoteStayResponse
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*StayQuote)(nil),             // 3: hotel.v1.StayQuote
+}
+var file_hotel_v1_rpc_rate_plan_quote_stay_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.QuoteStayRequest.check_in:type_name -> google.protobuf.Timestamp
+	2, // 1: hotel.v1.QuoteStayRequest.check_out:type_name -> google.protobuf.Timestamp
+	3, // 2: hotel.v1.QuoteStayResponse.quote:type_name -> hotel.v1.StayQuote
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_rate_plan_quote_stay_proto_init() }
+func file_hotel_v1_rpc_rate_plan_quote_stay_proto_init() {
+	if File_hotel_v1_rpc_rate_plan_quote_stay_proto != nil {
+		return
+	}
+	file_hotel_v1_models_rate_plan_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDesc), len(file_hotel_v1_rpc_rate_plan_quote_stay_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_rate_plan_quote_stay_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_rate_plan_quote_stay_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_rate_plan_quote_stay_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_rate_plan_quote_stay_proto = out.File
+	file_hotel_v1_rpc_rate_plan_quote_stay_proto_goTypes = nil
+	file_hotel_v1_rpc_rate_plan_quote_stay_proto_depIdxs = nil
+}
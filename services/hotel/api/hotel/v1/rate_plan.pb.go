@@ -0,0 +1,440 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/models/rate_plan.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WeekdayModifier struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DayOfWeek         uint32                 `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	AdjustmentPercent string                 `protobuf:"bytes,2,opt,name=adjustment_percent,json=adjustmentPercent,proto3" json:"adjustment_percent,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WeekdayModifier) Reset() {
+	*x = WeekdayModifier{}
+	mi := &file_hotel_v1_models_rate_plan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeekdayModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeekdayModifier) ProtoMessage() {}
+
+func (x *WeekdayModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_rate_plan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeekdayModifier.ProtoReflect.Descriptor instead.
+func (*WeekdayModifier) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_rate_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WeekdayModifier) GetDayOfWeek() uint32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *WeekdayModifier) GetAdjustmentPercent() string {
+	if x != nil {
+		return x.AdjustmentPercent
+	}
+	return ""
+}
+
+type RatePlan struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HotelId          string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId           *string                `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	RoomType         RoomType               `protobuf:"varint,4,opt,name=room_type,json=roomType,proto3,enum=hotel.v1.RoomType" json:"room_type,omitempty"`
+	Title            string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	StartDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Price            string                 `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`
+	WeekdayModifiers []*WeekdayModifier     `protobuf:"bytes,9,rep,name=weekday_modifiers,json=weekdayModifiers,proto3" json:"weekday_modifiers,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RatePlan) Reset() {
+	*x = RatePlan{}
+	mi := &file_hotel_v1_models_rate_plan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatePlan) ProtoMessage() {}
+
+func (x *RatePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_rate_plan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatePlan.ProtoReflect.Descriptor instead.
+func (*RatePlan) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_rate_plan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RatePlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RatePlan) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *RatePlan) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *RatePlan) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNSPECIFIED
+}
+
+func (x *RatePlan) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RatePlan) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RatePlan) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *RatePlan) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *RatePlan) GetWeekdayModifiers() []*WeekdayModifier {
+	if x != nil {
+		return x.WeekdayModifiers
+	}
+	return nil
+}
+
+func (x *RatePlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RatePlan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type NightlyRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Price         string                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	RatePlanId    *string                `protobuf:"bytes,3,opt,name=rate_plan_id,json=ratePlanId,proto3,oneof" json:"rate_plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NightlyRate) Reset() {
+	*x = NightlyRate{}
+	mi := &file_hotel_v1_models_rate_plan_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NightlyRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NightlyRate) ProtoMessage() {}
+
+func (x *NightlyRate) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_rate_plan_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NightlyRate.ProtoReflect.Descriptor instead.
+func (*NightlyRate) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_rate_plan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NightlyRate) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *NightlyRate) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *NightlyRate) GetRatePlanId() string {
+	if x != nil && x.RatePlanId != nil {
+		return *x.RatePlanId
+	}
+	return ""
+}
+
+type StayQuote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        string                 `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	CheckIn       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	Nights        []*NightlyRate         `protobuf:"bytes,4,rep,name=nights,proto3" json:"nights,omitempty"`
+	TotalAmount   string                 `protobuf:"bytes,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StayQuote) Reset() {
+	*x = StayQuote{}
+	mi := &file_hotel_v1_models_rate_plan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StayQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StayQuote) ProtoMessage() {}
+
+func (x *StayQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_rate_plan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StayQuote.ProtoReflect.Descriptor instead.
+func (*StayQuote) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_rate_plan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StayQuote) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *StayQuote) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *StayQuote) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *StayQuote) GetNights() []*NightlyRate {
+	if x != nil {
+		return x.Nights
+	}
+	return nil
+}
+
+func (x *StayQuote) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+var File_hotel_v1_models_rate_plan_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_models_rate_plan_proto_rawDesc = "" +
+	"\n" +
+	"\x1fhotel/v1/models/rate_plan.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ehotel/v1/enums/room_type.proto\"`\n" +
+	"\x0fWeekdayModifier\x12\x1e\n" +
+	"\vday_of_week\x18\x01 \x01(\rR\tdayOfWeek\x12-\n" +
+	"\x12adjustment_percent\x18\x02 \x01(\tR\x11adjustmentPercent\"\xec\x03\n" +
+	"\bRatePlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\tR\ahotelId\x12\x1c\n" +
+	"\aroom_id\x18\x03 \x01(\tH\x00R\x06roomId\x88\x01\x01\x12/\n" +
+	"\troom_type\x18\x04 \x01(\x0e2\x12.hotel.v1.RoomTypeR\broomType\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x14\n" +
+	"\x05price\x18\b \x01(\tR\x05price\x12F\n" +
+	"\x11weekday_modifiers\x18\t \x03(\v2\x19.hotel.v1.WeekdayModifierR\x10weekdayModifiers\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\n" +
+	"\n" +
+	"\b_room_id\"\x8b\x01\n" +
+	"\vNightlyRate\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\tR\x05price\x12%\n" +
+	"\frate_plan_id\x18\x03 \x01(\tH\x00R\n" +
+	"ratePlanId\x88\x01\x01B\x0f\n" +
+	"\r_rate_plan_id\"\xe6\x01\n" +
+	"\tStayQuote\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\tR\x06roomId\x125\n" +
+	"\bcheck_in\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\acheckIn\x127\n" +
+	"\tcheck_out\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bcheckOut\x12-\n" +
+	"\x06nights\x18\x04 \x03(\v2\x15.hotel.v1.NightlyRateR\x06nights\x12!\n" +
+	"\ftotal_amount\x18\x05 \x01(\tR\vtotalAmountB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_models_rate_plan_proto_rawDescOnce sync.Once
+	file_hotel_v1_models_rate_plan_proto_rawDescData []byte
+)
+
+func file_hotel_v1_models_rate_plan_proto_rawDescGZIP() []byte {
+	file_hotel_v1_models_rate_plan_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_models_rate_plan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_models_rate_plan_proto_rawDesc), len(file_hotel_v1_models_rate_plan_proto_rawDesc)))
+	})
+	return file_hotel_v1_models_rate_plan_proto_rawDescData
+}
+
+var file_hotel_v1_models_rate_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_hotel_v1_models_rate_plan_proto_goTypes = []any{
+	(*WeekdayModifier)(nil),       // 0: hotel.v1.WeekdayModifier
+	(*RatePlan)(nil),              // 1: hotel.v1.RatePlan
+	(*NightlyRate)(nil),           // 2: hotel.v1.NightlyRate
+	(*StayQuote)(nil),             // 3: hotel.v1.StayQuote
+	(RoomType)(0),                 // 4: hotel.v1.RoomType
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_hotel_v1_models_rate_plan_proto_depIdxs = []int32{
+	4,  // 0: hotel.v1.RatePlan.room_type:type_name -> hotel.v1.RoomType
+	5,  // 1: hotel.v1.RatePlan.start_date:type_name -> google.protobuf.Timestamp
+	5,  // 2: hotel.v1.RatePlan.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 3: hotel.v1.RatePlan.weekday_modifiers:type_name -> hotel.v1.WeekdayModifier
+	5,  // 4: hotel.v1.RatePlan.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 5: hotel.v1.RatePlan.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 6: hotel.v1.NightlyRate.date:type_name -> google.protobuf.Timestamp
+	5,  // 7: hotel.v1.StayQuote.check_in:type_name -> google.protobuf.Timestamp
+	5,  // 8: hotel.v1.StayQuote.check_out:type_name -> google.protobuf.Timestamp
+	2,  // 9: hotel.v1.StayQuote.nights:type_name -> hotel.v1.NightlyRate
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_models_rate_plan_proto_init() }
+func file_hotel_v1_models_rate_plan_proto_init() {
+	if File_hotel_v1_models_rate_plan_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_room_type_proto_init()
+	file_hotel_v1_models_rate_plan_proto_msgTypes[1].OneofWrappers = []any{}
+	file_hotel_v1_models_rate_plan_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_models_rate_plan_proto_rawDesc), len(file_hotel_v1_models_rate_plan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_models_rate_plan_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_models_rate_plan_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_models_rate_plan_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_models_rate_plan_proto = out.File
+	file_hotel_v1_models_rate_plan_proto_goTypes = nil
+	file_hotel_v1_models_rate_plan_proto_depIdxs = nil
+}
@@ -38,6 +38,10 @@ type Room struct {
 	Images        []string               `protobuf:"bytes,12,rep,name=images,proto3" json:"images,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HotelId       string                 `protobuf:"bytes,15,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Version       int64                  `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	CategoryId    *string                `protobuf:"bytes,18,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -170,6 +174,34 @@ func (x *Room) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Room) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *Room) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Room) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Room) GetCategoryId() string {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return ""
+}
+
 type RoomShort struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -298,6 +330,7 @@ type UpdateRoom struct {
 	Floor         int64                  `protobuf:"varint,8,opt,name=floor,proto3" json:"floor,omitempty"`
 	Amenities     []string               `protobuf:"bytes,9,rep,name=amenities,proto3" json:"amenities,omitempty"`
 	Images        []string               `protobuf:"bytes,10,rep,name=images,proto3" json:"images,omitempty"`
+	Version       int64                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -402,11 +435,18 @@ func (x *UpdateRoom) GetImages() []string {
 	return nil
 }
 
+func (x *UpdateRoom) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 var File_hotel_v1_models_room_proto protoreflect.FileDescriptor
 
 const file_hotel_v1_models_room_proto_rawDesc = "" +
 	"\n" +
-	"\x1ahotel/v1/models/room.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a hotel/v1/enums/room_status.proto\x1a\x1ehotel/v1/enums/room_type.proto\"\xe9\x03\n" +
+	"\x1ahotel/v1/models/room.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a hotel/v1/enums/room_status.proto\x1a\x1ehotel/v1/enums/room_type.proto\"\x8f\x05\n" +
 	"\x04Room\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
@@ -425,8 +465,15 @@ const file_hotel_v1_models_room_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
-	"\f_description\"\xab\x02\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bhotel_id\x18\x0f \x01(\tR\ahotelId\x12\x18\n" +
+	"\aversion\x18\x10 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12$\n" +
+	"\vcategory_id\x18\x12 \x01(\tH\x01R\n" +
+	"categoryId\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_category_id\"\xab\x02\n" +
 	"\tRoomShort\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
@@ -439,7 +486,7 @@ const file_hotel_v1_models_room_proto_rawDesc = "" +
 	"\barea_sqm\x18\b \x01(\x02R\aareaSqm\x12\x1c\n" +
 	"\tamenities\x18\t \x03(\tR\tamenities\x12\x16\n" +
 	"\x06images\x18\n" +
-	" \x03(\tR\x06images\"\xa6\x02\n" +
+	" \x03(\tR\x06images\"\xc0\x02\n" +
 	"\n" +
 	"UpdateRoom\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1f\n" +
@@ -453,7 +500,8 @@ const file_hotel_v1_models_room_proto_rawDesc = "" +
 	"\x05floor\x18\b \x01(\x03R\x05floor\x12\x1c\n" +
 	"\tamenities\x18\t \x03(\tR\tamenities\x12\x16\n" +
 	"\x06images\x18\n" +
-	" \x03(\tR\x06imagesB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+	" \x03(\tR\x06images\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversionB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
 
 var (
 	file_hotel_v1_models_room_proto_rawDescOnce sync.Once
@@ -481,14 +529,15 @@ var file_hotel_v1_models_room_proto_depIdxs = []int32{
 	4, // 1: hotel.v1.Room.type:type_name -> hotel.v1.RoomType
 	5, // 2: hotel.v1.Room.created_at:type_name -> google.protobuf.Timestamp
 	5, // 3: hotel.v1.Room.updated_at:type_name -> google.protobuf.Timestamp
-	3, // 4: hotel.v1.RoomShort.status:type_name -> hotel.v1.RoomStatus
-	4, // 5: hotel.v1.RoomShort.type:type_name -> hotel.v1.RoomType
-	4, // 6: hotel.v1.UpdateRoom.type:type_name -> hotel.v1.RoomType
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	5, // 4: hotel.v1.Room.deleted_at:type_name -> google.protobuf.Timestamp
+	3, // 5: hotel.v1.RoomShort.status:type_name -> hotel.v1.RoomStatus
+	4, // 6: hotel.v1.RoomShort.type:type_name -> hotel.v1.RoomType
+	4, // 7: hotel.v1.UpdateRoom.type:type_name -> hotel.v1.RoomType
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_hotel_v1_models_room_proto_init() }
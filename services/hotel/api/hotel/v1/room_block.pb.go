@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/models/room_block.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RoomBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Reason        RoomBlockReason        `protobuf:"varint,5,opt,name=reason,proto3,enum=hotel.v1.RoomBlockReason" json:"reason,omitempty"`
+	Note          *string                `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomBlock) Reset() {
+	*x = RoomBlock{}
+	mi := &file_hotel_v1_models_room_block_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomBlock) ProtoMessage() {}
+
+func (x *RoomBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_room_block_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomBlock.ProtoReflect.Descriptor instead.
+func (*RoomBlock) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_room_block_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoomBlock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoomBlock) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RoomBlock) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RoomBlock) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *RoomBlock) GetReason() RoomBlockReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RoomBlockReason_ROOM_BLOCK_REASON_UNSPECIFIED
+}
+
+func (x *RoomBlock) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *RoomBlock) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RoomBlock) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_hotel_v1_models_room_block_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_models_room_block_proto_rawDesc = "" +
+	"\n" +
+	" hotel/v1/models/room_block.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&hotel/v1/enums/room_block_reason.proto\"\xf1\x02\n" +
+	"\tRoomBlock\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aroom_id\x18\x02 \x01(\tR\x06roomId\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x121\n" +
+	"\x06reason\x18\x05 \x01(\x0e2\x19.hotel.v1.RoomBlockReasonR\x06reason\x12\x17\n" +
+	"\x04note\x18\x06 \x01(\tH\x00R\x04note\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\a\n" +
+	"\x05_noteB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_models_room_block_proto_rawDescOnce sync.Once
+	file_hotel_v1_models_room_block_proto_rawDescData []byte
+)
+
+func file_hotel_v1_models_room_block_proto_rawDescGZIP() []byte {
+	file_hotel_v1_models_room_block_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_models_room_block_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_models_room_block_proto_rawDesc), len(file_hotel_v1_models_room_block_proto_rawDesc)))
+	})
+	return file_hotel_v1_models_room_block_proto_rawDescData
+}
+
+var file_hotel_v1_models_room_block_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hotel_v1_models_room_block_proto_goTypes = []any{
+	(*RoomBlock)(nil),             // 0: hotel.v1.RoomBlock
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(RoomBlockReason)(0),          // 2: hotel.v1.RoomBlockReason
+}
+var file_hotel_v1_models_room_block_proto_depIdxs = []int32{
+	1, // 0: hotel.v1.RoomBlock.start_date:type_name -> google.protobuf.Timestamp
+	1, // 1: hotel.v1.RoomBlock.end_date:type_name -> google.protobuf.Timestamp
+	2, // 2: hotel.v1.RoomBlock.reason:type_name -> hotel.v1.RoomBlockReason
+	1, // 3: hotel.v1.RoomBlock.created_at:type_name -> google.protobuf.Timestamp
+	1, // 4: hotel.v1.RoomBlock.updated_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_models_room_block_proto_init() }
+func file_hotel_v1_models_room_block_proto_init() {
+	if File_hotel_v1_models_room_block_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_room_block_reason_proto_init()
+	file_hotel_v1_models_room_block_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_models_room_block_proto_rawDesc), len(file_hotel_v1_models_room_block_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_models_room_block_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_models_room_block_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_models_room_block_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_models_room_block_proto = out.File
+	file_hotel_v1_models_room_block_proto_goTypes = nil
+	file_hotel_v1_models_room_block_proto_depIdxs = nil
+}
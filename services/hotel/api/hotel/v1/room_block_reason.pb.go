@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/enums/room_block_reason.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RoomBlockReason int32
+
+const (
+	RoomBlockReason_ROOM_BLOCK_REASON_UNSPECIFIED RoomBlockReason = 0
+	RoomBlockReason_ROOM_BLOCK_REASON_MAINTENANCE RoomBlockReason = 1
+	RoomBlockReason_ROOM_BLOCK_REASON_OWNER_USE   RoomBlockReason = 2
+	RoomBlockReason_ROOM_BLOCK_REASON_RENOVATION  RoomBlockReason = 3
+)
+
+// Enum value maps for RoomBlockReason.
+var (
+	RoomBlockReason_name = map[int32]string{
+		0: "ROOM_BLOCK_REASON_UNSPECIFIED",
+		1: "ROOM_BLOCK_REASON_MAINTENANCE",
+		2: "ROOM_BLOCK_REASON_OWNER_USE",
+		3: "ROOM_BLOCK_REASON_RENOVATION",
+	}
+	RoomBlockReason_value = map[string]int32{
+		"ROOM_BLOCK_REASON_UNSPECIFIED": 0,
+		"ROOM_BLOCK_REASON_MAINTENANCE": 1,
+		"ROOM_BLOCK_REASON_OWNER_USE":   2,
+		"ROOM_BLOCK_REASON_RENOVATION":  3,
+	}
+)
+
+func (x RoomBlockReason) Enum() *RoomBlockReason {
+	p := new(RoomBlockReason)
+	*p = x
+	return p
+}
+
+func (x RoomBlockReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomBlockReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_hotel_v1_enums_room_block_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (RoomBlockReason) Type() protoreflect.EnumType {
+	return &file_hotel_v1_enums_room_block_reason_proto_enumTypes[0]
+}
+
+func (x RoomBlockReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomBlockReason.Descriptor instead.
+func (RoomBlockReason) EnumDescriptor() ([]byte, []int) {
+	return file_hotel_v1_enums_room_block_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_hotel_v1_enums_room_block_reason_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_enums_room_block_reason_proto_rawDesc = "" +
+	"\n" +
+	"&hotel/v1/enums/room_block_reason.proto\x12\bhotel.v1*\x9a\x01\n" +
+	"\x0fRoomBlockReason\x12!\n" +
+	"\x1dROOM_BLOCK_REASON_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dROOM_BLOCK_REASON_MAINTENANCE\x10\x01\x12\x1f\n" +
+	"\x1bROOM_BLOCK_REASON_OWNER_USE\x10\x02\x12 \n" +
+	"\x1cROOM_BLOCK_REASON_RENOVATION\x10\x03B\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_enums_room_block_reason_proto_rawDescOnce sync.Once
+	file_hotel_v1_enums_room_block_reason_proto_rawDescData []byte
+)
+
+func file_hotel_v1_enums_room_block_reason_proto_rawDescGZIP() []byte {
+	file_hotel_v1_enums_room_block_reason_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_enums_room_block_reason_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_enums_room_block_reason_proto_rawDesc), len(file_hotel_v1_enums_room_block_reason_proto_rawDesc)))
+	})
+	return file_hotel_v1_enums_room_block_reason_proto_rawDescData
+}
+
+var file_hotel_v1_enums_room_block_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_hotel_v1_enums_room_block_reason_proto_goTypes = []any{
+	(RoomBlockReason)(0), // 0: hotel.v1.RoomBlockReason
+}
+var file_hotel_v1_enums_room_block_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_enums_room_block_reason_proto_init() }
+func file_hotel_v1_enums_room_block_reason_proto_init() {
+	if File_hotel_v1_enums_room_block_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_enums_room_block_reason_proto_rawDesc), len(file_hotel_v1_enums_room_block_reason_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_enums_room_block_reason_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_enums_room_block_reason_proto_depIdxs,
+		EnumInfos:         file_hotel_v1_enums_room_block_reason_proto_enumTypes,
+	}.Build()
+	File_hotel_v1_enums_room_block_reason_proto = out.File
+	file_hotel_v1_enums_room_block_reason_proto_goTypes = nil
+	file_hotel_v1_enums_room_block_reason_proto_depIdxs = nil
+}
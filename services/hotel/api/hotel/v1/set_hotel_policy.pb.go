@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/hotel_policy/set_hotel_policy.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CancellationTierRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HoursBeforeCheckIn uint32                 `protobuf:"varint,1,opt,name=hours_before_check_in,json=hoursBeforeCheckIn,proto3" json:"hours_before_check_in,omitempty"`
+	Penalty            CancellationPenalty    `protobuf:"varint,2,opt,name=penalty,proto3,enum=hotel.v1.CancellationPenalty" json:"penalty,omitempty"`
+	PenaltyPercent     uint32                 `protobuf:"varint,3,opt,name=penalty_percent,json=penaltyPercent,proto3" json:"penalty_percent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CancellationTierRequest) Reset() {
+	*x = CancellationTierRequest{}
+	mi := &file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationTierRequest) ProtoMessage() {}
+
+func (x *CancellationTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationTierRequest.ProtoReflect.Descriptor instead.
+func (*CancellationTierRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CancellationTierRequest) GetHoursBeforeCheckIn() uint32 {
+	if x != nil {
+		return x.HoursBeforeCheckIn
+	}
+	return 0
+}
+
+func (x *CancellationTierRequest) GetPenalty() CancellationPenalty {
+	if x != nil {
+		return x.Penalty
+	}
+	return CancellationPenalty_CANCELLATION_PENALTY_UNSPECIFIED
+}
+
+func (x *CancellationTierRequest) GetPenaltyPercent() uint32 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
+type ChildAgeBandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinAge        uint32                 `protobuf:"varint,1,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`
+	MaxAge        uint32                 `protobuf:"varint,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	PricePercent  uint32                 `protobuf:"varint,3,opt,name=price_percent,json=pricePercent,proto3" json:"price_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChildAgeBandRequest) Reset() {
+	*x = ChildAgeBandRequest{}
+	mi := &file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChildAgeBandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildAgeBandRequest) ProtoMessage() {}
+
+func (x *ChildAgeBandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildAgeBandRequest.ProtoReflect.Descriptor instead.
+func (*ChildAgeBandRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChildAgeBandRequest) GetMinAge() uint32 {
+	if x != nil {
+		return x.MinAge
+	}
+	return 0
+}
+
+func (x *ChildAgeBandRequest) GetMaxAge() uint32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *ChildAgeBandRequest) GetPricePercent() uint32 {
+	if x != nil {
+		return x.PricePercent
+	}
+	return 0
+}
+
+type PetPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	FeePerNight   *string                `protobuf:"bytes,2,opt,name=fee_per_night,json=feePerNight,proto3,oneof" json:"fee_per_night,omitempty"`
+	MaxPets       uint32                 `protobuf:"varint,3,opt,name=max_pets,json=maxPets,proto3" json:"max_pets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PetPolicyRequest) Reset() {
+	*x = PetPolicyRequest{}
+	mi := &file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetPolicyRequest) ProtoMessage() {}
+
+func (x *PetPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetPolicyRequest.ProtoReflect.Descriptor instead.
+func (*PetPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PetPolicyRequest) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *PetPolicyRequest) GetFeePerNight() string {
+	if x != nil && x.FeePerNight != nil {
+		return *x.FeePerNight
+	}
+	return ""
+}
+
+func (x *PetPolicyRequest) GetMaxPets() uint32 {
+	if x != nil {
+		return x.MaxPets
+	}
+	return 0
+}
+
+type SetHotelPolicyRequest struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	CountryCode       string                     `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CitySlug          string                     `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
+	HotelSlug         string                     `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	CancellationTiers []*CancellationTierRequest `protobuf:"bytes,4,rep,name=cancellation_tiers,json=cancellationTiers,proto3" json:"cancellation_tiers,omitempty"`
+	PrepaymentPercent uint32                     `protobuf:"varint,5,opt,name=prepayment_percent,json=prepaymentPercent,proto3" json:"prepayment_percent,omitempty"`
+	ChildAgeBands     []*ChildAgeBandRequest     `protobuf:"bytes,6,rep,name=child_age_bands,json=childAgeBands,proto3" json:"child_age_bands,omitempty"`
+	Pets              *PetPolicyRequest          `protobuf:"bytes,7,opt,name=pets,proto3" json:"pets,omitempty"`
+	LatestCheckInTime *string                    `protobuf:"bytes,8,opt,name=latest_check_in_time,json=latestCheckInTime,proto3,oneof" json:"latest_check_in_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetHotelPolicyRequest) Reset() {
+	*x = SetHotelPolicyRequest{}
+	mi := &file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHotelPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHotelPolicyRequest) ProtoMessage() {}
+
+func (x *SetHotelPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHotelPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetHotelPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetHotelPolicyRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *SetHotelPolicyRequest) GetCitySlug() string {
+	if x != nil {
+		return x.CitySlug
+	}
+	return ""
+}
+
+func (x *SetHotelPolicyRequest) GetHotelSlug() string {
+	if x != nil {
+		return x.HotelSlug
+	}
+	return ""
+}
+
+func (x *SetHotelPolicyRequest) GetCancellationTiers() []*CancellationTierRequest {
+	if x != nil {
+		return x.CancellationTiers
+	}
+	return nil
+}
+
+func (x *SetHotelPolicyRequest) GetPrepaymentPercent() uint32 {
+	if x != nil {
+		return x.PrepaymentPercent
+	}
+	return 0
+}
+
+func (x *SetHotelPolicyRequest) GetChildAgeBands() []*ChildAgeBandRequest {
+	if x != nil {
+		return x.ChildAgeBands
+	}
+	return nil
+}
+
+func (x *SetHotelPolicyRequest) GetPets() *PetPolicyRequest {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+func (x *SetHotelPolicyRequest) GetLatestCheckInTime() string {
+	if x != nil && x.LatestCheckInTime != nil {
+		return *x.LatestCheckInTime
+	}
+	return ""
+}
+
+type SetHotelPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *HotelPolicy           `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHotelPolicyResponse) Reset() {
+	*x = SetHotelPolicyResponse{}
+	mi := &file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHotelPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHotelPolicyResponse) ProtoMessage() {}
+
+func (x *SetHotelPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHotelPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetHotelPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetHotelPolicyResponse) GetPolicy() *HotelPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDesc = "" +
+	"\n" +
+	"0hotel/v1/rpc/hotel_policy/set_hotel_policy.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a)hotel/v1/enums/cancellation_penalty.proto\x1a\"hotel/v1/models/hotel_policy.proto\"\xcd\x01\n" +
+	"\x17CancellationTierRequest\x12;\n" +
+	"\x15hours_before_check_in\x18\x01 \x01(\rB\b\xbaH\x05*\x03\x18\xb8DR\x12hoursBeforeCheckIn\x12C\n" +
+	"\apenalty\x18\x02 \x01(\x0e2\x1d.hotel.v1.CancellationPenaltyB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\apenalty\x120\n" +
+	"\x0fpenalty_percent\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18dR\x0epenaltyPercent\"\x87\x01\n" +
+	"\x13ChildAgeBandRequest\x12 \n" +
+	"\amin_age\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18\x11R\x06minAge\x12 \n" +
+	"\amax_age\x18\x02 \x01(\rB\a\xbaH\x04*\x02\x18\x11R\x06maxAge\x12,\n" +
+	"\rprice_percent\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18dR\fpricePercent\"\xab\x01\n" +
+	"\x10PetPolicyRequest\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12G\n" +
+	"\rfee_per_night\x18\x02 \x01(\tB\x1e\xbaH\x1br\x192\x17^[0-9]+(\\.[0-9]{1,2})?$H\x00R\vfeePerNight\x88\x01\x01\x12\"\n" +
+	"\bmax_pets\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18\n" +
+	"R\amaxPetsB\x10\n" +
+	"\x0e_fee_per_night\"\xdf\x04\n" +
+	"\x15SetHotelPolicyRequest\x124\n" +
+	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[a-z]{2}$R\vcountryCode\x12<\n" +
+	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
+	"\n" +
+	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\x12Z\n" +
+	"\x12cancellation_tiers\x18\x04 \x03(\v2!.hotel.v1.CancellationTierRequestB\b\xbaH\x05\x92\x01\x02\x10\n" +
+	"R\x11cancellationTiers\x126\n" +
+	"\x12prepayment_percent\x18\x05 \x01(\rB\a\xbaH\x04*\x02\x18dR\x11prepaymentPercent\x12O\n" +
+	"\x0fchild_age_bands\x18\x06 \x03(\v2\x1d.hotel.v1.ChildAgeBandRequestB\b\xbaH\x05\x92\x01\x02\x10\n" +
+	"R\rchildAgeBands\x126\n" +
+	"\x04pets\x18\a \x01(\v2\x1a.hotel.v1.PetPolicyRequestB\x06\xbaH\x03\xc8\x01\x01R\x04pets\x12\\\n" +
+	"\x14latest_check_in_time\x18\b \x01(\tB&\xbaH#r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$H\x00R\x11latestCheckInTime\x88\x01\x01B\x17\n" +
+	"\x15_latest_check_in_time\"G\n" +
+	"\x16SetHotelPolicyResponse\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.hotel.v1.HotelPolicyR\x06policyB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDesc), len(file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_goTypes = []any{
+	(*CancellationTierRequest)(nil), // 0: hotel.v1.CancellationTierRequest
+	(*ChildAgeBandRequest)(nil),     // 1: hotel.v1.ChildAgeBandRequest
+	(*PetPolicyRequest)(nil),        // 2: hotel.v1.PetPolicyRequest
+	(*SetHotelPolicyRequest)(nil),   // 3: hotel.v1.SetHotelPolicyRequest
+	(*SetHotelPolicyResponse)(nil),  // 4: hotel.v1.SetHotelPolicyResponse
+	(CancellationPenalty)(0),        // 5: hotel.v1.CancellationPenalty
+	(*HotelPolicy)(nil),             // 6: hotel.v1.HotelPolicy
+}
+var file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_depIdxs = []int32{
+	5, // 0: hotel.v1.CancellationTierRequest.penalty:type_name -> hotel.v1.CancellationPenalty
+	0, // 1: hotel.v1.SetHotelPolicyRequest.cancellation_tiers:type_name -> hotel.v1.CancellationTierRequest
+	1, // 2: hotel.v1.SetHotelPolicyRequest.child_age_bands:type_name -> hotel.v1.ChildAgeBandRequest
+	2, // 3: hotel.v1.SetHotelPolicyRequest.pets:type_name -> hotel.v1.PetPolicyRequest
+	6, // 4: hotel.v1.SetHotelPolicyResponse.policy:type_name -> hotel.v1.HotelPolicy
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_init() }
+func file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_init() {
+	if File_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_cancellation_penalty_proto_init()
+	file_hotel_v1_models_hotel_policy_proto_init()
+	file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[2].OneofWrappers = []any{}
+	file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDesc), len(file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto = out.File
+	file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_goTypes = nil
+	file_hotel_v1_rpc_hotel_policy_set_hotel_policy_proto_depIdxs = nil
+}
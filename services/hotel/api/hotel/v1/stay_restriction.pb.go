@@ -0,0 +1,394 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/models/stay_restriction.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StayRules struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MinLengthOfStay   *uint32                `protobuf:"varint,1,opt,name=min_length_of_stay,json=minLengthOfStay,proto3,oneof" json:"min_length_of_stay,omitempty"`
+	MaxLengthOfStay   *uint32                `protobuf:"varint,2,opt,name=max_length_of_stay,json=maxLengthOfStay,proto3,oneof" json:"max_length_of_stay,omitempty"`
+	ClosedToArrival   bool                   `protobuf:"varint,3,opt,name=closed_to_arrival,json=closedToArrival,proto3" json:"closed_to_arrival,omitempty"`
+	ClosedToDeparture bool                   `protobuf:"varint,4,opt,name=closed_to_departure,json=closedToDeparture,proto3" json:"closed_to_departure,omitempty"`
+	MinAdvanceDays    *uint32                `protobuf:"varint,5,opt,name=min_advance_days,json=minAdvanceDays,proto3,oneof" json:"min_advance_days,omitempty"`
+	MaxAdvanceDays    *uint32                `protobuf:"varint,6,opt,name=max_advance_days,json=maxAdvanceDays,proto3,oneof" json:"max_advance_days,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StayRules) Reset() {
+	*x = StayRules{}
+	mi := &file_hotel_v1_models_stay_restriction_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StayRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StayRules) ProtoMessage() {}
+
+func (x *StayRules) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_stay_restriction_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StayRules.ProtoReflect.Descriptor instead.
+func (*StayRules) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_stay_restriction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StayRules) GetMinLengthOfStay() uint32 {
+	if x != nil && x.MinLengthOfStay != nil {
+		return *x.MinLengthOfStay
+	}
+	return 0
+}
+
+func (x *StayRules) GetMaxLengthOfStay() uint32 {
+	if x != nil && x.MaxLengthOfStay != nil {
+		return *x.MaxLengthOfStay
+	}
+	return 0
+}
+
+func (x *StayRules) GetClosedToArrival() bool {
+	if x != nil {
+		return x.ClosedToArrival
+	}
+	return false
+}
+
+func (x *StayRules) GetClosedToDeparture() bool {
+	if x != nil {
+		return x.ClosedToDeparture
+	}
+	return false
+}
+
+func (x *StayRules) GetMinAdvanceDays() uint32 {
+	if x != nil && x.MinAdvanceDays != nil {
+		return *x.MinAdvanceDays
+	}
+	return 0
+}
+
+func (x *StayRules) GetMaxAdvanceDays() uint32 {
+	if x != nil && x.MaxAdvanceDays != nil {
+		return *x.MaxAdvanceDays
+	}
+	return 0
+}
+
+type StayRestriction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomId        *string                `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3,oneof" json:"room_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Rules         *StayRules             `protobuf:"bytes,6,opt,name=rules,proto3" json:"rules,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StayRestriction) Reset() {
+	*x = StayRestriction{}
+	mi := &file_hotel_v1_models_stay_restriction_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StayRestriction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StayRestriction) ProtoMessage() {}
+
+func (x *StayRestriction) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_stay_restriction_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StayRestriction.ProtoReflect.Descriptor instead.
+func (*StayRestriction) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_stay_restriction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StayRestriction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StayRestriction) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *StayRestriction) GetRoomId() string {
+	if x != nil && x.RoomId != nil {
+		return *x.RoomId
+	}
+	return ""
+}
+
+func (x *StayRestriction) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *StayRestriction) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *StayRestriction) GetRules() *StayRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *StayRestriction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StayRestriction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type StayRestrictionViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          StayRestrictionRule    `protobuf:"varint,1,opt,name=rule,proto3,enum=hotel.v1.StayRestrictionRule" json:"rule,omitempty"`
+	RestrictionId string                 `protobuf:"bytes,2,opt,name=restriction_id,json=restrictionId,proto3" json:"restriction_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Limit         *uint32                `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Actual        *uint32                `protobuf:"varint,5,opt,name=actual,proto3,oneof" json:"actual,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StayRestrictionViolation) Reset() {
+	*x = StayRestrictionViolation{}
+	mi := &file_hotel_v1_models_stay_restriction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StayRestrictionViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StayRestrictionViolation) ProtoMessage() {}
+
+func (x *StayRestrictionViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_models_stay_restriction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StayRestrictionViolation.ProtoReflect.Descriptor instead.
+func (*StayRestrictionViolation) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_models_stay_restriction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StayRestrictionViolation) GetRule() StayRestrictionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return StayRestrictionRule_STAY_RESTRICTION_RULE_UNSPECIFIED
+}
+
+func (x *StayRestrictionViolation) GetRestrictionId() string {
+	if x != nil {
+		return x.RestrictionId
+	}
+	return ""
+}
+
+func (x *StayRestrictionViolation) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *StayRestrictionViolation) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *StayRestrictionViolation) GetActual() uint32 {
+	if x != nil && x.Actual != nil {
+		return *x.Actual
+	}
+	return 0
+}
+
+func (x *StayRestrictionViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_hotel_v1_models_stay_restriction_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_models_stay_restriction_proto_rawDesc = "" +
+	"\n" +
+	"&hotel/v1/models/stay_restriction.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a*hotel/v1/enums/stay_restriction_rule.proto\"\x81\x03\n" +
+	"\tStayRules\x120\n" +
+	"\x12min_length_of_stay\x18\x01 \x01(\rH\x00R\x0fminLengthOfStay\x88\x01\x01\x120\n" +
+	"\x12max_length_of_stay\x18\x02 \x01(\rH\x01R\x0fmaxLengthOfStay\x88\x01\x01\x12*\n" +
+	"\x11closed_to_arrival\x18\x03 \x01(\bR\x0fclosedToArrival\x12.\n" +
+	"\x13closed_to_departure\x18\x04 \x01(\bR\x11closedToDeparture\x12-\n" +
+	"\x10min_advance_days\x18\x05 \x01(\rH\x02R\x0eminAdvanceDays\x88\x01\x01\x12-\n" +
+	"\x10max_advance_days\x18\x06 \x01(\rH\x03R\x0emaxAdvanceDays\x88\x01\x01B\x15\n" +
+	"\x13_min_length_of_stayB\x15\n" +
+	"\x13_max_length_of_stayB\x13\n" +
+	"\x11_min_advance_daysB\x13\n" +
+	"\x11_max_advance_days\"\xf9\x02\n" +
+	"\x0fStayRestriction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\tR\ahotelId\x12\x1c\n" +
+	"\aroom_id\x18\x03 \x01(\tH\x00R\x06roomId\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12)\n" +
+	"\x05rules\x18\x06 \x01(\v2\x13.hotel.v1.StayRulesR\x05rules\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\n" +
+	"\n" +
+	"\b_room_id\"\x8b\x02\n" +
+	"\x18StayRestrictionViolation\x121\n" +
+	"\x04rule\x18\x01 \x01(\x0e2\x1d.hotel.v1.StayRestrictionRuleR\x04rule\x12%\n" +
+	"\x0erestriction_id\x18\x02 \x01(\tR\rrestrictionId\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\rH\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06actual\x18\x05 \x01(\rH\x01R\x06actual\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessageB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_actualB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_models_stay_restriction_proto_rawDescOnce sync.Once
+	file_hotel_v1_models_stay_restriction_proto_rawDescData []byte
+)
+
+func file_hotel_v1_models_stay_restriction_proto_rawDescGZIP() []byte {
+	file_hotel_v1_models_stay_restriction_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_models_stay_restriction_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_models_stay_restriction_proto_rawDesc), len(file_hotel_v1_models_stay_restriction_proto_rawDesc)))
+	})
+	return file_hotel_v1_models_stay_restriction_proto_rawDescData
+}
+
+var file_hotel_v1_models_stay_restriction_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_hotel_v1_models_stay_restriction_proto_goTypes = []any{
+	(*StayRules)(nil),                // 0: hotel.v1.StayRules
+	(*StayRestriction)(nil),          // 1: hotel.v1.StayRestriction
+	(*StayRestrictionViolation)(nil), // 2: hotel.v1.StayRestrictionViolation
+	(*timestamppb.Timestamp)(nil),    // 3: google.protobuf.Timestamp
+	(StayRestrictionRule)(0),         // 4: hotel.v1.StayRestrictionRule
+}
+var file_hotel_v1_models_stay_restriction_proto_depIdxs = []int32{
+	3, // 0: hotel.v1.StayRestriction.start_date:type_name -> google.protobuf.Timestamp
+	3, // 1: hotel.v1.StayRestriction.end_date:type_name -> google.protobuf.Timestamp
+	0, // 2: hotel.v1.StayRestriction.rules:type_name -> hotel.v1.StayRules
+	3, // 3: hotel.v1.StayRestriction.created_at:type_name -> google.protobuf.Timestamp
+	3, // 4: hotel.v1.StayRestriction.updated_at:type_name -> google.protobuf.Timestamp
+	4, // 5: hotel.v1.StayRestrictionViolation.rule:type_name -> hotel.v1.StayRestrictionRule
+	3, // 6: hotel.v1.StayRestrictionViolation.date:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_models_stay_restriction_proto_init() }
+func file_hotel_v1_models_stay_restriction_proto_init() {
+	if File_hotel_v1_models_stay_restriction_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_stay_restriction_rule_proto_init()
+	file_hotel_v1_models_stay_restriction_proto_msgTypes[0].OneofWrappers = []any{}
+	file_hotel_v1_models_stay_restriction_proto_msgTypes[1].OneofWrappers = []any{}
+	file_hotel_v1_models_stay_restriction_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_models_stay_restriction_proto_rawDesc), len(file_hotel_v1_models_stay_restriction_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_models_stay_restriction_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_models_stay_restriction_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_models_stay_restriction_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_models_stay_restriction_proto = out.File
+	file_hotel_v1_models_stay_restriction_proto_goTypes = nil
+	file_hotel_v1_models_stay_restriction_proto_depIdxs = nil
+}
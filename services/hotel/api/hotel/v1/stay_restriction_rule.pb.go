@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/enums/stay_restriction_rule.proto
+
+package hotelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StayRestrictionRule int32
+
+const (
+	StayRestrictionRule_STAY_RESTRICTION_RULE_UNSPECIFIED         StayRestrictionRule = 0
+	StayRestrictionRule_STAY_RESTRICTION_RULE_MIN_LENGTH_OF_STAY  StayRestrictionRule = 1
+	StayRestrictionRule_STAY_RESTRICTION_RULE_MAX_LENGTH_OF_STAY  StayRestrictionRule = 2
+	StayRestrictionRule_STAY_RESTRICTION_RULE_CLOSED_TO_ARRIVAL   StayRestrictionRule = 3
+	StayRestrictionRule_STAY_RESTRICTION_RULE_CLOSED_TO_DEPARTURE StayRestrictionRule = 4
+	StayRestrictionRule_STAY_RESTRICTION_RULE_MIN_ADVANCE_BOOKING StayRestrictionRule = 5
+	StayRestrictionRule_STAY_RESTRICTION_RULE_MAX_ADVANCE_BOOKING StayRestrictionRule = 6
+)
+
+// Enum value maps for StayRestrictionRule.
+var (
+	StayRestrictionRule_name = map[int32]string{
+		0: "STAY_RESTRICTION_RULE_UNSPECIFIED",
+		1: "STAY_RESTRICTION_RULE_MIN_LENGTH_OF_STAY",
+		2: "STAY_RESTRICTION_RULE_MAX_LENGTH_OF_STAY",
+		3: "STAY_RESTRICTION_RULE_CLOSED_TO_ARRIVAL",
+		4: "STAY_RESTRICTION_RULE_CLOSED_TO_DEPARTURE",
+		5: "STAY_RESTRICTION_RULE_MIN_ADVANCE_BOOKING",
+		6: "STAY_RESTRICTION_RULE_MAX_ADVANCE_BOOKING",
+	}
+	StayRestrictionRule_value = map[string]int32{
+		"STAY_RESTRICTION_RULE_UNSPECIFIED":         0,
+		"STAY_RESTRICTION_RULE_MIN_LENGTH_OF_STAY":  1,
+		"STAY_RESTRICTION_RULE_MAX_LENGTH_OF_STAY":  2,
+		"STAY_RESTRICTION_RULE_CLOSED_TO_ARRIVAL":   3,
+		"STAY_RESTRICTION_RULE_CLOSED_TO_DEPARTURE": 4,
+		"STAY_RESTRICTION_RULE_MIN_ADVANCE_BOOKING": 5,
+		"STAY_RESTRICTION_RULE_MAX_ADVANCE_BOOKING": 6,
+	}
+)
+
+func (x StayRestrictionRule) Enum() *StayRestrictionRule {
+	p := new(StayRestrictionRule)
+	*p = x
+	return p
+}
+
+func (x StayRestrictionRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StayRestrictionRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_hotel_v1_enums_stay_restriction_rule_proto_enumTypes[0].Descriptor()
+}
+
+func (StayRestrictionRule) Type() protoreflect.EnumType {
+	return &file_hotel_v1_enums_stay_restriction_rule_proto_enumTypes[0]
+}
+
+func (x StayRestrictionRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StayRestrictionRule.Descriptor instead.
+func (StayRestrictionRule) EnumDescriptor() ([]byte, []int) {
+	return file_hotel_v1_enums_stay_restriction_rule_proto_rawDescGZIP(), []int{0}
+}
+
+var File_hotel_v1_enums_stay_restriction_rule_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_enums_stay_restriction_rule_proto_rawDesc = "" +
+	"\n" +
+	"*hotel/v1/enums/stay_restriction_rule.proto\x12\bhotel.v1*\xd2\x02\n" +
+	"\x13StayRestrictionRule\x12%\n" +
+	"!STAY_RESTRICTION_RULE_UNSPECIFIED\x10\x00\x12,\n" +
+	"(STAY_RESTRICTION_RULE_MIN_LENGTH_OF_STAY\x10\x01\x12,\n" +
+	"(STAY_RESTRICTION_RULE_MAX_LENGTH_OF_STAY\x10\x02\x12+\n" +
+	"'STAY_RESTRICTION_RULE_CLOSED_TO_ARRIVAL\x10\x03\x12-\n" +
+	")STAY_RESTRICTION_RULE_CLOSED_TO_DEPARTURE\x10\x04\x12-\n" +
+	")STAY_RESTRICTION_RULE_MIN_ADVANCE_BOOKING\x10\x05\x12-\n" +
+	")STAY_RESTRICTION_RULE_MAX_ADVANCE_BOOKING\x10\x06B\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_enums_stay_restriction_rule_proto_rawDescOnce sync.Once
+	file_hotel_v1_enums_stay_restriction_rule_proto_rawDescData []byte
+)
+
+func file_hotel_v1_enums_stay_restriction_rule_proto_rawDescGZIP() []byte {
+	file_hotel_v1_enums_stay_restriction_rule_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_enums_stay_restriction_rule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_enums_stay_restriction_rule_proto_rawDesc), len(file_hotel_v1_enums_stay_restriction_rule_proto_rawDesc)))
+	})
+	return file_hotel_v1_enums_stay_restriction_rule_proto_rawDescData
+}
+
+var file_hotel_v1_enums_stay_restriction_rule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_hotel_v1_enums_stay_restriction_rule_proto_goTypes = []any{
+	(StayRestrictionRule)(0), // 0: hotel.v1.StayRestrictionRule
+}
+var file_hotel_v1_enums_stay_restriction_rule_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_enums_stay_restriction_rule_proto_init() }
+func file_hotel_v1_enums_stay_restriction_rule_proto_init() {
+	if File_hotel_v1_enums_stay_restriction_rule_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_enums_stay_restriction_rule_proto_rawDesc), len(file_hotel_v1_enums_stay_restriction_rule_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_enums_stay_restriction_rule_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_enums_stay_restriction_rule_proto_depIdxs,
+		EnumInfos:         file_hotel_v1_enums_stay_restriction_rule_proto_enumTypes,
+	}.Build()
+	File_hotel_v1_enums_stay_restriction_rule_proto = out.File
+	file_hotel_v1_enums_stay_restriction_rule_proto_goTypes = nil
+	file_hotel_v1_enums_stay_restriction_rule_proto_depIdxs = nil
+}
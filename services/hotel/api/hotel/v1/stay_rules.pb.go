@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/stay_restriction/stay_rules.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StayRulesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MinLengthOfStay   *uint32                `protobuf:"varint,1,opt,name=min_length_of_stay,json=minLengthOfStay,proto3,oneof" json:"min_length_of_stay,omitempty"`
+	MaxLengthOfStay   *uint32                `protobuf:"varint,2,opt,name=max_length_of_stay,json=maxLengthOfStay,proto3,oneof" json:"max_length_of_stay,omitempty"`
+	ClosedToArrival   bool                   `protobuf:"varint,3,opt,name=closed_to_arrival,json=closedToArrival,proto3" json:"closed_to_arrival,omitempty"`
+	ClosedToDeparture bool                   `protobuf:"varint,4,opt,name=closed_to_departure,json=closedToDeparture,proto3" json:"closed_to_departure,omitempty"`
+	MinAdvanceDays    *uint32                `protobuf:"varint,5,opt,name=min_advance_days,json=minAdvanceDays,proto3,oneof" json:"min_advance_days,omitempty"`
+	MaxAdvanceDays    *uint32                `protobuf:"varint,6,opt,name=max_advance_days,json=maxAdvanceDays,proto3,oneof" json:"max_advance_days,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StayRulesRequest) Reset() {
+	*x = StayRulesRequest{}
+	mi := &file_hotel_v1_rpc_stay_restriction_stay_rules_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StayRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StayRulesRequest) ProtoMessage() {}
+
+func (x *StayRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_stay_restriction_stay_rules_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StayRulesRequest.ProtoReflect.Descriptor instead.
+func (*StayRulesRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StayRulesRequest) GetMinLengthOfStay() uint32 {
+	if x != nil && x.MinLengthOfStay != nil {
+		return *x.MinLengthOfStay
+	}
+	return 0
+}
+
+func (x *StayRulesRequest) GetMaxLengthOfStay() uint32 {
+	if x != nil && x.MaxLengthOfStay != nil {
+		return *x.MaxLengthOfStay
+	}
+	return 0
+}
+
+func (x *StayRulesRequest) GetClosedToArrival() bool {
+	if x != nil {
+		return x.ClosedToArrival
+	}
+	return false
+}
+
+func (x *StayRulesRequest) GetClosedToDeparture() bool {
+	if x != nil {
+		return x.ClosedToDeparture
+	}
+	return false
+}
+
+func (x *StayRulesRequest) GetMinAdvanceDays() uint32 {
+	if x != nil && x.MinAdvanceDays != nil {
+		return *x.MinAdvanceDays
+	}
+	return 0
+}
+
+func (x *StayRulesRequest) GetMaxAdvanceDays() uint32 {
+	if x != nil && x.MaxAdvanceDays != nil {
+		return *x.MaxAdvanceDays
+	}
+	return 0
+}
+
+var File_hotel_v1_rpc_stay_restriction_stay_rules_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDesc = "" +
+	"\n" +
+	".hotel/v1/rpc/stay_restriction/stay_rules.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"\xc4\b\n" +
+	"\x10StayRulesRequest\x12<\n" +
+	"\x12min_length_of_stay\x18\x01 \x01(\rB\n" +
+	"\xbaH\a*\x05\x18\xed\x02(\x01H\x00R\x0fminLengthOfStay\x88\x01\x01\x12<\n" +
+	"\x12max_length_of_stay\x18\x02 \x01(\rB\n" +
+	"\xbaH\a*\x05\x18\xed\x02(\x01H\x01R\x0fmaxLengthOfStay\x88\x01\x01\x12*\n" +
+	"\x11closed_to_arrival\x18\x03 \x01(\bR\x0fclosedToArrival\x12.\n" +
+	"\x13closed_to_departure\x18\x04 \x01(\bR\x11closedToDeparture\x127\n" +
+	"\x10min_advance_days\x18\x05 \x01(\rB\b\xbaH\x05*\x03\x18\xda\x05H\x02R\x0eminAdvanceDays\x88\x01\x01\x127\n" +
+	"\x10max_advance_days\x18\x06 \x01(\rB\b\xbaH\x05*\x03\x18\xda\x05H\x03R\x0emaxAdvanceDays\x88\x01\x01:\x8d\x05\xbaH\x89\x05\x1a\xe6\x01\n" +
+	"\x14stay_rules.not_empty\x12\x1dat least one rule must be set\x1a\xae\x01has(this.min_length_of_stay) || has(this.max_length_of_stay) || this.closed_to_arrival || this.closed_to_departure || has(this.min_advance_days) || has(this.max_advance_days)\x1a\xd4\x01\n" +
+	"\x1fstay_rules.length_of_stay.order\x12;max_length_of_stay must not be less than min_length_of_stay\x1at!has(this.min_length_of_stay) || !has(this.max_length_of_stay) || this.max_length_of_stay >= this.min_length_of_stay\x1a\xc6\x01\n" +
+	"\x1dstay_rules.advance_days.order\x127max_advance_days must not be less than min_advance_days\x1al!has(this.min_advance_days) || !has(this.max_advance_days) || this.max_advance_days >= this.min_advance_daysB\x15\n" +
+	"\x13_min_length_of_stayB\x15\n" +
+	"\x13_max_length_of_stayB\x13\n" +
+	"\x11_min_advance_daysB\x13\n" +
+	"\x11_max_advance_daysB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDesc), len(file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_stay_restriction_stay_rules_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hotel_v1_rpc_stay_restriction_stay_rules_proto_goTypes = []any{
+	(*StayRulesRequest)(nil), // 0: hotel.v1.StayRulesRequest
+}
+var file_hotel_v1_rpc_stay_restriction_stay_rules_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_stay_restriction_stay_rules_proto_init() }
+func file_hotel_v1_rpc_stay_restriction_stay_rules_proto_init() {
+	if File_hotel_v1_rpc_stay_restriction_stay_rules_proto != nil {
+		return
+	}
+	file_hotel_v1_rpc_stay_restriction_stay_rules_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDesc), len(file_hotel_v1_rpc_stay_restriction_stay_rules_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_stay_restriction_stay_rules_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_stay_restriction_stay_rules_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_stay_restriction_stay_rules_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_stay_restriction_stay_rules_proto = out.File
+	file_hotel_v1_rpc_stay_restriction_stay_rules_proto_goTypes = nil
+	file_hotel_v1_rpc_stay_restriction_stay_rules_proto_depIdxs = nil
+}
@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/amenity/update_amenity.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateAmenityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Category      AmenityCategory        `protobuf:"varint,2,opt,name=category,proto3,enum=hotel.v1.AmenityCategory" json:"category,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Names         *AmenityNames          `protobuf:"bytes,4,opt,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAmenityRequest) Reset() {
+	*x = UpdateAmenityRequest{}
+	mi := &file_hotel_v1_rpc_amenity_update_amenity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAmenityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAmenityRequest) ProtoMessage() {}
+
+func (x *UpdateAmenityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_amenity_update_amenity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAmenityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAmenityRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_amenity_update_amenity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateAmenityRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateAmenityRequest) GetCategory() AmenityCategory {
+	if x != nil {
+		return x.Category
+	}
+	return AmenityCategory_AMENITY_CATEGORY_UNSPECIFIED
+}
+
+func (x *UpdateAmenityRequest) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *UpdateAmenityRequest) GetNames() *AmenityNames {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type UpdateAmenityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amenity       *Amenity               `protobuf:"bytes,1,opt,name=amenity,proto3" json:"amenity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAmenityResponse) Reset() {
+	*x = UpdateAmenityResponse{}
+	mi := &file_hotel_v1_rpc_amenity_update_amenity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAmenityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAmenityResponse) ProtoMessage() {}
+
+func (x *UpdateAmenityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_amenity_update_amenity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAmenityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAmenityResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_amenity_update_amenity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateAmenityResponse) GetAmenity() *Amenity {
+	if x != nil {
+		return x.Amenity
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_amenity_update_amenity_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_amenity_update_amenity_proto_rawDesc = "" +
+	"\n" +
+	")hotel/v1/rpc/amenity/update_amenity.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a%hotel/v1/enums/amenity_category.proto\x1a\x1dhotel/v1/models/amenity.proto\x1a(hotel/v1/rpc/amenity/amenity_names.proto\"\xda\x01\n" +
+	"\x14UpdateAmenityRequest\x12.\n" +
+	"\x04code\x18\x01 \x01(\tB\x1a\xbaH\x17r\x15\x18@2\x11^[a-z][a-z0-9_]*$R\x04code\x12=\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x19.hotel.v1.AmenityCategoryB\x06\xbaH\x03\xc8\x01\x01R\bcategory\x12\x1d\n" +
+	"\x04icon\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04icon\x124\n" +
+	"\x05names\x18\x04 \x01(\v2\x16.hotel.v1.AmenityNamesB\x06\xbaH\x03\xc8\x01\x01R\x05names\"D\n" +
+	"\x15UpdateAmenityResponse\x12+\n" +
+	"\aamenity\x18\x01 \x01(\v2\x11.hotel.v1.AmenityR\aamenityB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_amenity_update_amenity_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_amenity_update_amenity_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_amenity_update_amenity_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_amenity_update_amenity_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_amenity_update_amenity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_amenity_update_amenity_proto_rawDesc), len(file_hotel_v1_rpc_amenity_update_amenity_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_amenity_update_amenity_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_amenity_update_amenity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_amenity_update_amenity_proto_goTypes = []any{
+	(*UpdateAmenityRequest)(nil),  // 0: hotel.v1.UpdateAmenityRequest
+	(*UpdateAmenityResponse)(nil), // 1: hotel.v1.UpdateAmenityResponse
+	(AmenityCategory)(0),          // 2: hotel.v1.AmenityCategory
+	(*AmenityNames)(nil),          // 3: hotel.v1.AmenityNames
+	(*Amenity)(nil),               // 4: hotel.v1.Amenity
+}
+var file_hotel_v1_rpc_amenity_update_amenity_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.UpdateAmenityRequest.category:type_name -> hotel.v1.AmenityCategory
+	3, // 1: hotel.v1.UpdateAmenityRequest.names:type_name -> hotel.v1.AmenityNames
+	4, // 2: hotel.v1.UpdateAmenityResponse.amenity:type_name -> hotel.v1.Amenity
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_amenity_update_amenity_proto_init() }
+func file_hotel_v1_rpc_amenity_update_amenity_proto_init() {
+	if File_hotel_v1_rpc_amenity_update_amenity_proto != nil {
+		return
+	}
+	file_hotel_v1_enums_amenity_category_proto_init()
+	file_hotel_v1_models_amenity_proto_init()
+	file_hotel_v1_rpc_amenity_amenity_names_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_amenity_update_amenity_proto_rawDesc), len(file_hotel_v1_rpc_amenity_update_amenity_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_amenity_update_amenity_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_amenity_update_amenity_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_amenity_update_amenity_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_amenity_update_amenity_proto = out.File
+	file_hotel_v1_rpc_amenity_update_amenity_proto_goTypes = nil
+	file_hotel_v1_rpc_amenity_update_amenity_proto_depIdxs = nil
+}
@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/hotel/update_hotel_rating.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UpdateHotelRatingRequest is sent by the review service with the average of
+// the hotel's published reviews; no rating clears it.
+type UpdateHotelRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rating        *float32               `protobuf:"fixed32,2,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHotelRatingRequest) Reset() {
+	*x = UpdateHotelRatingRequest{}
+	mi := &file_hotel_v1_rpc_hotel_update_hotel_rating_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHotelRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHotelRatingRequest) ProtoMessage() {}
+
+func (x *UpdateHotelRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_update_hotel_rating_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHotelRatingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHotelRatingRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateHotelRatingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateHotelRatingRequest) GetRating() float32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+type UpdateHotelRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        *float32               `protobuf:"fixed32,1,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHotelRatingResponse) Reset() {
+	*x = UpdateHotelRatingResponse{}
+	mi := &file_hotel_v1_rpc_hotel_update_hotel_rating_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHotelRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHotelRatingResponse) ProtoMessage() {}
+
+func (x *UpdateHotelRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_hotel_update_hotel_rating_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHotelRatingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHotelRatingResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateHotelRatingResponse) GetRating() float32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+var File_hotel_v1_rpc_hotel_update_hotel_rating_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDesc = "" +
+	"\n" +
+	",hotel/v1/rpc/hotel/update_hotel_rating.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"m\n" +
+	"\x18UpdateHotelRatingRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12,\n" +
+	"\x06rating\x18\x02 \x01(\x02B\x0f\xbaH\f\n" +
+	"\n" +
+	"\x1d\x00\x00\xa0@-\x00\x00\x00\x00H\x00R\x06rating\x88\x01\x01B\t\n" +
+	"\a_rating\"C\n" +
+	"\x19UpdateHotelRatingResponse\x12\x1b\n" +
+	"\x06rating\x18\x01 \x01(\x02H\x00R\x06rating\x88\x01\x01B\t\n" +
+	"\a_ratingB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDesc), len(file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_hotel_update_hotel_rating_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_hotel_update_hotel_rating_proto_goTypes = []any{
+	(*UpdateHotelRatingRequest)(nil),  // 0: hotel.v1.UpdateHotelRatingRequest
+	(*UpdateHotelRatingResponse)(nil), // 1: hotel.v1.UpdateHotelRatingResponse
+}
+var file_hotel_v1_rpc_hotel_update_hotel_rating_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_hotel_update_hotel_rating_proto_init() }
+func file_hotel_v1_rpc_hotel_update_hotel_rating_proto_init() {
+	if File_hotel_v1_rpc_hotel_update_hotel_rating_proto != nil {
+		return
+	}
+	file_hotel_v1_rpc_hotel_update_hotel_rating_proto_msgTypes[0].OneofWrappers = []any{}
+	file_hotel_v1_rpc_hotel_update_hotel_rating_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDesc), len(file_hotel_v1_rpc_hotel_update_hotel_rating_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_hotel_update_hotel_rating_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_hotel_update_hotel_rating_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_hotel_update_hotel_rating_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_hotel_update_hotel_rating_proto = out.File
+	file_hotel_v1_rpc_hotel_update_hotel_rating_proto_goTypes = nil
+	file_hotel_v1_rpc_hotel_update_hotel_rating_proto_depIdxs = nil
+}
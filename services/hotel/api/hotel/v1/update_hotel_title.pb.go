@@ -23,13 +23,14 @@ const (
 )
 
 type UpdateHotelTitleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CountryCode   string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
-	CitySlug      string                 `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
-	HotelSlug     string                 `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CountryCode     string                 `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	CitySlug        string                 `protobuf:"bytes,2,opt,name=city_slug,json=citySlug,proto3" json:"city_slug,omitempty"`
+	HotelSlug       string                 `protobuf:"bytes,3,opt,name=hotel_slug,json=hotelSlug,proto3" json:"hotel_slug,omitempty"`
+	Title           string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateHotelTitleRequest) Reset() {
@@ -90,6 +91,13 @@ func (x *UpdateHotelTitleRequest) GetTitle() string {
 	return ""
 }
 
+func (x *UpdateHotelTitleRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateHotelTitleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hotel         *UpdateHotelTitle      `protobuf:"bytes,1,opt,name=hotel,proto3" json:"hotel,omitempty"`
@@ -138,14 +146,16 @@ var File_hotel_v1_rpc_hotel_update_hotel_title_proto protoreflect.FileDescriptor
 
 const file_hotel_v1_rpc_hotel_update_hotel_title_proto_rawDesc = "" +
 	"\n" +
-	"+hotel/v1/rpc/hotel/update_hotel_title.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bhotel/v1/models/hotel.proto\"\xeb\x01\n" +
+	"+hotel/v1/rpc/hotel/update_hotel_title.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bhotel/v1/models/hotel.proto\"\xb9\x02\n" +
 	"\x17UpdateHotelTitleRequest\x124\n" +
 	"\fcountry_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
 	"^[a-z]{2}$R\vcountryCode\x12<\n" +
 	"\tcity_slug\x18\x02 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\bcitySlug\x12>\n" +
 	"\n" +
 	"hotel_slug\x18\x03 \x01(\tB\x1f\xbaH\x1cr\x1a2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\thotelSlug\x12\x1c\n" +
-	"\x05title\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05title\"L\n" +
+	"\x05title\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05title\x127\n" +
+	"\x10expected_version\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"L\n" +
 	"\x18UpdateHotelTitleResponse\x120\n" +
 	"\x05hotel\x18\x01 \x01(\v2\x1a.hotel.v1.UpdateHotelTitleR\x05hotelB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
 
@@ -182,6 +192,7 @@ func file_hotel_v1_rpc_hotel_update_hotel_title_proto_init() {
 		return
 	}
 	file_hotel_v1_models_hotel_proto_init()
+	file_hotel_v1_rpc_hotel_update_hotel_title_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
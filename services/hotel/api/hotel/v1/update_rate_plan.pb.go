@@ -0,0 +1,227 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/rate_plan/update_rate_plan.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateRatePlanRequest struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	Id               string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title            string                    `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartDate        *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate          *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Price            string                    `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	WeekdayModifiers []*WeekdayModifierRequest `protobuf:"bytes,6,rep,name=weekday_modifiers,json=weekdayModifiers,proto3" json:"weekday_modifiers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateRatePlanRequest) Reset() {
+	*x = UpdateRatePlanRequest{}
+	mi := &file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRatePlanRequest) ProtoMessage() {}
+
+func (x *UpdateRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateRatePlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRatePlanRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateRatePlanRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *UpdateRatePlanRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *UpdateRatePlanRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *UpdateRatePlanRequest) GetWeekdayModifiers() []*WeekdayModifierRequest {
+	if x != nil {
+		return x.WeekdayModifiers
+	}
+	return nil
+}
+
+type UpdateRatePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatePlan      *RatePlan              `protobuf:"bytes,1,opt,name=rate_plan,json=ratePlan,proto3" json:"rate_plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRatePlanResponse) Reset() {
+	*x = UpdateRatePlanResponse{}
+	mi := &file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRatePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRatePlanResponse) ProtoMessage() {}
+
+func (x *UpdateRatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRatePlanResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateRatePlanResponse) GetRatePlan() *RatePlan {
+	if x != nil {
+		return x.RatePlan
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_rate_plan_update_rate_plan_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDesc = "" +
+	"\n" +
+	"-hotel/v1/rpc/rate_plan/update_rate_plan.proto\x12\bhotel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fhotel/v1/models/rate_plan.proto\x1a-hotel/v1/rpc/rate_plan/weekday_modifier.proto\"\xc5\x03\n" +
+	"\x15UpdateRatePlanRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1f\n" +
+	"\x05title\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x05title\x12A\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tstartDate\x12=\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\aendDate\x124\n" +
+	"\x05price\x18\x05 \x01(\tB\x1e\xbaH\x1br\x192\x17^[0-9]+(\\.[0-9]{1,2})?$R\x05price\x12W\n" +
+	"\x11weekday_modifiers\x18\x06 \x03(\v2 .hotel.v1.WeekdayModifierRequestB\b\xbaH\x05\x92\x01\x02\x10\aR\x10weekdayModifiers:`\xbaH]\x1a[\n" +
+	"\x15rate_plan.dates.order\x12!end_date must be after start_date\x1a\x1fthis.end_date > this.start_date\"I\n" +
+	"\x16UpdateRatePlanResponse\x12/\n" +
+	"\trate_plan\x18\x01 \x01(\v2\x12.hotel.v1.RatePlanR\bratePlanB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDesc), len(file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_goTypes = []any{
+	(*UpdateRatePlanRequest)(nil),  // 0: hotel.v1.UpdateRatePlanRequest
+	(*UpdateRatePlanResponse)(nil), // 1: hotel.v1.UpdateRatePlanResponse
+	(*timestamppb.Timestamp)(nil),  // 2: google.protobuf.Timestamp
+	(*WeekdayModifierRequest)(nil), // 3: hotel.v1.WeekdayModifierRequest
+	(*RatePlan)(nil),               // 4: hotel.v1.RatePlan
+}
+var file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.UpdateRatePlanRequest.start_date:type_name -> google.protobuf.Timestamp
+	2, // 1: hotel.v1.UpdateRatePlanRequest.end_date:type_name -> google.protobuf.Timestamp
+	3, // 2: hotel.v1.UpdateRatePlanRequest.weekday_modifiers:type_name -> hotel.v1.WeekdayModifierRequest
+	4, // 3: hotel.v1.UpdateRatePlanResponse.rate_plan:type_name -> hotel.v1.RatePlan
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_init() }
+func file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_init() {
+	if File_hotel_v1_rpc_rate_plan_update_rate_plan_proto != nil {
+		return
+	}
+	file_hotel_v1_models_rate_plan_proto_init()
+	file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDesc), len(file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_rate_plan_update_rate_plan_proto = out.File
+	file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_goTypes = nil
+	file_hotel_v1_rpc_rate_plan_update_rate_plan_proto_depIdxs = nil
+}
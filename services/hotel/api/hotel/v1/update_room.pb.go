@@ -23,20 +23,21 @@ const (
 )
 
 type UpdateRoomRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	RoomNumber    string                 `protobuf:"bytes,4,opt,name=room_number,json=roomNumber,proto3" json:"room_number,omitempty"`
-	Type          RoomType               `protobuf:"varint,5,opt,name=type,proto3,enum=hotel.v1.RoomType" json:"type,omitempty"`
-	Price         string                 `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
-	Capacity      int64                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	AreaSqm       float32                `protobuf:"fixed32,8,opt,name=area_sqm,json=areaSqm,proto3" json:"area_sqm,omitempty"`
-	Floor         int64                  `protobuf:"varint,9,opt,name=floor,proto3" json:"floor,omitempty"`
-	Amenities     []string               `protobuf:"bytes,10,rep,name=amenities,proto3" json:"amenities,omitempty"`
-	Images        []string               `protobuf:"bytes,11,rep,name=images,proto3" json:"images,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RoomNumber      string                 `protobuf:"bytes,4,opt,name=room_number,json=roomNumber,proto3" json:"room_number,omitempty"`
+	Type            RoomType               `protobuf:"varint,5,opt,name=type,proto3,enum=hotel.v1.RoomType" json:"type,omitempty"`
+	Price           string                 `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	Capacity        int64                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	AreaSqm         float32                `protobuf:"fixed32,8,opt,name=area_sqm,json=areaSqm,proto3" json:"area_sqm,omitempty"`
+	Floor           int64                  `protobuf:"varint,9,opt,name=floor,proto3" json:"floor,omitempty"`
+	Amenities       []string               `protobuf:"bytes,10,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	Images          []string               `protobuf:"bytes,11,rep,name=images,proto3" json:"images,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,12,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateRoomRequest) Reset() {
@@ -146,6 +147,13 @@ func (x *UpdateRoomRequest) GetImages() []string {
 	return nil
 }
 
+func (x *UpdateRoomRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateRoomResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Room          *UpdateRoom            `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
@@ -194,7 +202,7 @@ var File_hotel_v1_rpc_room_update_room_proto protoreflect.FileDescriptor
 
 const file_hotel_v1_rpc_room_update_room_proto_rawDesc = "" +
 	"\n" +
-	"#hotel/v1/rpc/room/update_room.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ahotel/v1/models/room.proto\x1a\x1ehotel/v1/enums/room_type.proto\"\x97\x03\n" +
+	"#hotel/v1/rpc/room/update_room.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ahotel/v1/models/room.proto\x1a\x1ehotel/v1/enums/room_type.proto\"\x85\x04\n" +
 	"\x11UpdateRoomRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1c\n" +
 	"\x05title\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05title\x12(\n" +
@@ -205,10 +213,12 @@ const file_hotel_v1_rpc_room_update_room_proto_rawDesc = "" +
 	"\x05price\x18\x06 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05price\x12\"\n" +
 	"\bcapacity\x18\a \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\bcapacity\x12!\n" +
 	"\barea_sqm\x18\b \x01(\x02B\x06\xbaH\x03\xc8\x01\x01R\aareaSqm\x12\x1c\n" +
-	"\x05floor\x18\t \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05floor\x12$\n" +
+	"\x05floor\x18\t \x01(\x03B\x06\xbaH\x03\xc8\x01\x01R\x05floor\x12D\n" +
 	"\tamenities\x18\n" +
-	" \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\tamenities\x12\x1e\n" +
-	"\x06images\x18\v \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x06images\">\n" +
+	" \x03(\tB&\xbaH#\xc8\x01\x01\x92\x01\x1d\x102\x18\x01\"\x17r\x15\x18@2\x11^[a-z][a-z0-9_]*$R\tamenities\x12\x1e\n" +
+	"\x06images\x18\v \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x06images\x127\n" +
+	"\x10expected_version\x18\f \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\">\n" +
 	"\x12UpdateRoomResponse\x12(\n" +
 	"\x04room\x18\x01 \x01(\v2\x14.hotel.v1.UpdateRoomR\x04roomB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
 
@@ -248,6 +258,7 @@ func file_hotel_v1_rpc_room_update_room_proto_init() {
 	}
 	file_hotel_v1_models_room_proto_init()
 	file_hotel_v1_enums_room_type_proto_init()
+	file_hotel_v1_rpc_room_update_room_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
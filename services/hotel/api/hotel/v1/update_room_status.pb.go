@@ -23,11 +23,12 @@ const (
 )
 
 type UpdateRoomStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        RoomStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=hotel.v1.RoomStatus" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status          RoomStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=hotel.v1.RoomStatus" json:"status,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateRoomStatusRequest) Reset() {
@@ -74,9 +75,17 @@ func (x *UpdateRoomStatusRequest) GetStatus() RoomStatus {
 	return RoomStatus_ROOM_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateRoomStatusRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateRoomStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        RoomStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=hotel.v1.RoomStatus" json:"status,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -118,16 +127,26 @@ func (x *UpdateRoomStatusResponse) GetStatus() RoomStatus {
 	return RoomStatus_ROOM_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateRoomStatusResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 var File_hotel_v1_rpc_room_update_room_status_proto protoreflect.FileDescriptor
 
 const file_hotel_v1_rpc_room_update_room_status_proto_rawDesc = "" +
 	"\n" +
-	"*hotel/v1/rpc/room/update_room_status.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ahotel/v1/models/room.proto\x1a hotel/v1/enums/room_status.proto\"i\n" +
+	"*hotel/v1/rpc/room/update_room_status.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1ahotel/v1/models/room.proto\x1a hotel/v1/enums/room_status.proto\"\xb7\x01\n" +
 	"\x17UpdateRoomStatusRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x124\n" +
-	"\x06status\x18\x05 \x01(\x0e2\x14.hotel.v1.RoomStatusB\x06\xbaH\x03\xc8\x01\x01R\x06status\"H\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x14.hotel.v1.RoomStatusB\x06\xbaH\x03\xc8\x01\x01R\x06status\x127\n" +
+	"\x10expected_version\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02(\x01H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"b\n" +
 	"\x18UpdateRoomStatusResponse\x12,\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x14.hotel.v1.RoomStatusR\x06statusB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+	"\x06status\x18\x01 \x01(\x0e2\x14.hotel.v1.RoomStatusR\x06status\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversionB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
 
 var (
 	file_hotel_v1_rpc_room_update_room_status_proto_rawDescOnce sync.Once
@@ -164,6 +183,7 @@ func file_hotel_v1_rpc_room_update_room_status_proto_init() {
 	}
 	file_hotel_v1_models_room_proto_init()
 	file_hotel_v1_enums_room_status_proto_init()
+	file_hotel_v1_rpc_room_update_room_status_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
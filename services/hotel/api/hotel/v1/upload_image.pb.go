@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/image/upload_image.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UploadImageRequest is streamed by the client: the first message carries the
+// target, every following message carries the next chunk of the file.
+type UploadImageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadImageRequest_Target
+	//	*UploadImageRequest_Chunk
+	Payload       isUploadImageRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageRequest) Reset() {
+	*x = UploadImageRequest{}
+	mi := &file_hotel_v1_rpc_image_upload_image_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageRequest) ProtoMessage() {}
+
+func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_image_upload_image_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_image_upload_image_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UploadImageRequest) GetPayload() isUploadImageRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadImageRequest) GetTarget() *ImageTarget {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadImageRequest_Target); ok {
+			return x.Target
+		}
+	}
+	return nil
+}
+
+func (x *UploadImageRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadImageRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadImageRequest_Payload interface {
+	isUploadImageRequest_Payload()
+}
+
+type UploadImageRequest_Target struct {
+	Target *ImageTarget `protobuf:"bytes,1,opt,name=target,proto3,oneof"`
+}
+
+type UploadImageRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadImageRequest_Target) isUploadImageRequest_Payload() {}
+
+func (*UploadImageRequest_Chunk) isUploadImageRequest_Payload() {}
+
+type UploadImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *Image                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageResponse) Reset() {
+	*x = UploadImageResponse{}
+	mi := &file_hotel_v1_rpc_image_upload_image_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageResponse) ProtoMessage() {}
+
+func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_image_upload_image_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadImageResponse) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_image_upload_image_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UploadImageResponse) GetImage() *Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+var File_hotel_v1_rpc_image_upload_image_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_image_upload_image_proto_rawDesc = "" +
+	"\n" +
+	"%hotel/v1/rpc/image/upload_image.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bhotel/v1/models/image.proto\x1a%hotel/v1/rpc/image/image_target.proto\"|\n" +
+	"\x12UploadImageRequest\x12/\n" +
+	"\x06target\x18\x01 \x01(\v2\x15.hotel.v1.ImageTargetH\x00R\x06target\x12#\n" +
+	"\x05chunk\x18\x02 \x01(\fB\v\xbaH\bz\x06\x10\x01\x18\x80\x80@H\x00R\x05chunkB\x10\n" +
+	"\apayload\x12\x05\xbaH\x02\b\x01\"<\n" +
+	"\x13UploadImageResponse\x12%\n" +
+	"\x05image\x18\x01 \x01(\v2\x0f.hotel.v1.ImageR\x05imageB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_image_upload_image_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_image_upload_image_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_image_upload_image_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_image_upload_image_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_image_upload_image_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_image_upload_image_proto_rawDesc), len(file_hotel_v1_rpc_image_upload_image_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_image_upload_image_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_image_upload_image_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_hotel_v1_rpc_image_upload_image_proto_goTypes = []any{
+	(*UploadImageRequest)(nil),  // 0: hotel.v1.UploadImageRequest
+	(*UploadImageResponse)(nil), // 1: hotel.v1.UploadImageResponse
+	(*ImageTarget)(nil),         // 2: hotel.v1.ImageTarget
+	(*Image)(nil),               // 3: hotel.v1.Image
+}
+var file_hotel_v1_rpc_image_upload_image_proto_depIdxs = []int32{
+	2, // 0: hotel.v1.UploadImageRequest.target:type_name -> hotel.v1.ImageTarget
+	3, // 1: hotel.v1.UploadImageResponse.image:type_name -> hotel.v1.Image
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_image_upload_image_proto_init() }
+func file_hotel_v1_rpc_image_upload_image_proto_init() {
+	if File_hotel_v1_rpc_image_upload_image_proto != nil {
+		return
+	}
+	file_hotel_v1_models_image_proto_init()
+	file_hotel_v1_rpc_image_image_target_proto_init()
+	file_hotel_v1_rpc_image_upload_image_proto_msgTypes[0].OneofWrappers = []any{
+		(*UploadImageRequest_Target)(nil),
+		(*UploadImageRequest_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_image_upload_image_proto_rawDesc), len(file_hotel_v1_rpc_image_upload_image_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_image_upload_image_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_image_upload_image_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_image_upload_image_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_image_upload_image_proto = out.File
+	file_hotel_v1_rpc_image_upload_image_proto_goTypes = nil
+	file_hotel_v1_rpc_image_upload_image_proto_depIdxs = nil
+}
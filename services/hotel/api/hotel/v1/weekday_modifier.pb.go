@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: hotel/v1/rpc/rate_plan/weekday_modifier.proto
+
+package hotelv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WeekdayModifierRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DayOfWeek         uint32                 `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	AdjustmentPercent string                 `protobuf:"bytes,2,opt,name=adjustment_percent,json=adjustmentPercent,proto3" json:"adjustment_percent,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WeekdayModifierRequest) Reset() {
+	*x = WeekdayModifierRequest{}
+	mi := &file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeekdayModifierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeekdayModifierRequest) ProtoMessage() {}
+
+func (x *WeekdayModifierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeekdayModifierRequest.ProtoReflect.Descriptor instead.
+func (*WeekdayModifierRequest) Descriptor() ([]byte, []int) {
+	return file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WeekdayModifierRequest) GetDayOfWeek() uint32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *WeekdayModifierRequest) GetAdjustmentPercent() string {
+	if x != nil {
+		return x.AdjustmentPercent
+	}
+	return ""
+}
+
+var File_hotel_v1_rpc_rate_plan_weekday_modifier_proto protoreflect.FileDescriptor
+
+const file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDesc = "" +
+	"\n" +
+	"-hotel/v1/rpc/rate_plan/weekday_modifier.proto\x12\bhotel.v1\x1a\x1bbuf/validate/validate.proto\"\xb7\x02\n" +
+	"\x16WeekdayModifierRequest\x12)\n" +
+	"\vday_of_week\x18\x01 \x01(\rB\t\xbaH\x06*\x04\x18\a(\x01R\tdayOfWeek\x12\xf1\x01\n" +
+	"\x12adjustment_percent\x18\x02 \x01(\tB\xc1\x01\xbaH\xbd\x01\xba\x01\x98\x01\n" +
+	"&weekday_modifier.adjustment_percent.gt\x12,adjustment_percent must be greater than -100\x1a@!this.matches('^-?[0-9]+([.][0-9]+)?$') || double(this) > -100.0r\x1f2\x1d^-?[0-9]{1,3}(\\.[0-9]{1,2})?$R\x11adjustmentPercentB\x16Z\x14api/hotel/v1;hotelv1b\x06proto3"
+
+var (
+	file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDescOnce sync.Once
+	file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDescData []byte
+)
+
+func file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDescGZIP() []byte {
+	file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDescOnce.Do(func() {
+		file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDesc), len(file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDesc)))
+	})
+	return file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDescData
+}
+
+var file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_goTypes = []any{
+	(*WeekdayModifierRequest)(nil), // 0: hotel.v1.WeekdayModifierRequest
+}
+var file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_init() }
+func file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_init() {
+	if File_hotel_v1_rpc_rate_plan_weekday_modifier_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDesc), len(file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_goTypes,
+		DependencyIndexes: file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_depIdxs,
+		MessageInfos:      file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_msgTypes,
+	}.Build()
+	File_hotel_v1_rpc_rate_plan_weekday_modifier_proto = out.File
+	file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_goTypes = nil
+	file_hotel_v1_rpc_rate_plan_weekday_modifier_proto_depIdxs = nil
+}
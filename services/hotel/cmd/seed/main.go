@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/ilyakaznacheev/cleanenv"
+
+	"hotel/internal/config"
+	"hotel/internal/repository/postgres"
+	"hotel/internal/repository/seed"
+	"hotel/pkg/lib/logger"
+)
+
+// main loads the bundled country and city catalogue into the database. It is idempotent
+// and is meant to run after migrations whenever the dataset changes.
+func main() {
+	if err := cleanenv.ReadConfig(".env", &struct{}{}); err != nil {
+		slog.Warn("failed to load env", "error", err)
+	}
+
+	configPath := os.Getenv("CONFIG_PATH")
+	if configPath == "" {
+		panic("CONFIG_PATH is not set")
+	}
+
+	cfg, err := config.New(configPath)
+	if err != nil {
+		panic("failed to load config: " + err.Error())
+	}
+	slog.SetDefault(logger.New(cfg.Env, cfg.LogLevel))
+
+	countries, cities, err := seed.Geo()
+	if err != nil {
+		panic(err.Error())
+	}
+
+	repo := postgres.New(cfg)
+	if err = repo.UpsertGeo(context.Background(), countries, cities); err != nil {
+		panic("failed to seed geo catalogue: " + err.Error())
+	}
+
+	slog.Info("Geo catalogue seeded", "countries", len(countries), "cities", len(cities))
+}
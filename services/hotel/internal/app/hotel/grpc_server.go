@@ -6,9 +6,11 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"google.golang.org/grpc"
+
+	"hotel/internal/grpc/interceptor"
 )
 
-func newGRPCServer(logger *slog.Logger) *grpc.Server {
+func newGRPCServer(logger *slog.Logger, accessSecret string) *grpc.Server {
 	opts := []logging.Option{
 		logging.WithLogOnEvents(logging.FinishCall),
 		logging.WithFieldsFromContext(
@@ -22,6 +24,7 @@ func newGRPCServer(logger *slog.Logger) *grpc.Server {
 	return grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			logging.UnaryServerInterceptor(interceptorLogger(logger), opts...),
+			interceptor.AuthInterceptor(accessSecret),
 		),
 		grpc.ChainStreamInterceptor(
 			logging.StreamServerInterceptor(interceptorLogger(logger), opts...),
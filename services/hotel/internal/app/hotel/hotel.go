@@ -1,24 +1,37 @@
 package hotel
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"buf.build/go/protovalidate"
+	"github.com/go-chi/chi/v5"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	hotelv1 "hotel/api/hotel/v1"
 	"hotel/internal/config"
+	"hotel/internal/grpc/client"
 	"hotel/internal/grpc/handler"
+	httphandler "hotel/internal/http/handler"
+	"hotel/internal/http/router"
 	"hotel/internal/repository/postgres"
 	"hotel/internal/service"
+	"hotel/internal/storage/local"
+	"outbox"
+	"outbox/publisher"
 )
 
+const shutdownTimeout = 10 * time.Second
+
 type App struct {
 	Config *config.Config
 	Logger *slog.Logger
@@ -28,7 +41,19 @@ func (app *App) MustLoadGRPC() {
 	slog.SetDefault(app.Logger)
 
 	repo := postgres.New(app.Config)
-	svc := service.New(repo)
+
+	bookingClient, err := client.NewBookingClient(app.Config.BookingService)
+	if err != nil {
+		panic(err.Error())
+	}
+	defer func() { _ = bookingClient.Close() }()
+
+	blobStore, err := local.New(app.Config.Storage)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	svc := service.New(repo, bookingClient, blobStore)
 
 	validator, err := protovalidate.New()
 	if err != nil {
@@ -36,18 +61,47 @@ func (app *App) MustLoadGRPC() {
 	}
 	h := handler.New(svc, validator)
 
+	pub, err := publisher.New(app.Config.Outbox)
+	if err != nil {
+		panic(err.Error())
+	}
+	defer func() { _ = pub.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go outbox.NewRelay(repo, pub, app.Config.Outbox).Run(ctx)
+
 	addr := fmt.Sprintf("%s:%d", app.Config.Server.Host, app.Config.Server.Port)
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
 		panic(err.Error())
 	}
 
-	grpcServer := newGRPCServer(app.Logger)
+	grpcServer := newGRPCServer(app.Logger, app.Config.JWT.AccessSecret)
 
 	hotelv1.RegisterHotelServiceServer(grpcServer, h)
 	hotelv1.RegisterRoomServiceServer(grpcServer, h)
+	hotelv1.RegisterRoomCategoryServiceServer(grpcServer, h)
+	hotelv1.RegisterRatePlanServiceServer(grpcServer, h)
+	hotelv1.RegisterStayRestrictionServiceServer(grpcServer, h)
+	hotelv1.RegisterRoomBlockServiceServer(grpcServer, h)
+	hotelv1.RegisterImageServiceServer(grpcServer, h)
+	hotelv1.RegisterAmenityServiceServer(grpcServer, h)
+	hotelv1.RegisterGeoServiceServer(grpcServer, h)
+	hotelv1.RegisterHotelPolicyServiceServer(grpcServer, h)
+	hotelv1.RegisterHotelModerationServiceServer(grpcServer, h)
 	reflection.Register(grpcServer)
 
+	r := chi.NewRouter()
+	router.New(r, httphandler.New(svc))
+	r.Mount(blobStore.BasePath(), blobStore.Handler())
+
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", app.Config.HTTPServer.Host, app.Config.HTTPServer.Port),
+		Handler:           r,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
 	go func() {
 		slog.Info("Starting gRPC server", "address", addr)
 		if err = grpcServer.Serve(lis); err != nil {
@@ -55,14 +109,28 @@ func (app *App) MustLoadGRPC() {
 		}
 	}()
 
-	app.gracefulShutdown(grpcServer)
+	go func() {
+		slog.Info("Starting HTTP server", "address", httpServer.Addr)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Failed to serve HTTP", "error", err)
+		}
+	}()
+
+	app.gracefulShutdown(grpcServer, httpServer)
 }
 
-func (app *App) gracefulShutdown(grpcServer *grpc.Server) {
+func (app *App) gracefulShutdown(grpcServer *grpc.Server, httpServer *http.Server) {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	<-quit
 
+	slog.Info("Shutting down HTTP server")
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(ctx); err != nil {
+		slog.Error("Failed to shut down HTTP server", "error", err)
+	}
+
 	slog.Info("Shutting down gRPC server")
 	grpcServer.GracefulStop()
 	slog.Info("gRPC server stopped")
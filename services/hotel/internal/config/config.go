@@ -2,6 +2,8 @@ package config
 
 import (
 	"github.com/ilyakaznacheev/cleanenv"
+
+	"outbox"
 )
 
 type ServerConfig struct {
@@ -18,11 +20,30 @@ type PostgresConfig struct {
 	Port     int    `yaml:"port"`
 }
 
+type ClientConfig struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+}
+
+type JWTConfig struct {
+	AccessSecret string `yaml:"access_secret" env:"ACCESS_SECRET" env-required:"true"`
+}
+
+type StorageConfig struct {
+	Root    string `yaml:"root"`
+	BaseURL string `yaml:"base_url"`
+}
+
 type Config struct {
-	Postgres PostgresConfig `yaml:"postgres"`
-	Env      string         `yaml:"env"`
-	LogLevel string         `yaml:"log_level"`
-	Server   ServerConfig   `yaml:"server"`
+	Postgres       PostgresConfig `yaml:"postgres"`
+	Env            string         `yaml:"env"`
+	LogLevel       string         `yaml:"log_level"`
+	Server         ServerConfig   `yaml:"server"`
+	HTTPServer     ServerConfig   `yaml:"http_server"`
+	BookingService ClientConfig   `yaml:"booking_service"`
+	Storage        StorageConfig  `yaml:"storage"`
+	JWT            JWTConfig      `yaml:"jwt" env-prefix:"JWT_"`
+	Outbox         outbox.Config  `yaml:"outbox"`
 }
 
 func New(configPath string) (*Config, error) {
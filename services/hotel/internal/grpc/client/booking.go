@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bookingv1 "booking/api/booking/v1"
+	"hotel/internal/config"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+type BookingClient struct {
+	conn         *grpc.ClientConn
+	availability bookingv1.RoomAvailabilityServiceClient
+	bookings     bookingv1.BookingServiceClient
+}
+
+func NewBookingClient(cfg config.ClientConfig) (*BookingClient, error) {
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &BookingClient{
+		conn:         conn,
+		availability: bookingv1.NewRoomAvailabilityServiceClient(conn),
+		bookings:     bookingv1.NewBookingServiceClient(conn),
+	}, nil
+}
+
+func (c *BookingClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *BookingClient) BlockRoom(ctx context.Context, roomID, blockID uuid.UUID, stay models.DateRange) error {
+	_, err := c.availability.BlockRoom(
+		ctx, &bookingv1.BlockRoomRequest{
+			RoomId:  roomID.String(),
+			BlockId: blockID.String(),
+			StayRange: &bookingv1.DateRange{
+				Start: timestamppb.New(stay.Start),
+				End:   timestamppb.New(stay.End),
+			},
+		},
+	)
+	if err != nil {
+		return bookingErrToDomain(err)
+	}
+
+	return nil
+}
+
+func (c *BookingClient) UnblockRoom(ctx context.Context, blockID uuid.UUID) error {
+	_, err := c.availability.UnblockRoom(ctx, &bookingv1.UnblockRoomRequest{BlockId: blockID.String()})
+	if err != nil {
+		return bookingErrToDomain(err)
+	}
+
+	return nil
+}
+
+// GetActiveBookings returns the ids of the pending and confirmed bookings of the
+// target that have not checked out yet.
+func (c *BookingClient) GetActiveBookings(ctx context.Context, target models.BookingTarget) ([]string, error) {
+	req := &bookingv1.GetActiveBookingsRequest{}
+	if target.HotelID != nil {
+		req.Target = &bookingv1.GetActiveBookingsRequest_HotelId{HotelId: target.HotelID.String()}
+	} else {
+		req.Target = &bookingv1.GetActiveBookingsRequest_RoomId{RoomId: target.RoomID.String()}
+	}
+
+	resp, err := c.bookings.GetActiveBookings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("booking service: %w", err)
+	}
+
+	return resp.BookingIds, nil
+}
+
+// CancelActiveBookings cancels the bookings GetActiveBookings would return and
+// releases their room locks.
+func (c *BookingClient) CancelActiveBookings(ctx context.Context, target models.BookingTarget) ([]string, error) {
+	req := &bookingv1.CancelActiveBookingsRequest{}
+	if target.HotelID != nil {
+		req.Target = &bookingv1.CancelActiveBookingsRequest_HotelId{HotelId: target.HotelID.String()}
+	} else {
+		req.Target = &bookingv1.CancelActiveBookingsRequest_RoomId{RoomId: target.RoomID.String()}
+	}
+
+	resp, err := c.bookings.CancelActiveBookings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("booking service: %w", err)
+	}
+
+	return resp.BookingIds, nil
+}
+
+func bookingErrToDomain(err error) error {
+	switch status.Code(err) {
+	case codes.AlreadyExists:
+		return consts.ErrRoomUnavailable
+	case codes.NotFound:
+		return consts.ErrRoomBlockNotFound
+	default:
+		return fmt.Errorf("booking service: %w", err)
+	}
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/utils/helper"
+	"hotel/internal/grpc/utils/mapper"
+)
+
+func (h *Handler) CreateAmenity(
+	ctx context.Context,
+	req *hotelv1.CreateAmenityRequest,
+) (*hotelv1.CreateAmenityResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	amenity, err := h.svc.CreateAmenity(ctx, mapper.CreateAmenityRequestToDomain(req))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.CreateAmenityResponse{
+		Amenity: mapper.AmenityResponseToProto(amenity),
+	}, nil
+}
+
+func (h *Handler) GetAmenities(
+	ctx context.Context,
+	req *hotelv1.GetAmenitiesRequest,
+) (*hotelv1.GetAmenitiesResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	amenities, err := h.svc.GetAmenities(ctx, mapper.GetAmenitiesRequestToDomain(req))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetAmenitiesResponse{
+		Amenities: mapper.AmenitiesResponseToProto(amenities),
+	}, nil
+}
+
+func (h *Handler) GetAmenity(
+	ctx context.Context,
+	req *hotelv1.GetAmenityRequest,
+) (*hotelv1.GetAmenityResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	amenity, err := h.svc.GetAmenityByCode(ctx, req.Code)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetAmenityResponse{
+		Amenity: mapper.AmenityResponseToProto(amenity),
+	}, nil
+}
+
+func (h *Handler) UpdateAmenity(
+	ctx context.Context,
+	req *hotelv1.UpdateAmenityRequest,
+) (*hotelv1.UpdateAmenityResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	amenity, err := h.svc.UpdateAmenityByCode(ctx, req.Code, mapper.UpdateAmenityRequestToDomain(req))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.UpdateAmenityResponse{
+		Amenity: mapper.AmenityResponseToProto(amenity),
+	}, nil
+}
+
+func (h *Handler) DeleteAmenity(
+	ctx context.Context,
+	req *hotelv1.DeleteAmenityRequest,
+) (*hotelv1.DeleteAmenityResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	if err := h.svc.DeleteAmenityByCode(ctx, req.Code); err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.DeleteAmenityResponse{
+		Message: "success",
+	}, nil
+}
+
+func (h *Handler) SetHotelAmenities(
+	ctx context.Context,
+	req *hotelv1.SetHotelAmenitiesRequest,
+) (*hotelv1.SetHotelAmenitiesResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelRef := mapper.GetHotelRefRequestToDomain(req)
+	amenities, err := h.svc.SetHotelAmenities(ctx, hotelRef, req.AmenityCodes)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.SetHotelAmenitiesResponse{
+		Amenities: mapper.AmenitiesResponseToProto(amenities),
+	}, nil
+}
+
+func (h *Handler) GetHotelAmenities(
+	ctx context.Context,
+	req *hotelv1.GetHotelAmenitiesRequest,
+) (*hotelv1.GetHotelAmenitiesResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelRef := mapper.GetHotelRefRequestToDomain(req)
+	amenities, err := h.svc.GetHotelAmenities(ctx, hotelRef)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetHotelAmenitiesResponse{
+		Amenities: mapper.AmenitiesResponseToProto(amenities),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/utils/helper"
+	"hotel/internal/grpc/utils/mapper"
+	"hotel/internal/repository/models"
+)
+
+func (h *Handler) ListCountries(
+	ctx context.Context,
+	req *hotelv1.ListCountriesRequest,
+) (*hotelv1.ListCountriesResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	countries, err := h.svc.ListCountries(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.ListCountriesResponse{
+		Countries: mapper.CountriesResponseToProto(countries),
+	}, nil
+}
+
+func (h *Handler) ListCities(
+	ctx context.Context,
+	req *hotelv1.ListCitiesRequest,
+) (*hotelv1.ListCitiesResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	filter := models.CityFilter{
+		CountryCode:    req.CountryCode,
+		OnlyWithHotels: req.OnlyWithHotels,
+	}
+	cityList, err := h.svc.ListCities(ctx, filter, req.Page, req.Limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.ListCitiesResponse{
+		Cities:     mapper.CitiesResponseToProto(cityList.Cities),
+		TotalCount: cityList.TotalCount,
+		Page:       req.Page,
+		Limit:      req.Limit,
+	}, nil
+}
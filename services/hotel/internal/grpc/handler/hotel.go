@@ -5,8 +5,10 @@ import (
 	"log/slog"
 
 	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/interceptor"
 	"hotel/internal/grpc/utils/helper"
 	"hotel/internal/grpc/utils/mapper"
+	"hotel/internal/repository/models"
 )
 
 func (h *Handler) CreateHotel(
@@ -62,17 +64,68 @@ func (h *Handler) GetHotel(
 	}
 
 	ref := mapper.GetHotelRefRequestToDomain(req)
-	hotel, err := h.svc.GetHotelBySlug(ctx, ref)
+	hotel, err := h.svc.GetHotelBySlug(ctx, ref, mapper.HotelViewerToDomain(interceptor.GetActor(ctx)))
 	if err != nil {
 		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
 		return nil, helper.HandleDomainErr(err)
 	}
 
 	return &hotelv1.GetHotelResponse{
+		Hotel:          mapper.HotelResponseToProto(hotel),
+		SlugRedirected: hotel.HotelSlug != ref.HotelSlug,
+		CanonicalSlug:  hotel.HotelSlug,
+	}, nil
+}
+
+func (h *Handler) GetHotelByID(
+	ctx context.Context,
+	req *hotelv1.GetHotelByIDRequest,
+) (*hotelv1.GetHotelByIDResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelID, err := helper.ParseHotelID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	hotel, err := h.svc.GetHotelByID(ctx, hotelID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetHotelByIDResponse{
 		Hotel: mapper.HotelResponseToProto(hotel),
 	}, nil
 }
 
+func (h *Handler) GetHotelsByIDs(
+	ctx context.Context,
+	req *hotelv1.GetHotelsByIDsRequest,
+) (*hotelv1.GetHotelsByIDsResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelIDs, err := helper.ParseHotelIDs(req.Ids)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	hotels, err := h.svc.GetHotelsByIDs(ctx, hotelIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetHotelsByIDsResponse{
+		Hotels:      mapper.HotelListResponseToProto(hotels),
+		NotFoundIds: mapper.MissingIDsToProto(hotelIDs, mapper.HotelIDsToDomain(hotels)),
+	}, nil
+}
+
 func (h *Handler) UpdateHotel(
 	ctx context.Context,
 	req *hotelv1.UpdateHotelRequest,
@@ -83,13 +136,45 @@ func (h *Handler) UpdateHotel(
 
 	ref := mapper.GetHotelRefRequestToDomain(req)
 	hotel := mapper.UpdateHotelRequestToDomain(req)
-	if err := h.svc.UpdateHotelBySlug(ctx, ref, hotel); err != nil {
+	version, err := h.svc.UpdateHotelBySlug(ctx, ref, hotel)
+	if err != nil {
 		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
 		return nil, helper.HandleDomainErr(err)
 	}
 
 	return &hotelv1.UpdateHotelResponse{
-		Hotel: mapper.UpdateHotelResponseToProto(hotel),
+		Hotel: mapper.UpdateHotelResponseToProto(hotel, version),
+	}, nil
+}
+
+func (h *Handler) PatchHotel(
+	ctx context.Context,
+	req *hotelv1.PatchHotelRequest,
+) (*hotelv1.PatchHotelResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+	err := helper.ValidateUpdateMask(
+		req.UpdateMask, req.Hotel,
+		models.HotelFieldAddress,
+		models.HotelFieldLocation,
+		models.HotelFieldTimezone,
+		models.HotelFieldCheckInTime,
+		models.HotelFieldCheckOutTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := mapper.GetHotelRefRequestToDomain(req)
+	hotel, err := h.svc.PatchHotelBySlug(ctx, ref, mapper.PatchHotelRequestToDomain(req))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.PatchHotelResponse{
+		Hotel: mapper.HotelResponseToProto(hotel),
 	}, nil
 }
 
@@ -115,6 +200,30 @@ func (h *Handler) UpdateHotelTitle(
 	}, nil
 }
 
+func (h *Handler) UpdateHotelRating(
+	ctx context.Context,
+	req *hotelv1.UpdateHotelRatingRequest,
+) (*hotelv1.UpdateHotelRatingResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelID, err := helper.ParseHotelID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	rating, err := h.svc.UpdateHotelRating(ctx, hotelID, req.Rating)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.UpdateHotelRatingResponse{
+		Rating: rating,
+	}, nil
+}
+
 func (h *Handler) DeleteHotel(
 	ctx context.Context,
 	req *hotelv1.DeleteHotelRequest,
@@ -124,12 +233,14 @@ func (h *Handler) DeleteHotel(
 	}
 
 	ref := mapper.GetHotelRefRequestToDomain(req)
-	if err := h.svc.DeleteHotelBySlug(ctx, ref); err != nil {
+	cancelled, err := h.svc.DeleteHotelBySlug(ctx, ref, req.Force)
+	if err != nil {
 		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
 		return nil, helper.HandleDomainErr(err)
 	}
 
 	return &hotelv1.DeleteHotelResponse{
-		Message: "success",
+		Message:             "success",
+		CancelledBookingIds: cancelled,
 	}, nil
 }
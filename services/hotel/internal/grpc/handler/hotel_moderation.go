@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/interceptor"
+	"hotel/internal/grpc/utils/helper"
+	"hotel/internal/grpc/utils/mapper"
+	"hotel/internal/repository/models"
+)
+
+func (h *Handler) SubmitHotelForReview(
+	ctx context.Context,
+	req *hotelv1.SubmitHotelForReviewRequest,
+) (*hotelv1.SubmitHotelForReviewResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelRef := mapper.GetHotelRefRequestToDomain(req)
+	change := mapper.HotelStatusChangeToDomain(interceptor.GetActor(ctx), models.HotelStatusPendingReview, "")
+	transition, err := h.svc.ChangeHotelStatus(ctx, hotelRef, change)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.SubmitHotelForReviewResponse{
+		Transition: mapper.HotelStatusTransitionResponseToProto(transition),
+	}, nil
+}
+
+func (h *Handler) ApproveHotel(
+	ctx context.Context,
+	req *hotelv1.ApproveHotelRequest,
+) (*hotelv1.ApproveHotelResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelRef := mapper.GetHotelRefRequestToDomain(req)
+	change := mapper.HotelStatusChangeToDomain(interceptor.GetActor(ctx), models.HotelStatusPublished, "")
+	transition, err := h.svc.ChangeHotelStatus(ctx, hotelRef, change)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.ApproveHotelResponse{
+		Transition: mapper.HotelStatusTransitionResponseToProto(transition),
+	}, nil
+}
+
+func (h *Handler) RejectHotel(
+	ctx context.Context,
+	req *hotelv1.RejectHotelRequest,
+) (*hotelv1.RejectHotelResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelRef := mapper.GetHotelRefRequestToDomain(req)
+	change := mapper.HotelStatusChangeToDomain(interceptor.GetActor(ctx), models.HotelStatusDraft, req.Reason)
+	transition, err := h.svc.ChangeHotelStatus(ctx, hotelRef, change)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.RejectHotelResponse{
+		Transition: mapper.HotelStatusTransitionResponseToProto(transition),
+	}, nil
+}
+
+func (h *Handler) SuspendHotel(
+	ctx context.Context,
+	req *hotelv1.SuspendHotelRequest,
+) (*hotelv1.SuspendHotelResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelRef := mapper.GetHotelRefRequestToDomain(req)
+	change := mapper.HotelStatusChangeToDomain(interceptor.GetActor(ctx), models.HotelStatusSuspended, req.Reason)
+	transition, err := h.svc.ChangeHotelStatus(ctx, hotelRef, change)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.SuspendHotelResponse{
+		Transition: mapper.HotelStatusTransitionResponseToProto(transition),
+	}, nil
+}
+
+func (h *Handler) GetHotelStatusHistory(
+	ctx context.Context,
+	req *hotelv1.GetHotelStatusHistoryRequest,
+) (*hotelv1.GetHotelStatusHistoryResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelRef := mapper.GetHotelRefRequestToDomain(req)
+	history, err := h.svc.GetHotelStatusHistory(ctx, hotelRef)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetHotelStatusHistoryResponse{
+		Transitions: mapper.HotelStatusHistoryResponseToProto(history),
+	}, nil
+}
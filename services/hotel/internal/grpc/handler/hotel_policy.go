@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/utils/helper"
+	"hotel/internal/grpc/utils/mapper"
+)
+
+func (h *Handler) SetHotelPolicy(
+	ctx context.Context,
+	req *hotelv1.SetHotelPolicyRequest,
+) (*hotelv1.SetHotelPolicyResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelRef := mapper.GetHotelRefRequestToDomain(req)
+	policy, err := mapper.SetHotelPolicyRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	newPolicy, err := h.svc.SetHotelPolicy(ctx, hotelRef, policy)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.SetHotelPolicyResponse{
+		Policy: mapper.HotelPolicyResponseToProto(newPolicy),
+	}, nil
+}
+
+func (h *Handler) GetHotelPolicy(
+	ctx context.Context,
+	req *hotelv1.GetHotelPolicyRequest,
+) (*hotelv1.GetHotelPolicyResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelRef := mapper.GetHotelRefRequestToDomain(req)
+	policy, err := h.svc.GetHotelPolicy(ctx, hotelRef)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetHotelPolicyResponse{
+		Policy: mapper.HotelPolicyResponseToProto(policy),
+	}, nil
+}
+
+func (h *Handler) DeleteHotelPolicy(
+	ctx context.Context,
+	req *hotelv1.DeleteHotelPolicyRequest,
+) (*hotelv1.DeleteHotelPolicyResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelRef := mapper.GetHotelRefRequestToDomain(req)
+	if err := h.svc.DeleteHotelPolicy(ctx, hotelRef); err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.DeleteHotelPolicyResponse{
+		Message: "success",
+	}, nil
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/utils/helper"
+	"hotel/internal/grpc/utils/mapper"
+	"hotel/pkg/lib/utils/consts"
+)
+
+// UploadImage expects the image target in the first message and the file contents in the following chunks.
+func (h *Handler) UploadImage(stream hotelv1.ImageService_UploadImageServer) error {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if err = h.validator.Validate(req); err != nil {
+		return helper.HandleValidationErr(err)
+	}
+	if req.GetTarget() == nil {
+		return helper.HandleDomainErr(consts.ErrImageTargetRequired)
+	}
+
+	target, err := mapper.ImageTargetToDomain(req.GetTarget())
+	if err != nil {
+		return helper.HandleDomainErr(err)
+	}
+
+	var data bytes.Buffer
+	for {
+		req, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err = h.validator.Validate(req); err != nil {
+			return helper.HandleValidationErr(err)
+		}
+
+		chunk := req.GetChunk()
+		if chunk == nil {
+			return helper.HandleDomainErr(consts.ErrImageTargetRequired)
+		}
+		if data.Len()+len(chunk) > consts.MaxImageBytes {
+			return helper.HandleDomainErr(consts.ErrImageTooLarge)
+		}
+		data.Write(chunk)
+	}
+
+	img, err := h.svc.UploadImage(ctx, target, &data)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return helper.HandleDomainErr(err)
+	}
+
+	return stream.SendAndClose(&hotelv1.UploadImageResponse{
+		Image: mapper.ImageResponseToProto(img),
+	})
+}
+
+func (h *Handler) GetImages(
+	ctx context.Context,
+	req *hotelv1.GetImagesRequest,
+) (*hotelv1.GetImagesResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	target, err := mapper.ImageTargetToDomain(req.Target)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	images, err := h.svc.GetImages(ctx, target)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetImagesResponse{
+		Images: mapper.ImagesResponseToProto(images),
+	}, nil
+}
+
+func (h *Handler) ReorderImages(
+	ctx context.Context,
+	req *hotelv1.ReorderImagesRequest,
+) (*hotelv1.ReorderImagesResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	target, err := mapper.ImageTargetToDomain(req.Target)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	imageIDs, err := mapper.ImageIDsToDomain(req.ImageIds)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	images, err := h.svc.ReorderImages(ctx, target, imageIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.ReorderImagesResponse{
+		Images: mapper.ImagesResponseToProto(images),
+	}, nil
+}
+
+func (h *Handler) DeleteImage(
+	ctx context.Context,
+	req *hotelv1.DeleteImageRequest,
+) (*hotelv1.DeleteImageResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	imageID, err := helper.ParseImageID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	if err = h.svc.DeleteImageByID(ctx, imageID); err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.DeleteImageResponse{
+		Message: "success",
+	}, nil
+}
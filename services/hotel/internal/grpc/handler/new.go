@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"io"
 
 	"buf.build/go/protovalidate"
 	"github.com/google/uuid"
@@ -13,31 +14,133 @@ import (
 type HotelService interface {
 	CreateHotel(ctx context.Context, h *models.CreateHotel) (*models.Hotel, error)
 	GetHotels(ctx context.Context, ref models.HotelRef, sort string, page, limit uint64) (*models.HotelList, error)
-	GetHotelBySlug(ctx context.Context, ref models.HotelRef) (*models.Hotel, error)
-	UpdateHotelBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotel) error
+	GetHotelBySlug(ctx context.Context, ref models.HotelRef, viewer models.HotelViewer) (*models.Hotel, error)
+	GetHotelByID(ctx context.Context, hotelID uuid.UUID) (*models.Hotel, error)
+	GetHotelsByIDs(ctx context.Context, hotelIDs []uuid.UUID) ([]*models.Hotel, error)
+	UpdateHotelBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotel) (int64, error)
+	PatchHotelBySlug(ctx context.Context, ref models.HotelRef, h models.PatchHotel) (*models.Hotel, error)
 	UpdateHotelTitleBySlug(
 		ctx context.Context, ref models.HotelRef, h models.UpdateHotelTitle,
 	) (models.UpdateHotelTitle, error)
-	DeleteHotelBySlug(ctx context.Context, ref models.HotelRef) error
+	UpdateHotelRating(ctx context.Context, hotelID uuid.UUID, rating *float32) (*float32, error)
+	DeleteHotelBySlug(ctx context.Context, ref models.HotelRef, force bool) ([]string, error)
 }
 
 type RoomService interface {
 	CreateRoom(ctx context.Context, hotelRef models.HotelRef, room *models.CreateRoom) (*models.Room, error)
 	GetRooms(ctx context.Context, hotelRef models.HotelRef, page, limit uint64) (*models.RoomList, error)
 	GetRoomByID(ctx context.Context, roomID uuid.UUID) (*models.Room, error)
-	UpdateRoomByID(ctx context.Context, roomID uuid.UUID, room *models.UpdateRoom) error
-	UpdateRoomStatusByID(ctx context.Context, roomID uuid.UUID, room models.UpdateRoomStatus) error
-	DeleteRoomByID(ctx context.Context, roomID uuid.UUID) error
+	GetRoomsByIDs(ctx context.Context, roomIDs []uuid.UUID) ([]*models.Room, error)
+	UpdateRoomByID(ctx context.Context, roomID uuid.UUID, room *models.UpdateRoom) (int64, error)
+	PatchRoomByID(ctx context.Context, roomID uuid.UUID, room *models.PatchRoom) (*models.Room, error)
+	UpdateRoomStatusByID(ctx context.Context, roomID uuid.UUID, room models.UpdateRoomStatus) (int64, error)
+	DeleteRoomByID(ctx context.Context, roomID uuid.UUID, force bool) ([]string, error)
+}
+
+type RoomCategoryService interface {
+	CreateRoomCategory(
+		ctx context.Context, hotelRef models.HotelRef, c *models.CreateRoomCategory,
+	) (*models.RoomCategory, error)
+	GetRoomCategories(ctx context.Context, hotelRef models.HotelRef) ([]*models.RoomCategory, error)
+	GetRoomCategoryByID(ctx context.Context, categoryID uuid.UUID) (*models.RoomCategory, error)
+	UpdateRoomCategoryByID(
+		ctx context.Context, categoryID uuid.UUID, c *models.UpdateRoomCategory,
+	) (*models.RoomCategory, error)
+	DeleteRoomCategoryByID(ctx context.Context, categoryID uuid.UUID) error
+	AssignRoomCategory(ctx context.Context, roomID uuid.UUID, a models.AssignRoomCategory) (int64, error)
+}
+
+type RatePlanService interface {
+	CreateRatePlan(ctx context.Context, hotelRef models.HotelRef, rp *models.CreateRatePlan) (*models.RatePlan, error)
+	GetRatePlans(ctx context.Context, hotelRef models.HotelRef, page, limit uint64) (*models.RatePlanList, error)
+	GetRatePlanByID(ctx context.Context, ratePlanID uuid.UUID) (*models.RatePlan, error)
+	UpdateRatePlanByID(ctx context.Context, ratePlanID uuid.UUID, rp *models.UpdateRatePlan) (*models.RatePlan, error)
+	DeleteRatePlanByID(ctx context.Context, ratePlanID uuid.UUID) error
+	QuoteStay(ctx context.Context, roomID uuid.UUID, stay models.DateRange) (*models.StayQuote, error)
+}
+
+type StayRestrictionService interface {
+	CreateStayRestriction(
+		ctx context.Context, hotelRef models.HotelRef, sr *models.CreateStayRestriction,
+	) (*models.StayRestriction, error)
+	GetStayRestrictions(
+		ctx context.Context, hotelRef models.HotelRef, page, limit uint64,
+	) (*models.StayRestrictionList, error)
+	GetStayRestrictionByID(ctx context.Context, restrictionID uuid.UUID) (*models.StayRestriction, error)
+	UpdateStayRestrictionByID(
+		ctx context.Context, restrictionID uuid.UUID, sr *models.UpdateStayRestriction,
+	) (*models.StayRestriction, error)
+	DeleteStayRestrictionByID(ctx context.Context, restrictionID uuid.UUID) error
+	CheckStay(ctx context.Context, roomID uuid.UUID, stay models.DateRange) ([]models.StayViolation, error)
+}
+
+type RoomBlockService interface {
+	CreateRoomBlock(ctx context.Context, rb *models.CreateRoomBlock) (*models.RoomBlock, error)
+	GetRoomBlocks(ctx context.Context, roomID uuid.UUID, page, limit uint64) (*models.RoomBlockList, error)
+	DeleteRoomBlockByID(ctx context.Context, blockID uuid.UUID) error
+}
+
+type ImageService interface {
+	UploadImage(ctx context.Context, target models.ImageTarget, r io.Reader) (*models.Image, error)
+	GetImages(ctx context.Context, target models.ImageTarget) ([]*models.Image, error)
+	ReorderImages(ctx context.Context, target models.ImageTarget, imageIDs []uuid.UUID) ([]*models.Image, error)
+	DeleteImageByID(ctx context.Context, imageID uuid.UUID) error
+}
+
+type AmenityService interface {
+	CreateAmenity(ctx context.Context, a *models.CreateAmenity) (*models.Amenity, error)
+	GetAmenities(ctx context.Context, category *models.AmenityCategory) ([]*models.Amenity, error)
+	GetAmenityByCode(ctx context.Context, code string) (*models.Amenity, error)
+	UpdateAmenityByCode(ctx context.Context, code string, a *models.UpdateAmenity) (*models.Amenity, error)
+	DeleteAmenityByCode(ctx context.Context, code string) error
+	SetHotelAmenities(ctx context.Context, hotelRef models.HotelRef, codes []string) ([]*models.Amenity, error)
+	GetHotelAmenities(ctx context.Context, hotelRef models.HotelRef) ([]*models.Amenity, error)
+}
+
+type GeoService interface {
+	ListCountries(ctx context.Context) ([]*models.Country, error)
+	ListCities(ctx context.Context, filter models.CityFilter, page, limit uint64) (*models.CityList, error)
+}
+
+type HotelPolicyService interface {
+	SetHotelPolicy(ctx context.Context, hotelRef models.HotelRef, p *models.SetHotelPolicy) (*models.HotelPolicy, error)
+	GetHotelPolicy(ctx context.Context, hotelRef models.HotelRef) (*models.HotelPolicy, error)
+	DeleteHotelPolicy(ctx context.Context, hotelRef models.HotelRef) error
+}
+
+type HotelModerationService interface {
+	ChangeHotelStatus(
+		ctx context.Context, hotelRef models.HotelRef, c *models.HotelStatusChange,
+	) (*models.HotelStatusTransition, error)
+	GetHotelStatusHistory(ctx context.Context, hotelRef models.HotelRef) ([]*models.HotelStatusTransition, error)
 }
 
 type Service interface {
 	HotelService
 	RoomService
+	RoomCategoryService
+	RatePlanService
+	StayRestrictionService
+	RoomBlockService
+	ImageService
+	AmenityService
+	GeoService
+	HotelPolicyService
+	HotelModerationService
 }
 
 type Handler struct {
 	hotelv1.UnimplementedHotelServiceServer
 	hotelv1.UnimplementedRoomServiceServer
+	hotelv1.UnimplementedRoomCategoryServiceServer
+	hotelv1.UnimplementedRatePlanServiceServer
+	hotelv1.UnimplementedStayRestrictionServiceServer
+	hotelv1.UnimplementedRoomBlockServiceServer
+	hotelv1.UnimplementedImageServiceServer
+	hotelv1.UnimplementedAmenityServiceServer
+	hotelv1.UnimplementedGeoServiceServer
+	hotelv1.UnimplementedHotelPolicyServiceServer
+	hotelv1.UnimplementedHotelModerationServiceServer
 	svc       Service
 	validator protovalidate.Validator
 }
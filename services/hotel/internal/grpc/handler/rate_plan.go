@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/utils/helper"
+	"hotel/internal/grpc/utils/mapper"
+)
+
+func (h *Handler) CreateRatePlan(
+	ctx context.Context,
+	req *hotelv1.CreateRatePlanRequest,
+) (*hotelv1.CreateRatePlanResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	ref := mapper.GetHotelRefRequestToDomain(req)
+	ratePlan, err := mapper.CreateRatePlanRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	created, err := h.svc.CreateRatePlan(ctx, ref, ratePlan)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.CreateRatePlanResponse{
+		RatePlan: mapper.RatePlanResponseToProto(created),
+	}, nil
+}
+
+func (h *Handler) GetRatePlans(
+	ctx context.Context,
+	req *hotelv1.GetRatePlansRequest,
+) (*hotelv1.GetRatePlansResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	ref := mapper.GetHotelRefRequestToDomain(req)
+	ratePlanList, err := h.svc.GetRatePlans(ctx, ref, req.Page, req.Limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetRatePlansResponse{
+		RatePlans:  mapper.RatePlansResponseToProto(ratePlanList.RatePlans),
+		TotalCount: ratePlanList.TotalCount,
+		Page:       req.Page,
+		Limit:      req.Limit,
+	}, nil
+}
+
+func (h *Handler) GetRatePlan(
+	ctx context.Context,
+	req *hotelv1.GetRatePlanRequest,
+) (*hotelv1.GetRatePlanResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	ratePlanID, err := helper.ParseRatePlanID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	ratePlan, err := h.svc.GetRatePlanByID(ctx, ratePlanID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetRatePlanResponse{
+		RatePlan: mapper.RatePlanResponseToProto(ratePlan),
+	}, nil
+}
+
+func (h *Handler) UpdateRatePlan(
+	ctx context.Context,
+	req *hotelv1.UpdateRatePlanRequest,
+) (*hotelv1.UpdateRatePlanResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	ratePlanID, err := helper.ParseRatePlanID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	ratePlan, err := mapper.UpdateRatePlanRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	updated, err := h.svc.UpdateRatePlanByID(ctx, ratePlanID, ratePlan)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.UpdateRatePlanResponse{
+		RatePlan: mapper.RatePlanResponseToProto(updated),
+	}, nil
+}
+
+func (h *Handler) DeleteRatePlan(
+	ctx context.Context,
+	req *hotelv1.DeleteRatePlanRequest,
+) (*hotelv1.DeleteRatePlanResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	ratePlanID, err := helper.ParseRatePlanID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	if err = h.svc.DeleteRatePlanByID(ctx, ratePlanID); err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.DeleteRatePlanResponse{
+		Message: "success",
+	}, nil
+}
+
+func (h *Handler) QuoteStay(
+	ctx context.Context,
+	req *hotelv1.QuoteStayRequest,
+) (*hotelv1.QuoteStayResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	roomID, err := helper.ParseRoomID(req.RoomId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	quote, err := h.svc.QuoteStay(ctx, roomID, mapper.QuoteStayRequestToDomain(req))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.QuoteStayResponse{
+		Quote: mapper.StayQuoteResponseToProto(quote),
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	hotelv1 "hotel/api/hotel/v1"
 	"hotel/internal/grpc/utils/helper"
 	"hotel/internal/grpc/utils/mapper"
+	"hotel/internal/repository/models"
 )
 
 func (h *Handler) CreateRoom(
@@ -77,6 +78,31 @@ func (h *Handler) GetRoom(
 	}, nil
 }
 
+func (h *Handler) GetRoomsByIDs(
+	ctx context.Context,
+	req *hotelv1.GetRoomsByIDsRequest,
+) (*hotelv1.GetRoomsByIDsResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	roomIDs, err := helper.ParseRoomIDs(req.Ids)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	rooms, err := h.svc.GetRoomsByIDs(ctx, roomIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetRoomsByIDsResponse{
+		Rooms:       mapper.RoomListResponseToProto(rooms),
+		NotFoundIds: mapper.MissingIDsToProto(roomIDs, mapper.RoomIDsToDomain(rooms)),
+	}, nil
+}
+
 func (h *Handler) UpdateRoom(
 	ctx context.Context,
 	req *hotelv1.UpdateRoomRequest,
@@ -95,13 +121,56 @@ func (h *Handler) UpdateRoom(
 		return nil, helper.HandleDomainErr(err)
 	}
 
-	if err = h.svc.UpdateRoomByID(ctx, roomID, updated); err != nil {
+	version, err := h.svc.UpdateRoomByID(ctx, roomID, updated)
+	if err != nil {
 		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
 		return nil, helper.HandleDomainErr(err)
 	}
 
 	return &hotelv1.UpdateRoomResponse{
-		Room: mapper.UpdateRoomResponseToProto(updated),
+		Room: mapper.UpdateRoomResponseToProto(updated, version),
+	}, nil
+}
+
+func (h *Handler) PatchRoom(
+	ctx context.Context,
+	req *hotelv1.PatchRoomRequest,
+) (*hotelv1.PatchRoomResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+	err := helper.ValidateUpdateMask(
+		req.UpdateMask, req.Room,
+		models.RoomFieldTitle,
+		models.RoomFieldRoomNumber,
+		models.RoomFieldType,
+		models.RoomFieldPrice,
+		models.RoomFieldCapacity,
+		models.RoomFieldAreaSqm,
+		models.RoomFieldFloor,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	roomID, err := helper.ParseRoomID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	patch, err := mapper.PatchRoomRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	room, err := h.svc.PatchRoomByID(ctx, roomID, patch)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.PatchRoomResponse{
+		Room: mapper.RoomResponseToProto(room),
 	}, nil
 }
 
@@ -120,13 +189,15 @@ func (h *Handler) UpdateRoomStatus(
 
 	room := mapper.UpdateRoomStatusRequestToDomain(req)
 
-	if err = h.svc.UpdateRoomStatusByID(ctx, roomID, room); err != nil {
+	version, err := h.svc.UpdateRoomStatusByID(ctx, roomID, room)
+	if err != nil {
 		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
 		return nil, helper.HandleDomainErr(err)
 	}
 
 	return &hotelv1.UpdateRoomStatusResponse{
-		Status: mapper.UpdateRoomStatusResponseToProto(room),
+		Status:  mapper.UpdateRoomStatusResponseToProto(room),
+		Version: version,
 	}, nil
 }
 
@@ -143,12 +214,14 @@ func (h *Handler) DeleteRoom(
 		return nil, helper.HandleDomainErr(err)
 	}
 
-	if err = h.svc.DeleteRoomByID(ctx, roomID); err != nil {
+	cancelled, err := h.svc.DeleteRoomByID(ctx, roomID, req.Force)
+	if err != nil {
 		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
 		return nil, helper.HandleDomainErr(err)
 	}
 
 	return &hotelv1.DeleteRoomResponse{
-		Message: "success",
+		Message:             "success",
+		CancelledBookingIds: cancelled,
 	}, nil
 }
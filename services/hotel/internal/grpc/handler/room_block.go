@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/utils/helper"
+	"hotel/internal/grpc/utils/mapper"
+)
+
+func (h *Handler) CreateRoomBlock(
+	ctx context.Context,
+	req *hotelv1.CreateRoomBlockRequest,
+) (*hotelv1.CreateRoomBlockResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	block, err := mapper.CreateRoomBlockRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	created, err := h.svc.CreateRoomBlock(ctx, block)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.CreateRoomBlockResponse{
+		RoomBlock: mapper.RoomBlockResponseToProto(created),
+	}, nil
+}
+
+func (h *Handler) GetRoomBlocks(
+	ctx context.Context,
+	req *hotelv1.GetRoomBlocksRequest,
+) (*hotelv1.GetRoomBlocksResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	roomID, err := helper.ParseRoomID(req.RoomId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	blockList, err := h.svc.GetRoomBlocks(ctx, roomID, req.Page, req.Limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetRoomBlocksResponse{
+		RoomBlocks: mapper.RoomBlocksResponseToProto(blockList.RoomBlocks),
+		TotalCount: blockList.TotalCount,
+		Page:       req.Page,
+		Limit:      req.Limit,
+	}, nil
+}
+
+func (h *Handler) DeleteRoomBlock(
+	ctx context.Context,
+	req *hotelv1.DeleteRoomBlockRequest,
+) (*hotelv1.DeleteRoomBlockResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	blockID, err := helper.ParseRoomBlockID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	if err = h.svc.DeleteRoomBlockByID(ctx, blockID); err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.DeleteRoomBlockResponse{
+		Message: "success",
+	}, nil
+}
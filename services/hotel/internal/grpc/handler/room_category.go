@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/utils/helper"
+	"hotel/internal/grpc/utils/mapper"
+)
+
+func (h *Handler) CreateRoomCategory(
+	ctx context.Context,
+	req *hotelv1.CreateRoomCategoryRequest,
+) (*hotelv1.CreateRoomCategoryResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	ref := mapper.GetHotelRefRequestToDomain(req)
+	category, err := mapper.CreateRoomCategoryRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	created, err := h.svc.CreateRoomCategory(ctx, ref, category)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.CreateRoomCategoryResponse{
+		RoomCategory: mapper.RoomCategoryResponseToProto(created),
+	}, nil
+}
+
+func (h *Handler) GetRoomCategories(
+	ctx context.Context,
+	req *hotelv1.GetRoomCategoriesRequest,
+) (*hotelv1.GetRoomCategoriesResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	ref := mapper.GetHotelRefRequestToDomain(req)
+	categories, err := h.svc.GetRoomCategories(ctx, ref)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetRoomCategoriesResponse{
+		RoomCategories: mapper.RoomCategoriesResponseToProto(categories),
+	}, nil
+}
+
+func (h *Handler) GetRoomCategory(
+	ctx context.Context,
+	req *hotelv1.GetRoomCategoryRequest,
+) (*hotelv1.GetRoomCategoryResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	categoryID, err := helper.ParseRoomCategoryID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	category, err := h.svc.GetRoomCategoryByID(ctx, categoryID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetRoomCategoryResponse{
+		RoomCategory: mapper.RoomCategoryResponseToProto(category),
+	}, nil
+}
+
+func (h *Handler) UpdateRoomCategory(
+	ctx context.Context,
+	req *hotelv1.UpdateRoomCategoryRequest,
+) (*hotelv1.UpdateRoomCategoryResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	categoryID, err := helper.ParseRoomCategoryID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	category, err := mapper.UpdateRoomCategoryRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	updated, err := h.svc.UpdateRoomCategoryByID(ctx, categoryID, category)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.UpdateRoomCategoryResponse{
+		RoomCategory: mapper.RoomCategoryResponseToProto(updated),
+	}, nil
+}
+
+func (h *Handler) DeleteRoomCategory(
+	ctx context.Context,
+	req *hotelv1.DeleteRoomCategoryRequest,
+) (*hotelv1.DeleteRoomCategoryResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	categoryID, err := helper.ParseRoomCategoryID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	if err = h.svc.DeleteRoomCategoryByID(ctx, categoryID); err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.DeleteRoomCategoryResponse{
+		Message: "success",
+	}, nil
+}
+
+func (h *Handler) AssignRoomCategory(
+	ctx context.Context,
+	req *hotelv1.AssignRoomCategoryRequest,
+) (*hotelv1.AssignRoomCategoryResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	roomID, err := helper.ParseRoomID(req.RoomId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	assignment, err := mapper.AssignRoomCategoryRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	version, err := h.svc.AssignRoomCategory(ctx, roomID, assignment)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.AssignRoomCategoryResponse{
+		Version: version,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/utils/helper"
+	"hotel/internal/grpc/utils/mapper"
+)
+
+func (h *Handler) CreateStayRestriction(
+	ctx context.Context,
+	req *hotelv1.CreateStayRestrictionRequest,
+) (*hotelv1.CreateStayRestrictionResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	ref := mapper.GetHotelRefRequestToDomain(req)
+	restriction, err := mapper.CreateStayRestrictionRequestToDomain(req)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	created, err := h.svc.CreateStayRestriction(ctx, ref, restriction)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.CreateStayRestrictionResponse{
+		StayRestriction: mapper.StayRestrictionResponseToProto(created),
+	}, nil
+}
+
+func (h *Handler) GetStayRestrictions(
+	ctx context.Context,
+	req *hotelv1.GetStayRestrictionsRequest,
+) (*hotelv1.GetStayRestrictionsResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	ref := mapper.GetHotelRefRequestToDomain(req)
+	restrictionList, err := h.svc.GetStayRestrictions(ctx, ref, req.Page, req.Limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetStayRestrictionsResponse{
+		StayRestrictions: mapper.StayRestrictionsResponseToProto(restrictionList.StayRestrictions),
+		TotalCount:       restrictionList.TotalCount,
+		Page:             req.Page,
+		Limit:            req.Limit,
+	}, nil
+}
+
+func (h *Handler) GetStayRestriction(
+	ctx context.Context,
+	req *hotelv1.GetStayRestrictionRequest,
+) (*hotelv1.GetStayRestrictionResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	restrictionID, err := helper.ParseStayRestrictionID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	restriction, err := h.svc.GetStayRestrictionByID(ctx, restrictionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.GetStayRestrictionResponse{
+		StayRestriction: mapper.StayRestrictionResponseToProto(restriction),
+	}, nil
+}
+
+func (h *Handler) UpdateStayRestriction(
+	ctx context.Context,
+	req *hotelv1.UpdateStayRestrictionRequest,
+) (*hotelv1.UpdateStayRestrictionResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	restrictionID, err := helper.ParseStayRestrictionID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	restriction := mapper.UpdateStayRestrictionRequestToDomain(req)
+	updated, err := h.svc.UpdateStayRestrictionByID(ctx, restrictionID, restriction)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.UpdateStayRestrictionResponse{
+		StayRestriction: mapper.StayRestrictionResponseToProto(updated),
+	}, nil
+}
+
+func (h *Handler) DeleteStayRestriction(
+	ctx context.Context,
+	req *hotelv1.DeleteStayRestrictionRequest,
+) (*hotelv1.DeleteStayRestrictionResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	restrictionID, err := helper.ParseStayRestrictionID(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	if err = h.svc.DeleteStayRestrictionByID(ctx, restrictionID); err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.DeleteStayRestrictionResponse{
+		Message: "success",
+	}, nil
+}
+
+func (h *Handler) CheckStay(
+	ctx context.Context,
+	req *hotelv1.CheckStayRequest,
+) (*hotelv1.CheckStayResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	roomID, err := helper.ParseRoomID(req.RoomId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	violations, err := h.svc.CheckStay(ctx, roomID, mapper.CheckStayRequestToDomain(req))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &hotelv1.CheckStayResponse{
+		Allowed:    len(violations) == 0,
+		Violations: mapper.StayViolationsResponseToProto(violations),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"authn"
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/grpc/utils/helper"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+type contextKey string
+
+const ActorKey contextKey = "actor"
+
+// authenticatedMethods act on behalf of the caller and reject anonymous requests.
+var authenticatedMethods = map[string]struct{}{
+	hotelv1.HotelModerationService_SubmitHotelForReview_FullMethodName: {},
+	hotelv1.HotelModerationService_ApproveHotel_FullMethodName:         {},
+	hotelv1.HotelModerationService_RejectHotel_FullMethodName:          {},
+	hotelv1.HotelModerationService_SuspendHotel_FullMethodName:         {},
+}
+
+// AuthInterceptor reads the caller from the bearer access token issued by the
+// auth service and stores it in the context for GetActor. Requests without a
+// token go through as an anonymous actor unless the method needs one; a token
+// that does not verify is always rejected.
+func AuthInterceptor(accessSecret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var actor models.Actor
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		if token := md.Get("authorization"); len(token) > 0 {
+			bearerToken, ok := strings.CutPrefix(token[0], "Bearer ")
+			if !ok {
+				return nil, helper.HandleDomainErr(consts.ErrInvalidToken)
+			}
+
+			claims, err := authn.ParseBearerToken[models.UserRole](bearerToken, accessSecret)
+			if err != nil {
+				return nil, helper.HandleDomainErr(consts.ErrInvalidToken)
+			}
+			actor = models.Actor{ID: claims.Sub, Role: claims.Role}
+		}
+
+		if _, ok := authenticatedMethods[info.FullMethod]; ok && actor.ID == 0 {
+			return nil, helper.HandleDomainErr(consts.ErrUnauthenticated)
+		}
+
+		return handler(context.WithValue(ctx, ActorKey, actor), req)
+	}
+}
+
+// GetActor returns the caller AuthInterceptor found; it is the zero actor for
+// anonymous requests.
+func GetActor(ctx context.Context) models.Actor {
+	actor, _ := ctx.Value(ActorKey).(models.Actor)
+	return actor
+}
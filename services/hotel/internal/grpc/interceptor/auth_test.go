@@ -0,0 +1,81 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"authn"
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	const secret = "access-secret"
+	sign := func(secret string) string {
+		token, err := authn.SignToken(int64(7), models.UserRoleModerator, time.Minute, []byte(secret))
+		if err != nil {
+			t.Fatalf("SignToken() error = %v", err)
+		}
+		return token
+	}
+	moderator := models.Actor{ID: 7, Role: models.UserRoleModerator}
+
+	getHotel := &grpc.UnaryServerInfo{FullMethod: hotelv1.HotelService_GetHotel_FullMethodName}
+	approveHotel := &grpc.UnaryServerInfo{FullMethod: hotelv1.HotelModerationService_ApproveHotel_FullMethodName}
+
+	tests := []struct {
+		name          string
+		info          *grpc.UnaryServerInfo
+		authorization string
+		wantActor     models.Actor
+		wantCode      codes.Code
+	}{
+		{name: "anonymous read", info: getHotel},
+		{name: "authenticated read", info: getHotel, authorization: "Bearer " + sign(secret), wantActor: moderator},
+		{name: "moderation", info: approveHotel, authorization: "Bearer " + sign(secret), wantActor: moderator},
+		{name: "anonymous moderation", info: approveHotel, wantCode: codes.Unauthenticated},
+		{
+			name:          "token signed with another secret",
+			info:          getHotel,
+			authorization: "Bearer " + sign("other-secret"),
+			wantCode:      codes.Unauthenticated,
+		},
+		{name: "not a bearer token", info: getHotel, authorization: sign(secret), wantCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			var (
+				called bool
+				actor  models.Actor
+			)
+			handler := func(ctx context.Context, _ any) (any, error) {
+				called = true
+				actor = GetActor(ctx)
+				return nil, nil
+			}
+
+			_, err := AuthInterceptor(secret)(ctx, nil, tt.info, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("code = %s, want %s", got, tt.wantCode)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Fatalf("handler called = %v, want %v", called, tt.wantCode == codes.OK)
+			}
+			if actor != tt.wantActor {
+				t.Errorf("actor = %+v, want %+v", actor, tt.wantActor)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"hotel/internal/repository/models"
 	"hotel/pkg/lib/utils/consts"
 )
 
@@ -21,6 +22,59 @@ var (
 	errUniqueHotelField = domainErr{consts.MsgUniqueHotelField, codes.NotFound}
 	errUniqueRoomField  = domainErr{consts.MsgUniqueRoomField, codes.NotFound}
 	errInternalServer   = domainErr{consts.MsgInternalServer, codes.Internal}
+	errVersionMismatch  = domainErr{consts.MsgVersionMismatch, codes.Aborted}
+
+	errHotelHasActiveBookings = domainErr{consts.MsgHotelHasActiveBookings, codes.FailedPrecondition}
+	errRoomHasActiveBookings  = domainErr{consts.MsgRoomHasActiveBookings, codes.FailedPrecondition}
+
+	errRatePlanNotFound       = domainErr{consts.MsgRatePlanNotFound, codes.NotFound}
+	errRatePlanTargetNotFound = domainErr{consts.MsgRatePlanTargetNotFound, codes.NotFound}
+	errInvalidRatePlanID      = domainErr{consts.MsgInvalidRatePlanID, codes.InvalidArgument}
+	errInvalidRoomID          = domainErr{consts.MsgInvalidRoomID, codes.InvalidArgument}
+	errInvalidHotelID         = domainErr{consts.MsgInvalidHotelID, codes.InvalidArgument}
+	errInvalidPrice           = domainErr{consts.MsgInvalidPrice, codes.InvalidArgument}
+	errInvalidStayDates       = domainErr{consts.MsgInvalidStayDates, codes.InvalidArgument}
+	errStayTooLong            = domainErr{consts.MsgStayTooLong, codes.InvalidArgument}
+
+	errStayRestrictionNotFound       = domainErr{consts.MsgStayRestrictionNotFound, codes.NotFound}
+	errStayRestrictionTargetNotFound = domainErr{consts.MsgStayRestrictionTargetNotFound, codes.NotFound}
+	errInvalidStayRestrictionID      = domainErr{consts.MsgInvalidStayRestrictionID, codes.InvalidArgument}
+
+	errRoomCategoryNotFound    = domainErr{consts.MsgRoomCategoryNotFound, codes.NotFound}
+	errInvalidRoomCategoryID   = domainErr{consts.MsgInvalidRoomCategoryID, codes.InvalidArgument}
+	errUniqueRoomCategoryTitle = domainErr{consts.MsgUniqueRoomCategoryTitle, codes.AlreadyExists}
+	errRoomCategoryInUse       = domainErr{consts.MsgRoomCategoryInUse, codes.FailedPrecondition}
+
+	errRoomBlockNotFound  = domainErr{consts.MsgRoomBlockNotFound, codes.NotFound}
+	errInvalidRoomBlockID = domainErr{consts.MsgInvalidRoomBlockID, codes.InvalidArgument}
+	errRoomUnavailable    = domainErr{consts.MsgRoomUnavailable, codes.AlreadyExists}
+
+	errImageNotFound        = domainErr{consts.MsgImageNotFound, codes.NotFound}
+	errInvalidImageID       = domainErr{consts.MsgInvalidImageID, codes.InvalidArgument}
+	errImageTooLarge        = domainErr{consts.MsgImageTooLarge, codes.InvalidArgument}
+	errUnsupportedImageType = domainErr{consts.MsgUnsupportedImageType, codes.InvalidArgument}
+	errInvalidImage         = domainErr{consts.MsgInvalidImage, codes.InvalidArgument}
+	errImageTargetRequired  = domainErr{consts.MsgImageTargetRequired, codes.InvalidArgument}
+	errImageOrderMismatch   = domainErr{consts.MsgImageOrderMismatch, codes.InvalidArgument}
+
+	errAmenityNotFound   = domainErr{consts.MsgAmenityNotFound, codes.NotFound}
+	errUniqueAmenityCode = domainErr{consts.MsgUniqueAmenityCode, codes.AlreadyExists}
+	errAmenityInUse      = domainErr{consts.MsgAmenityInUse, codes.FailedPrecondition}
+
+	errCityNotFound    = domainErr{consts.MsgCityNotFound, codes.InvalidArgument}
+	errInvalidTimezone = domainErr{consts.MsgInvalidTimezone, codes.InvalidArgument}
+
+	errHotelPolicyNotFound      = domainErr{consts.MsgHotelPolicyNotFound, codes.NotFound}
+	errInvalidCancellationTiers = domainErr{consts.MsgInvalidCancellationTiers, codes.InvalidArgument}
+	errInvalidChildAgeBands     = domainErr{consts.MsgInvalidChildAgeBands, codes.InvalidArgument}
+	errInvalidPetPolicy         = domainErr{consts.MsgInvalidPetPolicy, codes.InvalidArgument}
+
+	errInvalidHotelTransition = domainErr{consts.MsgInvalidHotelTransition, codes.FailedPrecondition}
+	errHotelActionForbidden   = domainErr{consts.MsgHotelActionForbidden, codes.PermissionDenied}
+	errReasonRequired         = domainErr{consts.MsgReasonRequired, codes.InvalidArgument}
+
+	errUnauthenticated = domainErr{consts.MsgUnauthenticated, codes.Unauthenticated}
+	errInvalidToken    = domainErr{consts.MsgInvalidToken, codes.Unauthenticated}
 )
 
 func HandleDomainErr(err error) error {
@@ -34,10 +88,96 @@ func HandleDomainErr(err error) error {
 		domErr = errHotelNotFound
 	case errors.Is(err, consts.ErrRoomNotFound):
 		domErr = errRoomNotFound
+	case errors.Is(err, consts.ErrVersionMismatch):
+		domErr = errVersionMismatch
+	case errors.Is(err, consts.ErrHotelHasActiveBookings):
+		domErr = errHotelHasActiveBookings
+	case errors.Is(err, consts.ErrRoomHasActiveBookings):
+		domErr = errRoomHasActiveBookings
 	case errors.Is(err, consts.ErrUniqueHotelField):
 		domErr = errUniqueHotelField
 	case errors.Is(err, consts.ErrUniqueRoomField):
 		domErr = errUniqueRoomField
+	case errors.Is(err, consts.ErrRatePlanNotFound):
+		domErr = errRatePlanNotFound
+	case errors.Is(err, consts.ErrRatePlanTargetNotFound):
+		domErr = errRatePlanTargetNotFound
+	case errors.Is(err, consts.ErrInvalidRatePlanID):
+		domErr = errInvalidRatePlanID
+	case errors.Is(err, consts.ErrInvalidRoomID):
+		domErr = errInvalidRoomID
+	case errors.Is(err, consts.ErrInvalidHotelID):
+		domErr = errInvalidHotelID
+	case errors.Is(err, consts.ErrInvalidPrice):
+		domErr = errInvalidPrice
+	case errors.Is(err, consts.ErrInvalidStayDates):
+		domErr = errInvalidStayDates
+	case errors.Is(err, consts.ErrStayTooLong):
+		domErr = errStayTooLong
+	case errors.Is(err, consts.ErrStayRestrictionNotFound):
+		domErr = errStayRestrictionNotFound
+	case errors.Is(err, consts.ErrStayRestrictionTargetNotFound):
+		domErr = errStayRestrictionTargetNotFound
+	case errors.Is(err, consts.ErrInvalidStayRestrictionID):
+		domErr = errInvalidStayRestrictionID
+	case errors.Is(err, consts.ErrRoomCategoryNotFound):
+		domErr = errRoomCategoryNotFound
+	case errors.Is(err, consts.ErrInvalidRoomCategoryID):
+		domErr = errInvalidRoomCategoryID
+	case errors.Is(err, consts.ErrUniqueRoomCategoryTitle):
+		domErr = errUniqueRoomCategoryTitle
+	case errors.Is(err, consts.ErrRoomCategoryInUse):
+		domErr = errRoomCategoryInUse
+	case errors.Is(err, consts.ErrRoomBlockNotFound):
+		domErr = errRoomBlockNotFound
+	case errors.Is(err, consts.ErrInvalidRoomBlockID):
+		domErr = errInvalidRoomBlockID
+	case errors.Is(err, consts.ErrRoomUnavailable):
+		domErr = errRoomUnavailable
+	case errors.Is(err, consts.ErrImageNotFound):
+		domErr = errImageNotFound
+	case errors.Is(err, consts.ErrInvalidImageID):
+		domErr = errInvalidImageID
+	case errors.Is(err, consts.ErrImageTooLarge):
+		domErr = errImageTooLarge
+	case errors.Is(err, consts.ErrUnsupportedImageType):
+		domErr = errUnsupportedImageType
+	case errors.Is(err, consts.ErrInvalidImage):
+		domErr = errInvalidImage
+	case errors.Is(err, consts.ErrImageTargetRequired):
+		domErr = errImageTargetRequired
+	case errors.Is(err, consts.ErrImageOrderMismatch):
+		domErr = errImageOrderMismatch
+	case errors.Is(err, consts.ErrAmenityNotFound):
+		domErr = errAmenityNotFound
+	case errors.Is(err, consts.ErrUniqueAmenityCode):
+		domErr = errUniqueAmenityCode
+	case errors.Is(err, consts.ErrAmenityInUse):
+		domErr = errAmenityInUse
+	case errors.Is(err, consts.ErrCityNotFound):
+		domErr = errCityNotFound
+	case errors.Is(err, consts.ErrInvalidTimezone):
+		domErr = errInvalidTimezone
+	case errors.Is(err, consts.ErrHotelPolicyNotFound):
+		domErr = errHotelPolicyNotFound
+	case errors.Is(err, consts.ErrInvalidCancellationTiers):
+		domErr = errInvalidCancellationTiers
+	case errors.Is(err, consts.ErrInvalidChildAgeBands):
+		domErr = errInvalidChildAgeBands
+	case errors.Is(err, consts.ErrInvalidPetPolicy):
+		domErr = errInvalidPetPolicy
+	case errors.Is(err, consts.ErrInvalidHotelTransition):
+		domErr = errInvalidHotelTransition
+	case errors.Is(err, consts.ErrHotelActionForbidden):
+		domErr = errHotelActionForbidden
+	case errors.Is(err, consts.ErrReasonRequired):
+		domErr = errReasonRequired
+	case errors.Is(err, consts.ErrUnauthenticated):
+		domErr = errUnauthenticated
+	case errors.Is(err, consts.ErrInvalidToken):
+		domErr = errInvalidToken
+	case errors.Is(err, consts.ErrUnknownAmenity):
+		return handleUnknownAmenityErr(err)
 
 	default:
 		domErr = errInternalServer
@@ -51,3 +191,26 @@ func HandleDomainErr(err error) error {
 	st, _ := status.New(domErr.code, "operation failed").WithDetails(ei)
 	return st.Err()
 }
+
+// handleUnknownAmenityErr reports every rejected code as a field violation so clients can fix the whole list at once.
+func handleUnknownAmenityErr(err error) error {
+	br := &errdetails.BadRequest{}
+
+	var unknownErr *models.UnknownAmenityError
+	if errors.As(err, &unknownErr) {
+		for _, code := range unknownErr.Codes {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "amenities",
+				Description: consts.MsgUnknownAmenity + ": " + code,
+			})
+		}
+	}
+
+	ei := &errdetails.ErrorInfo{
+		Reason: consts.MsgUnknownAmenity,
+		Domain: "user-service",
+	}
+
+	st, _ := status.New(codes.InvalidArgument, "operation failed").WithDetails(ei, br)
+	return st.Err()
+}
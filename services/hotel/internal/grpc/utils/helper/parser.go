@@ -14,3 +14,78 @@ func ParseRoomID(roomID string) (uuid.UUID, error) {
 
 	return id, nil
 }
+
+func ParseRatePlanID(ratePlanID string) (uuid.UUID, error) {
+	id, err := uuid.Parse(ratePlanID)
+	if err != nil {
+		return uuid.UUID{}, consts.ErrInvalidRatePlanID
+	}
+
+	return id, nil
+}
+
+func ParseStayRestrictionID(restrictionID string) (uuid.UUID, error) {
+	id, err := uuid.Parse(restrictionID)
+	if err != nil {
+		return uuid.UUID{}, consts.ErrInvalidStayRestrictionID
+	}
+
+	return id, nil
+}
+
+func ParseRoomCategoryID(categoryID string) (uuid.UUID, error) {
+	id, err := uuid.Parse(categoryID)
+	if err != nil {
+		return uuid.UUID{}, consts.ErrInvalidRoomCategoryID
+	}
+
+	return id, nil
+}
+
+func ParseRoomBlockID(blockID string) (uuid.UUID, error) {
+	id, err := uuid.Parse(blockID)
+	if err != nil {
+		return uuid.UUID{}, consts.ErrInvalidRoomBlockID
+	}
+
+	return id, nil
+}
+
+func ParseImageID(imageID string) (uuid.UUID, error) {
+	id, err := uuid.Parse(imageID)
+	if err != nil {
+		return uuid.UUID{}, consts.ErrInvalidImageID
+	}
+
+	return id, nil
+}
+
+func ParseHotelID(hotelID string) (uuid.UUID, error) {
+	id, err := uuid.Parse(hotelID)
+	if err != nil {
+		return uuid.UUID{}, consts.ErrInvalidHotelID
+	}
+
+	return id, nil
+}
+
+func ParseHotelIDs(hotelIDs []string) ([]uuid.UUID, error) {
+	return parseIDs(hotelIDs, ParseHotelID)
+}
+
+func ParseRoomIDs(roomIDs []string) ([]uuid.UUID, error) {
+	return parseIDs(roomIDs, ParseRoomID)
+}
+
+func parseIDs(raw []string, parse func(string) (uuid.UUID, error)) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(raw))
+	for i, s := range raw {
+		id, err := parse(s)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,54 @@
+package helper
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"hotel/pkg/lib/utils/consts"
+)
+
+// ValidateUpdateMask checks that the mask names at least one top-level field of fields
+// and that every masked field listed in required is set. Format rules of the fields
+// themselves are left to protovalidate.
+func ValidateUpdateMask(mask *fieldmaskpb.FieldMask, fields proto.Message, required ...string) error {
+	msg := fields.ProtoReflect()
+	descriptors := msg.Descriptor().Fields()
+
+	br := &errdetails.BadRequest{}
+	if len(mask.GetPaths()) == 0 {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "update_mask",
+			Description: consts.MsgEmptyPatch,
+		})
+	}
+	for _, path := range mask.GetPaths() {
+		fd := descriptors.ByName(protoreflect.Name(path))
+		if fd == nil {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "update_mask",
+				Description: fmt.Sprintf(consts.MsgUnknownPatchField, path),
+			})
+			continue
+		}
+		if slices.Contains(required, path) && !msg.Has(fd) {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       path,
+				Description: consts.FieldRequired,
+			})
+		}
+	}
+
+	if len(br.FieldViolations) == 0 {
+		return nil
+	}
+
+	st, _ := status.New(codes.InvalidArgument, "validation failed").WithDetails(br)
+	return st.Err()
+}
@@ -0,0 +1,61 @@
+package mapper
+
+import (
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func amenityCategoryToDomain(category hotelv1.AmenityCategory) models.AmenityCategory {
+	var r models.AmenityCategory
+	switch category {
+	case hotelv1.AmenityCategory_AMENITY_CATEGORY_GENERAL:
+		r = models.AmenityCategoryGeneral
+	case hotelv1.AmenityCategory_AMENITY_CATEGORY_INTERNET:
+		r = models.AmenityCategoryInternet
+	case hotelv1.AmenityCategory_AMENITY_CATEGORY_BATHROOM:
+		r = models.AmenityCategoryBathroom
+	case hotelv1.AmenityCategory_AMENITY_CATEGORY_KITCHEN:
+		r = models.AmenityCategoryKitchen
+	case hotelv1.AmenityCategory_AMENITY_CATEGORY_FOOD_AND_DRINK:
+		r = models.AmenityCategoryFoodAndDrink
+	case hotelv1.AmenityCategory_AMENITY_CATEGORY_ENTERTAINMENT:
+		r = models.AmenityCategoryEntertainment
+	case hotelv1.AmenityCategory_AMENITY_CATEGORY_WELLNESS:
+		r = models.AmenityCategoryWellness
+	case hotelv1.AmenityCategory_AMENITY_CATEGORY_PARKING:
+		r = models.AmenityCategoryParking
+	case hotelv1.AmenityCategory_AMENITY_CATEGORY_ACCESSIBILITY:
+		r = models.AmenityCategoryAccessibility
+	case hotelv1.AmenityCategory_AMENITY_CATEGORY_OTHER:
+		r = models.AmenityCategoryOther
+	default:
+		r = models.AmenityCategoryUnspecified
+	}
+	return r
+}
+
+func CreateAmenityRequestToDomain(req *hotelv1.CreateAmenityRequest) *models.CreateAmenity {
+	return &models.CreateAmenity{
+		Names:    req.Names.GetNames(),
+		Code:     req.Code,
+		Category: amenityCategoryToDomain(req.Category),
+		Icon:     req.Icon,
+	}
+}
+
+func UpdateAmenityRequestToDomain(req *hotelv1.UpdateAmenityRequest) *models.UpdateAmenity {
+	return &models.UpdateAmenity{
+		Names:    req.Names.GetNames(),
+		Category: amenityCategoryToDomain(req.Category),
+		Icon:     req.Icon,
+	}
+}
+
+func GetAmenitiesRequestToDomain(req *hotelv1.GetAmenitiesRequest) *models.AmenityCategory {
+	if req.Category == nil {
+		return nil
+	}
+
+	category := amenityCategoryToDomain(*req.Category)
+	return &category
+}
@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func amenityCategoryToProto(category models.AmenityCategory) hotelv1.AmenityCategory {
+	var r hotelv1.AmenityCategory
+	switch category {
+	case models.AmenityCategoryGeneral:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_GENERAL
+	case models.AmenityCategoryInternet:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_INTERNET
+	case models.AmenityCategoryBathroom:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_BATHROOM
+	case models.AmenityCategoryKitchen:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_KITCHEN
+	case models.AmenityCategoryFoodAndDrink:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_FOOD_AND_DRINK
+	case models.AmenityCategoryEntertainment:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_ENTERTAINMENT
+	case models.AmenityCategoryWellness:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_WELLNESS
+	case models.AmenityCategoryParking:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_PARKING
+	case models.AmenityCategoryAccessibility:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_ACCESSIBILITY
+	case models.AmenityCategoryOther:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_OTHER
+	default:
+		r = hotelv1.AmenityCategory_AMENITY_CATEGORY_UNSPECIFIED
+	}
+	return r
+}
+
+func AmenityResponseToProto(resp *models.Amenity) *hotelv1.Amenity {
+	return &hotelv1.Amenity{
+		Code:      resp.Code,
+		Category:  amenityCategoryToProto(resp.Category),
+		Icon:      resp.Icon,
+		Names:     resp.Names,
+		CreatedAt: timestamppb.New(resp.CreatedAt),
+		UpdatedAt: timestamppb.New(resp.UpdatedAt),
+	}
+}
+
+func AmenitiesResponseToProto(resp []*models.Amenity) []*hotelv1.Amenity {
+	amenities := make([]*hotelv1.Amenity, len(resp))
+	for i, a := range resp {
+		amenities[i] = AmenityResponseToProto(a)
+	}
+	return amenities
+}
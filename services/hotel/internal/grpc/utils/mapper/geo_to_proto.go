@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func CountriesResponseToProto(resp []*models.Country) []*hotelv1.Country {
+	countries := make([]*hotelv1.Country, len(resp))
+	for i, c := range resp {
+		countries[i] = &hotelv1.Country{
+			Code:       c.Code,
+			IsoAlpha3:  c.ISOAlpha3,
+			Names:      c.Names,
+			HotelCount: c.HotelCount,
+		}
+	}
+	return countries
+}
+
+func CitiesResponseToProto(resp []*models.City) []*hotelv1.City {
+	cities := make([]*hotelv1.City, len(resp))
+	for i, c := range resp {
+		cities[i] = &hotelv1.City{
+			CountryCode: c.CountryCode,
+			Slug:        c.Slug,
+			Names:       c.Names,
+			Timezone:    c.Timezone,
+			Centroid:    locationResponseToProto(&c.Centroid),
+			HotelCount:  c.HotelCount,
+		}
+	}
+	return cities
+}
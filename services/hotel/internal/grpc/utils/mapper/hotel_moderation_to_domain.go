@@ -0,0 +1,30 @@
+package mapper
+
+import (
+	"hotel/internal/repository/models"
+)
+
+func HotelViewerToDomain(actor models.Actor) models.HotelViewer {
+	return models.HotelViewer{
+		ID:   actor.ID,
+		Role: actor.Role,
+	}
+}
+
+// HotelStatusChangeToDomain builds the change the authenticated actor asks
+// for; reason is left empty for actions that do not take one.
+func HotelStatusChangeToDomain(
+	actor models.Actor,
+	to models.HotelStatus,
+	reason string,
+) *models.HotelStatusChange {
+	c := &models.HotelStatusChange{
+		To:        to,
+		ActorID:   actor.ID,
+		ActorRole: actor.Role,
+	}
+	if reason != "" {
+		c.Reason = &reason
+	}
+	return c
+}
@@ -0,0 +1,61 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func hotelStatusToProto(status models.HotelStatus) hotelv1.HotelStatus {
+	var s hotelv1.HotelStatus
+	switch status {
+	case models.HotelStatusDraft:
+		s = hotelv1.HotelStatus_HOTEL_STATUS_DRAFT
+	case models.HotelStatusPendingReview:
+		s = hotelv1.HotelStatus_HOTEL_STATUS_PENDING_REVIEW
+	case models.HotelStatusPublished:
+		s = hotelv1.HotelStatus_HOTEL_STATUS_PUBLISHED
+	case models.HotelStatusSuspended:
+		s = hotelv1.HotelStatus_HOTEL_STATUS_SUSPENDED
+	default:
+		s = hotelv1.HotelStatus_HOTEL_STATUS_UNSPECIFIED
+	}
+	return s
+}
+
+func userRoleToProto(role models.UserRole) hotelv1.UserRole {
+	var r hotelv1.UserRole
+	switch role {
+	case models.UserRoleUser:
+		r = hotelv1.UserRole_USER_ROLE_USER
+	case models.UserRoleModerator:
+		r = hotelv1.UserRole_USER_ROLE_MODERATOR
+	case models.UserRoleAdmin:
+		r = hotelv1.UserRole_USER_ROLE_ADMIN
+	default:
+		r = hotelv1.UserRole_USER_ROLE_UNSPECIFIED
+	}
+	return r
+}
+
+func HotelStatusTransitionResponseToProto(resp *models.HotelStatusTransition) *hotelv1.HotelStatusTransition {
+	return &hotelv1.HotelStatusTransition{
+		Id:         resp.ID.String(),
+		HotelId:    resp.HotelID.String(),
+		FromStatus: hotelStatusToProto(resp.From),
+		ToStatus:   hotelStatusToProto(resp.To),
+		ActorId:    resp.ActorID,
+		ActorRole:  userRoleToProto(resp.ActorRole),
+		Reason:     resp.Reason,
+		CreatedAt:  timestamppb.New(resp.CreatedAt),
+	}
+}
+
+func HotelStatusHistoryResponseToProto(resp []*models.HotelStatusTransition) []*hotelv1.HotelStatusTransition {
+	transitions := make([]*hotelv1.HotelStatusTransition, len(resp))
+	for i, t := range resp {
+		transitions[i] = HotelStatusTransitionResponseToProto(t)
+	}
+	return transitions
+}
@@ -0,0 +1,65 @@
+package mapper
+
+import (
+	"github.com/shopspring/decimal"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func cancellationPenaltyToDomain(penalty hotelv1.CancellationPenalty) models.CancellationPenalty {
+	var r models.CancellationPenalty
+	switch penalty {
+	case hotelv1.CancellationPenalty_CANCELLATION_PENALTY_NONE:
+		r = models.CancellationPenaltyNone
+	case hotelv1.CancellationPenalty_CANCELLATION_PENALTY_FIRST_NIGHT:
+		r = models.CancellationPenaltyFirstNight
+	case hotelv1.CancellationPenalty_CANCELLATION_PENALTY_PERCENT:
+		r = models.CancellationPenaltyPercent
+	case hotelv1.CancellationPenalty_CANCELLATION_PENALTY_FULL_STAY:
+		r = models.CancellationPenaltyFullStay
+	default:
+		r = models.CancellationPenaltyUnspecified
+	}
+	return r
+}
+
+func SetHotelPolicyRequestToDomain(req *hotelv1.SetHotelPolicyRequest) (*models.SetHotelPolicy, error) {
+	p := &models.SetHotelPolicy{
+		LatestCheckInTime: req.LatestCheckInTime,
+		CancellationTiers: make([]models.CancellationTier, len(req.CancellationTiers)),
+		ChildAgeBands:     make([]models.ChildAgeBand, len(req.ChildAgeBands)),
+		Pets: models.PetPolicy{
+			Allowed: req.Pets.Allowed,
+			MaxPets: req.Pets.MaxPets,
+		},
+		PrepaymentPercent: req.PrepaymentPercent,
+	}
+
+	for i, tier := range req.CancellationTiers {
+		p.CancellationTiers[i] = models.CancellationTier{
+			Penalty:            cancellationPenaltyToDomain(tier.Penalty),
+			HoursBeforeCheckIn: tier.HoursBeforeCheckIn,
+			PenaltyPercent:     tier.PenaltyPercent,
+		}
+	}
+
+	for i, band := range req.ChildAgeBands {
+		p.ChildAgeBands[i] = models.ChildAgeBand{
+			MinAge:       band.MinAge,
+			MaxAge:       band.MaxAge,
+			PricePercent: band.PricePercent,
+		}
+	}
+
+	if req.Pets.FeePerNight != nil {
+		fee, err := decimal.NewFromString(*req.Pets.FeePerNight)
+		if err != nil {
+			return nil, consts.ErrInvalidPrice
+		}
+		p.Pets.FeePerNight = &fee
+	}
+
+	return p, nil
+}
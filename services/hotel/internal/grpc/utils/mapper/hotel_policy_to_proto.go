@@ -0,0 +1,66 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func cancellationPenaltyToProto(penalty models.CancellationPenalty) hotelv1.CancellationPenalty {
+	switch penalty {
+	case models.CancellationPenaltyNone:
+		return hotelv1.CancellationPenalty_CANCELLATION_PENALTY_NONE
+	case models.CancellationPenaltyFirstNight:
+		return hotelv1.CancellationPenalty_CANCELLATION_PENALTY_FIRST_NIGHT
+	case models.CancellationPenaltyPercent:
+		return hotelv1.CancellationPenalty_CANCELLATION_PENALTY_PERCENT
+	case models.CancellationPenaltyFullStay:
+		return hotelv1.CancellationPenalty_CANCELLATION_PENALTY_FULL_STAY
+	default:
+		return hotelv1.CancellationPenalty_CANCELLATION_PENALTY_UNSPECIFIED
+	}
+}
+
+func HotelPolicyResponseToProto(resp *models.HotelPolicy) *hotelv1.HotelPolicy {
+	if resp == nil {
+		return nil
+	}
+
+	p := &hotelv1.HotelPolicy{
+		HotelId:           resp.HotelID.String(),
+		CancellationTiers: make([]*hotelv1.CancellationTier, len(resp.CancellationTiers)),
+		PrepaymentPercent: resp.PrepaymentPercent,
+		ChildAgeBands:     make([]*hotelv1.ChildAgeBand, len(resp.ChildAgeBands)),
+		Pets: &hotelv1.PetPolicy{
+			Allowed: resp.Pets.Allowed,
+			MaxPets: resp.Pets.MaxPets,
+		},
+		LatestCheckInTime: resp.LatestCheckInTime,
+		CreatedAt:         timestamppb.New(resp.CreatedAt),
+		UpdatedAt:         timestamppb.New(resp.UpdatedAt),
+	}
+
+	for i, tier := range resp.CancellationTiers {
+		p.CancellationTiers[i] = &hotelv1.CancellationTier{
+			HoursBeforeCheckIn: tier.HoursBeforeCheckIn,
+			Penalty:            cancellationPenaltyToProto(tier.Penalty),
+			PenaltyPercent:     tier.PenaltyPercent,
+		}
+	}
+
+	for i, band := range resp.ChildAgeBands {
+		p.ChildAgeBands[i] = &hotelv1.ChildAgeBand{
+			MinAge:       band.MinAge,
+			MaxAge:       band.MaxAge,
+			PricePercent: band.PricePercent,
+		}
+	}
+
+	if resp.Pets.FeePerNight != nil {
+		fee := resp.Pets.FeePerNight.StringFixed(2)
+		p.Pets.FeePerNight = &fee
+	}
+
+	return p
+}
@@ -1,6 +1,10 @@
 package mapper
 
 import (
+	"slices"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
 	hotelv1 "hotel/api/hotel/v1"
 	"hotel/internal/repository/models"
 )
@@ -33,13 +37,16 @@ func GetHotelRefRequestToDomain[T hotelRefGetter](req T) models.HotelRef {
 
 func CreateHotelRequestToDomain(req *hotelv1.CreateHotelRequest) *models.CreateHotel {
 	return &models.CreateHotel{
-		CountryCode: req.CountryCode,
-		CitySlug:    req.CitySlug,
-		Title:       req.Title,
-		OwnerID:     req.OwnerId,
-		Description: req.Description,
-		Address:     req.Address,
-		Location:    locationRequestToDomain(req.Location),
+		CountryCode:  req.CountryCode,
+		CitySlug:     req.CitySlug,
+		Title:        req.Title,
+		OwnerID:      req.OwnerId,
+		Description:  req.Description,
+		Address:      req.Address,
+		Location:     locationRequestToDomain(req.Location),
+		Timezone:     req.Timezone,
+		CheckInTime:  req.CheckInTime,
+		CheckOutTime: req.CheckOutTime,
 	}
 }
 
@@ -53,14 +60,45 @@ func GetHotelsRequestToDomain(req *hotelv1.GetHotelsRequest) (uint64, uint64, mo
 
 func UpdateHotelRequestToDomain(req *hotelv1.UpdateHotelRequest) models.UpdateHotel {
 	return models.UpdateHotel{
-		Description: req.Description,
-		Address:     req.Address,
-		Location:    locationRequestToDomain(req.Location),
+		Description:     req.Description,
+		Address:         req.Address,
+		Location:        locationRequestToDomain(req.Location),
+		Timezone:        req.Timezone,
+		CheckInTime:     req.CheckInTime,
+		CheckOutTime:    req.CheckOutTime,
+		ExpectedVersion: req.ExpectedVersion,
 	}
 }
 
 func UpdateHotelTitleRequestToDomain(req *hotelv1.UpdateHotelTitleRequest) models.UpdateHotelTitle {
 	return models.UpdateHotelTitle{
-		Title: req.Title,
+		Title:           req.Title,
+		ExpectedVersion: req.ExpectedVersion,
 	}
 }
+
+func PatchHotelRequestToDomain(req *hotelv1.PatchHotelRequest) models.PatchHotel {
+	fields := req.Hotel
+	return models.PatchHotel{
+		Description:     &fields.Description,
+		Fields:          updateMaskFields(req.UpdateMask),
+		Address:         fields.Address,
+		Location:        locationRequestToDomain(fields.Location),
+		Timezone:        fields.Timezone,
+		CheckInTime:     fields.CheckInTime,
+		CheckOutTime:    fields.CheckOutTime,
+		ExpectedVersion: req.ExpectedVersion,
+	}
+}
+
+// updateMaskFields returns the masked paths without duplicates, in mask order.
+func updateMaskFields(mask *fieldmaskpb.FieldMask) []string {
+	fields := make([]string, 0, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		if !slices.Contains(fields, path) {
+			fields = append(fields, path)
+		}
+	}
+
+	return fields
+}
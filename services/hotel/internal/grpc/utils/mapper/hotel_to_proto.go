@@ -1,6 +1,9 @@
 package mapper
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	hotelv1 "hotel/api/hotel/v1"
@@ -16,37 +19,80 @@ func locationResponseToProto(l *models.Location) *hotelv1.Location {
 
 func CreateHotelResponseToProto(resp *models.Hotel) *hotelv1.CreateHotel {
 	return &hotelv1.CreateHotel{
-		Id:          resp.ID.String(),
-		HotelSlug:   resp.HotelSlug,
-		Title:       resp.Title,
-		OwnerId:     resp.OwnerID,
-		Description: *resp.Description,
-		Address:     resp.Address,
-		Location:    locationResponseToProto(&resp.Location),
-		CreatedAt:   timestamppb.New(resp.CreatedAt),
-		UpdatedAt:   timestamppb.New(resp.UpdatedAt),
+		Id:           resp.ID.String(),
+		HotelSlug:    resp.HotelSlug,
+		Title:        resp.Title,
+		OwnerId:      resp.OwnerID,
+		Description:  *resp.Description,
+		Address:      resp.Address,
+		Location:     locationResponseToProto(&resp.Location),
+		CreatedAt:    timestamppb.New(resp.CreatedAt),
+		UpdatedAt:    timestamppb.New(resp.UpdatedAt),
+		Timezone:     resp.Timezone,
+		CheckInTime:  resp.CheckInTime,
+		CheckOutTime: resp.CheckOutTime,
+		Version:      resp.Version,
+		Status:       hotelStatusToProto(resp.Status),
 	}
 }
 
 func HotelResponseToProto(resp *models.Hotel) *hotelv1.Hotel {
 	return &hotelv1.Hotel{
-		Id:          resp.ID.String(),
-		Title:       resp.Title,
-		OwnerId:     resp.OwnerID,
-		Description: *resp.Description,
-		Address:     resp.Address,
-		Rating:      resp.Rating,
-		Location:    locationResponseToProto(&resp.Location),
-		CreatedAt:   timestamppb.New(resp.CreatedAt),
-		UpdatedAt:   timestamppb.New(resp.UpdatedAt),
+		Id:           resp.ID.String(),
+		Title:        resp.Title,
+		OwnerId:      resp.OwnerID,
+		Description:  *resp.Description,
+		Address:      resp.Address,
+		Rating:       resp.Rating,
+		Location:     locationResponseToProto(&resp.Location),
+		CreatedAt:    timestamppb.New(resp.CreatedAt),
+		UpdatedAt:    timestamppb.New(resp.UpdatedAt),
+		HotelSlug:    resp.HotelSlug,
+		CountryCode:  resp.CountryCode,
+		CitySlug:     resp.CitySlug,
+		Timezone:     resp.Timezone,
+		CheckInTime:  resp.CheckInTime,
+		CheckOutTime: resp.CheckOutTime,
+		Policy:       HotelPolicyResponseToProto(resp.Policy),
+		Version:      resp.Version,
+		Status:       hotelStatusToProto(resp.Status),
+		DeletedAt:    deletedAtToProto(resp.DeletedAt),
+	}
+}
+
+// deletedAtToProto leaves the timestamp unset for live hotels and rooms.
+func deletedAtToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func HotelListResponseToProto(resp []*models.Hotel) []*hotelv1.Hotel {
+	hotels := make([]*hotelv1.Hotel, len(resp))
+	for i, h := range resp {
+		hotels[i] = HotelResponseToProto(h)
+	}
+	return hotels
+}
+
+func HotelIDsToDomain(resp []*models.Hotel) []uuid.UUID {
+	ids := make([]uuid.UUID, len(resp))
+	for i, h := range resp {
+		ids[i] = h.ID
 	}
+	return ids
 }
 
-func UpdateHotelResponseToProto(resp models.UpdateHotel) *hotelv1.UpdateHotel {
+func UpdateHotelResponseToProto(resp models.UpdateHotel, version int64) *hotelv1.UpdateHotel {
 	return &hotelv1.UpdateHotel{
-		Description: *resp.Description,
-		Address:     resp.Address,
-		Location:    locationResponseToProto(&resp.Location),
+		Description:  *resp.Description,
+		Address:      resp.Address,
+		Location:     locationResponseToProto(&resp.Location),
+		Timezone:     resp.Timezone,
+		CheckInTime:  resp.CheckInTime,
+		CheckOutTime: resp.CheckOutTime,
+		Version:      version,
 	}
 }
 
@@ -54,6 +100,7 @@ func UpdateHotelTitleResponseToProto(resp models.UpdateHotelTitle) *hotelv1.Upda
 	return &hotelv1.UpdateHotelTitle{
 		Title:     resp.Title,
 		HotelSlug: resp.HotelSlug,
+		Version:   resp.Version,
 	}
 }
 
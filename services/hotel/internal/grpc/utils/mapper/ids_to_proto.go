@@ -0,0 +1,21 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+)
+
+// MissingIDsToProto returns the requested ids absent from found, keeping the request order.
+func MissingIDsToProto(requested []uuid.UUID, found []uuid.UUID) []string {
+	seen := make(map[uuid.UUID]struct{}, len(found))
+	for _, id := range found {
+		seen[id] = struct{}{}
+	}
+
+	missing := make([]string, 0)
+	for _, id := range requested {
+		if _, ok := seen[id]; !ok {
+			missing = append(missing, id.String())
+		}
+	}
+	return missing
+}
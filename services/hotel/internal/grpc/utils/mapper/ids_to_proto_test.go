@@ -0,0 +1,59 @@
+package mapper
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"hotel/internal/repository/models"
+)
+
+func TestMissingIDsToProto(t *testing.T) {
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name      string
+		requested []uuid.UUID
+		found     []uuid.UUID
+		want      []string
+	}{
+		{name: "all found", requested: []uuid.UUID{first, second}, found: []uuid.UUID{second, first}, want: []string{}},
+		{
+			name:      "missing keep the request order",
+			requested: []uuid.UUID{third, first, second},
+			found:     []uuid.UUID{first},
+			want:      []string{third.String(), second.String()},
+		},
+		{name: "none found", requested: []uuid.UUID{first}, want: []string{first.String()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MissingIDsToProto(tt.requested, tt.found); !slices.Equal(got, tt.want) {
+				t.Errorf("MissingIDsToProto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeletedAtToProto(t *testing.T) {
+	deletedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	description := ""
+
+	live := HotelResponseToProto(&models.Hotel{ID: uuid.New(), Description: &description})
+	if live.DeletedAt != nil {
+		t.Errorf("live hotel deleted_at = %v, want unset", live.DeletedAt)
+	}
+
+	hotel := HotelResponseToProto(&models.Hotel{ID: uuid.New(), Description: &description, DeletedAt: &deletedAt})
+	if hotel.DeletedAt == nil || !hotel.DeletedAt.AsTime().Equal(deletedAt) {
+		t.Errorf("deleted hotel deleted_at = %v, want %s", hotel.DeletedAt, deletedAt)
+	}
+
+	room := RoomResponseToProto(&models.Room{ID: uuid.New(), DeletedAt: &deletedAt})
+	if room.DeletedAt == nil || !room.DeletedAt.AsTime().Equal(deletedAt) {
+		t.Errorf("deleted room deleted_at = %v, want %s", room.DeletedAt, deletedAt)
+	}
+}
@@ -0,0 +1,38 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func ImageTargetToDomain(req *hotelv1.ImageTarget) (models.ImageTarget, error) {
+	target := models.ImageTarget{
+		HotelRef: GetHotelRefRequestToDomain(req),
+	}
+
+	if req.RoomId != nil {
+		roomID, err := uuid.Parse(*req.RoomId)
+		if err != nil {
+			return models.ImageTarget{}, consts.ErrInvalidRoomID
+		}
+		target.RoomID = &roomID
+	}
+
+	return target, nil
+}
+
+func ImageIDsToDomain(ids []string) ([]uuid.UUID, error) {
+	imageIDs := make([]uuid.UUID, len(ids))
+	for i, idStr := range ids {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, consts.ErrInvalidImageID
+		}
+		imageIDs[i] = id
+	}
+
+	return imageIDs, nil
+}
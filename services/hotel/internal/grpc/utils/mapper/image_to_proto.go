@@ -0,0 +1,42 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func ImageResponseToProto(resp *models.Image) *hotelv1.Image {
+	img := &hotelv1.Image{
+		Id:          resp.ID.String(),
+		HotelId:     resp.HotelID.String(),
+		Position:    uint32(resp.Position),
+		ContentType: resp.ContentType,
+		SizeBytes:   uint64(resp.SizeBytes),
+		Width:       uint32(resp.Width),
+		Height:      uint32(resp.Height),
+		Urls: &hotelv1.ImageUrls{
+			Original:      resp.URLs.Original,
+			Thumbnail:     resp.URLs.Thumbnail,
+			ThumbnailWebp: resp.URLs.ThumbnailWebP,
+			Webp:          resp.URLs.WebP,
+		},
+		CreatedAt: timestamppb.New(resp.CreatedAt),
+	}
+
+	if resp.RoomID != nil {
+		roomID := resp.RoomID.String()
+		img.RoomId = &roomID
+	}
+
+	return img
+}
+
+func ImagesResponseToProto(resp []*models.Image) []*hotelv1.Image {
+	images := make([]*hotelv1.Image, len(resp))
+	for i, img := range resp {
+		images[i] = ImageResponseToProto(img)
+	}
+	return images
+}
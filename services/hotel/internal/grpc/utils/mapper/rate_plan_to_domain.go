@@ -0,0 +1,89 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func weekdayModifiersToDomain(req []*hotelv1.WeekdayModifierRequest) (models.WeekdayAdjustments, error) {
+	var adjustments models.WeekdayAdjustments
+	for _, m := range req {
+		adj, err := decimal.NewFromString(m.AdjustmentPercent)
+		if err != nil {
+			return models.WeekdayAdjustments{}, consts.ErrInvalidPrice
+		}
+		adjustments[m.DayOfWeek-1] = adj
+	}
+
+	return adjustments, nil
+}
+
+func CreateRatePlanRequestToDomain(req *hotelv1.CreateRatePlanRequest) (*models.CreateRatePlan, error) {
+	price, err := decimal.NewFromString(req.Price)
+	if err != nil {
+		return nil, consts.ErrInvalidPrice
+	}
+
+	adjustments, err := weekdayModifiersToDomain(req.WeekdayModifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	rp := &models.CreateRatePlan{
+		Title: req.Title,
+		StayRange: models.DateRange{
+			Start: req.StartDate.AsTime(),
+			End:   req.EndDate.AsTime(),
+		},
+		Price:              price,
+		WeekdayAdjustments: adjustments,
+	}
+
+	if req.RoomId != nil {
+		roomID, err := uuid.Parse(*req.RoomId)
+		if err != nil {
+			return nil, consts.ErrInvalidRoomID
+		}
+		rp.RoomID = &roomID
+	}
+
+	if req.RoomType != hotelv1.RoomType_ROOM_TYPE_UNSPECIFIED {
+		roomType := roomTypeToDomain(req.RoomType)
+		rp.RoomType = &roomType
+	}
+
+	return rp, nil
+}
+
+func UpdateRatePlanRequestToDomain(req *hotelv1.UpdateRatePlanRequest) (*models.UpdateRatePlan, error) {
+	price, err := decimal.NewFromString(req.Price)
+	if err != nil {
+		return nil, consts.ErrInvalidPrice
+	}
+
+	adjustments, err := weekdayModifiersToDomain(req.WeekdayModifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UpdateRatePlan{
+		Title: req.Title,
+		StayRange: models.DateRange{
+			Start: req.StartDate.AsTime(),
+			End:   req.EndDate.AsTime(),
+		},
+		Price:              price,
+		WeekdayAdjustments: adjustments,
+	}, nil
+}
+
+func QuoteStayRequestToDomain(req *hotelv1.QuoteStayRequest) models.DateRange {
+	return models.DateRange{
+		Start: req.CheckIn.AsTime(),
+		End:   req.CheckOut.AsTime(),
+	}
+}
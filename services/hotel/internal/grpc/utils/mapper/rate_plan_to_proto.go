@@ -0,0 +1,80 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func weekdayModifiersToProto(adjustments models.WeekdayAdjustments) []*hotelv1.WeekdayModifier {
+	var modifiers []*hotelv1.WeekdayModifier
+	for i, adj := range adjustments {
+		if adj.IsZero() {
+			continue
+		}
+		modifiers = append(
+			modifiers, &hotelv1.WeekdayModifier{
+				DayOfWeek:         uint32(i + 1),
+				AdjustmentPercent: adj.StringFixed(2),
+			},
+		)
+	}
+
+	return modifiers
+}
+
+func RatePlanResponseToProto(resp *models.RatePlan) *hotelv1.RatePlan {
+	rp := &hotelv1.RatePlan{
+		Id:               resp.ID.String(),
+		HotelId:          resp.HotelID.String(),
+		Title:            resp.Title,
+		StartDate:        timestamppb.New(resp.StayRange.Start),
+		EndDate:          timestamppb.New(resp.StayRange.End),
+		Price:            resp.Price.StringFixed(2),
+		WeekdayModifiers: weekdayModifiersToProto(resp.WeekdayAdjustments),
+		CreatedAt:        timestamppb.New(resp.CreatedAt),
+		UpdatedAt:        timestamppb.New(resp.UpdatedAt),
+	}
+
+	if resp.RoomID != nil {
+		roomID := resp.RoomID.String()
+		rp.RoomId = &roomID
+	}
+	if resp.RoomType != nil {
+		rp.RoomType = roomTypeToProto(*resp.RoomType)
+	}
+
+	return rp
+}
+
+func RatePlansResponseToProto(resp []*models.RatePlan) []*hotelv1.RatePlan {
+	ratePlans := make([]*hotelv1.RatePlan, len(resp))
+	for i, rp := range resp {
+		ratePlans[i] = RatePlanResponseToProto(rp)
+	}
+
+	return ratePlans
+}
+
+func StayQuoteResponseToProto(resp *models.StayQuote) *hotelv1.StayQuote {
+	nights := make([]*hotelv1.NightlyRate, len(resp.Nights))
+	for i, n := range resp.Nights {
+		nights[i] = &hotelv1.NightlyRate{
+			Date:  timestamppb.New(n.Date),
+			Price: n.Price.StringFixed(2),
+		}
+		if n.RatePlanID != nil {
+			ratePlanID := n.RatePlanID.String()
+			nights[i].RatePlanId = &ratePlanID
+		}
+	}
+
+	return &hotelv1.StayQuote{
+		RoomId:      resp.RoomID.String(),
+		CheckIn:     timestamppb.New(resp.StayRange.Start),
+		CheckOut:    timestamppb.New(resp.StayRange.End),
+		Nights:      nights,
+		TotalAmount: resp.TotalAmount.StringFixed(2),
+	}
+}
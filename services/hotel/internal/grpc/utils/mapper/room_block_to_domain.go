@@ -0,0 +1,41 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func roomBlockReasonToDomain(reason hotelv1.RoomBlockReason) models.RoomBlockReason {
+	var r models.RoomBlockReason
+	switch reason {
+	case hotelv1.RoomBlockReason_ROOM_BLOCK_REASON_MAINTENANCE:
+		r = models.RoomBlockReasonMaintenance
+	case hotelv1.RoomBlockReason_ROOM_BLOCK_REASON_OWNER_USE:
+		r = models.RoomBlockReasonOwnerUse
+	case hotelv1.RoomBlockReason_ROOM_BLOCK_REASON_RENOVATION:
+		r = models.RoomBlockReasonRenovation
+	default:
+		r = models.RoomBlockReasonUnspecified
+	}
+	return r
+}
+
+func CreateRoomBlockRequestToDomain(req *hotelv1.CreateRoomBlockRequest) (*models.CreateRoomBlock, error) {
+	roomID, err := uuid.Parse(req.RoomId)
+	if err != nil {
+		return nil, consts.ErrInvalidRoomID
+	}
+
+	return &models.CreateRoomBlock{
+		Note: req.Note,
+		StayRange: models.DateRange{
+			Start: req.StartDate.AsTime(),
+			End:   req.EndDate.AsTime(),
+		},
+		Reason: roomBlockReasonToDomain(req.Reason),
+		RoomID: roomID,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func roomBlockReasonToProto(reason models.RoomBlockReason) hotelv1.RoomBlockReason {
+	var r hotelv1.RoomBlockReason
+	switch reason {
+	case models.RoomBlockReasonMaintenance:
+		r = hotelv1.RoomBlockReason_ROOM_BLOCK_REASON_MAINTENANCE
+	case models.RoomBlockReasonOwnerUse:
+		r = hotelv1.RoomBlockReason_ROOM_BLOCK_REASON_OWNER_USE
+	case models.RoomBlockReasonRenovation:
+		r = hotelv1.RoomBlockReason_ROOM_BLOCK_REASON_RENOVATION
+	default:
+		r = hotelv1.RoomBlockReason_ROOM_BLOCK_REASON_UNSPECIFIED
+	}
+	return r
+}
+
+func RoomBlockResponseToProto(resp *models.RoomBlock) *hotelv1.RoomBlock {
+	return &hotelv1.RoomBlock{
+		Id:        resp.ID.String(),
+		RoomId:    resp.RoomID.String(),
+		StartDate: timestamppb.New(resp.StayRange.Start),
+		EndDate:   timestamppb.New(resp.StayRange.End),
+		Reason:    roomBlockReasonToProto(resp.Reason),
+		Note:      resp.Note,
+		CreatedAt: timestamppb.New(resp.CreatedAt),
+		UpdatedAt: timestamppb.New(resp.UpdatedAt),
+	}
+}
+
+func RoomBlocksResponseToProto(resp []*models.RoomBlock) []*hotelv1.RoomBlock {
+	blocks := make([]*hotelv1.RoomBlock, len(resp))
+	for i, rb := range resp {
+		blocks[i] = RoomBlockResponseToProto(rb)
+	}
+
+	return blocks
+}
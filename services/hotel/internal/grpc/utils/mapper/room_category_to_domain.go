@@ -0,0 +1,60 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func CreateRoomCategoryRequestToDomain(req *hotelv1.CreateRoomCategoryRequest) (*models.CreateRoomCategory, error) {
+	price, err := decimal.NewFromString(req.Price)
+	if err != nil {
+		return nil, consts.ErrInvalidPrice
+	}
+
+	return &models.CreateRoomCategory{
+		Description: req.Description,
+		Title:       req.Title,
+		Type:        roomTypeToDomain(req.Type),
+		Price:       price,
+		Amenities:   req.Amenities,
+		Images:      req.Images,
+		Capacity:    int(req.Capacity),
+		AreaSqm:     float64(req.AreaSqm),
+	}, nil
+}
+
+func UpdateRoomCategoryRequestToDomain(req *hotelv1.UpdateRoomCategoryRequest) (*models.UpdateRoomCategory, error) {
+	price, err := decimal.NewFromString(req.Price)
+	if err != nil {
+		return nil, consts.ErrInvalidPrice
+	}
+
+	return &models.UpdateRoomCategory{
+		ExpectedVersion: req.ExpectedVersion,
+		Description:     req.Description,
+		Title:           req.Title,
+		Type:            roomTypeToDomain(req.Type),
+		Price:           price,
+		Amenities:       req.Amenities,
+		Images:          req.Images,
+		Capacity:        int(req.Capacity),
+		AreaSqm:         float64(req.AreaSqm),
+	}, nil
+}
+
+func AssignRoomCategoryRequestToDomain(req *hotelv1.AssignRoomCategoryRequest) (models.AssignRoomCategory, error) {
+	a := models.AssignRoomCategory{ExpectedVersion: req.ExpectedVersion}
+	if req.CategoryId != nil {
+		categoryID, err := uuid.Parse(*req.CategoryId)
+		if err != nil {
+			return models.AssignRoomCategory{}, consts.ErrInvalidRoomCategoryID
+		}
+		a.CategoryID = &categoryID
+	}
+
+	return a, nil
+}
@@ -0,0 +1,40 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func RoomCategoryResponseToProto(resp *models.RoomCategory) *hotelv1.RoomCategory {
+	roomIDs := make([]string, len(resp.RoomIDs))
+	for i, id := range resp.RoomIDs {
+		roomIDs[i] = id.String()
+	}
+
+	return &hotelv1.RoomCategory{
+		Id:          resp.ID.String(),
+		HotelId:     resp.HotelID.String(),
+		Title:       resp.Title,
+		Description: resp.Description,
+		Type:        roomTypeToProto(resp.Type),
+		Price:       resp.Price.StringFixed(2),
+		Capacity:    int64(resp.Capacity),
+		AreaSqm:     float32(resp.AreaSqm),
+		Amenities:   resp.Amenities,
+		Images:      resp.Images,
+		RoomIds:     roomIDs,
+		Version:     resp.Version,
+		CreatedAt:   timestamppb.New(resp.CreatedAt),
+		UpdatedAt:   timestamppb.New(resp.UpdatedAt),
+	}
+}
+
+func RoomCategoriesResponseToProto(resp []*models.RoomCategory) []*hotelv1.RoomCategory {
+	categories := make([]*hotelv1.RoomCategory, len(resp))
+	for i, c := range resp {
+		categories[i] = RoomCategoryResponseToProto(c)
+	}
+	return categories
+}
@@ -1,6 +1,8 @@
 package mapper
 
 import (
+	"slices"
+
 	"github.com/shopspring/decimal"
 
 	hotelv1 "hotel/api/hotel/v1"
@@ -17,6 +19,8 @@ func roomTypeToDomain(status hotelv1.RoomType) models.RoomType {
 		s = models.RoomTypeDouble
 	case hotelv1.RoomType_ROOM_TYPE_SUITE:
 		s = models.RoomTypeSuite
+	case hotelv1.RoomType_ROOM_TYPE_DELUXE:
+		s = models.RoomTypeDeluxe
 	case hotelv1.RoomType_ROOM_TYPE_FAMILY:
 		s = models.RoomTypeFamily
 	case hotelv1.RoomType_ROOM_TYPE_PRESIDENTIAL:
@@ -66,21 +70,50 @@ func UpdateRoomRequestToDomain(req *hotelv1.UpdateRoomRequest) (*models.UpdateRo
 	}
 
 	return &models.UpdateRoom{
-		Description: req.Description,
-		Title:       req.Title,
-		RoomNumber:  req.RoomNumber,
-		Type:        roomTypeToDomain(req.Type),
-		Price:       price,
-		Amenities:   req.Amenities,
-		Images:      req.Images,
-		Capacity:    int(req.Capacity),
-		AreaSqm:     float64(req.AreaSqm),
-		Floor:       int(req.Floor),
+		Description:     req.Description,
+		Title:           req.Title,
+		RoomNumber:      req.RoomNumber,
+		Type:            roomTypeToDomain(req.Type),
+		Price:           price,
+		Amenities:       req.Amenities,
+		Images:          req.Images,
+		Capacity:        int(req.Capacity),
+		AreaSqm:         float64(req.AreaSqm),
+		Floor:           int(req.Floor),
+		ExpectedVersion: req.ExpectedVersion,
 	}, nil
 }
 
+func PatchRoomRequestToDomain(req *hotelv1.PatchRoomRequest) (*models.PatchRoom, error) {
+	fields := req.Room
+	room := &models.PatchRoom{
+		Description:     fields.Description,
+		Title:           fields.Title,
+		RoomNumber:      fields.RoomNumber,
+		Type:            roomTypeToDomain(fields.Type),
+		Fields:          updateMaskFields(req.UpdateMask),
+		Amenities:       fields.Amenities,
+		Images:          fields.Images,
+		Capacity:        int(fields.Capacity),
+		AreaSqm:         float64(fields.AreaSqm),
+		Floor:           int(fields.GetFloor()),
+		ExpectedVersion: req.ExpectedVersion,
+	}
+
+	if slices.Contains(room.Fields, models.RoomFieldPrice) {
+		price, err := decimal.NewFromString(fields.Price)
+		if err != nil || !price.IsPositive() {
+			return nil, consts.ErrInvalidPrice
+		}
+		room.Price = price
+	}
+
+	return room, nil
+}
+
 func UpdateRoomStatusRequestToDomain(req *hotelv1.UpdateRoomStatusRequest) models.UpdateRoomStatus {
 	return models.UpdateRoomStatus{
-		Status: roomStatusToDomain(req.Status),
+		Status:          roomStatusToDomain(req.Status),
+		ExpectedVersion: req.ExpectedVersion,
 	}
 }
@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	hotelv1 "hotel/api/hotel/v1"
@@ -33,6 +34,8 @@ func roomTypeToProto(status models.RoomType) hotelv1.RoomType {
 		s = hotelv1.RoomType_ROOM_TYPE_DOUBLE
 	case models.RoomTypeSuite:
 		s = hotelv1.RoomType_ROOM_TYPE_SUITE
+	case models.RoomTypeDeluxe:
+		s = hotelv1.RoomType_ROOM_TYPE_DELUXE
 	case models.RoomTypeFamily:
 		s = hotelv1.RoomType_ROOM_TYPE_FAMILY
 	case models.RoomTypePresidential:
@@ -44,7 +47,7 @@ func roomTypeToProto(status models.RoomType) hotelv1.RoomType {
 }
 
 func RoomResponseToProto(resp *models.Room) *hotelv1.Room {
-	return &hotelv1.Room{
+	room := &hotelv1.Room{
 		Id:          resp.ID.String(),
 		CreatedAt:   timestamppb.New(resp.CreatedAt),
 		UpdatedAt:   timestamppb.New(resp.UpdatedAt),
@@ -59,7 +62,33 @@ func RoomResponseToProto(resp *models.Room) *hotelv1.Room {
 		Capacity:    int64(resp.Capacity),
 		AreaSqm:     float32(resp.AreaSqm),
 		Floor:       int64(resp.Floor),
+		HotelId:     resp.HotelID.String(),
+		Version:     resp.Version,
+		DeletedAt:   deletedAtToProto(resp.DeletedAt),
 	}
+
+	if resp.CategoryID != nil {
+		categoryID := resp.CategoryID.String()
+		room.CategoryId = &categoryID
+	}
+
+	return room
+}
+
+func RoomListResponseToProto(resp []*models.Room) []*hotelv1.Room {
+	rooms := make([]*hotelv1.Room, len(resp))
+	for i, room := range resp {
+		rooms[i] = RoomResponseToProto(room)
+	}
+	return rooms
+}
+
+func RoomIDsToDomain(resp []*models.Room) []uuid.UUID {
+	ids := make([]uuid.UUID, len(resp))
+	for i, room := range resp {
+		ids[i] = room.ID
+	}
+	return ids
 }
 
 func RoomShortResponseToProto(resp *models.RoomShort) *hotelv1.RoomShort {
@@ -86,7 +115,7 @@ func RoomsResponseToProto(resp []*models.RoomShort) []*hotelv1.RoomShort {
 	return rooms
 }
 
-func UpdateRoomResponseToProto(resp *models.UpdateRoom) *hotelv1.UpdateRoom {
+func UpdateRoomResponseToProto(resp *models.UpdateRoom, version int64) *hotelv1.UpdateRoom {
 	return &hotelv1.UpdateRoom{
 		Description: resp.Description,
 		Title:       resp.Title,
@@ -98,6 +127,7 @@ func UpdateRoomResponseToProto(resp *models.UpdateRoom) *hotelv1.UpdateRoom {
 		Capacity:    int64(resp.Capacity),
 		AreaSqm:     float32(resp.AreaSqm),
 		Floor:       int64(resp.Floor),
+		Version:     version,
 	}
 }
 
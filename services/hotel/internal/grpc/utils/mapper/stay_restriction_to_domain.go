@@ -0,0 +1,67 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func optionalUint32ToDomain(v *uint32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+func stayRulesRequestToDomain(req *hotelv1.StayRulesRequest) models.StayRules {
+	return models.StayRules{
+		MinLengthOfStay:   optionalUint32ToDomain(req.MinLengthOfStay),
+		MaxLengthOfStay:   optionalUint32ToDomain(req.MaxLengthOfStay),
+		MinAdvanceDays:    optionalUint32ToDomain(req.MinAdvanceDays),
+		MaxAdvanceDays:    optionalUint32ToDomain(req.MaxAdvanceDays),
+		ClosedToArrival:   req.ClosedToArrival,
+		ClosedToDeparture: req.ClosedToDeparture,
+	}
+}
+
+func CreateStayRestrictionRequestToDomain(
+	req *hotelv1.CreateStayRestrictionRequest,
+) (*models.CreateStayRestriction, error) {
+	sr := &models.CreateStayRestriction{
+		StayRange: models.DateRange{
+			Start: req.StartDate.AsTime(),
+			End:   req.EndDate.AsTime(),
+		},
+		Rules: stayRulesRequestToDomain(req.Rules),
+	}
+
+	if req.RoomId != nil {
+		roomID, err := uuid.Parse(*req.RoomId)
+		if err != nil {
+			return nil, consts.ErrInvalidRoomID
+		}
+		sr.RoomID = &roomID
+	}
+
+	return sr, nil
+}
+
+func UpdateStayRestrictionRequestToDomain(req *hotelv1.UpdateStayRestrictionRequest) *models.UpdateStayRestriction {
+	return &models.UpdateStayRestriction{
+		StayRange: models.DateRange{
+			Start: req.StartDate.AsTime(),
+			End:   req.EndDate.AsTime(),
+		},
+		Rules: stayRulesRequestToDomain(req.Rules),
+	}
+}
+
+func CheckStayRequestToDomain(req *hotelv1.CheckStayRequest) models.DateRange {
+	return models.DateRange{
+		Start: req.CheckIn.AsTime(),
+		End:   req.CheckOut.AsTime(),
+	}
+}
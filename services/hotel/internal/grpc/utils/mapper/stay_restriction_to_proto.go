@@ -0,0 +1,88 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"hotel/internal/repository/models"
+)
+
+func optionalIntToProto(v *int) *uint32 {
+	if v == nil {
+		return nil
+	}
+	u := uint32(*v)
+	return &u
+}
+
+func stayRuleToProto(rule models.StayRule) hotelv1.StayRestrictionRule {
+	var r hotelv1.StayRestrictionRule
+	switch rule {
+	case models.StayRuleMinLengthOfStay:
+		r = hotelv1.StayRestrictionRule_STAY_RESTRICTION_RULE_MIN_LENGTH_OF_STAY
+	case models.StayRuleMaxLengthOfStay:
+		r = hotelv1.StayRestrictionRule_STAY_RESTRICTION_RULE_MAX_LENGTH_OF_STAY
+	case models.StayRuleClosedToArrival:
+		r = hotelv1.StayRestrictionRule_STAY_RESTRICTION_RULE_CLOSED_TO_ARRIVAL
+	case models.StayRuleClosedToDeparture:
+		r = hotelv1.StayRestrictionRule_STAY_RESTRICTION_RULE_CLOSED_TO_DEPARTURE
+	case models.StayRuleMinAdvanceBooking:
+		r = hotelv1.StayRestrictionRule_STAY_RESTRICTION_RULE_MIN_ADVANCE_BOOKING
+	case models.StayRuleMaxAdvanceBooking:
+		r = hotelv1.StayRestrictionRule_STAY_RESTRICTION_RULE_MAX_ADVANCE_BOOKING
+	default:
+		r = hotelv1.StayRestrictionRule_STAY_RESTRICTION_RULE_UNSPECIFIED
+	}
+	return r
+}
+
+func StayRestrictionResponseToProto(resp *models.StayRestriction) *hotelv1.StayRestriction {
+	sr := &hotelv1.StayRestriction{
+		Id:        resp.ID.String(),
+		HotelId:   resp.HotelID.String(),
+		StartDate: timestamppb.New(resp.StayRange.Start),
+		EndDate:   timestamppb.New(resp.StayRange.End),
+		Rules: &hotelv1.StayRules{
+			MinLengthOfStay:   optionalIntToProto(resp.Rules.MinLengthOfStay),
+			MaxLengthOfStay:   optionalIntToProto(resp.Rules.MaxLengthOfStay),
+			ClosedToArrival:   resp.Rules.ClosedToArrival,
+			ClosedToDeparture: resp.Rules.ClosedToDeparture,
+			MinAdvanceDays:    optionalIntToProto(resp.Rules.MinAdvanceDays),
+			MaxAdvanceDays:    optionalIntToProto(resp.Rules.MaxAdvanceDays),
+		},
+		CreatedAt: timestamppb.New(resp.CreatedAt),
+		UpdatedAt: timestamppb.New(resp.UpdatedAt),
+	}
+
+	if resp.RoomID != nil {
+		roomID := resp.RoomID.String()
+		sr.RoomId = &roomID
+	}
+
+	return sr
+}
+
+func StayRestrictionsResponseToProto(resp []*models.StayRestriction) []*hotelv1.StayRestriction {
+	restrictions := make([]*hotelv1.StayRestriction, len(resp))
+	for i, sr := range resp {
+		restrictions[i] = StayRestrictionResponseToProto(sr)
+	}
+
+	return restrictions
+}
+
+func StayViolationsResponseToProto(resp []models.StayViolation) []*hotelv1.StayRestrictionViolation {
+	violations := make([]*hotelv1.StayRestrictionViolation, len(resp))
+	for i, v := range resp {
+		violations[i] = &hotelv1.StayRestrictionViolation{
+			Rule:          stayRuleToProto(v.Rule),
+			RestrictionId: v.RestrictionID.String(),
+			Date:          timestamppb.New(v.Date),
+			Limit:         optionalIntToProto(v.Limit),
+			Actual:        optionalIntToProto(v.Actual),
+			Message:       v.Message,
+		}
+	}
+
+	return violations
+}
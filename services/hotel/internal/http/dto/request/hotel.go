@@ -6,17 +6,32 @@ type Location struct {
 }
 
 type HotelCreate struct {
-	Title       *string   `json:"title" validate:"required,min=3,max=100"`
-	OwnerID     *int64    `json:"owner_id" validate:"required,gt=0"`
-	Description *string   `json:"description" validate:"omitempty,max=2000"`
-	Address     *string   `json:"address" validate:"required,min=5,max=500"`
-	Location    *Location `json:"location" validate:"required"`
+	Title        *string   `json:"title" validate:"required,min=3,max=100"`
+	OwnerID      *int64    `json:"owner_id" validate:"required,gt=0"`
+	Description  *string   `json:"description" validate:"omitempty,max=2000"`
+	Address      *string   `json:"address" validate:"required,min=5,max=500"`
+	Location     *Location `json:"location" validate:"required"`
+	Timezone     *string   `json:"timezone" validate:"omitempty,max=64,timezone"`
+	CheckInTime  *string   `json:"check_in_time" validate:"omitempty,datetime=15:04"`
+	CheckOutTime *string   `json:"check_out_time" validate:"omitempty,datetime=15:04"`
 }
 
 type HotelUpdate struct {
-	Description *string   `json:"description" validate:"omitempty,max=2000"`
-	Address     *string   `json:"address" validate:"required,min=5,max=500"`
-	Location    *Location `json:"location" validate:"required"`
+	Description  *string   `json:"description" validate:"omitempty,max=2000"`
+	Address      *string   `json:"address" validate:"required,min=5,max=500"`
+	Location     *Location `json:"location" validate:"required"`
+	Timezone     *string   `json:"timezone" validate:"omitempty,max=64,timezone"`
+	CheckInTime  *string   `json:"check_in_time" validate:"omitempty,datetime=15:04"`
+	CheckOutTime *string   `json:"check_out_time" validate:"omitempty,datetime=15:04"`
+}
+
+type HotelPatch struct {
+	Description  *string   `json:"description" validate:"omitempty,max=2000"`
+	Address      *string   `json:"address" validate:"required,min=5,max=500"`
+	Location     *Location `json:"location" validate:"required"`
+	Timezone     *string   `json:"timezone" validate:"required,max=64,timezone"`
+	CheckInTime  *string   `json:"check_in_time" validate:"required,datetime=15:04"`
+	CheckOutTime *string   `json:"check_out_time" validate:"required,datetime=15:04"`
 }
 
 type HotelTitleUpdate struct {
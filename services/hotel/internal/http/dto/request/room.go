@@ -15,7 +15,7 @@ type RoomCreate struct {
 	Capacity    *int             `json:"capacity" validate:"required,gte=1,lte=10"`
 	AreaSqm     *float64         `json:"area_sqm" validate:"required,gt=0,lte=9999.99"`
 	Floor       *int             `json:"floor" validate:"required,gte=0,lte=2147483647"`
-	Amenities   []string         `json:"amenities" validate:"omitempty,max=50,dive,min=1,max=100"`
+	Amenities   []string         `json:"amenities" validate:"omitempty,max=50,unique,dive,max=64,amenity_code"`
 	Images      []string         `json:"images" validate:"omitempty,max=50,dive,min=1,max=500"`
 }
 
@@ -28,7 +28,20 @@ type RoomUpdate struct {
 	Capacity    *int             `json:"capacity" validate:"required,gte=1,lte=10"`
 	AreaSqm     *float64         `json:"area_sqm" validate:"required,gt=0,lte=9999.99"`
 	Floor       *int             `json:"floor" validate:"required,gte=0,lte=2147483647"`
-	Amenities   []string         `json:"amenities" validate:"omitempty,max=50,dive,min=1,max=100"`
+	Amenities   []string         `json:"amenities" validate:"omitempty,max=50,unique,dive,max=64,amenity_code"`
+	Images      []string         `json:"images" validate:"omitempty,max=50,dive,min=1,max=500"`
+}
+
+type RoomPatch struct {
+	Title       *string          `json:"title" validate:"required,min=3,max=100"`
+	Description *string          `json:"description" validate:"omitempty,max=1000"`
+	RoomNumber  *string          `json:"room_number" validate:"required,min=1,max=10"`
+	Type        *models.RoomType `json:"type" validate:"required,room_type"`
+	Price       *decimal.Decimal `json:"price" validate:"required,decimal_gt=0,decimal_lt=100000000"`
+	Capacity    *int             `json:"capacity" validate:"required,gte=1,lte=10"`
+	AreaSqm     *float64         `json:"area_sqm" validate:"required,gt=0,lte=9999.99"`
+	Floor       *int             `json:"floor" validate:"required,gte=0,lte=2147483647"`
+	Amenities   []string         `json:"amenities" validate:"omitempty,max=50,unique,dive,max=64,amenity_code"`
 	Images      []string         `json:"images" validate:"omitempty,max=50,dive,min=1,max=500"`
 }
 
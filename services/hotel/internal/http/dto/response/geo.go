@@ -0,0 +1,30 @@
+package response
+
+import (
+	"hotel/internal/http/utils/pagination"
+)
+
+type Country struct {
+	Names      map[string]string `json:"names"`
+	ISOAlpha3  *string           `json:"iso_alpha3"`
+	Code       string            `json:"code"`
+	HotelCount uint64            `json:"hotel_count"`
+}
+
+type City struct {
+	Names       map[string]string `json:"names"`
+	CountryCode string            `json:"country_code"`
+	Slug        string            `json:"slug"`
+	Timezone    string            `json:"timezone"`
+	Centroid    Location          `json:"centroid"`
+	HotelCount  uint64            `json:"hotel_count"`
+}
+
+type CityList struct {
+	Links            pagination.Links `json:"links"`
+	Cities           []City           `json:"cities"`
+	CurrentPage      uint64           `json:"current_page"`
+	Limit            uint64           `json:"limit"`
+	TotalPageCount   uint64           `json:"total_page_count"`
+	TotalCitiesCount uint64           `json:"total_cities_count"`
+}
@@ -14,26 +14,36 @@ type Location struct {
 }
 
 type HotelCreate struct {
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	Description *string   `json:"description"`
-	Title       string    `json:"title"`
-	Slug        string    `json:"slug"`
-	Address     string    `json:"address"`
-	OwnerID     int64     `json:"owner_id"`
-	Location    Location  `json:"location"`
-	ID          uuid.UUID `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Description  *string   `json:"description"`
+	Title        string    `json:"title"`
+	Slug         string    `json:"slug"`
+	Address      string    `json:"address"`
+	Timezone     string    `json:"timezone"`
+	CheckInTime  string    `json:"check_in_time"`
+	CheckOutTime string    `json:"check_out_time"`
+	Status       string    `json:"status"`
+	OwnerID      int64     `json:"owner_id"`
+	Version      int64     `json:"version"`
+	Location     Location  `json:"location"`
+	ID           uuid.UUID `json:"id"`
 }
 
 type HotelUpdate struct {
-	Description *string  `json:"description"`
-	Address     string   `json:"address"`
-	Location    Location `json:"location"`
+	Description  *string  `json:"description"`
+	Address      string   `json:"address"`
+	Timezone     *string  `json:"timezone"`
+	CheckInTime  *string  `json:"check_in_time"`
+	CheckOutTime *string  `json:"check_out_time"`
+	Version      int64    `json:"version"`
+	Location     Location `json:"location"`
 }
 
 type HotelTitleUpdate struct {
-	Title string `json:"title"`
-	Slug  string `json:"slug"`
+	Title   string `json:"title"`
+	Slug    string `json:"slug"`
+	Version int64  `json:"version"`
 }
 
 type HotelShort struct {
@@ -47,15 +57,20 @@ type HotelShort struct {
 }
 
 type Hotel struct {
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	Description *string   `json:"description"`
-	Rating      *float32  `json:"rating"`
-	Title       string    `json:"title"`
-	Address     string    `json:"address"`
-	OwnerID     int64     `json:"owner_id"`
-	Location    Location  `json:"location"`
-	ID          uuid.UUID `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Description  *string   `json:"description"`
+	Rating       *float32  `json:"rating"`
+	Title        string    `json:"title"`
+	Address      string    `json:"address"`
+	Timezone     string    `json:"timezone"`
+	CheckInTime  string    `json:"check_in_time"`
+	CheckOutTime string    `json:"check_out_time"`
+	Status       string    `json:"status"`
+	OwnerID      int64     `json:"owner_id"`
+	Version      int64     `json:"version"`
+	Location     Location  `json:"location"`
+	ID           uuid.UUID `json:"id"`
 }
 
 type HotelList struct {
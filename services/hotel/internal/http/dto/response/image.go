@@ -0,0 +1,27 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ImageURLs struct {
+	Original      string `json:"original"`
+	Thumbnail     string `json:"thumbnail"`
+	ThumbnailWebP string `json:"thumbnail_webp"`
+	WebP          string `json:"webp"`
+}
+
+type Image struct {
+	CreatedAt   time.Time  `json:"created_at"`
+	RoomID      *uuid.UUID `json:"room_id"`
+	URLs        ImageURLs  `json:"urls"`
+	ContentType string     `json:"content_type"`
+	SizeBytes   int64      `json:"size_bytes"`
+	Width       int        `json:"width"`
+	Height      int        `json:"height"`
+	Position    int        `json:"position"`
+	ID          uuid.UUID  `json:"id"`
+	HotelID     uuid.UUID  `json:"hotel_id"`
+}
@@ -24,6 +24,7 @@ type Room struct {
 	Capacity    int               `json:"capacity"`
 	AreaSqm     float64           `json:"area_sqm"`
 	Floor       int               `json:"floor"`
+	Version     int64             `json:"version"`
 	ID          uuid.UUID         `json:"id"`
 }
 
@@ -51,10 +52,12 @@ type RoomUpdate struct {
 	Capacity    int             `json:"capacity"`
 	AreaSqm     float64         `json:"area_sqm"`
 	Floor       int             `json:"floor"`
+	Version     int64           `json:"version"`
 }
 
 type RoomStatusUpdate struct {
-	Status models.RoomStatus `json:"status"`
+	Status  models.RoomStatus `json:"status"`
+	Version int64             `json:"version"`
 }
 
 type RoomList struct {
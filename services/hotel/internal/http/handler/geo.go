@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"hotel/internal/http/dto/response"
+	"hotel/internal/http/utils/helper"
+	"hotel/internal/http/utils/mapper"
+	"hotel/internal/http/utils/pagination"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type GeoService interface {
+	ListCountries(ctx context.Context) ([]*models.Country, error)
+	ListCities(ctx context.Context, filter models.CityFilter, page, limit uint64) (*models.CityList, error)
+}
+
+// CountryGetAll    godoc
+//
+//	@Summary		Get countries
+//	@Description	Get every country of the location catalogue with its hotel count
+//	@Tags			geo
+//	@Produce		json
+//	@Success		200	{array}		response.Country
+//	@Failure		500	{object}	response.ErrorSchema
+//	@Router			/countries [get]
+func (h *Handler) CountryGetAll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	countries, err := h.svc.ListCountries(ctx)
+	errHandler := &helper.ErrorHandler{}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
+	countriesResp := make([]response.Country, len(countries))
+	for i, c := range countries {
+		countriesResp[i] = mapper.CountryEntityToResponse(c)
+	}
+
+	helper.SendSuccess(w, r, http.StatusOK, countriesResp)
+}
+
+// CityGetAll    godoc
+//
+//	@Summary		Get cities
+//	@Description	Get cities of a country from the location catalogue
+//	@Tags			geo
+//	@Produce		json
+//	@Param			country_code		path		string	true	"Country Code"
+//	@Param			only_with_hotels	query		bool	false	"Only cities that have hotels"
+//	@Param			page				query		uint64	false	"Page"	default(1)
+//	@Param			limit				query		uint64	false	"Limit"	default(20)
+//	@Success		200					{object}	response.CityList
+//	@Failure		400					{object}	response.ErrorSchema
+//	@Failure		500					{object}	response.ErrorSchema
+//	@Router			/countries/{country_code}/cities [get]
+func (h *Handler) CityGetAll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	paginationParams, err := pagination.ParsePaginationQuery(r)
+	errHandler := &helper.ErrorHandler{BadRequest: consts.ErrInvalidQueryParam}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
+	filter := models.CityFilter{
+		CountryCode:    strings.ToUpper(chi.URLParam(r, "countryCode")),
+		OnlyWithHotels: r.URL.Query().Get("only_with_hotels") == "true",
+	}
+	cityList, err := h.svc.ListCities(ctx, filter, paginationParams.Page, paginationParams.Limit)
+	errHandler = &helper.ErrorHandler{}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
+	cities := make([]response.City, len(cityList.Cities))
+	for i, c := range cityList.Cities {
+		cities[i] = mapper.CityEntityToResponse(c)
+	}
+
+	totalPageCount := (cityList.TotalCount + paginationParams.Limit - 1) / paginationParams.Limit
+	pageLinks := pagination.BuildPaginationLinks(r, paginationParams, totalPageCount)
+	cityListResp := response.CityList{
+		Links:            pageLinks,
+		Cities:           cities,
+		CurrentPage:      paginationParams.Page,
+		Limit:            paginationParams.Limit,
+		TotalPageCount:   totalPageCount,
+		TotalCitiesCount: cityList.TotalCount,
+	}
+
+	helper.SendSuccess(w, r, http.StatusOK, cityListResp)
+}
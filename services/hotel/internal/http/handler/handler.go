@@ -3,6 +3,8 @@ package handler
 type Service interface {
 	HotelService
 	RoomService
+	ImageService
+	GeoService
 }
 
 type Handler struct {
@@ -18,16 +18,15 @@ import (
 )
 
 type HotelService interface {
-	HotelCreate(ctx context.Context, hotel models.HotelRef, h models.CreateHotel) (models.Hotel, error)
-	HotelGetBySlug(ctx context.Context, hotel models.HotelRef) (models.Hotel, error)
-	HotelGetAll(ctx context.Context, hotel models.HotelRef, sortField string, page, limit uint64) (
-		models.HotelList, error,
-	)
-	HotelUpdateBySlug(ctx context.Context, hotel models.HotelRef, h models.UpdateHotel) error
-	HotelTitleUpdateBySlug(
-		ctx context.Context, hotel models.HotelRef, h models.UpdateHotelTitle,
+	CreateHotel(ctx context.Context, h *models.CreateHotel) (*models.Hotel, error)
+	GetHotels(ctx context.Context, ref models.HotelRef, sort string, page, limit uint64) (*models.HotelList, error)
+	GetHotelBySlug(ctx context.Context, ref models.HotelRef, viewer models.HotelViewer) (*models.Hotel, error)
+	UpdateHotelBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotel) (int64, error)
+	PatchHotelBySlug(ctx context.Context, ref models.HotelRef, h models.PatchHotel) (*models.Hotel, error)
+	UpdateHotelTitleBySlug(
+		ctx context.Context, ref models.HotelRef, h models.UpdateHotelTitle,
 	) (models.UpdateHotelTitle, error)
-	HotelDeleteBySlug(ctx context.Context, hotel models.HotelRef) error
+	DeleteHotelBySlug(ctx context.Context, ref models.HotelRef, force bool) ([]string, error)
 }
 
 // HotelCreate   godoc
@@ -56,13 +55,16 @@ func (h *Handler) HotelCreate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	newHotel := mapper.HotelCreateRequestToEntity(req)
-	createdHotel, err := h.svc.HotelCreate(ctx, hotelRef, newHotel)
-	errHandler := &helper.ErrorHandler{Conflict: consts.ErrUniqueHotelField}
+	newHotel.CountryCode = hotelRef.CountryCode
+	newHotel.CitySlug = hotelRef.CitySlug
+	createdHotel, err := h.svc.CreateHotel(ctx, &newHotel)
+	errHandler := &helper.ErrorHandler{Conflict: consts.ErrUniqueHotelField, BadRequest: consts.ErrCityNotFound}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
 	}
 
-	hotelResponse := mapper.HotelCreateEntityToResponse(createdHotel)
+	hotelResponse := mapper.HotelCreateEntityToResponse(*createdHotel)
+	helper.SetETag(w, createdHotel.Version)
 	helper.SendSuccess(w, r, http.StatusCreated, hotelResponse)
 }
 
@@ -93,7 +95,7 @@ func (h *Handler) HotelGetAll(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	hotelList, err := h.svc.HotelGetAll(ctx, hotelRef, sortField, paginationParams.Page, paginationParams.Limit)
+	hotelList, err := h.svc.GetHotels(ctx, hotelRef, sortField, paginationParams.Page, paginationParams.Limit)
 	errHandler = &helper.ErrorHandler{}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
@@ -130,6 +132,7 @@ func (h *Handler) HotelGetAll(w http.ResponseWriter, r *http.Request) {
 //	@Param			city_slug    	path		         string	true	"City HotelSlug"
 //	@Param			hotel_slug      path		         string	true	"Hotel slug"
 //	@Success		200	{object}	response.Hotel
+//	@Success		301	{string}	string	"Retired slug, Location points to the canonical path"
 //	@Failure		400	{object}	response.ErrorSchema
 //	@Failure		401	{object}	response.ErrorSchema
 //	@Failure		404	{object}	response.ErrorSchema
@@ -140,16 +143,28 @@ func (h *Handler) HotelGetBySlug(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	hotelRef := middleware.GetHotelRef(ctx)
 
-	hotel, err := h.svc.HotelGetBySlug(ctx, hotelRef)
+	// The HTTP API does not authenticate callers, so it only shows published hotels.
+	hotel, err := h.svc.GetHotelBySlug(ctx, hotelRef, models.HotelViewer{})
 	errHandler := &helper.ErrorHandler{NotFound: consts.ErrHotelNotFound}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
 	}
 
-	hotelResponse := mapper.HotelGetEntityToResponse(hotel)
+	hotelResponse := mapper.HotelGetEntityToResponse(*hotel)
+	helper.SetETag(w, hotel.Version)
 	helper.SendSuccess(w, r, http.StatusOK, hotelResponse)
 }
 
+// HotelCanonicalSlug resolves the live slug for the hotel ref in ctx, following retired slugs.
+func (h *Handler) HotelCanonicalSlug(ctx context.Context) (string, error) {
+	hotel, err := h.svc.GetHotelBySlug(ctx, middleware.GetHotelRef(ctx), models.HotelViewer{})
+	if err != nil {
+		return "", err
+	}
+
+	return hotel.HotelSlug, nil
+}
+
 // HotelUpdateBySlug    godoc
 //
 //	@Summary		Update hotel by slug
@@ -160,11 +175,13 @@ func (h *Handler) HotelGetBySlug(w http.ResponseWriter, r *http.Request) {
 //	@Param			country_code	path		string	true	"Country Code"
 //	@Param			city_slug    	path		string	true	"City HotelSlug"
 //	@Param			hotel_slug	    path		string	true	"Hotel slug"
+//	@Param			If-Match	    header		string	false	"ETag of the hotel version being replaced"
 //	@Param          request         body        request.UpdateHotel  true  "Hotel data"
 //	@Success		200	{object}	            response.UpdateHotel
 //	@Failure		400	{object}	            response.ErrorSchema
 //	@Failure		401	{object}	            response.ErrorSchema
 //	@Failure		404	{object}	            response.ErrorSchema
+//	@Failure		412	{object}	            response.ErrorSchema
 //	@Failure		500	{object}	            response.ErrorSchema
 //	@Security		Bearer
 //	@Router			/{country_code}/{city_slug}/hotels/{hotel_slug} [put]
@@ -172,19 +189,83 @@ func (h *Handler) HotelUpdateBySlug(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	hotelRef := middleware.GetHotelRef(ctx)
 
+	expectedVersion, err := helper.ParseIfMatch(r)
+	errHandler := &helper.ErrorHandler{BadRequest: consts.ErrInvalidIfMatch}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
 	var req request.HotelUpdate
-	if err := helper.ParseJSON(w, r, &req, validation.CustomValidationError); err != nil {
+	if err = helper.ParseJSON(w, r, &req, validation.CustomValidationError); err != nil {
 		return
 	}
 
 	hotelUpdate := mapper.HotelUpdateRequestToEntity(req)
-	err := h.svc.HotelUpdateBySlug(ctx, hotelRef, hotelUpdate)
-	errHandler := &helper.ErrorHandler{NotFound: consts.ErrHotelNotFound}
+	hotelUpdate.ExpectedVersion = expectedVersion
+	version, err := h.svc.UpdateHotelBySlug(ctx, hotelRef, hotelUpdate)
+	errHandler = &helper.ErrorHandler{
+		NotFound:           consts.ErrHotelNotFound,
+		PreconditionFailed: consts.ErrVersionMismatch,
+	}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
+	hotelResponse := mapper.HotelUpdateEntityToResponse(hotelUpdate, version)
+	helper.SetETag(w, version)
+	helper.SendSuccess(w, r, http.StatusOK, hotelResponse)
+}
+
+// HotelPatchBySlug    godoc
+//
+//	@Summary		Partially update hotel by slug
+//	@Description	Update only the hotel fields present in the body from admin, moderator or owner provider
+//	@Tags			hotels
+//	@Accept			json
+//	@Produce		json
+//	@Param			country_code	path		string	true	"Country Code"
+//	@Param			city_slug    	path		string	true	"City HotelSlug"
+//	@Param			hotel_slug	    path		string	true	"Hotel slug"
+//	@Param			If-Match	    header		string	false	"ETag of the hotel version being changed"
+//	@Param          request         body        request.HotelPatch  true  "Hotel fields to update"
+//	@Success		200	{object}	            response.Hotel
+//	@Failure		400	{object}	            response.ErrorSchema
+//	@Failure		401	{object}	            response.ErrorSchema
+//	@Failure		404	{object}	            response.ErrorSchema
+//	@Failure		412	{object}	            response.ErrorSchema
+//	@Failure		500	{object}	            response.ErrorSchema
+//	@Security		Bearer
+//	@Router			/{country_code}/{city_slug}/hotels/{hotel_slug} [patch]
+func (h *Handler) HotelPatchBySlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	hotelRef := middleware.GetHotelRef(ctx)
+
+	expectedVersion, err := helper.ParseIfMatch(r)
+	errHandler := &helper.ErrorHandler{BadRequest: consts.ErrInvalidIfMatch}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
 	}
 
-	hotelResponse := mapper.HotelUpdateEntityToResponse(hotelUpdate)
+	var req request.HotelPatch
+	fields, err := helper.ParsePatchJSON(w, r, &req, validation.CustomValidationError)
+	if err != nil {
+		return
+	}
+
+	hotelPatch := mapper.HotelPatchRequestToEntity(req, fields)
+	hotelPatch.ExpectedVersion = expectedVersion
+	hotel, err := h.svc.PatchHotelBySlug(ctx, hotelRef, hotelPatch)
+	errHandler = &helper.ErrorHandler{
+		NotFound:           consts.ErrHotelNotFound,
+		BadRequest:         consts.ErrInvalidTimezone,
+		PreconditionFailed: consts.ErrVersionMismatch,
+	}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
+	hotelResponse := mapper.HotelGetEntityToResponse(*hotel)
+	helper.SetETag(w, hotel.Version)
 	helper.SendSuccess(w, r, http.StatusOK, hotelResponse)
 }
 
@@ -198,11 +279,13 @@ func (h *Handler) HotelUpdateBySlug(w http.ResponseWriter, r *http.Request) {
 //	@Param			country_code	path		string	true	"Country Code"
 //	@Param			city_slug    	path		string	true	"City HotelSlug"
 //	@Param			hotel_slug	    path		string	true	"Hotel slug"
+//	@Param			If-Match	    header		string	false	"ETag of the hotel version being changed"
 //	@Param          request         body        request.UpdateHotelTitle  true  "Hotel data"
 //	@Success		200	{object}	            response.UpdateHotelTitle
 //	@Failure		400	{object}	            response.ErrorSchema
 //	@Failure		401	{object}	            response.ErrorSchema
 //	@Failure		404	{object}	            response.ErrorSchema
+//	@Failure		412	{object}	            response.ErrorSchema
 //	@Failure		500	{object}	            response.ErrorSchema
 //	@Security		Bearer
 //	@Router			/{country_code}/{city_slug}/hotels/{hotel_slug}/update_title [put]
@@ -210,18 +293,30 @@ func (h *Handler) HotelTitleUpdateBySlug(w http.ResponseWriter, r *http.Request)
 	ctx := r.Context()
 	hotelRef := middleware.GetHotelRef(ctx)
 
+	expectedVersion, err := helper.ParseIfMatch(r)
+	errHandler := &helper.ErrorHandler{BadRequest: consts.ErrInvalidIfMatch}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
 	var req request.HotelTitleUpdate
-	if err := helper.ParseJSON(w, r, &req, validation.CustomValidationError); err != nil {
+	if err = helper.ParseJSON(w, r, &req, validation.CustomValidationError); err != nil {
 		return
 	}
 
-	hotelUpdated, err := h.svc.HotelTitleUpdateBySlug(ctx, hotelRef, mapper.HotelTitleUpdateRequestToEntity(req))
-	errHandler := &helper.ErrorHandler{NotFound: consts.ErrHotelNotFound}
+	titleUpdate := mapper.HotelTitleUpdateRequestToEntity(req)
+	titleUpdate.ExpectedVersion = expectedVersion
+	hotelUpdated, err := h.svc.UpdateHotelTitleBySlug(ctx, hotelRef, titleUpdate)
+	errHandler = &helper.ErrorHandler{
+		NotFound:           consts.ErrHotelNotFound,
+		PreconditionFailed: consts.ErrVersionMismatch,
+	}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
 	}
 
 	hotelResponse := mapper.HotelTitleUpdateEntityToResponse(hotelUpdated)
+	helper.SetETag(w, hotelUpdated.Version)
 	helper.SendSuccess(w, r, http.StatusOK, hotelResponse)
 }
 
@@ -235,19 +330,25 @@ func (h *Handler) HotelTitleUpdateBySlug(w http.ResponseWriter, r *http.Request)
 //	@Param			country_code	path		string	true	"Country Code"
 //	@Param			city_slug    	path		string	true	"City HotelSlug"
 //	@Param			hotel_slug      path		string	true	"Hotel slug"
+//	@Param			force			query		bool	false	"Cancel active and upcoming bookings"
 //	@Success		204	{object}	nil
 //	@Failure		400	{object}	response.ErrorSchema
 //	@Failure		401	{object}	response.ErrorSchema
 //	@Failure		404	{object}	response.ErrorSchema
+//	@Failure		409	{object}	response.ErrorSchema
 //	@Failure		500	{object}	response.ErrorSchema
 //	@Security		Bearer
 //	@Router			/{country_code}/{city_slug}/hotels/{hotel_slug} [delete]
 func (h *Handler) HotelDeleteBySlug(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	hotelRef := middleware.GetHotelRef(ctx)
+	force := r.URL.Query().Get("force") == "true"
 
-	err := h.svc.HotelDeleteBySlug(ctx, hotelRef)
-	errHandler := &helper.ErrorHandler{NotFound: consts.ErrHotelNotFound}
+	_, err := h.svc.DeleteHotelBySlug(ctx, hotelRef, force)
+	errHandler := &helper.ErrorHandler{
+		NotFound: consts.ErrHotelNotFound,
+		Conflict: consts.ErrHotelHasActiveBookings,
+	}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
 	}
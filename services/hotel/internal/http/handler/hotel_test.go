@@ -0,0 +1,178 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"hotel/internal/http/handler"
+	"hotel/internal/http/router"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+const (
+	liveSlug    = "grand-palace"
+	retiredSlug = "old-palace"
+	hotelsPath  = "/api/v1/ru/moscow/hotels/"
+)
+
+// stubService answers for a single hotel that is reachable by its live slug and
+// by one retired slug; everything the tests do not touch panics via the nil
+// embedded interface.
+type stubService struct {
+	handler.Service
+
+	hotel      *models.Hotel
+	room       *models.Room
+	hotelPatch *models.PatchHotel
+	roomPatch  *models.PatchRoom
+}
+
+func newStubService() *stubService {
+	return &stubService{
+		hotel: &models.Hotel{
+			ID:          uuid.New(),
+			Title:       "Grand Palace",
+			CountryCode: "ru",
+			CitySlug:    "moscow",
+			HotelSlug:   liveSlug,
+			Address:     "Tverskaya 1",
+			Timezone:    "Europe/Moscow",
+			Status:      models.HotelStatusPublished,
+			Version:     3,
+		},
+		room: &models.Room{
+			ID:      uuid.New(),
+			Title:   "Deluxe",
+			Version: 5,
+		},
+	}
+}
+
+func (s *stubService) GetHotelBySlug(
+	_ context.Context, ref models.HotelRef, _ models.HotelViewer,
+) (*models.Hotel, error) {
+	if ref.HotelSlug != liveSlug && ref.HotelSlug != retiredSlug {
+		return nil, consts.ErrHotelNotFound
+	}
+
+	return s.hotel, nil
+}
+
+func (s *stubService) GetRooms(context.Context, models.HotelRef, uint64, uint64) (*models.RoomList, error) {
+	return &models.RoomList{}, nil
+}
+
+func (s *stubService) PatchHotelBySlug(
+	_ context.Context, ref models.HotelRef, h models.PatchHotel,
+) (*models.Hotel, error) {
+	if ref.HotelSlug != liveSlug {
+		return nil, consts.ErrHotelNotFound
+	}
+	if h.ExpectedVersion != nil && *h.ExpectedVersion != s.hotel.Version {
+		return nil, consts.ErrVersionMismatch
+	}
+	s.hotelPatch = &h
+	s.hotel.Address = h.Address
+	s.hotel.Version++
+
+	return s.hotel, nil
+}
+
+func (s *stubService) PatchRoomByID(_ context.Context, roomID uuid.UUID, room *models.PatchRoom) (*models.Room, error) {
+	if roomID != s.room.ID {
+		return nil, consts.ErrRoomNotFound
+	}
+	if room.ExpectedVersion != nil && *room.ExpectedVersion != s.room.Version {
+		return nil, consts.ErrVersionMismatch
+	}
+	s.roomPatch = room
+	s.room.Title = room.Title
+	s.room.Version++
+
+	return s.room, nil
+}
+
+func newTestServer(svc handler.Service) http.Handler {
+	r := chi.NewRouter()
+	router.New(r, handler.New(svc))
+
+	return r
+}
+
+func serve(h http.Handler, method, target, body string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestHotelSlugRedirect(t *testing.T) {
+	srv := newTestServer(newStubService())
+
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		body         string
+		wantCode     int
+		wantLocation string
+	}{
+		{
+			name:     "live slug is served",
+			method:   http.MethodGet,
+			target:   hotelsPath + liveSlug,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:         "retired slug redirects to the live one",
+			method:       http.MethodGet,
+			target:       hotelsPath + retiredSlug,
+			wantCode:     http.StatusMovedPermanently,
+			wantLocation: hotelsPath + liveSlug,
+		},
+		{
+			name:         "nested path and query are kept",
+			method:       http.MethodGet,
+			target:       hotelsPath + retiredSlug + "/rooms?page=2&limit=10",
+			wantCode:     http.StatusMovedPermanently,
+			wantLocation: hotelsPath + liveSlug + "/rooms?page=2&limit=10",
+		},
+		{
+			name:     "writes are not redirected",
+			method:   http.MethodPatch,
+			target:   hotelsPath + retiredSlug,
+			body:     `{"address": "Tverskaya 2"}`,
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "unknown slug is not found",
+			method:   http.MethodGet,
+			target:   hotelsPath + "nowhere",
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(srv, tt.method, tt.target, tt.body, nil)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d, body %s", rec.Code, tt.wantCode, rec.Body)
+			}
+			if got := rec.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("Location = %q, want %q", got, tt.wantLocation)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"hotel/internal/http/dto/response"
+	"hotel/internal/http/middleware"
+	"hotel/internal/http/utils/helper"
+	"hotel/internal/http/utils/mapper"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+
+	"github.com/google/uuid"
+)
+
+// imageFormField is the multipart form field carrying the uploaded file.
+const imageFormField = "file"
+
+type ImageService interface {
+	UploadImage(ctx context.Context, target models.ImageTarget, r io.Reader) (*models.Image, error)
+	DeleteImageByID(ctx context.Context, imageID uuid.UUID) error
+}
+
+// ImageUploadHotel   godoc
+// @Summary      Upload hotel image
+// @Description  Upload an image to the hotel gallery from admin or owner provider
+// @Tags         images
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param		 country_code    path		string	true	"Country Code"
+// @Param		 city_slug    	 path		string	true	"City HotelSlug"
+// @Param		 hotel_slug      path		string	true	"Hotel slug"
+// @Param        file            formData   file    true    "JPEG, PNG or WebP image"
+// @Success      201             {object}   response.Image
+// @Failure      400             {object}   response.ErrorSchema
+// @Failure      401             {object}   response.ErrorSchema
+// @Failure      404             {object}   response.ErrorSchema
+// @Failure      500             {object}   response.ErrorSchema
+// @Security     Bearer
+// @Router       /{country_code}/{city_slug}/hotels/{hotel_slug}/images  [post]
+func (h *Handler) ImageUploadHotel(w http.ResponseWriter, r *http.Request) {
+	target := models.ImageTarget{HotelRef: middleware.GetHotelRef(r.Context())}
+	h.uploadImage(w, r, target)
+}
+
+// ImageUploadRoom   godoc
+// @Summary      Upload room image
+// @Description  Upload an image to the room gallery from admin or owner provider
+// @Tags         images
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param		 country_code    path		string	true	"Country Code"
+// @Param		 city_slug    	 path		string	true	"City HotelSlug"
+// @Param		 hotel_slug      path		string	true	"Hotel slug"
+// @Param		 id	             path		string	true	"Room ID"
+// @Param        file            formData   file    true    "JPEG, PNG or WebP image"
+// @Success      201             {object}   response.Image
+// @Failure      400             {object}   response.ErrorSchema
+// @Failure      401             {object}   response.ErrorSchema
+// @Failure      404             {object}   response.ErrorSchema
+// @Failure      500             {object}   response.ErrorSchema
+// @Security     Bearer
+// @Router       /{country_code}/{city_slug}/hotels/{hotel_slug}/rooms/{id}/images  [post]
+func (h *Handler) ImageUploadRoom(w http.ResponseWriter, r *http.Request) {
+	roomID, err := helper.ParseUUIDParam(r, "id")
+	if err != nil {
+		errMsg := response.ErrorResp(consts.ErrInvalidRoomID)
+		helper.SendError(w, r, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	target := models.ImageTarget{
+		RoomID:   &roomID,
+		HotelRef: middleware.GetHotelRef(r.Context()),
+	}
+	h.uploadImage(w, r, target)
+}
+
+// ImageDeleteByID    godoc
+//
+//	@Summary		Delete image by ID
+//	@Description	Delete image and its stored variants from admin or owner provider
+//	@Tags			images
+//	@Accept			json
+//	@Produce		json
+//	@Param		    country_code    path		string	true	"Country Code"
+//	@Param		    city_slug       path		string	true	"City HotelSlug"
+//	@Param		    hotel_slug      path		string	true	"Hotel slug"
+//	@Param			imageID	        path		string	true	"Image ID"
+//	@Success		204
+//	@Failure		400	{object}	response.ErrorSchema
+//	@Failure		401	{object}	response.ErrorSchema
+//	@Failure		404	{object}	response.ErrorSchema
+//	@Failure		500	{object}	response.ErrorSchema
+//	@Security		Bearer
+//	@Router			/{country_code}/{city_slug}/hotels/{hotel_slug}/images/{imageID} [delete]
+func (h *Handler) ImageDeleteByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := helper.ParseUUIDParam(r, "imageID")
+	if err != nil {
+		errMsg := response.ErrorResp(consts.ErrInvalidImageID)
+		helper.SendError(w, r, http.StatusBadRequest, errMsg)
+		return
+	}
+
+	err = h.svc.DeleteImageByID(ctx, id)
+	errHandler := &helper.ErrorHandler{NotFound: consts.ErrImageNotFound}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
+	helper.SendSuccess(w, r, http.StatusNoContent, nil)
+}
+
+func (h *Handler) uploadImage(w http.ResponseWriter, r *http.Request, target models.ImageTarget) {
+	ctx := r.Context()
+
+	// leave some room for the multipart framing around the file itself
+	r.Body = http.MaxBytesReader(w, r.Body, consts.MaxImageBytes+1<<20)
+
+	file, _, err := r.FormFile(imageFormField)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			errMsg := response.ErrorResp(consts.ErrImageTooLarge)
+			helper.SendError(w, r, http.StatusRequestEntityTooLarge, errMsg)
+			return
+		}
+		errMsg := response.ErrorResp(consts.ErrInvalidImage)
+		helper.SendError(w, r, http.StatusBadRequest, errMsg)
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	img, err := h.svc.UploadImage(ctx, target, file)
+	switch {
+	case errors.Is(err, consts.ErrImageTooLarge):
+		helper.SendError(w, r, http.StatusRequestEntityTooLarge, response.ErrorResp(err))
+		return
+	case errors.Is(err, consts.ErrUnsupportedImageType):
+		helper.SendError(w, r, http.StatusUnsupportedMediaType, response.ErrorResp(err))
+		return
+	case errors.Is(err, consts.ErrInvalidImage):
+		helper.SendError(w, r, http.StatusBadRequest, response.ErrorResp(err))
+		return
+	case errors.Is(err, consts.ErrRoomNotFound):
+		helper.SendError(w, r, http.StatusNotFound, response.ErrorResp(err))
+		return
+	}
+
+	errHandler := &helper.ErrorHandler{NotFound: consts.ErrHotelNotFound}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
+	helper.SendSuccess(w, r, http.StatusCreated, mapper.ImageEntityToResponse(img))
+}
@@ -0,0 +1,143 @@
+package handler_test
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestHotelPatchBySlug(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantCode   int
+		wantFields []string
+	}{
+		{
+			name:       "only present fields are patched",
+			body:       `{"address": "Tverskaya 2"}`,
+			wantCode:   http.StatusOK,
+			wantFields: []string{"address"},
+		},
+		{
+			name:       "explicit null clears an optional field",
+			body:       `{"description": null, "check_in_time": "15:00"}`,
+			wantCode:   http.StatusOK,
+			wantFields: []string{"check_in_time", "description"},
+		},
+		{
+			name:     "empty body is rejected",
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "unknown field is rejected",
+			body:     `{"stars": 5}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "null for a required field is rejected",
+			body:     `{"address": null}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "present fields are validated",
+			body:     `{"timezone": "Mars/Olympus"}`,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newStubService()
+			rec := serve(newTestServer(svc), http.MethodPatch, hotelsPath+liveSlug, tt.body, nil)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d, body %s", rec.Code, tt.wantCode, rec.Body)
+			}
+			if tt.wantFields == nil {
+				if svc.hotelPatch != nil {
+					t.Errorf("service called with %+v, want no call", svc.hotelPatch)
+				}
+				return
+			}
+			if !slices.Equal(svc.hotelPatch.Fields, tt.wantFields) {
+				t.Errorf("fields = %v, want %v", svc.hotelPatch.Fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestRoomPatchByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		roomID     string
+		wantCode   int
+		wantFields []string
+	}{
+		{
+			name:       "only present fields are patched",
+			body:       `{"title": "Junior suite"}`,
+			wantCode:   http.StatusOK,
+			wantFields: []string{"title"},
+		},
+		{
+			name:     "present fields are validated",
+			body:     `{"capacity": 0}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "unknown room is not found",
+			body:     `{"title": "Junior suite"}`,
+			roomID:   "7b4a8a34-9d2c-4d46-8c4c-2f0d7f4f0e11",
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newStubService()
+			roomID := tt.roomID
+			if roomID == "" {
+				roomID = svc.room.ID.String()
+			}
+			target := hotelsPath + liveSlug + "/rooms/" + roomID
+			rec := serve(newTestServer(svc), http.MethodPatch, target, tt.body, nil)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d, body %s", rec.Code, tt.wantCode, rec.Body)
+			}
+			if tt.wantFields == nil {
+				return
+			}
+			if !slices.Equal(svc.roomPatch.Fields, tt.wantFields) {
+				t.Errorf("fields = %v, want %v", svc.roomPatch.Fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestRoomPatchByIDChainsETags(t *testing.T) {
+	svc := newStubService()
+	srv := newTestServer(svc)
+	target := hotelsPath + liveSlug + "/rooms/" + svc.room.ID.String()
+
+	etag := `"5"`
+	for _, title := range []string{"Junior suite", "Grand suite"} {
+		rec := serve(srv, http.MethodPatch, target, `{"title": "`+title+`"}`, http.Header{"If-Match": {etag}})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d, body %s", rec.Code, http.StatusOK, rec.Body)
+		}
+		next := rec.Header().Get("ETag")
+		if next == "" || next == etag {
+			t.Fatalf("ETag = %q after patching version %s", next, etag)
+		}
+		etag = next
+	}
+
+	rec := serve(srv, http.MethodPatch, target, `{"title": "Stale suite"}`, http.Header{"If-Match": {`"5"`}})
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Fatalf("stale If-Match status = %d, want %d", rec.Code, http.StatusPreconditionFailed)
+	}
+}
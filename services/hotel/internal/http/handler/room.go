@@ -18,14 +18,13 @@ import (
 )
 
 type RoomService interface {
-	CreateRoom(ctx context.Context, hotel models.HotelRef, room models.CreateRoom) (models.Room, error)
-	GetRoomByID(ctx context.Context, hotel models.HotelRef, roomID uuid.UUID) (models.Room, error)
-	GetRooms(ctx context.Context, hotel models.HotelRef, limit, offset uint64) (models.RoomList, error)
-	UpdateRoomByID(ctx context.Context, hotel models.HotelRef, roomID uuid.UUID, room models.UpdateRoom) error
-	UpdateRoomStatusByID(
-		ctx context.Context, hotel models.HotelRef, roomID uuid.UUID, room models.UpdateRoomStatus,
-	) error
-	DeleteRoomByID(ctx context.Context, hotel models.HotelRef, roomID uuid.UUID) error
+	CreateRoom(ctx context.Context, hotelRef models.HotelRef, room *models.CreateRoom) (*models.Room, error)
+	GetRooms(ctx context.Context, hotelRef models.HotelRef, page, limit uint64) (*models.RoomList, error)
+	GetRoomByID(ctx context.Context, roomID uuid.UUID) (*models.Room, error)
+	UpdateRoomByID(ctx context.Context, roomID uuid.UUID, room *models.UpdateRoom) (int64, error)
+	PatchRoomByID(ctx context.Context, roomID uuid.UUID, room *models.PatchRoom) (*models.Room, error)
+	UpdateRoomStatusByID(ctx context.Context, roomID uuid.UUID, room models.UpdateRoomStatus) (int64, error)
+	DeleteRoomByID(ctx context.Context, roomID uuid.UUID, force bool) ([]string, error)
 }
 
 // RoomCreate   godoc
@@ -54,13 +53,15 @@ func (h *Handler) RoomCreate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	createdRoom, err := h.svc.CreateRoom(ctx, hotelRef, mapper.RoomCreateRequestToEntity(req))
-	errHandler := &helper.ErrorHandler{Conflict: consts.ErrUniqueRoomField}
+	newRoom := mapper.RoomCreateRequestToEntity(req)
+	createdRoom, err := h.svc.CreateRoom(ctx, hotelRef, &newRoom)
+	errHandler := &helper.ErrorHandler{Conflict: consts.ErrUniqueRoomField, BadRequest: consts.ErrUnknownAmenity}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
 	}
 
-	roomResponse := mapper.RoomEntityToResponse(createdRoom)
+	roomResponse := mapper.RoomEntityToResponse(*createdRoom)
+	helper.SetETag(w, createdRoom.Version)
 	helper.SendSuccess(w, r, http.StatusCreated, roomResponse)
 }
 
@@ -137,7 +138,6 @@ func (h *Handler) RoomGetAll(w http.ResponseWriter, r *http.Request) {
 //	@Router			/{country_code}/{city_slug}/hotels/{hotel_slug}/rooms/{id} [get]
 func (h *Handler) RoomGetByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	hotelRef := middleware.GetHotelRef(ctx)
 
 	id, err := helper.ParseUUIDParam(r, "id")
 	if err != nil {
@@ -146,13 +146,14 @@ func (h *Handler) RoomGetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	room, err := h.svc.GetRoomByID(ctx, hotelRef, id)
+	room, err := h.svc.GetRoomByID(ctx, id)
 	errHandler := &helper.ErrorHandler{NotFound: consts.ErrRoomNotFound}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
 	}
 
-	roomResponse := mapper.RoomEntityToResponse(room)
+	roomResponse := mapper.RoomEntityToResponse(*room)
+	helper.SetETag(w, room.Version)
 	helper.SendSuccess(w, r, http.StatusOK, roomResponse)
 }
 
@@ -167,17 +168,18 @@ func (h *Handler) RoomGetByID(w http.ResponseWriter, r *http.Request) {
 //	@Param		    city_slug       path		string	true	"City HotelSlug"
 //	@Param		    hotel_slug      path		string	true	"Hotel slug"
 //	@Param			id	path		string	true	"Room ID"
+//	@Param			If-Match	header	string	false	"ETag of the room version being replaced"
 //	@Param          request  body   request.UpdateRoom  true  "Room data"
 //	@Success		200	{object}	response.UpdateRoom
 //	@Failure		400	{object}	response.ErrorSchema
 //	@Failure		401	{object}	response.ErrorSchema
 //	@Failure		404	{object}	response.ErrorSchema
+//	@Failure		412	{object}	response.ErrorSchema
 //	@Failure		500	{object}	response.ErrorSchema
 //	@Security		Bearer
 //	@Router			/{country_code}/{city_slug}/hotels/{hotel_slug}/rooms/{id} [put]
 func (h *Handler) RoomUpdateByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	hotelRef := middleware.GetHotelRef(ctx)
 
 	id, err := helper.ParseUUIDParam(r, "id")
 	errHandler := &helper.ErrorHandler{BadRequest: consts.ErrInvalidHotelID}
@@ -185,19 +187,90 @@ func (h *Handler) RoomUpdateByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expectedVersion, err := helper.ParseIfMatch(r)
+	errHandler = &helper.ErrorHandler{BadRequest: consts.ErrInvalidIfMatch}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
 	var req request.RoomUpdate
 	if err = helper.ParseJSON(w, r, &req, validation.CustomValidationError); err != nil {
 		return
 	}
 
 	roomUpdate := mapper.RoomUpdateRequestToEntity(req)
-	err = h.svc.UpdateRoomByID(ctx, hotelRef, id, roomUpdate)
-	errHandler = &helper.ErrorHandler{NotFound: consts.ErrRoomNotFound}
+	roomUpdate.ExpectedVersion = expectedVersion
+	version, err := h.svc.UpdateRoomByID(ctx, id, &roomUpdate)
+	errHandler = &helper.ErrorHandler{
+		NotFound:           consts.ErrRoomNotFound,
+		BadRequest:         consts.ErrUnknownAmenity,
+		PreconditionFailed: consts.ErrVersionMismatch,
+	}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
+	roomResponse := mapper.RoomUpdateEntityToResponse(roomUpdate, version)
+	helper.SetETag(w, version)
+	helper.SendSuccess(w, r, http.StatusOK, roomResponse)
+}
+
+// RoomPatchByID    godoc
+//
+//	@Summary		Partially update room by ID
+//	@Description	Update only the room fields present in the body from admin or owner provider
+//	@Tags			rooms
+//	@Accept			json
+//	@Produce		json
+//	@Param		    country_code    path		string	true	"Country Code"
+//	@Param		    city_slug       path		string	true	"City HotelSlug"
+//	@Param		    hotel_slug      path		string	true	"Hotel slug"
+//	@Param			id	path		string	true	"Room ID"
+//	@Param			If-Match	header	string	false	"ETag of the room version being changed"
+//	@Param          request  body   request.RoomPatch  true  "Room fields to update"
+//	@Success		200	{object}	response.Room
+//	@Failure		400	{object}	response.ErrorSchema
+//	@Failure		401	{object}	response.ErrorSchema
+//	@Failure		404	{object}	response.ErrorSchema
+//	@Failure		412	{object}	response.ErrorSchema
+//	@Failure		500	{object}	response.ErrorSchema
+//	@Security		Bearer
+//	@Router			/{country_code}/{city_slug}/hotels/{hotel_slug}/rooms/{id} [patch]
+func (h *Handler) RoomPatchByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := helper.ParseUUIDParam(r, "id")
+	errHandler := &helper.ErrorHandler{BadRequest: consts.ErrInvalidHotelID}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
+	expectedVersion, err := helper.ParseIfMatch(r)
+	errHandler = &helper.ErrorHandler{BadRequest: consts.ErrInvalidIfMatch}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
 	}
 
-	roomResponse := mapper.RoomUpdateEntityToResponse(roomUpdate)
+	var req request.RoomPatch
+	fields, err := helper.ParsePatchJSON(w, r, &req, validation.CustomValidationError)
+	if err != nil {
+		return
+	}
+
+	roomPatch := mapper.RoomPatchRequestToEntity(req, fields)
+	roomPatch.ExpectedVersion = expectedVersion
+	room, err := h.svc.PatchRoomByID(ctx, id, &roomPatch)
+	errHandler = &helper.ErrorHandler{
+		NotFound:           consts.ErrRoomNotFound,
+		BadRequest:         consts.ErrUnknownAmenity,
+		PreconditionFailed: consts.ErrVersionMismatch,
+	}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
+	roomResponse := mapper.RoomEntityToResponse(*room)
+	helper.SetETag(w, room.Version)
 	helper.SendSuccess(w, r, http.StatusOK, roomResponse)
 }
 
@@ -212,17 +285,18 @@ func (h *Handler) RoomUpdateByID(w http.ResponseWriter, r *http.Request) {
 //	@Param		    city_slug       path		string	true	"City HotelSlug"
 //	@Param		    hotel_slug      path		string	true	"Hotel slug"
 //	@Param			id	path		string	true	"Room ID"
+//	@Param			If-Match	header	string	false	"ETag of the room version being changed"
 //	@Param          request  body   request.UpdateRoomStatus  true  "Room data"
 //	@Success		200	{object}	response.UpdateRoomStatus
 //	@Failure		400	{object}	response.ErrorSchema
 //	@Failure		401	{object}	response.ErrorSchema
 //	@Failure		404	{object}	response.ErrorSchema
+//	@Failure		412	{object}	response.ErrorSchema
 //	@Failure		500	{object}	response.ErrorSchema
 //	@Security		Bearer
 //	@Router			/{country_code}/{city_slug}/hotels/{hotel_slug}/rooms/{id}/update_status [put]
 func (h *Handler) RoomStatusUpdateByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	hotelRef := middleware.GetHotelRef(ctx)
 
 	id, err := helper.ParseUUIDParam(r, "id")
 	if err != nil {
@@ -231,19 +305,30 @@ func (h *Handler) RoomStatusUpdateByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expectedVersion, err := helper.ParseIfMatch(r)
+	errHandler := &helper.ErrorHandler{BadRequest: consts.ErrInvalidIfMatch}
+	if err = errHandler.Handle(w, r, err); err != nil {
+		return
+	}
+
 	var req request.RoomStatusUpdate
 	if err = helper.ParseJSON(w, r, &req, validation.CustomValidationError); err != nil {
 		return
 	}
 
 	roomUpdate := mapper.RoomStatusUpdateRequestToEntity(req)
-	err = h.svc.UpdateRoomStatusByID(ctx, hotelRef, id, roomUpdate)
-	errHandler := &helper.ErrorHandler{NotFound: consts.ErrRoomNotFound}
+	roomUpdate.ExpectedVersion = expectedVersion
+	version, err := h.svc.UpdateRoomStatusByID(ctx, id, roomUpdate)
+	errHandler = &helper.ErrorHandler{
+		NotFound:           consts.ErrRoomNotFound,
+		PreconditionFailed: consts.ErrVersionMismatch,
+	}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
 	}
 
-	roomResponse := mapper.RoomStatusUpdateEntityToResponse(roomUpdate)
+	roomResponse := mapper.RoomStatusUpdateEntityToResponse(roomUpdate, version)
+	helper.SetETag(w, version)
 	helper.SendSuccess(w, r, http.StatusOK, roomResponse)
 }
 
@@ -258,16 +343,17 @@ func (h *Handler) RoomStatusUpdateByID(w http.ResponseWriter, r *http.Request) {
 //	@Param		    city_slug       path		string	true	"City HotelSlug"
 //	@Param		    hotel_slug      path		string	true	"Hotel slug"
 //	@Param			id	path		string	true	"Room ID"
+//	@Param			force	query		bool	false	"Cancel active and upcoming bookings"
 //	@Success		204	{object}	nil
 //	@Failure		400	{object}	response.ErrorSchema
 //	@Failure		401	{object}	response.ErrorSchema
 //	@Failure		404	{object}	response.ErrorSchema
+//	@Failure		409	{object}	response.ErrorSchema
 //	@Failure		500	{object}	response.ErrorSchema
 //	@Security		Bearer
 //	@Router			/{country_code}/{city_slug}/hotels/{hotel_slug}/rooms/{id} [delete]
 func (h *Handler) RoomDeleteByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	hotelRef := middleware.GetHotelRef(ctx)
 
 	id, err := helper.ParseUUIDParam(r, "id")
 	if err != nil {
@@ -276,8 +362,12 @@ func (h *Handler) RoomDeleteByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.svc.DeleteRoomByID(ctx, hotelRef, id)
-	errHandler := &helper.ErrorHandler{NotFound: consts.ErrRoomNotFound}
+	force := r.URL.Query().Get("force") == "true"
+	_, err = h.svc.DeleteRoomByID(ctx, id, force)
+	errHandler := &helper.ErrorHandler{
+		NotFound: consts.ErrRoomNotFound,
+		Conflict: consts.ErrRoomHasActiveBookings,
+	}
 	if err = errHandler.Handle(w, r, err); err != nil {
 		return
 	}
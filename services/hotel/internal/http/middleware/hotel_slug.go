@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// HotelSlugResolver returns the live slug of the hotel addressed by the request's hotel ref.
+type HotelSlugResolver func(ctx context.Context) (string, error)
+
+// CanonicalHotelSlug answers GET requests made with a retired hotel slug with a 301 to the
+// canonical path. It must run after HotelPathValidator; unknown hotels fall through untouched.
+func CanonicalHotelSlug(resolve HotelSlugResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			hotelRef := GetHotelRef(r.Context())
+			canonicalSlug, err := resolve(r.Context())
+			if err != nil || canonicalSlug == hotelRef.HotelSlug {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			segment := "/hotels/" + hotelRef.HotelSlug
+			idx := strings.Index(r.URL.Path, segment)
+			if idx < 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+			rest := r.URL.Path[idx+len(segment):]
+			if rest != "" && rest[0] != '/' {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			target := *r.URL
+			target.Path = r.URL.Path[:idx] + "/hotels/" + canonicalSlug + rest
+			target.RawPath = ""
+			http.Redirect(w, r, target.String(), http.StatusMovedPermanently)
+		})
+	}
+}
@@ -19,6 +19,9 @@ func New(r chi.Router, h *handler.Handler) {
 	r.Route("/api/v1", func(r chi.Router) {
 		r.Get("/swagger/*", httpswagger.WrapHandler)
 
+		r.Get("/countries", h.CountryGetAll)
+		r.Get("/countries/{countryCode}/cities", h.CityGetAll)
+
 		hotelRouter("/{countryCode}/{citySlug}/hotels", r, h)
 	})
 }
@@ -14,10 +14,13 @@ func hotelRouter(pattern string, r chi.Router, h *handler.Handler) {
 
 			r.Post("/", h.HotelCreate)
 			r.Get("/", h.HotelGetAll)
-			r.Get("/{hotelSlug}", h.HotelGetBySlug)
+			r.With(middleware.CanonicalHotelSlug(h.HotelCanonicalSlug)).Get("/{hotelSlug}", h.HotelGetBySlug)
 			r.Put("/{hotelSlug}", h.HotelUpdateBySlug)
+			r.Patch("/{hotelSlug}", h.HotelPatchBySlug)
 			r.Put("/{hotelSlug}/update_title", h.HotelTitleUpdateBySlug)
 			r.Delete("/{hotelSlug}", h.HotelDeleteBySlug)
+			r.Post("/{hotelSlug}/images", h.ImageUploadHotel)
+			r.Delete("/{hotelSlug}/images/{imageID}", h.ImageDeleteByID)
 
 			roomRouter("/{hotelSlug}/rooms", r, h)
 		})
@@ -4,15 +4,20 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	"hotel/internal/http/handler"
+	"hotel/internal/http/middleware"
 )
 
 func roomRouter(pattern string, r chi.Router, h *handler.Handler) {
 	r.Route(pattern, func(r chi.Router) {
+		r.Use(middleware.CanonicalHotelSlug(h.HotelCanonicalSlug))
+
 		r.Post("/", h.RoomCreate)
 		r.Get("/", h.RoomGetAll)
 		r.Get("/{id}", h.RoomGetByID)
 		r.Put("/{id}", h.RoomUpdateByID)
+		r.Patch("/{id}", h.RoomPatchByID)
 		r.Put("/{id}/update_status", h.RoomStatusUpdateByID)
 		r.Delete("/{id}", h.RoomDeleteByID)
+		r.Post("/{id}/images", h.ImageUploadRoom)
 	})
 }
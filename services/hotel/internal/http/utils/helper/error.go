@@ -9,10 +9,11 @@ import (
 )
 
 type ErrorHandler struct {
-	NotFound     error
-	Conflict     error
-	BadRequest   error
-	Unauthorized error
+	NotFound           error
+	Conflict           error
+	BadRequest         error
+	Unauthorized       error
+	PreconditionFailed error
 }
 
 func (h *ErrorHandler) Handle(w http.ResponseWriter, r *http.Request, err error) error {
@@ -38,6 +39,12 @@ func (h *ErrorHandler) Handle(w http.ResponseWriter, r *http.Request, err error)
 		return err
 	}
 
+	if h.PreconditionFailed != nil && errors.Is(err, h.PreconditionFailed) {
+		errMsg := response.ErrorResp(h.PreconditionFailed)
+		SendError(w, r, http.StatusPreconditionFailed, errMsg)
+		return err
+	}
+
 	if h.Unauthorized != nil && errors.Is(err, h.Unauthorized) {
 		errMsg := response.ErrorResp(h.Unauthorized)
 		SendError(w, r, http.StatusUnauthorized, errMsg)
@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"hotel/pkg/lib/utils/consts"
+)
+
+// SetETag exposes the resource version as a strong ETag.
+func SetETag(w http.ResponseWriter, version int64) {
+	w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// ParseIfMatch returns the version named by the If-Match header, or nil when the
+// header is absent or "*". Weak and multiple ETags are rejected.
+func ParseIfMatch(r *http.Request) (*int64, error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	tag, err := strconv.Unquote(header)
+	if err != nil {
+		return nil, consts.ErrInvalidIfMatch
+	}
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version < 1 {
+		return nil, consts.ErrInvalidIfMatch
+	}
+
+	return &version, nil
+}
@@ -1,7 +1,12 @@
 package helper
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"maps"
 	"net/http"
+	"slices"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
@@ -34,6 +39,47 @@ func ParseJSON(
 	return nil
 }
 
+// ParsePatchJSON decodes a partial update body into v and returns the JSON names of the
+// fields present in it. Only those fields are validated; an explicit null counts as present.
+func ParsePatchJSON(
+	w http.ResponseWriter, r *http.Request,
+	v any,
+	customErr func(validator.FieldError) string,
+) ([]string, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		errMsg := validation.ErrorResp(consts.ErrInvalidJSON)
+		SendError(w, r, http.StatusBadRequest, errMsg)
+		return nil, err
+	}
+
+	var present map[string]json.RawMessage
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err = json.Unmarshal(body, &present); err == nil {
+		err = decoder.Decode(v)
+	}
+	if err != nil {
+		errMsg := validation.ErrorResp(consts.ErrInvalidJSON)
+		SendError(w, r, http.StatusBadRequest, errMsg)
+		return nil, err
+	}
+
+	fields := slices.Sorted(maps.Keys(present))
+	if len(fields) == 0 {
+		errMsg := validation.ErrorResp(consts.ErrEmptyPatch)
+		SendError(w, r, http.StatusBadRequest, errMsg)
+		return nil, consts.ErrEmptyPatch
+	}
+
+	if errMsg := validation.CheckPartialErrors(v, fields, customErr); errMsg != nil {
+		SendError(w, r, http.StatusBadRequest, errMsg)
+		return nil, consts.ErrInvalidJSON
+	}
+
+	return fields, nil
+}
+
 func ParseHotelPathParams(r *http.Request) (models.HotelRef, *validation.ValidateError) {
 	pathParams := request.HotelPathParams{
 		CountryCode: chi.URLParam(r, "countryCode"),
@@ -0,0 +1,29 @@
+package mapper
+
+import (
+	"hotel/internal/http/dto/response"
+	"hotel/internal/repository/models"
+)
+
+func CountryEntityToResponse(c *models.Country) response.Country {
+	return response.Country{
+		Names:      c.Names,
+		ISOAlpha3:  c.ISOAlpha3,
+		Code:       c.Code,
+		HotelCount: c.HotelCount,
+	}
+}
+
+func CityEntityToResponse(c *models.City) response.City {
+	return response.City{
+		Names:       c.Names,
+		CountryCode: c.CountryCode,
+		Slug:        c.Slug,
+		Timezone:    c.Timezone,
+		Centroid: response.Location{
+			Latitude:  c.Centroid.Latitude,
+			Longitude: c.Centroid.Longitude,
+		},
+		HotelCount: c.HotelCount,
+	}
+}
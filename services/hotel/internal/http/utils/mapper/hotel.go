@@ -12,11 +12,14 @@ func HotelCreateRequestToEntity(req request.HotelCreate) models.CreateHotel {
 		Longitude: *req.Location.Longitude,
 	}
 	return models.CreateHotel{
-		Title:       *req.Title,
-		OwnerID:     *req.OwnerID,
-		Description: req.Description,
-		Address:     *req.Address,
-		Location:    location,
+		Title:        *req.Title,
+		OwnerID:      *req.OwnerID,
+		Description:  req.Description,
+		Address:      *req.Address,
+		Location:     location,
+		Timezone:     req.Timezone,
+		CheckInTime:  req.CheckInTime,
+		CheckOutTime: req.CheckOutTime,
 	}
 }
 
@@ -26,12 +29,46 @@ func HotelUpdateRequestToEntity(req request.HotelUpdate) models.UpdateHotel {
 		Longitude: *req.Location.Longitude,
 	}
 	return models.UpdateHotel{
-		Description: req.Description,
-		Address:     *req.Address,
-		Location:    location,
+		Description:  req.Description,
+		Address:      *req.Address,
+		Location:     location,
+		Timezone:     req.Timezone,
+		CheckInTime:  req.CheckInTime,
+		CheckOutTime: req.CheckOutTime,
 	}
 }
 
+// HotelPatchRequestToEntity copies the present fields; required ones are non-nil after validation.
+func HotelPatchRequestToEntity(req request.HotelPatch, fields []string) models.PatchHotel {
+	h := models.PatchHotel{
+		Description: new(string),
+		Fields:      fields,
+	}
+	if req.Description != nil {
+		h.Description = req.Description
+	}
+	if req.Address != nil {
+		h.Address = *req.Address
+	}
+	if req.Location != nil {
+		h.Location = models.Location{
+			Latitude:  *req.Location.Latitude,
+			Longitude: *req.Location.Longitude,
+		}
+	}
+	if req.Timezone != nil {
+		h.Timezone = *req.Timezone
+	}
+	if req.CheckInTime != nil {
+		h.CheckInTime = *req.CheckInTime
+	}
+	if req.CheckOutTime != nil {
+		h.CheckOutTime = *req.CheckOutTime
+	}
+
+	return h
+}
+
 func HotelTitleUpdateRequestToEntity(req request.HotelTitleUpdate) models.UpdateHotelTitle {
 	return models.UpdateHotelTitle{
 		Title: *req.Title,
@@ -44,15 +81,20 @@ func HotelCreateEntityToResponse(req models.Hotel) response.HotelCreate {
 		Longitude: req.Location.Longitude,
 	}
 	return response.HotelCreate{
-		ID:          req.ID,
-		Title:       req.Title,
-		Slug:        req.HotelSlug,
-		OwnerID:     req.OwnerID,
-		Description: req.Description,
-		Address:     req.Address,
-		Location:    location,
-		CreatedAt:   req.CreatedAt,
-		UpdatedAt:   req.UpdatedAt,
+		ID:           req.ID,
+		Title:        req.Title,
+		Slug:         req.HotelSlug,
+		OwnerID:      req.OwnerID,
+		Description:  req.Description,
+		Address:      req.Address,
+		Location:     location,
+		Timezone:     req.Timezone,
+		CheckInTime:  req.CheckInTime,
+		CheckOutTime: req.CheckOutTime,
+		Status:       string(req.Status),
+		Version:      req.Version,
+		CreatedAt:    req.CreatedAt,
+		UpdatedAt:    req.UpdatedAt,
 	}
 }
 
@@ -62,14 +104,19 @@ func HotelGetEntityToResponse(req models.Hotel) response.Hotel {
 		Longitude: req.Location.Longitude,
 	}
 	return response.Hotel{
-		ID:          req.ID,
-		Title:       req.Title,
-		OwnerID:     req.OwnerID,
-		Description: req.Description,
-		Address:     req.Address,
-		Location:    location,
-		CreatedAt:   req.CreatedAt,
-		UpdatedAt:   req.UpdatedAt,
+		ID:           req.ID,
+		Title:        req.Title,
+		OwnerID:      req.OwnerID,
+		Description:  req.Description,
+		Address:      req.Address,
+		Location:     location,
+		Timezone:     req.Timezone,
+		CheckInTime:  req.CheckInTime,
+		CheckOutTime: req.CheckOutTime,
+		Status:       string(req.Status),
+		Version:      req.Version,
+		CreatedAt:    req.CreatedAt,
+		UpdatedAt:    req.UpdatedAt,
 	}
 }
 
@@ -89,22 +136,27 @@ func HotelShortEntityToShortResponse(req models.HotelShort) response.HotelShort
 	}
 }
 
-func HotelUpdateEntityToResponse(req models.UpdateHotel) response.HotelUpdate {
+func HotelUpdateEntityToResponse(req models.UpdateHotel, version int64) response.HotelUpdate {
 	location := response.Location{
 		Latitude:  req.Location.Latitude,
 		Longitude: req.Location.Longitude,
 	}
 	return response.HotelUpdate{
-		Description: req.Description,
-		Address:     req.Address,
-		Location:    location,
+		Description:  req.Description,
+		Address:      req.Address,
+		Timezone:     req.Timezone,
+		CheckInTime:  req.CheckInTime,
+		CheckOutTime: req.CheckOutTime,
+		Version:      version,
+		Location:     location,
 	}
 }
 
 func HotelTitleUpdateEntityToResponse(req models.UpdateHotelTitle) response.HotelTitleUpdate {
 	return response.HotelTitleUpdate{
-		Title: req.Title,
-		Slug:  req.HotelSlug,
+		Title:   req.Title,
+		Slug:    req.HotelSlug,
+		Version: req.Version,
 	}
 }
 
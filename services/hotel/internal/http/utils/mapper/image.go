@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"hotel/internal/http/dto/response"
+	"hotel/internal/repository/models"
+)
+
+func ImageEntityToResponse(img *models.Image) response.Image {
+	return response.Image{
+		CreatedAt: img.CreatedAt,
+		RoomID:    img.RoomID,
+		URLs: response.ImageURLs{
+			Original:      img.URLs.Original,
+			Thumbnail:     img.URLs.Thumbnail,
+			ThumbnailWebP: img.URLs.ThumbnailWebP,
+			WebP:          img.URLs.WebP,
+		},
+		ContentType: img.ContentType,
+		SizeBytes:   img.SizeBytes,
+		Width:       img.Width,
+		Height:      img.Height,
+		Position:    img.Position,
+		ID:          img.ID,
+		HotelID:     img.HotelID,
+	}
+}
@@ -36,6 +36,41 @@ func RoomUpdateRequestToEntity(req request.RoomUpdate) models.UpdateRoom {
 	}
 }
 
+// RoomPatchRequestToEntity copies the present fields; required ones are non-nil after validation.
+func RoomPatchRequestToEntity(req request.RoomPatch, fields []string) models.PatchRoom {
+	room := models.PatchRoom{
+		Fields:    fields,
+		Amenities: req.Amenities,
+		Images:    req.Images,
+	}
+	if req.Title != nil {
+		room.Title = *req.Title
+	}
+	if req.Description != nil {
+		room.Description = *req.Description
+	}
+	if req.RoomNumber != nil {
+		room.RoomNumber = *req.RoomNumber
+	}
+	if req.Type != nil {
+		room.Type = *req.Type
+	}
+	if req.Price != nil {
+		room.Price = *req.Price
+	}
+	if req.Capacity != nil {
+		room.Capacity = *req.Capacity
+	}
+	if req.AreaSqm != nil {
+		room.AreaSqm = *req.AreaSqm
+	}
+	if req.Floor != nil {
+		room.Floor = *req.Floor
+	}
+
+	return room
+}
+
 func RoomStatusUpdateRequestToEntity(req request.RoomStatusUpdate) models.UpdateRoomStatus {
 	return models.UpdateRoomStatus{
 		Status: req.Status,
@@ -56,6 +91,7 @@ func RoomEntityToResponse(req models.Room) response.Room {
 		Floor:       req.Floor,
 		Amenities:   req.Amenities,
 		Images:      req.Images,
+		Version:     req.Version,
 		CreatedAt:   req.CreatedAt,
 		UpdatedAt:   req.UpdatedAt,
 	}
@@ -76,7 +112,7 @@ func RoomShortEntityToShortResponse(req models.RoomShort) response.RoomShort {
 	}
 }
 
-func RoomUpdateEntityToResponse(req models.UpdateRoom) response.RoomUpdate {
+func RoomUpdateEntityToResponse(req models.UpdateRoom, version int64) response.RoomUpdate {
 	return response.RoomUpdate{
 		Title:       req.Title,
 		Description: &req.Description,
@@ -88,11 +124,13 @@ func RoomUpdateEntityToResponse(req models.UpdateRoom) response.RoomUpdate {
 		Floor:       req.Floor,
 		Amenities:   req.Amenities,
 		Images:      req.Images,
+		Version:     version,
 	}
 }
 
-func RoomStatusUpdateEntityToResponse(req models.UpdateRoomStatus) response.RoomStatusUpdate {
+func RoomStatusUpdateEntityToResponse(req models.UpdateRoomStatus, version int64) response.RoomStatusUpdate {
 	return response.RoomStatusUpdate{
-		Status: req.Status,
+		Status:  req.Status,
+		Version: version,
 	}
 }
@@ -0,0 +1,13 @@
+package validation
+
+import (
+	"regexp"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var amenityCodeRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+func amenityCodeValidator(fl validator.FieldLevel) bool {
+	return amenityCodeRegexp.MatchString(fl.Field().String())
+}
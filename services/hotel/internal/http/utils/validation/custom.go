@@ -19,6 +19,8 @@ func CustomValidationError(err validator.FieldError) string {
 		return consts.FieldRequired
 	case "slug_format":
 		return consts.FieldSlug
+	case "amenity_code":
+		return consts.FieldAmenityCode
 	case "min":
 		return fmt.Sprintf(consts.FieldMin, param)
 	case "max":
@@ -35,6 +37,8 @@ func CustomValidationError(err validator.FieldError) string {
 		return consts.FieldUUID
 	case "datetime":
 		return fmt.Sprintf(consts.FieldDatetime, param)
+	case "timezone":
+		return consts.FieldTimezone
 	case "room_status":
 		vals := make([]string, len(models.RoomStatusValues))
 		for i, v := range models.RoomStatusValues {
@@ -3,6 +3,7 @@ package validation
 import (
 	"errors"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
@@ -40,7 +41,7 @@ func formatValidationErrors(
 	return errorMessages
 }
 
-func CheckErrors(v any, customErr func(validator.FieldError) string) *ValidateError {
+func newValidator() *validator.Validate {
 	validate := validator.New()
 	if err := validate.RegisterValidation("slug_format", slugFormatValidator); err != nil {
 		panic(consts.ValidationUnregister + err.Error())
@@ -57,26 +58,57 @@ func CheckErrors(v any, customErr func(validator.FieldError) string) *ValidateEr
 	if err := validate.RegisterValidation("decimal_lt", decimalLtValidator); err != nil {
 		panic(consts.ValidationUnregister + err.Error())
 	}
+	if err := validate.RegisterValidation("amenity_code", amenityCodeValidator); err != nil {
+		panic(consts.ValidationUnregister + err.Error())
+	}
+
+	validate.RegisterTagNameFunc(jsonFieldName)
+
+	return validate
+}
+
+func jsonFieldName(fld reflect.StructField) string {
+	name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	if name == "-" || name == "" {
+		return fld.Name
+	}
+	return name
+}
 
-	validate.RegisterTagNameFunc(
-		func(fld reflect.StructField) string {
-			name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
-			if name == "-" || name == "" {
-				return fld.Name
-			}
-			return name
-		},
-	)
-
-	if err := validate.Struct(v); err != nil {
-		var validateErr validator.ValidationErrors
-		errors.As(err, &validateErr)
-
-		errorsResp := ValidateError{
-			Errors: formatValidationErrors(validateErr, customErr),
+func toValidateError(err error, customErr func(validator.FieldError) string) *ValidateError {
+	if err == nil {
+		return nil
+	}
+
+	var validateErr validator.ValidationErrors
+	errors.As(err, &validateErr)
+
+	return &ValidateError{
+		Errors: formatValidationErrors(validateErr, customErr),
+	}
+}
+
+func CheckErrors(v any, customErr func(validator.FieldError) string) *ValidateError {
+	return toValidateError(newValidator().Struct(v), customErr)
+}
+
+// CheckPartialErrors validates only the top-level fields of v whose JSON names are listed in fields.
+func CheckPartialErrors(v any, fields []string, customErr func(validator.FieldError) string) *ValidateError {
+	typ := reflect.Indirect(reflect.ValueOf(v)).Type()
+	masked := make(map[string]struct{}, len(fields))
+	for i := range typ.NumField() {
+		if fld := typ.Field(i); slices.Contains(fields, jsonFieldName(fld)) {
+			masked[fld.Name] = struct{}{}
 		}
-		return &errorsResp
 	}
 
-	return nil
+	err := newValidator().StructFiltered(v, func(ns []byte) bool {
+		_, top, _ := strings.Cut(string(ns), ".")
+		top, _, _ = strings.Cut(top, ".")
+		top, _, _ = strings.Cut(top, "[")
+		_, ok := masked[top]
+		return !ok
+	})
+
+	return toValidateError(err, customErr)
 }
@@ -0,0 +1,100 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"outbox"
+)
+
+const (
+	AggregateHotel = "hotel"
+	AggregateRoom  = "room"
+)
+
+const (
+	EventHotelUpdated     = "hotel.updated"
+	EventRoomUpdated      = "room.updated"
+	EventRoomPriceChanged = "room.price_changed"
+	EventHotelDeleted     = "hotel.deleted"
+	EventHotelRestored    = "hotel.restored"
+	EventRoomDeleted      = "room.deleted"
+	EventRoomRestored     = "room.restored"
+)
+
+func HotelUpdated(hotelID uuid.UUID, version int64, at time.Time) (*outbox.Event, error) {
+	return newEvent(AggregateHotel, hotelID, EventHotelUpdated, &hotelv1.HotelUpdated{
+		HotelId:    hotelID.String(),
+		Version:    version,
+		OccurredAt: timestamppb.New(at),
+	})
+}
+
+func RoomUpdated(roomID, hotelID uuid.UUID, version int64, at time.Time) (*outbox.Event, error) {
+	return newEvent(AggregateRoom, roomID, EventRoomUpdated, &hotelv1.RoomUpdated{
+		RoomId:     roomID.String(),
+		HotelId:    hotelID.String(),
+		Version:    version,
+		OccurredAt: timestamppb.New(at),
+	})
+}
+
+func RoomPriceChanged(
+	roomID, hotelID uuid.UUID,
+	oldPrice, newPrice decimal.Decimal,
+	version int64,
+	at time.Time,
+) (*outbox.Event, error) {
+	return newEvent(AggregateRoom, roomID, EventRoomPriceChanged, &hotelv1.RoomPriceChanged{
+		RoomId:     roomID.String(),
+		HotelId:    hotelID.String(),
+		OldPrice:   oldPrice.StringFixed(2),
+		NewPrice:   newPrice.StringFixed(2),
+		Version:    version,
+		OccurredAt: timestamppb.New(at),
+	})
+}
+
+func HotelDeleted(hotelID uuid.UUID, at time.Time) (*outbox.Event, error) {
+	return newEvent(AggregateHotel, hotelID, EventHotelDeleted, &hotelv1.HotelDeleted{
+		HotelId:    hotelID.String(),
+		OccurredAt: timestamppb.New(at),
+	})
+}
+
+func HotelRestored(hotelID uuid.UUID, at time.Time) (*outbox.Event, error) {
+	return newEvent(AggregateHotel, hotelID, EventHotelRestored, &hotelv1.HotelRestored{
+		HotelId:    hotelID.String(),
+		OccurredAt: timestamppb.New(at),
+	})
+}
+
+func RoomDeleted(roomID, hotelID uuid.UUID, at time.Time) (*outbox.Event, error) {
+	return newEvent(AggregateRoom, roomID, EventRoomDeleted, &hotelv1.RoomDeleted{
+		RoomId:     roomID.String(),
+		HotelId:    hotelID.String(),
+		OccurredAt: timestamppb.New(at),
+	})
+}
+
+func RoomRestored(roomID, hotelID uuid.UUID, at time.Time) (*outbox.Event, error) {
+	return newEvent(AggregateRoom, roomID, EventRoomRestored, &hotelv1.RoomRestored{
+		RoomId:     roomID.String(),
+		HotelId:    hotelID.String(),
+		OccurredAt: timestamppb.New(at),
+	})
+}
+
+func newEvent(
+	aggregateType string,
+	aggregateID uuid.UUID,
+	eventType string,
+	msg proto.Message,
+) (*outbox.Event, error) {
+	return outbox.NewEvent(aggregateType, aggregateID.String(), eventType, msg)
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"hotel/pkg/lib/utils/consts"
+)
+
+type AmenityCategory string
+
+const (
+	AmenityCategoryUnspecified   AmenityCategory = "AMENITY_CATEGORY_UNSPECIFIED"
+	AmenityCategoryGeneral       AmenityCategory = "AMENITY_CATEGORY_GENERAL"
+	AmenityCategoryInternet      AmenityCategory = "AMENITY_CATEGORY_INTERNET"
+	AmenityCategoryBathroom      AmenityCategory = "AMENITY_CATEGORY_BATHROOM"
+	AmenityCategoryKitchen       AmenityCategory = "AMENITY_CATEGORY_KITCHEN"
+	AmenityCategoryFoodAndDrink  AmenityCategory = "AMENITY_CATEGORY_FOOD_AND_DRINK"
+	AmenityCategoryEntertainment AmenityCategory = "AMENITY_CATEGORY_ENTERTAINMENT"
+	AmenityCategoryWellness      AmenityCategory = "AMENITY_CATEGORY_WELLNESS"
+	AmenityCategoryParking       AmenityCategory = "AMENITY_CATEGORY_PARKING"
+	AmenityCategoryAccessibility AmenityCategory = "AMENITY_CATEGORY_ACCESSIBILITY"
+	AmenityCategoryOther         AmenityCategory = "AMENITY_CATEGORY_OTHER"
+)
+
+type CreateAmenity struct {
+	Names    LocalizedNames
+	Code     string
+	Category AmenityCategory
+	Icon     string
+}
+
+type UpdateAmenity struct {
+	Names    LocalizedNames
+	Category AmenityCategory
+	Icon     string
+}
+
+type Amenity struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Names     LocalizedNames
+	Code      string
+	Category  AmenityCategory
+	Icon      string
+}
+
+func (a *CreateAmenity) ToRead() *Amenity {
+	return &Amenity{
+		Names:    a.Names,
+		Code:     a.Code,
+		Category: a.Category,
+		Icon:     a.Icon,
+	}
+}
+
+// UnknownAmenityError lists the submitted codes that are missing from the catalogue.
+type UnknownAmenityError struct {
+	Codes []string
+}
+
+func (e *UnknownAmenityError) Error() string {
+	return consts.MsgUnknownAmenity + ": " + strings.Join(e.Codes, ", ")
+}
+
+func (e *UnknownAmenityError) Unwrap() error {
+	return consts.ErrUnknownAmenity
+}
@@ -0,0 +1,10 @@
+package models
+
+import "github.com/google/uuid"
+
+// BookingTarget selects the bookings of a whole hotel or of a single room;
+// exactly one of the ids is set.
+type BookingTarget struct {
+	HotelID *uuid.UUID
+	RoomID  *uuid.UUID
+}
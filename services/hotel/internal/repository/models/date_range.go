@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// DateRange is a half-open range of dates: Start is included, End is not.
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (r DateRange) Contains(date time.Time) bool {
+	return !date.Before(r.Start) && date.Before(r.End)
+}
@@ -2,6 +2,8 @@ package models
 
 type RoomType string
 type RoomStatus string
+type HotelStatus string
+type UserRole string
 
 const (
 	RoomTypeUnspecified  RoomType = "ROOM_TYPE_UNSPECIFIED"
@@ -17,6 +19,17 @@ const (
 	RoomStatusOccupied    RoomStatus = "ROOM_STATUS_OCCUPIED"
 	RoomStatusMaintenance RoomStatus = "ROOM_STATUS_MAINTENANCE"
 	RoomStatusCleaning    RoomStatus = "ROOM_STATUS_CLEANING"
+
+	HotelStatusUnspecified   HotelStatus = "HOTEL_STATUS_UNSPECIFIED"
+	HotelStatusDraft         HotelStatus = "HOTEL_STATUS_DRAFT"
+	HotelStatusPendingReview HotelStatus = "HOTEL_STATUS_PENDING_REVIEW"
+	HotelStatusPublished     HotelStatus = "HOTEL_STATUS_PUBLISHED"
+	HotelStatusSuspended     HotelStatus = "HOTEL_STATUS_SUSPENDED"
+
+	UserRoleUnspecified UserRole = "USER_ROLE_UNSPECIFIED"
+	UserRoleUser        UserRole = "USER_ROLE_USER"
+	UserRoleModerator   UserRole = "USER_ROLE_MODERATOR"
+	UserRoleAdmin       UserRole = "USER_ROLE_ADMIN"
 )
 
 var RoomTypeValues = []RoomType{
@@ -0,0 +1,27 @@
+package models
+
+type Country struct {
+	Names      LocalizedNames
+	ISOAlpha3  *string
+	Code       string
+	HotelCount uint64
+}
+
+type City struct {
+	Names       LocalizedNames
+	CountryCode string
+	Slug        string
+	Timezone    string
+	Centroid    Location
+	HotelCount  uint64
+}
+
+type CityFilter struct {
+	CountryCode    string
+	OnlyWithHotels bool
+}
+
+type CityList struct {
+	Cities     []*City
+	TotalCount uint64
+}
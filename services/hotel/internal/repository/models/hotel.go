@@ -12,25 +12,56 @@ type Location struct {
 }
 
 type CreateHotel struct {
-	Description *string
-	CountryCode string
-	CitySlug    string
-	Title       string
-	HotelSlug   string
-	Address     string
-	OwnerID     int64
-	Location    Location
+	Description  *string
+	Timezone     *string
+	CheckInTime  *string
+	CheckOutTime *string
+	CountryCode  string
+	CitySlug     string
+	Title        string
+	HotelSlug    string
+	Address      string
+	OwnerID      int64
+	Location     Location
 }
 
 type UpdateHotel struct {
-	Description *string
-	Address     string
-	Location    Location
+	Description     *string
+	Timezone        *string
+	CheckInTime     *string
+	CheckOutTime    *string
+	ExpectedVersion *int64
+	Address         string
+	Location        Location
+}
+
+// Hotel fields that can be named in a partial update.
+const (
+	HotelFieldDescription  = "description"
+	HotelFieldAddress      = "address"
+	HotelFieldLocation     = "location"
+	HotelFieldTimezone     = "timezone"
+	HotelFieldCheckInTime  = "check_in_time"
+	HotelFieldCheckOutTime = "check_out_time"
+)
+
+// PatchHotel holds new values for the hotel fields listed in Fields; other values are ignored.
+type PatchHotel struct {
+	Description     *string
+	ExpectedVersion *int64
+	Fields          []string
+	Timezone        string
+	CheckInTime     string
+	CheckOutTime    string
+	Address         string
+	Location        Location
 }
 
 type UpdateHotelTitle struct {
-	Title     string
-	HotelSlug string
+	ExpectedVersion *int64
+	Title           string
+	HotelSlug       string
+	Version         int64
 }
 
 type HotelShort struct {
@@ -44,16 +75,25 @@ type HotelShort struct {
 }
 
 type Hotel struct {
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	Description *string
-	Rating      *float32
-	Title       string
-	Address     string
-	HotelSlug   string
-	OwnerID     int64
-	Location    Location
-	ID          uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
+	Description  *string
+	Rating       *float32
+	Title        string
+	Address      string
+	CountryCode  string
+	CitySlug     string
+	HotelSlug    string
+	Timezone     string
+	CheckInTime  string
+	CheckOutTime string
+	Status       HotelStatus
+	Policy       *HotelPolicy
+	OwnerID      int64
+	Version      int64
+	Location     Location
+	ID           uuid.UUID
 }
 
 type HotelList struct {
@@ -63,12 +103,16 @@ type HotelList struct {
 
 func (h *CreateHotel) ToRead() *Hotel {
 	return &Hotel{
-		Title:       h.Title,
-		HotelSlug:   h.HotelSlug,
-		OwnerID:     h.OwnerID,
-		Description: h.Description,
-		Address:     h.Address,
-		Location:    h.Location,
+		Title:        h.Title,
+		CountryCode:  h.CountryCode,
+		CitySlug:     h.CitySlug,
+		HotelSlug:    h.HotelSlug,
+		OwnerID:      h.OwnerID,
+		Description:  h.Description,
+		Address:      h.Address,
+		Location:     h.Location,
+		CheckInTime:  *h.CheckInTime,
+		CheckOutTime: *h.CheckOutTime,
 	}
 }
 
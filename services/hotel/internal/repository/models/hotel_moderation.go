@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HotelStatusChange is a requested move of a hotel to another status on behalf of an actor.
+type HotelStatusChange struct {
+	Reason    *string
+	To        HotelStatus
+	ActorRole UserRole
+	ActorID   int64
+}
+
+// Actor is the authenticated caller of a request; the zero actor is an anonymous guest.
+type Actor struct {
+	Role UserRole
+	ID   int64
+}
+
+// HotelViewer is who reads a hotel; the zero viewer is an anonymous guest.
+type HotelViewer struct {
+	Role UserRole
+	ID   int64
+}
+
+type HotelStatusTransition struct {
+	CreatedAt time.Time
+	Reason    *string
+	From      HotelStatus
+	To        HotelStatus
+	ActorRole UserRole
+	ActorID   int64
+	ID        uuid.UUID
+	HotelID   uuid.UUID
+}
+
+func (c *HotelStatusChange) ToTransition(hotelID uuid.UUID, from HotelStatus) *HotelStatusTransition {
+	return &HotelStatusTransition{
+		Reason:    c.Reason,
+		From:      from,
+		To:        c.To,
+		ActorRole: c.ActorRole,
+		ActorID:   c.ActorID,
+		HotelID:   hotelID,
+	}
+}
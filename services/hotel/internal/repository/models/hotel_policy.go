@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CancellationPenalty string
+
+const (
+	CancellationPenaltyUnspecified CancellationPenalty = "CANCELLATION_PENALTY_UNSPECIFIED"
+	CancellationPenaltyNone        CancellationPenalty = "CANCELLATION_PENALTY_NONE"
+	CancellationPenaltyFirstNight  CancellationPenalty = "CANCELLATION_PENALTY_FIRST_NIGHT"
+	CancellationPenaltyPercent     CancellationPenalty = "CANCELLATION_PENALTY_PERCENT"
+	CancellationPenaltyFullStay    CancellationPenalty = "CANCELLATION_PENALTY_FULL_STAY"
+)
+
+// CancellationTier applies to cancellations made at least HoursBeforeCheckIn
+// hours before check-in. PenaltyPercent is only set for the percent penalty.
+type CancellationTier struct {
+	Penalty            CancellationPenalty `json:"penalty"`
+	HoursBeforeCheckIn uint32              `json:"hours_before_check_in"`
+	PenaltyPercent     uint32              `json:"penalty_percent"`
+}
+
+// ChildAgeBand prices children of MinAge to MaxAge inclusive as a percent of the adult rate.
+type ChildAgeBand struct {
+	MinAge       uint32 `json:"min_age"`
+	MaxAge       uint32 `json:"max_age"`
+	PricePercent uint32 `json:"price_percent"`
+}
+
+type PetPolicy struct {
+	FeePerNight *decimal.Decimal
+	Allowed     bool
+	MaxPets     uint32
+}
+
+type SetHotelPolicy struct {
+	LatestCheckInTime *string
+	CancellationTiers []CancellationTier
+	ChildAgeBands     []ChildAgeBand
+	Pets              PetPolicy
+	PrepaymentPercent uint32
+}
+
+type HotelPolicy struct {
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	LatestCheckInTime *string
+	CancellationTiers []CancellationTier
+	ChildAgeBands     []ChildAgeBand
+	Pets              PetPolicy
+	PrepaymentPercent uint32
+	HotelID           uuid.UUID
+}
+
+func (p *SetHotelPolicy) ToRead() *HotelPolicy {
+	return &HotelPolicy{
+		LatestCheckInTime: p.LatestCheckInTime,
+		CancellationTiers: p.CancellationTiers,
+		ChildAgeBands:     p.ChildAgeBands,
+		Pets:              p.Pets,
+		PrepaymentPercent: p.PrepaymentPercent,
+	}
+}
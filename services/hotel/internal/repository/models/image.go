@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImageTarget addresses a hotel gallery, or a room gallery when RoomID is set.
+type ImageTarget struct {
+	RoomID   *uuid.UUID
+	HotelRef HotelRef
+}
+
+type ImageOwner struct {
+	RoomID  *uuid.UUID
+	HotelID uuid.UUID
+}
+
+// ImageVariants holds one value per stored variant of an image, either blob keys or public URLs.
+type ImageVariants struct {
+	Original      string
+	Thumbnail     string
+	ThumbnailWebP string
+	WebP          string
+}
+
+type CreateImage struct {
+	Owner       ImageOwner
+	Keys        ImageVariants
+	ContentType string
+	SizeBytes   int64
+	Width       int
+	Height      int
+	ID          uuid.UUID
+}
+
+type Image struct {
+	CreatedAt   time.Time
+	RoomID      *uuid.UUID
+	Keys        ImageVariants
+	URLs        ImageVariants
+	ContentType string
+	SizeBytes   int64
+	Width       int
+	Height      int
+	Position    int
+	ID          uuid.UUID
+	HotelID     uuid.UUID
+}
+
+func (img *CreateImage) ToRead() *Image {
+	return &Image{
+		RoomID:      img.Owner.RoomID,
+		Keys:        img.Keys,
+		ContentType: img.ContentType,
+		SizeBytes:   img.SizeBytes,
+		Width:       img.Width,
+		Height:      img.Height,
+		ID:          img.ID,
+		HotelID:     img.Owner.HotelID,
+	}
+}
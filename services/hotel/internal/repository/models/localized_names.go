@@ -0,0 +1,4 @@
+package models
+
+// LocalizedNames maps a locale ("en", "ru", "pt-BR") to a display name; "en" is always present.
+type LocalizedNames map[string]string
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// WeekdayAdjustments holds percent adjustments indexed from Monday (0) to Sunday (6).
+type WeekdayAdjustments [7]decimal.Decimal
+
+type CreateRatePlan struct {
+	RoomID             *uuid.UUID
+	RoomType           *RoomType
+	StayRange          DateRange
+	Title              string
+	Price              decimal.Decimal
+	WeekdayAdjustments WeekdayAdjustments
+}
+
+type UpdateRatePlan struct {
+	StayRange          DateRange
+	Title              string
+	Price              decimal.Decimal
+	WeekdayAdjustments WeekdayAdjustments
+}
+
+type RatePlan struct {
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	RoomID             *uuid.UUID
+	RoomType           *RoomType
+	StayRange          DateRange
+	Title              string
+	Price              decimal.Decimal
+	WeekdayAdjustments WeekdayAdjustments
+	ID                 uuid.UUID
+	HotelID            uuid.UUID
+}
+
+type RatePlanList struct {
+	RatePlans  []*RatePlan
+	TotalCount uint64
+}
+
+type NightlyRate struct {
+	Date       time.Time
+	RatePlanID *uuid.UUID
+	Price      decimal.Decimal
+}
+
+type StayQuote struct {
+	StayRange   DateRange
+	Nights      []NightlyRate
+	TotalAmount decimal.Decimal
+	RoomID      uuid.UUID
+}
+
+func (rp *CreateRatePlan) ToRead() *RatePlan {
+	return &RatePlan{
+		RoomID:             rp.RoomID,
+		RoomType:           rp.RoomType,
+		StayRange:          rp.StayRange,
+		Title:              rp.Title,
+		Price:              rp.Price,
+		WeekdayAdjustments: rp.WeekdayAdjustments,
+	}
+}
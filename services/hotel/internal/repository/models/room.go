@@ -21,26 +21,60 @@ type CreateRoom struct {
 }
 
 type UpdateRoom struct {
-	Description string
-	Title       string
-	RoomNumber  string
-	Type        RoomType
-	Price       decimal.Decimal
-	Amenities   []string
-	Images      []string
-	Capacity    int
-	AreaSqm     float64
-	Floor       int
+	ExpectedVersion *int64
+	Description     string
+	Title           string
+	RoomNumber      string
+	Type            RoomType
+	Price           decimal.Decimal
+	Amenities       []string
+	Images          []string
+	Capacity        int
+	AreaSqm         float64
+	Floor           int
+}
+
+// Room fields that can be named in a partial update.
+const (
+	RoomFieldTitle       = "title"
+	RoomFieldDescription = "description"
+	RoomFieldRoomNumber  = "room_number"
+	RoomFieldType        = "type"
+	RoomFieldPrice       = "price"
+	RoomFieldCapacity    = "capacity"
+	RoomFieldAreaSqm     = "area_sqm"
+	RoomFieldFloor       = "floor"
+	RoomFieldAmenities   = "amenities"
+	RoomFieldImages      = "images"
+)
+
+// PatchRoom holds new values for the room fields listed in Fields; other values are ignored.
+type PatchRoom struct {
+	ExpectedVersion *int64
+	Description     string
+	Title           string
+	RoomNumber      string
+	Type            RoomType
+	Price           decimal.Decimal
+	Fields          []string
+	Amenities       []string
+	Images          []string
+	Capacity        int
+	AreaSqm         float64
+	Floor           int
 }
 
 type UpdateRoomStatus struct {
-	Status RoomStatus
+	ExpectedVersion *int64
+	Status          RoomStatus
 }
 
 type Room struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	DeletedAt   *time.Time
 	Description *string
+	CategoryID  *uuid.UUID
 	Price       decimal.Decimal
 	Type        RoomType
 	Status      RoomStatus
@@ -51,7 +85,9 @@ type Room struct {
 	Capacity    int
 	AreaSqm     float64
 	Floor       int
+	Version     int64
 	ID          uuid.UUID
+	HotelID     uuid.UUID
 }
 
 type RoomShort struct {
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RoomBlockReason string
+
+const (
+	RoomBlockReasonUnspecified RoomBlockReason = "ROOM_BLOCK_REASON_UNSPECIFIED"
+	RoomBlockReasonMaintenance RoomBlockReason = "ROOM_BLOCK_REASON_MAINTENANCE"
+	RoomBlockReasonOwnerUse    RoomBlockReason = "ROOM_BLOCK_REASON_OWNER_USE"
+	RoomBlockReasonRenovation  RoomBlockReason = "ROOM_BLOCK_REASON_RENOVATION"
+)
+
+type CreateRoomBlock struct {
+	Note      *string
+	StayRange DateRange
+	Reason    RoomBlockReason
+	RoomID    uuid.UUID
+}
+
+type RoomBlock struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Note      *string
+	StayRange DateRange
+	Reason    RoomBlockReason
+	ID        uuid.UUID
+	RoomID    uuid.UUID
+}
+
+type RoomBlockList struct {
+	RoomBlocks []*RoomBlock
+	TotalCount uint64
+}
+
+func (rb *CreateRoomBlock) ToRead() *RoomBlock {
+	return &RoomBlock{
+		Note:      rb.Note,
+		StayRange: rb.StayRange,
+		Reason:    rb.Reason,
+		RoomID:    rb.RoomID,
+	}
+}
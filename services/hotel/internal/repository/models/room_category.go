@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CreateRoomCategory struct {
+	Description *string
+	Title       string
+	Type        RoomType
+	Price       decimal.Decimal
+	Amenities   []string
+	Images      []string
+	Capacity    int
+	AreaSqm     float64
+}
+
+type UpdateRoomCategory struct {
+	ExpectedVersion *int64
+	Description     *string
+	Title           string
+	Type            RoomType
+	Price           decimal.Decimal
+	Amenities       []string
+	Images          []string
+	Capacity        int
+	AreaSqm         float64
+}
+
+// RoomCategory is a sellable room type of a hotel; RoomIDs lists its live
+// rooms ordered by room number.
+type RoomCategory struct {
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Description *string
+	Title       string
+	Type        RoomType
+	Price       decimal.Decimal
+	Amenities   []string
+	Images      []string
+	RoomIDs     []uuid.UUID
+	Capacity    int
+	AreaSqm     float64
+	Version     int64
+	ID          uuid.UUID
+	HotelID     uuid.UUID
+}
+
+func (c *CreateRoomCategory) ToRead() *RoomCategory {
+	return &RoomCategory{
+		Title:       c.Title,
+		Description: c.Description,
+		Type:        c.Type,
+		Price:       c.Price,
+		Capacity:    c.Capacity,
+		AreaSqm:     c.AreaSqm,
+		Amenities:   c.Amenities,
+		Images:      c.Images,
+	}
+}
+
+// AssignRoomCategory moves a room into CategoryID, or out of its category when nil.
+type AssignRoomCategory struct {
+	ExpectedVersion *int64
+	CategoryID      *uuid.UUID
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StayRule string
+
+const (
+	StayRuleMinLengthOfStay   StayRule = "STAY_RESTRICTION_RULE_MIN_LENGTH_OF_STAY"
+	StayRuleMaxLengthOfStay   StayRule = "STAY_RESTRICTION_RULE_MAX_LENGTH_OF_STAY"
+	StayRuleClosedToArrival   StayRule = "STAY_RESTRICTION_RULE_CLOSED_TO_ARRIVAL"
+	StayRuleClosedToDeparture StayRule = "STAY_RESTRICTION_RULE_CLOSED_TO_DEPARTURE"
+	StayRuleMinAdvanceBooking StayRule = "STAY_RESTRICTION_RULE_MIN_ADVANCE_BOOKING"
+	StayRuleMaxAdvanceBooking StayRule = "STAY_RESTRICTION_RULE_MAX_ADVANCE_BOOKING"
+)
+
+type StayRules struct {
+	MinLengthOfStay   *int
+	MaxLengthOfStay   *int
+	MinAdvanceDays    *int
+	MaxAdvanceDays    *int
+	ClosedToArrival   bool
+	ClosedToDeparture bool
+}
+
+type CreateStayRestriction struct {
+	RoomID    *uuid.UUID
+	StayRange DateRange
+	Rules     StayRules
+}
+
+type UpdateStayRestriction struct {
+	StayRange DateRange
+	Rules     StayRules
+}
+
+type StayRestriction struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	RoomID    *uuid.UUID
+	StayRange DateRange
+	Rules     StayRules
+	ID        uuid.UUID
+	HotelID   uuid.UUID
+}
+
+type StayRestrictionList struct {
+	StayRestrictions []*StayRestriction
+	TotalCount       uint64
+}
+
+type StayViolation struct {
+	Date          time.Time
+	Limit         *int
+	Actual        *int
+	Rule          StayRule
+	Message       string
+	RestrictionID uuid.UUID
+}
+
+func (sr *CreateStayRestriction) ToRead() *StayRestriction {
+	return &StayRestriction{
+		RoomID:    sr.RoomID,
+		StayRange: sr.StayRange,
+		Rules:     sr.Rules,
+	}
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/repository/postgres/query"
+	"hotel/pkg/lib/utils/consts"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r *Repository) InsertAmenity(ctx context.Context, a *models.CreateAmenity) (*models.Amenity, error) {
+	newAmenity := a.ToRead()
+	err := r.db.QueryRow(
+		ctx, query.InsertAmenity,
+		a.Code,
+		a.Category,
+		a.Icon,
+		a.Names,
+	).Scan(
+		&newAmenity.CreatedAt,
+		&newAmenity.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, consts.ErrUniqueAmenityCode
+		}
+		return nil, err
+	}
+
+	return newAmenity, nil
+}
+
+func (r *Repository) SelectAmenities(ctx context.Context, category *models.AmenityCategory) ([]*models.Amenity, error) {
+	rows, err := r.db.Query(ctx, query.SelectAmenities, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAmenities(rows)
+}
+
+func (r *Repository) SelectAmenityByCode(ctx context.Context, code string) (*models.Amenity, error) {
+	var a models.Amenity
+	err := r.db.QueryRow(ctx, query.SelectAmenityByCode, code).Scan(amenityFields(&a)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrAmenityNotFound
+		}
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+func (r *Repository) SelectUnknownAmenityCodes(ctx context.Context, codes []string) ([]string, error) {
+	rows, err := r.db.Query(ctx, query.SelectUnknownAmenityCodes, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+func (r *Repository) UpdateAmenityByCode(
+	ctx context.Context,
+	code string,
+	a *models.UpdateAmenity,
+) (*models.Amenity, error) {
+	var updated models.Amenity
+	err := r.db.QueryRow(
+		ctx, query.UpdateAmenityByCode,
+		code,
+		a.Category,
+		a.Icon,
+		a.Names,
+	).Scan(amenityFields(&updated)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrAmenityNotFound
+		}
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (r *Repository) DeleteAmenityByCode(ctx context.Context, code string) error {
+	row, err := r.db.Exec(ctx, query.DeleteAmenityByCode, code)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return consts.ErrAmenityInUse
+		}
+		return err
+	}
+	if row.RowsAffected() == 0 {
+		return consts.ErrAmenityNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) SelectHotelAmenities(ctx context.Context, hotelRef models.HotelRef) ([]*models.Amenity, error) {
+	rows, err := r.db.Query(
+		ctx, query.SelectHotelAmenities,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanAmenities(rows)
+}
+
+func (r *Repository) ReplaceHotelAmenities(ctx context.Context, hotelRef models.HotelRef, codes []string) error {
+	var hotelID any
+	err := r.db.QueryRow(
+		ctx, query.ReplaceHotelAmenities,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+		codes,
+	).Scan(&hotelID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return consts.ErrHotelNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return consts.ErrUnknownAmenity
+		}
+		return err
+	}
+
+	return nil
+}
+
+func scanAmenities(rows pgx.Rows) ([]*models.Amenity, error) {
+	defer rows.Close()
+
+	amenities := make([]*models.Amenity, 0)
+	for rows.Next() {
+		var a models.Amenity
+		if err := rows.Scan(amenityFields(&a)...); err != nil {
+			return nil, err
+		}
+		amenities = append(amenities, &a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return amenities, nil
+}
+
+func amenityFields(a *models.Amenity) []any {
+	return []any{
+		&a.Code,
+		&a.Category,
+		&a.Icon,
+		&a.Names,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	}
+}
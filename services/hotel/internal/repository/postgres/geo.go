@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/repository/postgres/query"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) SelectCountries(ctx context.Context) ([]*models.Country, error) {
+	rows, err := r.db.Query(ctx, query.SelectCountries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	countries := make([]*models.Country, 0)
+	for rows.Next() {
+		var c models.Country
+		if err = rows.Scan(&c.Code, &c.ISOAlpha3, &c.Names, &c.HotelCount); err != nil {
+			return nil, err
+		}
+		countries = append(countries, &c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return countries, nil
+}
+
+func (r *Repository) SelectCities(
+	ctx context.Context,
+	filter models.CityFilter,
+	limit uint64,
+	offset uint64,
+) (*models.CityList, error) {
+	rows, err := r.db.Query(
+		ctx, query.SelectCities,
+		filter.CountryCode,
+		filter.OnlyWithHotels,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cityList := &models.CityList{Cities: make([]*models.City, 0)}
+	for rows.Next() {
+		var c models.City
+		err = rows.Scan(
+			&c.CountryCode,
+			&c.Slug,
+			&c.Names,
+			&c.Timezone,
+			&c.Centroid.Longitude,
+			&c.Centroid.Latitude,
+			&c.HotelCount,
+			&cityList.TotalCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		cityList.Cities = append(cityList.Cities, &c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return cityList, nil
+}
+
+// UpsertGeo writes the whole catalogue in one transaction so a half-applied seed never leaves
+// cities pointing at missing countries.
+func (r *Repository) UpsertGeo(ctx context.Context, countries []*models.Country, cities []*models.City) error {
+	batch := &pgx.Batch{}
+	for _, c := range countries {
+		batch.Queue(query.UpsertCountry, c.Code, c.ISOAlpha3, c.Names)
+	}
+	for _, c := range cities {
+		batch.Queue(
+			query.UpsertCity,
+			c.CountryCode,
+			c.Slug,
+			c.Names,
+			c.Timezone,
+			c.Centroid.Longitude,
+			c.Centroid.Latitude,
+		)
+	}
+
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		return tx.SendBatch(ctx, batch).Close()
+	})
+}
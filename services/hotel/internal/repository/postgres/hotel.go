@@ -3,11 +3,15 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"hotel/internal/outbox"
 	"hotel/internal/repository/models"
 	"hotel/internal/repository/postgres/query"
 	"hotel/pkg/lib/utils/consts"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
@@ -25,15 +29,29 @@ func (r *Repository) InsertHotel(ctx context.Context, h *models.CreateHotel) (*m
 		h.Address,
 		h.Location.Longitude,
 		h.Location.Latitude,
+		h.Timezone,
+		h.CheckInTime,
+		h.CheckOutTime,
 	).Scan(
 		&newHotel.ID,
+		&newHotel.Timezone,
+		&newHotel.Status,
+		&newHotel.Version,
 		&newHotel.CreatedAt,
 		&newHotel.UpdatedAt,
 	)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrCityNotFound
+		}
 		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return nil, consts.ErrUniqueHotelField
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505":
+				return nil, consts.ErrUniqueHotelField
+			case "23503":
+				return nil, consts.ErrCityNotFound
+			}
 		}
 		return nil, err
 	}
@@ -108,18 +126,7 @@ func (r *Repository) SelectHotelBySlug(ctx context.Context, ref models.HotelRef)
 		ref.CountryCode,
 		ref.CitySlug,
 		ref.HotelSlug,
-	).Scan(
-		&h.ID,
-		&h.Title,
-		&h.OwnerID,
-		&h.Description,
-		&h.Address,
-		&h.Location.Longitude,
-		&h.Location.Latitude,
-		&h.Rating,
-		&h.CreatedAt,
-		&h.UpdatedAt,
-	)
+	).Scan(hotelFields(&h)...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, consts.ErrHotelNotFound
@@ -130,8 +137,44 @@ func (r *Repository) SelectHotelBySlug(ctx context.Context, ref models.HotelRef)
 	return &h, nil
 }
 
-func (r *Repository) UpdateHotelBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotel) error {
-	row, err := r.db.Exec(
+func (r *Repository) SelectHotelByID(ctx context.Context, hotelID uuid.UUID) (*models.Hotel, error) {
+	var h models.Hotel
+	err := r.db.QueryRow(ctx, query.GetHotelByID, hotelID).Scan(hotelFields(&h)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrHotelNotFound
+		}
+		return nil, err
+	}
+
+	return &h, nil
+}
+
+func (r *Repository) SelectHotelsByIDs(ctx context.Context, hotelIDs []uuid.UUID) ([]*models.Hotel, error) {
+	rows, err := r.db.Query(ctx, query.GetHotelsByIDs, hotelIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hotels := make([]*models.Hotel, 0, len(hotelIDs))
+	for rows.Next() {
+		var h models.Hotel
+		if err = rows.Scan(hotelFields(&h)...); err != nil {
+			return nil, err
+		}
+		hotels = append(hotels, &h)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return hotels, nil
+}
+
+func (r *Repository) UpdateHotelBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotel) (int64, error) {
+	version, err := r.updateHotel(
 		ctx, query.UpdateHotelBySlug,
 		h.Description,
 		h.Address,
@@ -140,17 +183,60 @@ func (r *Repository) UpdateHotelBySlug(ctx context.Context, ref models.HotelRef,
 		ref.CountryCode,
 		ref.CitySlug,
 		ref.HotelSlug,
+		h.Timezone,
+		h.CheckInTime,
+		h.CheckOutTime,
+		h.ExpectedVersion,
 	)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, r.hotelVersionErr(ctx, ref, h.ExpectedVersion)
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return 0, consts.ErrUniqueHotelField
+		}
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (r *Repository) PatchHotelBySlug(ctx context.Context, ref models.HotelRef, h models.PatchHotel) error {
+	set := newUpdateSet(ref.CountryCode, ref.CitySlug, ref.HotelSlug, h.ExpectedVersion)
+	set.set("version = version + 1")
+	set.set("updated_at = now()")
+	for _, field := range h.Fields {
+		switch field {
+		case models.HotelFieldDescription:
+			set.set("description = $%d", h.Description)
+		case models.HotelFieldAddress:
+			set.set("address = $%d", h.Address)
+		case models.HotelFieldLocation:
+			set.set(
+				"location = ST_SetSRID(ST_MakePoint($%d, $%d), 4326)::geography",
+				h.Location.Longitude, h.Location.Latitude,
+			)
+		case models.HotelFieldTimezone:
+			set.set("timezone = $%d", h.Timezone)
+		case models.HotelFieldCheckInTime:
+			set.set("check_in_time = $%d::time", h.CheckInTime)
+		case models.HotelFieldCheckOutTime:
+			set.set("check_out_time = $%d::time", h.CheckOutTime)
+		}
+	}
+
+	_, err := r.updateHotel(ctx, fmt.Sprintf(query.PatchHotelBySlug, set), set.args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return r.hotelVersionErr(ctx, ref, h.ExpectedVersion)
+		}
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
 			return consts.ErrUniqueHotelField
 		}
 		return err
 	}
-	if row.RowsAffected() == 0 {
-		return consts.ErrHotelNotFound
-	}
 
 	return nil
 }
@@ -159,37 +245,135 @@ func (r *Repository) UpdateHotelTitleBySlug(
 	ctx context.Context,
 	ref models.HotelRef,
 	h models.UpdateHotelTitle,
-) error {
-	row, err := r.db.Exec(
+) (int64, error) {
+	version, err := r.updateHotel(
 		ctx, query.UpdateHotelTitleBySlug,
 		h.Title,
 		h.HotelSlug,
 		ref.CountryCode,
 		ref.CitySlug,
 		ref.HotelSlug,
+		h.ExpectedVersion,
 	)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, r.hotelVersionErr(ctx, ref, h.ExpectedVersion)
+		}
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return consts.ErrUniqueHotelField
+			return 0, consts.ErrUniqueHotelField
 		}
-		return err
-	}
-	if row.RowsAffected() == 0 {
-		return consts.ErrHotelNotFound
+		return 0, err
 	}
 
-	return nil
+	return version, nil
 }
 
+// DeleteHotelBySlug soft-deletes the hotel with its rooms and records
+// hotel.deleted in the outbox in the same transaction.
 func (r *Repository) DeleteHotelBySlug(ctx context.Context, ref models.HotelRef) error {
-	row, err := r.db.Exec(ctx, query.DeleteHotelBySlug, ref.CountryCode, ref.CitySlug, ref.HotelSlug)
-	if err != nil {
-		return err
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var hotelID uuid.UUID
+		err := tx.QueryRow(ctx, query.DeleteHotelBySlug, ref.CountryCode, ref.CitySlug, ref.HotelSlug).Scan(&hotelID)
+		if err != nil {
+			return err
+		}
+
+		event, err := outbox.HotelDeleted(hotelID, time.Now())
+		if err != nil {
+			return err
+		}
+		return r.CreateOutboxEvent(ctx, tx, event)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return consts.ErrHotelNotFound
 	}
-	if row.RowsAffected() == 0 {
+
+	return err
+}
+
+// RestoreHotelByID brings back a hotel deleted by DeleteHotelBySlug and records
+// hotel.restored in the outbox in the same transaction.
+func (r *Repository) RestoreHotelByID(ctx context.Context, hotelID uuid.UUID) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var restored uuid.UUID
+		if err := tx.QueryRow(ctx, query.RestoreHotelByID, hotelID).Scan(&restored); err != nil {
+			return err
+		}
+
+		event, err := outbox.HotelRestored(restored, time.Now())
+		if err != nil {
+			return err
+		}
+		return r.CreateOutboxEvent(ctx, tx, event)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
 		return consts.ErrHotelNotFound
 	}
 
-	return nil
+	return err
+}
+
+func (r *Repository) UpdateHotelRating(ctx context.Context, hotelID uuid.UUID, rating *float32) (*float32, error) {
+	var updated *float32
+	err := r.db.QueryRow(ctx, query.UpdateHotelRating, rating, hotelID).Scan(&updated)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrHotelNotFound
+		}
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// updateHotel runs an update returning the hotel id and version and records
+// the change in the outbox in the same transaction.
+func (r *Repository) updateHotel(ctx context.Context, sql string, args ...any) (int64, error) {
+	var version int64
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var hotelID uuid.UUID
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&hotelID, &version); err != nil {
+			return err
+		}
+
+		event, err := outbox.HotelUpdated(hotelID, version, time.Now())
+		if err != nil {
+			return err
+		}
+		return r.CreateOutboxEvent(ctx, tx, event)
+	})
+
+	return version, err
+}
+
+func (r *Repository) hotelVersionErr(ctx context.Context, ref models.HotelRef, expectedVersion *int64) error {
+	return r.versionErr(
+		ctx, expectedVersion, consts.ErrHotelNotFound,
+		query.HotelExistsBySlug, ref.CountryCode, ref.CitySlug, ref.HotelSlug,
+	)
+}
+
+func hotelFields(h *models.Hotel) []any {
+	return []any{
+		&h.ID,
+		&h.Title,
+		&h.CountryCode,
+		&h.CitySlug,
+		&h.HotelSlug,
+		&h.OwnerID,
+		&h.Description,
+		&h.Address,
+		&h.Location.Longitude,
+		&h.Location.Latitude,
+		&h.Rating,
+		&h.Timezone,
+		&h.CheckInTime,
+		&h.CheckOutTime,
+		&h.Status,
+		&h.Version,
+		&h.CreatedAt,
+		&h.UpdatedAt,
+		&h.DeletedAt,
+	}
 }
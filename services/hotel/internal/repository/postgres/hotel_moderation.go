@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/repository/postgres/query"
+	"hotel/pkg/lib/utils/consts"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) UpdateHotelStatus(
+	ctx context.Context,
+	t *models.HotelStatusTransition,
+) (*models.HotelStatusTransition, error) {
+	transition := *t
+	err := r.db.QueryRow(
+		ctx, query.UpdateHotelStatus,
+		t.HotelID,
+		t.From,
+		t.To,
+		t.ActorID,
+		t.ActorRole,
+		t.Reason,
+	).Scan(
+		&transition.ID,
+		&transition.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrInvalidHotelTransition
+		}
+		return nil, err
+	}
+
+	return &transition, nil
+}
+
+func (r *Repository) SelectHotelStatusHistory(
+	ctx context.Context,
+	hotelID uuid.UUID,
+) ([]*models.HotelStatusTransition, error) {
+	rows, err := r.db.Query(ctx, query.SelectHotelStatusHistory, hotelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []*models.HotelStatusTransition
+	for rows.Next() {
+		var t models.HotelStatusTransition
+		err = rows.Scan(
+			&t.ID,
+			&t.HotelID,
+			&t.From,
+			&t.To,
+			&t.ActorID,
+			&t.ActorRole,
+			&t.Reason,
+			&t.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, &t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/repository/postgres/query"
+	"hotel/pkg/lib/utils/consts"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) UpsertHotelPolicy(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+	p *models.SetHotelPolicy,
+) (*models.HotelPolicy, error) {
+	policy := p.ToRead()
+	err := r.db.QueryRow(
+		ctx, query.UpsertHotelPolicy,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+		p.CancellationTiers,
+		p.PrepaymentPercent,
+		p.ChildAgeBands,
+		p.Pets.Allowed,
+		p.Pets.FeePerNight,
+		p.Pets.MaxPets,
+		p.LatestCheckInTime,
+	).Scan(
+		&policy.HotelID,
+		&policy.CreatedAt,
+		&policy.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrHotelNotFound
+		}
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (r *Repository) SelectHotelPolicyBySlug(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+) (*models.HotelPolicy, error) {
+	var p models.HotelPolicy
+	err := r.db.QueryRow(
+		ctx, query.SelectHotelPolicyBySlug,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+	).Scan(hotelPolicyFields(&p)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrHotelPolicyNotFound
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func (r *Repository) SelectHotelPolicyByHotelID(ctx context.Context, hotelID uuid.UUID) (*models.HotelPolicy, error) {
+	var p models.HotelPolicy
+	err := r.db.QueryRow(ctx, query.SelectHotelPolicyByHotelID, hotelID).Scan(hotelPolicyFields(&p)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrHotelPolicyNotFound
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func (r *Repository) DeleteHotelPolicy(ctx context.Context, hotelRef models.HotelRef) error {
+	row, err := r.db.Exec(
+		ctx, query.DeleteHotelPolicy,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+	)
+	if err != nil {
+		return err
+	}
+	if row.RowsAffected() == 0 {
+		return consts.ErrHotelPolicyNotFound
+	}
+
+	return nil
+}
+
+func hotelPolicyFields(p *models.HotelPolicy) []any {
+	return []any{
+		&p.HotelID,
+		&p.CancellationTiers,
+		&p.PrepaymentPercent,
+		&p.ChildAgeBands,
+		&p.Pets.Allowed,
+		&p.Pets.FeePerNight,
+		&p.Pets.MaxPets,
+		&p.LatestCheckInTime,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	}
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/repository/postgres/query"
+	"hotel/pkg/lib/utils/consts"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) SelectImageOwner(ctx context.Context, target models.ImageTarget) (models.ImageOwner, error) {
+	var owner models.ImageOwner
+	err := r.db.QueryRow(
+		ctx, query.SelectImageOwner,
+		target.HotelRef.CountryCode,
+		target.HotelRef.CitySlug,
+		target.HotelRef.HotelSlug,
+		target.RoomID,
+	).Scan(&owner.HotelID, &owner.RoomID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ImageOwner{}, consts.ErrHotelNotFound
+		}
+		return models.ImageOwner{}, err
+	}
+
+	if target.RoomID != nil && owner.RoomID == nil {
+		return models.ImageOwner{}, consts.ErrRoomNotFound
+	}
+
+	return owner, nil
+}
+
+func (r *Repository) InsertImage(ctx context.Context, img *models.CreateImage) (*models.Image, error) {
+	newImage := img.ToRead()
+	err := r.db.QueryRow(
+		ctx, query.InsertImage,
+		img.ID,
+		img.Owner.HotelID,
+		img.Owner.RoomID,
+		img.ContentType,
+		img.SizeBytes,
+		img.Width,
+		img.Height,
+		img.Keys.Original,
+		img.Keys.Thumbnail,
+		img.Keys.ThumbnailWebP,
+		img.Keys.WebP,
+	).Scan(&newImage.Position, &newImage.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return newImage, nil
+}
+
+func (r *Repository) SelectImages(ctx context.Context, owner models.ImageOwner) ([]*models.Image, error) {
+	rows, err := r.db.Query(ctx, query.SelectImages, owner.HotelID, owner.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var images []*models.Image
+	for rows.Next() {
+		var img models.Image
+		if err = rows.Scan(imageFields(&img)...); err != nil {
+			return nil, err
+		}
+		images = append(images, &img)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+func (r *Repository) SelectImageByID(ctx context.Context, imageID uuid.UUID) (*models.Image, error) {
+	var img models.Image
+	if err := r.db.QueryRow(ctx, query.SelectImageByID, imageID).Scan(imageFields(&img)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrImageNotFound
+		}
+		return nil, err
+	}
+
+	return &img, nil
+}
+
+func (r *Repository) UpdateImagePositions(ctx context.Context, owner models.ImageOwner, imageIDs []uuid.UUID) error {
+	row, err := r.db.Exec(ctx, query.UpdateImagePositions, owner.HotelID, owner.RoomID, imageIDs)
+	if err != nil {
+		return err
+	}
+	if rowAffected := row.RowsAffected(); rowAffected == 0 {
+		return consts.ErrImageOrderMismatch
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteImageByID(ctx context.Context, imageID uuid.UUID) error {
+	row, err := r.db.Exec(ctx, query.DeleteImageByID, imageID)
+	if err != nil {
+		return err
+	}
+	if rowAffected := row.RowsAffected(); rowAffected == 0 {
+		return consts.ErrImageNotFound
+	}
+
+	return nil
+}
+
+func imageFields(img *models.Image) []any {
+	return []any{
+		&img.ID,
+		&img.HotelID,
+		&img.RoomID,
+		&img.Position,
+		&img.ContentType,
+		&img.SizeBytes,
+		&img.Width,
+		&img.Height,
+		&img.Keys.Original,
+		&img.Keys.Thumbnail,
+		&img.Keys.ThumbnailWebP,
+		&img.Keys.WebP,
+		&img.CreatedAt,
+	}
+}
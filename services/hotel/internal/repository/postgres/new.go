@@ -9,9 +9,14 @@ import (
 	_ "github.com/lib/pq"
 
 	"hotel/internal/config"
+	outboxpg "outbox/postgres"
 )
 
+// Repository embeds the outbox store, so it stores the events of the changes
+// it makes and serves as the repository of the outbox relay.
 type Repository struct {
+	*outboxpg.Store
+
 	db *pgxpool.Pool
 }
 
@@ -49,5 +54,5 @@ func New(config *config.Config) *Repository {
 		panic(err.Error())
 	}
 
-	return &Repository{db: db}
+	return &Repository{Store: outboxpg.New(db), db: db}
 }
@@ -0,0 +1,81 @@
+package query
+
+const (
+	InsertAmenity = `
+		INSERT INTO amenity (code, category, icon, names)
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at, updated_at;`
+
+	SelectAmenities = `
+		SELECT code,
+			   category,
+			   icon,
+			   names,
+			   created_at,
+			   updated_at
+		FROM amenity
+		WHERE $1::amenity_category IS NULL OR category = $1
+		ORDER BY category, code;`
+
+	SelectAmenityByCode = `
+		SELECT code,
+			   category,
+			   icon,
+			   names,
+			   created_at,
+			   updated_at
+		FROM amenity
+		WHERE code = $1;`
+
+	SelectUnknownAmenityCodes = `
+		SELECT c.code
+		FROM unnest($1::text[]) AS c(code)
+		LEFT JOIN amenity a ON a.code = c.code
+		WHERE a.code IS NULL
+		ORDER BY c.code;`
+
+	UpdateAmenityByCode = `
+		UPDATE amenity
+		SET category = $2,
+		    icon     = $3,
+		    names    = $4
+		WHERE code = $1
+		RETURNING code, category, icon, names, created_at, updated_at;`
+
+	DeleteAmenityByCode = `
+		DELETE FROM amenity
+		WHERE code = $1;`
+
+	SelectHotelAmenities = `
+		SELECT a.code,
+			   a.category,
+			   a.icon,
+			   a.names,
+			   a.created_at,
+			   a.updated_at
+		FROM hotel h
+		JOIN hotel_amenity ha ON ha.hotel_id = h.id
+		JOIN amenity a ON a.code = ha.amenity_code
+		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL
+		ORDER BY a.category, a.code;`
+
+	// ReplaceHotelAmenities returns no rows when the hotel does not exist. Codes
+	// missing from the new set are unlinked; existing links are left untouched.
+	ReplaceHotelAmenities = `
+		WITH target AS (
+			SELECT id
+			FROM hotel
+			WHERE country_code = $1 AND city_slug = $2 AND slug = $3 AND deleted_at IS NULL
+		), removed AS (
+			DELETE FROM hotel_amenity ha
+			USING target t
+			WHERE ha.hotel_id = t.id AND ha.amenity_code <> ALL(COALESCE($4::text[], '{}'))
+		), added AS (
+			INSERT INTO hotel_amenity (hotel_id, amenity_code)
+			SELECT t.id, c.code
+			FROM target t
+			CROSS JOIN unnest($4::text[]) AS c(code)
+			ON CONFLICT DO NOTHING
+		)
+		SELECT id FROM target;`
+)
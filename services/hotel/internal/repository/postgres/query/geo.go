@@ -0,0 +1,45 @@
+package query
+
+const (
+	SelectCountries = `
+		SELECT c.code,
+			   c.iso_alpha3,
+			   c.names,
+			   COUNT(h.id) AS hotel_count
+		FROM country c
+		LEFT JOIN hotel h ON h.country_code = c.code
+		GROUP BY c.code
+		ORDER BY c.names->>'en';`
+
+	SelectCities = `
+		SELECT ci.country_code,
+			   ci.slug,
+			   ci.names,
+			   ci.timezone,
+			   ST_X(ci.location::geometry),
+			   ST_Y(ci.location::geometry),
+			   COUNT(h.id) AS hotel_count,
+			   COUNT(*) OVER() AS total_count
+		FROM city ci
+		LEFT JOIN hotel h ON h.country_code = ci.country_code AND h.city_slug = ci.slug
+		WHERE ci.country_code = $1
+		GROUP BY ci.country_code, ci.slug
+		HAVING NOT $2 OR COUNT(h.id) > 0
+		ORDER BY hotel_count DESC, ci.slug
+		LIMIT $3 OFFSET $4;`
+
+	UpsertCountry = `
+		INSERT INTO country (code, iso_alpha3, names)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (code) DO UPDATE
+		SET iso_alpha3 = EXCLUDED.iso_alpha3,
+		    names      = EXCLUDED.names;`
+
+	UpsertCity = `
+		INSERT INTO city (country_code, slug, names, timezone, location)
+		VALUES ($1, $2, $3, $4, ST_SetSRID(ST_MakePoint($5, $6), 4326)::geography)
+		ON CONFLICT (country_code, slug) DO UPDATE
+		SET names    = EXCLUDED.names,
+		    timezone = EXCLUDED.timezone,
+		    location = EXCLUDED.location;`
+)
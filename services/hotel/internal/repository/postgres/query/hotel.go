@@ -1,6 +1,8 @@
 package query
 
 const (
+	// CreateHotelQuery inserts nothing when the city is not in the catalogue;
+	// a missing timezone falls back to the city's one.
 	CreateHotelQuery = `
 		INSERT INTO hotel (country_code, 
 						   city_slug, 
@@ -9,24 +11,101 @@ const (
 						   owner_id, 
 						   description, 
 						   address, 
-						   location)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, ST_SetSRID(ST_MakePoint($8, $9), 4326)::geography)
-		RETURNING id, created_at, updated_at`
+						   location,
+						   timezone,
+						   check_in_time,
+						   check_out_time)
+		SELECT c.country_code,
+			   c.slug,
+			   $3, $4, $5, $6, $7,
+			   ST_SetSRID(ST_MakePoint($8, $9), 4326)::geography,
+			   COALESCE($10, c.timezone),
+			   $11::time,
+			   $12::time
+		FROM city c
+		WHERE c.country_code = $1 AND c.slug = $2
+		RETURNING id, timezone, status, version, created_at, updated_at`
 
+	// GetHotelBySlug falls back to retired slugs; the live slug is always preferred.
 	GetHotelBySlug = `
+		SELECT h.id,
+			   h.title, 
+			   h.country_code,
+			   h.city_slug,
+			   h.slug,
+			   h.owner_id, 
+			   h.description, 
+			   h.address,
+			   h.longitude,
+			   h.latitude,
+			   h.rating, 
+			   h.timezone,
+			   to_char(h.check_in_time, 'HH24:MI'),
+			   to_char(h.check_out_time, 'HH24:MI'),
+			   h.status,
+			   h.version,
+			   h.created_at, 
+			   h.updated_at,
+			   h.deleted_at
+		FROM hotel h
+		LEFT JOIN hotel_slug_history hs
+			ON hs.hotel_id = h.id AND hs.country_code = $1 AND hs.city_slug = $2 AND hs.slug = $3
+		WHERE h.country_code = $1 AND h.city_slug = $2 AND (h.slug = $3 OR hs.slug IS NOT NULL)
+		  AND h.deleted_at IS NULL
+		ORDER BY h.slug = $3 DESC
+		LIMIT 1`
+
+	// GetHotelByID and GetHotelsByIDs still return deleted hotels so that
+	// historical bookings keep resolving them.
+	GetHotelByID = `
 		SELECT id,
-			   title, 
-			   owner_id, 
-			   description, 
+			   title,
+			   country_code,
+			   city_slug,
+			   slug,
+			   owner_id,
+			   description,
 			   address,
 			   longitude,
 			   latitude,
-			   rating, 
-			   created_at, 
-			   updated_at
-		FROM hotel 
-		WHERE country_code = $1 AND city_slug = $2 AND slug = $3`
+			   rating,
+			   timezone,
+			   to_char(check_in_time, 'HH24:MI'),
+			   to_char(check_out_time, 'HH24:MI'),
+			   status,
+			   version,
+			   created_at,
+			   updated_at,
+			   deleted_at
+		FROM hotel
+		WHERE id = $1`
 
+	GetHotelsByIDs = `
+		SELECT id,
+			   title,
+			   country_code,
+			   city_slug,
+			   slug,
+			   owner_id,
+			   description,
+			   address,
+			   longitude,
+			   latitude,
+			   rating,
+			   timezone,
+			   to_char(check_in_time, 'HH24:MI'),
+			   to_char(check_out_time, 'HH24:MI'),
+			   status,
+			   version,
+			   created_at,
+			   updated_at,
+			   deleted_at
+		FROM hotel
+		WHERE id = ANY($1::uuid[])
+		ORDER BY array_position($1::uuid[], id)`
+
+	// GetHotels lists only published hotels; drafts and hotels under review or
+	// suspended stay reachable by slug for their owner and moderators.
 	GetHotels = `
 		SELECT id,
 			   title,
@@ -38,7 +117,8 @@ const (
 			   latitude,
 			   COUNT(*) OVER() as total_count
 		FROM hotel
-		WHERE country_code = $1 AND city_slug = $2
+		WHERE country_code = $1 AND city_slug = $2 AND status = 'HOTEL_STATUS_PUBLISHED'
+		  AND deleted_at IS NULL
 		ORDER BY
 		    CASE WHEN $3 = 'title' THEN title END,
 			CASE WHEN $3 = 'rating' THEN rating END DESC
@@ -50,26 +130,83 @@ const (
 		  description = $1,
 		  address = $2,
 		  location = ST_SetSRID(ST_MakePoint($3, $4), 4326)::geography,
+		  timezone = COALESCE($8, timezone),
+		  check_in_time = COALESCE($9::time, check_in_time),
+		  check_out_time = COALESCE($10::time, check_out_time),
+		  version = version + 1,
 		  updated_at = now()
-		WHERE country_code = $5 AND city_slug = $6 AND slug = $7
-		RETURNING id, slug;`
+		WHERE country_code = $5 AND city_slug = $6 AND slug = $7 AND deleted_at IS NULL
+		  AND ($11::bigint IS NULL OR version = $11)
+		RETURNING id, version;`
+
+	// PatchHotelBySlug is completed with the assignments of the masked fields;
+	// their placeholders start at $5.
+	PatchHotelBySlug = `
+		UPDATE hotel
+		SET %s
+		WHERE country_code = $1 AND city_slug = $2 AND slug = $3 AND deleted_at IS NULL
+		  AND ($4::bigint IS NULL OR version = $4)
+		RETURNING id, version;`
 
 	UpdateHotelTitleBySlug = `
 		UPDATE hotel
 		SET
 		  title = $1,
 		  slug = $2,
+		  version = version + 1,
 		  updated_at = now()
-		WHERE country_code = $3 AND city_slug = $4 AND slug = $5
-		RETURNING id, slug;`
+		WHERE country_code = $3 AND city_slug = $4 AND slug = $5 AND deleted_at IS NULL
+		  AND ($6::bigint IS NULL OR version = $6)
+		RETURNING id, version;`
 
+	HotelExistsBySlug = `
+		SELECT EXISTS (
+			SELECT 1 FROM hotel
+			WHERE country_code = $1 AND city_slug = $2 AND slug = $3 AND deleted_at IS NULL
+		);`
+
+	// DeleteHotelBySlug soft-deletes the hotel together with its rooms; the slug
+	// stays reserved so links from old bookings never point at another hotel.
 	DeleteHotelBySlug = `
-		DELETE FROM hotel 
-		WHERE country_code = $1 AND city_slug = $2 AND slug = $3;`
+		WITH deleted AS (
+			UPDATE hotel
+			SET deleted_at = now()
+			WHERE country_code = $1 AND city_slug = $2 AND slug = $3 AND deleted_at IS NULL
+			RETURNING id
+		), deleted_rooms AS (
+			UPDATE room r
+			SET deleted_at = now()
+			FROM deleted d
+			WHERE r.hotel_id = d.id AND r.deleted_at IS NULL
+		)
+		SELECT id FROM deleted;`
+
+	// RestoreHotelByID undoes DeleteHotelBySlug: the hotel comes back with the
+	// rooms deleted along with it, not those deleted on their own before.
+	RestoreHotelByID = `
+		WITH target AS (
+			SELECT id, deleted_at
+			FROM hotel
+			WHERE id = $1 AND deleted_at IS NOT NULL
+			FOR UPDATE
+		), restored AS (
+			UPDATE hotel h
+			SET deleted_at = NULL
+			FROM target t
+			WHERE h.id = t.id
+			RETURNING h.id
+		), restored_rooms AS (
+			UPDATE room r
+			SET deleted_at = NULL
+			FROM target t
+			WHERE r.hotel_id = t.id AND r.deleted_at = t.deleted_at
+		)
+		SELECT id FROM restored;`
 
 	UpdateHotelRating = `
 		UPDATE hotel 
-		SET rating = $1,
+		SET rating = round($1::numeric, 2),
 			updated_at = CURRENT_TIMESTAMP
-		WHERE id = $2`
+		WHERE id = $2 AND deleted_at IS NULL
+		RETURNING rating`
 )
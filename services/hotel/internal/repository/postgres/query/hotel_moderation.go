@@ -0,0 +1,32 @@
+package query
+
+const (
+	// UpdateHotelStatus moves the hotel only while it is still in the status the
+	// change was checked against, and records the transition in the same statement.
+	UpdateHotelStatus = `
+		WITH updated AS (
+			UPDATE hotel
+			SET status = $3,
+				version = version + 1,
+				updated_at = now()
+			WHERE id = $1 AND status = $2 AND deleted_at IS NULL
+			RETURNING id
+		)
+		INSERT INTO hotel_status_history (hotel_id, from_status, to_status, actor_id, actor_role, reason)
+		SELECT id, $2, $3, $4, $5, $6
+		FROM updated
+		RETURNING id, created_at;`
+
+	SelectHotelStatusHistory = `
+		SELECT id,
+			   hotel_id,
+			   from_status,
+			   to_status,
+			   actor_id,
+			   actor_role,
+			   reason,
+			   created_at
+		FROM hotel_status_history
+		WHERE hotel_id = $1
+		ORDER BY created_at, id;`
+)
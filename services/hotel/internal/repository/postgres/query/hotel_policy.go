@@ -0,0 +1,61 @@
+package query
+
+const (
+	// UpsertHotelPolicy returns no rows when the hotel does not exist.
+	UpsertHotelPolicy = `
+		INSERT INTO hotel_policy (hotel_id,
+								  cancellation_tiers,
+								  prepayment_percent,
+								  child_age_bands,
+								  pets_allowed,
+								  pet_fee_per_night,
+								  max_pets,
+								  latest_check_in_time)
+		SELECT h.id, $4::jsonb, $5, $6::jsonb, $7, $8::numeric, $9, $10::time
+		FROM hotel h
+		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL
+		ON CONFLICT (hotel_id) DO UPDATE
+		SET cancellation_tiers = EXCLUDED.cancellation_tiers,
+			prepayment_percent = EXCLUDED.prepayment_percent,
+			child_age_bands = EXCLUDED.child_age_bands,
+			pets_allowed = EXCLUDED.pets_allowed,
+			pet_fee_per_night = EXCLUDED.pet_fee_per_night,
+			max_pets = EXCLUDED.max_pets,
+			latest_check_in_time = EXCLUDED.latest_check_in_time
+		RETURNING hotel_id, created_at, updated_at;`
+
+	SelectHotelPolicyBySlug = `
+		SELECT hp.hotel_id,
+			   hp.cancellation_tiers,
+			   hp.prepayment_percent,
+			   hp.child_age_bands,
+			   hp.pets_allowed,
+			   hp.pet_fee_per_night,
+			   hp.max_pets,
+			   to_char(hp.latest_check_in_time, 'HH24:MI'),
+			   hp.created_at,
+			   hp.updated_at
+		FROM hotel_policy hp
+		JOIN hotel h ON h.id = hp.hotel_id
+		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL;`
+
+	SelectHotelPolicyByHotelID = `
+		SELECT hotel_id,
+			   cancellation_tiers,
+			   prepayment_percent,
+			   child_age_bands,
+			   pets_allowed,
+			   pet_fee_per_night,
+			   max_pets,
+			   to_char(latest_check_in_time, 'HH24:MI'),
+			   created_at,
+			   updated_at
+		FROM hotel_policy
+		WHERE hotel_id = $1;`
+
+	DeleteHotelPolicy = `
+		DELETE FROM hotel_policy hp
+		USING hotel h
+		WHERE h.id = hp.hotel_id AND h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3
+		  AND h.deleted_at IS NULL;`
+)
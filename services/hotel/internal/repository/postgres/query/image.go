@@ -0,0 +1,93 @@
+package query
+
+const (
+	SelectImageOwner = `
+		SELECT h.id, r.id
+		FROM hotel h
+		LEFT JOIN room r ON r.hotel_id = h.id AND r.id = $4 AND r.deleted_at IS NULL
+		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL;`
+
+	InsertImage = `
+		INSERT INTO image (
+			id,
+			hotel_id,
+			room_id,
+			position,
+			content_type,
+			size_bytes,
+			width,
+			height,
+			original_key,
+			thumbnail_key,
+			thumbnail_webp_key,
+			webp_key
+		)
+		SELECT $1, $2, $3,
+			   COALESCE((
+			       SELECT MAX(position) + 1
+			       FROM image
+			       WHERE hotel_id = $2 AND room_id IS NOT DISTINCT FROM $3
+			   ), 0),
+			   $4, $5, $6, $7, $8, $9, $10, $11
+		RETURNING position, created_at;`
+
+	SelectImages = `
+		SELECT id,
+			   hotel_id,
+			   room_id,
+			   position,
+			   content_type,
+			   size_bytes,
+			   width,
+			   height,
+			   original_key,
+			   thumbnail_key,
+			   thumbnail_webp_key,
+			   webp_key,
+			   created_at
+		FROM image
+		WHERE hotel_id = $1 AND room_id IS NOT DISTINCT FROM $2
+		ORDER BY position, created_at;`
+
+	SelectImageByID = `
+		SELECT id,
+			   hotel_id,
+			   room_id,
+			   position,
+			   content_type,
+			   size_bytes,
+			   width,
+			   height,
+			   original_key,
+			   thumbnail_key,
+			   thumbnail_webp_key,
+			   webp_key,
+			   created_at
+		FROM image
+		WHERE id = $1;`
+
+	// UpdateImagePositions assigns positions by index of the given ids. It only
+	// applies when the ids are exactly the owner's images, otherwise no row changes.
+	UpdateImagePositions = `
+		WITH owned AS (
+			SELECT id
+			FROM image
+			WHERE hotel_id = $1 AND room_id IS NOT DISTINCT FROM $2
+		),
+		ordered AS (
+			SELECT id, position
+			FROM unnest($3::uuid[]) WITH ORDINALITY AS o(id, position)
+		)
+		UPDATE image i
+		SET position = ordered.position - 1
+		FROM ordered
+		WHERE i.id = ordered.id
+		  AND (SELECT COUNT(*) FROM owned) = cardinality($3::uuid[])
+		  AND NOT EXISTS (
+		      SELECT 1 FROM ordered WHERE ordered.id NOT IN (SELECT id FROM owned)
+		  );`
+
+	DeleteImageByID = `
+		DELETE FROM image
+		WHERE id = $1;`
+)
@@ -0,0 +1,92 @@
+package query
+
+const (
+	InsertRatePlan = `
+		INSERT INTO rate_plan (
+			hotel_id,
+			room_id,
+			room_type,
+			title,
+			stay_range,
+			price,
+			weekday_adjustments
+		)
+		SELECT h.id, $4, $5::room_type, $6, daterange($7::date, $8::date, '[)'), $9, $10::numeric[]
+		FROM hotel h
+		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL
+		  AND ($4::uuid IS NULL OR EXISTS (
+		      SELECT 1 FROM room r WHERE r.id = $4 AND r.hotel_id = h.id AND r.deleted_at IS NULL
+		  ))
+		RETURNING id, hotel_id, created_at, updated_at;`
+
+	SelectRatePlans = `
+		SELECT rp.id,
+			   rp.hotel_id,
+			   rp.room_id,
+			   rp.room_type,
+			   rp.title,
+			   lower(rp.stay_range),
+			   upper(rp.stay_range),
+			   rp.price,
+			   rp.weekday_adjustments::text[],
+			   rp.created_at,
+			   rp.updated_at,
+			   COUNT(*) OVER() as total_count
+		FROM rate_plan rp
+		JOIN hotel h ON h.id = rp.hotel_id
+		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL
+		ORDER BY lower(rp.stay_range), rp.created_at
+		LIMIT $4 OFFSET $5;`
+
+	SelectRatePlanByID = `
+		SELECT id,
+			   hotel_id,
+			   room_id,
+			   room_type,
+			   title,
+			   lower(stay_range),
+			   upper(stay_range),
+			   price,
+			   weekday_adjustments::text[],
+			   created_at,
+			   updated_at
+		FROM rate_plan
+		WHERE id = $1;`
+
+	// SelectRoomRatePlans orders matching plans by precedence: plans bound to the
+	// room itself win over room type plans, then the narrowest date range wins,
+	// then the most recently created one.
+	SelectRoomRatePlans = `
+		SELECT rp.id,
+			   rp.hotel_id,
+			   rp.room_id,
+			   rp.room_type,
+			   rp.title,
+			   lower(rp.stay_range),
+			   upper(rp.stay_range),
+			   rp.price,
+			   rp.weekday_adjustments::text[],
+			   rp.created_at,
+			   rp.updated_at
+		FROM rate_plan rp
+		JOIN room r ON r.hotel_id = rp.hotel_id
+		WHERE r.id = $1
+		  AND (rp.room_id = r.id OR (rp.room_id IS NULL AND rp.room_type = r.type))
+		  AND rp.stay_range && daterange($2::date, $3::date, '[)')
+		ORDER BY rp.room_id IS NULL,
+				 upper(rp.stay_range) - lower(rp.stay_range),
+				 rp.created_at DESC;`
+
+	UpdateRatePlanByID = `
+		UPDATE rate_plan
+		SET title               = $2,
+		    stay_range          = daterange($3::date, $4::date, '[)'),
+		    price               = $5,
+		    weekday_adjustments = $6::numeric[]
+		WHERE id = $1
+		RETURNING hotel_id, room_id, room_type, created_at, updated_at;`
+
+	DeleteRatePlanByID = `
+		DELETE FROM rate_plan
+		WHERE id = $1;`
+)
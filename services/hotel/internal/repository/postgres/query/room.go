@@ -2,23 +2,31 @@ package query
 
 const (
 	InsertRoomQuery = `
-		INSERT INTO room (
-			hotel_id,
-			title,
-			description,
-			room_number,
-			type,
-			price,
-			capacity,
-			area_sqm,
-			floor,
-			amenities,
-			images
+		WITH new_room AS (
+			INSERT INTO room (
+				hotel_id,
+				title,
+				description,
+				room_number,
+				type,
+				price,
+				capacity,
+				area_sqm,
+				floor,
+				images
+			)
+			SELECT h.id, $4, $5, $6, $7, $8, $9, $10, $11, $13
+			FROM hotel h
+			WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL
+			RETURNING id, hotel_id, status, version, created_at, updated_at
+		), amenities AS (
+			INSERT INTO room_amenity (room_id, amenity_code)
+			SELECT nr.id, c.code
+			FROM new_room nr
+			CROSS JOIN unnest($12::text[]) AS c(code)
 		)
-		SELECT h.id, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
-		FROM hotel h
-		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3
-		RETURNING id, status, created_at, updated_at;`
+		SELECT id, hotel_id, status, version, created_at, updated_at
+		FROM new_room;`
 
 	SelectRooms = `
 		SELECT r.id,
@@ -29,17 +37,59 @@ const (
 			   r.price,
 			   r.capacity,
 			   r.area_sqm,
-			   r.amenities,
+			   ARRAY(
+				   SELECT ra.amenity_code
+				   FROM room_amenity ra
+				   WHERE ra.room_id = r.id
+				   ORDER BY ra.amenity_code
+			   ) AS amenities,
 			   r.images,
 			   COUNT(*) OVER() as total_count
 		FROM room r
 		JOIN hotel h ON h.id = r.hotel_id
 		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3
+		  AND h.deleted_at IS NULL AND r.deleted_at IS NULL
 		ORDER BY r.room_number
 		LIMIT $4 OFFSET $5;`
 
 	SelectRoomByID = `
-		SELECT title,
+		SELECT hotel_id,
+			   title,
+			   description,
+			   room_number,
+			   type,
+			   status,
+			   price,
+			   capacity,
+			   area_sqm,
+			   floor,
+			   ARRAY(
+				   SELECT ra.amenity_code
+				   FROM room_amenity ra
+				   WHERE ra.room_id = room.id
+				   ORDER BY ra.amenity_code
+			   ) AS amenities,
+			   images,
+			   version,
+			   created_at,
+			   updated_at,
+			   deleted_at,
+			   category_id
+		FROM room
+		WHERE id = $1 AND deleted_at IS NULL;`
+
+	SelectRoomTimezone = `
+		SELECT h.timezone
+		FROM room r
+		JOIN hotel h ON h.id = r.hotel_id
+		WHERE r.id = $1 AND r.deleted_at IS NULL;`
+
+	// SelectRoomsByIDs still returns deleted rooms so that historical bookings
+	// keep resolving them.
+	SelectRoomsByIDs = `
+		SELECT id,
+			   hotel_id,
+			   title,
 			   description,
 			   room_number,
 			   type,
@@ -48,33 +98,104 @@ const (
 			   capacity,
 			   area_sqm,
 			   floor,
-			   amenities,
+			   ARRAY(
+				   SELECT ra.amenity_code
+				   FROM room_amenity ra
+				   WHERE ra.room_id = room.id
+				   ORDER BY ra.amenity_code
+			   ) AS amenities,
 			   images,
+			   version,
 			   created_at,
-			   updated_at
+			   updated_at,
+			   deleted_at,
+			   category_id
 		FROM room
-		WHERE id = $1;`
+		WHERE id = ANY($1::uuid[])
+		ORDER BY array_position($1::uuid[], id);`
 
+	// UpdateRoomByID returns no rows when the room does not exist or its version
+	// differs from $12. Codes missing from the new amenity set are unlinked;
+	// existing links are left untouched. The price before the update is returned
+	// last.
 	UpdateRoomByID = `
-		UPDATE room
-		SET title       = $2,
-		    description = $3,
-		    room_number = $4,
-		    type        = $5,
-		    price       = $6,
-		    capacity    = $7,
-		    area_sqm    = $8,
-		    floor       = $9,
-		    amenities   = $10,
-		    images      = $11
-		WHERE id = $1;`
+		WITH old AS (
+			SELECT price FROM room WHERE id = $1
+		), updated AS (
+			UPDATE room
+			SET title       = $2,
+			    description = $3,
+			    room_number = $4,
+			    type        = $5,
+			    price       = $6,
+			    capacity    = $7,
+			    area_sqm    = $8,
+			    floor       = $9,
+			    images      = $11,
+			    version     = version + 1
+			WHERE id = $1 AND deleted_at IS NULL AND ($12::bigint IS NULL OR version = $12)
+			RETURNING id, hotel_id, version, price
+		), removed AS (
+			DELETE FROM room_amenity ra
+			USING updated u
+			WHERE ra.room_id = u.id AND ra.amenity_code <> ALL(COALESCE($10::text[], '{}'))
+		), added AS (
+			INSERT INTO room_amenity (room_id, amenity_code)
+			SELECT u.id, c.code
+			FROM updated u
+			CROSS JOIN unnest($10::text[]) AS c(code)
+			ON CONFLICT DO NOTHING
+		)
+		SELECT u.hotel_id, u.version, u.price, o.price
+		FROM updated u, old o;`
+
+	// PatchRoomByID is completed with the assignments of the masked fields and,
+	// when amenities are masked, with PatchRoomAmenities; placeholders start at $3.
+	// It returns the room like UpdateRoomByID.
+	PatchRoomByID = `
+		WITH old AS (
+			SELECT price FROM room WHERE id = $1
+		), updated AS (
+			UPDATE room
+			SET %s
+			WHERE id = $1 AND deleted_at IS NULL AND ($2::bigint IS NULL OR version = $2)
+			RETURNING id, hotel_id, version, price
+		)%s
+		SELECT u.hotel_id, u.version, u.price, o.price
+		FROM updated u, old o;`
+
+	// PatchRoomAmenities syncs the room amenity links with the code array at $%[1]d.
+	PatchRoomAmenities = `, removed AS (
+			DELETE FROM room_amenity ra
+			USING updated u
+			WHERE ra.room_id = u.id AND ra.amenity_code <> ALL(COALESCE($%[1]d::text[], '{}'))
+		), added AS (
+			INSERT INTO room_amenity (room_id, amenity_code)
+			SELECT u.id, c.code
+			FROM updated u
+			CROSS JOIN unnest($%[1]d::text[]) AS c(code)
+			ON CONFLICT DO NOTHING
+		)`
 
 	UpdateRoomStatusByID = `
 		UPDATE room
-		SET status = $2
-		WHERE id = $1;`
+		SET status = $2,
+		    version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL AND ($3::bigint IS NULL OR version = $3)
+		RETURNING hotel_id, version;`
+
+	RoomExistsByID = `
+		SELECT EXISTS (SELECT 1 FROM room WHERE id = $1 AND deleted_at IS NULL);`
 
 	DeleteRoomByID = `
-		DELETE FROM room
-		WHERE id = $1;`
+		UPDATE room
+		SET deleted_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING hotel_id;`
+
+	RestoreRoomByID = `
+		UPDATE room
+		SET deleted_at = NULL
+		WHERE id = $1 AND deleted_at IS NOT NULL
+		RETURNING hotel_id;`
 )
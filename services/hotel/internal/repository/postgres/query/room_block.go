@@ -0,0 +1,39 @@
+package query
+
+const (
+	InsertRoomBlock = `
+		INSERT INTO room_block (id, room_id, stay_range, reason, note)
+		VALUES ($1, $2, daterange($3::date, $4::date, '[)'), $5, $6)
+		RETURNING created_at, updated_at;`
+
+	SelectRoomBlocks = `
+		SELECT id,
+			   room_id,
+			   lower(stay_range),
+			   upper(stay_range),
+			   reason,
+			   note,
+			   created_at,
+			   updated_at,
+			   COUNT(*) OVER() as total_count
+		FROM room_block
+		WHERE room_id = $1
+		ORDER BY lower(stay_range)
+		LIMIT $2 OFFSET $3;`
+
+	SelectRoomBlockByID = `
+		SELECT id,
+			   room_id,
+			   lower(stay_range),
+			   upper(stay_range),
+			   reason,
+			   note,
+			   created_at,
+			   updated_at
+		FROM room_block
+		WHERE id = $1;`
+
+	DeleteRoomBlockByID = `
+		DELETE FROM room_block
+		WHERE id = $1;`
+)
@@ -0,0 +1,156 @@
+package query
+
+const (
+	InsertRoomCategory = `
+		WITH new_category AS (
+			INSERT INTO room_category (
+				hotel_id,
+				title,
+				description,
+				type,
+				price,
+				capacity,
+				area_sqm,
+				images
+			)
+			SELECT h.id, $4, $5, $6, $7, $8, $9, $11
+			FROM hotel h
+			WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL
+			RETURNING id, hotel_id, version, created_at, updated_at
+		), amenities AS (
+			INSERT INTO room_category_amenity (category_id, amenity_code)
+			SELECT nc.id, c.code
+			FROM new_category nc
+			CROSS JOIN unnest($10::text[]) AS c(code)
+		)
+		SELECT id, hotel_id, version, created_at, updated_at
+		FROM new_category;`
+
+	SelectRoomCategories = `
+		SELECT rc.id,
+			   rc.hotel_id,
+			   rc.title,
+			   rc.description,
+			   rc.type,
+			   rc.price,
+			   rc.capacity,
+			   rc.area_sqm,
+			   ARRAY(
+				   SELECT rca.amenity_code
+				   FROM room_category_amenity rca
+				   WHERE rca.category_id = rc.id
+				   ORDER BY rca.amenity_code
+			   ) AS amenities,
+			   rc.images,
+			   ARRAY(
+				   SELECT r.id
+				   FROM room r
+				   WHERE r.category_id = rc.id AND r.deleted_at IS NULL
+				   ORDER BY r.room_number
+			   ) AS room_ids,
+			   rc.version,
+			   rc.created_at,
+			   rc.updated_at
+		FROM room_category rc
+		JOIN hotel h ON h.id = rc.hotel_id
+		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL
+		ORDER BY rc.title;`
+
+	SelectRoomCategoryByID = `
+		SELECT rc.id,
+			   rc.hotel_id,
+			   rc.title,
+			   rc.description,
+			   rc.type,
+			   rc.price,
+			   rc.capacity,
+			   rc.area_sqm,
+			   ARRAY(
+				   SELECT rca.amenity_code
+				   FROM room_category_amenity rca
+				   WHERE rca.category_id = rc.id
+				   ORDER BY rca.amenity_code
+			   ) AS amenities,
+			   rc.images,
+			   ARRAY(
+				   SELECT r.id
+				   FROM room r
+				   WHERE r.category_id = rc.id AND r.deleted_at IS NULL
+				   ORDER BY r.room_number
+			   ) AS room_ids,
+			   rc.version,
+			   rc.created_at,
+			   rc.updated_at
+		FROM room_category rc
+		WHERE rc.id = $1;`
+
+	// UpdateRoomCategoryByID returns no rows when the category does not exist or
+	// its version differs from $10. A new price is copied onto the live rooms of
+	// the category so that they keep being quoted alike.
+	UpdateRoomCategoryByID = `
+		WITH updated AS (
+			UPDATE room_category
+			SET title       = $2,
+			    description = $3,
+			    type        = $4,
+			    price       = $5,
+			    capacity    = $6,
+			    area_sqm    = $7,
+			    images      = $9,
+			    version     = version + 1
+			WHERE id = $1 AND ($10::bigint IS NULL OR version = $10)
+			RETURNING id, price
+		), removed AS (
+			DELETE FROM room_category_amenity rca
+			USING updated u
+			WHERE rca.category_id = u.id AND rca.amenity_code <> ALL(COALESCE($8::text[], '{}'))
+		), added AS (
+			INSERT INTO room_category_amenity (category_id, amenity_code)
+			SELECT u.id, c.code
+			FROM updated u
+			CROSS JOIN unnest($8::text[]) AS c(code)
+			ON CONFLICT DO NOTHING
+		), repriced AS (
+			UPDATE room r
+			SET price = u.price,
+			    version = r.version + 1
+			FROM updated u
+			WHERE r.category_id = u.id AND r.deleted_at IS NULL AND r.price <> u.price
+		)
+		SELECT id FROM updated;`
+
+	RoomCategoryExistsByID = `
+		SELECT EXISTS (SELECT 1 FROM room_category WHERE id = $1);`
+
+	// DeleteRoomCategoryByID leaves categories that still have live rooms alone.
+	DeleteRoomCategoryByID = `
+		DELETE FROM room_category rc
+		WHERE rc.id = $1
+		  AND NOT EXISTS (
+		      SELECT 1 FROM room r WHERE r.category_id = rc.id AND r.deleted_at IS NULL
+		  );`
+
+	// AssignRoomCategory moves the room into category $2, or out of any category
+	// when $2 is NULL, and takes over the category price. It returns no rows when
+	// the room is missing, its version differs from $3 or the category does not
+	// belong to the room's hotel.
+	AssignRoomCategory = `
+		UPDATE room r
+		SET category_id = $2,
+		    price = COALESCE((SELECT rc.price FROM room_category rc WHERE rc.id = $2), r.price),
+		    version = r.version + 1
+		WHERE r.id = $1 AND r.deleted_at IS NULL
+		  AND ($3::bigint IS NULL OR r.version = $3)
+		  AND ($2::uuid IS NULL OR EXISTS (
+		      SELECT 1 FROM room_category rc WHERE rc.id = $2 AND rc.hotel_id = r.hotel_id
+		  ))
+		RETURNING r.version;`
+
+	RoomCategoryExistsForRoom = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM room_category rc
+			JOIN room r ON r.hotel_id = rc.hotel_id
+			WHERE rc.id = $1 AND r.id = $2
+		);`
+)
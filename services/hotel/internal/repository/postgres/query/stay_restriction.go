@@ -0,0 +1,100 @@
+package query
+
+const (
+	InsertStayRestriction = `
+		INSERT INTO stay_restriction (
+			hotel_id,
+			room_id,
+			stay_range,
+			min_length_of_stay,
+			max_length_of_stay,
+			closed_to_arrival,
+			closed_to_departure,
+			min_advance_days,
+			max_advance_days
+		)
+		SELECT h.id, $4, daterange($5::date, $6::date, '[)'), $7, $8, $9, $10, $11, $12
+		FROM hotel h
+		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL
+		  AND ($4::uuid IS NULL OR EXISTS (
+		      SELECT 1 FROM room r WHERE r.id = $4 AND r.hotel_id = h.id AND r.deleted_at IS NULL
+		  ))
+		RETURNING id, hotel_id, created_at, updated_at;`
+
+	SelectStayRestrictions = `
+		SELECT sr.id,
+			   sr.hotel_id,
+			   sr.room_id,
+			   lower(sr.stay_range),
+			   upper(sr.stay_range),
+			   sr.min_length_of_stay,
+			   sr.max_length_of_stay,
+			   sr.closed_to_arrival,
+			   sr.closed_to_departure,
+			   sr.min_advance_days,
+			   sr.max_advance_days,
+			   sr.created_at,
+			   sr.updated_at,
+			   COUNT(*) OVER() as total_count
+		FROM stay_restriction sr
+		JOIN hotel h ON h.id = sr.hotel_id
+		WHERE h.country_code = $1 AND h.city_slug = $2 AND h.slug = $3 AND h.deleted_at IS NULL
+		ORDER BY lower(sr.stay_range), sr.created_at
+		LIMIT $4 OFFSET $5;`
+
+	SelectStayRestrictionByID = `
+		SELECT id,
+			   hotel_id,
+			   room_id,
+			   lower(stay_range),
+			   upper(stay_range),
+			   min_length_of_stay,
+			   max_length_of_stay,
+			   closed_to_arrival,
+			   closed_to_departure,
+			   min_advance_days,
+			   max_advance_days,
+			   created_at,
+			   updated_at
+		FROM stay_restriction
+		WHERE id = $1;`
+
+	// SelectRoomStayRestrictions returns hotel wide and room restrictions
+	// covering either the arrival or the departure date.
+	SelectRoomStayRestrictions = `
+		SELECT sr.id,
+			   sr.hotel_id,
+			   sr.room_id,
+			   lower(sr.stay_range),
+			   upper(sr.stay_range),
+			   sr.min_length_of_stay,
+			   sr.max_length_of_stay,
+			   sr.closed_to_arrival,
+			   sr.closed_to_departure,
+			   sr.min_advance_days,
+			   sr.max_advance_days,
+			   sr.created_at,
+			   sr.updated_at
+		FROM stay_restriction sr
+		JOIN room r ON r.hotel_id = sr.hotel_id
+		WHERE r.id = $1
+		  AND (sr.room_id IS NULL OR sr.room_id = r.id)
+		  AND (sr.stay_range @> $2::date OR sr.stay_range @> $3::date)
+		ORDER BY lower(sr.stay_range), sr.created_at;`
+
+	UpdateStayRestrictionByID = `
+		UPDATE stay_restriction
+		SET stay_range          = daterange($2::date, $3::date, '[)'),
+		    min_length_of_stay  = $4,
+		    max_length_of_stay  = $5,
+		    closed_to_arrival   = $6,
+		    closed_to_departure = $7,
+		    min_advance_days    = $8,
+		    max_advance_days    = $9
+		WHERE id = $1
+		RETURNING hotel_id, room_id, created_at, updated_at;`
+
+	DeleteStayRestrictionByID = `
+		DELETE FROM stay_restriction
+		WHERE id = $1;`
+)
@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/repository/postgres/query"
+	"hotel/pkg/lib/utils/consts"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+func (r *Repository) InsertRatePlan(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+	rp *models.CreateRatePlan,
+) (*models.RatePlan, error) {
+	newRatePlan := rp.ToRead()
+	err := r.db.QueryRow(
+		ctx, query.InsertRatePlan,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+		rp.RoomID,
+		rp.RoomType,
+		rp.Title,
+		rp.StayRange.Start,
+		rp.StayRange.End,
+		rp.Price,
+		weekdayAdjustmentsToDB(rp.WeekdayAdjustments),
+	).Scan(
+		&newRatePlan.ID,
+		&newRatePlan.HotelID,
+		&newRatePlan.CreatedAt,
+		&newRatePlan.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrRatePlanTargetNotFound
+		}
+		return nil, err
+	}
+
+	return newRatePlan, nil
+}
+
+func (r *Repository) SelectRatePlans(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+	limit uint64,
+	offset uint64,
+) (*models.RatePlanList, error) {
+	rows, err := r.db.Query(
+		ctx, query.SelectRatePlans,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ratePlanList := &models.RatePlanList{}
+	for rows.Next() {
+		var rp models.RatePlan
+		var adjustments []string
+		err = rows.Scan(
+			&rp.ID,
+			&rp.HotelID,
+			&rp.RoomID,
+			&rp.RoomType,
+			&rp.Title,
+			&rp.StayRange.Start,
+			&rp.StayRange.End,
+			&rp.Price,
+			&adjustments,
+			&rp.CreatedAt,
+			&rp.UpdatedAt,
+			&ratePlanList.TotalCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if rp.WeekdayAdjustments, err = weekdayAdjustmentsFromDB(adjustments); err != nil {
+			return nil, err
+		}
+		ratePlanList.RatePlans = append(ratePlanList.RatePlans, &rp)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ratePlanList, nil
+}
+
+func (r *Repository) SelectRatePlanByID(ctx context.Context, ratePlanID uuid.UUID) (*models.RatePlan, error) {
+	var rp models.RatePlan
+	var adjustments []string
+	err := r.db.QueryRow(ctx, query.SelectRatePlanByID, ratePlanID).Scan(
+		&rp.ID,
+		&rp.HotelID,
+		&rp.RoomID,
+		&rp.RoomType,
+		&rp.Title,
+		&rp.StayRange.Start,
+		&rp.StayRange.End,
+		&rp.Price,
+		&adjustments,
+		&rp.CreatedAt,
+		&rp.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrRatePlanNotFound
+		}
+		return nil, err
+	}
+
+	if rp.WeekdayAdjustments, err = weekdayAdjustmentsFromDB(adjustments); err != nil {
+		return nil, err
+	}
+
+	return &rp, nil
+}
+
+func (r *Repository) SelectRoomRatePlans(
+	ctx context.Context,
+	roomID uuid.UUID,
+	stayRange models.DateRange,
+) ([]*models.RatePlan, error) {
+	rows, err := r.db.Query(ctx, query.SelectRoomRatePlans, roomID, stayRange.Start, stayRange.End)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ratePlans []*models.RatePlan
+	for rows.Next() {
+		var rp models.RatePlan
+		var adjustments []string
+		err = rows.Scan(
+			&rp.ID,
+			&rp.HotelID,
+			&rp.RoomID,
+			&rp.RoomType,
+			&rp.Title,
+			&rp.StayRange.Start,
+			&rp.StayRange.End,
+			&rp.Price,
+			&adjustments,
+			&rp.CreatedAt,
+			&rp.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if rp.WeekdayAdjustments, err = weekdayAdjustmentsFromDB(adjustments); err != nil {
+			return nil, err
+		}
+		ratePlans = append(ratePlans, &rp)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ratePlans, nil
+}
+
+func (r *Repository) UpdateRatePlanByID(
+	ctx context.Context,
+	ratePlanID uuid.UUID,
+	rp *models.UpdateRatePlan,
+) (*models.RatePlan, error) {
+	updated := &models.RatePlan{
+		ID:                 ratePlanID,
+		StayRange:          rp.StayRange,
+		Title:              rp.Title,
+		Price:              rp.Price,
+		WeekdayAdjustments: rp.WeekdayAdjustments,
+	}
+	err := r.db.QueryRow(
+		ctx, query.UpdateRatePlanByID,
+		ratePlanID,
+		rp.Title,
+		rp.StayRange.Start,
+		rp.StayRange.End,
+		rp.Price,
+		weekdayAdjustmentsToDB(rp.WeekdayAdjustments),
+	).Scan(
+		&updated.HotelID,
+		&updated.RoomID,
+		&updated.RoomType,
+		&updated.CreatedAt,
+		&updated.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrRatePlanNotFound
+		}
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *Repository) DeleteRatePlanByID(ctx context.Context, ratePlanID uuid.UUID) error {
+	row, err := r.db.Exec(ctx, query.DeleteRatePlanByID, ratePlanID)
+	if err != nil {
+		return err
+	}
+	if rowAffected := row.RowsAffected(); rowAffected == 0 {
+		return consts.ErrRatePlanNotFound
+	}
+
+	return nil
+}
+
+func weekdayAdjustmentsToDB(adjustments models.WeekdayAdjustments) []string {
+	values := make([]string, len(adjustments))
+	for i, adj := range adjustments {
+		values[i] = adj.StringFixed(2)
+	}
+
+	return values
+}
+
+func weekdayAdjustmentsFromDB(values []string) (models.WeekdayAdjustments, error) {
+	var adjustments models.WeekdayAdjustments
+	for i := 0; i < len(values) && i < len(adjustments); i++ {
+		adj, err := decimal.NewFromString(values[i])
+		if err != nil {
+			return models.WeekdayAdjustments{}, err
+		}
+		adjustments[i] = adj
+	}
+
+	return adjustments, nil
+}
@@ -3,7 +3,10 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"hotel/internal/outbox"
 	"hotel/internal/repository/models"
 	"hotel/internal/repository/postgres/query"
 	"hotel/pkg/lib/utils/consts"
@@ -11,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
 )
 
 func (r *Repository) InsertRoom(
@@ -36,16 +40,14 @@ func (r *Repository) InsertRoom(
 		room.Images,
 	).Scan(
 		&newRoom.ID,
+		&newRoom.HotelID,
 		&newRoom.Status,
+		&newRoom.Version,
 		&newRoom.CreatedAt,
 		&newRoom.UpdatedAt,
 	)
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return nil, consts.ErrUniqueRoomField
-		}
-		return nil, err
+		return nil, roomWriteErr(err)
 	}
 
 	return newRoom, nil
@@ -114,21 +116,8 @@ func (r *Repository) SelectRooms(
 
 func (r *Repository) SelectRoomByID(ctx context.Context, roomID uuid.UUID) (*models.Room, error) {
 	room := &models.Room{ID: roomID}
-	err := r.db.QueryRow(ctx, query.SelectRoomByID, roomID).Scan(
-		&room.Title,
-		&room.Description,
-		&room.RoomNumber,
-		&room.Type,
-		&room.Status,
-		&room.Price,
-		&room.Capacity,
-		&room.AreaSqm,
-		&room.Floor,
-		&room.Amenities,
-		&room.Images,
-		&room.CreatedAt,
-		&room.UpdatedAt,
-	)
+	fields := roomFields(room)
+	err := r.db.QueryRow(ctx, query.SelectRoomByID, roomID).Scan(fields[1:]...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, consts.ErrRoomNotFound
@@ -139,9 +128,45 @@ func (r *Repository) SelectRoomByID(ctx context.Context, roomID uuid.UUID) (*mod
 	return room, nil
 }
 
-func (r *Repository) UpdateRoomByID(ctx context.Context, roomID uuid.UUID, room *models.UpdateRoom) error {
-	row, err := r.db.Exec(
-		ctx, query.UpdateRoomByID,
+func (r *Repository) SelectRoomTimezone(ctx context.Context, roomID uuid.UUID) (string, error) {
+	var timezone string
+	err := r.db.QueryRow(ctx, query.SelectRoomTimezone, roomID).Scan(&timezone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", consts.ErrRoomNotFound
+		}
+		return "", err
+	}
+
+	return timezone, nil
+}
+
+func (r *Repository) SelectRoomsByIDs(ctx context.Context, roomIDs []uuid.UUID) ([]*models.Room, error) {
+	rows, err := r.db.Query(ctx, query.SelectRoomsByIDs, roomIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rooms := make([]*models.Room, 0, len(roomIDs))
+	for rows.Next() {
+		var room models.Room
+		if err = rows.Scan(roomFields(&room)...); err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, &room)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rooms, nil
+}
+
+func (r *Repository) UpdateRoomByID(ctx context.Context, roomID uuid.UUID, room *models.UpdateRoom) (int64, error) {
+	version, err := r.updateRoom(
+		ctx, roomID, query.UpdateRoomByID,
 		roomID,
 		room.Title,
 		room.Description,
@@ -153,41 +178,204 @@ func (r *Repository) UpdateRoomByID(ctx context.Context, roomID uuid.UUID, room
 		room.Floor,
 		room.Amenities,
 		room.Images,
+		room.ExpectedVersion,
 	)
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return consts.ErrUniqueRoomField
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, r.roomVersionErr(ctx, roomID, room.ExpectedVersion)
 		}
-		return err
+		return 0, roomWriteErr(err)
 	}
-	if rowAffected := row.RowsAffected(); rowAffected == 0 {
-		return consts.ErrRoomNotFound
+
+	return version, nil
+}
+
+func (r *Repository) PatchRoomByID(ctx context.Context, roomID uuid.UUID, room *models.PatchRoom) error {
+	set := newUpdateSet(roomID, room.ExpectedVersion)
+	set.set("version = version + 1")
+	set.set("updated_at = now()")
+	var amenities string
+	for _, field := range room.Fields {
+		switch field {
+		case models.RoomFieldTitle:
+			set.set("title = $%d", room.Title)
+		case models.RoomFieldDescription:
+			set.set("description = $%d", room.Description)
+		case models.RoomFieldRoomNumber:
+			set.set("room_number = $%d", room.RoomNumber)
+		case models.RoomFieldType:
+			set.set("type = $%d", room.Type)
+		case models.RoomFieldPrice:
+			set.set("price = $%d", room.Price)
+		case models.RoomFieldCapacity:
+			set.set("capacity = $%d", room.Capacity)
+		case models.RoomFieldAreaSqm:
+			set.set("area_sqm = $%d", room.AreaSqm)
+		case models.RoomFieldFloor:
+			set.set("floor = $%d", room.Floor)
+		case models.RoomFieldImages:
+			set.set("images = $%d", room.Images)
+		case models.RoomFieldAmenities:
+			amenities = fmt.Sprintf(query.PatchRoomAmenities, set.arg(room.Amenities))
+		}
+	}
+
+	_, err := r.updateRoom(ctx, roomID, fmt.Sprintf(query.PatchRoomByID, set, amenities), set.args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return r.roomVersionErr(ctx, roomID, room.ExpectedVersion)
+		}
+		return roomWriteErr(err)
 	}
 
 	return nil
 }
 
-func (r *Repository) UpdateRoomStatusByID(ctx context.Context, roomID uuid.UUID, room models.UpdateRoomStatus) error {
-	row, err := r.db.Exec(ctx, query.UpdateRoomStatusByID, roomID, room.Status)
+func (r *Repository) UpdateRoomStatusByID(
+	ctx context.Context,
+	roomID uuid.UUID,
+	room models.UpdateRoomStatus,
+) (int64, error) {
+	var version int64
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var hotelID uuid.UUID
+		err := tx.QueryRow(
+			ctx, query.UpdateRoomStatusByID, roomID, room.Status, room.ExpectedVersion,
+		).Scan(&hotelID, &version)
+		if err != nil {
+			return err
+		}
+
+		event, err := outbox.RoomUpdated(roomID, hotelID, version, time.Now())
+		if err != nil {
+			return err
+		}
+		return r.CreateOutboxEvent(ctx, tx, event)
+	})
 	if err != nil {
-		return err
-	}
-	if rowAffected := row.RowsAffected(); rowAffected == 0 {
-		return consts.ErrRoomNotFound
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, r.roomVersionErr(ctx, roomID, room.ExpectedVersion)
+		}
+		return 0, err
 	}
 
-	return nil
+	return version, nil
 }
 
+// DeleteRoomByID soft-deletes the room and records room.deleted in the outbox
+// in the same transaction.
 func (r *Repository) DeleteRoomByID(ctx context.Context, roomID uuid.UUID) error {
-	row, err := r.db.Exec(ctx, query.DeleteRoomByID, roomID)
-	if err != nil {
-		return err
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var hotelID uuid.UUID
+		if err := tx.QueryRow(ctx, query.DeleteRoomByID, roomID).Scan(&hotelID); err != nil {
+			return err
+		}
+
+		event, err := outbox.RoomDeleted(roomID, hotelID, time.Now())
+		if err != nil {
+			return err
+		}
+		return r.CreateOutboxEvent(ctx, tx, event)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return consts.ErrRoomNotFound
 	}
-	if rowAffected := row.RowsAffected(); rowAffected == 0 {
+
+	return err
+}
+
+// RestoreRoomByID brings back a room deleted by DeleteRoomByID and records
+// room.restored in the outbox in the same transaction.
+func (r *Repository) RestoreRoomByID(ctx context.Context, roomID uuid.UUID) error {
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var hotelID uuid.UUID
+		if err := tx.QueryRow(ctx, query.RestoreRoomByID, roomID).Scan(&hotelID); err != nil {
+			return err
+		}
+
+		event, err := outbox.RoomRestored(roomID, hotelID, time.Now())
+		if err != nil {
+			return err
+		}
+		return r.CreateOutboxEvent(ctx, tx, event)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
 		return consts.ErrRoomNotFound
 	}
 
-	return nil
+	return err
+}
+
+// updateRoom runs an update returning the room like UpdateRoomByID and records
+// the change, and a new price separately, in the outbox in the same transaction.
+func (r *Repository) updateRoom(ctx context.Context, roomID uuid.UUID, sql string, args ...any) (int64, error) {
+	var version int64
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		var hotelID uuid.UUID
+		var price, oldPrice decimal.Decimal
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&hotelID, &version, &price, &oldPrice); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		event, err := outbox.RoomUpdated(roomID, hotelID, version, now)
+		if err != nil {
+			return err
+		}
+		if err = r.CreateOutboxEvent(ctx, tx, event); err != nil {
+			return err
+		}
+		if price.Equal(oldPrice) {
+			return nil
+		}
+
+		event, err = outbox.RoomPriceChanged(roomID, hotelID, oldPrice, price, version, now)
+		if err != nil {
+			return err
+		}
+		return r.CreateOutboxEvent(ctx, tx, event)
+	})
+
+	return version, err
+}
+
+func (r *Repository) roomVersionErr(ctx context.Context, roomID uuid.UUID, expectedVersion *int64) error {
+	return r.versionErr(ctx, expectedVersion, consts.ErrRoomNotFound, query.RoomExistsByID, roomID)
+}
+
+// roomFields lists scan targets in the column order of SelectRoomsByIDs; SelectRoomByID omits the leading id.
+func roomFields(room *models.Room) []any {
+	return []any{
+		&room.ID,
+		&room.HotelID,
+		&room.Title,
+		&room.Description,
+		&room.RoomNumber,
+		&room.Type,
+		&room.Status,
+		&room.Price,
+		&room.Capacity,
+		&room.AreaSqm,
+		&room.Floor,
+		&room.Amenities,
+		&room.Images,
+		&room.Version,
+		&room.CreatedAt,
+		&room.UpdatedAt,
+		&room.DeletedAt,
+		&room.CategoryID,
+	}
+}
+
+func roomWriteErr(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505":
+			return consts.ErrUniqueRoomField
+		case "23503":
+			return consts.ErrUnknownAmenity
+		}
+	}
+	return err
 }
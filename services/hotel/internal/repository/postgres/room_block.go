@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/repository/postgres/query"
+	"hotel/pkg/lib/utils/consts"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r *Repository) InsertRoomBlock(
+	ctx context.Context,
+	blockID uuid.UUID,
+	rb *models.CreateRoomBlock,
+) (*models.RoomBlock, error) {
+	newBlock := rb.ToRead()
+	newBlock.ID = blockID
+	err := r.db.QueryRow(
+		ctx, query.InsertRoomBlock,
+		blockID,
+		rb.RoomID,
+		rb.StayRange.Start,
+		rb.StayRange.End,
+		rb.Reason,
+		rb.Note,
+	).Scan(&newBlock.CreatedAt, &newBlock.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23P01":
+				return nil, consts.ErrRoomUnavailable
+			case "23503":
+				return nil, consts.ErrRoomNotFound
+			}
+		}
+		return nil, err
+	}
+
+	return newBlock, nil
+}
+
+func (r *Repository) SelectRoomBlocks(
+	ctx context.Context,
+	roomID uuid.UUID,
+	limit uint64,
+	offset uint64,
+) (*models.RoomBlockList, error) {
+	rows, err := r.db.Query(ctx, query.SelectRoomBlocks, roomID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	blockList := &models.RoomBlockList{}
+	for rows.Next() {
+		var rb models.RoomBlock
+		err = rows.Scan(
+			&rb.ID,
+			&rb.RoomID,
+			&rb.StayRange.Start,
+			&rb.StayRange.End,
+			&rb.Reason,
+			&rb.Note,
+			&rb.CreatedAt,
+			&rb.UpdatedAt,
+			&blockList.TotalCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		blockList.RoomBlocks = append(blockList.RoomBlocks, &rb)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return blockList, nil
+}
+
+func (r *Repository) SelectRoomBlockByID(ctx context.Context, blockID uuid.UUID) (*models.RoomBlock, error) {
+	var rb models.RoomBlock
+	err := r.db.QueryRow(ctx, query.SelectRoomBlockByID, blockID).Scan(
+		&rb.ID,
+		&rb.RoomID,
+		&rb.StayRange.Start,
+		&rb.StayRange.End,
+		&rb.Reason,
+		&rb.Note,
+		&rb.CreatedAt,
+		&rb.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrRoomBlockNotFound
+		}
+		return nil, err
+	}
+
+	return &rb, nil
+}
+
+func (r *Repository) DeleteRoomBlockByID(ctx context.Context, blockID uuid.UUID) error {
+	row, err := r.db.Exec(ctx, query.DeleteRoomBlockByID, blockID)
+	if err != nil {
+		return err
+	}
+	if rowAffected := row.RowsAffected(); rowAffected == 0 {
+		return consts.ErrRoomBlockNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/repository/postgres/query"
+	"hotel/pkg/lib/utils/consts"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func (r *Repository) InsertRoomCategory(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+	c *models.CreateRoomCategory,
+) (*models.RoomCategory, error) {
+	newCategory := c.ToRead()
+	err := r.db.QueryRow(
+		ctx, query.InsertRoomCategory,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+		c.Title,
+		c.Description,
+		c.Type,
+		c.Price,
+		c.Capacity,
+		c.AreaSqm,
+		c.Amenities,
+		c.Images,
+	).Scan(
+		&newCategory.ID,
+		&newCategory.HotelID,
+		&newCategory.Version,
+		&newCategory.CreatedAt,
+		&newCategory.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrHotelNotFound
+		}
+		return nil, roomCategoryWriteErr(err)
+	}
+
+	return newCategory, nil
+}
+
+func (r *Repository) SelectRoomCategories(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+) ([]*models.RoomCategory, error) {
+	rows, err := r.db.Query(
+		ctx, query.SelectRoomCategories,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []*models.RoomCategory
+	for rows.Next() {
+		var c models.RoomCategory
+		if err = rows.Scan(roomCategoryFields(&c)...); err != nil {
+			return nil, err
+		}
+		categories = append(categories, &c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *Repository) SelectRoomCategoryByID(ctx context.Context, categoryID uuid.UUID) (*models.RoomCategory, error) {
+	var c models.RoomCategory
+	err := r.db.QueryRow(ctx, query.SelectRoomCategoryByID, categoryID).Scan(roomCategoryFields(&c)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrRoomCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *Repository) UpdateRoomCategoryByID(
+	ctx context.Context,
+	categoryID uuid.UUID,
+	c *models.UpdateRoomCategory,
+) error {
+	err := r.db.QueryRow(
+		ctx, query.UpdateRoomCategoryByID,
+		categoryID,
+		c.Title,
+		c.Description,
+		c.Type,
+		c.Price,
+		c.Capacity,
+		c.AreaSqm,
+		c.Amenities,
+		c.Images,
+		c.ExpectedVersion,
+	).Scan(&categoryID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return r.versionErr(
+				ctx, c.ExpectedVersion, consts.ErrRoomCategoryNotFound, query.RoomCategoryExistsByID, categoryID,
+			)
+		}
+		return roomCategoryWriteErr(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteRoomCategoryByID(ctx context.Context, categoryID uuid.UUID) error {
+	row, err := r.db.Exec(ctx, query.DeleteRoomCategoryByID, categoryID)
+	if err != nil {
+		return err
+	}
+	if row.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err = r.db.QueryRow(ctx, query.RoomCategoryExistsByID, categoryID).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return consts.ErrRoomCategoryInUse
+	}
+
+	return consts.ErrRoomCategoryNotFound
+}
+
+func (r *Repository) AssignRoomCategory(
+	ctx context.Context,
+	roomID uuid.UUID,
+	a models.AssignRoomCategory,
+) (int64, error) {
+	var version int64
+	err := r.db.QueryRow(ctx, query.AssignRoomCategory, roomID, a.CategoryID, a.ExpectedVersion).Scan(&version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, r.assignRoomCategoryErr(ctx, roomID, a)
+		}
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// assignRoomCategoryErr tells a missing room from a category of another hotel
+// and from a stale version.
+func (r *Repository) assignRoomCategoryErr(ctx context.Context, roomID uuid.UUID, a models.AssignRoomCategory) error {
+	var exists bool
+	if err := r.db.QueryRow(ctx, query.RoomExistsByID, roomID).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return consts.ErrRoomNotFound
+	}
+
+	if a.CategoryID != nil {
+		if err := r.db.QueryRow(ctx, query.RoomCategoryExistsForRoom, a.CategoryID, roomID).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return consts.ErrRoomCategoryNotFound
+		}
+	}
+
+	return consts.ErrVersionMismatch
+}
+
+// roomCategoryFields lists scan targets in the column order of the room category selects.
+func roomCategoryFields(c *models.RoomCategory) []any {
+	return []any{
+		&c.ID,
+		&c.HotelID,
+		&c.Title,
+		&c.Description,
+		&c.Type,
+		&c.Price,
+		&c.Capacity,
+		&c.AreaSqm,
+		&c.Amenities,
+		&c.Images,
+		&c.RoomIDs,
+		&c.Version,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	}
+}
+
+func roomCategoryWriteErr(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505":
+			return consts.ErrUniqueRoomCategoryTitle
+		case "23503":
+			return consts.ErrUnknownAmenity
+		}
+	}
+	return err
+}
@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/repository/postgres/query"
+	"hotel/pkg/lib/utils/consts"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) InsertStayRestriction(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+	sr *models.CreateStayRestriction,
+) (*models.StayRestriction, error) {
+	newRestriction := sr.ToRead()
+	err := r.db.QueryRow(
+		ctx, query.InsertStayRestriction,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+		sr.RoomID,
+		sr.StayRange.Start,
+		sr.StayRange.End,
+		sr.Rules.MinLengthOfStay,
+		sr.Rules.MaxLengthOfStay,
+		sr.Rules.ClosedToArrival,
+		sr.Rules.ClosedToDeparture,
+		sr.Rules.MinAdvanceDays,
+		sr.Rules.MaxAdvanceDays,
+	).Scan(
+		&newRestriction.ID,
+		&newRestriction.HotelID,
+		&newRestriction.CreatedAt,
+		&newRestriction.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrStayRestrictionTargetNotFound
+		}
+		return nil, err
+	}
+
+	return newRestriction, nil
+}
+
+func (r *Repository) SelectStayRestrictions(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+	limit uint64,
+	offset uint64,
+) (*models.StayRestrictionList, error) {
+	rows, err := r.db.Query(
+		ctx, query.SelectStayRestrictions,
+		hotelRef.CountryCode,
+		hotelRef.CitySlug,
+		hotelRef.HotelSlug,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	restrictionList := &models.StayRestrictionList{}
+	for rows.Next() {
+		var sr models.StayRestriction
+		fields := append(stayRestrictionFields(&sr), &restrictionList.TotalCount)
+		if err = rows.Scan(fields...); err != nil {
+			return nil, err
+		}
+		restrictionList.StayRestrictions = append(restrictionList.StayRestrictions, &sr)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return restrictionList, nil
+}
+
+func (r *Repository) SelectStayRestrictionByID(
+	ctx context.Context,
+	restrictionID uuid.UUID,
+) (*models.StayRestriction, error) {
+	var sr models.StayRestriction
+	err := r.db.QueryRow(ctx, query.SelectStayRestrictionByID, restrictionID).Scan(stayRestrictionFields(&sr)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrStayRestrictionNotFound
+		}
+		return nil, err
+	}
+
+	return &sr, nil
+}
+
+func (r *Repository) SelectRoomStayRestrictions(
+	ctx context.Context,
+	roomID uuid.UUID,
+	stay models.DateRange,
+) ([]*models.StayRestriction, error) {
+	rows, err := r.db.Query(ctx, query.SelectRoomStayRestrictions, roomID, stay.Start, stay.End)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var restrictions []*models.StayRestriction
+	for rows.Next() {
+		var sr models.StayRestriction
+		if err = rows.Scan(stayRestrictionFields(&sr)...); err != nil {
+			return nil, err
+		}
+		restrictions = append(restrictions, &sr)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return restrictions, nil
+}
+
+func (r *Repository) UpdateStayRestrictionByID(
+	ctx context.Context,
+	restrictionID uuid.UUID,
+	sr *models.UpdateStayRestriction,
+) (*models.StayRestriction, error) {
+	updated := &models.StayRestriction{
+		ID:        restrictionID,
+		StayRange: sr.StayRange,
+		Rules:     sr.Rules,
+	}
+	err := r.db.QueryRow(
+		ctx, query.UpdateStayRestrictionByID,
+		restrictionID,
+		sr.StayRange.Start,
+		sr.StayRange.End,
+		sr.Rules.MinLengthOfStay,
+		sr.Rules.MaxLengthOfStay,
+		sr.Rules.ClosedToArrival,
+		sr.Rules.ClosedToDeparture,
+		sr.Rules.MinAdvanceDays,
+		sr.Rules.MaxAdvanceDays,
+	).Scan(
+		&updated.HotelID,
+		&updated.RoomID,
+		&updated.CreatedAt,
+		&updated.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrStayRestrictionNotFound
+		}
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *Repository) DeleteStayRestrictionByID(ctx context.Context, restrictionID uuid.UUID) error {
+	row, err := r.db.Exec(ctx, query.DeleteStayRestrictionByID, restrictionID)
+	if err != nil {
+		return err
+	}
+	if rowAffected := row.RowsAffected(); rowAffected == 0 {
+		return consts.ErrStayRestrictionNotFound
+	}
+
+	return nil
+}
+
+func stayRestrictionFields(sr *models.StayRestriction) []any {
+	return []any{
+		&sr.ID,
+		&sr.HotelID,
+		&sr.RoomID,
+		&sr.StayRange.Start,
+		&sr.StayRange.End,
+		&sr.Rules.MinLengthOfStay,
+		&sr.Rules.MaxLengthOfStay,
+		&sr.Rules.ClosedToArrival,
+		&sr.Rules.ClosedToDeparture,
+		&sr.Rules.MinAdvanceDays,
+		&sr.Rules.MaxAdvanceDays,
+		&sr.CreatedAt,
+		&sr.UpdatedAt,
+	}
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+)
+
+// updateSet collects the assignments of a dynamic UPDATE together with their arguments.
+type updateSet struct {
+	assignments []string
+	args        []any
+}
+
+func newUpdateSet(args ...any) *updateSet {
+	return &updateSet{args: args}
+}
+
+// arg appends value to the arguments and returns its placeholder number.
+func (s *updateSet) arg(value any) int {
+	s.args = append(s.args, value)
+	return len(s.args)
+}
+
+// set adds an assignment whose %d verbs are replaced with the placeholders of values.
+func (s *updateSet) set(assignment string, values ...any) {
+	placeholders := make([]any, len(values))
+	for i, v := range values {
+		placeholders[i] = s.arg(v)
+	}
+	s.assignments = append(s.assignments, fmt.Sprintf(assignment, placeholders...))
+}
+
+func (s *updateSet) String() string {
+	return strings.Join(s.assignments, ", ")
+}
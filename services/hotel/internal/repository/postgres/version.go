@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"context"
+
+	"hotel/pkg/lib/utils/consts"
+)
+
+// versionErr tells a stale expected version apart from a missing row once a
+// versioned update has matched nothing; existsQuery must select a single boolean.
+func (r *Repository) versionErr(
+	ctx context.Context,
+	expectedVersion *int64,
+	notFound error,
+	existsQuery string,
+	args ...any,
+) error {
+	if expectedVersion == nil {
+		return notFound
+	}
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, existsQuery, args...).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return consts.ErrVersionMismatch
+	}
+
+	return notFound
+}
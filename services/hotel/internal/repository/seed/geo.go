@@ -0,0 +1,97 @@
+package seed
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+	_ "time/tzdata"
+
+	"hotel/internal/repository/models"
+)
+
+//go:embed geo.json
+var geoDataset []byte
+
+var (
+	countryCodeRegexp = regexp.MustCompile(`^[a-z]{2}$`)
+	isoAlpha3Regexp   = regexp.MustCompile(`^[A-Z]{3}$`)
+	citySlugRegexp    = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+type geoFile struct {
+	Countries []struct {
+		Names     models.LocalizedNames `json:"names"`
+		Code      string                `json:"code"`
+		ISOAlpha3 string                `json:"iso_alpha3"`
+		Cities    []struct {
+			Names     models.LocalizedNames `json:"names"`
+			Slug      string                `json:"slug"`
+			Timezone  string                `json:"timezone"`
+			Latitude  float32               `json:"latitude"`
+			Longitude float32               `json:"longitude"`
+		} `json:"cities"`
+	} `json:"countries"`
+}
+
+// Geo parses and validates the bundled country and city catalogue.
+func Geo() ([]*models.Country, []*models.City, error) {
+	var file geoFile
+	if err := json.Unmarshal(geoDataset, &file); err != nil {
+		return nil, nil, fmt.Errorf("parse geo dataset: %w", err)
+	}
+
+	countries := make([]*models.Country, 0, len(file.Countries))
+	cities := make([]*models.City, 0)
+	seen := make(map[string]struct{})
+	for _, c := range file.Countries {
+		if !countryCodeRegexp.MatchString(c.Code) || !isoAlpha3Regexp.MatchString(c.ISOAlpha3) {
+			return nil, nil, fmt.Errorf("country %q: invalid iso code", c.Code)
+		}
+		if c.Names["en"] == "" {
+			return nil, nil, fmt.Errorf("country %q: missing en name", c.Code)
+		}
+		if _, ok := seen[c.Code]; ok {
+			return nil, nil, fmt.Errorf("country %q: duplicated", c.Code)
+		}
+		seen[c.Code] = struct{}{}
+
+		isoAlpha3 := c.ISOAlpha3
+		countries = append(countries, &models.Country{
+			Names:     c.Names,
+			ISOAlpha3: &isoAlpha3,
+			Code:      c.Code,
+		})
+
+		for _, city := range c.Cities {
+			key := c.Code + "/" + city.Slug
+			if !citySlugRegexp.MatchString(city.Slug) {
+				return nil, nil, fmt.Errorf("city %q: invalid slug", key)
+			}
+			if city.Names["en"] == "" {
+				return nil, nil, fmt.Errorf("city %q: missing en name", key)
+			}
+			if _, err := time.LoadLocation(city.Timezone); err != nil {
+				return nil, nil, fmt.Errorf("city %q: %w", key, err)
+			}
+			if _, ok := seen[key]; ok {
+				return nil, nil, fmt.Errorf("city %q: duplicated", key)
+			}
+			seen[key] = struct{}{}
+
+			cities = append(cities, &models.City{
+				Names:       city.Names,
+				CountryCode: c.Code,
+				Slug:        city.Slug,
+				Timezone:    city.Timezone,
+				Centroid: models.Location{
+					Latitude:  city.Latitude,
+					Longitude: city.Longitude,
+				},
+			})
+		}
+	}
+
+	return countries, cities, nil
+}
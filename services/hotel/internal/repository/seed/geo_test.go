@@ -0,0 +1,13 @@
+package seed
+
+import "testing"
+
+func TestGeo(t *testing.T) {
+	countries, cities, err := Geo()
+	if err != nil {
+		t.Fatalf("bundled dataset is invalid: %v", err)
+	}
+	if len(countries) == 0 || len(cities) == 0 {
+		t.Fatalf("expected a non-empty catalogue, got %d countries and %d cities", len(countries), len(cities))
+	}
+}
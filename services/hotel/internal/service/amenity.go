@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+
+	"hotel/internal/repository/models"
+)
+
+func (s *Service) CreateAmenity(ctx context.Context, a *models.CreateAmenity) (*models.Amenity, error) {
+	newAmenity, err := s.repo.InsertAmenity(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAmenity, nil
+}
+
+func (s *Service) GetAmenities(ctx context.Context, category *models.AmenityCategory) ([]*models.Amenity, error) {
+	amenities, err := s.repo.SelectAmenities(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return amenities, nil
+}
+
+func (s *Service) GetAmenityByCode(ctx context.Context, code string) (*models.Amenity, error) {
+	amenity, err := s.repo.SelectAmenityByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return amenity, nil
+}
+
+func (s *Service) UpdateAmenityByCode(
+	ctx context.Context,
+	code string,
+	a *models.UpdateAmenity,
+) (*models.Amenity, error) {
+	updated, err := s.repo.UpdateAmenityByCode(ctx, code, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *Service) DeleteAmenityByCode(ctx context.Context, code string) error {
+	if err := s.repo.DeleteAmenityByCode(ctx, code); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetHotelAmenities(ctx context.Context, hotelRef models.HotelRef) ([]*models.Amenity, error) {
+	amenities, err := s.repo.SelectHotelAmenities(ctx, hotelRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return amenities, nil
+}
+
+func (s *Service) SetHotelAmenities(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+	codes []string,
+) ([]*models.Amenity, error) {
+	if err := s.checkAmenityCodes(ctx, codes); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.ReplaceHotelAmenities(ctx, hotelRef, codes); err != nil {
+		return nil, err
+	}
+
+	return s.GetHotelAmenities(ctx, hotelRef)
+}
+
+// checkAmenityCodes rejects codes missing from the catalogue, naming every one of them.
+func (s *Service) checkAmenityCodes(ctx context.Context, codes []string) error {
+	if len(codes) == 0 {
+		return nil
+	}
+
+	unknown, err := s.repo.SelectUnknownAmenityCodes(ctx, codes)
+	if err != nil {
+		return err
+	}
+	if len(unknown) > 0 {
+		return &models.UnknownAmenityError{Codes: unknown}
+	}
+
+	return nil
+}
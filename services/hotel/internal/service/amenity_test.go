@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"hotel/internal/mocks"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func TestSetHotelAmenities(t *testing.T) {
+	hotelRef := models.HotelRef{CountryCode: "ru", CitySlug: "moscow", HotelSlug: "grand"}
+	wifi := &models.Amenity{Code: "wifi", Category: models.AmenityCategoryInternet}
+
+	tests := []struct {
+		name    string
+		codes   []string
+		unknown []string
+		wantErr error
+	}{
+		{name: "catalogue codes", codes: []string{"wifi"}},
+		{
+			name:    "codes missing from the catalogue",
+			codes:   []string{"wifi", "jacuzzi", "helipad"},
+			unknown: []string{"jacuzzi", "helipad"},
+			wantErr: consts.ErrUnknownAmenity,
+		},
+		{name: "clearing the amenities checks nothing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(t)
+			if len(tt.codes) > 0 {
+				repo.EXPECT().SelectUnknownAmenityCodes(mock.Anything, tt.codes).Return(tt.unknown, nil)
+			}
+			if tt.wantErr == nil {
+				repo.EXPECT().ReplaceHotelAmenities(mock.Anything, hotelRef, tt.codes).Return(nil)
+				repo.EXPECT().SelectHotelAmenities(mock.Anything, hotelRef).Return([]*models.Amenity{wifi}, nil)
+			}
+
+			amenities, err := New(repo, nil, nil).SetHotelAmenities(context.Background(), hotelRef, tt.codes)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SetHotelAmenities() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				var unknownErr *models.UnknownAmenityError
+				if !errors.As(err, &unknownErr) || !slices.Equal(unknownErr.Codes, tt.unknown) {
+					t.Errorf("error = %v, want every unknown code %v", err, tt.unknown)
+				}
+				return
+			}
+			if len(amenities) != 1 || amenities[0] != wifi {
+				t.Errorf("amenities = %v, want the stored ones", amenities)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"hotel/internal/repository/models"
+)
+
+func (s *Service) ListCountries(ctx context.Context) ([]*models.Country, error) {
+	countries, err := s.repo.SelectCountries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return countries, nil
+}
+
+func (s *Service) ListCities(
+	ctx context.Context,
+	filter models.CityFilter,
+	page uint64,
+	limit uint64,
+) (*models.CityList, error) {
+	offset := (page - 1) * limit
+	cityList, err := s.repo.SelectCities(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return cityList, nil
+}
@@ -2,14 +2,32 @@ package service
 
 import (
 	"context"
+	"log/slog"
+	"slices"
 
 	"hotel/internal/repository/models"
+	"hotel/internal/service/utils/helper"
+	"hotel/pkg/lib/utils/consts"
 
+	"github.com/google/uuid"
 	"github.com/gosimple/slug"
 )
 
 func (s *Service) CreateHotel(ctx context.Context, h *models.CreateHotel) (*models.Hotel, error) {
 	h.HotelSlug = slug.Make(h.Title)
+	if h.Timezone != nil {
+		if _, err := helper.LoadTimezone(*h.Timezone); err != nil {
+			return nil, err
+		}
+	}
+	if h.CheckInTime == nil {
+		checkIn := consts.DefaultCheckInTime
+		h.CheckInTime = &checkIn
+	}
+	if h.CheckOutTime == nil {
+		checkOut := consts.DefaultCheckOutTime
+		h.CheckOutTime = &checkOut
+	}
 
 	newHotel, err := s.repo.InsertHotel(ctx, h)
 	if err != nil {
@@ -35,21 +53,79 @@ func (s *Service) GetHotels(
 	return hotelList, nil
 }
 
-func (s *Service) GetHotelBySlug(ctx context.Context, ref models.HotelRef) (*models.Hotel, error) {
+// GetHotelBySlug hides hotels the viewer may not see behind ErrHotelNotFound.
+func (s *Service) GetHotelBySlug(
+	ctx context.Context,
+	ref models.HotelRef,
+	viewer models.HotelViewer,
+) (*models.Hotel, error) {
+	h, err := s.getHotelBySlug(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if !helper.CanViewHotel(h, viewer) {
+		return nil, consts.ErrHotelNotFound
+	}
+
+	return h, nil
+}
+
+func (s *Service) getHotelBySlug(ctx context.Context, ref models.HotelRef) (*models.Hotel, error) {
 	h, err := s.repo.SelectHotelBySlug(ctx, ref)
 	if err != nil {
 		return nil, err
 	}
+	if err = s.attachPolicy(ctx, h); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+func (s *Service) GetHotelByID(ctx context.Context, hotelID uuid.UUID) (*models.Hotel, error) {
+	h, err := s.repo.SelectHotelByID(ctx, hotelID)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.attachPolicy(ctx, h); err != nil {
+		return nil, err
+	}
 
 	return h, nil
 }
 
-func (s *Service) UpdateHotelBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotel) error {
-	if err := s.repo.UpdateHotelBySlug(ctx, ref, h); err != nil {
-		return err
+func (s *Service) GetHotelsByIDs(ctx context.Context, hotelIDs []uuid.UUID) ([]*models.Hotel, error) {
+	hotels, err := s.repo.SelectHotelsByIDs(ctx, hotelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return hotels, nil
+}
+
+// UpdateHotelBySlug returns the hotel version after the update.
+func (s *Service) UpdateHotelBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotel) (int64, error) {
+	if h.Timezone != nil {
+		if _, err := helper.LoadTimezone(*h.Timezone); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.repo.UpdateHotelBySlug(ctx, ref, h)
+}
+
+// PatchHotelBySlug updates only the masked fields and returns the hotel as stored afterwards.
+func (s *Service) PatchHotelBySlug(ctx context.Context, ref models.HotelRef, h models.PatchHotel) (*models.Hotel, error) {
+	if slices.Contains(h.Fields, models.HotelFieldTimezone) {
+		if _, err := helper.LoadTimezone(h.Timezone); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repo.PatchHotelBySlug(ctx, ref, h); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return s.getHotelBySlug(ctx, ref)
 }
 
 func (s *Service) UpdateHotelTitleBySlug(
@@ -58,17 +134,83 @@ func (s *Service) UpdateHotelTitleBySlug(
 	h models.UpdateHotelTitle,
 ) (models.UpdateHotelTitle, error) {
 	h.HotelSlug = slug.Make(h.Title)
-	if err := s.repo.UpdateHotelTitleBySlug(ctx, ref, h); err != nil {
+	version, err := s.repo.UpdateHotelTitleBySlug(ctx, ref, h)
+	if err != nil {
 		return models.UpdateHotelTitle{}, err
 	}
+	h.Version = version
 
 	return h, nil
 }
 
-func (s *Service) DeleteHotelBySlug(ctx context.Context, ref models.HotelRef) error {
-	if err := s.repo.DeleteHotelBySlug(ctx, ref); err != nil {
-		return err
+// UpdateHotelRating stores the rating the review service aggregated from the
+// hotel's reviews; a nil rating clears it.
+func (s *Service) UpdateHotelRating(ctx context.Context, hotelID uuid.UUID, rating *float32) (*float32, error) {
+	return s.repo.UpdateHotelRating(ctx, hotelID, rating)
+}
+
+// DeleteHotelBySlug soft-deletes the hotel and its rooms. A hotel with active or
+// upcoming bookings is only deleted when force is set, in which case those
+// bookings are cancelled and their ids returned.
+func (s *Service) DeleteHotelBySlug(ctx context.Context, ref models.HotelRef, force bool) ([]string, error) {
+	hotel, err := s.repo.SelectHotelBySlug(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deleteWithBookings(
+		ctx,
+		models.BookingTarget{HotelID: &hotel.ID},
+		force,
+		consts.ErrHotelHasActiveBookings,
+		func() error { return s.repo.DeleteHotelBySlug(ctx, ref) },
+		func(ctx context.Context) error { return s.repo.RestoreHotelByID(ctx, hotel.ID) },
+	)
+}
+
+// deleteWithBookings runs del first, so no booking can be made for the target
+// while its bookings are looked at, and only then deals with its active
+// bookings: without force the target is brought back with restore when it
+// still has any, with force they are cancelled. Bookings are cancelled only
+// for a target that is gone, and a failed check or cancellation restores it
+// so the delete can be retried.
+func (s *Service) deleteWithBookings(
+	ctx context.Context,
+	target models.BookingTarget,
+	force bool,
+	errActive error,
+	del func() error,
+	restore func(ctx context.Context) error,
+) ([]string, error) {
+	if err := del(); err != nil {
+		return nil, err
+	}
+
+	if !force {
+		active, err := s.booking.GetActiveBookings(ctx, target)
+		if err == nil && len(active) > 0 {
+			err = errActive
+		}
+		if err != nil {
+			s.restoreDeleted(ctx, restore)
+			return nil, err
+		}
+		return nil, nil
 	}
 
-	return nil
+	cancelled, err := s.booking.CancelActiveBookings(ctx, target)
+	if err != nil {
+		s.restoreDeleted(ctx, restore)
+		return nil, err
+	}
+
+	return cancelled, nil
+}
+
+// restoreDeleted brings back a target deleteWithBookings deleted; it runs even
+// when the request has been cancelled meanwhile.
+func (s *Service) restoreDeleted(ctx context.Context, restore func(ctx context.Context) error) {
+	if err := restore(context.WithoutCancel(ctx)); err != nil {
+		slog.ErrorContext(ctx, "failed to restore deleted target", slog.String("error", err.Error()))
+	}
 }
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/service/utils/helper"
+)
+
+func (s *Service) ChangeHotelStatus(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+	c *models.HotelStatusChange,
+) (*models.HotelStatusTransition, error) {
+	hotel, err := s.repo.SelectHotelBySlug(ctx, hotelRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = helper.CheckHotelTransition(hotel, c); err != nil {
+		return nil, err
+	}
+
+	transition, err := s.repo.UpdateHotelStatus(ctx, c.ToTransition(hotel.ID, hotel.Status))
+	if err != nil {
+		return nil, err
+	}
+
+	return transition, nil
+}
+
+func (s *Service) GetHotelStatusHistory(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+) ([]*models.HotelStatusTransition, error) {
+	hotel, err := s.repo.SelectHotelBySlug(ctx, hotelRef)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.repo.SelectHotelStatusHistory(ctx, hotel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/service/utils/helper"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func (s *Service) SetHotelPolicy(
+	ctx context.Context,
+	hotelRef models.HotelRef,
+	p *models.SetHotelPolicy,
+) (*models.HotelPolicy, error) {
+	if err := helper.NormalizePolicy(p); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.repo.UpsertHotelPolicy(ctx, hotelRef, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (s *Service) GetHotelPolicy(ctx context.Context, hotelRef models.HotelRef) (*models.HotelPolicy, error) {
+	policy, err := s.repo.SelectHotelPolicyBySlug(ctx, hotelRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (s *Service) DeleteHotelPolicy(ctx context.Context, hotelRef models.HotelRef) error {
+	if err := s.repo.DeleteHotelPolicy(ctx, hotelRef); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// attachPolicy loads the hotel's policy; hotels without one keep a nil policy.
+func (s *Service) attachPolicy(ctx context.Context, h *models.Hotel) error {
+	policy, err := s.repo.SelectHotelPolicyByHotelID(ctx, h.ID)
+	if err != nil {
+		if errors.Is(err, consts.ErrHotelPolicyNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	h.Policy = policy
+	return nil
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"hotel/internal/mocks"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+var errUnavailable = errors.New("booking service unavailable")
+
+// expectDelete expects a deletion of a hotel or room and the booking calls
+// around it, recording them in the order they happen.
+func expectDelete(
+	t *testing.T, deleteErr error, active []string, activeErr, cancelErr error,
+) (*mocks.MockRepository, *mocks.MockBookingClient, *[]string) {
+	repo := mocks.NewMockRepository(t)
+	booking := mocks.NewMockBookingClient(t)
+	calls := new([]string)
+	record := func(call string) { *calls = append(*calls, call) }
+
+	repo.EXPECT().SelectHotelBySlug(mock.Anything, mock.Anything).Return(&models.Hotel{ID: uuid.New()}, nil).Maybe()
+	repo.EXPECT().SelectRoomByID(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, roomID uuid.UUID) (*models.Room, error) {
+			return &models.Room{ID: roomID}, nil
+		}).Maybe()
+	repo.EXPECT().DeleteHotelBySlug(mock.Anything, mock.Anything).
+		Run(func(context.Context, models.HotelRef) { record("delete") }).Return(deleteErr).Maybe()
+	repo.EXPECT().DeleteRoomByID(mock.Anything, mock.Anything).
+		Run(func(context.Context, uuid.UUID) { record("delete") }).Return(deleteErr).Maybe()
+	repo.EXPECT().RestoreHotelByID(mock.Anything, mock.Anything).
+		Run(func(context.Context, uuid.UUID) { record("restore") }).Return(nil).Maybe()
+	repo.EXPECT().RestoreRoomByID(mock.Anything, mock.Anything).
+		Run(func(context.Context, uuid.UUID) { record("restore") }).Return(nil).Maybe()
+
+	booking.EXPECT().GetActiveBookings(mock.Anything, mock.Anything).
+		Run(func(context.Context, models.BookingTarget) { record("check") }).Return(active, activeErr).Maybe()
+	cancelled := active
+	if cancelErr != nil {
+		cancelled = nil
+	}
+	booking.EXPECT().CancelActiveBookings(mock.Anything, mock.Anything).
+		Run(func(context.Context, models.BookingTarget) { record("cancel") }).Return(cancelled, cancelErr).Maybe()
+
+	return repo, booking, calls
+}
+
+func TestDeleteHotelBySlug(t *testing.T) {
+	active := []string{"0b9e3b1c-54a2-4b8f-9c55-1f1c7b0e1a01"}
+
+	tests := []struct {
+		name          string
+		force         bool
+		active        []string
+		deleteErr     error
+		activeErr     error
+		cancelErr     error
+		wantErr       error
+		wantCalls     []string
+		wantCancelled []string
+	}{
+		{
+			name:      "no bookings",
+			wantCalls: []string{"delete", "check"},
+		},
+		{
+			name:      "active bookings without force restore the hotel",
+			active:    active,
+			wantErr:   consts.ErrHotelHasActiveBookings,
+			wantCalls: []string{"delete", "check", "restore"},
+		},
+		{
+			name:      "failed booking check restores the hotel",
+			activeErr: errUnavailable,
+			wantErr:   errUnavailable,
+			wantCalls: []string{"delete", "check", "restore"},
+		},
+		{
+			name:      "failed delete checks nothing",
+			active:    active,
+			deleteErr: consts.ErrHotelNotFound,
+			wantErr:   consts.ErrHotelNotFound,
+			wantCalls: []string{"delete"},
+		},
+		{
+			name:          "forced delete cancels after deleting",
+			force:         true,
+			active:        active,
+			wantCalls:     []string{"delete", "cancel"},
+			wantCancelled: active,
+		},
+		{
+			name:      "failed delete cancels nothing",
+			force:     true,
+			active:    active,
+			deleteErr: consts.ErrHotelNotFound,
+			wantErr:   consts.ErrHotelNotFound,
+			wantCalls: []string{"delete"},
+		},
+		{
+			name:      "failed cancellation restores the hotel",
+			force:     true,
+			active:    active,
+			cancelErr: errUnavailable,
+			wantErr:   errUnavailable,
+			wantCalls: []string{"delete", "cancel", "restore"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, booking, calls := expectDelete(t, tt.deleteErr, tt.active, tt.activeErr, tt.cancelErr)
+
+			cancelled, err := New(repo, booking, nil).DeleteHotelBySlug(context.Background(), models.HotelRef{}, tt.force)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeleteHotelBySlug() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(*calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", *calls, tt.wantCalls)
+			}
+			if !slices.Equal(cancelled, tt.wantCancelled) {
+				t.Errorf("cancelled = %v, want %v", cancelled, tt.wantCancelled)
+			}
+		})
+	}
+}
+
+func TestDeleteRoomByID(t *testing.T) {
+	active := []string{"0b9e3b1c-54a2-4b8f-9c55-1f1c7b0e1a01"}
+
+	tests := []struct {
+		name      string
+		active    []string
+		wantErr   error
+		wantCalls []string
+	}{
+		{
+			name:      "no bookings",
+			wantCalls: []string{"delete", "check"},
+		},
+		{
+			name:      "active bookings restore the room",
+			active:    active,
+			wantErr:   consts.ErrRoomHasActiveBookings,
+			wantCalls: []string{"delete", "check", "restore"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, booking, calls := expectDelete(t, nil, tt.active, nil, nil)
+
+			cancelled, err := New(repo, booking, nil).DeleteRoomByID(context.Background(), uuid.New(), false)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeleteRoomByID() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(*calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", *calls, tt.wantCalls)
+			}
+			if len(cancelled) != 0 {
+				t.Errorf("cancelled = %v, want none", cancelled)
+			}
+		})
+	}
+}
+
+func TestGetHotelByID(t *testing.T) {
+	deletedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		hotel   *models.Hotel
+		policy  *models.HotelPolicy
+		wantErr error
+	}{
+		{name: "live hotel", hotel: &models.Hotel{}, policy: &models.HotelPolicy{}},
+		{
+			// Historical bookings still resolve the hotel they were made at.
+			name:  "soft-deleted hotel without a policy",
+			hotel: &models.Hotel{DeletedAt: &deletedAt},
+		},
+		{name: "unknown hotel", wantErr: consts.ErrHotelNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(t)
+			hotelID := uuid.New()
+			repo.EXPECT().SelectHotelByID(mock.Anything, hotelID).Return(tt.hotel, tt.wantErr)
+			if tt.hotel != nil {
+				tt.hotel.ID = hotelID
+				if tt.policy != nil {
+					repo.EXPECT().SelectHotelPolicyByHotelID(mock.Anything, hotelID).Return(tt.policy, nil)
+				} else {
+					repo.EXPECT().SelectHotelPolicyByHotelID(mock.Anything, hotelID).
+						Return(nil, consts.ErrHotelPolicyNotFound)
+				}
+			}
+
+			hotel, err := New(repo, nil, nil).GetHotelByID(context.Background(), hotelID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetHotelByID() error = %v, want %v", err, tt.wantErr)
+			}
+			if hotel != tt.hotel {
+				t.Errorf("hotel = %+v, want %+v", hotel, tt.hotel)
+			}
+			if tt.hotel != nil && hotel.Policy != tt.policy {
+				t.Errorf("policy = %+v, want %+v", hotel.Policy, tt.policy)
+			}
+		})
+	}
+}
+
+func TestGetHotelsByIDs(t *testing.T) {
+	deletedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	live := &models.Hotel{ID: uuid.New()}
+	deleted := &models.Hotel{ID: uuid.New(), DeletedAt: &deletedAt}
+	hotelIDs := []uuid.UUID{deleted.ID, uuid.New(), live.ID}
+
+	repo := mocks.NewMockRepository(t)
+	repo.EXPECT().SelectHotelsByIDs(mock.Anything, hotelIDs).Return([]*models.Hotel{deleted, live}, nil)
+
+	hotels, err := New(repo, nil, nil).GetHotelsByIDs(context.Background(), hotelIDs)
+	if err != nil {
+		t.Fatalf("GetHotelsByIDs() error = %v", err)
+	}
+	if !slices.Equal(hotels, []*models.Hotel{deleted, live}) {
+		t.Errorf("hotels = %v, want the deleted and the live one", hotels)
+	}
+}
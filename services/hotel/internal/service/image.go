@@ -0,0 +1,159 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/service/utils/helper"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func (s *Service) UploadImage(ctx context.Context, target models.ImageTarget, r io.Reader) (*models.Image, error) {
+	owner, err := s.repo.SelectImageOwner(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, consts.MaxImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+
+	processed, err := helper.ProcessImage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	img := &models.CreateImage{
+		Owner:       owner,
+		ContentType: processed.Original.ContentType,
+		SizeBytes:   int64(len(data)),
+		Width:       processed.Width,
+		Height:      processed.Height,
+		ID:          uuid.New(),
+	}
+
+	blobs := []struct {
+		key   *string
+		name  string
+		image helper.EncodedImage
+	}{
+		{&img.Keys.Original, "original", processed.Original},
+		{&img.Keys.Thumbnail, "thumbnail", processed.Thumbnail},
+		{&img.Keys.ThumbnailWebP, "thumbnail", processed.ThumbnailWebP},
+		{&img.Keys.WebP, "image", processed.WebP},
+	}
+
+	prefix := imageKeyPrefix(owner, img.ID)
+	var stored []string
+	for _, b := range blobs {
+		key := prefix + b.name + b.image.Ext
+		if err = s.blobs.Put(ctx, key, bytes.NewReader(b.image.Data)); err != nil {
+			s.deleteBlobs(ctx, stored)
+			return nil, err
+		}
+		*b.key = key
+		stored = append(stored, key)
+	}
+
+	newImage, err := s.repo.InsertImage(ctx, img)
+	if err != nil {
+		s.deleteBlobs(ctx, stored)
+		return nil, err
+	}
+
+	return s.withImageURLs(newImage), nil
+}
+
+func (s *Service) GetImages(ctx context.Context, target models.ImageTarget) ([]*models.Image, error) {
+	owner, err := s.repo.SelectImageOwner(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := s.repo.SelectImages(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, img := range images {
+		s.withImageURLs(img)
+	}
+
+	return images, nil
+}
+
+func (s *Service) ReorderImages(
+	ctx context.Context,
+	target models.ImageTarget,
+	imageIDs []uuid.UUID,
+) ([]*models.Image, error) {
+	owner, err := s.repo.SelectImageOwner(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.repo.UpdateImagePositions(ctx, owner, imageIDs); err != nil {
+		return nil, err
+	}
+
+	images, err := s.repo.SelectImages(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, img := range images {
+		s.withImageURLs(img)
+	}
+
+	return images, nil
+}
+
+func (s *Service) DeleteImageByID(ctx context.Context, imageID uuid.UUID) error {
+	img, err := s.repo.SelectImageByID(ctx, imageID)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repo.DeleteImageByID(ctx, imageID); err != nil {
+		return err
+	}
+
+	s.deleteBlobs(ctx, []string{img.Keys.Original, img.Keys.Thumbnail, img.Keys.ThumbnailWebP, img.Keys.WebP})
+
+	return nil
+}
+
+func (s *Service) withImageURLs(img *models.Image) *models.Image {
+	img.URLs = models.ImageVariants{
+		Original:      s.blobs.URL(img.Keys.Original),
+		Thumbnail:     s.blobs.URL(img.Keys.Thumbnail),
+		ThumbnailWebP: s.blobs.URL(img.Keys.ThumbnailWebP),
+		WebP:          s.blobs.URL(img.Keys.WebP),
+	}
+
+	return img
+}
+
+// deleteBlobs removes blobs on a best effort basis, a leftover blob is only wasted space.
+func (s *Service) deleteBlobs(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			slog.ErrorContext(ctx, "failed to delete blob", slog.String("key", key), slog.String("error", err.Error()))
+		}
+	}
+}
+
+func imageKeyPrefix(owner models.ImageOwner, imageID uuid.UUID) string {
+	prefix := "hotels/" + owner.HotelID.String() + "/"
+	if owner.RoomID != nil {
+		prefix += "rooms/" + owner.RoomID.String() + "/"
+	}
+
+	return prefix + imageID.String() + "/"
+}
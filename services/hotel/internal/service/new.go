@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"io"
 
 	"github.com/google/uuid"
 
@@ -14,29 +15,145 @@ type HotelRepository interface {
 		ctx context.Context, hotelRef models.HotelRef, sortField string, limit, offset uint64,
 	) (*models.HotelList, error)
 	SelectHotelBySlug(ctx context.Context, ref models.HotelRef) (*models.Hotel, error)
-	UpdateHotelBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotel) error
-	UpdateHotelTitleBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotelTitle) error
+	SelectHotelByID(ctx context.Context, hotelID uuid.UUID) (*models.Hotel, error)
+	SelectHotelsByIDs(ctx context.Context, hotelIDs []uuid.UUID) ([]*models.Hotel, error)
+	UpdateHotelBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotel) (int64, error)
+	PatchHotelBySlug(ctx context.Context, ref models.HotelRef, h models.PatchHotel) error
+	UpdateHotelTitleBySlug(ctx context.Context, ref models.HotelRef, h models.UpdateHotelTitle) (int64, error)
+	UpdateHotelRating(ctx context.Context, hotelID uuid.UUID, rating *float32) (*float32, error)
 	DeleteHotelBySlug(ctx context.Context, ref models.HotelRef) error
+	RestoreHotelByID(ctx context.Context, hotelID uuid.UUID) error
 }
 
 type RoomRepository interface {
 	InsertRoom(ctx context.Context, hotelRef models.HotelRef, room *models.CreateRoom) (*models.Room, error)
 	SelectRooms(ctx context.Context, hotelRef models.HotelRef, limit, offset uint64) (*models.RoomList, error)
 	SelectRoomByID(ctx context.Context, roomID uuid.UUID) (*models.Room, error)
-	UpdateRoomByID(ctx context.Context, roomID uuid.UUID, room *models.UpdateRoom) error
-	UpdateRoomStatusByID(ctx context.Context, roomID uuid.UUID, room models.UpdateRoomStatus) error
+	SelectRoomTimezone(ctx context.Context, roomID uuid.UUID) (string, error)
+	SelectRoomsByIDs(ctx context.Context, roomIDs []uuid.UUID) ([]*models.Room, error)
+	UpdateRoomByID(ctx context.Context, roomID uuid.UUID, room *models.UpdateRoom) (int64, error)
+	PatchRoomByID(ctx context.Context, roomID uuid.UUID, room *models.PatchRoom) error
+	UpdateRoomStatusByID(ctx context.Context, roomID uuid.UUID, room models.UpdateRoomStatus) (int64, error)
 	DeleteRoomByID(ctx context.Context, roomID uuid.UUID) error
+	RestoreRoomByID(ctx context.Context, roomID uuid.UUID) error
+}
+
+type RoomCategoryRepository interface {
+	InsertRoomCategory(
+		ctx context.Context, hotelRef models.HotelRef, c *models.CreateRoomCategory,
+	) (*models.RoomCategory, error)
+	SelectRoomCategories(ctx context.Context, hotelRef models.HotelRef) ([]*models.RoomCategory, error)
+	SelectRoomCategoryByID(ctx context.Context, categoryID uuid.UUID) (*models.RoomCategory, error)
+	UpdateRoomCategoryByID(ctx context.Context, categoryID uuid.UUID, c *models.UpdateRoomCategory) error
+	DeleteRoomCategoryByID(ctx context.Context, categoryID uuid.UUID) error
+	AssignRoomCategory(ctx context.Context, roomID uuid.UUID, a models.AssignRoomCategory) (int64, error)
+}
+
+type RatePlanRepository interface {
+	InsertRatePlan(ctx context.Context, hotelRef models.HotelRef, rp *models.CreateRatePlan) (*models.RatePlan, error)
+	SelectRatePlans(
+		ctx context.Context, hotelRef models.HotelRef, limit, offset uint64,
+	) (*models.RatePlanList, error)
+	SelectRatePlanByID(ctx context.Context, ratePlanID uuid.UUID) (*models.RatePlan, error)
+	SelectRoomRatePlans(ctx context.Context, roomID uuid.UUID, stayRange models.DateRange) ([]*models.RatePlan, error)
+	UpdateRatePlanByID(ctx context.Context, ratePlanID uuid.UUID, rp *models.UpdateRatePlan) (*models.RatePlan, error)
+	DeleteRatePlanByID(ctx context.Context, ratePlanID uuid.UUID) error
+}
+
+type StayRestrictionRepository interface {
+	InsertStayRestriction(
+		ctx context.Context, hotelRef models.HotelRef, sr *models.CreateStayRestriction,
+	) (*models.StayRestriction, error)
+	SelectStayRestrictions(
+		ctx context.Context, hotelRef models.HotelRef, limit, offset uint64,
+	) (*models.StayRestrictionList, error)
+	SelectStayRestrictionByID(ctx context.Context, restrictionID uuid.UUID) (*models.StayRestriction, error)
+	SelectRoomStayRestrictions(
+		ctx context.Context, roomID uuid.UUID, stay models.DateRange,
+	) ([]*models.StayRestriction, error)
+	UpdateStayRestrictionByID(
+		ctx context.Context, restrictionID uuid.UUID, sr *models.UpdateStayRestriction,
+	) (*models.StayRestriction, error)
+	DeleteStayRestrictionByID(ctx context.Context, restrictionID uuid.UUID) error
+}
+
+type RoomBlockRepository interface {
+	InsertRoomBlock(ctx context.Context, blockID uuid.UUID, rb *models.CreateRoomBlock) (*models.RoomBlock, error)
+	SelectRoomBlocks(ctx context.Context, roomID uuid.UUID, limit, offset uint64) (*models.RoomBlockList, error)
+	SelectRoomBlockByID(ctx context.Context, blockID uuid.UUID) (*models.RoomBlock, error)
+	DeleteRoomBlockByID(ctx context.Context, blockID uuid.UUID) error
+}
+
+type ImageRepository interface {
+	SelectImageOwner(ctx context.Context, target models.ImageTarget) (models.ImageOwner, error)
+	InsertImage(ctx context.Context, img *models.CreateImage) (*models.Image, error)
+	SelectImages(ctx context.Context, owner models.ImageOwner) ([]*models.Image, error)
+	SelectImageByID(ctx context.Context, imageID uuid.UUID) (*models.Image, error)
+	UpdateImagePositions(ctx context.Context, owner models.ImageOwner, imageIDs []uuid.UUID) error
+	DeleteImageByID(ctx context.Context, imageID uuid.UUID) error
+}
+
+type AmenityRepository interface {
+	InsertAmenity(ctx context.Context, a *models.CreateAmenity) (*models.Amenity, error)
+	SelectAmenities(ctx context.Context, category *models.AmenityCategory) ([]*models.Amenity, error)
+	SelectAmenityByCode(ctx context.Context, code string) (*models.Amenity, error)
+	SelectUnknownAmenityCodes(ctx context.Context, codes []string) ([]string, error)
+	UpdateAmenityByCode(ctx context.Context, code string, a *models.UpdateAmenity) (*models.Amenity, error)
+	DeleteAmenityByCode(ctx context.Context, code string) error
+	SelectHotelAmenities(ctx context.Context, hotelRef models.HotelRef) ([]*models.Amenity, error)
+	ReplaceHotelAmenities(ctx context.Context, hotelRef models.HotelRef, codes []string) error
+}
+
+type GeoRepository interface {
+	SelectCountries(ctx context.Context) ([]*models.Country, error)
+	SelectCities(ctx context.Context, filter models.CityFilter, limit, offset uint64) (*models.CityList, error)
+}
+
+type HotelPolicyRepository interface {
+	UpsertHotelPolicy(ctx context.Context, hotelRef models.HotelRef, p *models.SetHotelPolicy) (*models.HotelPolicy, error)
+	SelectHotelPolicyBySlug(ctx context.Context, hotelRef models.HotelRef) (*models.HotelPolicy, error)
+	SelectHotelPolicyByHotelID(ctx context.Context, hotelID uuid.UUID) (*models.HotelPolicy, error)
+	DeleteHotelPolicy(ctx context.Context, hotelRef models.HotelRef) error
+}
+
+type HotelModerationRepository interface {
+	UpdateHotelStatus(ctx context.Context, t *models.HotelStatusTransition) (*models.HotelStatusTransition, error)
+	SelectHotelStatusHistory(ctx context.Context, hotelID uuid.UUID) ([]*models.HotelStatusTransition, error)
 }
 
 type Repository interface {
 	HotelRepository
 	RoomRepository
+	RoomCategoryRepository
+	RatePlanRepository
+	StayRestrictionRepository
+	RoomBlockRepository
+	ImageRepository
+	AmenityRepository
+	GeoRepository
+	HotelPolicyRepository
+	HotelModerationRepository
+}
+
+type BookingClient interface {
+	BlockRoom(ctx context.Context, roomID, blockID uuid.UUID, stay models.DateRange) error
+	UnblockRoom(ctx context.Context, blockID uuid.UUID) error
+	GetActiveBookings(ctx context.Context, target models.BookingTarget) ([]string, error)
+	CancelActiveBookings(ctx context.Context, target models.BookingTarget) ([]string, error)
+}
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
 }
 
 type Service struct {
-	repo Repository
+	repo    Repository
+	booking BookingClient
+	blobs   BlobStore
 }
 
-func New(repo Repository) *Service {
-	return &Service{repo}
+func New(repo Repository, booking BookingClient, blobs BlobStore) *Service {
+	return &Service{repo: repo, booking: booking, blobs: blobs}
 }
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/service/utils/helper"
+)
+
+func (s *Service) CreateRatePlan(
+	ctx context.Context,
+	hotel models.HotelRef,
+	rp *models.CreateRatePlan,
+) (*models.RatePlan, error) {
+	rp.StayRange = truncateDateRange(rp.StayRange)
+	newRatePlan, err := s.repo.InsertRatePlan(ctx, hotel, rp)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRatePlan, nil
+}
+
+func (s *Service) GetRatePlans(
+	ctx context.Context,
+	hotel models.HotelRef,
+	page uint64,
+	limit uint64,
+) (*models.RatePlanList, error) {
+	offset := (page - 1) * limit
+	ratePlanList, err := s.repo.SelectRatePlans(ctx, hotel, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return ratePlanList, nil
+}
+
+func (s *Service) GetRatePlanByID(ctx context.Context, ratePlanID uuid.UUID) (*models.RatePlan, error) {
+	rp, err := s.repo.SelectRatePlanByID(ctx, ratePlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rp, nil
+}
+
+func (s *Service) UpdateRatePlanByID(
+	ctx context.Context,
+	ratePlanID uuid.UUID,
+	rp *models.UpdateRatePlan,
+) (*models.RatePlan, error) {
+	rp.StayRange = truncateDateRange(rp.StayRange)
+	updated, err := s.repo.UpdateRatePlanByID(ctx, ratePlanID, rp)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *Service) DeleteRatePlanByID(ctx context.Context, ratePlanID uuid.UUID) error {
+	if err := s.repo.DeleteRatePlanByID(ctx, ratePlanID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) QuoteStay(ctx context.Context, roomID uuid.UUID, stay models.DateRange) (*models.StayQuote, error) {
+	loc, err := s.roomLocation(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	stay = helper.LocalDateRange(stay, loc)
+	if _, err = helper.StayNights(stay); err != nil {
+		return nil, err
+	}
+
+	room, err := s.repo.SelectRoomByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	ratePlans, err := s.repo.SelectRoomRatePlans(ctx, roomID, stay)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.QuoteStay(roomID, stay, room.Price, ratePlans)
+}
+
+func truncateDateRange(r models.DateRange) models.DateRange {
+	return models.DateRange{
+		Start: helper.TruncateDate(r.Start),
+		End:   helper.TruncateDate(r.End),
+	}
+}
@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"hotel/internal/repository/models"
+	"hotel/internal/service/utils/helper"
+	"hotel/pkg/lib/utils/consts"
 
 	"github.com/google/uuid"
 )
@@ -11,6 +15,10 @@ import (
 func (s *Service) CreateRoom(ctx context.Context, hotel models.HotelRef, room *models.CreateRoom) (
 	*models.Room, error,
 ) {
+	if err := s.checkAmenityCodes(ctx, room.Amenities); err != nil {
+		return nil, err
+	}
+
 	newRoom, err := s.repo.InsertRoom(ctx, hotel, room)
 	if err != nil {
 		return nil, err
@@ -38,26 +46,69 @@ func (s *Service) GetRoomByID(ctx context.Context, roomID uuid.UUID) (*models.Ro
 	return room, nil
 }
 
-func (s *Service) UpdateRoomByID(ctx context.Context, roomID uuid.UUID, room *models.UpdateRoom) error {
-	if err := s.repo.UpdateRoomByID(ctx, roomID, room); err != nil {
-		return err
+func (s *Service) GetRoomsByIDs(ctx context.Context, roomIDs []uuid.UUID) ([]*models.Room, error) {
+	rooms, err := s.repo.SelectRoomsByIDs(ctx, roomIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return rooms, nil
+}
+
+// UpdateRoomByID returns the room version after the update.
+func (s *Service) UpdateRoomByID(ctx context.Context, roomID uuid.UUID, room *models.UpdateRoom) (int64, error) {
+	if err := s.checkAmenityCodes(ctx, room.Amenities); err != nil {
+		return 0, err
+	}
+
+	return s.repo.UpdateRoomByID(ctx, roomID, room)
+}
+
+// PatchRoomByID updates only the masked fields and returns the room as stored afterwards.
+func (s *Service) PatchRoomByID(ctx context.Context, roomID uuid.UUID, room *models.PatchRoom) (*models.Room, error) {
+	if slices.Contains(room.Fields, models.RoomFieldAmenities) {
+		if err := s.checkAmenityCodes(ctx, room.Amenities); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repo.PatchRoomByID(ctx, roomID, room); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return s.GetRoomByID(ctx, roomID)
 }
 
-func (s *Service) UpdateRoomStatusByID(ctx context.Context, roomID uuid.UUID, room models.UpdateRoomStatus) error {
-	if err := s.repo.UpdateRoomStatusByID(ctx, roomID, room); err != nil {
-		return err
+// UpdateRoomStatusByID returns the room version after the update.
+func (s *Service) UpdateRoomStatusByID(
+	ctx context.Context,
+	roomID uuid.UUID,
+	room models.UpdateRoomStatus,
+) (int64, error) {
+	return s.repo.UpdateRoomStatusByID(ctx, roomID, room)
+}
+
+// DeleteRoomByID soft-deletes the room, guarding its bookings like DeleteHotelBySlug.
+func (s *Service) DeleteRoomByID(ctx context.Context, roomID uuid.UUID, force bool) ([]string, error) {
+	if _, err := s.repo.SelectRoomByID(ctx, roomID); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return s.deleteWithBookings(
+		ctx,
+		models.BookingTarget{RoomID: &roomID},
+		force,
+		consts.ErrRoomHasActiveBookings,
+		func() error { return s.repo.DeleteRoomByID(ctx, roomID) },
+		func(ctx context.Context) error { return s.repo.RestoreRoomByID(ctx, roomID) },
+	)
 }
 
-func (s *Service) DeleteRoomByID(ctx context.Context, roomID uuid.UUID) error {
-	if err := s.repo.DeleteRoomByID(ctx, roomID); err != nil {
-		return err
+// roomLocation returns the timezone of the hotel the room belongs to.
+func (s *Service) roomLocation(ctx context.Context, roomID uuid.UUID) (*time.Location, error) {
+	timezone, err := s.repo.SelectRoomTimezone(ctx, roomID)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return helper.LoadTimezone(timezone)
 }
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/service/utils/helper"
+	"hotel/pkg/lib/utils/consts"
+)
+
+// CreateRoomBlock reserves the dates in the booking service first, so a block
+// can never overlap an existing booking, and releases them if saving fails.
+func (s *Service) CreateRoomBlock(ctx context.Context, rb *models.CreateRoomBlock) (*models.RoomBlock, error) {
+	loc, err := s.roomLocation(ctx, rb.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	rb.StayRange = helper.LocalDateRange(rb.StayRange, loc)
+
+	blockID := uuid.New()
+	if err = s.booking.BlockRoom(ctx, rb.RoomID, blockID, rb.StayRange); err != nil {
+		return nil, err
+	}
+
+	newBlock, err := s.repo.InsertRoomBlock(ctx, blockID, rb)
+	if err != nil {
+		if unblockErr := s.booking.UnblockRoom(ctx, blockID); unblockErr != nil {
+			slog.ErrorContext(ctx, "failed to release room block", slog.String("error", unblockErr.Error()))
+		}
+		return nil, err
+	}
+
+	return newBlock, nil
+}
+
+func (s *Service) GetRoomBlocks(
+	ctx context.Context,
+	roomID uuid.UUID,
+	page uint64,
+	limit uint64,
+) (*models.RoomBlockList, error) {
+	offset := (page - 1) * limit
+	blockList, err := s.repo.SelectRoomBlocks(ctx, roomID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockList, nil
+}
+
+func (s *Service) DeleteRoomBlockByID(ctx context.Context, blockID uuid.UUID) error {
+	if _, err := s.repo.SelectRoomBlockByID(ctx, blockID); err != nil {
+		return err
+	}
+
+	if err := s.booking.UnblockRoom(ctx, blockID); err != nil && !errors.Is(err, consts.ErrRoomBlockNotFound) {
+		return err
+	}
+
+	if err := s.repo.DeleteRoomBlockByID(ctx, blockID); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"hotel/internal/mocks"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func TestCreateRoomBlock(t *testing.T) {
+	errInsert := errors.New("insert failed")
+
+	tests := []struct {
+		name        string
+		blockErr    error
+		insertErr   error
+		wantErr     error
+		wantInsert  bool
+		wantUnblock bool
+	}{
+		{name: "free dates", wantInsert: true},
+		{
+			// The booking service holds a booking or another block on the dates.
+			name:     "dates already locked",
+			blockErr: consts.ErrRoomUnavailable,
+			wantErr:  consts.ErrRoomUnavailable,
+		},
+		{
+			name:        "failed insert releases the dates",
+			insertErr:   errInsert,
+			wantErr:     errInsert,
+			wantInsert:  true,
+			wantUnblock: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(t)
+			booking := mocks.NewMockBookingClient(t)
+			roomID := uuid.New()
+
+			// The block is asked for late on the 10th in UTC, which is already
+			// the 11th at the hotel.
+			rb := &models.CreateRoomBlock{
+				RoomID: roomID,
+				Reason: models.RoomBlockReasonMaintenance,
+				StayRange: models.DateRange{
+					Start: time.Date(2026, 3, 10, 22, 30, 0, 0, time.UTC),
+					End:   time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC),
+				},
+			}
+			stay := models.DateRange{
+				Start: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC),
+			}
+
+			var blockID uuid.UUID
+			repo.EXPECT().SelectRoomTimezone(mock.Anything, roomID).Return("Asia/Tokyo", nil)
+			booking.EXPECT().BlockRoom(mock.Anything, roomID, mock.Anything, stay).
+				Run(func(_ context.Context, _ uuid.UUID, id uuid.UUID, _ models.DateRange) { blockID = id }).
+				Return(tt.blockErr)
+			if tt.wantInsert {
+				repo.EXPECT().InsertRoomBlock(mock.Anything, mock.Anything, rb).
+					RunAndReturn(func(_ context.Context, id uuid.UUID, rb *models.CreateRoomBlock) (*models.RoomBlock, error) {
+						if id != blockID {
+							t.Errorf("inserted block %s, want the one locked in booking %s", id, blockID)
+						}
+						if tt.insertErr != nil {
+							return nil, tt.insertErr
+						}
+						block := rb.ToRead()
+						block.ID = id
+						return block, nil
+					})
+			}
+			if tt.wantUnblock {
+				booking.EXPECT().UnblockRoom(mock.Anything, mock.Anything).
+					Run(func(_ context.Context, id uuid.UUID) {
+						if id != blockID {
+							t.Errorf("released block %s, want %s", id, blockID)
+						}
+					}).
+					Return(nil).
+					Once()
+			}
+
+			block, err := New(repo, booking, nil).CreateRoomBlock(context.Background(), rb)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateRoomBlock() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (block.ID != blockID || block.StayRange != stay) {
+				t.Errorf("block = %s for %+v, want %s for %+v", block.ID, block.StayRange, blockID, stay)
+			}
+		})
+	}
+}
+
+func TestDeleteRoomBlockByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		selectErr  error
+		unblockErr error
+		wantErr    error
+		wantDelete bool
+	}{
+		{name: "released", wantDelete: true},
+		{
+			name:       "already released in booking",
+			unblockErr: consts.ErrRoomBlockNotFound,
+			wantDelete: true,
+		},
+		{
+			name:       "booking service unavailable keeps the block",
+			unblockErr: errUnavailable,
+			wantErr:    errUnavailable,
+		},
+		{
+			name:      "unknown block",
+			selectErr: consts.ErrRoomBlockNotFound,
+			wantErr:   consts.ErrRoomBlockNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(t)
+			booking := mocks.NewMockBookingClient(t)
+			blockID := uuid.New()
+
+			if tt.selectErr != nil {
+				repo.EXPECT().SelectRoomBlockByID(mock.Anything, blockID).Return(nil, tt.selectErr)
+			} else {
+				repo.EXPECT().SelectRoomBlockByID(mock.Anything, blockID).Return(&models.RoomBlock{ID: blockID}, nil)
+				booking.EXPECT().UnblockRoom(mock.Anything, blockID).Return(tt.unblockErr)
+			}
+			if tt.wantDelete {
+				repo.EXPECT().DeleteRoomBlockByID(mock.Anything, blockID).Return(nil)
+			}
+
+			err := New(repo, booking, nil).DeleteRoomBlockByID(context.Background(), blockID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeleteRoomBlockByID() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"hotel/internal/repository/models"
+)
+
+func (s *Service) CreateRoomCategory(
+	ctx context.Context,
+	hotel models.HotelRef,
+	c *models.CreateRoomCategory,
+) (*models.RoomCategory, error) {
+	if err := s.checkAmenityCodes(ctx, c.Amenities); err != nil {
+		return nil, err
+	}
+
+	newCategory, err := s.repo.InsertRoomCategory(ctx, hotel, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCategory, nil
+}
+
+func (s *Service) GetRoomCategories(ctx context.Context, hotel models.HotelRef) ([]*models.RoomCategory, error) {
+	categories, err := s.repo.SelectRoomCategories(ctx, hotel)
+	if err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (s *Service) GetRoomCategoryByID(ctx context.Context, categoryID uuid.UUID) (*models.RoomCategory, error) {
+	category, err := s.repo.SelectRoomCategoryByID(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// UpdateRoomCategoryByID returns the category as stored after the update; the
+// rooms of the category take over its new price.
+func (s *Service) UpdateRoomCategoryByID(
+	ctx context.Context,
+	categoryID uuid.UUID,
+	c *models.UpdateRoomCategory,
+) (*models.RoomCategory, error) {
+	if err := s.checkAmenityCodes(ctx, c.Amenities); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateRoomCategoryByID(ctx, categoryID, c); err != nil {
+		return nil, err
+	}
+
+	return s.GetRoomCategoryByID(ctx, categoryID)
+}
+
+func (s *Service) DeleteRoomCategoryByID(ctx context.Context, categoryID uuid.UUID) error {
+	if err := s.repo.DeleteRoomCategoryByID(ctx, categoryID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AssignRoomCategory returns the room version after the move.
+func (s *Service) AssignRoomCategory(
+	ctx context.Context,
+	roomID uuid.UUID,
+	a models.AssignRoomCategory,
+) (int64, error) {
+	return s.repo.AssignRoomCategory(ctx, roomID, a)
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"hotel/internal/mocks"
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func TestGetRoomByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		room    *models.Room
+		wantErr error
+	}{
+		{name: "live room", room: &models.Room{}},
+		{
+			// The repository reports a soft-deleted room as missing.
+			name:    "unknown or soft-deleted room",
+			wantErr: consts.ErrRoomNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(t)
+			roomID := uuid.New()
+			if tt.room != nil {
+				tt.room.ID = roomID
+			}
+			repo.EXPECT().SelectRoomByID(mock.Anything, roomID).Return(tt.room, tt.wantErr)
+
+			room, err := New(repo, nil, nil).GetRoomByID(context.Background(), roomID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetRoomByID() error = %v, want %v", err, tt.wantErr)
+			}
+			if room != tt.room {
+				t.Errorf("room = %+v, want %+v", room, tt.room)
+			}
+		})
+	}
+}
+
+func TestGetRoomsByIDs(t *testing.T) {
+	deletedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	live := &models.Room{ID: uuid.New()}
+	deleted := &models.Room{ID: uuid.New(), DeletedAt: &deletedAt}
+	roomIDs := []uuid.UUID{live.ID, uuid.New(), deleted.ID}
+
+	repo := mocks.NewMockRepository(t)
+	repo.EXPECT().SelectRoomsByIDs(mock.Anything, roomIDs).Return([]*models.Room{live, deleted}, nil)
+
+	rooms, err := New(repo, nil, nil).GetRoomsByIDs(context.Background(), roomIDs)
+	if err != nil {
+		t.Fatalf("GetRoomsByIDs() error = %v", err)
+	}
+	if !slices.Equal(rooms, []*models.Room{live, deleted}) {
+		t.Errorf("rooms = %v, want the live and the deleted one", rooms)
+	}
+}
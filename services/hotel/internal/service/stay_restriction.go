@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"hotel/internal/repository/models"
+	"hotel/internal/service/utils/helper"
+)
+
+func (s *Service) CreateStayRestriction(
+	ctx context.Context,
+	hotel models.HotelRef,
+	sr *models.CreateStayRestriction,
+) (*models.StayRestriction, error) {
+	sr.StayRange = truncateDateRange(sr.StayRange)
+	newRestriction, err := s.repo.InsertStayRestriction(ctx, hotel, sr)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestriction, nil
+}
+
+func (s *Service) GetStayRestrictions(
+	ctx context.Context,
+	hotel models.HotelRef,
+	page uint64,
+	limit uint64,
+) (*models.StayRestrictionList, error) {
+	offset := (page - 1) * limit
+	restrictionList, err := s.repo.SelectStayRestrictions(ctx, hotel, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return restrictionList, nil
+}
+
+func (s *Service) GetStayRestrictionByID(
+	ctx context.Context,
+	restrictionID uuid.UUID,
+) (*models.StayRestriction, error) {
+	sr, err := s.repo.SelectStayRestrictionByID(ctx, restrictionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sr, nil
+}
+
+func (s *Service) UpdateStayRestrictionByID(
+	ctx context.Context,
+	restrictionID uuid.UUID,
+	sr *models.UpdateStayRestriction,
+) (*models.StayRestriction, error) {
+	sr.StayRange = truncateDateRange(sr.StayRange)
+	updated, err := s.repo.UpdateStayRestrictionByID(ctx, restrictionID, sr)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *Service) DeleteStayRestrictionByID(ctx context.Context, restrictionID uuid.UUID) error {
+	if err := s.repo.DeleteStayRestrictionByID(ctx, restrictionID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) CheckStay(
+	ctx context.Context,
+	roomID uuid.UUID,
+	stay models.DateRange,
+) ([]models.StayViolation, error) {
+	loc, err := s.roomLocation(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	stay = helper.LocalDateRange(stay, loc)
+	if _, err = helper.StayNights(stay); err != nil {
+		return nil, err
+	}
+
+	restrictions, err := s.repo.SelectRoomStayRestrictions(ctx, roomID, stay)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.CheckStay(stay, time.Now().In(loc), restrictions)
+}
@@ -0,0 +1,123 @@
+package helper
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+
+	"hotel/pkg/lib/utils/consts"
+)
+
+const jpegQuality = 85
+
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+type EncodedImage struct {
+	ContentType string
+	Ext         string
+	Data        []byte
+}
+
+type ProcessedImage struct {
+	Original      EncodedImage
+	Thumbnail     EncodedImage
+	ThumbnailWebP EncodedImage
+	WebP          EncodedImage
+	Width         int
+	Height        int
+}
+
+// ProcessImage validates an uploaded image and renders its thumbnail and WebP variants.
+func ProcessImage(data []byte) (*ProcessedImage, error) {
+	if len(data) > consts.MaxImageBytes {
+		return nil, consts.ErrImageTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := imageExtensions[contentType]
+	if !ok {
+		return nil, consts.ErrUnsupportedImageType
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, consts.ErrInvalidImage
+	}
+	if cfg.Width*cfg.Height > consts.MaxImagePixels {
+		return nil, consts.ErrImageTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, consts.ErrInvalidImage
+	}
+
+	thumbnail := ResizeToFit(img, consts.ImageThumbnailSide)
+	processed := &ProcessedImage{
+		Original: EncodedImage{ContentType: contentType, Ext: ext, Data: data},
+		Width:    cfg.Width,
+		Height:   cfg.Height,
+	}
+
+	if processed.Thumbnail, err = encodeJPEG(thumbnail); err != nil {
+		return nil, err
+	}
+	if processed.ThumbnailWebP, err = encodeWebP(thumbnail); err != nil {
+		return nil, err
+	}
+	if processed.WebP, err = encodeWebP(ResizeToFit(img, consts.ImageWebPSide)); err != nil {
+		return nil, err
+	}
+
+	return processed, nil
+}
+
+// ResizeToFit scales img down, keeping its aspect ratio, so neither side exceeds maxSide.
+func ResizeToFit(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSide && height <= maxSide {
+		return img
+	}
+
+	if width >= height {
+		height = max(1, height*maxSide/width)
+		width = maxSide
+	} else {
+		width = max(1, width*maxSide/height)
+		height = maxSide
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	return dst
+}
+
+func encodeJPEG(img image.Image) (EncodedImage, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return EncodedImage{}, err
+	}
+
+	return EncodedImage{ContentType: "image/jpeg", Ext: ".jpg", Data: buf.Bytes()}, nil
+}
+
+func encodeWebP(img image.Image) (EncodedImage, error) {
+	var buf bytes.Buffer
+	if err := nativewebp.Encode(&buf, img, nil); err != nil {
+		return EncodedImage{}, err
+	}
+
+	return EncodedImage{ContentType: "image/webp", Ext: ".webp", Data: buf.Bytes()}, nil
+}
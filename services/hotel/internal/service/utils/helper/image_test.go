@@ -0,0 +1,64 @@
+package helper
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"hotel/pkg/lib/utils/consts"
+)
+
+func pngImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, height/2, color.RGBA{R: 200, A: 255})
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encode png: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestProcessImage(t *testing.T) {
+	processed, err := ProcessImage(pngImage(t, 800, 400))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if processed.Width != 800 || processed.Height != 400 {
+		t.Errorf("size = %dx%d, want 800x400", processed.Width, processed.Height)
+	}
+	if processed.Original.ContentType != "image/png" {
+		t.Errorf("content type = %s, want image/png", processed.Original.ContentType)
+	}
+
+	thumbnail, _, err := image.DecodeConfig(bytes.NewReader(processed.Thumbnail.Data))
+	if err != nil {
+		t.Fatalf("decode thumbnail: %v", err)
+	}
+	if thumbnail.Width != 320 || thumbnail.Height != 160 {
+		t.Errorf("thumbnail size = %dx%d, want 320x160", thumbnail.Width, thumbnail.Height)
+	}
+
+	webp, format, err := image.DecodeConfig(bytes.NewReader(processed.WebP.Data))
+	if err != nil {
+		t.Fatalf("decode webp: %v", err)
+	}
+	if format != "webp" || webp.Width != 800 {
+		t.Errorf("webp = %s %dpx wide, want webp 800px wide", format, webp.Width)
+	}
+}
+
+func TestProcessImageUnsupportedType(t *testing.T) {
+	if _, err := ProcessImage([]byte("GIF89a not really")); !errors.Is(err, consts.ErrUnsupportedImageType) {
+		t.Fatalf("err = %v, want %v", err, consts.ErrUnsupportedImageType)
+	}
+}
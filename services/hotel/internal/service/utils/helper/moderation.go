@@ -0,0 +1,89 @@
+package helper
+
+import (
+	"slices"
+
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+type hotelTransition struct {
+	from      models.HotelStatus
+	to        models.HotelStatus
+	roles     []models.UserRole
+	ownerOnly bool
+	reason    bool
+}
+
+// hotelTransitions lists every allowed move of a hotel between statuses: owners
+// submit drafts, moderators approve or send them back, admins suspend and reinstate.
+var hotelTransitions = []hotelTransition{
+	{
+		from:      models.HotelStatusDraft,
+		to:        models.HotelStatusPendingReview,
+		ownerOnly: true,
+	},
+	{
+		from:  models.HotelStatusPendingReview,
+		to:    models.HotelStatusPublished,
+		roles: []models.UserRole{models.UserRoleModerator, models.UserRoleAdmin},
+	},
+	{
+		from:   models.HotelStatusPendingReview,
+		to:     models.HotelStatusDraft,
+		roles:  []models.UserRole{models.UserRoleModerator, models.UserRoleAdmin},
+		reason: true,
+	},
+	{
+		from:   models.HotelStatusPublished,
+		to:     models.HotelStatusSuspended,
+		roles:  []models.UserRole{models.UserRoleAdmin},
+		reason: true,
+	},
+	{
+		from:  models.HotelStatusSuspended,
+		to:    models.HotelStatusPublished,
+		roles: []models.UserRole{models.UserRoleAdmin},
+	},
+}
+
+// CanViewHotel reports whether the viewer may read the hotel: published hotels
+// are public, the others only reach their owner, moderators and admins.
+func CanViewHotel(h *models.Hotel, v models.HotelViewer) bool {
+	switch {
+	case h.Status == models.HotelStatusPublished:
+		return true
+	case v.ID != 0 && v.ID == h.OwnerID:
+		return true
+	default:
+		return v.Role == models.UserRoleModerator || v.Role == models.UserRoleAdmin
+	}
+}
+
+// CheckHotelTransition reports whether the actor may move the hotel to the requested status.
+// The actor comes from the caller's access token; without one nothing changes.
+func CheckHotelTransition(h *models.Hotel, c *models.HotelStatusChange) error {
+	if c.ActorID == 0 {
+		return consts.ErrHotelActionForbidden
+	}
+
+	idx := slices.IndexFunc(hotelTransitions, func(t hotelTransition) bool {
+		return t.from == h.Status && t.to == c.To
+	})
+	if idx < 0 {
+		return consts.ErrInvalidHotelTransition
+	}
+
+	t := hotelTransitions[idx]
+	if t.ownerOnly && c.ActorID != h.OwnerID {
+		return consts.ErrHotelActionForbidden
+	}
+	if t.roles != nil && !slices.Contains(t.roles, c.ActorRole) {
+		return consts.ErrHotelActionForbidden
+	}
+	if t.reason && (c.Reason == nil || *c.Reason == "") {
+		return consts.ErrReasonRequired
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package helper
+
+import (
+	"errors"
+	"testing"
+
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func TestCheckHotelTransition(t *testing.T) {
+	const ownerID = 7
+	reason := "photos do not match the address"
+	empty := ""
+
+	tests := []struct {
+		name    string
+		from    models.HotelStatus
+		change  models.HotelStatusChange
+		wantErr error
+	}{
+		{
+			name:   "owner submits draft",
+			from:   models.HotelStatusDraft,
+			change: models.HotelStatusChange{To: models.HotelStatusPendingReview, ActorID: ownerID, ActorRole: models.UserRoleUser},
+		},
+		{
+			name:    "someone else submits draft",
+			from:    models.HotelStatusDraft,
+			change:  models.HotelStatusChange{To: models.HotelStatusPendingReview, ActorID: 8, ActorRole: models.UserRoleModerator},
+			wantErr: consts.ErrHotelActionForbidden,
+		},
+		{
+			name:   "moderator approves",
+			from:   models.HotelStatusPendingReview,
+			change: models.HotelStatusChange{To: models.HotelStatusPublished, ActorID: 1, ActorRole: models.UserRoleModerator},
+		},
+		{
+			name:    "owner approves own hotel",
+			from:    models.HotelStatusPendingReview,
+			change:  models.HotelStatusChange{To: models.HotelStatusPublished, ActorID: ownerID, ActorRole: models.UserRoleUser},
+			wantErr: consts.ErrHotelActionForbidden,
+		},
+		{
+			name: "moderator rejects with reason",
+			from: models.HotelStatusPendingReview,
+			change: models.HotelStatusChange{
+				To: models.HotelStatusDraft, ActorID: 1, ActorRole: models.UserRoleModerator, Reason: &reason,
+			},
+		},
+		{
+			name: "moderator rejects with empty reason",
+			from: models.HotelStatusPendingReview,
+			change: models.HotelStatusChange{
+				To: models.HotelStatusDraft, ActorID: 1, ActorRole: models.UserRoleModerator, Reason: &empty,
+			},
+			wantErr: consts.ErrReasonRequired,
+		},
+		{
+			name:    "draft cannot be published directly",
+			from:    models.HotelStatusDraft,
+			change:  models.HotelStatusChange{To: models.HotelStatusPublished, ActorID: 1, ActorRole: models.UserRoleAdmin},
+			wantErr: consts.ErrInvalidHotelTransition,
+		},
+		{
+			name: "moderator cannot suspend",
+			from: models.HotelStatusPublished,
+			change: models.HotelStatusChange{
+				To: models.HotelStatusSuspended, ActorID: 1, ActorRole: models.UserRoleModerator, Reason: &reason,
+			},
+			wantErr: consts.ErrHotelActionForbidden,
+		},
+		{
+			name: "admin suspends",
+			from: models.HotelStatusPublished,
+			change: models.HotelStatusChange{
+				To: models.HotelStatusSuspended, ActorID: 1, ActorRole: models.UserRoleAdmin, Reason: &reason,
+			},
+		},
+		{
+			name:    "anonymous caller",
+			from:    models.HotelStatusPendingReview,
+			change:  models.HotelStatusChange{To: models.HotelStatusPublished, ActorRole: models.UserRoleAdmin},
+			wantErr: consts.ErrHotelActionForbidden,
+		},
+		{
+			name:   "admin reinstates",
+			from:   models.HotelStatusSuspended,
+			change: models.HotelStatusChange{To: models.HotelStatusPublished, ActorID: 1, ActorRole: models.UserRoleAdmin},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &models.Hotel{Status: tt.from, OwnerID: ownerID}
+			if err := CheckHotelTransition(h, &tt.change); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckHotelTransition() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCanViewHotel(t *testing.T) {
+	const ownerID = 7
+
+	viewers := []struct {
+		name   string
+		viewer models.HotelViewer
+		staff  bool
+	}{
+		{name: "anonymous", viewer: models.HotelViewer{}},
+		{name: "another user", viewer: models.HotelViewer{ID: 8, Role: models.UserRoleUser}},
+		{name: "owner", viewer: models.HotelViewer{ID: ownerID, Role: models.UserRoleUser}, staff: true},
+		{name: "moderator", viewer: models.HotelViewer{ID: 1, Role: models.UserRoleModerator}, staff: true},
+		{name: "admin", viewer: models.HotelViewer{ID: 2, Role: models.UserRoleAdmin}, staff: true},
+	}
+	statuses := []models.HotelStatus{
+		models.HotelStatusDraft,
+		models.HotelStatusPendingReview,
+		models.HotelStatusPublished,
+		models.HotelStatusSuspended,
+	}
+
+	for _, status := range statuses {
+		for _, v := range viewers {
+			t.Run(string(status)+"/"+v.name, func(t *testing.T) {
+				h := &models.Hotel{OwnerID: ownerID, Status: status}
+				want := status == models.HotelStatusPublished || v.staff
+				if got := CanViewHotel(h, v.viewer); got != want {
+					t.Errorf("CanViewHotel() = %v, want %v", got, want)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"slices"
+
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+// NormalizePolicy validates the policy and orders cancellation tiers from the
+// earliest cancellation to the latest and child age bands from the youngest.
+func NormalizePolicy(p *models.SetHotelPolicy) error {
+	slices.SortFunc(p.CancellationTiers, func(a, b models.CancellationTier) int {
+		return int(b.HoursBeforeCheckIn) - int(a.HoursBeforeCheckIn)
+	})
+	for i, tier := range p.CancellationTiers {
+		if i > 0 && tier.HoursBeforeCheckIn == p.CancellationTiers[i-1].HoursBeforeCheckIn {
+			return consts.ErrInvalidCancellationTiers
+		}
+		isPercent := tier.Penalty == models.CancellationPenaltyPercent
+		if isPercent != (tier.PenaltyPercent > 0) {
+			return consts.ErrInvalidCancellationTiers
+		}
+	}
+
+	slices.SortFunc(p.ChildAgeBands, func(a, b models.ChildAgeBand) int {
+		return int(a.MinAge) - int(b.MinAge)
+	})
+	for i, band := range p.ChildAgeBands {
+		if band.MinAge > band.MaxAge {
+			return consts.ErrInvalidChildAgeBands
+		}
+		if i > 0 && band.MinAge <= p.ChildAgeBands[i-1].MaxAge {
+			return consts.ErrInvalidChildAgeBands
+		}
+	}
+
+	if !p.Pets.Allowed && (p.Pets.FeePerNight != nil || p.Pets.MaxPets > 0) {
+		return consts.ErrInvalidPetPolicy
+	}
+
+	return nil
+}
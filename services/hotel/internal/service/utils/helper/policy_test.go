@@ -0,0 +1,87 @@
+package helper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func TestNormalizePolicy(t *testing.T) {
+	fee := decimal.RequireFromString("15")
+
+	tests := []struct {
+		name    string
+		policy  models.SetHotelPolicy
+		wantErr error
+	}{
+		{
+			name: "free until 48h then first night",
+			policy: models.SetHotelPolicy{
+				CancellationTiers: []models.CancellationTier{
+					{HoursBeforeCheckIn: 0, Penalty: models.CancellationPenaltyFirstNight},
+					{HoursBeforeCheckIn: 48, Penalty: models.CancellationPenaltyNone},
+				},
+				ChildAgeBands: []models.ChildAgeBand{
+					{MinAge: 6, MaxAge: 11, PricePercent: 50},
+					{MinAge: 0, MaxAge: 5},
+				},
+				Pets: models.PetPolicy{Allowed: true, FeePerNight: &fee, MaxPets: 2},
+			},
+		},
+		{
+			name: "duplicate tier hours",
+			policy: models.SetHotelPolicy{
+				CancellationTiers: []models.CancellationTier{
+					{HoursBeforeCheckIn: 24, Penalty: models.CancellationPenaltyNone},
+					{HoursBeforeCheckIn: 24, Penalty: models.CancellationPenaltyFullStay},
+				},
+			},
+			wantErr: consts.ErrInvalidCancellationTiers,
+		},
+		{
+			name: "percent penalty without percent",
+			policy: models.SetHotelPolicy{
+				CancellationTiers: []models.CancellationTier{
+					{HoursBeforeCheckIn: 24, Penalty: models.CancellationPenaltyPercent},
+				},
+			},
+			wantErr: consts.ErrInvalidCancellationTiers,
+		},
+		{
+			name: "overlapping child bands",
+			policy: models.SetHotelPolicy{
+				ChildAgeBands: []models.ChildAgeBand{
+					{MinAge: 0, MaxAge: 6},
+					{MinAge: 6, MaxAge: 12, PricePercent: 50},
+				},
+			},
+			wantErr: consts.ErrInvalidChildAgeBands,
+		},
+		{
+			name: "pet fee without pets",
+			policy: models.SetHotelPolicy{
+				Pets: models.PetPolicy{FeePerNight: &fee},
+			},
+			wantErr: consts.ErrInvalidPetPolicy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NormalizePolicy(&tt.policy)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NormalizePolicy() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			tiers := tt.policy.CancellationTiers
+			if tiers[0].HoursBeforeCheckIn != 48 || tt.policy.ChildAgeBands[0].MinAge != 0 {
+				t.Fatalf("NormalizePolicy() did not order tiers and bands: %+v", tt.policy)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package helper
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+func TruncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func StayNights(stay models.DateRange) ([]time.Time, error) {
+	in := TruncateDate(stay.Start)
+	out := TruncateDate(stay.End)
+	if !out.After(in) {
+		return nil, consts.ErrInvalidStayDates
+	}
+
+	var nights []time.Time
+	for d := in; d.Before(out); d = d.AddDate(0, 0, 1) {
+		if len(nights) == consts.MaxQuoteNights {
+			return nil, consts.ErrStayTooLong
+		}
+		nights = append(nights, d)
+	}
+
+	return nights, nil
+}
+
+// NightlyPrice applies the plan's day of week adjustment to its base price.
+func NightlyPrice(rp *models.RatePlan, date time.Time) decimal.Decimal {
+	idx := (int(date.Weekday()) + 6) % 7
+	factor := decimal.NewFromInt(1).Add(rp.WeekdayAdjustments[idx].Div(hundred))
+
+	return rp.Price.Mul(factor).Round(2)
+}
+
+// QuoteStay prices every night of the stay with the first plan covering it.
+// Plans must be ordered by precedence; nights without a plan fall back to basePrice.
+func QuoteStay(
+	roomID uuid.UUID,
+	stay models.DateRange,
+	basePrice decimal.Decimal,
+	ratePlans []*models.RatePlan,
+) (*models.StayQuote, error) {
+	nights, err := StayNights(stay)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &models.StayQuote{
+		RoomID: roomID,
+		StayRange: models.DateRange{
+			Start: nights[0],
+			End:   nights[len(nights)-1].AddDate(0, 0, 1),
+		},
+		Nights:      make([]models.NightlyRate, len(nights)),
+		TotalAmount: decimal.Zero,
+	}
+
+	for i, night := range nights {
+		rate := models.NightlyRate{Date: night, Price: basePrice}
+		for _, rp := range ratePlans {
+			if rp.StayRange.Contains(night) {
+				rate.Price = NightlyPrice(rp, night)
+				rate.RatePlanID = &rp.ID
+				break
+			}
+		}
+
+		quote.Nights[i] = rate
+		quote.TotalAmount = quote.TotalAmount.Add(rate.Price)
+	}
+
+	return quote, nil
+}
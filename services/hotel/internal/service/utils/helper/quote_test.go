@@ -0,0 +1,91 @@
+package helper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+func date(day int) time.Time {
+	return time.Date(2026, time.March, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestQuoteStay(t *testing.T) {
+	base := decimal.RequireFromString("100")
+	season := &models.RatePlan{
+		ID:        uuid.New(),
+		StayRange: models.DateRange{Start: date(1), End: date(31)},
+		Price:     decimal.RequireFromString("150"),
+	}
+	// 2026-03-07 and 2026-03-08 are Saturday and Sunday.
+	season.WeekdayAdjustments[5] = decimal.RequireFromString("20")
+	season.WeekdayAdjustments[6] = decimal.RequireFromString("-10")
+	holiday := &models.RatePlan{
+		ID:        uuid.New(),
+		StayRange: models.DateRange{Start: date(8), End: date(9)},
+		Price:     decimal.RequireFromString("300"),
+	}
+
+	cases := []struct {
+		ratePlans     []*models.RatePlan
+		name          string
+		expectedTotal string
+		checkIn       time.Time
+		checkOut      time.Time
+	}{
+		{
+			name:          "Base price without plans",
+			checkIn:       date(2),
+			checkOut:      date(5),
+			expectedTotal: "300",
+		},
+		{
+			name:          "Weekday adjustments",
+			ratePlans:     []*models.RatePlan{season},
+			checkIn:       date(6),
+			checkOut:      date(9),
+			expectedTotal: "465",
+		},
+		{
+			name:          "First matching plan wins",
+			ratePlans:     []*models.RatePlan{holiday, season},
+			checkIn:       date(6),
+			checkOut:      date(9),
+			expectedTotal: "630",
+		},
+		{
+			name:          "Nights outside plans fall back to base price",
+			ratePlans:     []*models.RatePlan{season},
+			checkIn:       time.Date(2026, time.February, 27, 15, 0, 0, 0, time.UTC),
+			checkOut:      date(3),
+			expectedTotal: "485",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			stay := models.DateRange{Start: tc.checkIn, End: tc.checkOut}
+			quote, err := QuoteStay(uuid.New(), stay, base, tc.ratePlans)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !quote.TotalAmount.Equal(decimal.RequireFromString(tc.expectedTotal)) {
+				t.Errorf("total = %s, want %s", quote.TotalAmount, tc.expectedTotal)
+			}
+		})
+	}
+}
+
+func TestQuoteStayInvalidDates(t *testing.T) {
+	stay := models.DateRange{Start: date(5), End: date(5)}
+	if _, err := QuoteStay(uuid.New(), stay, decimal.NewFromInt(1), nil); !errors.Is(err, consts.ErrInvalidStayDates) {
+		t.Fatalf("err = %v, want %v", err, consts.ErrInvalidStayDates)
+	}
+}
@@ -0,0 +1,110 @@
+package helper
+
+import (
+	"fmt"
+	"time"
+
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+const dateLayout = "2006-01-02"
+
+// CheckStay evaluates the stay against every restriction. Length of stay, arrival
+// and advance booking rules apply when the restriction covers the check-in date,
+// departure rules when it covers the check-out date.
+func CheckStay(
+	stay models.DateRange,
+	today time.Time,
+	restrictions []*models.StayRestriction,
+) ([]models.StayViolation, error) {
+	nights, err := StayNights(stay)
+	if err != nil {
+		return nil, err
+	}
+
+	arrival := nights[0]
+	departure := nights[len(nights)-1].AddDate(0, 0, 1)
+	stayLength := len(nights)
+	advanceDays := int(arrival.Sub(TruncateDate(today)).Hours() / 24)
+
+	var violations []models.StayViolation
+	for _, sr := range restrictions {
+		rules := sr.Rules
+		if sr.StayRange.Contains(arrival) {
+			if rules.MinLengthOfStay != nil && stayLength < *rules.MinLengthOfStay {
+				violations = append(
+					violations, limitViolation(
+						sr, models.StayRuleMinLengthOfStay, arrival, *rules.MinLengthOfStay, stayLength,
+						consts.MsgViolationMinLengthOfStay,
+					),
+				)
+			}
+			if rules.MaxLengthOfStay != nil && stayLength > *rules.MaxLengthOfStay {
+				violations = append(
+					violations, limitViolation(
+						sr, models.StayRuleMaxLengthOfStay, arrival, *rules.MaxLengthOfStay, stayLength,
+						consts.MsgViolationMaxLengthOfStay,
+					),
+				)
+			}
+			if rules.ClosedToArrival {
+				violations = append(
+					violations, models.StayViolation{
+						Date:          arrival,
+						Rule:          models.StayRuleClosedToArrival,
+						Message:       fmt.Sprintf(consts.MsgViolationClosedToArrival, arrival.Format(dateLayout)),
+						RestrictionID: sr.ID,
+					},
+				)
+			}
+			if rules.MinAdvanceDays != nil && advanceDays < *rules.MinAdvanceDays {
+				violations = append(
+					violations, limitViolation(
+						sr, models.StayRuleMinAdvanceBooking, arrival, *rules.MinAdvanceDays, advanceDays,
+						consts.MsgViolationMinAdvanceBooking,
+					),
+				)
+			}
+			if rules.MaxAdvanceDays != nil && advanceDays > *rules.MaxAdvanceDays {
+				violations = append(
+					violations, limitViolation(
+						sr, models.StayRuleMaxAdvanceBooking, arrival, *rules.MaxAdvanceDays, advanceDays,
+						consts.MsgViolationMaxAdvanceBooking,
+					),
+				)
+			}
+		}
+
+		if rules.ClosedToDeparture && sr.StayRange.Contains(departure) {
+			violations = append(
+				violations, models.StayViolation{
+					Date:          departure,
+					Rule:          models.StayRuleClosedToDeparture,
+					Message:       fmt.Sprintf(consts.MsgViolationClosedToDeparture, departure.Format(dateLayout)),
+					RestrictionID: sr.ID,
+				},
+			)
+		}
+	}
+
+	return violations, nil
+}
+
+func limitViolation(
+	sr *models.StayRestriction,
+	rule models.StayRule,
+	date time.Time,
+	limit int,
+	actual int,
+	msg string,
+) models.StayViolation {
+	return models.StayViolation{
+		Date:          date,
+		Limit:         &limit,
+		Actual:        &actual,
+		Rule:          rule,
+		Message:       fmt.Sprintf(msg, limit, actual),
+		RestrictionID: sr.ID,
+	}
+}
@@ -0,0 +1,89 @@
+package helper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"hotel/internal/repository/models"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestCheckStay(t *testing.T) {
+	today := date(1)
+	march := models.DateRange{Start: date(1), End: date(31)}
+
+	cases := []struct {
+		rules    models.StayRules
+		name     string
+		expected []models.StayRule
+		checkIn  time.Time
+		checkOut time.Time
+	}{
+		{
+			name:     "Stay within limits",
+			rules:    models.StayRules{MinLengthOfStay: intPtr(2), MaxLengthOfStay: intPtr(7)},
+			checkIn:  date(10),
+			checkOut: date(13),
+		},
+		{
+			name:     "Stay too short",
+			rules:    models.StayRules{MinLengthOfStay: intPtr(2)},
+			checkIn:  date(10),
+			checkOut: date(11),
+			expected: []models.StayRule{models.StayRuleMinLengthOfStay},
+		},
+		{
+			name:     "Stay too long",
+			rules:    models.StayRules{MaxLengthOfStay: intPtr(3)},
+			checkIn:  date(10),
+			checkOut: date(20),
+			expected: []models.StayRule{models.StayRuleMaxLengthOfStay},
+		},
+		{
+			name:     "Closed to arrival and departure",
+			rules:    models.StayRules{ClosedToArrival: true, ClosedToDeparture: true},
+			checkIn:  date(10),
+			checkOut: date(12),
+			expected: []models.StayRule{models.StayRuleClosedToArrival, models.StayRuleClosedToDeparture},
+		},
+		{
+			name:     "Departure outside restriction range",
+			rules:    models.StayRules{ClosedToDeparture: true},
+			checkIn:  date(29),
+			checkOut: date(31),
+		},
+		{
+			name:     "Booked too late",
+			rules:    models.StayRules{MinAdvanceDays: intPtr(7), MaxAdvanceDays: intPtr(20)},
+			checkIn:  date(3),
+			checkOut: date(5),
+			expected: []models.StayRule{models.StayRuleMinAdvanceBooking},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			restrictions := []*models.StayRestriction{{ID: uuid.New(), StayRange: march, Rules: tc.rules}}
+			stay := models.DateRange{Start: tc.checkIn, End: tc.checkOut}
+
+			violations, err := CheckStay(stay, today, restrictions)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(violations) != len(tc.expected) {
+				t.Fatalf("violations = %+v, want rules %v", violations, tc.expected)
+			}
+			for i, v := range violations {
+				if v.Rule != tc.expected[i] {
+					t.Errorf("violation %d rule = %s, want %s", i, v.Rule, tc.expected[i])
+				}
+			}
+		})
+	}
+}
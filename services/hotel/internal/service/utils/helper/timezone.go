@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"time"
+	_ "time/tzdata"
+
+	"hotel/internal/repository/models"
+	"hotel/pkg/lib/utils/consts"
+)
+
+// LoadTimezone resolves an IANA timezone name. "Local" and the empty name are
+// rejected because they depend on the host rather than on the hotel.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, consts.ErrInvalidTimezone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, consts.ErrInvalidTimezone
+	}
+
+	return loc, nil
+}
+
+// LocalDate returns the calendar date of t in the hotel's timezone as a UTC midnight.
+// A timestamp already at UTC midnight is a date-only value, which is how stored
+// dates leave the service, and keeps its date.
+func LocalDate(t time.Time, loc *time.Location) time.Time {
+	t = t.UTC()
+	if t.Equal(TruncateDate(t)) {
+		return t
+	}
+
+	return TruncateDate(t.In(loc))
+}
+
+func LocalDateRange(r models.DateRange, loc *time.Location) models.DateRange {
+	return models.DateRange{
+		Start: LocalDate(r.Start, loc),
+		End:   LocalDate(r.End, loc),
+	}
+}
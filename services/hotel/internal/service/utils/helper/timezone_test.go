@@ -0,0 +1,73 @@
+package helper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"hotel/pkg/lib/utils/consts"
+)
+
+func TestLocalDate(t *testing.T) {
+	vladivostok, err := LoadTimezone("Asia/Vladivostok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := LoadTimezone("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		in   time.Time
+		loc  *time.Location
+		want time.Time
+	}{
+		{
+			name: "date only keeps its date east of UTC",
+			in:   date(20),
+			loc:  vladivostok,
+			want: date(20),
+		},
+		{
+			name: "date only keeps its date west of UTC",
+			in:   date(20),
+			loc:  newYork,
+			want: date(20),
+		},
+		{
+			name: "local midnight east of UTC",
+			in:   time.Date(2026, time.March, 20, 0, 0, 0, 0, vladivostok),
+			loc:  vladivostok,
+			want: date(20),
+		},
+		{
+			name: "late evening west of UTC",
+			in:   time.Date(2026, time.March, 20, 23, 30, 0, 0, newYork),
+			loc:  newYork,
+			want: date(20),
+		},
+		{
+			name: "utc afternoon is next day east of UTC",
+			in:   time.Date(2026, time.March, 19, 15, 0, 0, 0, time.UTC),
+			loc:  vladivostok,
+			want: date(20),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LocalDate(tt.in, tt.loc); !got.Equal(tt.want) {
+				t.Fatalf("LocalDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadTimezone(t *testing.T) {
+	for _, name := range []string{"", "Local", "Mars/Olympus"} {
+		if _, err := LoadTimezone(name); !errors.Is(err, consts.ErrInvalidTimezone) {
+			t.Fatalf("LoadTimezone(%q) error = %v, want %v", name, err, consts.ErrInvalidTimezone)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"hotel/internal/config"
+	"hotel/pkg/lib/utils/consts"
+)
+
+// Store keeps blobs on the local filesystem under root and serves them from baseURL.
+type Store struct {
+	root     string
+	baseURL  string
+	basePath string
+}
+
+func New(cfg config.StorageConfig) (*Store, error) {
+	baseURL, err := url.Parse(strings.TrimRight(cfg.BaseURL, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.MkdirAll(cfg.Root, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		root:     cfg.Root,
+		baseURL:  baseURL.String(),
+		basePath: baseURL.Path,
+	}, nil
+}
+
+func (s *Store) Put(_ context.Context, key string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *Store) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Store) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+// BasePath is the path of baseURL the blobs are served under.
+func (s *Store) BasePath() string {
+	return s.basePath
+}
+
+// Handler serves the stored blobs by key. Directory listings and uploads still
+// being written are not exposed.
+func (s *Store) Handler() http.Handler {
+	return http.StripPrefix(s.basePath, http.FileServer(blobDir{http.Dir(s.root)}))
+}
+
+func (s *Store) path(key string) (string, error) {
+	if key == "" || !filepath.IsLocal(key) {
+		return "", consts.ErrInvalidImageBlobKey
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+type blobDir struct {
+	http.Dir
+}
+
+func (d blobDir) Open(name string) (http.File, error) {
+	if strings.HasPrefix(path.Base(name), ".") {
+		return nil, os.ErrNotExist
+	}
+
+	f, err := d.Dir.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil || info.IsDir() {
+		_ = f.Close()
+		return nil, os.ErrNotExist
+	}
+
+	return f, nil
+}
@@ -0,0 +1,72 @@
+package local
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"hotel/internal/config"
+)
+
+func TestStoreHandler(t *testing.T) {
+	root := t.TempDir()
+	store, err := New(config.StorageConfig{Root: root, BaseURL: "http://localhost:8092/media/"})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	const key = "hotels/42/original.jpg"
+	if err = store.Put(context.Background(), key, strings.NewReader("jpeg bytes")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err = os.WriteFile(filepath.Join(root, "hotels", "42", ".upload-1"), []byte("partial"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := store.URL(key), "http://localhost:8092/media/"+key; got != want {
+		t.Errorf("URL() = %q, want %q", got, want)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(store.BasePath()+"/", store.Handler())
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	tests := []struct {
+		name     string
+		path     string
+		wantCode int
+		wantBody string
+	}{
+		{name: "stored blob", path: "/media/" + key, wantCode: http.StatusOK, wantBody: "jpeg bytes"},
+		{name: "missing blob", path: "/media/hotels/42/missing.jpg", wantCode: http.StatusNotFound},
+		{name: "directory listing", path: "/media/hotels/42/", wantCode: http.StatusNotFound},
+		{name: "upload in flight", path: "/media/hotels/42/.upload-1", wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Get(srv.URL + tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != tt.wantCode {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantCode)
+			}
+			if tt.wantBody == "" {
+				return
+			}
+			body, _ := io.ReadAll(resp.Body)
+			if string(body) != tt.wantBody {
+				t.Errorf("body = %q, want %q", body, tt.wantBody)
+			}
+		})
+	}
+}
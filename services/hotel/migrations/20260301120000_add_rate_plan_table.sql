@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE IF NOT EXISTS rate_plan (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    hotel_id UUID NOT NULL REFERENCES hotel(id) ON DELETE CASCADE,
+    room_id UUID REFERENCES room(id) ON DELETE CASCADE,
+    room_type room_type,
+    title VARCHAR(100) NOT NULL,
+    stay_range DATERANGE NOT NULL,
+    price NUMERIC(10,2) NOT NULL CHECK (price > 0),
+    -- percent adjustments indexed by ISO day of week (1 = Monday ... 7 = Sunday)
+    weekday_adjustments NUMERIC(5,2)[] NOT NULL DEFAULT '{0,0,0,0,0,0,0}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT rate_plan_target CHECK (room_id IS NOT NULL OR room_type IS NOT NULL),
+    CONSTRAINT rate_plan_range_valid CHECK (upper(stay_range) > lower(stay_range)),
+    CONSTRAINT rate_plan_weekday_adjustments CHECK (array_length(weekday_adjustments, 1) = 7),
+    CONSTRAINT rate_plan_weekday_adjustments_range CHECK (-100 < ALL (weekday_adjustments))
+);
+
+CREATE INDEX IF NOT EXISTS rate_plan_hotel_idx ON rate_plan (hotel_id);
+CREATE INDEX IF NOT EXISTS rate_plan_room_idx ON rate_plan (room_id) WHERE room_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS rate_plan_stay_range_idx ON rate_plan USING GIST (hotel_id, stay_range);
+
+CREATE TRIGGER update_rate_plans_updated_at
+    BEFORE UPDATE ON rate_plan
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_rate_plans_updated_at ON rate_plan;
+
+DROP INDEX IF EXISTS rate_plan_hotel_idx;
+DROP INDEX IF EXISTS rate_plan_room_idx;
+DROP INDEX IF EXISTS rate_plan_stay_range_idx;
+
+DROP TABLE IF EXISTS rate_plan;
+
+DROP EXTENSION IF EXISTS btree_gist;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS stay_restriction (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    hotel_id UUID NOT NULL REFERENCES hotel(id) ON DELETE CASCADE,
+    room_id UUID REFERENCES room(id) ON DELETE CASCADE,
+    stay_range DATERANGE NOT NULL,
+    min_length_of_stay INT CHECK (min_length_of_stay > 0),
+    max_length_of_stay INT CHECK (max_length_of_stay > 0),
+    closed_to_arrival BOOLEAN NOT NULL DEFAULT FALSE,
+    closed_to_departure BOOLEAN NOT NULL DEFAULT FALSE,
+    min_advance_days INT CHECK (min_advance_days >= 0),
+    max_advance_days INT CHECK (max_advance_days >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT stay_restriction_range_valid CHECK (upper(stay_range) > lower(stay_range)),
+    CONSTRAINT stay_restriction_length_of_stay CHECK (max_length_of_stay >= min_length_of_stay),
+    CONSTRAINT stay_restriction_advance_days CHECK (max_advance_days >= min_advance_days)
+);
+
+CREATE INDEX IF NOT EXISTS stay_restriction_hotel_idx ON stay_restriction (hotel_id);
+CREATE INDEX IF NOT EXISTS stay_restriction_stay_range_idx ON stay_restriction USING GIST (hotel_id, stay_range);
+
+CREATE TRIGGER update_stay_restrictions_updated_at
+    BEFORE UPDATE ON stay_restriction
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_stay_restrictions_updated_at ON stay_restriction;
+
+DROP INDEX IF EXISTS stay_restriction_hotel_idx;
+DROP INDEX IF EXISTS stay_restriction_stay_range_idx;
+
+DROP TABLE IF EXISTS stay_restriction;
+-- +goose StatementEnd
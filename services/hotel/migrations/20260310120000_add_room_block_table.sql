@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE room_block_reason AS ENUM ('ROOM_BLOCK_REASON_MAINTENANCE',
+    'ROOM_BLOCK_REASON_OWNER_USE',
+    'ROOM_BLOCK_REASON_RENOVATION'
+    );
+
+CREATE TABLE IF NOT EXISTS room_block (
+    id UUID PRIMARY KEY,
+    room_id UUID NOT NULL REFERENCES room(id) ON DELETE CASCADE,
+    stay_range DATERANGE NOT NULL,
+    reason room_block_reason NOT NULL,
+    note VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT room_block_range_valid CHECK (upper(stay_range) > lower(stay_range)),
+    CONSTRAINT room_block_no_overlap EXCLUDE USING gist (
+        room_id WITH =,
+        stay_range WITH &&
+    )
+);
+
+CREATE TRIGGER update_room_blocks_updated_at
+    BEFORE UPDATE ON room_block
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_room_blocks_updated_at ON room_block;
+
+DROP TABLE IF EXISTS room_block;
+
+DROP TYPE IF EXISTS room_block_reason;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS image (
+    id UUID PRIMARY KEY,
+    hotel_id UUID NOT NULL REFERENCES hotel(id) ON DELETE CASCADE,
+    room_id UUID REFERENCES room(id) ON DELETE CASCADE,
+    position INT NOT NULL CHECK (position >= 0),
+    content_type VARCHAR(50) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    width INT NOT NULL CHECK (width > 0),
+    height INT NOT NULL CHECK (height > 0),
+    original_key TEXT NOT NULL,
+    thumbnail_key TEXT NOT NULL,
+    thumbnail_webp_key TEXT NOT NULL,
+    webp_key TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS image_hotel_idx ON image (hotel_id, position) WHERE room_id IS NULL;
+CREATE INDEX IF NOT EXISTS image_room_idx ON image (room_id, position) WHERE room_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS image_hotel_idx;
+DROP INDEX IF EXISTS image_room_idx;
+
+DROP TABLE IF EXISTS image;
+-- +goose StatementEnd
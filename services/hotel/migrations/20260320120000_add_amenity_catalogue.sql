@@ -0,0 +1,191 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE amenity_category AS ENUM ('AMENITY_CATEGORY_GENERAL',
+    'AMENITY_CATEGORY_INTERNET',
+    'AMENITY_CATEGORY_BATHROOM',
+    'AMENITY_CATEGORY_KITCHEN',
+    'AMENITY_CATEGORY_FOOD_AND_DRINK',
+    'AMENITY_CATEGORY_ENTERTAINMENT',
+    'AMENITY_CATEGORY_WELLNESS',
+    'AMENITY_CATEGORY_PARKING',
+    'AMENITY_CATEGORY_ACCESSIBILITY',
+    'AMENITY_CATEGORY_OTHER'
+    );
+
+CREATE TABLE IF NOT EXISTS amenity (
+    code VARCHAR(64) PRIMARY KEY,
+    category amenity_category NOT NULL,
+    icon VARCHAR(64) NOT NULL,
+    names JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT amenity_code_format CHECK (code ~ '^[a-z][a-z0-9_]*$'),
+    CONSTRAINT amenity_names_en CHECK (names ? 'en')
+);
+
+CREATE TABLE IF NOT EXISTS hotel_amenity (
+    hotel_id UUID NOT NULL REFERENCES hotel(id) ON DELETE CASCADE,
+    amenity_code VARCHAR(64) NOT NULL REFERENCES amenity(code) ON UPDATE CASCADE,
+    PRIMARY KEY (hotel_id, amenity_code)
+);
+
+CREATE TABLE IF NOT EXISTS room_amenity (
+    room_id UUID NOT NULL REFERENCES room(id) ON DELETE CASCADE,
+    amenity_code VARCHAR(64) NOT NULL REFERENCES amenity(code) ON UPDATE CASCADE,
+    PRIMARY KEY (room_id, amenity_code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_hotel_amenity_code ON hotel_amenity(amenity_code);
+CREATE INDEX IF NOT EXISTS idx_room_amenity_code ON room_amenity(amenity_code);
+
+CREATE TRIGGER update_amenities_updated_at
+    BEFORE UPDATE ON amenity
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO amenity (code, category, icon, names) VALUES
+    ('wifi', 'AMENITY_CATEGORY_INTERNET', 'wifi', '{"en": "Wi-Fi", "ru": "Wi-Fi"}'),
+    ('air_conditioning', 'AMENITY_CATEGORY_GENERAL', 'snowflake', '{"en": "Air conditioning", "ru": "Кондиционер"}'),
+    ('heating', 'AMENITY_CATEGORY_GENERAL', 'thermometer', '{"en": "Heating", "ru": "Отопление"}'),
+    ('tv', 'AMENITY_CATEGORY_ENTERTAINMENT', 'tv', '{"en": "TV", "ru": "Телевизор"}'),
+    ('safe', 'AMENITY_CATEGORY_GENERAL', 'lock', '{"en": "Safe", "ru": "Сейф"}'),
+    ('balcony', 'AMENITY_CATEGORY_GENERAL', 'balcony', '{"en": "Balcony", "ru": "Балкон"}'),
+    ('private_bathroom', 'AMENITY_CATEGORY_BATHROOM', 'bath', '{"en": "Private bathroom", "ru": "Собственная ванная"}'),
+    ('bathtub', 'AMENITY_CATEGORY_BATHROOM', 'bathtub', '{"en": "Bathtub", "ru": "Ванна"}'),
+    ('shower', 'AMENITY_CATEGORY_BATHROOM', 'shower', '{"en": "Shower", "ru": "Душ"}'),
+    ('hair_dryer', 'AMENITY_CATEGORY_BATHROOM', 'hair-dryer', '{"en": "Hair dryer", "ru": "Фен"}'),
+    ('kitchenette', 'AMENITY_CATEGORY_KITCHEN', 'kitchen', '{"en": "Kitchenette", "ru": "Мини-кухня"}'),
+    ('minibar', 'AMENITY_CATEGORY_FOOD_AND_DRINK', 'minibar', '{"en": "Minibar", "ru": "Мини-бар"}'),
+    ('coffee_machine', 'AMENITY_CATEGORY_FOOD_AND_DRINK', 'coffee', '{"en": "Coffee machine", "ru": "Кофемашина"}'),
+    ('breakfast', 'AMENITY_CATEGORY_FOOD_AND_DRINK', 'croissant', '{"en": "Breakfast", "ru": "Завтрак"}'),
+    ('restaurant', 'AMENITY_CATEGORY_FOOD_AND_DRINK', 'utensils', '{"en": "Restaurant", "ru": "Ресторан"}'),
+    ('bar', 'AMENITY_CATEGORY_FOOD_AND_DRINK', 'glass', '{"en": "Bar", "ru": "Бар"}'),
+    ('pool', 'AMENITY_CATEGORY_WELLNESS', 'pool', '{"en": "Swimming pool", "ru": "Бассейн"}'),
+    ('spa', 'AMENITY_CATEGORY_WELLNESS', 'spa', '{"en": "Spa", "ru": "Спа"}'),
+    ('gym', 'AMENITY_CATEGORY_WELLNESS', 'dumbbell', '{"en": "Fitness centre", "ru": "Фитнес-центр"}'),
+    ('parking', 'AMENITY_CATEGORY_PARKING', 'parking', '{"en": "Parking", "ru": "Парковка"}'),
+    ('ev_charging', 'AMENITY_CATEGORY_PARKING', 'plug', '{"en": "EV charging", "ru": "Зарядка электромобилей"}'),
+    ('airport_shuttle', 'AMENITY_CATEGORY_PARKING', 'bus', '{"en": "Airport shuttle", "ru": "Трансфер из аэропорта"}'),
+    ('elevator', 'AMENITY_CATEGORY_ACCESSIBILITY', 'elevator', '{"en": "Elevator", "ru": "Лифт"}'),
+    ('wheelchair_accessible', 'AMENITY_CATEGORY_ACCESSIBILITY', 'wheelchair', '{"en": "Wheelchair accessible", "ru": "Доступно для инвалидных колясок"}'),
+    ('pets_allowed', 'AMENITY_CATEGORY_OTHER', 'paw', '{"en": "Pets allowed", "ru": "Можно с животными"}'),
+    ('reception_24h', 'AMENITY_CATEGORY_GENERAL', 'bell', '{"en": "24-hour front desk", "ru": "Круглосуточная стойка регистрации"}')
+ON CONFLICT (code) DO NOTHING;
+
+-- Map the free-text room amenities onto the catalogue. Spellings are compared
+-- lower-cased, with ё read as е and everything but letters and digits stripped,
+-- so "Wi-Fi" and "wifi" meet, and so do "Тренажёрный зал" and "тренажерный зал".
+CREATE TEMPORARY TABLE amenity_alias (
+    alias TEXT PRIMARY KEY,
+    code VARCHAR(64) NOT NULL
+) ON COMMIT DROP;
+
+INSERT INTO amenity_alias (alias, code) VALUES
+    ('wifi', 'wifi'), ('wlan', 'wifi'), ('internet', 'wifi'), ('freewifi', 'wifi'),
+    ('ac', 'air_conditioning'), ('aircon', 'air_conditioning'), ('airconditioning', 'air_conditioning'),
+    ('conditioner', 'air_conditioning'), ('heating', 'heating'),
+    ('tv', 'tv'), ('television', 'tv'), ('smarttv', 'tv'), ('flatscreentv', 'tv'),
+    ('safe', 'safe'), ('inroomsafe', 'safe'), ('balcony', 'balcony'),
+    ('bathroom', 'private_bathroom'), ('privatebathroom', 'private_bathroom'), ('ensuite', 'private_bathroom'),
+    ('bath', 'bathtub'), ('bathtub', 'bathtub'), ('shower', 'shower'),
+    ('hairdryer', 'hair_dryer'), ('fen', 'hair_dryer'),
+    ('kitchen', 'kitchenette'), ('kitchenette', 'kitchenette'),
+    ('minibar', 'minibar'), ('fridge', 'minibar'), ('refrigerator', 'minibar'),
+    ('coffee', 'coffee_machine'), ('coffeemachine', 'coffee_machine'), ('coffeemaker', 'coffee_machine'),
+    ('kettle', 'coffee_machine'),
+    ('breakfast', 'breakfast'), ('breakfastincluded', 'breakfast'),
+    ('restaurant', 'restaurant'), ('bar', 'bar'),
+    ('pool', 'pool'), ('swimmingpool', 'pool'), ('spa', 'spa'), ('sauna', 'spa'),
+    ('gym', 'gym'), ('fitness', 'gym'), ('fitnesscenter', 'gym'), ('fitnesscentre', 'gym'),
+    ('parking', 'parking'), ('freeparking', 'parking'), ('evcharging', 'ev_charging'),
+    ('shuttle', 'airport_shuttle'), ('airportshuttle', 'airport_shuttle'), ('transfer', 'airport_shuttle'),
+    ('elevator', 'elevator'), ('lift', 'elevator'),
+    ('wheelchair', 'wheelchair_accessible'), ('wheelchairaccessible', 'wheelchair_accessible'),
+    ('accessible', 'wheelchair_accessible'),
+    ('pets', 'pets_allowed'), ('petfriendly', 'pets_allowed'), ('petsallowed', 'pets_allowed'),
+    ('reception', 'reception_24h'), ('reception24h', 'reception_24h'), ('24hreception', 'reception_24h'),
+    ('frontdesk24h', 'reception_24h'), ('24hourfrontdesk', 'reception_24h'),
+    ('вайфай', 'wifi'), ('интернет', 'wifi'), ('беспроводнойинтернет', 'wifi'), ('бесплатныйwifi', 'wifi'),
+    ('кондиционер', 'air_conditioning'), ('кондиционирование', 'air_conditioning'), ('отопление', 'heating'),
+    ('телевизор', 'tv'), ('тв', 'tv'), ('смарттв', 'tv'), ('кабельноетв', 'tv'), ('спутниковоетв', 'tv'),
+    ('сейф', 'safe'), ('балкон', 'balcony'),
+    ('собственнаяванная', 'private_bathroom'), ('собственнаяваннаякомната', 'private_bathroom'),
+    ('ваннаякомната', 'private_bathroom'), ('санузел', 'private_bathroom'),
+    ('ванна', 'bathtub'), ('душ', 'shower'), ('душеваякабина', 'shower'), ('фен', 'hair_dryer'),
+    ('кухня', 'kitchenette'), ('миникухня', 'kitchenette'), ('кухонныйуголок', 'kitchenette'),
+    ('минибар', 'minibar'), ('холодильник', 'minibar'),
+    ('кофемашина', 'coffee_machine'), ('кофеварка', 'coffee_machine'), ('чайник', 'coffee_machine'),
+    ('завтрак', 'breakfast'), ('завтраквключен', 'breakfast'),
+    ('ресторан', 'restaurant'), ('бар', 'bar'),
+    ('бассейн', 'pool'), ('спа', 'spa'), ('сауна', 'spa'), ('баня', 'spa'),
+    ('фитнесцентр', 'gym'), ('фитнес', 'gym'), ('тренажерныйзал', 'gym'), ('спортзал', 'gym'),
+    ('парковка', 'parking'), ('бесплатнаяпарковка', 'parking'), ('стоянка', 'parking'), ('автостоянка', 'parking'),
+    ('зарядкаэлектромобилей', 'ev_charging'), ('зарядкадляэлектромобилей', 'ev_charging'),
+    ('трансфер', 'airport_shuttle'), ('трансферизаэропорта', 'airport_shuttle'),
+    ('трансферваэропорт', 'airport_shuttle'), ('шаттл', 'airport_shuttle'),
+    ('лифт', 'elevator'),
+    ('доступнодляинвалидныхколясок', 'wheelchair_accessible'), ('дляинвалидов', 'wheelchair_accessible'),
+    ('безбарьернаясреда', 'wheelchair_accessible'),
+    ('можносживотными', 'pets_allowed'), ('размещениесживотными', 'pets_allowed'),
+    ('животныеразрешены', 'pets_allowed'),
+    ('круглосуточнаястойкарегистрации', 'reception_24h'), ('круглосуточнаярецепция', 'reception_24h'),
+    ('ресепшн', 'reception_24h'), ('рецепция', 'reception_24h'), ('стойкарегистрации', 'reception_24h');
+
+CREATE TEMPORARY TABLE legacy_room_amenity ON COMMIT DROP AS
+SELECT DISTINCT r.id AS room_id,
+       trim(raw.value) AS value,
+       regexp_replace(replace(lower(raw.value), 'ё', 'е'), '[^[:alnum:]]', '', 'g') AS alias
+FROM room r
+CROSS JOIN LATERAL unnest(r.amenities) AS raw(value)
+WHERE regexp_replace(raw.value, '[^[:alnum:]]', '', 'g') <> '';
+
+-- Strings nobody anticipated are kept as OTHER entries so no data is lost;
+-- admins can merge them into proper codes afterwards. Those without Latin
+-- letters or digits to build a code from are coded by a hash of the spelling.
+ALTER TABLE legacy_room_amenity ADD COLUMN code VARCHAR(64);
+
+UPDATE legacy_room_amenity l
+SET code = COALESCE(
+    (SELECT a.code FROM amenity_alias a WHERE a.alias = l.alias),
+    left('legacy_' || COALESCE(
+        NULLIF(trim(BOTH '_' FROM regexp_replace(lower(l.value), '[^a-z0-9]+', '_', 'g')), ''),
+        left(md5(l.alias), 12)
+    ), 64)
+);
+
+INSERT INTO amenity (code, category, icon, names)
+SELECT DISTINCT ON (l.code) l.code, 'AMENITY_CATEGORY_OTHER', 'tag', jsonb_build_object('en', l.value)
+FROM legacy_room_amenity l
+WHERE l.code LIKE 'legacy\_%'
+ORDER BY l.code, l.value
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO room_amenity (room_id, amenity_code)
+SELECT l.room_id, l.code
+FROM legacy_room_amenity l
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE room DROP COLUMN IF EXISTS amenities;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE room ADD COLUMN IF NOT EXISTS amenities TEXT[];
+
+UPDATE room r
+SET amenities = ARRAY(
+    SELECT ra.amenity_code
+    FROM room_amenity ra
+    WHERE ra.room_id = r.id
+    ORDER BY ra.amenity_code
+);
+
+DROP TRIGGER IF EXISTS update_amenities_updated_at ON amenity;
+
+DROP TABLE IF EXISTS room_amenity;
+DROP TABLE IF EXISTS hotel_amenity;
+DROP TABLE IF EXISTS amenity;
+
+DROP TYPE IF EXISTS amenity_category;
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS hotel_slug_history (
+    country_code CHAR(2) NOT NULL,
+    city_slug VARCHAR(100) NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    hotel_id UUID NOT NULL REFERENCES hotel(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (country_code, city_slug, slug)
+);
+
+CREATE INDEX IF NOT EXISTS idx_hotel_slug_history_hotel_id ON hotel_slug_history(hotel_id);
+
+-- Keeps the retired slug of a hotel so old links can be redirected. A slug that
+-- becomes live again (renamed back, or taken by another hotel) leaves the history.
+CREATE OR REPLACE FUNCTION record_hotel_slug_history()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.slug IS DISTINCT FROM NEW.slug THEN
+        INSERT INTO hotel_slug_history (country_code, city_slug, slug, hotel_id)
+        VALUES (OLD.country_code, OLD.city_slug, OLD.slug, OLD.id)
+        ON CONFLICT (country_code, city_slug, slug)
+            DO UPDATE SET hotel_id = EXCLUDED.hotel_id, created_at = CURRENT_TIMESTAMP;
+    END IF;
+
+    DELETE FROM hotel_slug_history
+    WHERE country_code = NEW.country_code AND city_slug = NEW.city_slug AND slug = NEW.slug;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_hotels_slug_history
+    AFTER INSERT OR UPDATE OF slug ON hotel
+    FOR EACH ROW
+EXECUTE FUNCTION record_hotel_slug_history();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS record_hotels_slug_history ON hotel;
+
+DROP FUNCTION IF EXISTS record_hotel_slug_history();
+
+DROP TABLE IF EXISTS hotel_slug_history;
+-- +goose StatementEnd
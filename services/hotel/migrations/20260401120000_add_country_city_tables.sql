@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS country (
+    code CHAR(2) PRIMARY KEY CHECK (code ~ '^[a-z]{2}$'),
+    iso_alpha3 CHAR(3) CHECK (iso_alpha3 ~ '^[A-Z]{3}$'),
+    names JSONB NOT NULL CHECK (names ? 'en'),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS city (
+    country_code CHAR(2) NOT NULL REFERENCES country(code) ON UPDATE CASCADE,
+    slug VARCHAR(100) NOT NULL CHECK (slug ~ '^[a-z0-9]+(-[a-z0-9]+)*$'),
+    names JSONB NOT NULL CHECK (names ? 'en'),
+    timezone VARCHAR(64) NOT NULL,
+    location GEOGRAPHY(Point, 4326) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (country_code, slug)
+);
+
+CREATE TRIGGER update_countries_updated_at
+    BEFORE UPDATE ON country
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_cities_updated_at
+    BEFORE UPDATE ON city
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+-- Locations already used by hotels get placeholder rows so the foreign key can be
+-- added; the geo seed overwrites names, timezone and centroid for known places.
+INSERT INTO country (code, names)
+SELECT DISTINCT h.country_code, jsonb_build_object('en', upper(h.country_code))
+FROM hotel h
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO city (country_code, slug, names, timezone, location)
+SELECT h.country_code,
+       h.city_slug,
+       jsonb_build_object('en', initcap(replace(h.city_slug, '-', ' '))),
+       'UTC',
+       ST_Centroid(ST_Collect(h.location::geometry))::geography
+FROM hotel h
+GROUP BY h.country_code, h.city_slug
+ON CONFLICT (country_code, slug) DO NOTHING;
+
+ALTER TABLE hotel
+    ADD CONSTRAINT hotel_city_fkey FOREIGN KEY (country_code, city_slug)
+        REFERENCES city(country_code, slug) ON UPDATE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE hotel DROP CONSTRAINT IF EXISTS hotel_city_fkey;
+
+DROP TRIGGER IF EXISTS update_cities_updated_at ON city;
+DROP TRIGGER IF EXISTS update_countries_updated_at ON country;
+
+DROP TABLE IF EXISTS city;
+DROP TABLE IF EXISTS country;
+-- +goose StatementEnd
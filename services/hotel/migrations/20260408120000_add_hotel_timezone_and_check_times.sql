@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE hotel
+    ADD COLUMN timezone       VARCHAR(64),
+    ADD COLUMN check_in_time  TIME NOT NULL DEFAULT '14:00',
+    ADD COLUMN check_out_time TIME NOT NULL DEFAULT '12:00';
+
+UPDATE hotel h
+SET timezone = c.timezone
+FROM city c
+WHERE c.country_code = h.country_code AND c.slug = h.city_slug;
+
+ALTER TABLE hotel ALTER COLUMN timezone SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE hotel
+    DROP COLUMN IF EXISTS check_out_time,
+    DROP COLUMN IF EXISTS check_in_time,
+    DROP COLUMN IF EXISTS timezone;
+-- +goose StatementEnd
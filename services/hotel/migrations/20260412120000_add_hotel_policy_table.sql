@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS hotel_policy (
+    hotel_id UUID PRIMARY KEY REFERENCES hotel(id) ON DELETE CASCADE,
+    cancellation_tiers JSONB NOT NULL DEFAULT '[]',
+    prepayment_percent SMALLINT NOT NULL DEFAULT 0,
+    child_age_bands JSONB NOT NULL DEFAULT '[]',
+    pets_allowed BOOLEAN NOT NULL DEFAULT FALSE,
+    pet_fee_per_night NUMERIC(12, 2),
+    max_pets SMALLINT NOT NULL DEFAULT 0,
+    latest_check_in_time TIME,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT hotel_policy_prepayment_percent CHECK (prepayment_percent BETWEEN 0 AND 100),
+    CONSTRAINT hotel_policy_pet_fee CHECK (pet_fee_per_night >= 0),
+    CONSTRAINT hotel_policy_max_pets CHECK (max_pets >= 0)
+);
+
+CREATE TRIGGER update_hotel_policies_updated_at
+    BEFORE UPDATE ON hotel_policy
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_hotel_policies_updated_at ON hotel_policy;
+
+DROP TABLE IF EXISTS hotel_policy;
+-- +goose StatementEnd
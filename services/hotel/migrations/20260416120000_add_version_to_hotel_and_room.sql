@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE hotel ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE room ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE room DROP COLUMN IF EXISTS version;
+ALTER TABLE hotel DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE hotel_status AS ENUM ('HOTEL_STATUS_DRAFT',
+                                  'HOTEL_STATUS_PENDING_REVIEW',
+                                  'HOTEL_STATUS_PUBLISHED',
+                                  'HOTEL_STATUS_SUSPENDED');
+
+CREATE TYPE user_role AS ENUM ('USER_ROLE_USER',
+                               'USER_ROLE_MODERATOR',
+                               'USER_ROLE_ADMIN');
+
+ALTER TABLE hotel
+    ADD COLUMN status hotel_status NOT NULL DEFAULT 'HOTEL_STATUS_DRAFT';
+
+-- Hotels created before moderation existed were already public.
+UPDATE hotel
+SET status = 'HOTEL_STATUS_PUBLISHED';
+
+CREATE INDEX IF NOT EXISTS idx_hotel_city_status ON hotel(country_code, city_slug, status);
+
+CREATE TABLE IF NOT EXISTS hotel_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    hotel_id UUID NOT NULL REFERENCES hotel(id) ON DELETE CASCADE,
+    from_status hotel_status NOT NULL,
+    to_status hotel_status NOT NULL,
+    actor_id BIGINT NOT NULL,
+    actor_role user_role NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_hotel_status_history_hotel_id ON hotel_status_history(hotel_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS hotel_status_history;
+
+DROP INDEX IF EXISTS idx_hotel_city_status;
+
+ALTER TABLE hotel
+    DROP COLUMN IF EXISTS status;
+
+DROP TYPE IF EXISTS user_role;
+
+DROP TYPE IF EXISTS hotel_status;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE hotel
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+ALTER TABLE room
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- A deleted room frees its number for a new room of the same hotel. Hotel slugs
+-- stay unique across deleted hotels so old booking links never resolve to another hotel.
+ALTER TABLE room
+    DROP CONSTRAINT IF EXISTS room_hotel_id_room_number_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS room_hotel_id_room_number_live_idx
+    ON room (hotel_id, room_number) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM room
+WHERE deleted_at IS NOT NULL;
+
+DELETE FROM hotel
+WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS room_hotel_id_room_number_live_idx;
+
+ALTER TABLE room
+    ADD CONSTRAINT room_hotel_id_room_number_key UNIQUE (hotel_id, room_number);
+
+ALTER TABLE room
+    DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE hotel
+    DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS room_category (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    hotel_id UUID NOT NULL REFERENCES hotel(id) ON DELETE CASCADE,
+    title VARCHAR(100) NOT NULL,
+    description TEXT,
+    type room_type NOT NULL,
+    price NUMERIC(10,2) NOT NULL CHECK (price > 0),
+    capacity INT NOT NULL CHECK (capacity > 0 AND capacity <= 10),
+    area_sqm NUMERIC(6,2) NOT NULL,
+    images TEXT[],
+    version BIGINT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (hotel_id, title)
+);
+
+CREATE TABLE IF NOT EXISTS room_category_amenity (
+    category_id UUID NOT NULL REFERENCES room_category(id) ON DELETE CASCADE,
+    amenity_code VARCHAR(64) NOT NULL REFERENCES amenity(code) ON UPDATE CASCADE,
+    PRIMARY KEY (category_id, amenity_code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_room_category_hotel ON room_category(hotel_id);
+CREATE INDEX IF NOT EXISTS idx_room_category_amenity_code ON room_category_amenity(amenity_code);
+
+CREATE TRIGGER update_room_categories_updated_at
+    BEFORE UPDATE ON room_category
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+-- Rooms keep their own details; the category groups them for sale and dictates
+-- the price they are quoted at. Categories with live rooms are never deleted, so
+-- SET NULL only detaches soft-deleted rooms.
+ALTER TABLE room ADD COLUMN category_id UUID REFERENCES room_category(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_room_category_id ON room(category_id) WHERE category_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_room_category_id;
+
+ALTER TABLE room DROP COLUMN IF EXISTS category_id;
+
+DROP TRIGGER IF EXISTS update_room_categories_updated_at ON room_category;
+
+DROP TABLE IF EXISTS room_category_amenity;
+DROP TABLE IF EXISTS room_category;
+-- +goose StatementEnd
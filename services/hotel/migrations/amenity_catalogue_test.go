@@ -0,0 +1,106 @@
+package migrations
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+const amenityCatalogue = "20260320120000_add_amenity_catalogue.sql"
+
+var (
+	amenityCategoryRe = regexp.MustCompile(`'(AMENITY_CATEGORY_[A-Z_]+)'`)
+	amenitySeedRe     = regexp.MustCompile(`\('([^']*)', '(AMENITY_CATEGORY_[A-Z_]+)', '[^']*', '(\{[^']*\})'\)`)
+	amenityAliasRe    = regexp.MustCompile(`\('([^']*)', '([^']*)'\)`)
+	amenityCodeRe     = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+// amenityAlias normalizes a spelling the way the migration compares them.
+func amenityAlias(value string) string {
+	value = strings.ReplaceAll(strings.ToLower(value), "ё", "е")
+
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, value)
+}
+
+// statement returns the SQL from the first line starting with prefix up to the
+// semicolon closing it.
+func statement(t *testing.T, sql, prefix string) string {
+	t.Helper()
+
+	start := strings.Index(sql, "\n"+prefix)
+	if start < 0 {
+		t.Fatalf("%s has no %q", amenityCatalogue, prefix)
+	}
+	end := strings.Index(sql[start:], ";")
+
+	return sql[start : start+end]
+}
+
+func TestAmenityCatalogue(t *testing.T) {
+	raw, err := os.ReadFile(amenityCatalogue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql := string(raw)
+
+	categories := make(map[string]bool)
+	for _, m := range amenityCategoryRe.FindAllStringSubmatch(statement(t, sql, "CREATE TYPE amenity_category"), -1) {
+		categories[m[1]] = true
+	}
+
+	seeds := amenitySeedRe.FindAllStringSubmatch(statement(t, sql, "INSERT INTO amenity (code"), -1)
+	if len(seeds) == 0 {
+		t.Fatal("no amenities seeded")
+	}
+	names := make(map[string]map[string]string, len(seeds))
+	for _, m := range seeds {
+		code, category := m[1], m[2]
+		if !amenityCodeRe.MatchString(code) {
+			t.Errorf("code %q breaks amenity_code_format", code)
+		}
+		if !categories[category] {
+			t.Errorf("code %q has unknown category %s", code, category)
+		}
+		var localized map[string]string
+		if err = json.Unmarshal([]byte(m[3]), &localized); err != nil {
+			t.Fatalf("code %q names: %v", code, err)
+		}
+		if localized["en"] == "" || localized["ru"] == "" {
+			t.Errorf("code %q names = %v, want en and ru", code, localized)
+		}
+		names[code] = localized
+	}
+
+	aliases := make(map[string]string)
+	for _, m := range amenityAliasRe.FindAllStringSubmatch(statement(t, sql, "INSERT INTO amenity_alias"), -1) {
+		alias, code := m[1], m[2]
+		if _, exists := aliases[alias]; exists {
+			t.Errorf("alias %q listed twice", alias)
+		}
+		if amenityAlias(alias) != alias {
+			t.Errorf("alias %q is not normalized, want %q", alias, amenityAlias(alias))
+		}
+		if _, exists := names[code]; !exists {
+			t.Errorf("alias %q maps to unknown code %q", alias, code)
+		}
+		aliases[alias] = code
+	}
+
+	// Rooms that spelled an amenity like the catalogue does, by code or by its
+	// English or Russian name, keep it rather than getting a legacy entry.
+	for code, localized := range names {
+		for _, spelling := range []string{code, localized["en"], localized["ru"]} {
+			if got := aliases[amenityAlias(spelling)]; got != code {
+				t.Errorf("%q migrates to %q, want %q", spelling, got, code)
+			}
+		}
+	}
+}
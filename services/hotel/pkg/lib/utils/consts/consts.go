@@ -0,0 +1,13 @@
+package consts
+
+const (
+	MaxQuoteNights = 365
+
+	DefaultCheckInTime  = "14:00"
+	DefaultCheckOutTime = "12:00"
+
+	MaxImageBytes      = 10 << 20
+	MaxImagePixels     = 50_000_000
+	ImageThumbnailSide = 320
+	ImageWebPSide      = 1920
+)
@@ -14,8 +14,10 @@ const (
 	FieldLte             = "field must be ≤ %s, got %v"
 	FieldUUID            = "field must be a valid UUID"
 	FieldDatetime        = "field must be in the format %s"
+	FieldTimezone        = "field must be an IANA timezone name (e.g., 'Asia/Vladivostok')"
 	FieldEnum            = "field must be one of: %s"
 	FieldSlug            = "must contain only lowercase letters, numbers and hyphens (e.g., 'my-hotel-slug')"
+	FieldAmenityCode     = "must be a catalogue amenity code of lowercase letters, numbers and underscores (e.g., 'air_conditioning')"
 
 	MsgHotelNotFound     = "hotel not found"
 	MsgUniqueHotelField  = "hotel title already exists"
@@ -27,6 +29,68 @@ const (
 	MsgInvalidQueryParam = "invalid query parameter"
 	MsgInternalServer    = "internal server error"
 	MsgInvalidJSON       = "invalid JSON body"
+	MsgEmptyPatch        = "request must name at least one field to update"
+	MsgUnknownPatchField = "unknown field %q"
+	MsgVersionMismatch   = "resource was modified by someone else, reload it and retry"
+	MsgInvalidIfMatch    = "If-Match must hold a single ETag returned by this API"
+
+	MsgHotelHasActiveBookings = "hotel has active or upcoming bookings, delete with force to cancel them"
+	MsgRoomHasActiveBookings  = "room has active or upcoming bookings, delete with force to cancel them"
+
+	MsgRatePlanNotFound       = "rate plan not found"
+	MsgRatePlanTargetNotFound = "hotel or room for rate plan not found"
+	MsgInvalidRatePlanID      = "invalid rate plan id"
+	MsgInvalidStayDates       = "invalid stay dates"
+	MsgStayTooLong            = "stay is too long to quote"
+
+	MsgStayRestrictionNotFound       = "stay restriction not found"
+	MsgStayRestrictionTargetNotFound = "hotel or room for stay restriction not found"
+	MsgInvalidStayRestrictionID      = "invalid stay restriction id"
+
+	MsgRoomCategoryNotFound    = "room category not found"
+	MsgInvalidRoomCategoryID   = "invalid room category id"
+	MsgUniqueRoomCategoryTitle = "room category title already exists"
+	MsgRoomCategoryInUse       = "room category still has rooms assigned"
+
+	MsgRoomBlockNotFound  = "room block not found"
+	MsgInvalidRoomBlockID = "invalid room block id"
+	MsgRoomUnavailable    = "room is already booked or blocked for these dates"
+
+	MsgImageNotFound        = "image not found"
+	MsgInvalidImageID       = "invalid image id"
+	MsgImageTooLarge        = "image is too large"
+	MsgUnsupportedImageType = "unsupported image type, expected JPEG, PNG or WebP"
+	MsgInvalidImage         = "image cannot be decoded"
+	MsgImageTargetRequired  = "first upload message must contain the image target"
+	MsgImageOrderMismatch   = "image ids must list every image of the gallery exactly once"
+	MsgInvalidImageBlobKey  = "invalid image blob key"
+
+	MsgAmenityNotFound   = "amenity not found"
+	MsgUniqueAmenityCode = "amenity with this code already exists"
+	MsgAmenityInUse      = "amenity is still linked to hotels or rooms"
+	MsgUnknownAmenity    = "unknown amenity code"
+
+	MsgCityNotFound    = "city is not in the location catalogue"
+	MsgInvalidTimezone = "invalid timezone, expected an IANA name"
+
+	MsgHotelPolicyNotFound      = "hotel policy not found"
+	MsgInvalidCancellationTiers = "cancellation tiers must have distinct hours and a percent only for percent penalties"
+	MsgInvalidChildAgeBands     = "child age bands must not overlap and min age must not exceed max age"
+	MsgInvalidPetPolicy         = "pet fee and limit are only allowed when pets are allowed"
+
+	MsgInvalidHotelTransition = "hotel status does not allow this action"
+	MsgHotelActionForbidden   = "not allowed to change the status of this hotel"
+	MsgReasonRequired         = "a reason is required for this action"
+
+	MsgUnauthenticated = "authentication required"
+	MsgInvalidToken    = "invalid or expired token"
+
+	MsgViolationMinLengthOfStay   = "stay must be at least %d nights, got %d"
+	MsgViolationMaxLengthOfStay   = "stay must be at most %d nights, got %d"
+	MsgViolationClosedToArrival   = "arrival is not allowed on %s"
+	MsgViolationClosedToDeparture = "departure is not allowed on %s"
+	MsgViolationMinAdvanceBooking = "stay must be booked at least %d days in advance, got %d"
+	MsgViolationMaxAdvanceBooking = "stay must be booked at most %d days in advance, got %d"
 )
 
 var (
@@ -40,4 +104,58 @@ var (
 	ErrInvalidQueryParam = errors.New(MsgInvalidQueryParam)
 	ErrInternalServer    = errors.New(MsgInternalServer)
 	ErrInvalidJSON       = errors.New(MsgInvalidJSON)
+	ErrEmptyPatch        = errors.New(MsgEmptyPatch)
+	ErrVersionMismatch   = errors.New(MsgVersionMismatch)
+	ErrInvalidIfMatch    = errors.New(MsgInvalidIfMatch)
+
+	ErrHotelHasActiveBookings = errors.New(MsgHotelHasActiveBookings)
+	ErrRoomHasActiveBookings  = errors.New(MsgRoomHasActiveBookings)
+
+	ErrRatePlanNotFound       = errors.New(MsgRatePlanNotFound)
+	ErrRatePlanTargetNotFound = errors.New(MsgRatePlanTargetNotFound)
+	ErrInvalidRatePlanID      = errors.New(MsgInvalidRatePlanID)
+	ErrInvalidStayDates       = errors.New(MsgInvalidStayDates)
+	ErrStayTooLong            = errors.New(MsgStayTooLong)
+
+	ErrStayRestrictionNotFound       = errors.New(MsgStayRestrictionNotFound)
+	ErrStayRestrictionTargetNotFound = errors.New(MsgStayRestrictionTargetNotFound)
+	ErrInvalidStayRestrictionID      = errors.New(MsgInvalidStayRestrictionID)
+
+	ErrRoomCategoryNotFound    = errors.New(MsgRoomCategoryNotFound)
+	ErrInvalidRoomCategoryID   = errors.New(MsgInvalidRoomCategoryID)
+	ErrUniqueRoomCategoryTitle = errors.New(MsgUniqueRoomCategoryTitle)
+	ErrRoomCategoryInUse       = errors.New(MsgRoomCategoryInUse)
+
+	ErrRoomBlockNotFound  = errors.New(MsgRoomBlockNotFound)
+	ErrInvalidRoomBlockID = errors.New(MsgInvalidRoomBlockID)
+	ErrRoomUnavailable    = errors.New(MsgRoomUnavailable)
+
+	ErrImageNotFound        = errors.New(MsgImageNotFound)
+	ErrInvalidImageID       = errors.New(MsgInvalidImageID)
+	ErrImageTooLarge        = errors.New(MsgImageTooLarge)
+	ErrUnsupportedImageType = errors.New(MsgUnsupportedImageType)
+	ErrInvalidImage         = errors.New(MsgInvalidImage)
+	ErrImageTargetRequired  = errors.New(MsgImageTargetRequired)
+	ErrImageOrderMismatch   = errors.New(MsgImageOrderMismatch)
+	ErrInvalidImageBlobKey  = errors.New(MsgInvalidImageBlobKey)
+
+	ErrAmenityNotFound   = errors.New(MsgAmenityNotFound)
+	ErrUniqueAmenityCode = errors.New(MsgUniqueAmenityCode)
+	ErrAmenityInUse      = errors.New(MsgAmenityInUse)
+	ErrUnknownAmenity    = errors.New(MsgUnknownAmenity)
+
+	ErrCityNotFound    = errors.New(MsgCityNotFound)
+	ErrInvalidTimezone = errors.New(MsgInvalidTimezone)
+
+	ErrHotelPolicyNotFound      = errors.New(MsgHotelPolicyNotFound)
+	ErrInvalidCancellationTiers = errors.New(MsgInvalidCancellationTiers)
+	ErrInvalidChildAgeBands     = errors.New(MsgInvalidChildAgeBands)
+	ErrInvalidPetPolicy         = errors.New(MsgInvalidPetPolicy)
+
+	ErrInvalidHotelTransition = errors.New(MsgInvalidHotelTransition)
+	ErrHotelActionForbidden   = errors.New(MsgHotelActionForbidden)
+	ErrReasonRequired         = errors.New(MsgReasonRequired)
+
+	ErrUnauthenticated = errors.New(MsgUnauthenticated)
+	ErrInvalidToken    = errors.New(MsgInvalidToken)
 )
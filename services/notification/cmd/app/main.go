@@ -0,0 +1,35 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/ilyakaznacheev/cleanenv"
+
+	"notification/internal/app/notification"
+	"notification/internal/config"
+	"notification/pkg/lib/logger"
+)
+
+func main() {
+	if err := cleanenv.ReadConfig(".env", &struct{}{}); err != nil {
+		slog.Warn("failed to load env", "error", err)
+	}
+
+	configPath := os.Getenv("CONFIG_PATH")
+	if configPath == "" {
+		panic("CONFIG_PATH is not set")
+	}
+
+	cfg, err := config.New(configPath)
+	if err != nil {
+		panic("failed to load config: " + err.Error())
+	}
+
+	log := logger.New(cfg.Env, cfg.LogLevel)
+	notificationApp := notification.App{
+		Config: cfg,
+		Logger: log,
+	}
+	notificationApp.MustRun()
+}
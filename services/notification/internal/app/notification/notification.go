@@ -0,0 +1,138 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"notification/internal/channel"
+	"notification/internal/channel/sink"
+	"notification/internal/channel/sms"
+	"notification/internal/channel/smtp"
+	"notification/internal/channel/telegram"
+	"notification/internal/config"
+	"notification/internal/consumer"
+	"notification/internal/repository/postgres"
+	"notification/internal/service"
+	"notification/internal/templates"
+	"notification/internal/utils/consts"
+	"outbox/secret"
+)
+
+type App struct {
+	Config *config.Config
+	Logger *slog.Logger
+}
+
+// MustRun consumes events and delivers notifications until SIGINT or SIGTERM.
+func (app *App) MustRun() {
+	slog.SetDefault(app.Logger)
+
+	repo, err := postgres.New(app.Config)
+	if err != nil {
+		panic(err.Error())
+	}
+	defer repo.Close()
+
+	renderer, err := templates.New(app.Config.Template.DefaultLocale)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	channels, err := newChannels(app.Config)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	resetKey, err := secret.ParseKey(app.Config.PasswordReset.EventKey)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	svc := service.New(repo, renderer, channels, app.Config.Template, app.Config.Dispatch, resetKey)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventConsumer := consumer.New(app.Config.Consumer, svc)
+	defer func() { _ = eventConsumer.Close() }()
+
+	slog.Info("Starting event consumer", "topics", app.Config.Consumer.Topics)
+	go eventConsumer.Run(ctx)
+	go dispatchNotifications(ctx, svc, app.Config.Dispatch)
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+
+	slog.Info("Shutting down notification service")
+}
+
+// dispatchNotifications delivers due notifications until ctx is cancelled,
+// draining full batches right away and polling otherwise.
+func dispatchNotifications(ctx context.Context, svc *service.Service, cfg config.DispatchConfig) {
+	ticker := time.NewTicker(cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := svc.DispatchDue(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to dispatch notifications", "err", err)
+		}
+		if err == nil && n == cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newChannels builds the enabled channels; a channel with no driver is left
+// out and its notifications are not created.
+func newChannels(cfg *config.Config) (map[string]channel.Channel, error) {
+	channels := make(map[string]channel.Channel)
+	configs := map[string]config.ChannelConfig{
+		channel.Email:    cfg.Channels.Email,
+		channel.SMS:      cfg.Channels.SMS,
+		channel.Telegram: cfg.Channels.Telegram,
+	}
+	for name, channelCfg := range configs {
+		if channelCfg.Driver == "" {
+			continue
+		}
+
+		ch, err := newChannel(name, channelCfg, cfg)
+		if err != nil {
+			return nil, err
+		}
+		channels[name] = ch
+		slog.Info("Notification channel enabled", "channel", name, "driver", channelCfg.Driver)
+	}
+
+	return channels, nil
+}
+
+func newChannel(name string, channelCfg config.ChannelConfig, cfg *config.Config) (channel.Channel, error) {
+	switch {
+	case channelCfg.Driver == sink.LogDriver:
+		return sink.NewLog(name), nil
+	case channelCfg.Driver == sink.FileDriver:
+		return sink.NewFile(channelCfg.Dir)
+	case name == channel.Email && channelCfg.Driver == smtp.Name:
+		return smtp.New(cfg.SMTP)
+	case name == channel.SMS && channelCfg.Driver == sms.Name:
+		return sms.New(cfg.SMS), nil
+	case name == channel.Telegram && channelCfg.Driver == telegram.Name:
+		return telegram.New(cfg.Telegram), nil
+	default:
+		return nil, fmt.Errorf("%w: %q for %s", consts.ErrUnknownDriver, channelCfg.Driver, name)
+	}
+}
@@ -0,0 +1,22 @@
+package channel
+
+import "context"
+
+const (
+	Email    = "email"
+	SMS      = "sms"
+	Telegram = "telegram"
+)
+
+// Message is a rendered notification addressed for one channel: an email
+// address, a phone number or a Telegram chat ID. Subject is empty outside
+// email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Channel interface {
+	Send(ctx context.Context, msg Message) error
+}
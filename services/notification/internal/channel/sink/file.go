@@ -0,0 +1,40 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"notification/internal/channel"
+)
+
+const FileDriver = "file"
+
+// File is a development sink writing every message of a channel to its own
+// file in dir.
+type File struct {
+	dir string
+}
+
+func NewFile(dir string) (*File, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &File{dir: dir}, nil
+}
+
+func (f *File) Send(_ context.Context, msg channel.Message) error {
+	file, err := os.CreateTemp(f.dir, time.Now().UTC().Format("20060102-150405")+"-*.txt")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(file, "To: %s\nSubject: %s\n\n%s\n", msg.To, msg.Subject, msg.Body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
@@ -0,0 +1,26 @@
+package sink
+
+import (
+	"context"
+	"log/slog"
+
+	"notification/internal/channel"
+)
+
+const LogDriver = "log"
+
+// Log is a development sink logging the messages of a channel instead of
+// delivering them.
+type Log struct {
+	channel string
+}
+
+func NewLog(channel string) *Log {
+	return &Log{channel: channel}
+}
+
+func (l *Log) Send(ctx context.Context, msg channel.Message) error {
+	slog.InfoContext(ctx, "notification sent to log sink",
+		"channel", l.channel, "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
@@ -0,0 +1,66 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"notification/internal/channel"
+	"notification/internal/config"
+	"notification/internal/utils/consts"
+)
+
+const Name = "gateway"
+
+const requestTimeout = 10 * time.Second
+
+// Sender posts messages to an HTTP SMS gateway as JSON, authenticating with a
+// bearer API key.
+type Sender struct {
+	client *http.Client
+	cfg    config.SMSConfig
+}
+
+type message struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+func New(cfg config.SMSConfig) *Sender {
+	return &Sender{
+		client: &http.Client{Timeout: requestTimeout},
+		cfg:    cfg,
+	}
+}
+
+func (s *Sender) Send(ctx context.Context, msg channel.Message) error {
+	body, err := json.Marshal(message{From: s.cfg.Sender, To: msg.To, Text: msg.Body})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.cfg.APIKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: sms gateway answered %s", consts.ErrDeliveryRejected, resp.Status)
+	}
+
+	return nil
+}
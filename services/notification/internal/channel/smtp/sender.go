@@ -0,0 +1,56 @@
+package smtp
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"notification/internal/channel"
+	"notification/internal/config"
+)
+
+const Name = "smtp"
+
+// Sender mails plain-text UTF-8 messages through an SMTP relay, authenticating
+// only when a username is set.
+type Sender struct {
+	auth smtp.Auth
+	addr string
+	from *mail.Address
+}
+
+func New(cfg config.SMTPConfig) (*Sender, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("smtp from address: %w", err)
+	}
+
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+
+	return &Sender{
+		auth: auth,
+		addr: cfg.Host + ":" + strconv.Itoa(cfg.Port),
+		from: from,
+	}, nil
+}
+
+func (s *Sender) Send(_ context.Context, msg channel.Message) error {
+	var b strings.Builder
+	b.WriteString("From: " + s.from.String() + "\r\n")
+	b.WriteString("To: " + msg.To + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	return smtp.SendMail(s.addr, s.auth, s.from.Address, []string{msg.To}, []byte(b.String()))
+}
@@ -0,0 +1,78 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"notification/internal/channel"
+	"notification/internal/config"
+	"notification/internal/utils/consts"
+)
+
+const Name = "bot"
+
+const requestTimeout = 10 * time.Second
+
+// Sender delivers messages through the Telegram Bot API; the recipient is a
+// chat ID that has started a conversation with the bot.
+type Sender struct {
+	client *http.Client
+	url    string
+}
+
+type sendMessageRequest struct {
+	ChatID string `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+type sendMessageResponse struct {
+	Description string `json:"description"`
+	OK          bool   `json:"ok"`
+}
+
+func New(cfg config.TelegramConfig) *Sender {
+	return &Sender{
+		client: &http.Client{Timeout: requestTimeout},
+		url:    fmt.Sprintf("%s/bot%s/sendMessage", cfg.APIURL, cfg.BotToken),
+	}
+}
+
+func (s *Sender) Send(ctx context.Context, msg channel.Message) error {
+	body, err := json.Marshal(sendMessageRequest{ChatID: msg.To, Text: msg.Body})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		// The request URL carries the bot token, keep it out of the error.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return fmt.Errorf("telegram request: %w", urlErr.Err)
+		}
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result sendMessageResponse
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("%w: telegram answered %s", consts.ErrDeliveryRejected, resp.Status)
+	}
+	if !result.OK {
+		return fmt.Errorf("%w: %s", consts.ErrDeliveryRejected, result.Description)
+	}
+
+	return nil
+}
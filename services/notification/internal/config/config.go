@@ -0,0 +1,109 @@
+package config
+
+import (
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+type PostgresConfig struct {
+	Host     string `yaml:"host"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	DB       string `yaml:"db"`
+	SSLMode  string `yaml:"sslmode"`
+	Port     int    `yaml:"port"`
+}
+
+// ConsumerConfig sets up the Kafka consumer group reading the event topics
+// the other services publish from their outbox.
+type ConsumerConfig struct {
+	GroupID      string        `yaml:"group_id" env:"CONSUMER_GROUP_ID" env-default:"notification"`
+	Brokers      []string      `yaml:"brokers" env:"KAFKA_BROKERS" env-separator:","`
+	Topics       []string      `yaml:"topics" env:"CONSUMER_TOPICS" env-separator:","`
+	RetryBackoff time.Duration `yaml:"retry_backoff" env:"CONSUMER_RETRY_BACKOFF" env-default:"5s"`
+}
+
+// DispatchConfig sets up the worker delivering due notifications. A failed
+// delivery is retried with exponential backoff until MaxAttempts.
+type DispatchConfig struct {
+	PollInterval time.Duration `yaml:"poll_interval" env:"DISPATCH_POLL_INTERVAL" env-default:"1s"`
+	RetryBackoff time.Duration `yaml:"retry_backoff" env:"DISPATCH_RETRY_BACKOFF" env-default:"30s"`
+	BatchSize    int           `yaml:"batch_size" env:"DISPATCH_BATCH_SIZE" env-default:"50"`
+	MaxAttempts  int32         `yaml:"max_attempts" env:"DISPATCH_MAX_ATTEMPTS" env-default:"5"`
+}
+
+// TemplateConfig picks the locale notifications are rendered in when the
+// event does not say, and the links the templates point to.
+type TemplateConfig struct {
+	DefaultLocale    string        `yaml:"default_locale" env:"TEMPLATE_DEFAULT_LOCALE" env-default:"en"`
+	PasswordResetURL string        `yaml:"password_reset_url" env:"TEMPLATE_PASSWORD_RESET_URL"`
+	ReminderLead     time.Duration `yaml:"reminder_lead" env:"TEMPLATE_REMINDER_LEAD" env-default:"24h"`
+}
+
+// ChannelConfig picks how a channel delivers: its real driver, "file" to
+// write the messages to Dir or "log" to log them. An empty driver turns the
+// channel off.
+type ChannelConfig struct {
+	Driver string `yaml:"driver"`
+	Dir    string `yaml:"dir"`
+}
+
+type ChannelsConfig struct {
+	Email    ChannelConfig `yaml:"email"`
+	SMS      ChannelConfig `yaml:"sms"`
+	Telegram ChannelConfig `yaml:"telegram"`
+}
+
+type SMTPConfig struct {
+	Host     string `yaml:"host" env:"SMTP_HOST"`
+	Username string `yaml:"username" env:"SMTP_USERNAME"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD"`
+	From     string `yaml:"from" env:"SMTP_FROM"`
+	Port     int    `yaml:"port" env:"SMTP_PORT" env-default:"587"`
+}
+
+// SMSConfig points at an HTTP SMS gateway taking JSON messages.
+type SMSConfig struct {
+	URL    string `yaml:"url" env:"SMS_URL"`
+	APIKey string `yaml:"api_key" env:"SMS_API_KEY"`
+	Sender string `yaml:"sender" env:"SMS_SENDER"`
+}
+
+type TelegramConfig struct {
+	APIURL   string `yaml:"api_url" env:"TELEGRAM_API_URL" env-default:"https://api.telegram.org"`
+	BotToken string `yaml:"bot_token" env:"TELEGRAM_BOT_TOKEN"`
+}
+
+// PasswordResetConfig holds the base64 key auth seals reset tokens with; it
+// must match the event_key of the auth service.
+type PasswordResetConfig struct {
+	EventKey string `yaml:"event_key" env:"PASSWORD_RESET_EVENT_KEY" env-required:"true"`
+}
+
+type Config struct {
+	Env           string              `yaml:"env"`
+	LogLevel      string              `yaml:"log_level"`
+	Postgres      PostgresConfig      `yaml:"postgres"`
+	Consumer      ConsumerConfig      `yaml:"consumer"`
+	Dispatch      DispatchConfig      `yaml:"dispatch"`
+	Template      TemplateConfig      `yaml:"template"`
+	PasswordReset PasswordResetConfig `yaml:"password_reset"`
+	Channels      ChannelsConfig      `yaml:"channels"`
+	SMTP          SMTPConfig          `yaml:"smtp"`
+	SMS           SMSConfig           `yaml:"sms"`
+	Telegram      TelegramConfig      `yaml:"telegram"`
+}
+
+func New(configPath string) (*Config, error) {
+	var config Config
+	if err := cleanenv.ReadConfig(configPath, &config); err != nil {
+		return nil, err
+	}
+
+	if err := cleanenv.ReadEnv(&config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
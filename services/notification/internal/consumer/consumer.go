@@ -0,0 +1,139 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+
+	"notification/internal/config"
+	"notification/internal/repository/models"
+	"notification/internal/utils/consts"
+)
+
+// Headers the outbox relays of the other services set on every message.
+const (
+	headerEventID   = "event-id"
+	headerEventType = "event-type"
+)
+
+type Handler interface {
+	HandleEvent(ctx context.Context, event *models.Event) error
+}
+
+// Consumer reads the event topics as a consumer group. An offset is committed
+// only once the handler is done with the message, so a crash redelivers it;
+// the handler deduplicates by event ID.
+type Consumer struct {
+	reader       *kafka.Reader
+	handler      Handler
+	retryBackoff time.Duration
+}
+
+func New(cfg config.ConsumerConfig, handler Handler) *Consumer {
+	return &Consumer{
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:     cfg.Brokers,
+			GroupID:     cfg.GroupID,
+			GroupTopics: cfg.Topics,
+			StartOffset: kafka.FirstOffset,
+		}),
+		handler:      handler,
+		retryBackoff: cfg.RetryBackoff,
+	}
+}
+
+// Run consumes until ctx is cancelled.
+func (c *Consumer) Run(ctx context.Context) {
+	for {
+		msg, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			slog.ErrorContext(ctx, "failed to fetch event", "err", err)
+			if !c.wait(ctx) {
+				return
+			}
+			continue
+		}
+
+		if !c.handle(ctx, msg) {
+			return
+		}
+
+		if err = c.reader.CommitMessages(ctx, msg); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to commit event offset", "err", err)
+		}
+	}
+}
+
+func (c *Consumer) Close() error {
+	return c.reader.Close()
+}
+
+// handle retries the handler until it succeeds or the event turns out to be
+// invalid, which is logged and skipped. It reports false when ctx is done.
+func (c *Consumer) handle(ctx context.Context, msg kafka.Message) bool {
+	event, err := newEvent(msg)
+	if err != nil {
+		skipEvent(ctx, msg, err)
+		return true
+	}
+
+	for {
+		err = c.handler.HandleEvent(ctx, event)
+		if err == nil {
+			return true
+		}
+		if errors.Is(err, consts.ErrInvalidEvent) {
+			skipEvent(ctx, msg, err)
+			return true
+		}
+
+		slog.ErrorContext(ctx, "failed to handle event", "event_id", event.ID, "event_type", event.Type, "err", err)
+		if !c.wait(ctx) {
+			return false
+		}
+	}
+}
+
+func (c *Consumer) wait(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(c.retryBackoff):
+		return true
+	}
+}
+
+func skipEvent(ctx context.Context, msg kafka.Message, err error) {
+	slog.WarnContext(ctx, "skipping invalid event",
+		"topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset, "err", err)
+}
+
+func newEvent(msg kafka.Message) (*models.Event, error) {
+	event := &models.Event{Topic: msg.Topic, Payload: msg.Value}
+
+	var eventID string
+	for _, header := range msg.Headers {
+		switch header.Key {
+		case headerEventID:
+			eventID = string(header.Value)
+		case headerEventType:
+			event.Type = string(header.Value)
+		}
+	}
+
+	id, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: event id %q", consts.ErrInvalidEvent, eventID)
+	}
+	event.ID = id
+
+	return event, nil
+}
@@ -0,0 +1,19 @@
+package models
+
+type NotificationKind string
+
+const (
+	NotificationKindBookingConfirmation NotificationKind = "booking_confirmation"
+	NotificationKindBookingCancellation NotificationKind = "booking_cancellation"
+	NotificationKindCheckInReminder     NotificationKind = "check_in_reminder"
+	NotificationKindPasswordReset       NotificationKind = "password_reset"
+)
+
+type NotificationStatus string
+
+const (
+	NotificationStatusPending   NotificationStatus = "PENDING"
+	NotificationStatusSent      NotificationStatus = "SENT"
+	NotificationStatusFailed    NotificationStatus = "FAILED"
+	NotificationStatusCancelled NotificationStatus = "CANCELLED"
+)
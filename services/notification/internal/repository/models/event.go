@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event is a domain event read from a topic. ID comes from the event-id header
+// the outbox relay sets, so a redelivered event keeps its ID.
+type Event struct {
+	ProcessedAt time.Time
+	Topic       string
+	Type        string
+	Payload     []byte
+	ID          uuid.UUID
+}
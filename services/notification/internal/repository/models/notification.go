@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification is a rendered message for one recipient on one channel. It is
+// delivered once SendAt has passed; a check-in reminder is created with a
+// SendAt ahead of the stay.
+type Notification struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	SendAt    time.Time
+	SentAt    *time.Time
+	BookingID *uuid.UUID
+	LastError *string
+	Kind      NotificationKind
+	Status    NotificationStatus
+	Channel   string
+	Recipient string
+	Locale    string
+	Subject   string
+	Body      string
+	Attempts  int32
+	ID        uuid.UUID
+	EventID   uuid.UUID
+}
+
+// DeliveryAttempt is one try to deliver a notification; Error is nil when the
+// channel accepted it.
+type DeliveryAttempt struct {
+	CreatedAt      time.Time
+	Error          *string
+	ID             int64
+	Attempt        int32
+	NotificationID uuid.UUID
+}
+
+// Recipient holds the addresses a notification can be delivered to; a channel
+// is skipped when its address is empty.
+type Recipient struct {
+	Name           string
+	Email          string
+	Phone          string
+	TelegramChatID string
+	Locale         string
+}
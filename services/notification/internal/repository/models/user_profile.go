@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// UserProfile is where a user wants to be notified. UpdatedAt is when auth
+// changed it, so an older event never overwrites a newer profile.
+type UserProfile struct {
+	UpdatedAt      time.Time
+	Locale         *string
+	TelegramChatID *string
+	UserID         int64
+}
@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"notification/internal/repository/models"
+	"notification/internal/repository/postgres/query"
+	"notification/internal/utils/consts"
+)
+
+// CreateProcessedEvent records the event and reports false when it was
+// already processed, which is how redelivered events are skipped.
+func (r *Repository) CreateProcessedEvent(ctx context.Context, tx pgx.Tx, e *models.Event) (bool, error) {
+	if e == nil {
+		return false, consts.ErrNilObject
+	}
+
+	db := r.executor(tx)
+
+	err := db.QueryRow(ctx, query.CreateProcessedEvent, e.ID, e.Type, e.Topic).Scan(&e.ProcessedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *Repository) CreateNotification(
+	ctx context.Context,
+	tx pgx.Tx,
+	n *models.Notification,
+) (*models.Notification, error) {
+	if n == nil {
+		return nil, consts.ErrNilObject
+	}
+
+	db := r.executor(tx)
+
+	notification := *n
+	err := db.QueryRow(
+		ctx,
+		query.CreateNotification,
+		n.EventID,
+		n.Kind,
+		n.Channel,
+		n.Recipient,
+		n.Locale,
+		n.Subject,
+		n.Body,
+		n.BookingID,
+		n.SendAt,
+	).Scan(
+		&notification.ID,
+		&notification.Status,
+		&notification.Attempts,
+		&notification.CreatedAt,
+		&notification.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+// GetDueNotifications locks up to limit notifications due for delivery until tx ends.
+func (r *Repository) GetDueNotifications(ctx context.Context, tx pgx.Tx, limit int) ([]*models.Notification, error) {
+	db := r.executor(tx)
+
+	rows, err := db.Query(ctx, query.SelectDueNotifications, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*models.Notification
+	for rows.Next() {
+		var n models.Notification
+		if err = rows.Scan(
+			&n.ID,
+			&n.EventID,
+			&n.Kind,
+			&n.Channel,
+			&n.Recipient,
+			&n.Locale,
+			&n.Subject,
+			&n.Body,
+			&n.BookingID,
+			&n.Status,
+			&n.Attempts,
+			&n.LastError,
+			&n.SendAt,
+			&n.SentAt,
+			&n.CreatedAt,
+			&n.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, &n)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *Repository) MarkNotificationSent(ctx context.Context, tx pgx.Tx, id uuid.UUID) error {
+	db := r.executor(tx)
+
+	_, err := db.Exec(ctx, query.MarkNotificationSent, id)
+	return err
+}
+
+// MarkNotificationRetry records a failed delivery and retries it at retryAt.
+func (r *Repository) MarkNotificationRetry(
+	ctx context.Context,
+	tx pgx.Tx,
+	id uuid.UUID,
+	lastErr string,
+	retryAt time.Time,
+) error {
+	db := r.executor(tx)
+
+	_, err := db.Exec(ctx, query.MarkNotificationRetry, id, lastErr, retryAt)
+	return err
+}
+
+func (r *Repository) MarkNotificationFailed(ctx context.Context, tx pgx.Tx, id uuid.UUID, lastErr string) error {
+	db := r.executor(tx)
+
+	_, err := db.Exec(ctx, query.MarkNotificationFailed, id, lastErr)
+	return err
+}
+
+// CancelPendingBookingNotifications drops the notifications of kind that have
+// not been delivered for the booking yet, e.g. the reminder of a cancelled stay.
+func (r *Repository) CancelPendingBookingNotifications(
+	ctx context.Context,
+	tx pgx.Tx,
+	bookingID uuid.UUID,
+	kind models.NotificationKind,
+) (int64, error) {
+	db := r.executor(tx)
+
+	tag, err := db.Exec(ctx, query.CancelPendingBookingNotifications, bookingID, kind)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *Repository) CreateDeliveryAttempt(
+	ctx context.Context,
+	tx pgx.Tx,
+	a *models.DeliveryAttempt,
+) (*models.DeliveryAttempt, error) {
+	if a == nil {
+		return nil, consts.ErrNilObject
+	}
+
+	db := r.executor(tx)
+
+	attempt := *a
+	err := db.QueryRow(ctx, query.CreateDeliveryAttempt, a.NotificationID, a.Attempt, a.Error).
+		Scan(&attempt.ID, &attempt.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &attempt, nil
+}
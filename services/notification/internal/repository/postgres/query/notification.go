@@ -0,0 +1,95 @@
+package query
+
+const (
+	// CreateProcessedEvent returns no row when the event was processed before.
+	CreateProcessedEvent = `
+		INSERT INTO processed_event (event_id, event_type, topic)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (event_id) DO NOTHING
+		RETURNING processed_at;`
+
+	CreateNotification = `
+		INSERT INTO notification (
+			event_id,
+			kind,
+			channel,
+			recipient,
+			locale,
+			subject,
+			body,
+			booking_id,
+			send_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, status, attempts, created_at, updated_at;`
+
+	// SelectDueNotifications locks the pending notifications whose time has
+	// come and skips the ones another dispatcher is already delivering.
+	SelectDueNotifications = `
+		SELECT
+			id,
+			event_id,
+			kind,
+			channel,
+			recipient,
+			locale,
+			subject,
+			body,
+			booking_id,
+			status,
+			attempts,
+			last_error,
+			send_at,
+			sent_at,
+			created_at,
+			updated_at
+		FROM notification
+		WHERE status = 'PENDING'
+		  AND send_at <= now()
+		ORDER BY send_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED;`
+
+	// MarkNotificationSent and MarkNotificationFailed drop the body of a
+	// password reset once it is no longer going to be sent: it holds a live
+	// reset link.
+	MarkNotificationSent = `
+		UPDATE notification
+		SET status = 'SENT',
+			attempts = attempts + 1,
+			last_error = NULL,
+			body = CASE WHEN kind = 'password_reset' THEN '' ELSE body END,
+			sent_at = now(),
+			updated_at = now()
+		WHERE id = $1;`
+
+	MarkNotificationRetry = `
+		UPDATE notification
+		SET attempts = attempts + 1,
+			last_error = $2,
+			send_at = $3,
+			updated_at = now()
+		WHERE id = $1;`
+
+	MarkNotificationFailed = `
+		UPDATE notification
+		SET status = 'FAILED',
+			attempts = attempts + 1,
+			last_error = $2,
+			body = CASE WHEN kind = 'password_reset' THEN '' ELSE body END,
+			updated_at = now()
+		WHERE id = $1;`
+
+	CancelPendingBookingNotifications = `
+		UPDATE notification
+		SET status = 'CANCELLED',
+			updated_at = now()
+		WHERE booking_id = $1
+		  AND kind = $2
+		  AND status = 'PENDING';`
+
+	CreateDeliveryAttempt = `
+		INSERT INTO delivery_attempt (notification_id, attempt, error)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at;`
+)
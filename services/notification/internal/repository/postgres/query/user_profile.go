@@ -0,0 +1,19 @@
+package query
+
+const (
+	// UpsertUserProfile keeps the newest profile when events arrive out of
+	// order or are redelivered.
+	UpsertUserProfile = `
+		INSERT INTO user_profile (user_id, locale, telegram_chat_id, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE
+		SET locale = EXCLUDED.locale,
+			telegram_chat_id = EXCLUDED.telegram_chat_id,
+			updated_at = EXCLUDED.updated_at
+		WHERE user_profile.updated_at <= EXCLUDED.updated_at;`
+
+	GetUserProfile = `
+		SELECT locale, telegram_chat_id, updated_at
+		FROM user_profile
+		WHERE user_id = $1;`
+)
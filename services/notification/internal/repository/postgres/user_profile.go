@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"notification/internal/repository/models"
+	"notification/internal/repository/postgres/query"
+	"notification/internal/utils/consts"
+)
+
+func (r *Repository) UpsertUserProfile(ctx context.Context, tx pgx.Tx, p *models.UserProfile) error {
+	if p == nil {
+		return consts.ErrNilObject
+	}
+
+	db := r.executor(tx)
+
+	_, err := db.Exec(ctx, query.UpsertUserProfile, p.UserID, p.Locale, p.TelegramChatID, p.UpdatedAt)
+	return err
+}
+
+// GetUserProfile returns nil when auth never published a profile for the user.
+func (r *Repository) GetUserProfile(ctx context.Context, tx pgx.Tx, userID int64) (*models.UserProfile, error) {
+	db := r.executor(tx)
+
+	p := &models.UserProfile{UserID: userID}
+	err := db.QueryRow(ctx, query.GetUserProfile, userID).Scan(&p.Locale, &p.TelegramChatID, &p.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"notification/internal/channel"
+	"notification/internal/repository/models"
+	"notification/internal/utils/consts"
+	"outbox"
+)
+
+// DispatchDue delivers the notifications that are due and reports how many it
+// picked up. Every try is recorded as a delivery attempt; a failed delivery is
+// retried with exponential backoff until MaxAttempts.
+func (s *Service) DispatchDue(ctx context.Context) (int, error) {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	notifications, err := s.repo.GetDueNotifications(ctx, tx, s.dispatch.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, n := range notifications {
+		if err = s.deliver(ctx, tx, n); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return len(notifications), nil
+}
+
+func (s *Service) deliver(ctx context.Context, tx pgx.Tx, n *models.Notification) error {
+	sendErr := consts.ErrChannelUnavailable
+	if ch, ok := s.channels[n.Channel]; ok {
+		sendErr = ch.Send(ctx, channel.Message{To: n.Recipient, Subject: n.Subject, Body: n.Body})
+	}
+
+	attempt := &models.DeliveryAttempt{Attempt: n.Attempts + 1, NotificationID: n.ID}
+	if sendErr != nil {
+		lastErr := sendErr.Error()
+		attempt.Error = &lastErr
+	}
+	if _, err := s.repo.CreateDeliveryAttempt(ctx, tx, attempt); err != nil {
+		return err
+	}
+
+	if sendErr == nil {
+		return s.repo.MarkNotificationSent(ctx, tx, n.ID)
+	}
+
+	slog.WarnContext(ctx, "failed to deliver notification",
+		"notification_id", n.ID, "channel", n.Channel, "attempt", attempt.Attempt, "err", sendErr)
+
+	if attempt.Attempt >= s.dispatch.MaxAttempts || errors.Is(sendErr, consts.ErrChannelUnavailable) {
+		return s.repo.MarkNotificationFailed(ctx, tx, n.ID, sendErr.Error())
+	}
+
+	retryAt := time.Now().Add(outbox.Backoff(s.dispatch.RetryBackoff, n.Attempts))
+	return s.repo.MarkNotificationRetry(ctx, tx, n.ID, sendErr.Error(), retryAt)
+}
@@ -0,0 +1,374 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+
+	userv1 "auth/api/user/v1"
+	bookingv1 "booking/api/booking/v1"
+	"notification/internal/channel"
+	"notification/internal/repository/models"
+	"notification/internal/templates"
+	"notification/internal/utils/consts"
+	"outbox/secret"
+)
+
+// Event types published by the booking and auth outboxes.
+const (
+	EventBookingConfirmed           = "booking.confirmed"
+	EventBookingCancelled           = "booking.cancelled"
+	EventBookingModified            = "booking.modified"
+	EventUserProfileUpdated         = "user.profile_updated"
+	EventUserPasswordResetRequested = "user.password_reset_requested"
+)
+
+// notice is a notification to render for every channel the recipient can be
+// reached on.
+type notice struct {
+	sendAt    time.Time
+	bookingID *uuid.UUID
+	recipient models.Recipient
+	kind      models.NotificationKind
+	data      templates.Data
+}
+
+// plan is what an event asks for: notices to send and, for a cancelled or
+// modified booking, the pending reminders to drop before they are sent. The notices of a booking go to its
+// user, whose profile sets their locale and Telegram chat; a user event
+// carries the profile to store instead.
+type plan struct {
+	cancelReminders *uuid.UUID
+	profile         *models.UserProfile
+	userID          int64
+	notices         []notice
+}
+
+// HandleEvent turns an event into notifications. The event ID is recorded in
+// the same transaction, so a redelivered event is skipped. Events no template
+// is interested in are ignored.
+func (s *Service) HandleEvent(ctx context.Context, event *models.Event) error {
+	p, err := s.planEvent(event, time.Now())
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return nil
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	created, err := s.repo.CreateProcessedEvent(ctx, tx, event)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to record processed event", "err", err)
+		return err
+	}
+	if !created {
+		slog.DebugContext(ctx, "skipping duplicate event", "event_id", event.ID, "event_type", event.Type)
+		return nil
+	}
+
+	if p.profile != nil {
+		if err = s.repo.UpsertUserProfile(ctx, tx, p.profile); err != nil {
+			slog.ErrorContext(ctx, "failed to store user profile", "err", err)
+			return err
+		}
+	}
+
+	if p.userID != 0 {
+		profile, err := s.repo.GetUserProfile(ctx, tx, p.userID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get user profile", "err", err)
+			return err
+		}
+		for i := range p.notices {
+			p.notices[i].recipient = withProfile(p.notices[i].recipient, profile)
+		}
+	}
+
+	if p.cancelReminders != nil {
+		_, err = s.repo.CancelPendingBookingNotifications(
+			ctx, tx, *p.cancelReminders, models.NotificationKindCheckInReminder,
+		)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to cancel check-in reminders", "err", err)
+			return err
+		}
+	}
+
+	for _, n := range p.notices {
+		if err = s.createNotifications(ctx, tx, event.ID, n); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *Service) planEvent(event *models.Event, now time.Time) (*plan, error) {
+	switch event.Type {
+	case EventBookingConfirmed:
+		var e bookingv1.BookingConfirmed
+		if err := unmarshalEvent(event, &e); err != nil {
+			return nil, err
+		}
+		return s.planBookingConfirmed(&e, now)
+	case EventBookingCancelled:
+		var e bookingv1.BookingCancelled
+		if err := unmarshalEvent(event, &e); err != nil {
+			return nil, err
+		}
+		return planBookingCancelled(&e, now)
+	case EventBookingModified:
+		var e bookingv1.BookingModified
+		if err := unmarshalEvent(event, &e); err != nil {
+			return nil, err
+		}
+		return s.planBookingModified(&e, now)
+	case EventUserProfileUpdated:
+		var e userv1.UserProfileUpdated
+		if err := unmarshalEvent(event, &e); err != nil {
+			return nil, err
+		}
+		return planProfileUpdated(&e), nil
+	case EventUserPasswordResetRequested:
+		var e userv1.UserPasswordResetRequested
+		if err := unmarshalEvent(event, &e); err != nil {
+			return nil, err
+		}
+		return s.planPasswordReset(&e, now)
+	default:
+		return nil, nil
+	}
+}
+
+// planBookingConfirmed confirms the booking right away and schedules the
+// check-in reminder ReminderLead before check-in, unless that has passed.
+func (s *Service) planBookingConfirmed(e *bookingv1.BookingConfirmed, now time.Time) (*plan, error) {
+	bookingID, err := parseBookingID(e.GetBookingId())
+	if err != nil {
+		return nil, err
+	}
+
+	recipient := bookingRecipient(e.GetGuestName(), e.GetGuestEmail(), e.GetGuestPhone())
+	data := templates.Data{
+		CheckIn:   e.GetCheckIn().AsTime(),
+		CheckOut:  e.GetCheckOut().AsTime(),
+		GuestName: e.GetGuestName(),
+		BookingID: e.GetBookingId(),
+	}
+
+	p := &plan{userID: e.GetUserId(), notices: []notice{{
+		sendAt:    now,
+		bookingID: &bookingID,
+		recipient: recipient,
+		kind:      models.NotificationKindBookingConfirmation,
+		data:      data,
+	}}}
+
+	if reminder, ok := s.checkInReminder(bookingID, recipient, data, now); ok {
+		p.notices = append(p.notices, reminder)
+	}
+
+	return p, nil
+}
+
+// planBookingModified drops the pending check-in reminder of the booking,
+// which may be set for the old stay or guest, and schedules it again while
+// the booking is confirmed.
+func (s *Service) planBookingModified(e *bookingv1.BookingModified, now time.Time) (*plan, error) {
+	bookingID, err := parseBookingID(e.GetBookingId())
+	if err != nil {
+		return nil, err
+	}
+
+	p := &plan{cancelReminders: &bookingID, userID: e.GetUserId()}
+	if e.GetStatus() != bookingv1.BookingStatus_BOOKING_STATUS_CONFIRMED {
+		return p, nil
+	}
+
+	recipient := bookingRecipient(e.GetGuestName(), e.GetGuestEmail(), e.GetGuestPhone())
+	data := templates.Data{
+		CheckIn:   e.GetCheckIn().AsTime(),
+		CheckOut:  e.GetCheckOut().AsTime(),
+		GuestName: e.GetGuestName(),
+		BookingID: e.GetBookingId(),
+	}
+	if reminder, ok := s.checkInReminder(bookingID, recipient, data, now); ok {
+		p.notices = append(p.notices, reminder)
+	}
+
+	return p, nil
+}
+
+// checkInReminder is the reminder sent ReminderLead before check-in; there is
+// none once that moment has passed.
+func (s *Service) checkInReminder(
+	bookingID uuid.UUID,
+	recipient models.Recipient,
+	data templates.Data,
+	now time.Time,
+) (notice, bool) {
+	remindAt := data.CheckIn.Add(-s.template.ReminderLead)
+	if !remindAt.After(now) {
+		return notice{}, false
+	}
+
+	return notice{
+		sendAt:    remindAt,
+		bookingID: &bookingID,
+		recipient: recipient,
+		kind:      models.NotificationKindCheckInReminder,
+		data:      data,
+	}, true
+}
+
+func planBookingCancelled(e *bookingv1.BookingCancelled, now time.Time) (*plan, error) {
+	bookingID, err := parseBookingID(e.GetBookingId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &plan{
+		cancelReminders: &bookingID,
+		userID:          e.GetUserId(),
+		notices: []notice{{
+			sendAt:    now,
+			bookingID: &bookingID,
+			recipient: bookingRecipient(e.GetGuestName(), e.GetGuestEmail(), e.GetGuestPhone()),
+			kind:      models.NotificationKindBookingCancellation,
+			data: templates.Data{
+				GuestName: e.GetGuestName(),
+				BookingID: e.GetBookingId(),
+				Reason:    e.GetReason(),
+			},
+		}},
+	}, nil
+}
+
+func planProfileUpdated(e *userv1.UserProfileUpdated) *plan {
+	return &plan{profile: &models.UserProfile{
+		UpdatedAt:      e.GetOccurredAt().AsTime(),
+		Locale:         e.Locale,
+		TelegramChatID: e.TelegramChatId,
+		UserID:         e.GetUserId(),
+	}}
+}
+
+func (s *Service) planPasswordReset(e *userv1.UserPasswordResetRequested, now time.Time) (*plan, error) {
+	token, err := secret.Open(s.resetKey, e.GetSealedResetToken())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", consts.ErrInvalidEvent, EventUserPasswordResetRequested, err)
+	}
+
+	resetURL, err := url.Parse(s.template.PasswordResetURL)
+	if err != nil {
+		return nil, err
+	}
+	query := resetURL.Query()
+	query.Set("token", string(token))
+	resetURL.RawQuery = query.Encode()
+
+	return &plan{notices: []notice{{
+		sendAt:    now,
+		recipient: models.Recipient{Email: e.GetEmail()},
+		kind:      models.NotificationKindPasswordReset,
+		data: templates.Data{
+			ExpiresAt: e.GetExpiresAt().AsTime(),
+			ResetURL:  resetURL.String(),
+		},
+	}}}, nil
+}
+
+// createNotifications renders n once and stores a notification for every
+// enabled channel the recipient has an address for.
+func (s *Service) createNotifications(ctx context.Context, tx pgx.Tx, eventID uuid.UUID, n notice) error {
+	content, err := s.renderer.Render(n.recipient.Locale, n.kind, n.data)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to render notification", "kind", n.kind, "err", err)
+		return err
+	}
+
+	addresses := []struct {
+		channel string
+		to      string
+	}{
+		{channel.Email, n.recipient.Email},
+		{channel.SMS, n.recipient.Phone},
+		{channel.Telegram, n.recipient.TelegramChatID},
+	}
+	for _, address := range addresses {
+		if address.to == "" || s.channels[address.channel] == nil {
+			continue
+		}
+
+		notification := &models.Notification{
+			SendAt:    n.sendAt,
+			BookingID: n.bookingID,
+			Kind:      n.kind,
+			Channel:   address.channel,
+			Recipient: address.to,
+			Locale:    content.Locale,
+			Subject:   content.Subject,
+			Body:      content.Body,
+			EventID:   eventID,
+		}
+		if address.channel != channel.Email {
+			notification.Subject = ""
+			notification.Body = content.Text
+		}
+
+		if _, err = s.repo.CreateNotification(ctx, tx, notification); err != nil {
+			slog.ErrorContext(ctx, "failed to create notification", "err", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func bookingRecipient(name, email, phone string) models.Recipient {
+	return models.Recipient{Name: name, Email: email, Phone: phone}
+}
+
+// withProfile fills in the locale and Telegram chat the user chose; without a
+// profile the recipient keeps the default locale and is not sent to Telegram.
+func withProfile(r models.Recipient, profile *models.UserProfile) models.Recipient {
+	if profile == nil {
+		return r
+	}
+	if profile.Locale != nil {
+		r.Locale = *profile.Locale
+	}
+	if profile.TelegramChatID != nil {
+		r.TelegramChatID = *profile.TelegramChatID
+	}
+
+	return r
+}
+
+func parseBookingID(id string) (uuid.UUID, error) {
+	bookingID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: booking id %q", consts.ErrInvalidEvent, id)
+	}
+	return bookingID, nil
+}
+
+func unmarshalEvent(event *models.Event, msg proto.Message) error {
+	if err := proto.Unmarshal(event.Payload, msg); err != nil {
+		return fmt.Errorf("%w: %s: %w", consts.ErrInvalidEvent, event.Type, err)
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userv1 "auth/api/user/v1"
+	bookingv1 "booking/api/booking/v1"
+	"notification/internal/channel"
+	"notification/internal/config"
+	"notification/internal/repository/models"
+	"notification/internal/templates"
+	"notification/internal/utils/consts"
+	"outbox/secret"
+)
+
+type fakeTx struct {
+	pgx.Tx
+}
+
+func (fakeTx) Commit(context.Context) error   { return nil }
+func (fakeTx) Rollback(context.Context) error { return nil }
+
+// fakeRepo keeps profiles and notifications in memory; everything the tests do
+// not touch panics via the nil embedded interface.
+type fakeRepo struct {
+	Repository
+
+	profiles      map[int64]*models.UserProfile
+	notifications []*models.Notification
+}
+
+func (r *fakeRepo) BeginTx(context.Context) (pgx.Tx, error) {
+	return fakeTx{}, nil
+}
+
+func (r *fakeRepo) CreateProcessedEvent(context.Context, pgx.Tx, *models.Event) (bool, error) {
+	return true, nil
+}
+
+func (r *fakeRepo) UpsertUserProfile(_ context.Context, _ pgx.Tx, p *models.UserProfile) error {
+	r.profiles[p.UserID] = p
+	return nil
+}
+
+func (r *fakeRepo) GetUserProfile(_ context.Context, _ pgx.Tx, userID int64) (*models.UserProfile, error) {
+	return r.profiles[userID], nil
+}
+
+func (r *fakeRepo) CreateNotification(_ context.Context, _ pgx.Tx, n *models.Notification) (*models.Notification, error) {
+	r.notifications = append(r.notifications, n)
+	return n, nil
+}
+
+// CancelPendingBookingNotifications treats a notification without a status as
+// pending, the way the column defaults.
+func (r *fakeRepo) CancelPendingBookingNotifications(
+	_ context.Context, _ pgx.Tx, bookingID uuid.UUID, kind models.NotificationKind,
+) (int64, error) {
+	var cancelled int64
+	for _, n := range r.notifications {
+		if n.BookingID != nil && *n.BookingID == bookingID && n.Kind == kind && n.Status == "" {
+			n.Status = models.NotificationStatusCancelled
+			cancelled++
+		}
+	}
+
+	return cancelled, nil
+}
+
+type nopChannel struct{}
+
+func (nopChannel) Send(context.Context, channel.Message) error { return nil }
+
+func newEvent(t *testing.T, eventType string, msg proto.Message) *models.Event {
+	t.Helper()
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &models.Event{ID: uuid.New(), Type: eventType, Payload: payload}
+}
+
+func TestHandleEventBookingRecipientProfile(t *testing.T) {
+	const userID = 42
+	confirmed := &bookingv1.BookingConfirmed{
+		BookingId:  uuid.NewString(),
+		UserId:     userID,
+		CheckIn:    timestamppb.New(time.Now().Add(2 * time.Hour)),
+		CheckOut:   timestamppb.New(time.Now().Add(50 * time.Hour)),
+		GuestName:  "Anna",
+		GuestEmail: proto.String("anna@example.com"),
+	}
+
+	tests := []struct {
+		name    string
+		profile *userv1.UserProfileUpdated
+		want    map[string]string
+	}{
+		{
+			name: "without a profile",
+			want: map[string]string{"anna@example.com": "en"},
+		},
+		{
+			name: "with a profile",
+			profile: &userv1.UserProfileUpdated{
+				UserId:         userID,
+				Locale:         proto.String("ru"),
+				TelegramChatId: proto.String("100500"),
+				OccurredAt:     timestamppb.Now(),
+			},
+			want: map[string]string{"anna@example.com": "ru", "100500": "ru"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer, err := templates.New("en")
+			if err != nil {
+				t.Fatal(err)
+			}
+			repo := &fakeRepo{profiles: make(map[int64]*models.UserProfile)}
+			channels := map[string]channel.Channel{channel.Email: nopChannel{}, channel.Telegram: nopChannel{}}
+			svc := New(repo, renderer, channels, config.TemplateConfig{ReminderLead: time.Hour}, config.DispatchConfig{}, nil)
+
+			ctx := context.Background()
+			if tt.profile != nil {
+				if err = svc.HandleEvent(ctx, newEvent(t, EventUserProfileUpdated, tt.profile)); err != nil {
+					t.Fatalf("HandleEvent(profile) error = %v", err)
+				}
+			}
+			if err = svc.HandleEvent(ctx, newEvent(t, EventBookingConfirmed, confirmed)); err != nil {
+				t.Fatalf("HandleEvent(confirmed) error = %v", err)
+			}
+
+			got := make(map[string]string)
+			for _, n := range repo.notifications {
+				got[n.Recipient] = n.Locale
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("recipients = %v, want %v", got, tt.want)
+			}
+			for to, locale := range tt.want {
+				if got[to] != locale {
+					t.Errorf("recipient %s locale = %q, want %q", to, got[to], locale)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleEventPasswordReset(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, secret.KeySize)
+	sealed, err := secret.Seal(key, []byte("s3cr3t"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		key     []byte
+		wantErr error
+		wantURL string
+	}{
+		{
+			name:    "shared key",
+			key:     key,
+			wantURL: "http://localhost/reset?token=s3cr3t",
+		},
+		{
+			name:    "other key",
+			key:     bytes.Repeat([]byte{8}, secret.KeySize),
+			wantErr: consts.ErrInvalidEvent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer, err := templates.New("en")
+			if err != nil {
+				t.Fatal(err)
+			}
+			repo := &fakeRepo{profiles: make(map[int64]*models.UserProfile)}
+			channels := map[string]channel.Channel{channel.Email: nopChannel{}}
+			tmpl := config.TemplateConfig{PasswordResetURL: "http://localhost/reset"}
+			svc := New(repo, renderer, channels, tmpl, config.DispatchConfig{}, tt.key)
+
+			requested := &userv1.UserPasswordResetRequested{
+				UserId:           42,
+				Email:            "anna@example.com",
+				SealedResetToken: sealed,
+				ExpiresAt:        timestamppb.New(time.Now().Add(time.Hour)),
+				OccurredAt:       timestamppb.Now(),
+			}
+			err = svc.HandleEvent(context.Background(), newEvent(t, EventUserPasswordResetRequested, requested))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("HandleEvent() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if len(repo.notifications) != 0 {
+					t.Errorf("notifications = %d, want none", len(repo.notifications))
+				}
+				return
+			}
+
+			if len(repo.notifications) != 1 {
+				t.Fatalf("notifications = %d, want 1", len(repo.notifications))
+			}
+			if body := repo.notifications[0].Body; !strings.Contains(body, tt.wantURL) {
+				t.Errorf("body %q does not contain %q", body, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestHandleEventBookingModifiedReschedulesReminder(t *testing.T) {
+	bookingID := uuid.NewString()
+	checkIn := time.Now().Add(72 * time.Hour)
+	movedCheckIn := checkIn.Add(48 * time.Hour)
+
+	tests := []struct {
+		name   string
+		status bookingv1.BookingStatus
+		want   []time.Time
+	}{
+		{
+			name:   "confirmed",
+			status: bookingv1.BookingStatus_BOOKING_STATUS_CONFIRMED,
+			want:   []time.Time{movedCheckIn.Add(-time.Hour)},
+		},
+		{
+			name:   "checked in",
+			status: bookingv1.BookingStatus_BOOKING_STATUS_CHECKED_IN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer, err := templates.New("en")
+			if err != nil {
+				t.Fatal(err)
+			}
+			repo := &fakeRepo{profiles: make(map[int64]*models.UserProfile)}
+			channels := map[string]channel.Channel{channel.Email: nopChannel{}}
+			svc := New(repo, renderer, channels, config.TemplateConfig{ReminderLead: time.Hour}, config.DispatchConfig{}, nil)
+
+			ctx := context.Background()
+			confirmed := &bookingv1.BookingConfirmed{
+				BookingId:  bookingID,
+				UserId:     42,
+				CheckIn:    timestamppb.New(checkIn),
+				CheckOut:   timestamppb.New(checkIn.Add(24 * time.Hour)),
+				GuestName:  "Anna",
+				GuestEmail: proto.String("anna@example.com"),
+			}
+			if err = svc.HandleEvent(ctx, newEvent(t, EventBookingConfirmed, confirmed)); err != nil {
+				t.Fatalf("HandleEvent(confirmed) error = %v", err)
+			}
+
+			modified := &bookingv1.BookingModified{
+				BookingId:  bookingID,
+				UserId:     42,
+				Status:     tt.status,
+				CheckIn:    timestamppb.New(movedCheckIn),
+				CheckOut:   timestamppb.New(movedCheckIn.Add(24 * time.Hour)),
+				GuestName:  "Anna",
+				GuestEmail: proto.String("anna@example.com"),
+			}
+			if err = svc.HandleEvent(ctx, newEvent(t, EventBookingModified, modified)); err != nil {
+				t.Fatalf("HandleEvent(modified) error = %v", err)
+			}
+
+			var pending []time.Time
+			for _, n := range repo.notifications {
+				if n.Kind == models.NotificationKindCheckInReminder && n.Status == "" {
+					pending = append(pending, n.SendAt)
+				}
+			}
+			if len(pending) != len(tt.want) {
+				t.Fatalf("pending reminders = %v, want %v", pending, tt.want)
+			}
+			for i, at := range tt.want {
+				if !pending[i].Equal(at) {
+					t.Errorf("reminder %d at %s, want %s", i, pending[i], at)
+				}
+			}
+		})
+	}
+}
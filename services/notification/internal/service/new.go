@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"notification/internal/channel"
+	"notification/internal/config"
+	"notification/internal/repository/models"
+	"notification/internal/templates"
+)
+
+type TransactionRepository interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+}
+
+type EventRepository interface {
+	CreateProcessedEvent(ctx context.Context, tx pgx.Tx, e *models.Event) (bool, error)
+}
+
+type NotificationRepository interface {
+	CreateNotification(ctx context.Context, tx pgx.Tx, n *models.Notification) (*models.Notification, error)
+	GetDueNotifications(ctx context.Context, tx pgx.Tx, limit int) ([]*models.Notification, error)
+	MarkNotificationSent(ctx context.Context, tx pgx.Tx, id uuid.UUID) error
+	MarkNotificationRetry(ctx context.Context, tx pgx.Tx, id uuid.UUID, lastErr string, retryAt time.Time) error
+	MarkNotificationFailed(ctx context.Context, tx pgx.Tx, id uuid.UUID, lastErr string) error
+	CancelPendingBookingNotifications(
+		ctx context.Context, tx pgx.Tx, bookingID uuid.UUID, kind models.NotificationKind,
+	) (int64, error)
+	CreateDeliveryAttempt(ctx context.Context, tx pgx.Tx, a *models.DeliveryAttempt) (*models.DeliveryAttempt, error)
+}
+
+type UserProfileRepository interface {
+	UpsertUserProfile(ctx context.Context, tx pgx.Tx, p *models.UserProfile) error
+	GetUserProfile(ctx context.Context, tx pgx.Tx, userID int64) (*models.UserProfile, error)
+}
+
+type Repository interface {
+	TransactionRepository
+	EventRepository
+	NotificationRepository
+	UserProfileRepository
+}
+
+type Renderer interface {
+	Render(locale string, kind models.NotificationKind, data templates.Data) (*templates.Content, error)
+}
+
+type Service struct {
+	repo     Repository
+	renderer Renderer
+	channels map[string]channel.Channel
+	template config.TemplateConfig
+	dispatch config.DispatchConfig
+	resetKey []byte
+}
+
+// New sets up the service; resetKey opens the reset tokens auth seals into
+// user.password_reset_requested.
+func New(
+	repo Repository,
+	renderer Renderer,
+	channels map[string]channel.Channel,
+	template config.TemplateConfig,
+	dispatch config.DispatchConfig,
+	resetKey []byte,
+) *Service {
+	return &Service{
+		repo:     repo,
+		renderer: renderer,
+		channels: channels,
+		template: template,
+		dispatch: dispatch,
+		resetKey: resetKey,
+	}
+}
@@ -0,0 +1,125 @@
+package templates
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strings"
+	"text/template"
+	"time"
+
+	"notification/internal/repository/models"
+	"notification/internal/utils/consts"
+)
+
+//go:embed files
+var files embed.FS
+
+// dateLayouts formats dates the way each locale writes them. Stay dates are
+// calendar dates, so they are always formatted in UTC.
+var dateLayouts = map[string]string{
+	"en": "Jan 2, 2006",
+	"ru": "02.01.2006",
+}
+
+// Data is what the templates can refer to; an event fills only the fields its
+// notification needs.
+type Data struct {
+	CheckIn   time.Time
+	CheckOut  time.Time
+	ExpiresAt time.Time
+	GuestName string
+	BookingID string
+	Reason    string
+	ResetURL  string
+}
+
+// Content is a rendered notification: Subject and Body for email, Text for
+// the short channels.
+type Content struct {
+	Locale  string
+	Subject string
+	Body    string
+	Text    string
+}
+
+// Renderer renders a notification kind from files/<locale>/<kind>.tmpl, which
+// defines the <kind>.subject, <kind>.body and <kind>.text templates.
+type Renderer struct {
+	locales       map[string]*template.Template
+	defaultLocale string
+}
+
+func New(defaultLocale string) (*Renderer, error) {
+	entries, err := fs.ReadDir(files, "files")
+	if err != nil {
+		return nil, err
+	}
+
+	locales := make(map[string]*template.Template, len(entries))
+	for _, entry := range entries {
+		locale := entry.Name()
+		t, err := template.New(locale).
+			Funcs(template.FuncMap{"date": formatDate(locale), "clock": formatClock}).
+			ParseFS(files, "files/"+locale+"/*.tmpl")
+		if err != nil {
+			return nil, fmt.Errorf("parse %s templates: %w", locale, err)
+		}
+		locales[locale] = t
+	}
+
+	if _, ok := locales[defaultLocale]; !ok {
+		return nil, fmt.Errorf("%w: %q", consts.ErrUnknownLocale, defaultLocale)
+	}
+
+	return &Renderer{locales: locales, defaultLocale: defaultLocale}, nil
+}
+
+// Render renders kind in locale, falling back to the default locale when
+// there are no templates for it.
+func (r *Renderer) Render(locale string, kind models.NotificationKind, data Data) (*Content, error) {
+	t, ok := r.locales[locale]
+	if !ok {
+		locale = r.defaultLocale
+		t = r.locales[locale]
+	}
+
+	content := &Content{Locale: locale}
+	parts := []struct {
+		dst  *string
+		name string
+	}{
+		{&content.Subject, "subject"},
+		{&content.Body, "body"},
+		{&content.Text, "text"},
+	}
+	for _, part := range parts {
+		name := string(kind) + "." + part.name
+		if t.Lookup(name) == nil {
+			return nil, fmt.Errorf("%w: %s/%s", consts.ErrUnknownTemplate, locale, name)
+		}
+
+		var b strings.Builder
+		if err := t.ExecuteTemplate(&b, name, data); err != nil {
+			return nil, err
+		}
+		*part.dst = strings.TrimSpace(b.String())
+	}
+
+	return content, nil
+}
+
+func formatDate(locale string) func(time.Time) string {
+	layout, ok := dateLayouts[locale]
+	if !ok {
+		layout = time.DateOnly
+	}
+
+	return func(t time.Time) string {
+		return t.UTC().Format(layout)
+	}
+}
+
+func formatClock(t time.Time) string {
+	return t.UTC().Format("15:04")
+}
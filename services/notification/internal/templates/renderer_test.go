@@ -0,0 +1,114 @@
+package templates
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"notification/internal/repository/models"
+	"notification/internal/utils/consts"
+)
+
+var kinds = []models.NotificationKind{
+	models.NotificationKindBookingConfirmation,
+	models.NotificationKindBookingCancellation,
+	models.NotificationKindCheckInReminder,
+	models.NotificationKindPasswordReset,
+}
+
+func TestRenderAllKinds(t *testing.T) {
+	r, err := New("en")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data := Data{
+		CheckIn:   time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		CheckOut:  time.Date(2026, 7, 5, 0, 0, 0, 0, time.UTC),
+		ExpiresAt: time.Date(2026, 6, 1, 12, 30, 0, 0, time.UTC),
+		GuestName: "Anna",
+		BookingID: "b-1",
+		ResetURL:  "http://localhost/reset?token=t",
+	}
+	for _, locale := range []string{"en", "ru"} {
+		for _, kind := range kinds {
+			content, err := r.Render(locale, kind, data)
+			if err != nil {
+				t.Fatalf("%s/%s: unexpected error: %v", locale, kind, err)
+			}
+			if content.Locale != locale {
+				t.Errorf("%s/%s: locale = %s", locale, kind, content.Locale)
+			}
+			if content.Subject == "" || content.Body == "" || content.Text == "" {
+				t.Errorf("%s/%s: empty part in %+v", locale, kind, content)
+			}
+		}
+	}
+}
+
+func TestRenderFormatsDatesPerLocale(t *testing.T) {
+	r, err := New("en")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data := Data{CheckIn: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), BookingID: "b-1"}
+	want := map[string]string{"en": "Jul 1, 2026", "ru": "01.07.2026"}
+	for locale, date := range want {
+		content, err := r.Render(locale, models.NotificationKindCheckInReminder, data)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", locale, err)
+		}
+		if !strings.Contains(content.Text, date) {
+			t.Errorf("%s: text %q does not contain %q", locale, content.Text, date)
+		}
+	}
+}
+
+func TestRenderFallsBackToDefaultLocale(t *testing.T) {
+	r, err := New("ru")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	content, err := r.Render("de", models.NotificationKindBookingCancellation, Data{BookingID: "b-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content.Locale != "ru" {
+		t.Errorf("locale = %s, want ru", content.Locale)
+	}
+}
+
+func TestRenderOmitsMissingReason(t *testing.T) {
+	r, err := New("en")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	content, err := r.Render("en", models.NotificationKindBookingCancellation, Data{BookingID: "b-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(content.Body, "Reason") || strings.Contains(content.Text, "Reason") {
+		t.Errorf("reason rendered without one: %q / %q", content.Body, content.Text)
+	}
+}
+
+func TestNewUnknownDefaultLocale(t *testing.T) {
+	if _, err := New("de"); !errors.Is(err, consts.ErrUnknownLocale) {
+		t.Errorf("err = %v, want %v", err, consts.ErrUnknownLocale)
+	}
+}
+
+func TestRenderUnknownKind(t *testing.T) {
+	r, err := New("en")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err = r.Render("en", "welcome", Data{}); !errors.Is(err, consts.ErrUnknownTemplate) {
+		t.Errorf("err = %v, want %v", err, consts.ErrUnknownTemplate)
+	}
+}
@@ -0,0 +1,23 @@
+package consts
+
+import "errors"
+
+const (
+	MsgNilObject          = "object cannot be nil"
+	MsgInvalidEvent       = "invalid event"
+	MsgUnknownLocale      = "unknown template locale"
+	MsgUnknownTemplate    = "unknown notification template"
+	MsgUnknownDriver      = "unknown channel driver"
+	MsgChannelUnavailable = "notification channel is not enabled"
+	MsgDeliveryRejected   = "notification delivery rejected"
+)
+
+var (
+	ErrNilObject          = errors.New(MsgNilObject)
+	ErrInvalidEvent       = errors.New(MsgInvalidEvent)
+	ErrUnknownLocale      = errors.New(MsgUnknownLocale)
+	ErrUnknownTemplate    = errors.New(MsgUnknownTemplate)
+	ErrUnknownDriver      = errors.New(MsgUnknownDriver)
+	ErrChannelUnavailable = errors.New(MsgChannelUnavailable)
+	ErrDeliveryRejected   = errors.New(MsgDeliveryRejected)
+)
@@ -0,0 +1,59 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every consumed event is recorded once; a redelivered event finds its id here
+-- and is skipped.
+CREATE TABLE IF NOT EXISTS processed_event (
+    event_id UUID PRIMARY KEY,
+    event_type TEXT NOT NULL,
+    topic TEXT NOT NULL,
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS notification (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES processed_event(event_id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    recipient TEXT NOT NULL,
+    locale TEXT NOT NULL,
+    subject TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    booking_id UUID,
+    status TEXT NOT NULL DEFAULT 'PENDING',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    send_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT notification_status_check CHECK (status IN ('PENDING', 'SENT', 'FAILED', 'CANCELLED')),
+    CONSTRAINT notification_event_kind_channel_key UNIQUE (event_id, kind, channel)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_due ON notification(send_at)
+    WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_notification_booking ON notification(booking_id, kind)
+    WHERE status = 'PENDING';
+
+CREATE TABLE IF NOT EXISTS delivery_attempt (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    notification_id UUID NOT NULL REFERENCES notification(id) ON DELETE CASCADE,
+    attempt INTEGER NOT NULL,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT delivery_attempt_notification_attempt_key UNIQUE (notification_id, attempt)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS delivery_attempt;
+
+DROP INDEX IF EXISTS idx_notification_booking;
+DROP INDEX IF EXISTS idx_notification_due;
+
+DROP TABLE IF EXISTS notification;
+
+DROP TABLE IF EXISTS processed_event;
+-- +goose StatementEnd
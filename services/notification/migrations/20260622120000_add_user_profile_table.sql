@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The notification settings of each user, kept from the user.profile_updated
+-- events auth publishes; booking notifications to the user read the locale and
+-- Telegram chat from here.
+CREATE TABLE IF NOT EXISTS user_profile (
+    user_id BIGINT PRIMARY KEY,
+    locale TEXT,
+    telegram_chat_id TEXT,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_profile;
+-- +goose StatementEnd
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: review/v1/rpc/create_review.proto
+
+package reviewv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateReviewScores struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cleanliness   uint32                 `protobuf:"varint,1,opt,name=cleanliness,proto3" json:"cleanliness,omitempty"`
+	Location      uint32                 `protobuf:"varint,2,opt,name=location,proto3" json:"location,omitempty"`
+	Staff         uint32                 `protobuf:"varint,3,opt,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewScores) Reset() {
+	*x = CreateReviewScores{}
+	mi := &file_review_v1_rpc_create_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewScores) ProtoMessage() {}
+
+func (x *CreateReviewScores) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_rpc_create_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewScores.ProtoReflect.Descriptor instead.
+func (*CreateReviewScores) Descriptor() ([]byte, []int) {
+	return file_review_v1_rpc_create_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateReviewScores) GetCleanliness() uint32 {
+	if x != nil {
+		return x.Cleanliness
+	}
+	return 0
+}
+
+func (x *CreateReviewScores) GetLocation() uint32 {
+	if x != nil {
+		return x.Location
+	}
+	return 0
+}
+
+func (x *CreateReviewScores) GetStaff() uint32 {
+	if x != nil {
+		return x.Staff
+	}
+	return 0
+}
+
+type CreateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Scores        *CreateReviewScores    `protobuf:"bytes,3,opt,name=scores,proto3" json:"scores,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_review_v1_rpc_create_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_rpc_create_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_rpc_create_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateReviewRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetScores() *CreateReviewScores {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *CreateReviewRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type CreateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewResponse) Reset() {
+	*x = CreateReviewResponse{}
+	mi := &file_review_v1_rpc_create_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewResponse) ProtoMessage() {}
+
+func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_rpc_create_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_rpc_create_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+var File_review_v1_rpc_create_review_proto protoreflect.FileDescriptor
+
+const file_review_v1_rpc_create_review_proto_rawDesc = "" +
+	"\n" +
+	"!review/v1/rpc/create_review.proto\x12\treview.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1dreview/v1/models/review.proto\"\x89\x01\n" +
+	"\x12CreateReviewScores\x12+\n" +
+	"\vcleanliness\x18\x01 \x01(\rB\t\xbaH\x06*\x04\x18\x05(\x01R\vcleanliness\x12%\n" +
+	"\blocation\x18\x02 \x01(\rB\t\xbaH\x06*\x04\x18\x05(\x01R\blocation\x12\x1f\n" +
+	"\x05staff\x18\x03 \x01(\rB\t\xbaH\x06*\x04\x18\x05(\x01R\x05staff\"\xbf\x01\n" +
+	"\x13CreateReviewRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\x12\"\n" +
+	"\bactor_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\aactorId\x12=\n" +
+	"\x06scores\x18\x03 \x01(\v2\x1d.review.v1.CreateReviewScoresB\x06\xbaH\x03\xc8\x01\x01R\x06scores\x12\x1c\n" +
+	"\x04text\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xa0\x1fR\x04text\"A\n" +
+	"\x14CreateReviewResponse\x12)\n" +
+	"\x06review\x18\x01 \x01(\v2\x11.review.v1.ReviewR\x06reviewB\x18Z\x16api/review/v1;reviewv1b\x06proto3"
+
+var (
+	file_review_v1_rpc_create_review_proto_rawDescOnce sync.Once
+	file_review_v1_rpc_create_review_proto_rawDescData []byte
+)
+
+func file_review_v1_rpc_create_review_proto_rawDescGZIP() []byte {
+	file_review_v1_rpc_create_review_proto_rawDescOnce.Do(func() {
+		file_review_v1_rpc_create_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_review_v1_rpc_create_review_proto_rawDesc), len(file_review_v1_rpc_create_review_proto_rawDesc)))
+	})
+	return file_review_v1_rpc_create_review_proto_rawDescData
+}
+
+var file_review_v1_rpc_create_review_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_review_v1_rpc_create_review_proto_goTypes = []any{
+	(*CreateReviewScores)(nil),   // 0: review.v1.CreateReviewScores
+	(*CreateReviewRequest)(nil),  // 1: review.v1.CreateReviewRequest
+	(*CreateReviewResponse)(nil), // 2: review.v1.CreateReviewResponse
+	(*Review)(nil),               // 3: review.v1.Review
+}
+var file_review_v1_rpc_create_review_proto_depIdxs = []int32{
+	0, // 0: review.v1.CreateReviewRequest.scores:type_name -> review.v1.CreateReviewScores
+	3, // 1: review.v1.CreateReviewResponse.review:type_name -> review.v1.Review
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_review_v1_rpc_create_review_proto_init() }
+func file_review_v1_rpc_create_review_proto_init() {
+	if File_review_v1_rpc_create_review_proto != nil {
+		return
+	}
+	file_review_v1_models_review_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_v1_rpc_create_review_proto_rawDesc), len(file_review_v1_rpc_create_review_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_review_v1_rpc_create_review_proto_goTypes,
+		DependencyIndexes: file_review_v1_rpc_create_review_proto_depIdxs,
+		MessageInfos:      file_review_v1_rpc_create_review_proto_msgTypes,
+	}.Build()
+	File_review_v1_rpc_create_review_proto = out.File
+	file_review_v1_rpc_create_review_proto_goTypes = nil
+	file_review_v1_rpc_create_review_proto_depIdxs = nil
+}
@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: review/v1/rpc/get_hotel_reviews.proto
+
+package reviewv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetHotelReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Page          uint64                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint64                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotelReviewsRequest) Reset() {
+	*x = GetHotelReviewsRequest{}
+	mi := &file_review_v1_rpc_get_hotel_reviews_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotelReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotelReviewsRequest) ProtoMessage() {}
+
+func (x *GetHotelReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_rpc_get_hotel_reviews_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotelReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetHotelReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_rpc_get_hotel_reviews_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetHotelReviewsRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *GetHotelReviewsRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetHotelReviewsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetHotelReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	TotalCount    uint64                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          uint64                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint64                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Summary       *HotelRatingSummary    `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotelReviewsResponse) Reset() {
+	*x = GetHotelReviewsResponse{}
+	mi := &file_review_v1_rpc_get_hotel_reviews_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotelReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotelReviewsResponse) ProtoMessage() {}
+
+func (x *GetHotelReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_rpc_get_hotel_reviews_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotelReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetHotelReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_rpc_get_hotel_reviews_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetHotelReviewsResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *GetHotelReviewsResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetHotelReviewsResponse) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetHotelReviewsResponse) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetHotelReviewsResponse) GetSummary() *HotelRatingSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+var File_review_v1_rpc_get_hotel_reviews_proto protoreflect.FileDescriptor
+
+const file_review_v1_rpc_get_hotel_reviews_proto_rawDesc = "" +
+	"\n" +
+	"%review/v1/rpc/get_hotel_reviews.proto\x12\treview.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1dreview/v1/models/review.proto\"{\n" +
+	"\x16GetHotelReviewsRequest\x12#\n" +
+	"\bhotel_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\ahotelId\x12\x1b\n" +
+	"\x04page\x18\x02 \x01(\x04B\a\xbaH\x042\x02(\x01R\x04page\x12\x1f\n" +
+	"\x05limit\x18\x03 \x01(\x04B\t\xbaH\x062\x04\x18d(\x01R\x05limit\"\xca\x01\n" +
+	"\x17GetHotelReviewsResponse\x12+\n" +
+	"\areviews\x18\x01 \x03(\v2\x11.review.v1.ReviewR\areviews\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x04R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x04R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x04R\x05limit\x127\n" +
+	"\asummary\x18\x05 \x01(\v2\x1d.review.v1.HotelRatingSummaryR\asummaryB\x18Z\x16api/review/v1;reviewv1b\x06proto3"
+
+var (
+	file_review_v1_rpc_get_hotel_reviews_proto_rawDescOnce sync.Once
+	file_review_v1_rpc_get_hotel_reviews_proto_rawDescData []byte
+)
+
+func file_review_v1_rpc_get_hotel_reviews_proto_rawDescGZIP() []byte {
+	file_review_v1_rpc_get_hotel_reviews_proto_rawDescOnce.Do(func() {
+		file_review_v1_rpc_get_hotel_reviews_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_review_v1_rpc_get_hotel_reviews_proto_rawDesc), len(file_review_v1_rpc_get_hotel_reviews_proto_rawDesc)))
+	})
+	return file_review_v1_rpc_get_hotel_reviews_proto_rawDescData
+}
+
+var file_review_v1_rpc_get_hotel_reviews_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_review_v1_rpc_get_hotel_reviews_proto_goTypes = []any{
+	(*GetHotelReviewsRequest)(nil),  // 0: review.v1.GetHotelReviewsRequest
+	(*GetHotelReviewsResponse)(nil), // 1: review.v1.GetHotelReviewsResponse
+	(*Review)(nil),                  // 2: review.v1.Review
+	(*HotelRatingSummary)(nil),      // 3: review.v1.HotelRatingSummary
+}
+var file_review_v1_rpc_get_hotel_reviews_proto_depIdxs = []int32{
+	2, // 0: review.v1.GetHotelReviewsResponse.reviews:type_name -> review.v1.Review
+	3, // 1: review.v1.GetHotelReviewsResponse.summary:type_name -> review.v1.HotelRatingSummary
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_review_v1_rpc_get_hotel_reviews_proto_init() }
+func file_review_v1_rpc_get_hotel_reviews_proto_init() {
+	if File_review_v1_rpc_get_hotel_reviews_proto != nil {
+		return
+	}
+	file_review_v1_models_review_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_v1_rpc_get_hotel_reviews_proto_rawDesc), len(file_review_v1_rpc_get_hotel_reviews_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_review_v1_rpc_get_hotel_reviews_proto_goTypes,
+		DependencyIndexes: file_review_v1_rpc_get_hotel_reviews_proto_depIdxs,
+		MessageInfos:      file_review_v1_rpc_get_hotel_reviews_proto_msgTypes,
+	}.Build()
+	File_review_v1_rpc_get_hotel_reviews_proto = out.File
+	file_review_v1_rpc_get_hotel_reviews_proto_goTypes = nil
+	file_review_v1_rpc_get_hotel_reviews_proto_depIdxs = nil
+}
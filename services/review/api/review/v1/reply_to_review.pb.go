@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: review/v1/rpc/reply_to_review.proto
+
+package reviewv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReplyToReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyToReviewRequest) Reset() {
+	*x = ReplyToReviewRequest{}
+	mi := &file_review_v1_rpc_reply_to_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyToReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyToReviewRequest) ProtoMessage() {}
+
+func (x *ReplyToReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_rpc_reply_to_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyToReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReplyToReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_rpc_reply_to_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReplyToReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *ReplyToReviewRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *ReplyToReviewRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ReplyToReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyToReviewResponse) Reset() {
+	*x = ReplyToReviewResponse{}
+	mi := &file_review_v1_rpc_reply_to_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyToReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyToReviewResponse) ProtoMessage() {}
+
+func (x *ReplyToReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_rpc_reply_to_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyToReviewResponse.ProtoReflect.Descriptor instead.
+func (*ReplyToReviewResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_rpc_reply_to_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReplyToReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+var File_review_v1_rpc_reply_to_review_proto protoreflect.FileDescriptor
+
+const file_review_v1_rpc_reply_to_review_proto_rawDesc = "" +
+	"\n" +
+	"#review/v1/rpc/reply_to_review.proto\x12\treview.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1dreview/v1/models/review.proto\"\x81\x01\n" +
+	"\x14ReplyToReviewRequest\x12%\n" +
+	"\treview_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\breviewId\x12\"\n" +
+	"\bactor_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\aactorId\x12\x1e\n" +
+	"\x04text\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xa0\x1fR\x04text\"B\n" +
+	"\x15ReplyToReviewResponse\x12)\n" +
+	"\x06review\x18\x01 \x01(\v2\x11.review.v1.ReviewR\x06reviewB\x18Z\x16api/review/v1;reviewv1b\x06proto3"
+
+var (
+	file_review_v1_rpc_reply_to_review_proto_rawDescOnce sync.Once
+	file_review_v1_rpc_reply_to_review_proto_rawDescData []byte
+)
+
+func file_review_v1_rpc_reply_to_review_proto_rawDescGZIP() []byte {
+	file_review_v1_rpc_reply_to_review_proto_rawDescOnce.Do(func() {
+		file_review_v1_rpc_reply_to_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_review_v1_rpc_reply_to_review_proto_rawDesc), len(file_review_v1_rpc_reply_to_review_proto_rawDesc)))
+	})
+	return file_review_v1_rpc_reply_to_review_proto_rawDescData
+}
+
+var file_review_v1_rpc_reply_to_review_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_review_v1_rpc_reply_to_review_proto_goTypes = []any{
+	(*ReplyToReviewRequest)(nil),  // 0: review.v1.ReplyToReviewRequest
+	(*ReplyToReviewResponse)(nil), // 1: review.v1.ReplyToReviewResponse
+	(*Review)(nil),                // 2: review.v1.Review
+}
+var file_review_v1_rpc_reply_to_review_proto_depIdxs = []int32{
+	2, // 0: review.v1.ReplyToReviewResponse.review:type_name -> review.v1.Review
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_review_v1_rpc_reply_to_review_proto_init() }
+func file_review_v1_rpc_reply_to_review_proto_init() {
+	if File_review_v1_rpc_reply_to_review_proto != nil {
+		return
+	}
+	file_review_v1_models_review_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_v1_rpc_reply_to_review_proto_rawDesc), len(file_review_v1_rpc_reply_to_review_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_review_v1_rpc_reply_to_review_proto_goTypes,
+		DependencyIndexes: file_review_v1_rpc_reply_to_review_proto_depIdxs,
+		MessageInfos:      file_review_v1_rpc_reply_to_review_proto_msgTypes,
+	}.Build()
+	File_review_v1_rpc_reply_to_review_proto = out.File
+	file_review_v1_rpc_reply_to_review_proto_goTypes = nil
+	file_review_v1_rpc_reply_to_review_proto_depIdxs = nil
+}
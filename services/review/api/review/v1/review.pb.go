@@ -0,0 +1,453 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: review/v1/models/review.proto
+
+package reviewv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewScores struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cleanliness   uint32                 `protobuf:"varint,1,opt,name=cleanliness,proto3" json:"cleanliness,omitempty"`
+	Location      uint32                 `protobuf:"varint,2,opt,name=location,proto3" json:"location,omitempty"`
+	Staff         uint32                 `protobuf:"varint,3,opt,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewScores) Reset() {
+	*x = ReviewScores{}
+	mi := &file_review_v1_models_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewScores) ProtoMessage() {}
+
+func (x *ReviewScores) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_models_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewScores.ProtoReflect.Descriptor instead.
+func (*ReviewScores) Descriptor() ([]byte, []int) {
+	return file_review_v1_models_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewScores) GetCleanliness() uint32 {
+	if x != nil {
+		return x.Cleanliness
+	}
+	return 0
+}
+
+func (x *ReviewScores) GetLocation() uint32 {
+	if x != nil {
+		return x.Location
+	}
+	return 0
+}
+
+func (x *ReviewScores) GetStaff() uint32 {
+	if x != nil {
+		return x.Staff
+	}
+	return 0
+}
+
+type ReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      int64                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewReply) Reset() {
+	*x = ReviewReply{}
+	mi := &file_review_v1_models_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewReply) ProtoMessage() {}
+
+func (x *ReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_models_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewReply.ProtoReflect.Descriptor instead.
+func (*ReviewReply) Descriptor() ([]byte, []int) {
+	return file_review_v1_models_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewReply) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *ReviewReply) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ReviewReply) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HotelId       string                 `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,3,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	AuthorId      int64                  `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Scores        *ReviewScores          `protobuf:"bytes,5,opt,name=scores,proto3" json:"scores,omitempty"`
+	Overall       float32                `protobuf:"fixed32,6,opt,name=overall,proto3" json:"overall,omitempty"`
+	Text          string                 `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
+	Status        ReviewStatus           `protobuf:"varint,8,opt,name=status,proto3,enum=review.v1.ReviewStatus" json:"status,omitempty"`
+	Reply         *ReviewReply           `protobuf:"bytes,9,opt,name=reply,proto3" json:"reply,omitempty"`
+	RemovalReason *string                `protobuf:"bytes,10,opt,name=removal_reason,json=removalReason,proto3,oneof" json:"removal_reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_review_v1_models_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_models_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_review_v1_models_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *Review) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Review) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Review) GetScores() *ReviewScores {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Review) GetOverall() float32 {
+	if x != nil {
+		return x.Overall
+	}
+	return 0
+}
+
+func (x *Review) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Review) GetStatus() ReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReviewStatus_REVIEW_STATUS_UNSPECIFIED
+}
+
+func (x *Review) GetReply() *ReviewReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *Review) GetRemovalReason() string {
+	if x != nil && x.RemovalReason != nil {
+		return *x.RemovalReason
+	}
+	return ""
+}
+
+func (x *Review) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Review) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type HotelRatingSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        *float32               `protobuf:"fixed32,1,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	ReviewCount   uint64                 `protobuf:"varint,2,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	Cleanliness   float32                `protobuf:"fixed32,3,opt,name=cleanliness,proto3" json:"cleanliness,omitempty"`
+	Location      float32                `protobuf:"fixed32,4,opt,name=location,proto3" json:"location,omitempty"`
+	Staff         float32                `protobuf:"fixed32,5,opt,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotelRatingSummary) Reset() {
+	*x = HotelRatingSummary{}
+	mi := &file_review_v1_models_review_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotelRatingSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotelRatingSummary) ProtoMessage() {}
+
+func (x *HotelRatingSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_models_review_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotelRatingSummary.ProtoReflect.Descriptor instead.
+func (*HotelRatingSummary) Descriptor() ([]byte, []int) {
+	return file_review_v1_models_review_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HotelRatingSummary) GetRating() float32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+func (x *HotelRatingSummary) GetReviewCount() uint64 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *HotelRatingSummary) GetCleanliness() float32 {
+	if x != nil {
+		return x.Cleanliness
+	}
+	return 0
+}
+
+func (x *HotelRatingSummary) GetLocation() float32 {
+	if x != nil {
+		return x.Location
+	}
+	return 0
+}
+
+func (x *HotelRatingSummary) GetStaff() float32 {
+	if x != nil {
+		return x.Staff
+	}
+	return 0
+}
+
+var File_review_v1_models_review_proto protoreflect.FileDescriptor
+
+const file_review_v1_models_review_proto_rawDesc = "" +
+	"\n" +
+	"\x1dreview/v1/models/review.proto\x12\treview.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#review/v1/enums/review_status.proto\"b\n" +
+	"\fReviewScores\x12 \n" +
+	"\vcleanliness\x18\x01 \x01(\rR\vcleanliness\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\rR\blocation\x12\x14\n" +
+	"\x05staff\x18\x03 \x01(\rR\x05staff\"y\n" +
+	"\vReviewReply\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe2\x03\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\tR\ahotelId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x03 \x01(\tR\tbookingId\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\x03R\bauthorId\x12/\n" +
+	"\x06scores\x18\x05 \x01(\v2\x17.review.v1.ReviewScoresR\x06scores\x12\x18\n" +
+	"\aoverall\x18\x06 \x01(\x02R\aoverall\x12\x12\n" +
+	"\x04text\x18\a \x01(\tR\x04text\x12/\n" +
+	"\x06status\x18\b \x01(\x0e2\x17.review.v1.ReviewStatusR\x06status\x12,\n" +
+	"\x05reply\x18\t \x01(\v2\x16.review.v1.ReviewReplyR\x05reply\x12*\n" +
+	"\x0eremoval_reason\x18\n" +
+	" \x01(\tH\x00R\rremovalReason\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x11\n" +
+	"\x0f_removal_reason\"\xb3\x01\n" +
+	"\x12HotelRatingSummary\x12\x1b\n" +
+	"\x06rating\x18\x01 \x01(\x02H\x00R\x06rating\x88\x01\x01\x12!\n" +
+	"\freview_count\x18\x02 \x01(\x04R\vreviewCount\x12 \n" +
+	"\vcleanliness\x18\x03 \x01(\x02R\vcleanliness\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\x02R\blocation\x12\x14\n" +
+	"\x05staff\x18\x05 \x01(\x02R\x05staffB\t\n" +
+	"\a_ratingB\x18Z\x16api/review/v1;reviewv1b\x06proto3"
+
+var (
+	file_review_v1_models_review_proto_rawDescOnce sync.Once
+	file_review_v1_models_review_proto_rawDescData []byte
+)
+
+func file_review_v1_models_review_proto_rawDescGZIP() []byte {
+	file_review_v1_models_review_proto_rawDescOnce.Do(func() {
+		file_review_v1_models_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_review_v1_models_review_proto_rawDesc), len(file_review_v1_models_review_proto_rawDesc)))
+	})
+	return file_review_v1_models_review_proto_rawDescData
+}
+
+var file_review_v1_models_review_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_review_v1_models_review_proto_goTypes = []any{
+	(*ReviewScores)(nil),          // 0: review.v1.ReviewScores
+	(*ReviewReply)(nil),           // 1: review.v1.ReviewReply
+	(*Review)(nil),                // 2: review.v1.Review
+	(*HotelRatingSummary)(nil),    // 3: review.v1.HotelRatingSummary
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(ReviewStatus)(0),             // 5: review.v1.ReviewStatus
+}
+var file_review_v1_models_review_proto_depIdxs = []int32{
+	4, // 0: review.v1.ReviewReply.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: review.v1.Review.scores:type_name -> review.v1.ReviewScores
+	5, // 2: review.v1.Review.status:type_name -> review.v1.ReviewStatus
+	1, // 3: review.v1.Review.reply:type_name -> review.v1.ReviewReply
+	4, // 4: review.v1.Review.created_at:type_name -> google.protobuf.Timestamp
+	4, // 5: review.v1.Review.updated_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_review_v1_models_review_proto_init() }
+func file_review_v1_models_review_proto_init() {
+	if File_review_v1_models_review_proto != nil {
+		return
+	}
+	file_review_v1_enums_review_status_proto_init()
+	file_review_v1_models_review_proto_msgTypes[2].OneofWrappers = []any{}
+	file_review_v1_models_review_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_v1_models_review_proto_rawDesc), len(file_review_v1_models_review_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_review_v1_models_review_proto_goTypes,
+		DependencyIndexes: file_review_v1_models_review_proto_depIdxs,
+		MessageInfos:      file_review_v1_models_review_proto_msgTypes,
+	}.Build()
+	File_review_v1_models_review_proto = out.File
+	file_review_v1_models_review_proto_goTypes = nil
+	file_review_v1_models_review_proto_depIdxs = nil
+}
@@ -0,0 +1,91 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: review/v1/review_service.proto
+
+package reviewv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_review_v1_review_service_proto protoreflect.FileDescriptor
+
+const file_review_v1_review_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1ereview/v1/review_service.proto\x12\treview.v1\x1a!review/v1/rpc/create_review.proto\x1a\x1ereview/v1/rpc/get_review.proto\x1a%review/v1/rpc/get_hotel_reviews.proto\x1a#review/v1/rpc/reply_to_review.proto\x1a$review/v1/rpc/take_down_review.proto2\xad\x03\n" +
+	"\rReviewService\x12O\n" +
+	"\fCreateReview\x12\x1e.review.v1.CreateReviewRequest\x1a\x1f.review.v1.CreateReviewResponse\x12F\n" +
+	"\tGetReview\x12\x1b.review.v1.GetReviewRequest\x1a\x1c.review.v1.GetReviewResponse\x12X\n" +
+	"\x0fGetHotelReviews\x12!.review.v1.GetHotelReviewsRequest\x1a\".review.v1.GetHotelReviewsResponse\x12R\n" +
+	"\rReplyToReview\x12\x1f.review.v1.ReplyToReviewRequest\x1a .review.v1.ReplyToReviewResponse\x12U\n" +
+	"\x0eTakeDownReview\x12 .review.v1.TakeDownReviewRequest\x1a!.review.v1.TakeDownReviewResponseB\x18Z\x16api/review/v1;reviewv1b\x06proto3"
+
+var file_review_v1_review_service_proto_goTypes = []any{
+	(*CreateReviewRequest)(nil),     // 0: review.v1.CreateReviewRequest
+	(*GetReviewRequest)(nil),        // 1: review.v1.GetReviewRequest
+	(*GetHotelReviewsRequest)(nil),  // 2: review.v1.GetHotelReviewsRequest
+	(*ReplyToReviewRequest)(nil),    // 3: review.v1.ReplyToReviewRequest
+	(*TakeDownReviewRequest)(nil),   // 4: review.v1.TakeDownReviewRequest
+	(*CreateReviewResponse)(nil),    // 5: review.v1.CreateReviewResponse
+	(*GetReviewResponse)(nil),       // 6: review.v1.GetReviewResponse
+	(*GetHotelReviewsResponse)(nil), // 7: review.v1.GetHotelReviewsResponse
+	(*ReplyToReviewResponse)(nil),   // 8: review.v1.ReplyToReviewResponse
+	(*TakeDownReviewResponse)(nil),  // 9: review.v1.TakeDownReviewResponse
+}
+var file_review_v1_review_service_proto_depIdxs = []int32{
+	0, // 0: review.v1.ReviewService.CreateReview:input_type -> review.v1.CreateReviewRequest
+	1, // 1: review.v1.ReviewService.GetReview:input_type -> review.v1.GetReviewRequest
+	2, // 2: review.v1.ReviewService.GetHotelReviews:input_type -> review.v1.GetHotelReviewsRequest
+	3, // 3: review.v1.ReviewService.ReplyToReview:input_type -> review.v1.ReplyToReviewRequest
+	4, // 4: review.v1.ReviewService.TakeDownReview:input_type -> review.v1.TakeDownReviewRequest
+	5, // 5: review.v1.ReviewService.CreateReview:output_type -> review.v1.CreateReviewResponse
+	6, // 6: review.v1.ReviewService.GetReview:output_type -> review.v1.GetReviewResponse
+	7, // 7: review.v1.ReviewService.GetHotelReviews:output_type -> review.v1.GetHotelReviewsResponse
+	8, // 8: review.v1.ReviewService.ReplyToReview:output_type -> review.v1.ReplyToReviewResponse
+	9, // 9: review.v1.ReviewService.TakeDownReview:output_type -> review.v1.TakeDownReviewResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_review_v1_review_service_proto_init() }
+func file_review_v1_review_service_proto_init() {
+	if File_review_v1_review_service_proto != nil {
+		return
+	}
+	file_review_v1_rpc_create_review_proto_init()
+	file_review_v1_rpc_get_review_proto_init()
+	file_review_v1_rpc_get_hotel_reviews_proto_init()
+	file_review_v1_rpc_reply_to_review_proto_init()
+	file_review_v1_rpc_take_down_review_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_v1_review_service_proto_rawDesc), len(file_review_v1_review_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_review_v1_review_service_proto_goTypes,
+		DependencyIndexes: file_review_v1_review_service_proto_depIdxs,
+	}.Build()
+	File_review_v1_review_service_proto = out.File
+	file_review_v1_review_service_proto_goTypes = nil
+	file_review_v1_review_service_proto_depIdxs = nil
+}
@@ -0,0 +1,129 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: review/v1/enums/review_status.proto
+
+package reviewv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewStatus int32
+
+const (
+	ReviewStatus_REVIEW_STATUS_UNSPECIFIED ReviewStatus = 0
+	ReviewStatus_REVIEW_STATUS_PUBLISHED   ReviewStatus = 1
+	ReviewStatus_REVIEW_STATUS_REMOVED     ReviewStatus = 2
+)
+
+// Enum value maps for ReviewStatus.
+var (
+	ReviewStatus_name = map[int32]string{
+		0: "REVIEW_STATUS_UNSPECIFIED",
+		1: "REVIEW_STATUS_PUBLISHED",
+		2: "REVIEW_STATUS_REMOVED",
+	}
+	ReviewStatus_value = map[string]int32{
+		"REVIEW_STATUS_UNSPECIFIED": 0,
+		"REVIEW_STATUS_PUBLISHED":   1,
+		"REVIEW_STATUS_REMOVED":     2,
+	}
+)
+
+func (x ReviewStatus) Enum() *ReviewStatus {
+	p := new(ReviewStatus)
+	*p = x
+	return p
+}
+
+func (x ReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_review_v1_enums_review_status_proto_enumTypes[0].Descriptor()
+}
+
+func (ReviewStatus) Type() protoreflect.EnumType {
+	return &file_review_v1_enums_review_status_proto_enumTypes[0]
+}
+
+func (x ReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewStatus.Descriptor instead.
+func (ReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_review_v1_enums_review_status_proto_rawDescGZIP(), []int{0}
+}
+
+var File_review_v1_enums_review_status_proto protoreflect.FileDescriptor
+
+const file_review_v1_enums_review_status_proto_rawDesc = "" +
+	"\n" +
+	"#review/v1/enums/review_status.proto\x12\treview.v1*e\n" +
+	"\fReviewStatus\x12\x1d\n" +
+	"\x19REVIEW_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17REVIEW_STATUS_PUBLISHED\x10\x01\x12\x19\n" +
+	"\x15REVIEW_STATUS_REMOVED\x10\x02B\x18Z\x16api/review/v1;reviewv1b\x06proto3"
+
+var (
+	file_review_v1_enums_review_status_proto_rawDescOnce sync.Once
+	file_review_v1_enums_review_status_proto_rawDescData []byte
+)
+
+func file_review_v1_enums_review_status_proto_rawDescGZIP() []byte {
+	file_review_v1_enums_review_status_proto_rawDescOnce.Do(func() {
+		file_review_v1_enums_review_status_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_review_v1_enums_review_status_proto_rawDesc), len(file_review_v1_enums_review_status_proto_rawDesc)))
+	})
+	return file_review_v1_enums_review_status_proto_rawDescData
+}
+
+var file_review_v1_enums_review_status_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_review_v1_enums_review_status_proto_goTypes = []any{
+	(ReviewStatus)(0), // 0: review.v1.ReviewStatus
+}
+var file_review_v1_enums_review_status_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_review_v1_enums_review_status_proto_init() }
+func file_review_v1_enums_review_status_proto_init() {
+	if File_review_v1_enums_review_status_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_v1_enums_review_status_proto_rawDesc), len(file_review_v1_enums_review_status_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_review_v1_enums_review_status_proto_goTypes,
+		DependencyIndexes: file_review_v1_enums_review_status_proto_depIdxs,
+		EnumInfos:         file_review_v1_enums_review_status_proto_enumTypes,
+	}.Build()
+	File_review_v1_enums_review_status_proto = out.File
+	file_review_v1_enums_review_status_proto_goTypes = nil
+	file_review_v1_enums_review_status_proto_depIdxs = nil
+}
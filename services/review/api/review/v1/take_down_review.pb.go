@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: review/v1/rpc/take_down_review.proto
+
+package reviewv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TakeDownReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole     UserRole               `protobuf:"varint,3,opt,name=actor_role,json=actorRole,proto3,enum=review.v1.UserRole" json:"actor_role,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeDownReviewRequest) Reset() {
+	*x = TakeDownReviewRequest{}
+	mi := &file_review_v1_rpc_take_down_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeDownReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeDownReviewRequest) ProtoMessage() {}
+
+func (x *TakeDownReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_rpc_take_down_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeDownReviewRequest.ProtoReflect.Descriptor instead.
+func (*TakeDownReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_rpc_take_down_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TakeDownReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *TakeDownReviewRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *TakeDownReviewRequest) GetActorRole() UserRole {
+	if x != nil {
+		return x.ActorRole
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *TakeDownReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TakeDownReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeDownReviewResponse) Reset() {
+	*x = TakeDownReviewResponse{}
+	mi := &file_review_v1_rpc_take_down_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeDownReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeDownReviewResponse) ProtoMessage() {}
+
+func (x *TakeDownReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_rpc_take_down_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeDownReviewResponse.ProtoReflect.Descriptor instead.
+func (*TakeDownReviewResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_rpc_take_down_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TakeDownReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+var File_review_v1_rpc_take_down_review_proto protoreflect.FileDescriptor
+
+const file_review_v1_rpc_take_down_review_proto_rawDesc = "" +
+	"\n" +
+	"$review/v1/rpc/take_down_review.proto\x12\treview.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1freview/v1/enums/user_role.proto\x1a\x1dreview/v1/models/review.proto\"\xc6\x01\n" +
+	"\x15TakeDownReviewRequest\x12%\n" +
+	"\treview_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\breviewId\x12\"\n" +
+	"\bactor_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\aactorId\x12>\n" +
+	"\n" +
+	"actor_role\x18\x03 \x01(\x0e2\x13.review.v1.UserRoleB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\tactorRole\x12\"\n" +
+	"\x06reason\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe8\aR\x06reason\"C\n" +
+	"\x16TakeDownReviewResponse\x12)\n" +
+	"\x06review\x18\x01 \x01(\v2\x11.review.v1.ReviewR\x06reviewB\x18Z\x16api/review/v1;reviewv1b\x06proto3"
+
+var (
+	file_review_v1_rpc_take_down_review_proto_rawDescOnce sync.Once
+	file_review_v1_rpc_take_down_review_proto_rawDescData []byte
+)
+
+func file_review_v1_rpc_take_down_review_proto_rawDescGZIP() []byte {
+	file_review_v1_rpc_take_down_review_proto_rawDescOnce.Do(func() {
+		file_review_v1_rpc_take_down_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_review_v1_rpc_take_down_review_proto_rawDesc), len(file_review_v1_rpc_take_down_review_proto_rawDesc)))
+	})
+	return file_review_v1_rpc_take_down_review_proto_rawDescData
+}
+
+var file_review_v1_rpc_take_down_review_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_review_v1_rpc_take_down_review_proto_goTypes = []any{
+	(*TakeDownReviewRequest)(nil),  // 0: review.v1.TakeDownReviewRequest
+	(*TakeDownReviewResponse)(nil), // 1: review.v1.TakeDownReviewResponse
+	(UserRole)(0),                  // 2: review.v1.UserRole
+	(*Review)(nil),                 // 3: review.v1.Review
+}
+var file_review_v1_rpc_take_down_review_proto_depIdxs = []int32{
+	2, // 0: review.v1.TakeDownReviewRequest.actor_role:type_name -> review.v1.UserRole
+	3, // 1: review.v1.TakeDownReviewResponse.review:type_name -> review.v1.Review
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_review_v1_rpc_take_down_review_proto_init() }
+func file_review_v1_rpc_take_down_review_proto_init() {
+	if File_review_v1_rpc_take_down_review_proto != nil {
+		return
+	}
+	file_review_v1_enums_user_role_proto_init()
+	file_review_v1_models_review_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_v1_rpc_take_down_review_proto_rawDesc), len(file_review_v1_rpc_take_down_review_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_review_v1_rpc_take_down_review_proto_goTypes,
+		DependencyIndexes: file_review_v1_rpc_take_down_review_proto_depIdxs,
+		MessageInfos:      file_review_v1_rpc_take_down_review_proto_msgTypes,
+	}.Build()
+	File_review_v1_rpc_take_down_review_proto = out.File
+	file_review_v1_rpc_take_down_review_proto_goTypes = nil
+	file_review_v1_rpc_take_down_review_proto_depIdxs = nil
+}
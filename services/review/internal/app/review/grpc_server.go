@@ -0,0 +1,54 @@
+package review
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"google.golang.org/grpc"
+)
+
+func newGRPCServer(logger *slog.Logger, unary ...grpc.UnaryServerInterceptor) *grpc.Server {
+	opts := []logging.Option{
+		logging.WithLogOnEvents(logging.FinishCall),
+		logging.WithFieldsFromContext(
+			func(ctx context.Context) logging.Fields {
+				return logging.Fields{}
+			},
+		),
+		logging.WithLevels(logging.DefaultServerCodeToLevel),
+	}
+
+	return grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			append(
+				[]grpc.UnaryServerInterceptor{logging.UnaryServerInterceptor(interceptorLogger(logger), opts...)},
+				unary...,
+			)...,
+		),
+		grpc.ChainStreamInterceptor(
+			logging.StreamServerInterceptor(interceptorLogger(logger), opts...),
+		),
+	)
+}
+
+func interceptorLogger(l *slog.Logger) logging.Logger {
+	return logging.LoggerFunc(
+		func(ctx context.Context, lvl logging.Level, msg string, fields ...any) {
+			filtered := make([]any, 0, len(fields))
+			for i := 0; i < len(fields); i += 2 {
+				if i+1 >= len(fields) {
+					break
+				}
+				key := fields[i].(string)
+
+				switch key {
+				case "grpc.service", "grpc.method", "grpc.code", "grpc.time_ms":
+					filtered = append(filtered, key, fields[i+1])
+				}
+			}
+
+			l.Log(ctx, slog.Level(lvl), msg, filtered...)
+		},
+	)
+}
@@ -0,0 +1,112 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	reviewv1 "review/api/review/v1"
+	"review/internal/config"
+	"review/internal/grpc/client"
+	"review/internal/grpc/handler"
+	"review/internal/repository/postgres"
+	"review/internal/service"
+)
+
+type App struct {
+	Config *config.Config
+	Logger *slog.Logger
+}
+
+func (app *App) MustLoadGRPC() {
+	slog.SetDefault(app.Logger)
+
+	repo, err := postgres.New(app.Config)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		panic(err.Error())
+	}
+
+	bookingClient, err := client.NewBookingClient(app.Config.BookingService)
+	if err != nil {
+		panic(err.Error())
+	}
+	defer func() { _ = bookingClient.Close() }()
+
+	hotelClient, err := client.NewHotelClient(app.Config.HotelService)
+	if err != nil {
+		panic(err.Error())
+	}
+	defer func() { _ = hotelClient.Close() }()
+
+	svc := service.New(repo, bookingClient, hotelClient)
+	h := handler.New(svc, validator)
+
+	addr := fmt.Sprintf("%s:%d", app.Config.Server.Host, app.Config.Server.Port)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go syncHotelRatings(ctx, svc, app.Config.Rating)
+
+	grpcServer := newGRPCServer(app.Logger)
+
+	reviewv1.RegisterReviewServiceServer(grpcServer, h)
+	reflection.Register(grpcServer)
+
+	go func() {
+		slog.Info("Starting gRPC server", "address", addr)
+		if err = grpcServer.Serve(lis); err != nil {
+			slog.Error("Failed to serve", "error", err)
+		}
+	}()
+
+	app.gracefulShutdown(grpcServer)
+}
+
+func (app *App) gracefulShutdown(grpcServer *grpc.Server) {
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+
+	slog.Info("Shutting down gRPC server...")
+	grpcServer.GracefulStop()
+	slog.Info("gRPC server stopped")
+}
+
+// syncHotelRatings pushes changed hotel ratings to the hotel service until ctx
+// is cancelled.
+func syncHotelRatings(ctx context.Context, svc *service.Service, cfg config.RatingConfig) {
+	ticker := time.NewTicker(cfg.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			synced, err := svc.SyncHotelRatings(ctx, cfg.BatchSize)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to sync hotel ratings", "err", err)
+				continue
+			}
+			slog.DebugContext(ctx, "synced hotel ratings", "count", synced)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+type ServerConfig struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+}
+
+type PostgresConfig struct {
+	Host     string `yaml:"host"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	DB       string `yaml:"db"`
+	SSLMode  string `yaml:"sslmode"`
+	Port     int    `yaml:"port"`
+}
+
+type ClientConfig struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+}
+
+// RatingConfig sets up the job pushing the average review score of hotels
+// whose reviews changed to the hotel service.
+type RatingConfig struct {
+	RefreshInterval time.Duration `yaml:"refresh_interval" env:"RATING_REFRESH_INTERVAL" env-default:"5m"`
+	BatchSize       int           `yaml:"batch_size" env:"RATING_BATCH_SIZE" env-default:"100"`
+}
+
+type Config struct {
+	Env            string         `yaml:"env"`
+	LogLevel       string         `yaml:"log_level"`
+	Postgres       PostgresConfig `yaml:"postgres"`
+	Server         ServerConfig   `yaml:"server"`
+	HotelService   ClientConfig   `yaml:"hotel_service"`
+	BookingService ClientConfig   `yaml:"booking_service"`
+	Rating         RatingConfig   `yaml:"rating"`
+}
+
+func New(configPath string) (*Config, error) {
+	var config Config
+	if err := cleanenv.ReadConfig(configPath, &config); err != nil {
+		return nil, err
+	}
+
+	if err := cleanenv.ReadEnv(&config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	bookingv1 "booking/api/booking/v1"
+	"review/internal/config"
+	"review/internal/repository/models"
+	"review/internal/utils/consts"
+)
+
+type BookingClient struct {
+	conn     *grpc.ClientConn
+	bookings bookingv1.BookingServiceClient
+}
+
+func NewBookingClient(cfg config.ClientConfig) (*BookingClient, error) {
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &BookingClient{
+		conn:     conn,
+		bookings: bookingv1.NewBookingServiceClient(conn),
+	}, nil
+}
+
+func (c *BookingClient) Close() error {
+	return c.conn.Close()
+}
+
+// GetStay returns who made the booking, for which hotel and how far the stay got.
+func (c *BookingClient) GetStay(ctx context.Context, bookingID uuid.UUID) (*models.Stay, error) {
+	resp, err := c.bookings.GetBooking(ctx, &bookingv1.GetBookingRequest{Id: bookingID.String()})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, consts.ErrBookingNotFound
+		}
+		return nil, fmt.Errorf("booking service: %w", err)
+	}
+
+	booking := resp.Booking
+	stay := &models.Stay{
+		CheckOut:  booking.CheckOut.AsTime(),
+		Status:    models.StayStatus(booking.Status.String()),
+		UserID:    booking.UserId,
+		BookingID: bookingID,
+	}
+	if stay.HotelID, err = uuid.Parse(booking.HotelId); err != nil {
+		return nil, err
+	}
+
+	return stay, nil
+}
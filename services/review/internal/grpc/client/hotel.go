@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	hotelv1 "hotel/api/hotel/v1"
+	"review/internal/config"
+	"review/internal/utils/consts"
+)
+
+type HotelClient struct {
+	conn   *grpc.ClientConn
+	hotels hotelv1.HotelServiceClient
+}
+
+func NewHotelClient(cfg config.ClientConfig) (*HotelClient, error) {
+	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &HotelClient{
+		conn:   conn,
+		hotels: hotelv1.NewHotelServiceClient(conn),
+	}, nil
+}
+
+func (c *HotelClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *HotelClient) GetHotelOwner(ctx context.Context, hotelID uuid.UUID) (int64, error) {
+	resp, err := c.hotels.GetHotelByID(ctx, &hotelv1.GetHotelByIDRequest{Id: hotelID.String()})
+	if err != nil {
+		return 0, hotelErrToDomain(err)
+	}
+
+	return resp.Hotel.OwnerId, nil
+}
+
+// UpdateHotelRating stores the hotel's average review score; a nil rating
+// clears it.
+func (c *HotelClient) UpdateHotelRating(ctx context.Context, hotelID uuid.UUID, rating *float32) error {
+	_, err := c.hotels.UpdateHotelRating(
+		ctx, &hotelv1.UpdateHotelRatingRequest{
+			Id:     hotelID.String(),
+			Rating: rating,
+		},
+	)
+	if err != nil {
+		return hotelErrToDomain(err)
+	}
+
+	return nil
+}
+
+func hotelErrToDomain(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return consts.ErrHotelNotFound
+	}
+	return fmt.Errorf("hotel service: %w", err)
+}
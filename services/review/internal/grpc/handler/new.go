@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+
+	"buf.build/go/protovalidate"
+	"github.com/google/uuid"
+
+	reviewv1 "review/api/review/v1"
+	"review/internal/repository/models"
+)
+
+type Service interface {
+	CreateReview(
+		ctx context.Context, bookingID uuid.UUID, actorID int64, scores models.ReviewScores, text string,
+	) (*models.Review, error)
+	GetReview(ctx context.Context, reviewID uuid.UUID) (*models.Review, error)
+	GetHotelReviews(
+		ctx context.Context, hotelID uuid.UUID, page uint64, limit uint64,
+	) (*models.ReviewList, *models.HotelRatingSummary, error)
+	ReplyToReview(ctx context.Context, reviewID uuid.UUID, actorID int64, text string) (*models.Review, error)
+	TakeDownReview(ctx context.Context, reviewID uuid.UUID, takedown *models.ReviewTakedown) (*models.Review, error)
+}
+
+type Handler struct {
+	reviewv1.UnimplementedReviewServiceServer
+	svc       Service
+	validator protovalidate.Validator
+}
+
+func New(svc Service, validator protovalidate.Validator) *Handler {
+	return &Handler{svc: svc, validator: validator}
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	reviewv1 "review/api/review/v1"
+	"review/internal/grpc/utils/helper"
+	"review/internal/grpc/utils/mapper"
+)
+
+func (h *Handler) CreateReview(
+	ctx context.Context,
+	req *reviewv1.CreateReviewRequest,
+) (*reviewv1.CreateReviewResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	bookingID, err := mapper.BookingIDToDomain(req.BookingId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	review, err := h.svc.CreateReview(
+		ctx, bookingID, req.ActorId, mapper.ReviewScoresToDomain(req.Scores), req.Text,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &reviewv1.CreateReviewResponse{
+		Review: mapper.ReviewToProto(review),
+	}, nil
+}
+
+func (h *Handler) GetReview(
+	ctx context.Context,
+	req *reviewv1.GetReviewRequest,
+) (*reviewv1.GetReviewResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	reviewID, err := mapper.ReviewIDToDomain(req.Id)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	review, err := h.svc.GetReview(ctx, reviewID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &reviewv1.GetReviewResponse{
+		Review: mapper.ReviewToProto(review),
+	}, nil
+}
+
+func (h *Handler) GetHotelReviews(
+	ctx context.Context,
+	req *reviewv1.GetHotelReviewsRequest,
+) (*reviewv1.GetHotelReviewsResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	hotelID, err := mapper.HotelIDToDomain(req.HotelId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	reviews, summary, err := h.svc.GetHotelReviews(ctx, hotelID, req.Page, req.Limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &reviewv1.GetHotelReviewsResponse{
+		Reviews:    mapper.ReviewListToProto(reviews.Reviews),
+		TotalCount: reviews.TotalCount,
+		Page:       req.Page,
+		Limit:      req.Limit,
+		Summary:    mapper.HotelRatingSummaryToProto(summary),
+	}, nil
+}
+
+func (h *Handler) ReplyToReview(
+	ctx context.Context,
+	req *reviewv1.ReplyToReviewRequest,
+) (*reviewv1.ReplyToReviewResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	reviewID, err := mapper.ReviewIDToDomain(req.ReviewId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	review, err := h.svc.ReplyToReview(ctx, reviewID, req.ActorId, req.Text)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &reviewv1.ReplyToReviewResponse{
+		Review: mapper.ReviewToProto(review),
+	}, nil
+}
+
+func (h *Handler) TakeDownReview(
+	ctx context.Context,
+	req *reviewv1.TakeDownReviewRequest,
+) (*reviewv1.TakeDownReviewResponse, error) {
+	if err := h.validator.Validate(req); err != nil {
+		return nil, helper.HandleValidationErr(err)
+	}
+
+	reviewID, err := mapper.ReviewIDToDomain(req.ReviewId)
+	if err != nil {
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	review, err := h.svc.TakeDownReview(ctx, reviewID, mapper.TakeDownReviewRequestToDomain(req))
+	if err != nil {
+		slog.ErrorContext(ctx, "failed", slog.String("error", err.Error()))
+		return nil, helper.HandleDomainErr(err)
+	}
+
+	return &reviewv1.TakeDownReviewResponse{
+		Review: mapper.ReviewToProto(review),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package helper
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"review/internal/utils/consts"
+)
+
+type domainErr struct {
+	message string
+	code    codes.Code
+}
+
+var (
+	errReviewNotFound        = domainErr{consts.MsgReviewNotFound, codes.NotFound}
+	errBookingNotFound       = domainErr{consts.MsgBookingNotFound, codes.NotFound}
+	errHotelNotFound         = domainErr{consts.MsgHotelNotFound, codes.NotFound}
+	errInvalidReviewID       = domainErr{consts.MsgInvalidReviewID, codes.InvalidArgument}
+	errInvalidBookingID      = domainErr{consts.MsgInvalidBookingID, codes.InvalidArgument}
+	errInvalidHotelID        = domainErr{consts.MsgInvalidHotelID, codes.InvalidArgument}
+	errReviewAlreadyExists   = domainErr{consts.MsgReviewAlreadyExists, codes.AlreadyExists}
+	errStayNotReviewable     = domainErr{consts.MsgStayNotReviewable, codes.FailedPrecondition}
+	errReviewActionForbidden = domainErr{consts.MsgReviewActionForbidden, codes.PermissionDenied}
+	errReviewRemoved         = domainErr{consts.MsgReviewRemoved, codes.FailedPrecondition}
+	errInternalServer        = domainErr{consts.MsgInternalServer, codes.Internal}
+)
+
+func HandleDomainErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var domErr domainErr
+	switch {
+	case errors.Is(err, consts.ErrReviewNotFound):
+		domErr = errReviewNotFound
+	case errors.Is(err, consts.ErrBookingNotFound):
+		domErr = errBookingNotFound
+	case errors.Is(err, consts.ErrHotelNotFound):
+		domErr = errHotelNotFound
+	case errors.Is(err, consts.ErrInvalidReviewID):
+		domErr = errInvalidReviewID
+	case errors.Is(err, consts.ErrInvalidBookingID):
+		domErr = errInvalidBookingID
+	case errors.Is(err, consts.ErrInvalidHotelID):
+		domErr = errInvalidHotelID
+	case errors.Is(err, consts.ErrReviewAlreadyExists):
+		domErr = errReviewAlreadyExists
+	case errors.Is(err, consts.ErrStayNotReviewable):
+		domErr = errStayNotReviewable
+	case errors.Is(err, consts.ErrReviewActionForbidden):
+		domErr = errReviewActionForbidden
+	case errors.Is(err, consts.ErrReviewRemoved):
+		domErr = errReviewRemoved
+	default:
+		domErr = errInternalServer
+	}
+
+	ei := &errdetails.ErrorInfo{
+		Reason: domErr.message,
+		Domain: "review-service",
+	}
+
+	st, _ := status.New(domErr.code, "operation failed").WithDetails(ei)
+	return st.Err()
+}
@@ -0,0 +1,53 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+
+	reviewv1 "review/api/review/v1"
+	"review/internal/repository/models"
+	"review/internal/utils/consts"
+)
+
+func ReviewIDToDomain(idStr string) (uuid.UUID, error) {
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return uuid.UUID{}, consts.ErrInvalidReviewID
+	}
+
+	return id, nil
+}
+
+func BookingIDToDomain(idStr string) (uuid.UUID, error) {
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return uuid.UUID{}, consts.ErrInvalidBookingID
+	}
+
+	return id, nil
+}
+
+func HotelIDToDomain(idStr string) (uuid.UUID, error) {
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return uuid.UUID{}, consts.ErrInvalidHotelID
+	}
+
+	return id, nil
+}
+
+// ReviewScoresToDomain reads scores validation already kept within 1..5.
+func ReviewScoresToDomain(s *reviewv1.CreateReviewScores) models.ReviewScores {
+	return models.ReviewScores{
+		Cleanliness: int16(s.Cleanliness),
+		Location:    int16(s.Location),
+		Staff:       int16(s.Staff),
+	}
+}
+
+func TakeDownReviewRequestToDomain(req *reviewv1.TakeDownReviewRequest) *models.ReviewTakedown {
+	return &models.ReviewTakedown{
+		Reason:    req.Reason,
+		ActorRole: models.UserRole(req.ActorRole.String()),
+		ActorID:   req.ActorId,
+	}
+}
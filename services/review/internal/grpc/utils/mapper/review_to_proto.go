@@ -0,0 +1,74 @@
+package mapper
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	reviewv1 "review/api/review/v1"
+	"review/internal/repository/models"
+)
+
+func reviewStatusToProto(status models.ReviewStatus) reviewv1.ReviewStatus {
+	switch status {
+	case models.ReviewStatusPublished:
+		return reviewv1.ReviewStatus_REVIEW_STATUS_PUBLISHED
+	case models.ReviewStatusRemoved:
+		return reviewv1.ReviewStatus_REVIEW_STATUS_REMOVED
+	default:
+		return reviewv1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED
+	}
+}
+
+func ReviewToProto(r *models.Review) *reviewv1.Review {
+	if r == nil {
+		return nil
+	}
+
+	review := &reviewv1.Review{
+		Id:        r.ID.String(),
+		HotelId:   r.HotelID.String(),
+		BookingId: r.BookingID.String(),
+		AuthorId:  r.AuthorID,
+		Scores: &reviewv1.ReviewScores{
+			Cleanliness: uint32(r.Scores.Cleanliness),
+			Location:    uint32(r.Scores.Location),
+			Staff:       uint32(r.Scores.Staff),
+		},
+		Overall:       r.Overall,
+		Text:          r.Text,
+		Status:        reviewStatusToProto(r.Status),
+		RemovalReason: r.RemovalReason,
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+		UpdatedAt:     timestamppb.New(r.UpdatedAt),
+	}
+	if r.Reply != nil {
+		review.Reply = &reviewv1.ReviewReply{
+			AuthorId:  r.Reply.AuthorID,
+			Text:      r.Reply.Text,
+			CreatedAt: timestamppb.New(r.Reply.CreatedAt),
+		}
+	}
+
+	return review
+}
+
+func ReviewListToProto(reviews []*models.Review) []*reviewv1.Review {
+	result := make([]*reviewv1.Review, len(reviews))
+	for i, r := range reviews {
+		result[i] = ReviewToProto(r)
+	}
+	return result
+}
+
+func HotelRatingSummaryToProto(s *models.HotelRatingSummary) *reviewv1.HotelRatingSummary {
+	if s == nil {
+		return nil
+	}
+
+	return &reviewv1.HotelRatingSummary{
+		Rating:      s.Rating,
+		ReviewCount: s.ReviewCount,
+		Cleanliness: s.Cleanliness,
+		Location:    s.Location,
+		Staff:       s.Staff,
+	}
+}
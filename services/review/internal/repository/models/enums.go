@@ -0,0 +1,24 @@
+package models
+
+type ReviewStatus string
+type UserRole string
+type StayStatus string
+
+const (
+	ReviewStatusPublished   ReviewStatus = "REVIEW_STATUS_PUBLISHED"
+	ReviewStatusRemoved     ReviewStatus = "REVIEW_STATUS_REMOVED"
+	ReviewStatusUnspecified ReviewStatus = "REVIEW_STATUS_UNSPECIFIED"
+)
+
+const (
+	UserRoleUnspecified UserRole = "USER_ROLE_UNSPECIFIED"
+	UserRoleUser        UserRole = "USER_ROLE_USER"
+	UserRoleModerator   UserRole = "USER_ROLE_MODERATOR"
+	UserRoleAdmin       UserRole = "USER_ROLE_ADMIN"
+)
+
+// StayStatus mirrors the booking status names of the booking service.
+const (
+	StayStatusConfirmed  StayStatus = "BOOKING_STATUS_CONFIRMED"
+	StayStatusCheckedOut StayStatus = "BOOKING_STATUS_CHECKED_OUT"
+)
@@ -0,0 +1,21 @@
+package models
+
+import "github.com/google/uuid"
+
+// HotelRatingSummary aggregates the published reviews of a hotel; Rating is
+// nil while the hotel has none.
+type HotelRatingSummary struct {
+	Rating      *float32
+	ReviewCount uint64
+	Cleanliness float32
+	Location    float32
+	Staff       float32
+}
+
+// HotelRating is the average score of a hotel the rating job pushes to the
+// hotel service.
+type HotelRating struct {
+	Rating      *float32
+	ReviewCount uint64
+	HotelID     uuid.UUID
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReviewScores struct {
+	Cleanliness int16
+	Location    int16
+	Staff       int16
+}
+
+type ReviewReply struct {
+	CreatedAt time.Time
+	Text      string
+	AuthorID  int64
+}
+
+type Review struct {
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Reply         *ReviewReply
+	RemovalReason *string
+	Text          string
+	Status        ReviewStatus
+	AuthorID      int64
+	Overall       float32
+	Scores        ReviewScores
+	ID            uuid.UUID
+	HotelID       uuid.UUID
+	BookingID     uuid.UUID
+}
+
+type CreateReview struct {
+	Text      string
+	AuthorID  int64
+	Scores    ReviewScores
+	BookingID uuid.UUID
+	HotelID   uuid.UUID
+}
+
+type ReviewList struct {
+	Reviews    []*Review
+	TotalCount uint64
+}
+
+// ReviewTakedown is a moderator's request to hide a review.
+type ReviewTakedown struct {
+	Reason    string
+	ActorRole UserRole
+	ActorID   int64
+}
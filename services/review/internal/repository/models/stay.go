@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Stay is the part of a booking the review service needs to tell whether its
+// guest may review the hotel.
+type Stay struct {
+	CheckOut  time.Time
+	Status    StayStatus
+	UserID    int64
+	BookingID uuid.UUID
+	HotelID   uuid.UUID
+}
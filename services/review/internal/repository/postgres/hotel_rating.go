@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"review/internal/repository/models"
+	"review/internal/repository/postgres/query"
+)
+
+// MarkHotelRatingDirty queues the hotel for the next rating sync.
+func (r *Repository) MarkHotelRatingDirty(ctx context.Context, tx pgx.Tx, hotelID uuid.UUID) error {
+	db := r.executor(tx)
+
+	_, err := db.Exec(ctx, query.MarkHotelRatingDirty, hotelID)
+	return err
+}
+
+// GetDirtyHotelRatingsForUpdate locks up to limit hotels waiting for a rating
+// sync until tx ends and returns their current ratings. Hotels already locked
+// by another sync are skipped.
+func (r *Repository) GetDirtyHotelRatingsForUpdate(
+	ctx context.Context,
+	tx pgx.Tx,
+	limit int,
+) ([]*models.HotelRating, error) {
+	db := r.executor(tx)
+
+	rows, err := db.Query(ctx, query.GetDirtyHotelRatingsForUpdate, limit)
+	if err != nil {
+		return nil, err
+	}
+	hotelIDs, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, err
+	}
+	if len(hotelIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err = db.Query(ctx, query.GetHotelRatings, hotelIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	byHotel := make(map[uuid.UUID]*models.HotelRating, len(hotelIDs))
+	for rows.Next() {
+		var hr models.HotelRating
+		if err = rows.Scan(&hr.HotelID, &hr.Rating, &hr.ReviewCount); err != nil {
+			return nil, err
+		}
+		byHotel[hr.HotelID] = &hr
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ratings := make([]*models.HotelRating, len(hotelIDs))
+	for i, hotelID := range hotelIDs {
+		if hr, ok := byHotel[hotelID]; ok {
+			ratings[i] = hr
+			continue
+		}
+		ratings[i] = &models.HotelRating{HotelID: hotelID}
+	}
+
+	return ratings, nil
+}
+
+func (r *Repository) MarkHotelRatingSynced(ctx context.Context, tx pgx.Tx, hr *models.HotelRating) error {
+	db := r.executor(tx)
+
+	_, err := db.Exec(ctx, query.MarkHotelRatingSynced, hr.Rating, hr.ReviewCount, hr.HotelID)
+	return err
+}
@@ -0,0 +1,36 @@
+package query
+
+const (
+	MarkHotelRatingDirty = `
+		INSERT INTO hotel_rating (hotel_id)
+		VALUES ($1)
+		ON CONFLICT (hotel_id) DO UPDATE
+		SET dirty = TRUE,
+			marked_at = now();`
+
+	GetDirtyHotelRatingsForUpdate = `
+		SELECT hotel_id
+		FROM hotel_rating
+		WHERE dirty
+		ORDER BY marked_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED;`
+
+	GetHotelRatings = `
+		SELECT
+			hotel_id,
+			ROUND(AVG(overall), 2)::real,
+			COUNT(*)
+		FROM review
+		WHERE hotel_id = ANY($1)
+		  AND status = 'REVIEW_STATUS_PUBLISHED'
+		GROUP BY hotel_id;`
+
+	MarkHotelRatingSynced = `
+		UPDATE hotel_rating
+		SET rating = $1,
+			review_count = $2,
+			dirty = FALSE,
+			synced_at = now()
+		WHERE hotel_id = $3;`
+)
@@ -0,0 +1,140 @@
+package query
+
+const (
+	CreateReview = `
+		INSERT INTO review (
+			hotel_id,
+			booking_id,
+			author_id,
+			cleanliness,
+			location,
+			staff,
+			text
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING
+			id,
+			hotel_id,
+			booking_id,
+			author_id,
+			cleanliness,
+			location,
+			staff,
+			overall::real,
+			text,
+			status,
+			reply_text,
+			reply_author_id,
+			replied_at,
+			removal_reason,
+			created_at,
+			updated_at;`
+
+	GetReviewByID = `
+		SELECT
+			id,
+			hotel_id,
+			booking_id,
+			author_id,
+			cleanliness,
+			location,
+			staff,
+			overall::real,
+			text,
+			status,
+			reply_text,
+			reply_author_id,
+			replied_at,
+			removal_reason,
+			created_at,
+			updated_at
+		FROM review
+		WHERE id = $1;`
+
+	GetHotelReviews = `
+		SELECT
+			id,
+			hotel_id,
+			booking_id,
+			author_id,
+			cleanliness,
+			location,
+			staff,
+			overall::real,
+			text,
+			status,
+			reply_text,
+			reply_author_id,
+			replied_at,
+			removal_reason,
+			created_at,
+			updated_at,
+			COUNT(*) OVER() AS total_count
+		FROM review
+		WHERE hotel_id = $1
+		  AND status = 'REVIEW_STATUS_PUBLISHED'
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3;`
+
+	GetHotelRatingSummary = `
+		SELECT
+			ROUND(AVG(overall), 2)::real,
+			COUNT(*),
+			COALESCE(ROUND(AVG(cleanliness), 2), 0)::real,
+			COALESCE(ROUND(AVG(location), 2), 0)::real,
+			COALESCE(ROUND(AVG(staff), 2), 0)::real
+		FROM review
+		WHERE hotel_id = $1
+		  AND status = 'REVIEW_STATUS_PUBLISHED';`
+
+	UpdateReviewReply = `
+		UPDATE review
+		SET reply_text = $1,
+			reply_author_id = $2,
+			replied_at = now()
+		WHERE id = $3
+		  AND status = 'REVIEW_STATUS_PUBLISHED'
+		RETURNING
+			id,
+			hotel_id,
+			booking_id,
+			author_id,
+			cleanliness,
+			location,
+			staff,
+			overall::real,
+			text,
+			status,
+			reply_text,
+			reply_author_id,
+			replied_at,
+			removal_reason,
+			created_at,
+			updated_at;`
+
+	RemoveReview = `
+		UPDATE review
+		SET status = 'REVIEW_STATUS_REMOVED',
+			removed_by = $1,
+			removal_reason = $2,
+			removed_at = now()
+		WHERE id = $3
+		  AND status = 'REVIEW_STATUS_PUBLISHED'
+		RETURNING
+			id,
+			hotel_id,
+			booking_id,
+			author_id,
+			cleanliness,
+			location,
+			staff,
+			overall::real,
+			text,
+			status,
+			reply_text,
+			reply_author_id,
+			replied_at,
+			removal_reason,
+			created_at,
+			updated_at;`
+)
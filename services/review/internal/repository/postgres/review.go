@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"review/internal/repository/models"
+	"review/internal/repository/postgres/query"
+	"review/internal/utils/consts"
+)
+
+func (r *Repository) CreateReview(ctx context.Context, tx pgx.Tx, rv *models.CreateReview) (*models.Review, error) {
+	if rv == nil {
+		return nil, consts.ErrNilObject
+	}
+
+	db := r.executor(tx)
+
+	review, err := scanReview(
+		db.QueryRow(
+			ctx,
+			query.CreateReview,
+			rv.HotelID,
+			rv.BookingID,
+			rv.AuthorID,
+			rv.Scores.Cleanliness,
+			rv.Scores.Location,
+			rv.Scores.Staff,
+			rv.Text,
+		),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, consts.ErrReviewAlreadyExists
+		}
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (r *Repository) GetReviewByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*models.Review, error) {
+	db := r.executor(tx)
+
+	review, err := scanReview(db.QueryRow(ctx, query.GetReviewByID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrReviewNotFound
+		}
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// GetHotelReviews pages through the published reviews of a hotel, newest first.
+func (r *Repository) GetHotelReviews(
+	ctx context.Context,
+	tx pgx.Tx,
+	hotelID uuid.UUID,
+	limit uint64,
+	offset uint64,
+) (*models.ReviewList, error) {
+	db := r.executor(tx)
+
+	rows, err := db.Query(ctx, query.GetHotelReviews, hotelID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := &models.ReviewList{Reviews: make([]*models.Review, 0, limit)}
+	for rows.Next() {
+		review, err := scanReview(rows, &list.TotalCount)
+		if err != nil {
+			return nil, err
+		}
+		list.Reviews = append(list.Reviews, review)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (r *Repository) GetHotelRatingSummary(
+	ctx context.Context,
+	tx pgx.Tx,
+	hotelID uuid.UUID,
+) (*models.HotelRatingSummary, error) {
+	db := r.executor(tx)
+
+	var s models.HotelRatingSummary
+	err := db.QueryRow(ctx, query.GetHotelRatingSummary, hotelID).Scan(
+		&s.Rating,
+		&s.ReviewCount,
+		&s.Cleanliness,
+		&s.Location,
+		&s.Staff,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// UpdateReviewReply sets the hotel's reply on a published review, replacing
+// an earlier one.
+func (r *Repository) UpdateReviewReply(
+	ctx context.Context,
+	tx pgx.Tx,
+	id uuid.UUID,
+	authorID int64,
+	text string,
+) (*models.Review, error) {
+	db := r.executor(tx)
+
+	review, err := scanReview(db.QueryRow(ctx, query.UpdateReviewReply, text, authorID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrReviewRemoved
+		}
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// RemoveReview takes a published review down.
+func (r *Repository) RemoveReview(
+	ctx context.Context,
+	tx pgx.Tx,
+	id uuid.UUID,
+	takedown *models.ReviewTakedown,
+) (*models.Review, error) {
+	if takedown == nil {
+		return nil, consts.ErrNilObject
+	}
+
+	db := r.executor(tx)
+
+	review, err := scanReview(db.QueryRow(ctx, query.RemoveReview, takedown.ActorID, takedown.Reason, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, consts.ErrReviewRemoved
+		}
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// scanReview reads a review row followed by any extra columns into extra.
+func scanReview(row pgx.Row, extra ...any) (*models.Review, error) {
+	var (
+		rv            models.Review
+		replyText     *string
+		replyAuthorID *int64
+		repliedAt     *time.Time
+	)
+	dest := []any{
+		&rv.ID,
+		&rv.HotelID,
+		&rv.BookingID,
+		&rv.AuthorID,
+		&rv.Scores.Cleanliness,
+		&rv.Scores.Location,
+		&rv.Scores.Staff,
+		&rv.Overall,
+		&rv.Text,
+		&rv.Status,
+		&replyText,
+		&replyAuthorID,
+		&repliedAt,
+		&rv.RemovalReason,
+		&rv.CreatedAt,
+		&rv.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	if replyText != nil && replyAuthorID != nil && repliedAt != nil {
+		rv.Reply = &models.ReviewReply{
+			CreatedAt: *repliedAt,
+			Text:      *replyText,
+			AuthorID:  *replyAuthorID,
+		}
+	}
+
+	return &rv, nil
+}
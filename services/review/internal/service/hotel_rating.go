@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"review/internal/utils/consts"
+)
+
+// SyncHotelRatings pushes the average score of up to batchSize hotels whose
+// reviews changed to the hotel service and returns how many were synced.
+// Hotels the hotel service fails to update stay queued for the next run; hotels
+// it no longer knows are dropped from the queue.
+func (s *Service) SyncHotelRatings(ctx context.Context, batchSize int) (int, error) {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	ratings, err := s.repo.GetDirtyHotelRatingsForUpdate(ctx, tx, batchSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get dirty hotel ratings", "err", err)
+		return 0, err
+	}
+
+	var synced int
+	for _, hr := range ratings {
+		err = s.hotel.UpdateHotelRating(ctx, hr.HotelID, hr.Rating)
+		if err != nil && !errors.Is(err, consts.ErrHotelNotFound) {
+			slog.ErrorContext(ctx, "failed to update hotel rating", "hotel_id", hr.HotelID, "err", err)
+			continue
+		}
+
+		if err = s.repo.MarkHotelRatingSynced(ctx, tx, hr); err != nil {
+			slog.ErrorContext(ctx, "failed to mark hotel rating synced", "err", err)
+			return 0, err
+		}
+		synced++
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return 0, err
+	}
+
+	return synced, nil
+}
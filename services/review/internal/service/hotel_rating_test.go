@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+
+	"review/internal/mocks"
+	"review/internal/repository/models"
+	"review/internal/utils/consts"
+)
+
+func TestSyncHotelRatings(t *testing.T) {
+	rating := float32(4.25)
+	rated := &models.HotelRating{HotelID: uuid.New(), Rating: &rating, ReviewCount: 4}
+	// Its last review was taken down: the hotel goes back to having no rating.
+	unrated := &models.HotelRating{HotelID: uuid.New()}
+	failing := &models.HotelRating{HotelID: uuid.New(), Rating: &rating, ReviewCount: 4}
+	gone := &models.HotelRating{HotelID: uuid.New(), Rating: &rating, ReviewCount: 1}
+
+	repo := mocks.NewMockRepository(t)
+	hotel := mocks.NewMockHotelClient(t)
+	tx := expectTx(t, repo, true)
+
+	repo.EXPECT().GetDirtyHotelRatingsForUpdate(mock.Anything, tx, 10).
+		Return([]*models.HotelRating{rated, unrated, failing, gone}, nil)
+	hotel.EXPECT().UpdateHotelRating(mock.Anything, rated.HotelID, &rating).Return(nil)
+	hotel.EXPECT().UpdateHotelRating(mock.Anything, unrated.HotelID, (*float32)(nil)).Return(nil)
+	hotel.EXPECT().UpdateHotelRating(mock.Anything, failing.HotelID, &rating).Return(errors.New("hotel unavailable"))
+	hotel.EXPECT().UpdateHotelRating(mock.Anything, gone.HotelID, &rating).Return(consts.ErrHotelNotFound)
+
+	var synced []uuid.UUID
+	repo.EXPECT().MarkHotelRatingSynced(mock.Anything, tx, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ pgx.Tx, hr *models.HotelRating) error {
+			synced = append(synced, hr.HotelID)
+			return nil
+		})
+
+	n, err := New(repo, nil, hotel).SyncHotelRatings(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("SyncHotelRatings() error = %v", err)
+	}
+
+	// The failed hotel stays dirty for the next run; the unknown one is dropped.
+	want := []uuid.UUID{rated.HotelID, unrated.HotelID, gone.HotelID}
+	if n != len(want) {
+		t.Errorf("synced = %d, want %d", n, len(want))
+	}
+	if !slices.Equal(synced, want) {
+		t.Errorf("marked synced %v, want %v", synced, want)
+	}
+}
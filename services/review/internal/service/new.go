@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"review/internal/repository/models"
+)
+
+type ReviewTransactionRepository interface {
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+}
+
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, tx pgx.Tx, rv *models.CreateReview) (*models.Review, error)
+	GetReviewByID(ctx context.Context, tx pgx.Tx, id uuid.UUID) (*models.Review, error)
+	GetHotelReviews(
+		ctx context.Context, tx pgx.Tx, hotelID uuid.UUID, limit uint64, offset uint64,
+	) (*models.ReviewList, error)
+	GetHotelRatingSummary(ctx context.Context, tx pgx.Tx, hotelID uuid.UUID) (*models.HotelRatingSummary, error)
+	UpdateReviewReply(
+		ctx context.Context, tx pgx.Tx, id uuid.UUID, authorID int64, text string,
+	) (*models.Review, error)
+	RemoveReview(ctx context.Context, tx pgx.Tx, id uuid.UUID, takedown *models.ReviewTakedown) (*models.Review, error)
+}
+
+type HotelRatingRepository interface {
+	MarkHotelRatingDirty(ctx context.Context, tx pgx.Tx, hotelID uuid.UUID) error
+	GetDirtyHotelRatingsForUpdate(ctx context.Context, tx pgx.Tx, limit int) ([]*models.HotelRating, error)
+	MarkHotelRatingSynced(ctx context.Context, tx pgx.Tx, hr *models.HotelRating) error
+}
+
+type Repository interface {
+	ReviewTransactionRepository
+	ReviewRepository
+	HotelRatingRepository
+}
+
+type BookingClient interface {
+	GetStay(ctx context.Context, bookingID uuid.UUID) (*models.Stay, error)
+}
+
+type HotelClient interface {
+	GetHotelOwner(ctx context.Context, hotelID uuid.UUID) (int64, error)
+	UpdateHotelRating(ctx context.Context, hotelID uuid.UUID, rating *float32) error
+}
+
+type Service struct {
+	repo    Repository
+	booking BookingClient
+	hotel   HotelClient
+}
+
+func New(repo Repository, booking BookingClient, hotel HotelClient) *Service {
+	return &Service{repo: repo, booking: booking, hotel: hotel}
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"review/internal/repository/models"
+	"review/internal/service/utils/helper"
+	"review/internal/utils/consts"
+)
+
+// CreateReview lets the guest of a finished stay review the hotel once per
+// booking. The stay is verified with the booking service.
+func (s *Service) CreateReview(
+	ctx context.Context,
+	bookingID uuid.UUID,
+	actorID int64,
+	scores models.ReviewScores,
+	text string,
+) (*models.Review, error) {
+	stay, err := s.booking.GetStay(ctx, bookingID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get booking", "err", err)
+		return nil, err
+	}
+	if err = helper.CheckStayReviewable(stay, actorID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	review, err := s.repo.CreateReview(
+		ctx, tx, &models.CreateReview{
+			Text:      text,
+			AuthorID:  actorID,
+			Scores:    scores,
+			BookingID: bookingID,
+			HotelID:   stay.HotelID,
+		},
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create review", "err", err)
+		return nil, err
+	}
+
+	if err = s.repo.MarkHotelRatingDirty(ctx, tx, review.HotelID); err != nil {
+		slog.ErrorContext(ctx, "failed to mark hotel rating dirty", "err", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (s *Service) GetReview(ctx context.Context, reviewID uuid.UUID) (*models.Review, error) {
+	review, err := s.repo.GetReviewByID(ctx, nil, reviewID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get review by id", "err", err)
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// GetHotelReviews pages through the published reviews of a hotel together
+// with their summary.
+func (s *Service) GetHotelReviews(
+	ctx context.Context,
+	hotelID uuid.UUID,
+	page uint64,
+	limit uint64,
+) (*models.ReviewList, *models.HotelRatingSummary, error) {
+	reviews, err := s.repo.GetHotelReviews(ctx, nil, hotelID, limit, (page-1)*limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get hotel reviews", "err", err)
+		return nil, nil, err
+	}
+
+	summary, err := s.repo.GetHotelRatingSummary(ctx, nil, hotelID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get hotel rating summary", "err", err)
+		return nil, nil, err
+	}
+
+	return reviews, summary, nil
+}
+
+// ReplyToReview sets the hotel owner's reply on a published review.
+func (s *Service) ReplyToReview(
+	ctx context.Context,
+	reviewID uuid.UUID,
+	actorID int64,
+	text string,
+) (*models.Review, error) {
+	review, err := s.repo.GetReviewByID(ctx, nil, reviewID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get review by id", "err", err)
+		return nil, err
+	}
+	if review.Status == models.ReviewStatusRemoved {
+		return nil, consts.ErrReviewRemoved
+	}
+
+	ownerID, err := s.hotel.GetHotelOwner(ctx, review.HotelID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get hotel owner", "err", err)
+		return nil, err
+	}
+	if ownerID != actorID {
+		return nil, consts.ErrReviewActionForbidden
+	}
+
+	review, err = s.repo.UpdateReviewReply(ctx, nil, reviewID, actorID, text)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update review reply", "err", err)
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// TakeDownReview lets a moderator hide a published review; the hotel's rating
+// is recalculated without it.
+func (s *Service) TakeDownReview(
+	ctx context.Context,
+	reviewID uuid.UUID,
+	takedown *models.ReviewTakedown,
+) (*models.Review, error) {
+	if takedown == nil {
+		return nil, consts.ErrNilObject
+	}
+	if err := helper.CheckReviewTakedown(takedown); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "err", err)
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err = s.repo.GetReviewByID(ctx, tx, reviewID); err != nil {
+		slog.ErrorContext(ctx, "failed to get review by id", "err", err)
+		return nil, err
+	}
+
+	review, err := s.repo.RemoveReview(ctx, tx, reviewID, takedown)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to remove review", "err", err)
+		return nil, err
+	}
+
+	if err = s.repo.MarkHotelRatingDirty(ctx, tx, review.HotelID); err != nil {
+		slog.ErrorContext(ctx, "failed to mark hotel rating dirty", "err", err)
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "err", err)
+		return nil, err
+	}
+
+	return review, nil
+}
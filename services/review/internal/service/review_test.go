@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"review/internal/mocks"
+	"review/internal/repository/models"
+	"review/internal/utils/consts"
+)
+
+// expectTx expects one transaction that rolls back when the service returns
+// and commits only when commit is set.
+func expectTx(t *testing.T, repo *mocks.MockRepository, commit bool) *mocks.MockTx {
+	tx := mocks.NewMockTx(t)
+	repo.EXPECT().BeginTx(mock.Anything).Return(tx, nil).Once()
+	tx.EXPECT().Rollback(mock.Anything).Return(nil).Maybe()
+	if commit {
+		tx.EXPECT().Commit(mock.Anything).Return(nil).Once()
+	}
+
+	return tx
+}
+
+func TestCreateReview(t *testing.T) {
+	const guestID = 7
+	hotelID := uuid.New()
+	scores := models.ReviewScores{Cleanliness: 5, Location: 4, Staff: 5}
+
+	tests := []struct {
+		name      string
+		stay      *models.Stay
+		stayErr   error
+		createErr error
+		wantErr   error
+	}{
+		{
+			name: "checked-out guest",
+			stay: &models.Stay{UserID: guestID, Status: models.StayStatusCheckedOut, CheckOut: time.Now()},
+		},
+		{
+			name: "confirmed stay that is over",
+			stay: &models.Stay{UserID: guestID, Status: models.StayStatusConfirmed, CheckOut: time.Now().Add(-time.Hour)},
+		},
+		{
+			name:    "stay still ongoing",
+			stay:    &models.Stay{UserID: guestID, Status: models.StayStatusConfirmed, CheckOut: time.Now().Add(time.Hour)},
+			wantErr: consts.ErrStayNotReviewable,
+		},
+		{
+			name:    "someone else's booking",
+			stay:    &models.Stay{UserID: guestID + 1, Status: models.StayStatusCheckedOut, CheckOut: time.Now()},
+			wantErr: consts.ErrReviewActionForbidden,
+		},
+		{
+			name:    "unknown booking",
+			stayErr: consts.ErrBookingNotFound,
+			wantErr: consts.ErrBookingNotFound,
+		},
+		{
+			// The unique booking_id index turns a second review into a conflict.
+			name:      "stay already reviewed",
+			stay:      &models.Stay{UserID: guestID, Status: models.StayStatusCheckedOut, CheckOut: time.Now()},
+			createErr: consts.ErrReviewAlreadyExists,
+			wantErr:   consts.ErrReviewAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(t)
+			booking := mocks.NewMockBookingClient(t)
+			bookingID := uuid.New()
+
+			if tt.stay != nil {
+				tt.stay.BookingID, tt.stay.HotelID = bookingID, hotelID
+			}
+			booking.EXPECT().GetStay(mock.Anything, bookingID).Return(tt.stay, tt.stayErr)
+
+			// Only a reviewable stay reaches the database.
+			if tt.stay != nil && (tt.wantErr == nil || tt.createErr != nil) {
+				tx := expectTx(t, repo, tt.wantErr == nil)
+				want := &models.CreateReview{
+					Text: "Quiet room", AuthorID: guestID, Scores: scores, BookingID: bookingID, HotelID: hotelID,
+				}
+				created := repo.EXPECT().CreateReview(mock.Anything, tx, want)
+				if tt.createErr != nil {
+					created.Return(nil, tt.createErr)
+				} else {
+					created.Return(&models.Review{ID: uuid.New(), HotelID: hotelID, BookingID: bookingID}, nil)
+					repo.EXPECT().MarkHotelRatingDirty(mock.Anything, tx, hotelID).Return(nil).Once()
+				}
+			}
+
+			review, err := New(repo, booking, nil).CreateReview(context.Background(), bookingID, guestID, scores, "Quiet room")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateReview() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && review.BookingID != bookingID {
+				t.Errorf("review booking = %s, want %s", review.BookingID, bookingID)
+			}
+		})
+	}
+}
+
+func TestTakeDownReview(t *testing.T) {
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name    string
+		role    models.UserRole
+		wantErr error
+	}{
+		{name: "moderator", role: models.UserRoleModerator},
+		{name: "guest", role: models.UserRoleUser, wantErr: consts.ErrReviewActionForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockRepository(t)
+			reviewID := uuid.New()
+			takedown := &models.ReviewTakedown{Reason: "spam", ActorRole: tt.role, ActorID: 1}
+
+			if tt.wantErr == nil {
+				// The hotel's rating is recalculated without the removed review.
+				tx := expectTx(t, repo, true)
+				repo.EXPECT().GetReviewByID(mock.Anything, tx, reviewID).Return(&models.Review{ID: reviewID}, nil)
+				repo.EXPECT().RemoveReview(mock.Anything, tx, reviewID, takedown).
+					Return(&models.Review{ID: reviewID, HotelID: hotelID, Status: models.ReviewStatusRemoved}, nil)
+				repo.EXPECT().MarkHotelRatingDirty(mock.Anything, tx, hotelID).Return(nil).Once()
+			}
+
+			review, err := New(repo, nil, nil).TakeDownReview(context.Background(), reviewID, takedown)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TakeDownReview() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && review.Status != models.ReviewStatusRemoved {
+				t.Errorf("review status = %s, want %s", review.Status, models.ReviewStatusRemoved)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package helper
+
+import (
+	"slices"
+	"time"
+
+	"review/internal/repository/models"
+	"review/internal/utils/consts"
+)
+
+// moderatorRoles may take any review down.
+var moderatorRoles = []models.UserRole{models.UserRoleModerator, models.UserRoleAdmin}
+
+// CheckStayReviewable reports whether the actor may review the stay: it must
+// be their own booking and the guest must have checked out, or the confirmed
+// stay must be over.
+func CheckStayReviewable(stay *models.Stay, actorID int64, now time.Time) error {
+	if stay.UserID != actorID {
+		return consts.ErrReviewActionForbidden
+	}
+
+	switch stay.Status {
+	case models.StayStatusCheckedOut:
+		return nil
+	case models.StayStatusConfirmed:
+		if !stay.CheckOut.After(now) {
+			return nil
+		}
+	}
+
+	return consts.ErrStayNotReviewable
+}
+
+func CheckReviewTakedown(takedown *models.ReviewTakedown) error {
+	if !slices.Contains(moderatorRoles, takedown.ActorRole) {
+		return consts.ErrReviewActionForbidden
+	}
+
+	return nil
+}
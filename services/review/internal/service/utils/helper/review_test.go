@@ -0,0 +1,69 @@
+package helper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"review/internal/repository/models"
+	"review/internal/utils/consts"
+)
+
+func TestCheckStayReviewable(t *testing.T) {
+	now := time.Date(2026, 7, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		stay    models.Stay
+		actorID int64
+		wantErr error
+	}{
+		{
+			name:    "checked out",
+			stay:    models.Stay{UserID: 1, Status: models.StayStatusCheckedOut, CheckOut: now.AddDate(0, 0, 1)},
+			actorID: 1,
+		},
+		{
+			name:    "confirmed stay over",
+			stay:    models.Stay{UserID: 1, Status: models.StayStatusConfirmed, CheckOut: now.AddDate(0, 0, -1)},
+			actorID: 1,
+		},
+		{
+			name:    "confirmed stay ongoing",
+			stay:    models.Stay{UserID: 1, Status: models.StayStatusConfirmed, CheckOut: now.AddDate(0, 0, 1)},
+			actorID: 1,
+			wantErr: consts.ErrStayNotReviewable,
+		},
+		{
+			name:    "cancelled",
+			stay:    models.Stay{UserID: 1, Status: "BOOKING_STATUS_CANCELLED", CheckOut: now.AddDate(0, 0, -1)},
+			actorID: 1,
+			wantErr: consts.ErrStayNotReviewable,
+		},
+		{
+			name:    "someone else's booking",
+			stay:    models.Stay{UserID: 2, Status: models.StayStatusCheckedOut, CheckOut: now.AddDate(0, 0, -1)},
+			actorID: 1,
+			wantErr: consts.ErrReviewActionForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckStayReviewable(&tt.stay, tt.actorID, now); !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckReviewTakedown(t *testing.T) {
+	for role, wantErr := range map[models.UserRole]error{
+		models.UserRoleUser:      consts.ErrReviewActionForbidden,
+		models.UserRoleModerator: nil,
+		models.UserRoleAdmin:     nil,
+	} {
+		if err := CheckReviewTakedown(&models.ReviewTakedown{ActorRole: role}); !errors.Is(err, wantErr) {
+			t.Errorf("%s: err = %v, want %v", role, err, wantErr)
+		}
+	}
+}
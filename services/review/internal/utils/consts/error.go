@@ -0,0 +1,33 @@
+package consts
+
+import "errors"
+
+const (
+	MsgNilObject             = "object cannot be nil"
+	MsgReviewNotFound        = "review not found"
+	MsgBookingNotFound       = "booking not found"
+	MsgHotelNotFound         = "hotel not found"
+	MsgInvalidReviewID       = "invalid review ID"
+	MsgInvalidBookingID      = "invalid booking ID"
+	MsgInvalidHotelID        = "invalid hotel ID"
+	MsgInternalServer        = "internal server error"
+	MsgReviewAlreadyExists   = "booking has already been reviewed"
+	MsgStayNotReviewable     = "only guests whose stay has ended can review the hotel"
+	MsgReviewActionForbidden = "user is not allowed to perform this action on the review"
+	MsgReviewRemoved         = "review has been taken down"
+)
+
+var (
+	ErrNilObject             = errors.New(MsgNilObject)
+	ErrReviewNotFound        = errors.New(MsgReviewNotFound)
+	ErrBookingNotFound       = errors.New(MsgBookingNotFound)
+	ErrHotelNotFound         = errors.New(MsgHotelNotFound)
+	ErrInvalidReviewID       = errors.New(MsgInvalidReviewID)
+	ErrInvalidBookingID      = errors.New(MsgInvalidBookingID)
+	ErrInvalidHotelID        = errors.New(MsgInvalidHotelID)
+	ErrInternalServer        = errors.New(MsgInternalServer)
+	ErrReviewAlreadyExists   = errors.New(MsgReviewAlreadyExists)
+	ErrStayNotReviewable     = errors.New(MsgStayNotReviewable)
+	ErrReviewActionForbidden = errors.New(MsgReviewActionForbidden)
+	ErrReviewRemoved         = errors.New(MsgReviewRemoved)
+)
@@ -0,0 +1,78 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+
+CREATE TYPE review_status AS ENUM ('REVIEW_STATUS_UNSPECIFIED',
+                                   'REVIEW_STATUS_PUBLISHED',
+                                   'REVIEW_STATUS_REMOVED');
+
+-- A booking gets at most one review; hotel_id and author_id are copied from
+-- the booking when the stay is verified.
+CREATE TABLE IF NOT EXISTS review (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    hotel_id UUID NOT NULL,
+    booking_id UUID NOT NULL UNIQUE,
+    author_id BIGINT NOT NULL,
+    cleanliness SMALLINT NOT NULL CHECK (cleanliness BETWEEN 1 AND 5),
+    location SMALLINT NOT NULL CHECK (location BETWEEN 1 AND 5),
+    staff SMALLINT NOT NULL CHECK (staff BETWEEN 1 AND 5),
+    overall NUMERIC(3,2) GENERATED ALWAYS AS (ROUND((cleanliness + location + staff) / 3.0, 2)) STORED,
+    text TEXT NOT NULL DEFAULT '',
+    status review_status NOT NULL DEFAULT 'REVIEW_STATUS_PUBLISHED',
+    reply_text TEXT,
+    reply_author_id BIGINT,
+    replied_at TIMESTAMPTZ,
+    removed_by BIGINT,
+    removal_reason TEXT,
+    removed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_hotel ON review(hotel_id, created_at DESC)
+    WHERE status = 'REVIEW_STATUS_PUBLISHED';
+
+CREATE OR REPLACE FUNCTION update_updated_at_column()
+    RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_review_updated_at
+    BEFORE UPDATE ON review
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+-- hotel_rating marks hotels whose reviews changed since their rating was last
+-- pushed to the hotel service; rating and review_count hold the pushed values.
+CREATE TABLE IF NOT EXISTS hotel_rating (
+    hotel_id UUID PRIMARY KEY,
+    rating NUMERIC(3,2),
+    review_count INTEGER NOT NULL DEFAULT 0,
+    dirty BOOLEAN NOT NULL DEFAULT TRUE,
+    marked_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    synced_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_hotel_rating_dirty ON hotel_rating(marked_at)
+    WHERE dirty;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_hotel_rating_dirty;
+
+DROP TABLE IF EXISTS hotel_rating;
+
+DROP TRIGGER IF EXISTS update_review_updated_at ON review;
+
+DROP FUNCTION IF EXISTS update_updated_at_column();
+
+DROP INDEX IF EXISTS idx_review_hotel;
+
+DROP TABLE IF EXISTS review;
+
+DROP TYPE IF EXISTS review_status;
+-- +goose StatementEnd